@@ -12,8 +12,10 @@
 package clients
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -94,3 +96,63 @@ func (c *RemoteNode) GetStatistics(ctx context.Context, hostName string) (*model
 
 	return &statistics, nil
 }
+
+func (c *RemoteNode) RequestAsyncReplicationComparison(ctx context.Context, hostName, className string) error {
+	p := path.Join("/nodes/replication/compare", className)
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: hostName, Path: p}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+	if err != nil {
+		return enterrors.NewErrOpenHttpRequest(err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return enterrors.NewErrSendHttpRequest(err)
+	}
+
+	defer res.Body.Close()
+	body, _ := io.ReadAll(res.Body)
+	if res.StatusCode != http.StatusAccepted {
+		return enterrors.NewErrUnexpectedStatusCode(res.StatusCode, body)
+	}
+
+	return nil
+}
+
+func (c *RemoteNode) SetDynamicIndexUpgradePolicy(ctx context.Context,
+	hostName, className, tenant, targetVector, policy string,
+) error {
+	p := path.Join("/nodes/dynamic-index", className)
+	method := http.MethodPut
+	url := url.URL{Scheme: "http", Host: hostName, Path: p}
+
+	payload, err := json.Marshal(models.DynamicVectorIndexPolicyRequest{
+		Tenant:        tenant,
+		TargetVector:  targetVector,
+		UpgradePolicy: &policy,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal upgrade policy request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), bytes.NewReader(payload))
+	if err != nil {
+		return enterrors.NewErrOpenHttpRequest(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return enterrors.NewErrSendHttpRequest(err)
+	}
+
+	defer res.Body.Close()
+	body, _ := io.ReadAll(res.Body)
+	if res.StatusCode != http.StatusAccepted {
+		return enterrors.NewErrUnexpectedStatusCode(res.StatusCode, body)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/weaviate/weaviate/usecases/rebalancer"
+)
+
+const pathRebalancerStatus = "/rebalancer/status"
+
+type ClusterRebalancer struct {
+	client *http.Client
+}
+
+func NewClusterRebalancer(client *http.Client) *ClusterRebalancer {
+	return &ClusterRebalancer{client: client}
+}
+
+// Status returns the status of the rebalancer running on host
+func (c *ClusterRebalancer) Status(ctx context.Context, host string,
+) (*rebalancer.Status, error) {
+	url := url.URL{Scheme: "http", Host: host, Path: pathRebalancerStatus}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("new status request: %w", err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("status request: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d (%s)", res.StatusCode, body)
+	}
+
+	var status rebalancer.Status
+	if err := json.Unmarshal(body, &status); err != nil {
+		return nil, fmt.Errorf("unmarshal status response: %w", err)
+	}
+	return &status, nil
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/weaviate/weaviate/entities/tenantactivity"
+)
+
+const pathTenantActivity = "/tenant-activity"
+
+type ClusterTenantActivity struct {
+	client *http.Client
+}
+
+func NewClusterTenantActivity(client *http.Client) *ClusterTenantActivity {
+	return &ClusterTenantActivity{client: client}
+}
+
+// TenantActivity returns when the tenants held by host were last used
+func (c *ClusterTenantActivity) TenantActivity(ctx context.Context, host string,
+) (tenantactivity.ByCollection, error) {
+	url := url.URL{Scheme: "http", Host: host, Path: pathTenantActivity}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("new tenant activity request: %w", err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("tenant activity request: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d (%s)", res.StatusCode, body)
+	}
+
+	var activity tenantactivity.ByCollection
+	if err := json.Unmarshal(body, &activity); err != nil {
+		return nil, fmt.Errorf("unmarshal tenant activity response: %w", err)
+	}
+	return activity, nil
+}
@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
@@ -256,16 +257,19 @@ func (c *RemoteIndex) Exists(ctx context.Context, hostName, indexName,
 }
 
 func (c *RemoteIndex) DeleteObject(ctx context.Context, hostName, indexName,
-	shardName string, id strfmt.UUID, schemaVersion uint64,
+	shardName string, id strfmt.UUID, expectedVersion *int64, schemaVersion uint64,
 ) error {
 	path := fmt.Sprintf("/indices/%s/shards/%s/objects/%s", indexName, shardName, id)
 	method := http.MethodDelete
-	value := []string{strconv.FormatUint(schemaVersion, 10)}
+	query := url.Values{replica.SchemaVersionKey: []string{strconv.FormatUint(schemaVersion, 10)}}
+	if expectedVersion != nil {
+		query.Set(replica.ExpectedVersionKey, strconv.FormatInt(*expectedVersion, 10))
+	}
 	url := url.URL{
 		Scheme:   "http",
 		Host:     hostName,
 		Path:     path,
-		RawQuery: url.Values{replica.SchemaVersionKey: value}.Encode(),
+		RawQuery: query.Encode(),
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
@@ -285,6 +289,10 @@ func (c *RemoteIndex) DeleteObject(ctx context.Context, hostName, indexName,
 		return nil
 	}
 
+	if res.StatusCode == http.StatusPreconditionFailed {
+		body, _ := io.ReadAll(res.Body)
+		return objects.NewErrPreconditionFailed("%s", strings.TrimSpace(string(body)))
+	}
 	if res.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(res.Body)
 		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
@@ -326,6 +334,10 @@ func (c *RemoteIndex) MergeObject(ctx context.Context, hostName, indexName,
 	}
 
 	defer res.Body.Close()
+	if res.StatusCode == http.StatusPreconditionFailed {
+		body, _ := io.ReadAll(res.Body)
+		return objects.NewErrPreconditionFailed("%s", strings.TrimSpace(string(body)))
+	}
 	if res.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(res.Body)
 		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
@@ -837,3 +849,65 @@ func (c *RemoteIndex) IncreaseReplicationFactor(ctx context.Context,
 	}
 	return c.retry(ctx, 34, try)
 }
+
+func (c *RemoteIndex) SyncShardReplica(ctx context.Context,
+	hostName, indexName, shardName, targetNode string,
+) error {
+	path := fmt.Sprintf("/replicas/indices/%s/shards/%s:sync", indexName, shardName)
+
+	method := http.MethodPost
+	q := url.Values{"target": []string{targetNode}}.Encode()
+	url := url.URL{Scheme: "http", Host: hostName, Path: path, RawQuery: q}
+
+	try := func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+		if err != nil {
+			return false, fmt.Errorf("create http request: %w", err)
+		}
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusNoContent {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		return false, nil
+	}
+	return c.retry(ctx, 9, try)
+}
+
+func (c *RemoteIndex) TrackShardDeletions(ctx context.Context,
+	hostName, indexName, shardName string, track bool,
+) error {
+	path := fmt.Sprintf("/replicas/indices/%s/shards/%s:track-deletions", indexName, shardName)
+
+	method := http.MethodPost
+	if !track {
+		method = http.MethodDelete
+	}
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	try := func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+		if err != nil {
+			return false, fmt.Errorf("create http request: %w", err)
+		}
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusNoContent {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		return false, nil
+	}
+	return c.retry(ctx, 9, try)
+}
@@ -21,6 +21,7 @@ import (
 	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/go-openapi/strfmt"
@@ -177,13 +178,18 @@ func (c *replicationClient) PutObject(ctx context.Context, host, index,
 }
 
 func (c *replicationClient) DeleteObject(ctx context.Context, host, index,
-	shard, requestID string, uuid strfmt.UUID, schemaVersion uint64,
+	shard, requestID string, uuid strfmt.UUID, expectedVersion *int64, schemaVersion uint64,
 ) (replica.SimpleResponse, error) {
 	var resp replica.SimpleResponse
 	req, err := newHttpReplicaRequest(ctx, http.MethodDelete, host, index, shard, requestID, uuid.String(), nil, schemaVersion)
 	if err != nil {
 		return resp, fmt.Errorf("create http request: %w", err)
 	}
+	if expectedVersion != nil {
+		q := req.URL.Query()
+		q.Set(replica.ExpectedVersionKey, strconv.FormatInt(*expectedVersion, 10))
+		req.URL.RawQuery = q.Encode()
+	}
 
 	err = c.do(c.timeoutUnit*10, req, nil, &resp)
 	return resp, err
@@ -207,6 +213,24 @@ func (c *replicationClient) PutObjects(ctx context.Context, host, index,
 	return resp, err
 }
 
+func (c *replicationClient) PutObjectsTransactional(ctx context.Context, host, index,
+	shard, requestID string, objects []*storobj.Object, expectedVersions []*int64, schemaVersion uint64,
+) (replica.SimpleResponse, error) {
+	var resp replica.SimpleResponse
+	body, err := clusterapi.IndicesPayloads.TransactionalObjectList.Marshal(objects, expectedVersions)
+	if err != nil {
+		return resp, fmt.Errorf("encode request: %w", err)
+	}
+	req, err := newHttpReplicaRequest(ctx, http.MethodPost, host, index, shard, requestID, "", nil, schemaVersion)
+	if err != nil {
+		return resp, fmt.Errorf("create http request: %w", err)
+	}
+
+	clusterapi.IndicesPayloads.TransactionalObjectList.SetContentTypeHeaderReq(req)
+	err = c.do(c.timeoutUnit*60, req, body, &resp)
+	return resp, err
+}
+
 func (c *replicationClient) MergeObject(ctx context.Context, host, index, shard, requestID string,
 	doc *objects.MergeDocument, schemaVersion uint64,
 ) (replica.SimpleResponse, error) {
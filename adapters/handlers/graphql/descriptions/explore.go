@@ -28,3 +28,12 @@ const (
 	ID                   = "Concept identifier in the uuid format"
 	Beacon               = "Concept identifier in the beacon format, such as weaviate://<hostname>/<kind>/id"
 )
+
+const (
+	VectorPerTarget          = "Query vectors by target vector, used when searching several target vectors with different query vectors. Targets without an entry are searched with 'vector'"
+	TargetCombination        = "How the distances to several target vectors are combined into a single distance"
+	TargetCombinationMethod  = "The method used to combine the distances to the target vectors, defaults to minimum"
+	TargetCombinationWeights = "The weight of each target vector, required for manualWeights. Targets without weight have a weight of 1 for relativeScore"
+)
+
+const ExactSearch = "Compute the exact distances to all vectors, or all filtered vectors, instead of searching the vector index. Slow on large collections, meant for measuring the recall of the index"
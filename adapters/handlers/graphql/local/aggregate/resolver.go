@@ -159,6 +159,7 @@ func resolveAggregate(p graphql.ResolveParams, modulesProvider ModulesProvider,
 		ModuleParams:     moduleParams,
 		Hybrid:           hybridParams,
 		Tenant:           tenant,
+		ExactSearch:      common_filters.ExtractExactSearch(p.Args),
 	}
 
 	// we might support objectLimit without nearMedia filters later, e.g. with sort
@@ -0,0 +1,45 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"strings"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+)
+
+// ExactSearchField is the "exact" field of the near* arguments
+func ExactSearchField() *graphql.InputObjectFieldConfig {
+	return &graphql.InputObjectFieldConfig{
+		Description: descriptions.ExactSearch,
+		Type:        graphql.Boolean,
+	}
+}
+
+// ExtractExactSearch returns true if any near* argument, including the ones
+// provided by modules, sets "exact"
+func ExtractExactSearch(args map[string]interface{}) bool {
+	for name, arg := range args {
+		if !strings.HasPrefix(name, "near") {
+			continue
+		}
+		source, ok := arg.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if exact, ok := source["exact"].(bool); ok && exact {
+			return true
+		}
+	}
+	return false
+}
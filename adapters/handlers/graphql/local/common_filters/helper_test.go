@@ -50,8 +50,8 @@ func newMockResolver(t *testing.T, params mockParams) *mockResolver {
 					},
 				),
 			},
-			"nearVector": NearVectorArgument("Get", "SomeAction"),
-			"nearObject": NearObjectArgument("Get", "SomeAction"),
+			"nearVector": NearVectorArgument("Get", "SomeAction", true),
+			"nearObject": NearObjectArgument("Get", "SomeAction", true),
 		},
 		Type: graphql.Int,
 		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
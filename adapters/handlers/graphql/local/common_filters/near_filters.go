@@ -16,16 +16,44 @@ import (
 
 	"github.com/tailor-inc/graphql"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/dto"
 )
 
-func NearVectorArgument(argumentPrefix, className string) *graphql.ArgumentConfig {
+// NearVectorArgument builds the nearVector argument of a class. If
+// addTargets is set, the argument allows searching several target vectors at
+// once, each with its own query vector.
+func NearVectorArgument(argumentPrefix, className string, addTargets bool) *graphql.ArgumentConfig {
 	prefix := fmt.Sprintf("%s%s", argumentPrefix, className)
+	fields := NearVectorFields(prefix)
+	if addTargets {
+		// the vector can be provided per target instead
+		fields["vector"] = &graphql.InputObjectFieldConfig{
+			Description: descriptions.Vector,
+			Type:        graphql.NewList(graphql.Float),
+		}
+		fields["vectorPerTarget"] = &graphql.InputObjectFieldConfig{
+			Description: descriptions.VectorPerTarget,
+			Type: graphql.NewList(graphql.NewInputObject(graphql.InputObjectConfig{
+				Name: fmt.Sprintf("%sNearVectorPerTargetInpObj", prefix),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"target": &graphql.InputObjectFieldConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					"vector": &graphql.InputObjectFieldConfig{
+						Type: graphql.NewNonNull(graphql.NewList(graphql.Float)),
+					},
+				},
+			})),
+		}
+		fields["targetCombination"] = targetCombinationField(prefix + "NearVector")
+	}
+	fields["exact"] = ExactSearchField()
 	return &graphql.ArgumentConfig{
 		// Description: descriptions.GetExplore,
 		Type: graphql.NewInputObject(
 			graphql.InputObjectConfig{
 				Name:   fmt.Sprintf("%sNearVectorInpObj", prefix),
-				Fields: NearVectorFields(prefix),
+				Fields: fields,
 			},
 		),
 	}
@@ -52,13 +80,21 @@ func NearVectorFields(prefix string) graphql.InputObjectConfigFieldMap {
 	}
 }
 
-func NearObjectArgument(argumentPrefix, className string) *graphql.ArgumentConfig {
+// NearObjectArgument builds the nearObject argument of a class. If
+// addTargets is set, the argument allows combining the distances to several
+// target vectors.
+func NearObjectArgument(argumentPrefix, className string, addTargets bool) *graphql.ArgumentConfig {
 	prefix := fmt.Sprintf("%s%s", argumentPrefix, className)
+	fields := nearObjectFields(prefix)
+	if addTargets {
+		fields["targetCombination"] = targetCombinationField(prefix + "NearObject")
+	}
+	fields["exact"] = ExactSearchField()
 	return &graphql.ArgumentConfig{
 		Type: graphql.NewInputObject(
 			graphql.InputObjectConfig{
 				Name:   fmt.Sprintf("%sNearObjectInpObj", prefix),
-				Fields: nearObjectFields(prefix),
+				Fields: fields,
 			},
 		),
 	}
@@ -88,3 +124,41 @@ func nearObjectFields(prefix string) graphql.InputObjectConfigFieldMap {
 		},
 	}
 }
+
+func targetCombinationField(prefix string) *graphql.InputObjectFieldConfig {
+	return &graphql.InputObjectFieldConfig{
+		Description: descriptions.TargetCombination,
+		Type: graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: fmt.Sprintf("%sTargetCombinationInpObj", prefix),
+			Fields: graphql.InputObjectConfigFieldMap{
+				"method": &graphql.InputObjectFieldConfig{
+					Description: descriptions.TargetCombinationMethod,
+					Type: graphql.NewEnum(graphql.EnumConfig{
+						Name: fmt.Sprintf("%sTargetCombinationMethodEnum", prefix),
+						Values: graphql.EnumValueConfigMap{
+							"minimum":       &graphql.EnumValueConfig{Value: dto.Minimum},
+							"sum":           &graphql.EnumValueConfig{Value: dto.Sum},
+							"average":       &graphql.EnumValueConfig{Value: dto.Average},
+							"manualWeights": &graphql.EnumValueConfig{Value: dto.ManualWeights},
+							"relativeScore": &graphql.EnumValueConfig{Value: dto.RelativeScore},
+						},
+					}),
+				},
+				"weights": &graphql.InputObjectFieldConfig{
+					Description: descriptions.TargetCombinationWeights,
+					Type: graphql.NewList(graphql.NewInputObject(graphql.InputObjectConfig{
+						Name: fmt.Sprintf("%sTargetWeightInpObj", prefix),
+						Fields: graphql.InputObjectConfigFieldMap{
+							"target": &graphql.InputObjectFieldConfig{
+								Type: graphql.NewNonNull(graphql.String),
+							},
+							"weight": &graphql.InputObjectFieldConfig{
+								Type: graphql.NewNonNull(graphql.Float),
+							},
+						},
+					})),
+				},
+			},
+		}),
+	}
+}
@@ -21,11 +21,21 @@ import (
 func ExtractNearVector(source map[string]interface{}) (searchparams.NearVector, error) {
 	var args searchparams.NearVector
 
-	// vector is a required argument, so we don't need to check for its existing
-	vector := source["vector"].([]interface{})
-	args.Vector = make([]float32, len(vector))
-	for i, value := range vector {
-		args.Vector[i] = float32(value.(float64))
+	if vector, ok := source["vector"].([]interface{}); ok {
+		args.Vector = extractFloat32s(vector)
+	}
+
+	if vectorPerTarget, ok := source["vectorPerTarget"].([]interface{}); ok {
+		args.VectorPerTarget = make(map[string][]float32, len(vectorPerTarget))
+		for _, value := range vectorPerTarget {
+			entry := value.(map[string]interface{})
+			args.VectorPerTarget[entry["target"].(string)] = extractFloat32s(entry["vector"].([]interface{}))
+		}
+	}
+
+	if args.Vector == nil && args.VectorPerTarget == nil {
+		return searchparams.NearVector{},
+			fmt.Errorf("either vector or vectorPerTarget is required")
 	}
 
 	certainty, certaintyOK := source["certainty"]
@@ -54,3 +64,11 @@ func ExtractNearVector(source map[string]interface{}) (searchparams.NearVector,
 	}
 	return args, nil
 }
+
+func extractFloat32s(in []interface{}) []float32 {
+	out := make([]float32, len(in))
+	for i, value := range in {
+		out[i] = float32(value.(float64))
+	}
+	return out
+}
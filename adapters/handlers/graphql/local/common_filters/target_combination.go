@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/weaviate/weaviate/entities/dto"
+)
+
+// ExtractTargetCombination extracts the "targetCombination" argument of a
+// near filter searching the given target vectors. It returns nil if the
+// argument is not set.
+func ExtractTargetCombination(source map[string]interface{}, targetVectors []string,
+) (*dto.TargetCombination, error) {
+	combination, ok := source["targetCombination"].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	if len(targetVectors) < 2 {
+		return nil, fmt.Errorf("targetCombination requires at least two targetVectors")
+	}
+
+	out := &dto.TargetCombination{Type: dto.Minimum}
+	if method, ok := combination["method"].(dto.TargetCombinationType); ok {
+		out.Type = method
+	}
+
+	if weights, ok := combination["weights"].([]interface{}); ok {
+		out.Weights = make(map[string]float32, len(weights))
+		for _, value := range weights {
+			weight := value.(map[string]interface{})
+			target := weight["target"].(string)
+			if !slices.Contains(targetVectors, target) {
+				return nil, fmt.Errorf("weight for target vector %q which is not searched", target)
+			}
+			out.Weights[target] = float32(weight["weight"].(float64))
+		}
+	}
+
+	if out.Type == dto.ManualWeights {
+		for _, target := range targetVectors {
+			if _, ok := out.Weights[target]; !ok {
+				return nil, fmt.Errorf("manualWeights requires a weight for target vector %q", target)
+			}
+		}
+	}
+	return out, nil
+}
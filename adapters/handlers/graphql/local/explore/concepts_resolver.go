@@ -80,6 +80,10 @@ func (r *resolver) resolveExplore(p graphql.ResolveParams) (interface{}, error)
 		return nil, err
 	}
 
+	if common_filters.ExtractExactSearch(p.Args) {
+		return nil, fmt.Errorf("exact search is not supported by Explore, use Get instead")
+	}
+
 	params := traverser.ExploreParams{}
 
 	if param, ok := p.Args["nearVector"]; ok {
@@ -358,6 +358,7 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 	if err != nil {
 		return nil, err
 	}
+	addlProps.ExactSearch = common_filters.ExtractExactSearch(p.Args)
 
 	var sort []filters.Sort
 	if sortArg, ok := p.Args["sort"]; ok {
@@ -370,12 +371,18 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 	}
 
 	var nearVectorParams *searchparams.NearVector
+	var targetCombination *dto.TargetCombination
 	if nearVector, ok := p.Args["nearVector"]; ok {
 		p, err := common_filters.ExtractNearVector(nearVector.(map[string]interface{}))
 		if err != nil {
 			return nil, fmt.Errorf("failed to extract nearVector params: %s", err)
 		}
 		nearVectorParams = &p
+		targetCombination, err = common_filters.ExtractTargetCombination(
+			nearVector.(map[string]interface{}), p.TargetVectors)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract nearVector params: %s", err)
+		}
 	}
 
 	var nearObjectParams *searchparams.NearObject
@@ -385,6 +392,11 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 			return nil, fmt.Errorf("failed to extract nearObject params: %s", err)
 		}
 		nearObjectParams = &p
+		targetCombination, err = common_filters.ExtractTargetCombination(
+			nearObject.(map[string]interface{}), p.TargetVectors)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract nearObject params: %s", err)
+		}
 	}
 
 	var moduleParams map[string]interface{}
@@ -441,22 +453,23 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 	}
 
 	params := dto.GetParams{
-		Filters:               filters,
-		ClassName:             className,
-		Pagination:            pagination,
-		Cursor:                cursor,
-		Properties:            properties,
-		Sort:                  sort,
-		NearVector:            nearVectorParams,
-		NearObject:            nearObjectParams,
-		Group:                 group,
-		ModuleParams:          moduleParams,
-		AdditionalProperties:  addlProps,
-		KeywordRanking:        keywordRankingParams,
-		HybridSearch:          hybridParams,
-		ReplicationProperties: replProps,
-		GroupBy:               groupByParams,
-		Tenant:                tenant,
+		Filters:                 filters,
+		ClassName:               className,
+		Pagination:              pagination,
+		Cursor:                  cursor,
+		Properties:              properties,
+		Sort:                    sort,
+		NearVector:              nearVectorParams,
+		NearObject:              nearObjectParams,
+		TargetVectorCombination: targetCombination,
+		Group:                   group,
+		ModuleParams:            moduleParams,
+		AdditionalProperties:    addlProps,
+		KeywordRanking:          keywordRankingParams,
+		HybridSearch:            hybridParams,
+		ReplicationProperties:   replProps,
+		GroupBy:                 groupByParams,
+		Tenant:                  tenant,
 	}
 
 	// need to perform vector search by distance
@@ -1243,6 +1243,27 @@ func TestNearVectorRanker(t *testing.T) {
 		resolver.AssertResolve(t, query)
 	})
 
+	t.Run("for actions with exact search", func(t *testing.T) {
+		query := `{ Get { SomeAction(nearVector: {
+								vector: [0.123, 0.984]
+								exact: true
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{
+				Vector: []float32{0.123, 0.984},
+			},
+			AdditionalProperties: additional.Properties{ExactSearch: true},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
 	t.Run("for things with optional distance set", func(t *testing.T) {
 		query := `{ Get { SomeThing(nearVector: {
 								vector: [0.123, 0.984]
@@ -2205,6 +2226,50 @@ func TestNearVectorNoModules(t *testing.T) {
 
 		resolver.AssertResolve(t, query)
 	})
+
+	t.Run("for things with multiple target vectors", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+								vectorPerTarget: [
+									{target: "first", vector: [0.123, 0.984]}
+									{target: "second", vector: [0.5]}
+								]
+								targetVectors: ["first", "second"]
+								targetCombination: {
+									method: manualWeights
+									weights: [{target: "first", weight: 0.2}, {target: "second", weight: 0.8}]
+								}
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{
+				VectorPerTarget: map[string][]float32{
+					"first":  {0.123, 0.984},
+					"second": {0.5},
+				},
+				TargetVectors: []string{"first", "second"},
+			},
+			TargetVectorCombination: &dto.TargetCombination{
+				Type:    dto.ManualWeights,
+				Weights: map[string]float32{"first": 0.2, "second": 0.8},
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("for things with a target combination for a single target vector", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+								vector: [0.123, 0.984]
+								targetVectors: ["first"]
+								targetCombination: {method: sum}
+							}) { intField } } }`
+
+		resolver.AssertFailToResolve(t, query)
+	})
 }
 
 func TestSort(t *testing.T) {
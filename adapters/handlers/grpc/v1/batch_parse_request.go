@@ -43,16 +43,7 @@ func batchFromProto(req *pb.BatchObjectsRequest, getClass func(string) *models.C
 	for i, obj := range objectsBatch {
 		var props map[string]interface{}
 		if obj.Properties != nil {
-			props = extractPrimitiveProperties(&pb.ObjectPropertiesValue{
-				NonRefProperties:       obj.Properties.NonRefProperties,
-				BooleanArrayProperties: obj.Properties.BooleanArrayProperties,
-				NumberArrayProperties:  obj.Properties.NumberArrayProperties,
-				TextArrayProperties:    obj.Properties.TextArrayProperties,
-				IntArrayProperties:     obj.Properties.IntArrayProperties,
-				ObjectProperties:       obj.Properties.ObjectProperties,
-				ObjectArrayProperties:  obj.Properties.ObjectArrayProperties,
-				EmptyListProps:         obj.Properties.EmptyListProps,
-			})
+			props = primitivePropertiesFromProto(obj.Properties)
 			// If class is not in schema, continue as there is no ref to extract
 			class := getClass(obj.Collection)
 			if class != nil {
@@ -103,6 +94,19 @@ func batchFromProto(req *pb.BatchObjectsRequest, getClass func(string) *models.C
 	return objs[:insertCounter], objOriginalIndex, objectErrors
 }
 
+func primitivePropertiesFromProto(properties *pb.BatchObject_Properties) map[string]interface{} {
+	return extractPrimitiveProperties(&pb.ObjectPropertiesValue{
+		NonRefProperties:       properties.NonRefProperties,
+		BooleanArrayProperties: properties.BooleanArrayProperties,
+		NumberArrayProperties:  properties.NumberArrayProperties,
+		TextArrayProperties:    properties.TextArrayProperties,
+		IntArrayProperties:     properties.IntArrayProperties,
+		ObjectProperties:       properties.ObjectProperties,
+		ObjectArrayProperties:  properties.ObjectArrayProperties,
+		EmptyListProps:         properties.EmptyListProps,
+	})
+}
+
 func extractSingleRefTarget(class *models.Class, properties []*pb.BatchObject_SingleTargetRefProps, props map[string]interface{}) error {
 	for _, refSingle := range properties {
 		propName := refSingle.GetPropName()
@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func batchUpdateObjectsFromProto(req *pb.BatchUpdateRequest) (objects.BatchUpdateObjects, error) {
+	if req.Changes != nil {
+		return nil, fmt.Errorf("changes are set per object when updating by uuid")
+	}
+	if req.DryRun {
+		return nil, fmt.Errorf("dry run is only supported when updating by filters")
+	}
+
+	objs := make(objects.BatchUpdateObjects, len(req.Objects))
+	for i, obj := range req.Objects {
+		changes, err := propertyChangesFromProto(obj.Changes)
+		if err != nil {
+			return nil, fmt.Errorf("object %d: %w", i, err)
+		}
+		objs[i] = objects.BatchUpdateObject{
+			OriginalIndex: i,
+			UUID:          strfmt.UUID(obj.Uuid),
+			Changes:       changes,
+		}
+	}
+	return objs, nil
+}
+
+func batchUpdateParamsFromProto(req *pb.BatchUpdateRequest, getClass func(string) *models.Class,
+) (objects.BatchUpdateParams, error) {
+	params := objects.BatchUpdateParams{
+		ClassName: schema.ClassName(req.Collection),
+		DryRun:    req.DryRun,
+	}
+	if req.After != nil {
+		params.After = strfmt.UUID(*req.After)
+	}
+	if len(req.Objects) > 0 {
+		return params, fmt.Errorf("objects cannot be combined with filters")
+	}
+
+	changes, err := propertyChangesFromProto(req.Changes)
+	if err != nil {
+		return params, err
+	}
+	params.Changes = changes
+
+	clause, err := extractFilters(req.Filters, getClass, req.Collection)
+	if err != nil {
+		return params, err
+	}
+	params.Filters = &filters.LocalFilter{Root: &clause}
+	return params, nil
+}
+
+func propertyChangesFromProto(changes *pb.PropertyChanges) (objects.PropertyChanges, error) {
+	if changes == nil {
+		return objects.PropertyChanges{}, nil
+	}
+
+	out := objects.PropertyChanges{Unset: changes.Unset}
+	for _, props := range []*pb.BatchObject_Properties{changes.Set, changes.Append} {
+		if props != nil && (len(props.SingleTargetRefProps) > 0 || len(props.MultiTargetRefProps) > 0) {
+			return out, fmt.Errorf("references cannot be updated partially")
+		}
+	}
+	if changes.Set != nil {
+		out.Set = primitivePropertiesFromProto(changes.Set)
+	}
+	if changes.Append != nil {
+		out.Append = primitivePropertiesFromProto(changes.Append)
+	}
+	return out, nil
+}
+
+func batchUpdateReplyFromObjects(objs objects.BatchUpdateObjects) *pb.BatchUpdateReply {
+	reply := &pb.BatchUpdateReply{Matches: int64(len(objs))}
+	for _, obj := range objs {
+		if obj.Err == nil {
+			reply.Updated += 1
+			continue
+		}
+		reply.Errors = append(reply.Errors, &pb.BatchUpdateReply_BatchError{
+			Index: int32(obj.OriginalIndex),
+			Uuid:  obj.UUID.String(),
+			Error: obj.Err.Error(),
+		})
+	}
+	return reply
+}
+
+func batchUpdateReplyFromResult(res objects.BatchUpdateResult) *pb.BatchUpdateReply {
+	reply := &pb.BatchUpdateReply{Matches: res.Matches, Updated: res.Updated}
+	if res.Next != "" {
+		next := res.Next.String()
+		reply.Next = &next
+	}
+	for i, obj := range res.Objects {
+		if obj.Err == nil {
+			continue
+		}
+		reply.Errors = append(reply.Errors, &pb.BatchUpdateReply_BatchError{
+			Index: int32(i),
+			Uuid:  obj.UUID.String(),
+			Error: obj.Err.Error(),
+		})
+	}
+	return reply
+}
@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestBatchUpdateRequest(t *testing.T) {
+	collection := "TestClass"
+	uuid := "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc"
+	scheme := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: collection,
+					Properties: []*models.Property{
+						{Name: "name", DataType: schema.DataTypeText.PropString()},
+						{Name: "popularity", DataType: schema.DataTypeInt.PropString()},
+						{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+					},
+				},
+			},
+		},
+	}
+	getClass := func(name string) *models.Class { return scheme.GetClass(name) }
+
+	set, err := structpb.NewStruct(map[string]interface{}{"popularity": 7})
+	require.Nil(t, err)
+	changesIn := &pb.PropertyChanges{
+		Set:   &pb.BatchObject_Properties{NonRefProperties: set},
+		Unset: []string{"name"},
+		Append: &pb.BatchObject_Properties{TextArrayProperties: []*pb.TextArrayProperties{
+			{PropName: "tags", Values: []string{"a", "b"}},
+		}},
+	}
+	changesOut := objects.PropertyChanges{
+		Set:    map[string]interface{}{"popularity": float64(7)},
+		Unset:  []string{"name"},
+		Append: map[string]interface{}{"tags": []interface{}{"a", "b"}},
+	}
+
+	t.Run("by uuid", func(t *testing.T) {
+		objs, err := batchUpdateObjectsFromProto(&pb.BatchUpdateRequest{
+			Collection: collection,
+			Objects: []*pb.BatchUpdateObject{
+				{Uuid: uuid, Changes: changesIn},
+				{Uuid: uuid},
+			},
+		})
+		require.Nil(t, err)
+		require.Equal(t, objects.BatchUpdateObjects{
+			{OriginalIndex: 0, UUID: strfmt.UUID(uuid), Changes: changesOut},
+			{OriginalIndex: 1, UUID: strfmt.UUID(uuid)},
+		}, objs)
+	})
+
+	t.Run("by uuid with shared changes", func(t *testing.T) {
+		_, err := batchUpdateObjectsFromProto(&pb.BatchUpdateRequest{
+			Collection: collection,
+			Objects:    []*pb.BatchUpdateObject{{Uuid: uuid}},
+			Changes:    changesIn,
+		})
+		require.NotNil(t, err)
+	})
+
+	t.Run("with references", func(t *testing.T) {
+		_, err := batchUpdateObjectsFromProto(&pb.BatchUpdateRequest{
+			Collection: collection,
+			Objects: []*pb.BatchUpdateObject{{Uuid: uuid, Changes: &pb.PropertyChanges{
+				Append: &pb.BatchObject_Properties{SingleTargetRefProps: []*pb.BatchObject_SingleTargetRefProps{
+					{PropName: "ref", Uuids: []string{uuid}},
+				}},
+			}}},
+		})
+		require.NotNil(t, err)
+	})
+
+	t.Run("by filter", func(t *testing.T) {
+		params, err := batchUpdateParamsFromProto(&pb.BatchUpdateRequest{
+			Collection: collection,
+			DryRun:     true,
+			Filters: &pb.Filters{
+				Operator:  pb.Filters_OPERATOR_EQUAL,
+				TestValue: &pb.Filters_ValueText{ValueText: "test"},
+				Target:    &pb.FilterTarget{Target: &pb.FilterTarget_Property{Property: "name"}},
+			},
+			Changes: changesIn,
+		}, getClass)
+		require.Nil(t, err)
+		require.Equal(t, changesOut, params.Changes)
+		require.True(t, params.DryRun)
+		require.Equal(t, schema.ClassName(collection), params.ClassName)
+		require.Equal(t, &filters.LocalFilter{Root: &filters.Clause{
+			On:       &filters.Path{Class: schema.ClassName(collection), Property: "name"},
+			Operator: filters.OperatorEqual,
+			Value:    &filters.Value{Value: "test", Type: schema.DataTypeText},
+		}}, params.Filters)
+	})
+
+	t.Run("by filter with objects", func(t *testing.T) {
+		_, err := batchUpdateParamsFromProto(&pb.BatchUpdateRequest{
+			Collection: collection,
+			Objects:    []*pb.BatchUpdateObject{{Uuid: uuid}},
+			Filters:    &pb.Filters{},
+		}, getClass)
+		require.NotNil(t, err)
+	})
+
+	t.Run("reply", func(t *testing.T) {
+		reply := batchUpdateReplyFromObjects(objects.BatchUpdateObjects{
+			{OriginalIndex: 0, UUID: strfmt.UUID(uuid)},
+			{OriginalIndex: 1, UUID: strfmt.UUID(uuid), Err: errors.New("not found")},
+		})
+		require.Equal(t, int64(2), reply.Matches)
+		require.Equal(t, int64(1), reply.Updated)
+		require.Len(t, reply.Errors, 1)
+		require.Equal(t, int32(1), reply.Errors[0].Index)
+		require.Equal(t, uuid, reply.Errors[0].Uuid)
+		require.Equal(t, "not found", reply.Errors[0].Error)
+	})
+
+	t.Run("reply from filter result", func(t *testing.T) {
+		reply := batchUpdateReplyFromResult(objects.BatchUpdateResult{
+			Matches: 3,
+			Updated: 1,
+			Objects: objects.BatchSimpleObjects{
+				{UUID: strfmt.UUID(uuid)},
+				{UUID: strfmt.UUID(uuid), Err: errors.New("conflict")},
+			},
+		})
+		require.Equal(t, int64(3), reply.Matches)
+		require.Equal(t, int64(1), reply.Updated)
+		require.Len(t, reply.Errors, 1)
+		require.Equal(t, int32(1), reply.Errors[0].Index)
+		require.Equal(t, "conflict", reply.Errors[0].Error)
+	})
+}
@@ -13,6 +13,7 @@ package v1
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/weaviate/weaviate/usecases/config"
 
@@ -87,12 +88,25 @@ func searchParamsFromProto(req *pb.SearchRequest, getClass func(string) *models.
 			vector = byteops.Float32FromByteVector(nv.VectorBytes)
 		} else if len(nv.Vector) > 0 {
 			vector = nv.Vector
-		} else {
+		} else if len(nv.VectorPerTarget) == 0 {
 			return dto.GetParams{}, fmt.Errorf("near_vector: vector is required")
 		}
 		out.NearVector = &searchparams.NearVector{
 			Vector:        vector,
-			TargetVectors: nv.TargetVectors,
+			TargetVectors: targetVectorsFromProto(nv.Targets, nv.TargetVectors),
+		}
+		if len(nv.VectorPerTarget) > 0 {
+			out.NearVector.VectorPerTarget = make(map[string][]float32, len(nv.VectorPerTarget))
+			for target, vec := range nv.VectorPerTarget {
+				if !slices.Contains(out.NearVector.TargetVectors, target) {
+					return dto.GetParams{}, fmt.Errorf("near_vector: vector for target vector %s which is not searched", target)
+				}
+				out.NearVector.VectorPerTarget[target] = byteops.Float32FromByteVector(vec)
+			}
+		}
+		out.TargetVectorCombination, err = extractTargetCombination(nv.Targets)
+		if err != nil {
+			return dto.GetParams{}, errors.Wrap(err, "near_vector")
 		}
 
 		// The following business logic should not sit in the API. However, it is
@@ -110,6 +124,8 @@ func searchParamsFromProto(req *pb.SearchRequest, getClass func(string) *models.
 			out.NearVector.Distance = *nv.Distance
 			out.NearVector.WithDistance = true
 		}
+
+		out.AdditionalProperties.ExactSearch = nv.Exact
 	}
 
 	if no := req.NearObject; no != nil {
@@ -118,7 +134,11 @@ func searchParamsFromProto(req *pb.SearchRequest, getClass func(string) *models.
 		}
 		out.NearObject = &searchparams.NearObject{
 			ID:            no.Id,
-			TargetVectors: no.TargetVectors,
+			TargetVectors: targetVectorsFromProto(no.Targets, no.TargetVectors),
+		}
+		out.TargetVectorCombination, err = extractTargetCombination(no.Targets)
+		if err != nil {
+			return dto.GetParams{}, errors.Wrap(err, "near_object")
 		}
 
 		// The following business logic should not sit in the API. However, it is
@@ -366,6 +386,8 @@ func extractGroupBy(groupIn *pb.GroupBy, out *dto.GetParams) (*searchparams.Grou
 
 func extractTargetVectors(req *pb.SearchRequest, class *models.Class) (*[]string, error) {
 	var targetVectors *[]string
+	// only near_vector and near_object can search several target vectors at once
+	multiTarget := false
 	if hs := req.HybridSearch; hs != nil {
 		targetVectors = &hs.TargetVectors
 	}
@@ -382,7 +404,9 @@ func extractTargetVectors(req *pb.SearchRequest, class *models.Class) (*[]string
 		targetVectors = &ni.TargetVectors
 	}
 	if no := req.NearObject; no != nil {
-		targetVectors = &no.TargetVectors
+		targets := targetVectorsFromProto(no.Targets, no.TargetVectors)
+		targetVectors = &targets
+		multiTarget = true
 	}
 	if nt := req.NearText; nt != nil {
 		targetVectors = &nt.TargetVectors
@@ -391,7 +415,9 @@ func extractTargetVectors(req *pb.SearchRequest, class *models.Class) (*[]string
 		targetVectors = &nt.TargetVectors
 	}
 	if nv := req.NearVector; nv != nil {
-		targetVectors = &nv.TargetVectors
+		targets := targetVectorsFromProto(nv.Targets, nv.TargetVectors)
+		targetVectors = &targets
+		multiTarget = true
 	}
 	if nv := req.NearVideo; nv != nil {
 		targetVectors = &nv.TargetVectors
@@ -400,12 +426,61 @@ func extractTargetVectors(req *pb.SearchRequest, class *models.Class) (*[]string
 	if targetVectors != nil && len(*targetVectors) == 0 && len(class.VectorConfig) > 1 {
 		return nil, fmt.Errorf("class %s has multiple vectors, but no target vectors were provided", class.Class)
 	}
-	if targetVectors != nil && len(*targetVectors) > 1 {
+	if targetVectors != nil && len(*targetVectors) > 1 && !multiTarget {
 		return nil, fmt.Errorf("cannot provide multiple target vectors when searching, only one is allowed")
 	}
 	return targetVectors, nil
 }
 
+// targetVectorsFromProto returns the target vectors of the targets message if
+// it is set, and falls back to the plain list of target vectors otherwise
+func targetVectorsFromProto(targets *pb.Targets, targetVectors []string) []string {
+	if targets != nil && len(targets.TargetVectors) > 0 {
+		return targets.TargetVectors
+	}
+	return targetVectors
+}
+
+func extractTargetCombination(targets *pb.Targets) (*dto.TargetCombination, error) {
+	if targets == nil || len(targets.TargetVectors) < 2 {
+		return nil, nil
+	}
+
+	out := &dto.TargetCombination{}
+	switch targets.Combination {
+	case pb.CombinationMethod_COMBINATION_METHOD_UNSPECIFIED, pb.CombinationMethod_COMBINATION_METHOD_TYPE_MIN:
+		out.Type = dto.Minimum
+	case pb.CombinationMethod_COMBINATION_METHOD_TYPE_SUM:
+		out.Type = dto.Sum
+	case pb.CombinationMethod_COMBINATION_METHOD_TYPE_AVERAGE:
+		out.Type = dto.Average
+	case pb.CombinationMethod_COMBINATION_METHOD_TYPE_RELATIVE_SCORE:
+		out.Type = dto.RelativeScore
+	case pb.CombinationMethod_COMBINATION_METHOD_TYPE_MANUAL:
+		out.Type = dto.ManualWeights
+	default:
+		return nil, fmt.Errorf("unknown combination method %v", targets.Combination)
+	}
+
+	if len(targets.Weights) > 0 {
+		out.Weights = make(map[string]float32, len(targets.Weights))
+		for target, weight := range targets.Weights {
+			if !slices.Contains(targets.TargetVectors, target) {
+				return nil, fmt.Errorf("weight for target vector %s which is not searched", target)
+			}
+			out.Weights[target] = weight
+		}
+	}
+	if out.Type == dto.ManualWeights {
+		for _, target := range targets.TargetVectors {
+			if _, ok := out.Weights[target]; !ok {
+				return nil, fmt.Errorf("manual combination requires a weight for target vector %s", target)
+			}
+		}
+	}
+	return out, nil
+}
+
 func extractSorting(sortIn []*pb.SortBy) []filters.Sort {
 	sortOut := make([]filters.Sort, len(sortIn))
 	for i := range sortIn {
@@ -356,6 +356,29 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "near vector with exact search",
+			req: &pb.SearchRequest{
+				Collection: multiVecClass,
+				Properties: &pb.PropertiesRequest{},
+				NearVector: &pb.NearVector{
+					Vector:        []float32{1, 2, 3},
+					TargetVectors: []string{"custom"},
+					Exact:         true,
+				},
+			},
+			out: dto.GetParams{
+				ClassName:            multiVecClass,
+				Pagination:           defaultPagination,
+				Properties:           search.SelectProperties{},
+				AdditionalProperties: additional.Properties{NoProps: true, ExactSearch: true},
+				NearVector: &searchparams.NearVector{
+					Vector:        []float32{1, 2, 3},
+					TargetVectors: []string{"custom"},
+				},
+			},
+			error: false,
+		},
 		{
 			name: "Vectors throws error if no target vectors are given",
 			req: &pb.SearchRequest{
@@ -370,19 +393,83 @@ func TestGRPCRequest(t *testing.T) {
 			error: true,
 		},
 		{
-			name: "Vectors throws error if more than one target vectors are given",
+			name: "near vector with multiple target vectors",
 			req: &pb.SearchRequest{
 				Collection: multiVecClass,
-				Metadata:   &pb.MetadataRequest{Vector: true},
 				Properties: &pb.PropertiesRequest{},
 				NearVector: &pb.NearVector{
-					Vector:        []float32{1, 2, 3},
+					VectorPerTarget: map[string][]byte{
+						"custom": byteops.Float32ToByteVector([]float32{1, 2, 3}),
+						"first":  byteops.Float32ToByteVector([]float32{4, 5}),
+					},
+					Targets: &pb.Targets{
+						TargetVectors: []string{"custom", "first"},
+						Combination:   pb.CombinationMethod_COMBINATION_METHOD_TYPE_MANUAL,
+						Weights:       map[string]float32{"custom": 0.25, "first": 0.75},
+					},
+				},
+			},
+			out: dto.GetParams{
+				ClassName:            multiVecClass,
+				Pagination:           defaultPagination,
+				Properties:           search.SelectProperties{},
+				AdditionalProperties: additional.Properties{NoProps: true},
+				NearVector: &searchparams.NearVector{
 					TargetVectors: []string{"custom", "first"},
+					VectorPerTarget: map[string][]float32{
+						"custom": {1, 2, 3},
+						"first":  {4, 5},
+					},
+				},
+				TargetVectorCombination: &dto.TargetCombination{
+					Type:    dto.ManualWeights,
+					Weights: map[string]float32{"custom": 0.25, "first": 0.75},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "near vector with multiple target vectors and missing manual weight",
+			req: &pb.SearchRequest{
+				Collection: multiVecClass,
+				Properties: &pb.PropertiesRequest{},
+				NearVector: &pb.NearVector{
+					Vector: []float32{1, 2, 3},
+					Targets: &pb.Targets{
+						TargetVectors: []string{"custom", "first"},
+						Combination:   pb.CombinationMethod_COMBINATION_METHOD_TYPE_MANUAL,
+						Weights:       map[string]float32{"custom": 0.25},
+					},
+				},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
+		{
+			name: "near vector with vector for target vector which is not searched",
+			req: &pb.SearchRequest{
+				Collection: multiVecClass,
+				Properties: &pb.PropertiesRequest{},
+				NearVector: &pb.NearVector{
+					VectorPerTarget: map[string][]byte{
+						"other": byteops.Float32ToByteVector([]float32{1, 2, 3}),
+					},
+					Targets: &pb.Targets{TargetVectors: []string{"custom", "first"}},
 				},
 			},
 			out:   dto.GetParams{},
 			error: true,
 		},
+		{
+			name: "hybrid throws error if more than one target vectors are given",
+			req: &pb.SearchRequest{
+				Collection:   multiVecClass,
+				Properties:   &pb.PropertiesRequest{},
+				HybridSearch: &pb.Hybrid{Query: "query", TargetVectors: []string{"custom", "first"}},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
 		{
 			name: "Properties return all nonref values with new default logic",
 			req:  &pb.SearchRequest{Uses_123Api: true, Collection: classname, Properties: &pb.PropertiesRequest{ReturnAllNonrefProperties: true}},
@@ -18,6 +18,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
 
 	"github.com/weaviate/weaviate/usecases/config"
 
@@ -123,6 +124,61 @@ func (s *Service) batchDelete(ctx context.Context, req *pb.BatchDeleteRequest) (
 	return result, nil
 }
 
+func (s *Service) BatchUpdate(ctx context.Context, req *pb.BatchUpdateRequest) (*pb.BatchUpdateReply, error) {
+	var result *pb.BatchUpdateReply
+	var errInner error
+
+	if err := enterrors.GoWrapperWithBlock(func() {
+		result, errInner = s.batchUpdate(ctx, req)
+	}, s.logger); err != nil {
+		return nil, err
+	}
+
+	return result, errInner
+}
+
+func (s *Service) batchUpdate(ctx context.Context, req *pb.BatchUpdateRequest) (*pb.BatchUpdateReply, error) {
+	before := time.Now()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	replicationProperties := extractReplicationProperties(req.ConsistencyLevel)
+
+	tenant := ""
+	if req.Tenant != nil {
+		tenant = *req.Tenant
+	}
+
+	var result *pb.BatchUpdateReply
+	if req.Filters != nil {
+		params, err := batchUpdateParamsFromProto(req, s.schemaManager.ReadOnlyClass)
+		if err != nil {
+			return nil, fmt.Errorf("batch update params: %w", err)
+		}
+		response, err := s.batchManager.UpdateObjectsByFilter(ctx, principal, params,
+			replicationProperties, tenant)
+		if err != nil {
+			return nil, fmt.Errorf("batch update: %w", err)
+		}
+		result = batchUpdateReplyFromResult(response)
+	} else {
+		objs, err := batchUpdateObjectsFromProto(req)
+		if err != nil {
+			return nil, fmt.Errorf("batch update params: %w", err)
+		}
+		response, err := s.batchManager.UpdateObjects(ctx, principal, req.Collection, objs,
+			replicationProperties, tenant)
+		if err != nil {
+			return nil, fmt.Errorf("batch update: %w", err)
+		}
+		result = batchUpdateReplyFromObjects(response)
+	}
+
+	result.Took = float32(time.Since(before).Seconds())
+	return result, nil
+}
+
 func (s *Service) BatchObjects(ctx context.Context, req *pb.BatchObjectsRequest) (*pb.BatchObjectsReply, error) {
 	var result *pb.BatchObjectsReply
 	var errInner error
@@ -146,8 +202,18 @@ func (s *Service) batchObjects(ctx context.Context, req *pb.BatchObjectsRequest)
 
 	replicationProperties := extractReplicationProperties(req.ConsistencyLevel)
 
-	all := "ALL"
-	response, err := s.batchManager.AddObjects(ctx, principal, objs, []*string{&all}, replicationProperties)
+	if req.Transactional {
+		return s.batchObjectsTransactional(ctx, principal, req, objs, objOriginalIndex,
+			objectParsingErrors, replicationProperties, before)
+	}
+
+	var response objects.BatchObjects
+	if versions := expectedVersions(req, objs, objOriginalIndex); versions != nil {
+		response, err = s.batchManager.AddObjectsConditional(ctx, principal, objs, versions, replicationProperties)
+	} else {
+		all := "ALL"
+		response, err = s.batchManager.AddObjects(ctx, principal, objs, []*string{&all}, replicationProperties)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -170,6 +236,61 @@ func (s *Service) batchObjects(ctx context.Context, req *pb.BatchObjectsRequest)
 	return result, nil
 }
 
+// batchObjectsTransactional adds all objects or none of them, an object which
+// could not be parsed aborts the transaction before anything is written
+func (s *Service) batchObjectsTransactional(ctx context.Context, principal *models.Principal,
+	req *pb.BatchObjectsRequest, objs []*models.Object, objOriginalIndex map[int]int,
+	objectParsingErrors map[int]error, replicationProperties *additional.ReplicationProperties,
+	before time.Time,
+) (*pb.BatchObjectsReply, error) {
+	var objErrors []*pb.BatchObjectsReply_BatchError
+	if len(objectParsingErrors) > 0 {
+		for i := range req.Objects {
+			msg := "transaction aborted, an object could not be parsed"
+			if err, ok := objectParsingErrors[i]; ok {
+				msg = err.Error()
+			}
+			objErrors = append(objErrors, &pb.BatchObjectsReply_BatchError{Index: int32(i), Error: msg})
+		}
+		return &pb.BatchObjectsReply{
+			Took:   float32(time.Since(before).Seconds()),
+			Errors: objErrors,
+		}, nil
+	}
+
+	response, err := s.batchManager.AddObjectsTransactional(ctx, principal, objs,
+		expectedVersions(req, objs, objOriginalIndex), replicationProperties)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, obj := range response {
+		if obj.Err != nil {
+			objErrors = append(objErrors, &pb.BatchObjectsReply_BatchError{Index: int32(objOriginalIndex[i]), Error: obj.Err.Error()})
+		}
+	}
+
+	return &pb.BatchObjectsReply{
+		Took:   float32(time.Since(before).Seconds()),
+		Errors: objErrors,
+	}, nil
+}
+
+// expectedVersions returns the expected version of each parsed object, nil if
+// no object has one
+func expectedVersions(req *pb.BatchObjectsRequest, objs []*models.Object, objOriginalIndex map[int]int) []*int64 {
+	var out []*int64
+	for i := range objs {
+		if version := req.Objects[objOriginalIndex[i]].ExpectedVersion; version != nil {
+			if out == nil {
+				out = make([]*int64, len(objs))
+			}
+			out[i] = version
+		}
+	}
+	return out
+}
+
 func (s *Service) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
 	var result *pb.SearchReply
 	var errInner error
@@ -113,7 +113,7 @@ type shards interface {
 	Exists(ctx context.Context, indexName, shardName string,
 		id strfmt.UUID) (bool, error)
 	DeleteObject(ctx context.Context, indexName, shardName string,
-		id strfmt.UUID, schemaVersion uint64) error
+		id strfmt.UUID, expectedVersion *int64, schemaVersion uint64) error
 	MergeObject(ctx context.Context, indexName, shardName string,
 		mergeDoc objects.MergeDocument, schemaVersion uint64) error
 	MultiGetObjects(ctx context.Context, indexName, shardName string,
@@ -551,9 +551,16 @@ func (i *indices) deleteObject() http.Handler {
 			return
 		}
 
-		err = i.shards.DeleteObject(r.Context(), index, shard, strfmt.UUID(id), schemaVersion)
+		expectedVersion, err := extractExpectedVersionFromUrlQuery(r.URL.Query())
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = i.shards.DeleteObject(r.Context(), index, shard, strfmt.UUID(id), expectedVersion, schemaVersion)
+		if err != nil {
+			http.Error(w, err.Error(), writeErrorStatus(err))
+			return
 		}
 
 		w.WriteHeader(http.StatusNoContent)
@@ -597,7 +604,7 @@ func (i *indices) mergeObject() http.Handler {
 		}
 
 		if err = i.shards.MergeObject(r.Context(), index, shard, mergeDoc, schemaVersion); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			http.Error(w, err.Error(), writeErrorStatus(err))
 			return
 		}
 
@@ -1323,3 +1330,12 @@ func (i *indices) putShardReinit() http.Handler {
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
+
+// writeErrorStatus returns the status code of a failed write, a conditional
+// write which was rejected fails with 412
+func writeErrorStatus(err error) int {
+	if errors.As(err, &objects.ErrPreconditionFailed{}) {
+		return http.StatusPreconditionFailed
+	}
+	return http.StatusInternalServerError
+}
@@ -39,6 +39,7 @@ type indicesPayloads struct {
 	MergeDoc                  mergeDocPayload
 	ObjectList                objectListPayload
 	VersionedObjectList       versionedObjectListPayload
+	TransactionalObjectList   transactionalObjectListPayload
 	SearchResults             searchResultsPayload
 	SearchParams              searchParamsPayload
 	ReferenceList             referenceListPayload
@@ -229,6 +230,56 @@ func (p objectListPayload) Unmarshal(in []byte) ([]*storobj.Object, error) {
 	return out, nil
 }
 
+// transactionalObjectListPayload is an object list which is put in a single
+// transaction, it is prefixed with the JSON encoded expected versions
+type transactionalObjectListPayload struct{}
+
+func (p transactionalObjectListPayload) MIME() string {
+	return "application/vnd.weaviate.storobj.transaction+octet-stream"
+}
+
+func (p transactionalObjectListPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+func (p transactionalObjectListPayload) Marshal(objs []*storobj.Object,
+	expectedVersions []*int64,
+) ([]byte, error) {
+	versions, err := json.Marshal(expectedVersions)
+	if err != nil {
+		return nil, err
+	}
+	list, err := IndicesPayloads.ObjectList.Marshal(objs)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 8, 8+len(versions)+len(list))
+	binary.LittleEndian.PutUint64(out, uint64(len(versions)))
+	out = append(out, versions...)
+	return append(out, list...), nil
+}
+
+func (p transactionalObjectListPayload) Unmarshal(in []byte) ([]*storobj.Object, []*int64, error) {
+	if len(in) < 8 {
+		return nil, nil, fmt.Errorf("transaction payload too short")
+	}
+	length := binary.LittleEndian.Uint64(in[:8])
+	if uint64(len(in)-8) < length {
+		return nil, nil, fmt.Errorf("transaction payload too short")
+	}
+
+	var expectedVersions []*int64
+	if err := json.Unmarshal(in[8:8+length], &expectedVersions); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal expected versions: %w", err)
+	}
+	objs, err := IndicesPayloads.ObjectList.Unmarshal(in[8+length:])
+	if err != nil {
+		return nil, nil, err
+	}
+	return objs, expectedVersions, nil
+}
+
 type versionedObjectListPayload struct{}
 
 func (p versionedObjectListPayload) MIME() string {
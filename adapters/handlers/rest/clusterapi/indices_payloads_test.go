@@ -94,3 +94,38 @@ func Test_objectListPayload_Marshal(t *testing.T) {
 	assert.EqualValues(t, objs[2].Object, received[1].Object)
 	assert.EqualValues(t, objs[2].ID(), received[1].ID())
 }
+
+func Test_transactionalObjectListPayload_Marshal(t *testing.T) {
+	id1 := strfmt.UUID("c6f85bf5-c3b7-4c1d-bd51-e899f9605336")
+	id2 := strfmt.UUID("88750a99-a72d-46c2-a582-89f02654391d")
+	version := int64(1234)
+
+	objs := []*storobj.Object{
+		{
+			MarshallerVersion: 1,
+			Object:            models.Object{ID: id1, Class: "SomeClass"},
+			Vector:            []float32{1, 2, 3},
+			VectorLen:         3,
+		},
+		{
+			MarshallerVersion: 1,
+			Object:            models.Object{ID: id2, Class: "SomeClass", LastUpdateTimeUnix: version},
+			Vector:            []float32{4, 5, 6},
+			VectorLen:         3,
+		},
+	}
+
+	payload := transactionalObjectListPayload{}
+	b, err := payload.Marshal(objs, []*int64{nil, &version})
+	require.Nil(t, err)
+
+	received, expectedVersions, err := payload.Unmarshal(b)
+	require.Nil(t, err)
+	require.Len(t, received, 2)
+	assert.Equal(t, id1, received[0].ID())
+	assert.Equal(t, id2, received[1].ID())
+	assert.Equal(t, []*int64{nil, &version}, expectedVersions)
+
+	_, _, err = payload.Unmarshal(b[:4])
+	assert.NotNil(t, err)
+}
@@ -37,10 +37,12 @@ type replicator interface {
 		requestID string, object *storobj.Object, schemaVersion uint64) replica.SimpleResponse
 	ReplicateObjects(ctx context.Context, indexName, shardName,
 		requestID string, objects []*storobj.Object, schemaVersion uint64) replica.SimpleResponse
+	ReplicateObjectsTransactional(ctx context.Context, indexName, shardName,
+		requestID string, objects []*storobj.Object, expectedVersions []*int64, schemaVersion uint64) replica.SimpleResponse
 	ReplicateUpdate(ctx context.Context, indexName, shardName,
 		requestID string, mergeDoc *objects.MergeDocument, schemaVersion uint64) replica.SimpleResponse
 	ReplicateDeletion(ctx context.Context, indexName, shardName,
-		requestID string, uuid strfmt.UUID, schemaVersion uint64) replica.SimpleResponse
+		requestID string, uuid strfmt.UUID, expectedVersion *int64, schemaVersion uint64) replica.SimpleResponse
 	ReplicateDeletions(ctx context.Context, indexName, shardName,
 		requestID string, uuids []strfmt.UUID, dryRun bool, schemaVersion uint64) replica.SimpleResponse
 	ReplicateReferences(ctx context.Context, indexName, shardName,
@@ -65,6 +67,10 @@ type replicator interface {
 type localScaler interface {
 	LocalScaleOut(ctx context.Context, className string,
 		dist scaler.ShardDist) error
+	LocalSyncShardReplica(ctx context.Context, className,
+		shardName, targetNode string) error
+	LocalTrackShardDeletions(ctx context.Context, className,
+		shardName string, track bool) error
 }
 
 type replicatedIndices struct {
@@ -90,6 +96,10 @@ var (
 		`\/shards\/(` + sh + `)\/objects/references`)
 	regxIncreaseRepFactor = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
 		`\/replication-factor:increase`)
+	regxSyncShardReplica = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `):sync`)
+	regxTrackShardDeletions = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `):track-deletions`)
 	regxCommitPhase = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `):(commit|abort)`)
 )
@@ -196,6 +206,24 @@ func (i *replicatedIndices) indicesHandler() http.HandlerFunc {
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 
+		case regxSyncShardReplica.MatchString(path):
+			if r.Method == http.MethodPost {
+				i.syncShardReplica().ServeHTTP(w, r)
+				return
+			}
+
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+
+		case regxTrackShardDeletions.MatchString(path):
+			if r.Method == http.MethodPost || r.Method == http.MethodDelete {
+				i.trackShardDeletions().ServeHTTP(w, r)
+				return
+			}
+
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+
 		case regxCommitPhase.MatchString(path):
 			if r.Method == http.MethodPost {
 				i.executeCommitPhase().ServeHTTP(w, r)
@@ -285,6 +313,50 @@ func (i *replicatedIndices) increaseReplicationFactor() http.Handler {
 	})
 }
 
+func (i *replicatedIndices) syncShardReplica() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxSyncShardReplica.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		targetNode := r.URL.Query().Get("target")
+		if targetNode == "" {
+			http.Error(w, "target node not provided", http.StatusBadRequest)
+			return
+		}
+
+		if err := i.scaler.LocalSyncShardReplica(r.Context(), index, shard, targetNode); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (i *replicatedIndices) trackShardDeletions() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxTrackShardDeletions.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+		track := r.Method == http.MethodPost
+		if err := i.scaler.LocalTrackShardDeletions(r.Context(), index, shard, track); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
 func (i *replicatedIndices) postObject() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := regxObjects.FindStringSubmatch(r.URL.Path)
@@ -318,6 +390,8 @@ func (i *replicatedIndices) postObject() http.Handler {
 			return
 		case IndicesPayloads.ObjectList.MIME():
 			i.postObjectBatch(w, r, index, shard, requestID, schemaVersion)
+		case IndicesPayloads.TransactionalObjectList.MIME():
+			i.postObjectTransaction(w, r, index, shard, requestID, schemaVersion)
 			return
 		default:
 			http.Error(w, "415 Unsupported Media Type", http.StatusUnsupportedMediaType)
@@ -449,7 +523,8 @@ func (i *replicatedIndices) getObjectsDigestsInTokenRange() http.Handler {
 
 		digests, lastTokenRead, err := i.shards.DigestObjectsInTokenRange(r.Context(),
 			index, shard, tokenRangeReq.InitialToken, tokenRangeReq.FinalToken, tokenRangeReq.Limit)
-		if err != nil {
+		// reaching the limit is expected, the caller continues after lastTokenRead
+		if err != nil && !errors.Is(err, storobj.ErrLimitReached) {
 			http.Error(w, "digest objects in range: "+err.Error(),
 				http.StatusInternalServerError)
 			return
@@ -579,7 +654,13 @@ func (i *replicatedIndices) deleteObject() http.Handler {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
-		resp := i.shards.ReplicateDeletion(r.Context(), index, shard, requestID, strfmt.UUID(id), schemaVersion)
+		expectedVersion, err := extractExpectedVersionFromUrlQuery(r.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp := i.shards.ReplicateDeletion(r.Context(), index, shard, requestID, strfmt.UUID(id),
+			expectedVersion, schemaVersion)
 		if localIndexNotReady(resp) {
 			http.Error(w, resp.FirstError().Error(), http.StatusServiceUnavailable)
 			return
@@ -708,6 +789,38 @@ func (i *replicatedIndices) postObjectBatch(w http.ResponseWriter, r *http.Reque
 	w.Write(b)
 }
 
+func (i *replicatedIndices) postObjectTransaction(w http.ResponseWriter, r *http.Request,
+	index, shard, requestID string, schemaVersion uint64,
+) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	objs, expectedVersions, err := IndicesPayloads.TransactionalObjectList.Unmarshal(bodyBytes)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp := i.shards.ReplicateObjectsTransactional(r.Context(), index, shard, requestID,
+		objs, expectedVersions, schemaVersion)
+	if localIndexNotReady(resp) {
+		http.Error(w, resp.FirstError().Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	b, err := json.Marshal(resp)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("unmarshal resp: %+v, error: %v", resp, err),
+			http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(b)
+}
+
 func (i *replicatedIndices) getObject() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := regxObject.FindStringSubmatch(r.URL.Path)
@@ -26,6 +26,8 @@ import (
 type nodesManager interface {
 	GetNodeStatus(ctx context.Context, className, output string) (*models.NodeStatus, error)
 	GetStatistics(ctx context.Context) (*models.Statistics, error)
+	RequestAsyncReplicationComparison(ctx context.Context, className string) error
+	SetDynamicIndexUpgradePolicy(ctx context.Context, className, tenant, targetVector, policy string) error
 }
 
 type nodes struct {
@@ -41,6 +43,8 @@ var (
 	regxNodes      = regexp.MustCompile(`/status`)
 	regxNodesClass = regexp.MustCompile(`/status/(` + entschema.ClassNameRegexCore + `)`)
 	regxStatistics = regexp.MustCompile(`/statistics`)
+	regxCompare    = regexp.MustCompile(`/replication/compare/(` + entschema.ClassNameRegexCore + `)`)
+	regxDynamic    = regexp.MustCompile(`/dynamic-index/(` + entschema.ClassNameRegexCore + `)`)
 )
 
 func (s *nodes) Nodes() http.Handler {
@@ -51,6 +55,15 @@ func (s *nodes) nodesHandler() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		path := r.URL.Path
 		switch {
+		case regxDynamic.MatchString(path):
+			if r.Method != http.MethodPut {
+				msg := fmt.Sprintf("/nodes api path %q not found", path)
+				http.Error(w, msg, http.StatusMethodNotAllowed)
+				return
+			}
+
+			s.incomingDynamicIndexUpgradePolicy().ServeHTTP(w, r)
+			return
 		case regxNodes.MatchString(path) || regxNodesClass.MatchString(path):
 			if r.Method != http.MethodGet {
 				msg := fmt.Sprintf("/nodes api path %q not found", path)
@@ -69,6 +82,15 @@ func (s *nodes) nodesHandler() http.HandlerFunc {
 
 			s.incomingStatistics().ServeHTTP(w, r)
 			return
+		case regxCompare.MatchString(path):
+			if r.Method != http.MethodPost {
+				msg := fmt.Sprintf("/nodes api path %q not found", path)
+				http.Error(w, msg, http.StatusMethodNotAllowed)
+				return
+			}
+
+			s.incomingAsyncReplicationComparison().ServeHTTP(w, r)
+			return
 		default:
 			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
 			return
@@ -140,3 +162,57 @@ func (s *nodes) incomingStatistics() http.Handler {
 		w.Write(statisticsBytes)
 	})
 }
+
+func (s *nodes) incomingAsyncReplicationComparison() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+
+		args := regxCompare.FindStringSubmatch(r.URL.Path)
+		if len(args) != 2 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+		className := args[1]
+
+		if err := s.nodesManager.RequestAsyncReplicationComparison(r.Context(), className); err != nil {
+			http.Error(w, "/nodes fulfill request: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+func (s *nodes) incomingDynamicIndexUpgradePolicy() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+
+		args := regxDynamic.FindStringSubmatch(r.URL.Path)
+		if len(args) != 2 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+		className := args[1]
+
+		var req models.DynamicVectorIndexPolicyRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "/nodes unmarshal request: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+		if req.UpgradePolicy == nil {
+			http.Error(w, "upgrade policy is required", http.StatusBadRequest)
+			return
+		}
+
+		if err := s.nodesManager.SetDynamicIndexUpgradePolicy(r.Context(), className,
+			req.Tenant, req.TargetVector, *req.UpgradePolicy); err != nil {
+			http.Error(w, "/nodes fulfill request: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
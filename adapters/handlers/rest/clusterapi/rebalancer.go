@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/weaviate/weaviate/usecases/rebalancer"
+)
+
+type localRebalancer interface {
+	LocalStatus() *rebalancer.Status
+}
+
+type rebalancerHandlers struct {
+	rebalancer localRebalancer
+	auth       auth
+}
+
+func NewRebalancer(r localRebalancer, auth auth) *rebalancerHandlers {
+	return &rebalancerHandlers{rebalancer: r, auth: auth}
+}
+
+func (h *rebalancerHandlers) Status() http.Handler {
+	return h.auth.handleFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		b, err := json.Marshal(h.rebalancer.LocalStatus())
+		if err != nil {
+			status := http.StatusInternalServerError
+			http.Error(w, fmt.Errorf("marshal response: %w", err).Error(), status)
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(b)
+	})
+}
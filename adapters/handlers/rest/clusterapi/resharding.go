@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/weaviate/weaviate/usecases/resharding"
+)
+
+type localResharding interface {
+	LocalStatus(className string) *resharding.NodeStatus
+}
+
+type reshardingHandlers struct {
+	manager localResharding
+	auth    auth
+}
+
+func NewResharding(manager localResharding, auth auth) *reshardingHandlers {
+	return &reshardingHandlers{manager: manager, auth: auth}
+}
+
+// Status returns the progress of the resharding of the class on the shards
+// of this node
+func (h *reshardingHandlers) Status() http.Handler {
+	return h.auth.handleFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		className := r.URL.Query().Get("class")
+		if className == "" {
+			http.Error(w, "class is required", http.StatusBadRequest)
+			return
+		}
+
+		b, err := json.Marshal(h.manager.LocalStatus(className))
+		if err != nil {
+			status := http.StatusInternalServerError
+			http.Error(w, fmt.Errorf("marshal response: %w", err).Error(), status)
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(b)
+	})
+}
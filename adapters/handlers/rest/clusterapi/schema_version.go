@@ -30,3 +30,17 @@ func extractSchemaVersionFromUrlQuery(values url.Values) (uint64, error) {
 	}
 	return 0, nil
 }
+
+// extractExpectedVersionFromUrlQuery returns the expected version of a
+// conditional write, nil if the write is unconditional
+func extractExpectedVersionFromUrlQuery(values url.Values) (*int64, error) {
+	v := values.Get(replica.ExpectedVersionKey)
+	if v == "" {
+		return nil, nil
+	}
+	expectedVersion, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q is an invalid value for %s", err, v, replica.ExpectedVersionKey)
+	}
+	return &expectedVersion, nil
+}
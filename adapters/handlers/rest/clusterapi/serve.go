@@ -32,6 +32,10 @@ func Serve(appState *state.State) {
 	classifications := NewClassifications(appState.ClassificationRepo.TxManager(), auth)
 	nodes := NewNodes(appState.RemoteNodeIncoming, auth)
 	backups := NewBackups(appState.BackupManager, auth)
+	rebalancer := NewRebalancer(appState.Rebalancer, auth)
+	vectorReindex := NewVectorReindex(appState.VectorReindex, auth)
+	resharding := NewResharding(appState.Resharding, auth)
+	tenantActivity := NewTenantActivity(appState.DB, auth)
 
 	mux := http.NewServeMux()
 	mux.Handle("/classifications/transactions/",
@@ -47,6 +51,11 @@ func Serve(appState *state.State) {
 	mux.Handle("/backups/abort", backups.Abort())
 	mux.Handle("/backups/status", backups.Status())
 
+	mux.Handle("/rebalancer/status", rebalancer.Status())
+	mux.Handle("/vector-reindex/status", vectorReindex.Status())
+	mux.Handle("/resharding/status", resharding.Status())
+	mux.Handle("/tenant-activity", tenantActivity.Activity())
+
 	mux.Handle("/", index())
 	http.ListenAndServe(fmt.Sprintf(":%d", port), mux)
 }
@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/weaviate/weaviate/entities/vectorreindex"
+)
+
+type localVectorReindex interface {
+	LocalStatus(className, targetVector string) *vectorreindex.NodeStatus
+}
+
+type vectorReindexHandlers struct {
+	manager localVectorReindex
+	auth    auth
+}
+
+func NewVectorReindex(manager localVectorReindex, auth auth) *vectorReindexHandlers {
+	return &vectorReindexHandlers{manager: manager, auth: auth}
+}
+
+// Status returns the progress of the job of the class and target vector on
+// the shards of this node
+func (h *vectorReindexHandlers) Status() http.Handler {
+	return h.auth.handleFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		className := r.URL.Query().Get("class")
+		if className == "" {
+			http.Error(w, "class is required", http.StatusBadRequest)
+			return
+		}
+		targetVector := r.URL.Query().Get("targetVector")
+
+		b, err := json.Marshal(h.manager.LocalStatus(className, targetVector))
+		if err != nil {
+			status := http.StatusInternalServerError
+			http.Error(w, fmt.Errorf("marshal response: %w", err).Error(), status)
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(b)
+	})
+}
@@ -42,6 +42,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	modulestorage "github.com/weaviate/weaviate/adapters/repos/modules"
 	schemarepo "github.com/weaviate/weaviate/adapters/repos/schema"
 	rCluster "github.com/weaviate/weaviate/cluster"
@@ -98,13 +99,18 @@ import (
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/rebalancer"
 	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/resharding"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"github.com/weaviate/weaviate/usecases/telemetry"
+	"github.com/weaviate/weaviate/usecases/tenantdeactivator"
 	"github.com/weaviate/weaviate/usecases/traverser"
+	"github.com/weaviate/weaviate/usecases/vectorrecall"
+	"github.com/weaviate/weaviate/usecases/vectorreindex"
 )
 
 const MinimumRequiredContextionaryVersion = "1.0.2"
@@ -121,7 +127,7 @@ type vectorRepo interface {
 	objects.BatchVectorRepo
 	traverser.VectorSearcher
 	classification.VectorRepo
-	scaler.BackUpper
+	scaler.Source
 	SetSchemaGetter(schemaUC.SchemaGetter)
 	WaitForStartup(ctx context.Context) error
 	Shutdown(ctx context.Context) error
@@ -182,29 +188,52 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		appState.Metrics = promMetrics
 	}
 
+	dataPathLock, err := db.LockDataPath(appState.ServerConfig.Config.Persistence.DataPath)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatal("could not lock data path")
+	}
+	appState.DataPathLock = dataPathLock
+
 	// TODO: configure http transport for efficient intra-cluster comm
 	remoteIndexClient := clients.NewRemoteIndex(appState.ClusterHttpClient)
 	remoteNodesClient := clients.NewRemoteNode(appState.ClusterHttpClient)
 	replicationClient := clients.NewReplicationClient(appState.ClusterHttpClient)
 	repo, err := db.New(appState.Logger, db.Config{
-		ServerVersion:             config.ServerVersion,
-		GitHash:                   config.GitHash,
-		MemtablesFlushDirtyAfter:  appState.ServerConfig.Config.Persistence.MemtablesFlushDirtyAfter,
-		MemtablesInitialSizeMB:    10,
-		MemtablesMaxSizeMB:        appState.ServerConfig.Config.Persistence.MemtablesMaxSizeMB,
-		MemtablesMinActiveSeconds: appState.ServerConfig.Config.Persistence.MemtablesMinActiveDurationSeconds,
-		MemtablesMaxActiveSeconds: appState.ServerConfig.Config.Persistence.MemtablesMaxActiveDurationSeconds,
-		MaxSegmentSize:            appState.ServerConfig.Config.Persistence.LSMMaxSegmentSize,
-		HNSWMaxLogSize:            appState.ServerConfig.Config.Persistence.HNSWMaxLogSize,
-		RootPath:                  appState.ServerConfig.Config.Persistence.DataPath,
-		QueryLimit:                appState.ServerConfig.Config.QueryDefaults.Limit,
-		QueryMaximumResults:       appState.ServerConfig.Config.QueryMaximumResults,
-		QueryNestedRefLimit:       appState.ServerConfig.Config.QueryNestedCrossReferenceLimit,
-		MaxImportGoroutinesFactor: appState.ServerConfig.Config.MaxImportGoroutinesFactor,
-		TrackVectorDimensions:     appState.ServerConfig.Config.TrackVectorDimensions,
-		ResourceUsage:             appState.ServerConfig.Config.ResourceUsage,
-		AvoidMMap:                 appState.ServerConfig.Config.AvoidMmap,
-		DisableLazyLoadShards:     appState.ServerConfig.Config.DisableLazyLoadShards,
+		ServerVersion:                config.ServerVersion,
+		GitHash:                      config.GitHash,
+		MemtablesFlushDirtyAfter:     appState.ServerConfig.Config.Persistence.MemtablesFlushDirtyAfter,
+		MemtablesInitialSizeMB:       10,
+		MemtablesMaxSizeMB:           appState.ServerConfig.Config.Persistence.MemtablesMaxSizeMB,
+		MemtablesMinActiveSeconds:    appState.ServerConfig.Config.Persistence.MemtablesMinActiveDurationSeconds,
+		MemtablesMaxActiveSeconds:    appState.ServerConfig.Config.Persistence.MemtablesMaxActiveDurationSeconds,
+		MaxSegmentSize:               appState.ServerConfig.Config.Persistence.LSMMaxSegmentSize,
+		HNSWMaxLogSize:               appState.ServerConfig.Config.Persistence.HNSWMaxLogSize,
+		HNSWSnapshotInterval:         time.Duration(appState.ServerConfig.Config.Persistence.HNSWSnapshotIntervalSeconds) * time.Second,
+		LSMScrubInterval:             time.Duration(appState.ServerConfig.Config.Persistence.LSMScrubIntervalSeconds) * time.Second,
+		LSMScrubRepair:               appState.ServerConfig.Config.Persistence.LSMScrubRepair,
+		LSMCompactionPolicy:          appState.ServerConfig.Config.Persistence.LSMCompactionPolicy,
+		LSMCompactionFanOut:          appState.ServerConfig.Config.Persistence.LSMCompactionFanOut,
+		RootPath:                     appState.ServerConfig.Config.Persistence.DataPath,
+		QueryLimit:                   appState.ServerConfig.Config.QueryDefaults.Limit,
+		QueryMaximumResults:          appState.ServerConfig.Config.QueryMaximumResults,
+		QueryNestedRefLimit:          appState.ServerConfig.Config.QueryNestedCrossReferenceLimit,
+		MaxImportGoroutinesFactor:    appState.ServerConfig.Config.MaxImportGoroutinesFactor,
+		TrackVectorDimensions:        appState.ServerConfig.Config.TrackVectorDimensions,
+		ResourceUsage:                appState.ServerConfig.Config.ResourceUsage,
+		AvoidMMap:                    appState.ServerConfig.Config.AvoidMmap,
+		DisableLazyLoadShards:        appState.ServerConfig.Config.DisableLazyLoadShards,
+		VectorCacheBudget:            int64(appState.ServerConfig.Config.VectorCacheBudget.SizeMB * 1024 * 1024),
+		VectorCacheBudgetIdleTimeout: appState.ServerConfig.Config.VectorCacheBudget.IdleTimeout,
+		LSMTierStore:                 lsmTierStore(appState),
+		LSMTierPolicy: lsmkv.TierPolicy{
+			MinLevel: uint16(appState.ServerConfig.Config.Persistence.LSMTierMinLevel),
+			MinAge:   time.Duration(appState.ServerConfig.Config.Persistence.LSMTierMinAgeSeconds) * time.Second,
+		},
+		LSMTierCacheSize: int64(appState.ServerConfig.Config.Persistence.LSMTierCacheSizeMB) * 1024 * 1024,
+		// the last use of tenants is needed to deactivate idle tenants
+		TrackTenantActivity: true,
 		// Pass dummy replication config with minimum factor 1. Otherwise the
 		// setting is not backward-compatible. The user may have created a class
 		// with factor=1 before the change was introduced. Now their setup would no
@@ -252,6 +281,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 
 	scaler := scaler.New(appState.Cluster, vectorRepo,
 		remoteIndexClient, appState.Logger, appState.ServerConfig.Config.Persistence.DataPath)
+	scaler.SetTransferRateLimit(int(appState.ServerConfig.Config.Rebalancer.MaxTransferMBPerSecond) * 1024 * 1024)
 	appState.Scaler = scaler
 
 	server2port, err := parseNode2Port(appState)
@@ -328,6 +358,30 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		schemaManager, repo, appState.Modules)
 	appState.BackupManager = backupManager
 
+	appState.Rebalancer = rebalancer.New(appState.ServerConfig.Config.Rebalancer,
+		appState.Authorizer, appState.ClusterService.Raft,
+		appState.ClusterService.SchemaReader(), appState.Cluster, schemaManager,
+		clients.NewClusterRebalancer(appState.ClusterHttpClient), appState.Logger)
+
+	appState.TenantDeactivator = tenantdeactivator.New(
+		appState.ServerConfig.Config.AutoTenantDeactivation,
+		appState.ClusterService.SchemaReader(), appState.ClusterService.Raft,
+		repo, appState.Cluster, clients.NewClusterTenantActivity(appState.ClusterHttpClient),
+		appState.Logger)
+
+	appState.VectorReindex = vectorreindex.New(appState.Authorizer,
+		appState.ClusterService.Raft, appState.ClusterService.SchemaReader(),
+		appState.Modules, repo, appState.Cluster,
+		clients.NewClusterVectorReindex(appState.ClusterHttpClient), appState.Logger)
+
+	appState.Resharding = resharding.New(appState.Authorizer,
+		appState.ClusterService.Raft, appState.ClusterService.SchemaReader(),
+		repo, appState.Cluster,
+		clients.NewClusterResharding(appState.ClusterHttpClient), appState.Logger)
+
+	appState.VectorRecall = vectorrecall.New(appState.Authorizer,
+		appState.ClusterService.SchemaReader(), repo)
+
 	enterrors.GoWrapper(func() { clusterapi.Serve(appState) }, appState.Logger)
 
 	vectorRepo.SetSchemaGetter(schemaManager)
@@ -427,6 +481,23 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	return appState
 }
 
+// lsmTierStore resolves the backup backend holding the remote tier of LSM
+// segments when it is first used, as modules are initialized after the
+// database
+func lsmTierStore(appState *state.State) func() (lsmkv.RemoteStore, error) {
+	name := appState.ServerConfig.Config.Persistence.LSMTierBackend
+	if name == "" {
+		return nil
+	}
+	return func() (lsmkv.RemoteStore, error) {
+		backend, err := appState.Modules.BackupBackend(name)
+		if err != nil {
+			return nil, err
+		}
+		return db.NewBackupTierStore(backend, appState.Cluster.LocalName())
+	}
+}
+
 func parseNode2Port(appState *state.State) (m map[string]int, err error) {
 	m = make(map[string]int, len(appState.ServerConfig.Config.Raft.Join))
 	for _, raftNamePort := range appState.ServerConfig.Config.Raft.Join {
@@ -499,6 +570,10 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Logger)
 	setupBackupHandlers(api, backupScheduler, appState.Metrics, appState.Logger)
 	setupNodesHandlers(api, appState.SchemaManager, appState.DB, appState)
+	setupRebalancerHandlers(api, appState.Rebalancer, appState.Metrics, appState.Logger)
+	setupVectorReindexHandlers(api, appState.VectorReindex, appState.Metrics, appState.Logger)
+	setupReshardingHandlers(api, appState.Resharding, appState.Metrics, appState.Logger)
+	setupVectorRecallHandlers(api, appState.VectorRecall, appState.Metrics, appState.Logger)
 
 	grpcServer := createGrpcServer(appState)
 	setupMiddlewares := makeSetupMiddlewares(appState)
@@ -515,6 +590,11 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		}, appState.Logger)
 	}
 
+	appState.Rebalancer.Start()
+	appState.TenantDeactivator.Start()
+	appState.VectorReindex.Start()
+	appState.Resharding.Start()
+
 	api.ServerShutdown = func() {
 		if telemetryEnabled(appState) {
 			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -531,6 +611,26 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		// stop reindexing on server shutdown
 		appState.ReindexCtxCancel()
 
+		// stop moving shards before leaving the cluster
+		rebalancerCtx, rebalancerCancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer rebalancerCancel()
+		if err := appState.Rebalancer.Stop(rebalancerCtx); err != nil {
+			appState.Logger.WithField("action", "stop_rebalancer").
+				Errorf("failed to stop rebalancer: %s", err.Error())
+		}
+		if err := appState.VectorReindex.Stop(rebalancerCtx); err != nil {
+			appState.Logger.WithField("action", "stop_vector_reindex").
+				Errorf("failed to stop vector reindexing: %s", err.Error())
+		}
+		if err := appState.Resharding.Stop(rebalancerCtx); err != nil {
+			appState.Logger.WithField("action", "stop_resharding").
+				Errorf("failed to stop resharding: %s", err.Error())
+		}
+		if err := appState.TenantDeactivator.Stop(rebalancerCtx); err != nil {
+			appState.Logger.WithField("action", "stop_tenant_deactivator").
+				Errorf("failed to stop tenant deactivator: %s", err.Error())
+		}
+
 		// gracefully stop gRPC server
 		grpcServer.GracefulStop()
 
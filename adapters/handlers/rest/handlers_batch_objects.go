@@ -41,8 +41,18 @@ func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	objs, err := h.manager.AddObjects(params.HTTPRequest.Context(), principal,
-		params.Body.Objects, params.Body.Fields, repl)
+	var objs objects.BatchObjects
+	versions := expectedVersions(params.Body.Objects, params.Body.ExpectedVersions)
+	if params.Body.Transactional != nil && *params.Body.Transactional {
+		objs, err = h.manager.AddObjectsTransactional(params.HTTPRequest.Context(), principal,
+			params.Body.Objects, versions, repl)
+	} else if versions != nil {
+		objs, err = h.manager.AddObjectsConditional(params.HTTPRequest.Context(), principal,
+			params.Body.Objects, versions, repl)
+	} else {
+		objs, err = h.manager.AddObjects(params.HTTPRequest.Context(), principal,
+			params.Body.Objects, params.Body.Fields, repl)
+	}
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
 		switch err.(type) {
@@ -66,6 +76,25 @@ func (h *batchObjectHandlers) addObjects(params batch.BatchObjectsCreateParams,
 		WithPayload(h.objectsResponse(objs))
 }
 
+// expectedVersions orders the expected versions, which are given by object
+// id, like the objects
+func expectedVersions(objs []*models.Object, byID map[string]int64) []*int64 {
+	if len(byID) == 0 {
+		return nil
+	}
+
+	out := make([]*int64, len(objs))
+	for i, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		if version, ok := byID[obj.ID.String()]; ok {
+			out[i] = &version
+		}
+	}
+	return out
+}
+
 func (h *batchObjectHandlers) objectsResponse(input objects.BatchObjects) []*models.ObjectsGetResponse {
 	response := make([]*models.ObjectsGetResponse, len(input))
 	for i, object := range input {
@@ -236,6 +265,91 @@ func (h *batchObjectHandlers) objectsDeleteResponse(input *objects.BatchDeleteRe
 	return response
 }
 
+func (h *batchObjectHandlers) updateObjects(params batch.BatchObjectsUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		return batch.NewBatchObjectsUpdateBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	tenant := getTenant(params.Tenant)
+
+	res, err := h.manager.UpdateObjectsByMatch(params.HTTPRequest.Context(), principal,
+		params.Body, repl, tenant)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		if errors.As(err, &objects.ErrInvalidUserInput{}) {
+			return batch.NewBatchObjectsUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &objects.ErrMultiTenancy{}) {
+			return batch.NewBatchObjectsUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &autherrs.Forbidden{}) {
+			return batch.NewBatchObjectsUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		} else {
+			return batch.NewBatchObjectsUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return batch.NewBatchObjectsUpdateOK().
+		WithPayload(h.objectsUpdateResponse(res))
+}
+
+func (h *batchObjectHandlers) objectsUpdateResponse(input *objects.BatchUpdateResponse) *models.BatchUpdateResponse {
+	var failed int64
+	output := input.Output
+	var objects []*models.BatchUpdateResponseResultsObjectsItems0
+	for _, obj := range input.Result.Objects {
+		var errorResponse *models.ErrorResponse
+
+		status := models.BatchUpdateResponseResultsObjectsItems0StatusSUCCESS
+		if input.DryRun {
+			status = models.BatchUpdateResponseResultsObjectsItems0StatusDRYRUN
+		} else if obj.Err != nil {
+			status = models.BatchUpdateResponseResultsObjectsItems0StatusFAILED
+			errorResponse = errPayloadFromSingleErr(obj.Err)
+			failed += 1
+		}
+
+		if output == verbosity.OutputMinimal &&
+			(status == models.BatchUpdateResponseResultsObjectsItems0StatusSUCCESS ||
+				status == models.BatchUpdateResponseResultsObjectsItems0StatusDRYRUN) {
+			// only add SUCCESS and DRYRUN results if output is "verbose"
+			continue
+		}
+
+		objects = append(objects, &models.BatchUpdateResponseResultsObjectsItems0{
+			ID:     obj.UUID,
+			Status: &status,
+			Errors: errorResponse,
+		})
+	}
+
+	response := &models.BatchUpdateResponse{
+		Match: &models.BatchUpdateResponseMatch{
+			Class: input.Match.Class,
+			Where: input.Match.Where,
+		},
+		DryRun: &input.DryRun,
+		Output: &output,
+		Results: &models.BatchUpdateResponseResults{
+			Matches: input.Result.Matches,
+			Limit:   input.Result.Limit,
+			Next:    input.Result.Next,
+			Updated: input.Result.Updated,
+			Failed:  failed,
+			Objects: objects,
+		},
+	}
+	return response
+}
+
 func setupObjectBatchHandlers(api *operations.WeaviateAPI, manager *objects.BatchManager, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) {
 	h := &batchObjectHandlers{manager, newBatchRequestsTotal(metrics, logger)}
 
@@ -245,6 +359,8 @@ func setupObjectBatchHandlers(api *operations.WeaviateAPI, manager *objects.Batc
 		BatchReferencesCreateHandlerFunc(h.addReferences)
 	api.BatchBatchObjectsDeleteHandler = batch.
 		BatchObjectsDeleteHandlerFunc(h.deleteObjects)
+	api.BatchBatchObjectsUpdateHandler = batch.
+		BatchObjectsUpdateHandlerFunc(h.updateObjects)
 }
 
 type batchRequestsTotal struct {
@@ -19,6 +19,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/nodes"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/replication"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/adapters/repos/db"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
@@ -99,6 +100,82 @@ func (n *nodesHandlers) getNodesStatistics(params cluster.ClusterGetStatisticsPa
 	return cluster.NewClusterGetStatisticsOK().WithPayload(statistics)
 }
 
+func (n *nodesHandlers) getReplicationStatus(params replication.ReplicationStatusParams, principal *models.Principal) middleware.Responder {
+	var className string
+	if params.Class != nil {
+		className = *params.Class
+	}
+
+	shards, err := n.manager.GetReplicationStatus(params.HTTPRequest.Context(), principal, className)
+	if err != nil {
+		n.metricRequestsTotal.logError(className, err)
+		switch {
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return replication.NewReplicationStatusNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return replication.NewReplicationStatusForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return replication.NewReplicationStatusInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	n.metricRequestsTotal.logOk(className)
+	return replication.NewReplicationStatusOK().
+		WithPayload(&models.ReplicationStatusResponse{Shards: shards})
+}
+
+func (n *nodesHandlers) requestReplicationComparison(params replication.ReplicationCompareParams, principal *models.Principal) middleware.Responder {
+	err := n.manager.RequestAsyncReplicationComparison(params.HTTPRequest.Context(), principal, params.ClassName)
+	if err != nil {
+		n.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return replication.NewReplicationCompareNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return replication.NewReplicationCompareForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return replication.NewReplicationCompareUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return replication.NewReplicationCompareInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	n.metricRequestsTotal.logOk(params.ClassName)
+	return replication.NewReplicationCompareAccepted()
+}
+
+func (n *nodesHandlers) setDynamicIndexUpgradePolicy(params nodes.NodesDynamicIndexUpdateParams, principal *models.Principal) middleware.Responder {
+	err := n.manager.SetDynamicIndexUpgradePolicy(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body.Tenant, params.Body.TargetVector, *params.Body.UpgradePolicy)
+	if err != nil {
+		n.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return nodes.NewNodesDynamicIndexUpdateNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return nodes.NewNodesDynamicIndexUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return nodes.NewNodesDynamicIndexUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesDynamicIndexUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	n.metricRequestsTotal.logOk(params.ClassName)
+	return nodes.NewNodesDynamicIndexUpdateAccepted()
+}
+
 func (n *nodesHandlers) handleGetNodesError(err error) middleware.Responder {
 	n.metricRequestsTotal.logError("", err)
 	if errors.As(err, &enterrors.ErrNotFound{}) {
@@ -130,6 +207,12 @@ func setupNodesHandlers(api *operations.WeaviateAPI,
 		NodesGetClassHandlerFunc(h.getNodesStatusByClass)
 	api.ClusterClusterGetStatisticsHandler = cluster.
 		ClusterGetStatisticsHandlerFunc(h.getNodesStatistics)
+	api.ReplicationReplicationStatusHandler = replication.
+		ReplicationStatusHandlerFunc(h.getReplicationStatus)
+	api.ReplicationReplicationCompareHandler = replication.
+		ReplicationCompareHandlerFunc(h.requestReplicationComparison)
+	api.NodesNodesDynamicIndexUpdateHandler = nodes.
+		NodesDynamicIndexUpdateHandlerFunc(h.setDynamicIndexUpgradePolicy)
 }
 
 type nodesRequestsTotal struct {
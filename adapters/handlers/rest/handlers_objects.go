@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	middleware "github.com/go-openapi/runtime/middleware"
@@ -54,9 +55,9 @@ type objectsManager interface {
 	GetObject(context.Context, *models.Principal, string, strfmt.UUID,
 		additional.Properties, *additional.ReplicationProperties, string) (*models.Object, error)
 	DeleteObject(context.Context, *models.Principal, string,
-		strfmt.UUID, *additional.ReplicationProperties, string) error
+		strfmt.UUID, *additional.ReplicationProperties, string, *int64) error
 	UpdateObject(context.Context, *models.Principal, string, strfmt.UUID,
-		*models.Object, *additional.ReplicationProperties) (*models.Object, error)
+		*models.Object, *additional.ReplicationProperties, *int64) (*models.Object, error)
 	HeadObject(ctx context.Context, principal *models.Principal, class string, id strfmt.UUID,
 		repl *additional.ReplicationProperties, tenant string) (bool, *uco.Error)
 	GetObjects(context.Context, *models.Principal, *int64, *int64,
@@ -64,7 +65,7 @@ type objectsManager interface {
 	Query(ctx context.Context, principal *models.Principal,
 		params *uco.QueryParams) ([]*models.Object, *uco.Error)
 	MergeObject(context.Context, *models.Principal, *models.Object,
-		*additional.ReplicationProperties) *uco.Error
+		*additional.ReplicationProperties, *int64) *uco.Error
 	AddObjectReference(context.Context, *models.Principal, *uco.AddReferenceInput,
 		*additional.ReplicationProperties, string) *uco.Error
 	UpdateObjectReferences(context.Context, *models.Principal,
@@ -209,7 +210,8 @@ func (h *objectHandlers) getObject(params objects.ObjectsClassGetParams,
 	}
 
 	h.metricRequestsTotal.logOk(getClassName(object))
-	return objects.NewObjectsClassGetOK().WithPayload(object)
+	return objects.NewObjectsClassGetOK().WithPayload(object).
+		WithETag(objectETag(object.LastUpdateTimeUnix))
 }
 
 func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
@@ -328,10 +330,17 @@ func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
+	expectedVersion, err := getExpectedVersion(params.IfMatch)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		return objects.NewObjectsClassDeleteBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
 	tenant := getTenant(params.Tenant)
 
 	err = h.manager.DeleteObject(params.HTTPRequest.Context(),
-		principal, params.ClassName, params.ID, repl, tenant)
+		principal, params.ClassName, params.ID, repl, tenant, expectedVersion)
 	if err != nil {
 		h.metricRequestsTotal.logError(params.ClassName, err)
 		switch err.(type) {
@@ -340,6 +349,12 @@ func (h *objectHandlers) deleteObject(params objects.ObjectsClassDeleteParams,
 				WithPayload(errPayloadFromSingleErr(err))
 		case uco.ErrNotFound:
 			return objects.NewObjectsClassDeleteNotFound()
+		case uco.ErrPreconditionFailed:
+			return objects.NewObjectsClassDeletePreconditionFailed().
+				WithPayload(errPayloadFromSingleErr(err))
+		case uco.ErrInvalidUserInput:
+			return objects.NewObjectsClassDeleteBadRequest().
+				WithPayload(errPayloadFromSingleErr(err))
 		case uco.ErrMultiTenancy:
 			return objects.NewObjectsClassDeleteUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
@@ -364,11 +379,21 @@ func (h *objectHandlers) updateObject(params objects.ObjectsClassPutParams,
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
+	expectedVersion, err := getExpectedVersion(params.IfMatch)
+	if err != nil {
+		h.metricRequestsTotal.logError(className, err)
+		return objects.NewObjectsCreateBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
 	object, err := h.manager.UpdateObject(params.HTTPRequest.Context(),
-		principal, params.ClassName, params.ID, params.Body, repl)
+		principal, params.ClassName, params.ID, params.Body, repl, expectedVersion)
 	if err != nil {
 		h.metricRequestsTotal.logError(className, err)
-		if errors.As(err, &uco.ErrInvalidUserInput{}) {
+		if errors.As(err, &uco.ErrPreconditionFailed{}) {
+			return objects.NewObjectsClassPutPreconditionFailed().
+				WithPayload(errPayloadFromSingleErr(err))
+		} else if errors.As(err, &uco.ErrInvalidUserInput{}) {
 			return objects.NewObjectsClassPutUnprocessableEntity().
 				WithPayload(errPayloadFromSingleErr(err))
 		} else if errors.As(err, &uco.ErrMultiTenancy{}) {
@@ -440,12 +465,22 @@ func (h *objectHandlers) patchObject(params objects.ObjectsClassPatchParams, pri
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	objErr := h.manager.MergeObject(params.HTTPRequest.Context(), principal, updates, repl)
+	expectedVersion, err := getExpectedVersion(params.IfMatch)
+	if err != nil {
+		h.metricRequestsTotal.logError(getClassName(updates), err)
+		return objects.NewObjectsClassPatchBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	objErr := h.manager.MergeObject(params.HTTPRequest.Context(), principal, updates, repl, expectedVersion)
 	if objErr != nil {
 		h.metricRequestsTotal.logError(getClassName(updates), objErr)
 		switch {
 		case objErr.NotFound():
 			return objects.NewObjectsClassPatchNotFound()
+		case objErr.PreconditionFailed():
+			return objects.NewObjectsClassPatchPreconditionFailed().
+				WithPayload(errPayloadFromSingleErr(objErr))
 		case objErr.Forbidden():
 			return objects.NewObjectsClassPatchForbidden().
 				WithPayload(errPayloadFromSingleErr(objErr))
@@ -904,6 +939,26 @@ func getConsistencyLevel(lvl *string) (string, error) {
 	return "", nil
 }
 
+// getExpectedVersion parses the version of an If-Match header. Both plain
+// versions and (weak) ETags as returned by objectETag are accepted.
+func getExpectedVersion(ifMatch *string) (*int64, error) {
+	if ifMatch == nil {
+		return nil, nil
+	}
+	tag := strings.TrimPrefix(strings.TrimSpace(*ifMatch), "W/")
+	tag = strings.Trim(tag, `"`)
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil || version < 0 {
+		return nil, fmt.Errorf("invalid If-Match header %q, expected an object version", *ifMatch)
+	}
+	return &version, nil
+}
+
+// objectETag returns the ETag of an object, which is its version
+func objectETag(lastUpdateTimeUnix int64) string {
+	return fmt.Sprintf("%q", strconv.FormatInt(lastUpdateTimeUnix, 10))
+}
+
 func getTenant(maybeKey *string) string {
 	if maybeKey != nil {
 		return *maybeKey
@@ -1039,6 +1039,35 @@ func TestEnrichObjectsWithLinks(t *testing.T) {
 	})
 }
 
+func TestGetExpectedVersion(t *testing.T) {
+	str := func(s string) *string { return &s }
+	for _, tc := range []struct {
+		ifMatch  *string
+		expected *int64
+		err      bool
+	}{
+		{ifMatch: nil},
+		{ifMatch: str("123"), expected: int64Ptr(123)},
+		{ifMatch: str(`"123"`), expected: int64Ptr(123)},
+		{ifMatch: str(`W/"123"`), expected: int64Ptr(123)},
+		{ifMatch: str(objectETag(0)), expected: int64Ptr(0)},
+		{ifMatch: str("*"), err: true},
+		{ifMatch: str("-1"), err: true},
+	} {
+		version, err := getExpectedVersion(tc.ifMatch)
+		if tc.err {
+			assert.Error(t, err, *tc.ifMatch)
+			continue
+		}
+		require.Nil(t, err)
+		assert.Equal(t, tc.expected, version)
+	}
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
 type fakeManager struct {
 	getObjectReturn *models.Object
 	getObjectErr    error
@@ -1112,19 +1141,19 @@ func (f *fakeManager) Query(_ context.Context,
 }
 
 func (f *fakeManager) UpdateObject(_ context.Context, _ *models.Principal, _ string,
-	_ strfmt.UUID, updates *models.Object, _ *additional.ReplicationProperties,
+	_ strfmt.UUID, updates *models.Object, _ *additional.ReplicationProperties, _ *int64,
 ) (*models.Object, error) {
 	return updates, f.updateObjectErr
 }
 
 func (f *fakeManager) MergeObject(_ context.Context, _ *models.Principal,
-	_ *models.Object, _ *additional.ReplicationProperties,
+	_ *models.Object, _ *additional.ReplicationProperties, _ *int64,
 ) *uco.Error {
 	return f.patchObjectReturn
 }
 
 func (f *fakeManager) DeleteObject(_ context.Context, _ *models.Principal,
-	class string, _ strfmt.UUID, _ *additional.ReplicationProperties, _ string,
+	class string, _ strfmt.UUID, _ *additional.ReplicationProperties, _ string, _ *int64,
 ) error {
 	return f.deleteObjectReturn
 }
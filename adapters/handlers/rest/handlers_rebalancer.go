@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/replication"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/rebalancer"
+)
+
+type rebalancerHandlers struct {
+	rebalancer          *rebalancer.Rebalancer
+	metricRequestsTotal restApiRequestsTotal
+}
+
+func (h *rebalancerHandlers) getStatus(params replication.ReplicationRebalancerGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	status, err := h.rebalancer.Status(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return replication.NewReplicationRebalancerGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return replication.NewReplicationRebalancerGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return replication.NewReplicationRebalancerGetOK().WithPayload(rebalancerStatusPayload(status))
+}
+
+func (h *rebalancerHandlers) update(params replication.ReplicationRebalancerUpdateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	status, err := h.rebalancer.SetPaused(params.HTTPRequest.Context(), principal,
+		*params.Body.Paused)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return replication.NewReplicationRebalancerUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return replication.NewReplicationRebalancerUpdateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return replication.NewReplicationRebalancerUpdateOK().WithPayload(rebalancerStatusPayload(status))
+}
+
+func rebalancerStatusPayload(status *rebalancer.Status) *models.RebalancerStatus {
+	payload := &models.RebalancerStatus{
+		Enabled:         status.Enabled,
+		Paused:          status.Paused,
+		Leader:          status.Leader,
+		LastRunTimeUnix: unixMilli(status.LastRun),
+		Nodes:           make([]*models.RebalancerNodeLoad, len(status.Nodes)),
+		Moves:           make([]*models.RebalancerMove, len(status.Moves)),
+	}
+	for i, n := range status.Nodes {
+		payload.Nodes[i] = &models.RebalancerNodeLoad{
+			Name:            n.Name,
+			DiskUsedPercent: n.DiskUsedPercent,
+			Shards:          int64(n.Shards),
+		}
+	}
+	for i, m := range status.Moves {
+		payload.Moves[i] = &models.RebalancerMove{
+			Class:          m.Class,
+			Shard:          m.Shard,
+			SourceNode:     m.SourceNode,
+			TargetNode:     m.TargetNode,
+			Status:         m.Status,
+			Error:          m.Error,
+			StartTimeUnix:  unixMilli(m.StartTime),
+			FinishTimeUnix: unixMilli(m.FinishTime),
+		}
+	}
+	return payload
+}
+
+// unixMilli returns 0 for the zero time instead of a negative value
+func unixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+func setupRebalancerHandlers(api *operations.WeaviateAPI,
+	rebalancer *rebalancer.Rebalancer, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+) {
+	h := &rebalancerHandlers{rebalancer, newRebalancerRequestsTotal(metrics, logger)}
+	api.ReplicationReplicationRebalancerGetHandler = replication.
+		ReplicationRebalancerGetHandlerFunc(h.getStatus)
+	api.ReplicationReplicationRebalancerUpdateHandler = replication.
+		ReplicationRebalancerUpdateHandlerFunc(h.update)
+}
+
+type rebalancerRequestsTotal struct {
+	*restApiRequestsTotalImpl
+}
+
+func newRebalancerRequestsTotal(metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) restApiRequestsTotal {
+	return &rebalancerRequestsTotal{
+		restApiRequestsTotalImpl: &restApiRequestsTotalImpl{newRequestsTotalMetric(metrics, "rest"), "rest", "rebalancer", logger},
+	}
+}
+
+func (e *rebalancerRequestsTotal) logError(className string, err error) {
+	switch err.(type) {
+	case errors.Forbidden:
+		e.logUserError(className)
+	default:
+		e.logServerError(className, err)
+	}
+}
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/replication"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/resharding"
+)
+
+type reshardingHandlers struct {
+	manager             *resharding.Manager
+	metricRequestsTotal restApiRequestsTotal
+}
+
+func (h *reshardingHandlers) getStatus(params replication.ReplicationReshardStatusParams,
+	principal *models.Principal,
+) middleware.Responder {
+	status, err := h.manager.Status(params.HTTPRequest.Context(), principal, params.ClassName)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return replication.NewReplicationReshardStatusForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrNotFound:
+			return replication.NewReplicationReshardStatusNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return replication.NewReplicationReshardStatusInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return replication.NewReplicationReshardStatusOK().WithPayload(reshardingStatusPayload(status))
+}
+
+func reshardingStatusPayload(status *resharding.Status) *models.ReshardingStatus {
+	r := status.Resharding
+	payload := &models.ReshardingStatus{
+		Class:          status.Class,
+		ShardCount:     int64(len(r.Physical)),
+		Status:         r.Status,
+		Error:          r.Error,
+		StartTimeUnix:  unixMilli(r.StartTime),
+		FinishTimeUnix: unixMilli(r.FinishTime),
+	}
+	for _, node := range status.Nodes {
+		nodePayload := &models.ReshardingNodeStatus{
+			Node:   node.Node,
+			Error:  node.Error,
+			Shards: make([]*models.ReshardingShardStatus, len(node.Shards)),
+		}
+		for i, shard := range node.Shards {
+			nodePayload.Shards[i] = &models.ReshardingShardStatus{
+				Shard:  shard.Shard,
+				Status: shard.Status,
+				Error:  shard.Error,
+			}
+		}
+		payload.Nodes = append(payload.Nodes, nodePayload)
+	}
+	return payload
+}
+
+func setupReshardingHandlers(api *operations.WeaviateAPI,
+	manager *resharding.Manager, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+) {
+	h := &reshardingHandlers{manager, newReshardingRequestsTotal(metrics, logger)}
+	api.ReplicationReplicationReshardStatusHandler = replication.
+		ReplicationReshardStatusHandlerFunc(h.getStatus)
+}
+
+type reshardingRequestsTotal struct {
+	*restApiRequestsTotalImpl
+}
+
+func newReshardingRequestsTotal(metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) restApiRequestsTotal {
+	return &reshardingRequestsTotal{
+		restApiRequestsTotalImpl: &restApiRequestsTotalImpl{newRequestsTotalMetric(metrics, "rest"), "rest", "resharding", logger},
+	}
+}
+
+func (e *reshardingRequestsTotal) logError(className string, err error) {
+	switch err.(type) {
+	case errors.Forbidden, enterrors.ErrNotFound:
+		e.logUserError(className)
+	default:
+		e.logServerError(className, err)
+	}
+}
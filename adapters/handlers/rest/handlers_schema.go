@@ -15,11 +15,14 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/replication"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	uco "github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/resharding"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 )
 
@@ -206,6 +209,107 @@ func (s *schemaHandlers) updateShardStatus(params schema.SchemaObjectsShardsUpda
 	return schema.NewSchemaObjectsShardsUpdateOK().WithPayload(payload)
 }
 
+func (s *schemaHandlers) transferShard(params replication.ReplicationTransferParams,
+	principal *models.Principal,
+) middleware.Responder {
+	body := params.Body
+	move := body.TransferType == nil || *body.TransferType == models.ShardTransferRequestTransferTypeMOVE
+	transfer, err := s.manager.TransferShard(params.HTTPRequest.Context(), principal,
+		*body.Class, *body.Shard, *body.SourceNode, *body.TargetNode, move)
+	if err != nil {
+		s.metricRequestsTotal.logError(*body.Class, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return replication.NewReplicationTransferForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrNotFound:
+			return replication.NewReplicationTransferNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrUnprocessable:
+			return replication.NewReplicationTransferUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return replication.NewReplicationTransferInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(*body.Class)
+	return replication.NewReplicationTransferAccepted().WithPayload(shardTransferPayload(*transfer))
+}
+
+func (s *schemaHandlers) listShardTransfers(params replication.ReplicationTransferListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	transfers, err := s.manager.ShardTransfers(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return replication.NewReplicationTransferListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return replication.NewReplicationTransferListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := make([]*models.ShardTransferStatus, len(transfers))
+	for i, t := range transfers {
+		payload[i] = shardTransferPayload(t)
+	}
+	s.metricRequestsTotal.logOk("")
+	return replication.NewReplicationTransferListOK().WithPayload(payload)
+}
+
+func shardTransferPayload(t schemaUC.ShardTransfer) *models.ShardTransferStatus {
+	transferType := models.ShardTransferStatusTransferTypeCOPY
+	if t.Move {
+		transferType = models.ShardTransferStatusTransferTypeMOVE
+	}
+	return &models.ShardTransferStatus{
+		ID:             t.ID,
+		Class:          t.Class,
+		Shard:          t.Shard,
+		SourceNode:     t.SourceNode,
+		TargetNode:     t.TargetNode,
+		TransferType:   transferType,
+		Status:         t.Status,
+		Error:          t.Error,
+		StartTimeUnix:  unixMilli(t.StartTime),
+		FinishTimeUnix: unixMilli(t.FinishTime),
+	}
+}
+
+func (s *schemaHandlers) reshardClass(params replication.ReplicationReshardParams,
+	principal *models.Principal,
+) middleware.Responder {
+	body := params.Body
+	started, err := s.manager.ReshardClass(params.HTTPRequest.Context(), principal,
+		*body.Class, int(*body.ShardCount))
+	if err != nil {
+		s.metricRequestsTotal.logError(*body.Class, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return replication.NewReplicationReshardForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrNotFound:
+			return replication.NewReplicationReshardNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrUnprocessable:
+			return replication.NewReplicationReshardUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return replication.NewReplicationReshardInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk(*body.Class)
+	return replication.NewReplicationReshardAccepted().WithPayload(
+		reshardingStatusPayload(&resharding.Status{Class: *body.Class, Resharding: *started}))
+}
+
 func (s *schemaHandlers) createTenants(params schema.TenantsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
@@ -338,6 +442,13 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 	api.SchemaTenantsDeleteHandler = schema.TenantsDeleteHandlerFunc(h.deleteTenants)
 	api.SchemaTenantsGetHandler = schema.TenantsGetHandlerFunc(h.getTenants)
 	api.SchemaTenantExistsHandler = schema.TenantExistsHandlerFunc(h.tenantExists)
+
+	api.ReplicationReplicationTransferHandler = replication.
+		ReplicationTransferHandlerFunc(h.transferShard)
+	api.ReplicationReplicationTransferListHandler = replication.
+		ReplicationTransferListHandlerFunc(h.listShardTransfers)
+	api.ReplicationReplicationReshardHandler = replication.
+		ReplicationReshardHandlerFunc(h.reshardClass)
 }
 
 type schemaRequestsTotal struct {
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/vectorrecall"
+)
+
+type vectorRecallHandlers struct {
+	auditor             *vectorrecall.Auditor
+	metricRequestsTotal restApiRequestsTotal
+}
+
+func (h *vectorRecallHandlers) measure(params schema.SchemaObjectsVectorRecallParams,
+	principal *models.Principal,
+) middleware.Responder {
+	body := params.Body
+	report, err := h.auditor.Measure(params.HTTPRequest.Context(), principal, vectorrecall.Request{
+		ClassName:    params.ClassName,
+		TargetVector: body.TargetVector,
+		Tenant:       body.Tenant,
+		K:            int(body.K),
+		Samples:      int(body.Samples),
+	})
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsVectorRecallForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrNotFound:
+			return schema.NewSchemaObjectsVectorRecallNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrUnprocessable:
+			return schema.NewSchemaObjectsVectorRecallUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorRecallInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorRecallOK().WithPayload(&models.VectorRecallReport{
+		ClassName:    report.ClassName,
+		TargetVector: report.TargetVector,
+		Tenant:       report.Tenant,
+		K:            int64(report.K),
+		Samples:      int64(report.Samples),
+		Recall:       &report.Recall,
+		MinRecall:    &report.MinRecall,
+		IndexTookMs:  float64(report.IndexTook) / float64(time.Millisecond),
+		ExactTookMs:  float64(report.ExactTook) / float64(time.Millisecond),
+	})
+}
+
+func setupVectorRecallHandlers(api *operations.WeaviateAPI,
+	auditor *vectorrecall.Auditor, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+) {
+	h := &vectorRecallHandlers{auditor, newVectorRecallRequestsTotal(metrics, logger)}
+	api.SchemaSchemaObjectsVectorRecallHandler = schema.
+		SchemaObjectsVectorRecallHandlerFunc(h.measure)
+}
+
+type vectorRecallRequestsTotal struct {
+	*restApiRequestsTotalImpl
+}
+
+func newVectorRecallRequestsTotal(metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) restApiRequestsTotal {
+	return &vectorRecallRequestsTotal{
+		restApiRequestsTotalImpl: &restApiRequestsTotalImpl{newRequestsTotalMetric(metrics, "rest"), "rest", "vector_recall", logger},
+	}
+}
+
+func (e *vectorRecallRequestsTotal) logError(className string, err error) {
+	switch err.(type) {
+	case errors.Forbidden, enterrors.ErrNotFound, enterrors.ErrUnprocessable:
+		e.logUserError(className)
+	default:
+		e.logServerError(className, err)
+	}
+}
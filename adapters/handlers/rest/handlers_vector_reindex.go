@@ -0,0 +1,264 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	entreindex "github.com/weaviate/weaviate/entities/vectorreindex"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/vectorreindex"
+)
+
+type vectorReindexHandlers struct {
+	manager             *vectorreindex.Manager
+	metricRequestsTotal restApiRequestsTotal
+}
+
+func (h *vectorReindexHandlers) start(params schema.SchemaObjectsVectorReindexStartParams,
+	principal *models.Principal,
+) middleware.Responder {
+	body := params.Body
+	job := entreindex.Job{
+		TargetVector:        body.TargetVector,
+		Vectorizer:          *body.Vectorizer,
+		MaxObjectsPerSecond: body.MaxObjectsPerSecond,
+	}
+	if body.BatchSize != nil {
+		job.BatchSize = int(*body.BatchSize)
+	}
+	if body.ModuleConfig != nil {
+		moduleConfig, ok := body.ModuleConfig.(map[string]interface{})
+		if !ok {
+			err := fmt.Errorf("module config must be an object, got %T", body.ModuleConfig)
+			h.metricRequestsTotal.logUserError(params.ClassName)
+			return schema.NewSchemaObjectsVectorReindexStartUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		job.ModuleConfig = moduleConfig
+	}
+
+	status, err := h.manager.StartJob(params.HTTPRequest.Context(), principal, params.ClassName, job)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsVectorReindexStartForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrNotFound:
+			return schema.NewSchemaObjectsVectorReindexStartNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrUnprocessable:
+			return schema.NewSchemaObjectsVectorReindexStartUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorReindexStartInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorReindexStartOK().WithPayload(vectorReindexStatusPayload(status))
+}
+
+func (h *vectorReindexHandlers) getStatus(params schema.SchemaObjectsVectorReindexGetParams,
+	principal *models.Principal,
+) middleware.Responder {
+	status, err := h.manager.Status(params.HTTPRequest.Context(), principal,
+		params.ClassName, stringOrEmpty(params.TargetVector))
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsVectorReindexGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrNotFound:
+			return schema.NewSchemaObjectsVectorReindexGetNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorReindexGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorReindexGetOK().WithPayload(vectorReindexStatusPayload(status))
+}
+
+func (h *vectorReindexHandlers) cancel(params schema.SchemaObjectsVectorReindexCancelParams,
+	principal *models.Principal,
+) middleware.Responder {
+	status, err := h.manager.Cancel(params.HTTPRequest.Context(), principal,
+		params.ClassName, stringOrEmpty(params.TargetVector))
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsVectorReindexCancelForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrNotFound:
+			return schema.NewSchemaObjectsVectorReindexCancelNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrUnprocessable:
+			return schema.NewSchemaObjectsVectorReindexCancelUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorReindexCancelInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorReindexCancelOK().WithPayload(vectorReindexStatusPayload(status))
+}
+
+func (h *vectorReindexHandlers) pause(params schema.SchemaObjectsVectorReindexPauseParams,
+	principal *models.Principal,
+) middleware.Responder {
+	status, err := h.manager.Pause(params.HTTPRequest.Context(), principal,
+		params.ClassName, stringOrEmpty(params.TargetVector))
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsVectorReindexPauseForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrNotFound:
+			return schema.NewSchemaObjectsVectorReindexPauseNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrUnprocessable:
+			return schema.NewSchemaObjectsVectorReindexPauseUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorReindexPauseInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorReindexPauseOK().WithPayload(vectorReindexStatusPayload(status))
+}
+
+func (h *vectorReindexHandlers) resume(params schema.SchemaObjectsVectorReindexResumeParams,
+	principal *models.Principal,
+) middleware.Responder {
+	status, err := h.manager.Resume(params.HTTPRequest.Context(), principal,
+		params.ClassName, stringOrEmpty(params.TargetVector))
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsVectorReindexResumeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrNotFound:
+			return schema.NewSchemaObjectsVectorReindexResumeNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case enterrors.ErrUnprocessable:
+			return schema.NewSchemaObjectsVectorReindexResumeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorReindexResumeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return schema.NewSchemaObjectsVectorReindexResumeOK().WithPayload(vectorReindexStatusPayload(status))
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func vectorReindexStatusPayload(status *vectorreindex.Status) *models.VectorReindexStatus {
+	job := status.Job
+	payload := &models.VectorReindexStatus{
+		ClassName:           status.Class,
+		TargetVector:        job.TargetVector,
+		Vectorizer:          job.Vectorizer,
+		ModuleConfig:        job.ModuleConfig,
+		BatchSize:           int64(job.BatchSize),
+		MaxObjectsPerSecond: job.MaxObjectsPerSecond,
+		Backfill:            job.Backfill,
+		DeferredTenants:     job.Deferred,
+		Status:              job.Status,
+		Error:               job.Error,
+		StartTimeUnix:       unixMilli(job.StartTime),
+		FinishTimeUnix:      unixMilli(job.FinishTime),
+		Nodes:               make([]*models.VectorReindexNodeStatus, len(status.Nodes)),
+	}
+	for i, node := range status.Nodes {
+		nodePayload := &models.VectorReindexNodeStatus{
+			Name:   node.Node,
+			Error:  node.Error,
+			Shards: make([]*models.VectorReindexShardStatus, len(node.Shards)),
+		}
+		for j, shard := range node.Shards {
+			nodePayload.Shards[j] = &models.VectorReindexShardStatus{
+				Name:             shard.Shard,
+				Status:           shard.Status,
+				ObjectsProcessed: shard.ObjectsProcessed,
+				ObjectsTotal:     shard.ObjectsTotal,
+				Error:            shard.Error,
+			}
+			payload.ObjectsProcessed += shard.ObjectsProcessed
+			payload.ObjectsTotal += shard.ObjectsTotal
+		}
+		payload.Nodes[i] = nodePayload
+	}
+	return payload
+}
+
+func setupVectorReindexHandlers(api *operations.WeaviateAPI,
+	manager *vectorreindex.Manager, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+) {
+	h := &vectorReindexHandlers{manager, newVectorReindexRequestsTotal(metrics, logger)}
+	api.SchemaSchemaObjectsVectorReindexStartHandler = schema.
+		SchemaObjectsVectorReindexStartHandlerFunc(h.start)
+	api.SchemaSchemaObjectsVectorReindexGetHandler = schema.
+		SchemaObjectsVectorReindexGetHandlerFunc(h.getStatus)
+	api.SchemaSchemaObjectsVectorReindexCancelHandler = schema.
+		SchemaObjectsVectorReindexCancelHandlerFunc(h.cancel)
+	api.SchemaSchemaObjectsVectorReindexPauseHandler = schema.
+		SchemaObjectsVectorReindexPauseHandlerFunc(h.pause)
+	api.SchemaSchemaObjectsVectorReindexResumeHandler = schema.
+		SchemaObjectsVectorReindexResumeHandlerFunc(h.resume)
+}
+
+type vectorReindexRequestsTotal struct {
+	*restApiRequestsTotalImpl
+}
+
+func newVectorReindexRequestsTotal(metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) restApiRequestsTotal {
+	return &vectorReindexRequestsTotal{
+		restApiRequestsTotalImpl: &restApiRequestsTotalImpl{newRequestsTotalMetric(metrics, "rest"), "rest", "vector_reindex", logger},
+	}
+}
+
+func (e *vectorReindexRequestsTotal) logError(className string, err error) {
+	switch err.(type) {
+	case errors.Forbidden, enterrors.ErrNotFound, enterrors.ErrUnprocessable:
+		e.logUserError(className)
+	default:
+		e.logServerError(className, err)
+	}
+}
@@ -95,11 +95,17 @@ func (o *BatchObjectsCreate) ServeHTTP(rw http.ResponseWriter, r *http.Request)
 // swagger:model BatchObjectsCreateBody
 type BatchObjectsCreateBody struct {
 
+	// Optimistic version check. Maps object ids to the lastUpdateTimeUnix the stored object must have, 0 expects the object not to exist. Objects with a version are written one by one unless the batch is transactional.
+	ExpectedVersions map[string]int64 `json:"expectedVersions,omitempty" yaml:"expectedVersions,omitempty"`
+
 	// Define which fields need to be returned. Default value is ALL
 	Fields []*string `json:"fields" yaml:"fields"`
 
 	// objects
 	Objects []*models.Object `json:"objects" yaml:"objects"`
+
+	// Apply all objects or none of them. All objects must belong to the same class and shard or tenant. Readers never observe a partially applied transaction.
+	Transactional *bool `json:"transactional,omitempty" yaml:"transactional,omitempty"`
 }
 
 // Validate validates this batch objects create body
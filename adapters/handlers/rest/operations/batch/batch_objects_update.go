@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batch
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BatchObjectsUpdateHandlerFunc turns a function with the right signature into a batch objects update handler
+type BatchObjectsUpdateHandlerFunc func(BatchObjectsUpdateParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn BatchObjectsUpdateHandlerFunc) Handle(params BatchObjectsUpdateParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// BatchObjectsUpdateHandler interface for that can handle valid batch objects update params
+type BatchObjectsUpdateHandler interface {
+	Handle(BatchObjectsUpdateParams, *models.Principal) middleware.Responder
+}
+
+// NewBatchObjectsUpdate creates a new http.Handler for the batch objects update operation
+func NewBatchObjectsUpdate(ctx *middleware.Context, handler BatchObjectsUpdateHandler) *BatchObjectsUpdate {
+	return &BatchObjectsUpdate{Context: ctx, Handler: handler}
+}
+
+/*
+	BatchObjectsUpdate swagger:route PATCH /batch/objects batch objects batchObjectsUpdate
+
+Updates Objects based on a match filter as a batch.
+
+Partially update Objects in bulk that match a certain filter. Properties which are not mentioned keep their values.
+*/
+type BatchObjectsUpdate struct {
+	Context *middleware.Context
+	Handler BatchObjectsUpdateHandler
+}
+
+func (o *BatchObjectsUpdate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewBatchObjectsUpdateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batch
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BatchObjectsUpdateOKCode is the HTTP code returned for type BatchObjectsUpdateOK
+const BatchObjectsUpdateOKCode int = 200
+
+/*
+BatchObjectsUpdateOK Request succeeded, see response body to get detailed information about each batched item.
+
+swagger:response batchObjectsUpdateOK
+*/
+type BatchObjectsUpdateOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BatchUpdateResponse `json:"body,omitempty"`
+}
+
+// NewBatchObjectsUpdateOK creates BatchObjectsUpdateOK with default headers values
+func NewBatchObjectsUpdateOK() *BatchObjectsUpdateOK {
+
+	return &BatchObjectsUpdateOK{}
+}
+
+// WithPayload adds the payload to the batch objects update o k response
+func (o *BatchObjectsUpdateOK) WithPayload(payload *models.BatchUpdateResponse) *BatchObjectsUpdateOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batch objects update o k response
+func (o *BatchObjectsUpdateOK) SetPayload(payload *models.BatchUpdateResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchObjectsUpdateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BatchObjectsUpdateBadRequestCode is the HTTP code returned for type BatchObjectsUpdateBadRequest
+const BatchObjectsUpdateBadRequestCode int = 400
+
+/*
+BatchObjectsUpdateBadRequest Malformed request.
+
+swagger:response batchObjectsUpdateBadRequest
+*/
+type BatchObjectsUpdateBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchObjectsUpdateBadRequest creates BatchObjectsUpdateBadRequest with default headers values
+func NewBatchObjectsUpdateBadRequest() *BatchObjectsUpdateBadRequest {
+
+	return &BatchObjectsUpdateBadRequest{}
+}
+
+// WithPayload adds the payload to the batch objects update bad request response
+func (o *BatchObjectsUpdateBadRequest) WithPayload(payload *models.ErrorResponse) *BatchObjectsUpdateBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batch objects update bad request response
+func (o *BatchObjectsUpdateBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchObjectsUpdateBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BatchObjectsUpdateUnauthorizedCode is the HTTP code returned for type BatchObjectsUpdateUnauthorized
+const BatchObjectsUpdateUnauthorizedCode int = 401
+
+/*
+BatchObjectsUpdateUnauthorized Unauthorized or invalid credentials.
+
+swagger:response batchObjectsUpdateUnauthorized
+*/
+type BatchObjectsUpdateUnauthorized struct {
+}
+
+// NewBatchObjectsUpdateUnauthorized creates BatchObjectsUpdateUnauthorized with default headers values
+func NewBatchObjectsUpdateUnauthorized() *BatchObjectsUpdateUnauthorized {
+
+	return &BatchObjectsUpdateUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *BatchObjectsUpdateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// BatchObjectsUpdateForbiddenCode is the HTTP code returned for type BatchObjectsUpdateForbidden
+const BatchObjectsUpdateForbiddenCode int = 403
+
+/*
+BatchObjectsUpdateForbidden Forbidden
+
+swagger:response batchObjectsUpdateForbidden
+*/
+type BatchObjectsUpdateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchObjectsUpdateForbidden creates BatchObjectsUpdateForbidden with default headers values
+func NewBatchObjectsUpdateForbidden() *BatchObjectsUpdateForbidden {
+
+	return &BatchObjectsUpdateForbidden{}
+}
+
+// WithPayload adds the payload to the batch objects update forbidden response
+func (o *BatchObjectsUpdateForbidden) WithPayload(payload *models.ErrorResponse) *BatchObjectsUpdateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batch objects update forbidden response
+func (o *BatchObjectsUpdateForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchObjectsUpdateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BatchObjectsUpdateUnprocessableEntityCode is the HTTP code returned for type BatchObjectsUpdateUnprocessableEntity
+const BatchObjectsUpdateUnprocessableEntityCode int = 422
+
+/*
+BatchObjectsUpdateUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?
+
+swagger:response batchObjectsUpdateUnprocessableEntity
+*/
+type BatchObjectsUpdateUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchObjectsUpdateUnprocessableEntity creates BatchObjectsUpdateUnprocessableEntity with default headers values
+func NewBatchObjectsUpdateUnprocessableEntity() *BatchObjectsUpdateUnprocessableEntity {
+
+	return &BatchObjectsUpdateUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the batch objects update unprocessable entity response
+func (o *BatchObjectsUpdateUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *BatchObjectsUpdateUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batch objects update unprocessable entity response
+func (o *BatchObjectsUpdateUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchObjectsUpdateUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BatchObjectsUpdateInternalServerErrorCode is the HTTP code returned for type BatchObjectsUpdateInternalServerError
+const BatchObjectsUpdateInternalServerErrorCode int = 500
+
+/*
+BatchObjectsUpdateInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response batchObjectsUpdateInternalServerError
+*/
+type BatchObjectsUpdateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBatchObjectsUpdateInternalServerError creates BatchObjectsUpdateInternalServerError with default headers values
+func NewBatchObjectsUpdateInternalServerError() *BatchObjectsUpdateInternalServerError {
+
+	return &BatchObjectsUpdateInternalServerError{}
+}
+
+// WithPayload adds the payload to the batch objects update internal server error response
+func (o *BatchObjectsUpdateInternalServerError) WithPayload(payload *models.ErrorResponse) *BatchObjectsUpdateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the batch objects update internal server error response
+func (o *BatchObjectsUpdateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BatchObjectsUpdateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesDynamicIndexUpdateHandlerFunc turns a function with the right signature into a nodes dynamic index update handler
+type NodesDynamicIndexUpdateHandlerFunc func(NodesDynamicIndexUpdateParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn NodesDynamicIndexUpdateHandlerFunc) Handle(params NodesDynamicIndexUpdateParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// NodesDynamicIndexUpdateHandler interface for that can handle valid nodes dynamic index update params
+type NodesDynamicIndexUpdateHandler interface {
+	Handle(NodesDynamicIndexUpdateParams, *models.Principal) middleware.Responder
+}
+
+// NewNodesDynamicIndexUpdate creates a new http.Handler for the nodes dynamic index update operation
+func NewNodesDynamicIndexUpdate(ctx *middleware.Context, handler NodesDynamicIndexUpdateHandler) *NodesDynamicIndexUpdate {
+	return &NodesDynamicIndexUpdate{Context: ctx, Handler: handler}
+}
+
+/*
+	NodesDynamicIndexUpdate swagger:route PUT /nodes/{className}/dynamic-index nodes nodesDynamicIndexUpdate
+
+Forces or defers the upgrade of the dynamic vector indexes of a class from flat to hnsw, for a single tenant or all shards. The policy is persisted with each index; a forced upgrade starts in the background.
+*/
+type NodesDynamicIndexUpdate struct {
+	Context *middleware.Context
+	Handler NodesDynamicIndexUpdateHandler
+}
+
+func (o *NodesDynamicIndexUpdate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewNodesDynamicIndexUpdateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,255 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesDynamicIndexUpdateAcceptedCode is the HTTP code returned for type NodesDynamicIndexUpdateAccepted
+const NodesDynamicIndexUpdateAcceptedCode int = 202
+
+/*
+NodesDynamicIndexUpdateAccepted Upgrade policy successfully updated
+
+swagger:response nodesDynamicIndexUpdateAccepted
+*/
+type NodesDynamicIndexUpdateAccepted struct {
+}
+
+// NewNodesDynamicIndexUpdateAccepted creates NodesDynamicIndexUpdateAccepted with default headers values
+func NewNodesDynamicIndexUpdateAccepted() *NodesDynamicIndexUpdateAccepted {
+
+	return &NodesDynamicIndexUpdateAccepted{}
+}
+
+// WriteResponse to the client
+func (o *NodesDynamicIndexUpdateAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(202)
+}
+
+// NodesDynamicIndexUpdateUnauthorizedCode is the HTTP code returned for type NodesDynamicIndexUpdateUnauthorized
+const NodesDynamicIndexUpdateUnauthorizedCode int = 401
+
+/*
+NodesDynamicIndexUpdateUnauthorized Unauthorized or invalid credentials.
+
+swagger:response nodesDynamicIndexUpdateUnauthorized
+*/
+type NodesDynamicIndexUpdateUnauthorized struct {
+}
+
+// NewNodesDynamicIndexUpdateUnauthorized creates NodesDynamicIndexUpdateUnauthorized with default headers values
+func NewNodesDynamicIndexUpdateUnauthorized() *NodesDynamicIndexUpdateUnauthorized {
+
+	return &NodesDynamicIndexUpdateUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *NodesDynamicIndexUpdateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// NodesDynamicIndexUpdateForbiddenCode is the HTTP code returned for type NodesDynamicIndexUpdateForbidden
+const NodesDynamicIndexUpdateForbiddenCode int = 403
+
+/*
+NodesDynamicIndexUpdateForbidden Forbidden
+
+swagger:response nodesDynamicIndexUpdateForbidden
+*/
+type NodesDynamicIndexUpdateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesDynamicIndexUpdateForbidden creates NodesDynamicIndexUpdateForbidden with default headers values
+func NewNodesDynamicIndexUpdateForbidden() *NodesDynamicIndexUpdateForbidden {
+
+	return &NodesDynamicIndexUpdateForbidden{}
+}
+
+// WithPayload adds the payload to the nodes dynamic index update forbidden response
+func (o *NodesDynamicIndexUpdateForbidden) WithPayload(payload *models.ErrorResponse) *NodesDynamicIndexUpdateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes dynamic index update forbidden response
+func (o *NodesDynamicIndexUpdateForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDynamicIndexUpdateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesDynamicIndexUpdateNotFoundCode is the HTTP code returned for type NodesDynamicIndexUpdateNotFound
+const NodesDynamicIndexUpdateNotFoundCode int = 404
+
+/*
+NodesDynamicIndexUpdateNotFound Not Found - Class or tenant does not exist
+
+swagger:response nodesDynamicIndexUpdateNotFound
+*/
+type NodesDynamicIndexUpdateNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesDynamicIndexUpdateNotFound creates NodesDynamicIndexUpdateNotFound with default headers values
+func NewNodesDynamicIndexUpdateNotFound() *NodesDynamicIndexUpdateNotFound {
+
+	return &NodesDynamicIndexUpdateNotFound{}
+}
+
+// WithPayload adds the payload to the nodes dynamic index update not found response
+func (o *NodesDynamicIndexUpdateNotFound) WithPayload(payload *models.ErrorResponse) *NodesDynamicIndexUpdateNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes dynamic index update not found response
+func (o *NodesDynamicIndexUpdateNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDynamicIndexUpdateNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesDynamicIndexUpdateUnprocessableEntityCode is the HTTP code returned for type NodesDynamicIndexUpdateUnprocessableEntity
+const NodesDynamicIndexUpdateUnprocessableEntityCode int = 422
+
+/*
+NodesDynamicIndexUpdateUnprocessableEntity Invalid request, e.g. the target vector does not use a dynamic index or the tenant is not active.
+
+swagger:response nodesDynamicIndexUpdateUnprocessableEntity
+*/
+type NodesDynamicIndexUpdateUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesDynamicIndexUpdateUnprocessableEntity creates NodesDynamicIndexUpdateUnprocessableEntity with default headers values
+func NewNodesDynamicIndexUpdateUnprocessableEntity() *NodesDynamicIndexUpdateUnprocessableEntity {
+
+	return &NodesDynamicIndexUpdateUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the nodes dynamic index update unprocessable entity response
+func (o *NodesDynamicIndexUpdateUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *NodesDynamicIndexUpdateUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes dynamic index update unprocessable entity response
+func (o *NodesDynamicIndexUpdateUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDynamicIndexUpdateUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// NodesDynamicIndexUpdateInternalServerErrorCode is the HTTP code returned for type NodesDynamicIndexUpdateInternalServerError
+const NodesDynamicIndexUpdateInternalServerErrorCode int = 500
+
+/*
+NodesDynamicIndexUpdateInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response nodesDynamicIndexUpdateInternalServerError
+*/
+type NodesDynamicIndexUpdateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewNodesDynamicIndexUpdateInternalServerError creates NodesDynamicIndexUpdateInternalServerError with default headers values
+func NewNodesDynamicIndexUpdateInternalServerError() *NodesDynamicIndexUpdateInternalServerError {
+
+	return &NodesDynamicIndexUpdateInternalServerError{}
+}
+
+// WithPayload adds the payload to the nodes dynamic index update internal server error response
+func (o *NodesDynamicIndexUpdateInternalServerError) WithPayload(payload *models.ErrorResponse) *NodesDynamicIndexUpdateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the nodes dynamic index update internal server error response
+func (o *NodesDynamicIndexUpdateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *NodesDynamicIndexUpdateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -43,6 +43,10 @@ type ObjectsClassDeleteParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Only apply the write if the stored object has this version, its lastUpdateTimeUnix as returned in the ETag header. A version of 0 requires that the object does not exist. A write within the same millisecond as the write of the stored version would not change the version and is refused, it needs to be retried.
+	  In: header
+	*/
+	IfMatch *string
 	/*
 	  Required: true
 	  In: path
@@ -74,6 +78,10 @@ func (o *ObjectsClassDeleteParams) BindRequest(r *http.Request, route *middlewar
 
 	qs := runtime.Values(r.URL.Query())
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rClassName, rhkClassName, _ := route.Params.GetOK("className")
 	if err := o.bindClassName(rClassName, rhkClassName, route.Formats); err != nil {
 		res = append(res, err)
@@ -99,6 +107,23 @@ func (o *ObjectsClassDeleteParams) BindRequest(r *http.Request, route *middlewar
 	return nil
 }
 
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *ObjectsClassDeleteParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
+
 // bindClassName binds and validates parameter ClassName from path.
 func (o *ObjectsClassDeleteParams) bindClassName(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -189,6 +189,51 @@ func (o *ObjectsClassDeleteNotFound) WriteResponse(rw http.ResponseWriter, produ
 	rw.WriteHeader(404)
 }
 
+// ObjectsClassDeletePreconditionFailedCode is the HTTP code returned for type ObjectsClassDeletePreconditionFailed
+const ObjectsClassDeletePreconditionFailedCode int = 412
+
+/*
+ObjectsClassDeletePreconditionFailed The stored object does not have the version given in the If-Match header, or the write would not change the version and needs to be retried.
+
+swagger:response objectsClassDeletePreconditionFailed
+*/
+type ObjectsClassDeletePreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassDeletePreconditionFailed creates ObjectsClassDeletePreconditionFailed with default headers values
+func NewObjectsClassDeletePreconditionFailed() *ObjectsClassDeletePreconditionFailed {
+
+	return &ObjectsClassDeletePreconditionFailed{}
+}
+
+// WithPayload adds the payload to the objects class delete precondition failed response
+func (o *ObjectsClassDeletePreconditionFailed) WithPayload(payload *models.ErrorResponse) *ObjectsClassDeletePreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class delete precondition failed response
+func (o *ObjectsClassDeletePreconditionFailed) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassDeletePreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassDeleteUnprocessableEntityCode is the HTTP code returned for type ObjectsClassDeleteUnprocessableEntity
 const ObjectsClassDeleteUnprocessableEntityCode int = 422
 
@@ -33,6 +33,10 @@ ObjectsClassGetOK Successful response.
 swagger:response objectsClassGetOK
 */
 type ObjectsClassGetOK struct {
+	/*The version of the object, its lastUpdateTimeUnix. Can be passed to conditional writes in the If-Match header.
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -46,6 +50,17 @@ func NewObjectsClassGetOK() *ObjectsClassGetOK {
 	return &ObjectsClassGetOK{}
 }
 
+// WithETag adds the eTag to the objects class get o k response
+func (o *ObjectsClassGetOK) WithETag(eTag string) *ObjectsClassGetOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the objects class get o k response
+func (o *ObjectsClassGetOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the objects class get o k response
 func (o *ObjectsClassGetOK) WithPayload(payload *models.Object) *ObjectsClassGetOK {
 	o.Payload = payload
@@ -60,6 +75,13 @@ func (o *ObjectsClassGetOK) SetPayload(payload *models.Object) {
 // WriteResponse to the client
 func (o *ObjectsClassGetOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -45,6 +45,10 @@ type ObjectsClassPatchParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Only apply the write if the stored object has this version, its lastUpdateTimeUnix as returned in the ETag header. A version of 0 requires that the object does not exist. A write within the same millisecond as the write of the stored version would not change the version and is refused, it needs to be retried.
+	  In: header
+	*/
+	IfMatch *string
 	/*RFC 7396-style patch, the body contains the object to merge into the existing object.
 	  In: body
 	*/
@@ -76,6 +80,10 @@ func (o *ObjectsClassPatchParams) BindRequest(r *http.Request, route *middleware
 
 	qs := runtime.Values(r.URL.Query())
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.Object
@@ -118,6 +126,23 @@ func (o *ObjectsClassPatchParams) BindRequest(r *http.Request, route *middleware
 	return nil
 }
 
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *ObjectsClassPatchParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
+
 // bindClassName binds and validates parameter ClassName from path.
 func (o *ObjectsClassPatchParams) bindClassName(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
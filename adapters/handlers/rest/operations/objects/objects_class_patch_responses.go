@@ -189,6 +189,51 @@ func (o *ObjectsClassPatchNotFound) WriteResponse(rw http.ResponseWriter, produc
 	rw.WriteHeader(404)
 }
 
+// ObjectsClassPatchPreconditionFailedCode is the HTTP code returned for type ObjectsClassPatchPreconditionFailed
+const ObjectsClassPatchPreconditionFailedCode int = 412
+
+/*
+ObjectsClassPatchPreconditionFailed The stored object does not have the version given in the If-Match header, or the write would not change the version and needs to be retried.
+
+swagger:response objectsClassPatchPreconditionFailed
+*/
+type ObjectsClassPatchPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassPatchPreconditionFailed creates ObjectsClassPatchPreconditionFailed with default headers values
+func NewObjectsClassPatchPreconditionFailed() *ObjectsClassPatchPreconditionFailed {
+
+	return &ObjectsClassPatchPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the objects class patch precondition failed response
+func (o *ObjectsClassPatchPreconditionFailed) WithPayload(payload *models.ErrorResponse) *ObjectsClassPatchPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class patch precondition failed response
+func (o *ObjectsClassPatchPreconditionFailed) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassPatchPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassPatchUnprocessableEntityCode is the HTTP code returned for type ObjectsClassPatchUnprocessableEntity
 const ObjectsClassPatchUnprocessableEntityCode int = 422
 
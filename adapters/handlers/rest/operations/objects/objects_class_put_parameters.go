@@ -46,6 +46,10 @@ type ObjectsClassPutParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Only apply the write if the stored object has this version, its lastUpdateTimeUnix as returned in the ETag header. A version of 0 requires that the object does not exist. A write within the same millisecond as the write of the stored version would not change the version and is refused, it needs to be retried.
+	  In: header
+	*/
+	IfMatch *string
 	/*
 	  Required: true
 	  In: body
@@ -78,6 +82,10 @@ func (o *ObjectsClassPutParams) BindRequest(r *http.Request, route *middleware.M
 
 	qs := runtime.Values(r.URL.Query())
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.Object
@@ -126,6 +134,23 @@ func (o *ObjectsClassPutParams) BindRequest(r *http.Request, route *middleware.M
 	return nil
 }
 
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *ObjectsClassPutParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
+
 // bindClassName binds and validates parameter ClassName from path.
 func (o *ObjectsClassPutParams) bindClassName(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -164,6 +164,51 @@ func (o *ObjectsClassPutNotFound) WriteResponse(rw http.ResponseWriter, producer
 	rw.WriteHeader(404)
 }
 
+// ObjectsClassPutPreconditionFailedCode is the HTTP code returned for type ObjectsClassPutPreconditionFailed
+const ObjectsClassPutPreconditionFailedCode int = 412
+
+/*
+ObjectsClassPutPreconditionFailed The stored object does not have the version given in the If-Match header, or the write would not change the version and needs to be retried.
+
+swagger:response objectsClassPutPreconditionFailed
+*/
+type ObjectsClassPutPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewObjectsClassPutPreconditionFailed creates ObjectsClassPutPreconditionFailed with default headers values
+func NewObjectsClassPutPreconditionFailed() *ObjectsClassPutPreconditionFailed {
+
+	return &ObjectsClassPutPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the objects class put precondition failed response
+func (o *ObjectsClassPutPreconditionFailed) WithPayload(payload *models.ErrorResponse) *ObjectsClassPutPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the objects class put precondition failed response
+func (o *ObjectsClassPutPreconditionFailed) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ObjectsClassPutPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ObjectsClassPutUnprocessableEntityCode is the HTTP code returned for type ObjectsClassPutUnprocessableEntity
 const ObjectsClassPutUnprocessableEntityCode int = 422
 
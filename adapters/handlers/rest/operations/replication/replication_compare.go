@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationCompareHandlerFunc turns a function with the right signature into a replication compare handler
+type ReplicationCompareHandlerFunc func(ReplicationCompareParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ReplicationCompareHandlerFunc) Handle(params ReplicationCompareParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ReplicationCompareHandler interface for that can handle valid replication compare params
+type ReplicationCompareHandler interface {
+	Handle(ReplicationCompareParams, *models.Principal) middleware.Responder
+}
+
+// NewReplicationCompare creates a new http.Handler for the replication compare operation
+func NewReplicationCompare(ctx *middleware.Context, handler ReplicationCompareHandler) *ReplicationCompare {
+	return &ReplicationCompare{Context: ctx, Handler: handler}
+}
+
+/*
+	ReplicationCompare swagger:route POST /replication/compare/{className} replication replicationCompare
+
+Triggers an immediate hashtree comparison of every shard of the class with its replicas.
+*/
+type ReplicationCompare struct {
+	Context *middleware.Context
+	Handler ReplicationCompareHandler
+}
+
+func (o *ReplicationCompare) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewReplicationCompareParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,255 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationCompareAcceptedCode is the HTTP code returned for type ReplicationCompareAccepted
+const ReplicationCompareAcceptedCode int = 202
+
+/*
+ReplicationCompareAccepted Comparison successfully triggered
+
+swagger:response replicationCompareAccepted
+*/
+type ReplicationCompareAccepted struct {
+}
+
+// NewReplicationCompareAccepted creates ReplicationCompareAccepted with default headers values
+func NewReplicationCompareAccepted() *ReplicationCompareAccepted {
+
+	return &ReplicationCompareAccepted{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationCompareAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(202)
+}
+
+// ReplicationCompareUnauthorizedCode is the HTTP code returned for type ReplicationCompareUnauthorized
+const ReplicationCompareUnauthorizedCode int = 401
+
+/*
+ReplicationCompareUnauthorized Unauthorized or invalid credentials.
+
+swagger:response replicationCompareUnauthorized
+*/
+type ReplicationCompareUnauthorized struct {
+}
+
+// NewReplicationCompareUnauthorized creates ReplicationCompareUnauthorized with default headers values
+func NewReplicationCompareUnauthorized() *ReplicationCompareUnauthorized {
+
+	return &ReplicationCompareUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationCompareUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ReplicationCompareForbiddenCode is the HTTP code returned for type ReplicationCompareForbidden
+const ReplicationCompareForbiddenCode int = 403
+
+/*
+ReplicationCompareForbidden Forbidden
+
+swagger:response replicationCompareForbidden
+*/
+type ReplicationCompareForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationCompareForbidden creates ReplicationCompareForbidden with default headers values
+func NewReplicationCompareForbidden() *ReplicationCompareForbidden {
+
+	return &ReplicationCompareForbidden{}
+}
+
+// WithPayload adds the payload to the replication compare forbidden response
+func (o *ReplicationCompareForbidden) WithPayload(payload *models.ErrorResponse) *ReplicationCompareForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication compare forbidden response
+func (o *ReplicationCompareForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationCompareForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationCompareNotFoundCode is the HTTP code returned for type ReplicationCompareNotFound
+const ReplicationCompareNotFoundCode int = 404
+
+/*
+ReplicationCompareNotFound Not Found - Class does not exist
+
+swagger:response replicationCompareNotFound
+*/
+type ReplicationCompareNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationCompareNotFound creates ReplicationCompareNotFound with default headers values
+func NewReplicationCompareNotFound() *ReplicationCompareNotFound {
+
+	return &ReplicationCompareNotFound{}
+}
+
+// WithPayload adds the payload to the replication compare not found response
+func (o *ReplicationCompareNotFound) WithPayload(payload *models.ErrorResponse) *ReplicationCompareNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication compare not found response
+func (o *ReplicationCompareNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationCompareNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationCompareUnprocessableEntityCode is the HTTP code returned for type ReplicationCompareUnprocessableEntity
+const ReplicationCompareUnprocessableEntityCode int = 422
+
+/*
+ReplicationCompareUnprocessableEntity Async replication is not enabled for the class.
+
+swagger:response replicationCompareUnprocessableEntity
+*/
+type ReplicationCompareUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationCompareUnprocessableEntity creates ReplicationCompareUnprocessableEntity with default headers values
+func NewReplicationCompareUnprocessableEntity() *ReplicationCompareUnprocessableEntity {
+
+	return &ReplicationCompareUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the replication compare unprocessable entity response
+func (o *ReplicationCompareUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ReplicationCompareUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication compare unprocessable entity response
+func (o *ReplicationCompareUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationCompareUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationCompareInternalServerErrorCode is the HTTP code returned for type ReplicationCompareInternalServerError
+const ReplicationCompareInternalServerErrorCode int = 500
+
+/*
+ReplicationCompareInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response replicationCompareInternalServerError
+*/
+type ReplicationCompareInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationCompareInternalServerError creates ReplicationCompareInternalServerError with default headers values
+func NewReplicationCompareInternalServerError() *ReplicationCompareInternalServerError {
+
+	return &ReplicationCompareInternalServerError{}
+}
+
+// WithPayload adds the payload to the replication compare internal server error response
+func (o *ReplicationCompareInternalServerError) WithPayload(payload *models.ErrorResponse) *ReplicationCompareInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication compare internal server error response
+func (o *ReplicationCompareInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationCompareInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
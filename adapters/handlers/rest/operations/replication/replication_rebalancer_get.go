@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalancerGetHandlerFunc turns a function with the right signature into a replication rebalancer get handler
+type ReplicationRebalancerGetHandlerFunc func(ReplicationRebalancerGetParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ReplicationRebalancerGetHandlerFunc) Handle(params ReplicationRebalancerGetParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ReplicationRebalancerGetHandler interface for that can handle valid replication rebalancer get params
+type ReplicationRebalancerGetHandler interface {
+	Handle(ReplicationRebalancerGetParams, *models.Principal) middleware.Responder
+}
+
+// NewReplicationRebalancerGet creates a new http.Handler for the replication rebalancer get operation
+func NewReplicationRebalancerGet(ctx *middleware.Context, handler ReplicationRebalancerGetHandler) *ReplicationRebalancerGet {
+	return &ReplicationRebalancerGet{Context: ctx, Handler: handler}
+}
+
+/*
+	ReplicationRebalancerGet swagger:route GET /replication/rebalancer replication replicationRebalancerGet
+
+Returns the state of the automatic shard rebalancer, including the load of the nodes and the recent shard moves.
+*/
+type ReplicationRebalancerGet struct {
+	Context *middleware.Context
+	Handler ReplicationRebalancerGetHandler
+}
+
+func (o *ReplicationRebalancerGet) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewReplicationRebalancerGetParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,185 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalancerGetOKCode is the HTTP code returned for type ReplicationRebalancerGetOK
+const ReplicationRebalancerGetOKCode int = 200
+
+/*
+ReplicationRebalancerGetOK The state of the rebalancer
+
+swagger:response replicationRebalancerGetOK
+*/
+type ReplicationRebalancerGetOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.RebalancerStatus `json:"body,omitempty"`
+}
+
+// NewReplicationRebalancerGetOK creates ReplicationRebalancerGetOK with default headers values
+func NewReplicationRebalancerGetOK() *ReplicationRebalancerGetOK {
+
+	return &ReplicationRebalancerGetOK{}
+}
+
+// WithPayload adds the payload to the replication rebalancer get o k response
+func (o *ReplicationRebalancerGetOK) WithPayload(payload *models.RebalancerStatus) *ReplicationRebalancerGetOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalancer get o k response
+func (o *ReplicationRebalancerGetOK) SetPayload(payload *models.RebalancerStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancerGetOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalancerGetUnauthorizedCode is the HTTP code returned for type ReplicationRebalancerGetUnauthorized
+const ReplicationRebalancerGetUnauthorizedCode int = 401
+
+/*
+ReplicationRebalancerGetUnauthorized Unauthorized or invalid credentials.
+
+swagger:response replicationRebalancerGetUnauthorized
+*/
+type ReplicationRebalancerGetUnauthorized struct {
+}
+
+// NewReplicationRebalancerGetUnauthorized creates ReplicationRebalancerGetUnauthorized with default headers values
+func NewReplicationRebalancerGetUnauthorized() *ReplicationRebalancerGetUnauthorized {
+
+	return &ReplicationRebalancerGetUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancerGetUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ReplicationRebalancerGetForbiddenCode is the HTTP code returned for type ReplicationRebalancerGetForbidden
+const ReplicationRebalancerGetForbiddenCode int = 403
+
+/*
+ReplicationRebalancerGetForbidden Forbidden
+
+swagger:response replicationRebalancerGetForbidden
+*/
+type ReplicationRebalancerGetForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalancerGetForbidden creates ReplicationRebalancerGetForbidden with default headers values
+func NewReplicationRebalancerGetForbidden() *ReplicationRebalancerGetForbidden {
+
+	return &ReplicationRebalancerGetForbidden{}
+}
+
+// WithPayload adds the payload to the replication rebalancer get forbidden response
+func (o *ReplicationRebalancerGetForbidden) WithPayload(payload *models.ErrorResponse) *ReplicationRebalancerGetForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalancer get forbidden response
+func (o *ReplicationRebalancerGetForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancerGetForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalancerGetInternalServerErrorCode is the HTTP code returned for type ReplicationRebalancerGetInternalServerError
+const ReplicationRebalancerGetInternalServerErrorCode int = 500
+
+/*
+ReplicationRebalancerGetInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response replicationRebalancerGetInternalServerError
+*/
+type ReplicationRebalancerGetInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalancerGetInternalServerError creates ReplicationRebalancerGetInternalServerError with default headers values
+func NewReplicationRebalancerGetInternalServerError() *ReplicationRebalancerGetInternalServerError {
+
+	return &ReplicationRebalancerGetInternalServerError{}
+}
+
+// WithPayload adds the payload to the replication rebalancer get internal server error response
+func (o *ReplicationRebalancerGetInternalServerError) WithPayload(payload *models.ErrorResponse) *ReplicationRebalancerGetInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalancer get internal server error response
+func (o *ReplicationRebalancerGetInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancerGetInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
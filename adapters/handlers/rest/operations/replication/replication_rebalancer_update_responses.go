@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalancerUpdateOKCode is the HTTP code returned for type ReplicationRebalancerUpdateOK
+const ReplicationRebalancerUpdateOKCode int = 200
+
+/*
+ReplicationRebalancerUpdateOK The state of the rebalancer after the update
+
+swagger:response replicationRebalancerUpdateOK
+*/
+type ReplicationRebalancerUpdateOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.RebalancerStatus `json:"body,omitempty"`
+}
+
+// NewReplicationRebalancerUpdateOK creates ReplicationRebalancerUpdateOK with default headers values
+func NewReplicationRebalancerUpdateOK() *ReplicationRebalancerUpdateOK {
+
+	return &ReplicationRebalancerUpdateOK{}
+}
+
+// WithPayload adds the payload to the replication rebalancer update o k response
+func (o *ReplicationRebalancerUpdateOK) WithPayload(payload *models.RebalancerStatus) *ReplicationRebalancerUpdateOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalancer update o k response
+func (o *ReplicationRebalancerUpdateOK) SetPayload(payload *models.RebalancerStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancerUpdateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalancerUpdateUnauthorizedCode is the HTTP code returned for type ReplicationRebalancerUpdateUnauthorized
+const ReplicationRebalancerUpdateUnauthorizedCode int = 401
+
+/*
+ReplicationRebalancerUpdateUnauthorized Unauthorized or invalid credentials.
+
+swagger:response replicationRebalancerUpdateUnauthorized
+*/
+type ReplicationRebalancerUpdateUnauthorized struct {
+}
+
+// NewReplicationRebalancerUpdateUnauthorized creates ReplicationRebalancerUpdateUnauthorized with default headers values
+func NewReplicationRebalancerUpdateUnauthorized() *ReplicationRebalancerUpdateUnauthorized {
+
+	return &ReplicationRebalancerUpdateUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancerUpdateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ReplicationRebalancerUpdateForbiddenCode is the HTTP code returned for type ReplicationRebalancerUpdateForbidden
+const ReplicationRebalancerUpdateForbiddenCode int = 403
+
+/*
+ReplicationRebalancerUpdateForbidden Forbidden
+
+swagger:response replicationRebalancerUpdateForbidden
+*/
+type ReplicationRebalancerUpdateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalancerUpdateForbidden creates ReplicationRebalancerUpdateForbidden with default headers values
+func NewReplicationRebalancerUpdateForbidden() *ReplicationRebalancerUpdateForbidden {
+
+	return &ReplicationRebalancerUpdateForbidden{}
+}
+
+// WithPayload adds the payload to the replication rebalancer update forbidden response
+func (o *ReplicationRebalancerUpdateForbidden) WithPayload(payload *models.ErrorResponse) *ReplicationRebalancerUpdateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalancer update forbidden response
+func (o *ReplicationRebalancerUpdateForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancerUpdateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalancerUpdateUnprocessableEntityCode is the HTTP code returned for type ReplicationRebalancerUpdateUnprocessableEntity
+const ReplicationRebalancerUpdateUnprocessableEntityCode int = 422
+
+/*
+ReplicationRebalancerUpdateUnprocessableEntity Invalid update request
+
+swagger:response replicationRebalancerUpdateUnprocessableEntity
+*/
+type ReplicationRebalancerUpdateUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalancerUpdateUnprocessableEntity creates ReplicationRebalancerUpdateUnprocessableEntity with default headers values
+func NewReplicationRebalancerUpdateUnprocessableEntity() *ReplicationRebalancerUpdateUnprocessableEntity {
+
+	return &ReplicationRebalancerUpdateUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the replication rebalancer update unprocessable entity response
+func (o *ReplicationRebalancerUpdateUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ReplicationRebalancerUpdateUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalancer update unprocessable entity response
+func (o *ReplicationRebalancerUpdateUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancerUpdateUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalancerUpdateInternalServerErrorCode is the HTTP code returned for type ReplicationRebalancerUpdateInternalServerError
+const ReplicationRebalancerUpdateInternalServerErrorCode int = 500
+
+/*
+ReplicationRebalancerUpdateInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response replicationRebalancerUpdateInternalServerError
+*/
+type ReplicationRebalancerUpdateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalancerUpdateInternalServerError creates ReplicationRebalancerUpdateInternalServerError with default headers values
+func NewReplicationRebalancerUpdateInternalServerError() *ReplicationRebalancerUpdateInternalServerError {
+
+	return &ReplicationRebalancerUpdateInternalServerError{}
+}
+
+// WithPayload adds the payload to the replication rebalancer update internal server error response
+func (o *ReplicationRebalancerUpdateInternalServerError) WithPayload(payload *models.ErrorResponse) *ReplicationRebalancerUpdateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalancer update internal server error response
+func (o *ReplicationRebalancerUpdateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancerUpdateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationReshardHandlerFunc turns a function with the right signature into a replication reshard handler
+type ReplicationReshardHandlerFunc func(ReplicationReshardParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ReplicationReshardHandlerFunc) Handle(params ReplicationReshardParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ReplicationReshardHandler interface for that can handle valid replication reshard params
+type ReplicationReshardHandler interface {
+	Handle(ReplicationReshardParams, *models.Principal) middleware.Responder
+}
+
+// NewReplicationReshard creates a new http.Handler for the replication reshard operation
+func NewReplicationReshard(ctx *middleware.Context, handler ReplicationReshardHandler) *ReplicationReshard {
+	return &ReplicationReshard{Context: ctx, Handler: handler}
+}
+
+/*
+	ReplicationReshard swagger:route POST /replication/reshard replication replicationReshard
+
+Splits the physical shards of a class into more shards, or merges them into fewer, while the class keeps serving traffic. The virtual shards are redistributed among the new physical shards and the existing objects are copied to them in the background, while writes are applied to both layouts. Once all objects are copied, the new layout replaces the current one atomically. The resharding is stored in the cluster schema and continues after restarts and leader changes, its progress is returned by GET /replication/reshard/{className}. Classes with multi-tenancy enabled cannot be resharded.
+*/
+type ReplicationReshard struct {
+	Context *middleware.Context
+	Handler ReplicationReshardHandler
+}
+
+func (o *ReplicationReshard) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewReplicationReshardParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
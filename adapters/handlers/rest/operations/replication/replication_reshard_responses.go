@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationReshardAcceptedCode is the HTTP code returned for type ReplicationReshardAccepted
+const ReplicationReshardAcceptedCode int = 202
+
+/*
+ReplicationReshardAccepted Resharding started
+
+swagger:response replicationReshardAccepted
+*/
+type ReplicationReshardAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ReshardingStatus `json:"body,omitempty"`
+}
+
+// NewReplicationReshardAccepted creates ReplicationReshardAccepted with default headers values
+func NewReplicationReshardAccepted() *ReplicationReshardAccepted {
+
+	return &ReplicationReshardAccepted{}
+}
+
+// WithPayload adds the payload to the replication reshard accepted response
+func (o *ReplicationReshardAccepted) WithPayload(payload *models.ReshardingStatus) *ReplicationReshardAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication reshard accepted response
+func (o *ReplicationReshardAccepted) SetPayload(payload *models.ReshardingStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationReshardAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationReshardUnauthorizedCode is the HTTP code returned for type ReplicationReshardUnauthorized
+const ReplicationReshardUnauthorizedCode int = 401
+
+/*
+ReplicationReshardUnauthorized Unauthorized or invalid credentials.
+
+swagger:response replicationReshardUnauthorized
+*/
+type ReplicationReshardUnauthorized struct {
+}
+
+// NewReplicationReshardUnauthorized creates ReplicationReshardUnauthorized with default headers values
+func NewReplicationReshardUnauthorized() *ReplicationReshardUnauthorized {
+
+	return &ReplicationReshardUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationReshardUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ReplicationReshardForbiddenCode is the HTTP code returned for type ReplicationReshardForbidden
+const ReplicationReshardForbiddenCode int = 403
+
+/*
+ReplicationReshardForbidden Forbidden
+
+swagger:response replicationReshardForbidden
+*/
+type ReplicationReshardForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationReshardForbidden creates ReplicationReshardForbidden with default headers values
+func NewReplicationReshardForbidden() *ReplicationReshardForbidden {
+
+	return &ReplicationReshardForbidden{}
+}
+
+// WithPayload adds the payload to the replication reshard forbidden response
+func (o *ReplicationReshardForbidden) WithPayload(payload *models.ErrorResponse) *ReplicationReshardForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication reshard forbidden response
+func (o *ReplicationReshardForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationReshardForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationReshardNotFoundCode is the HTTP code returned for type ReplicationReshardNotFound
+const ReplicationReshardNotFoundCode int = 404
+
+/*
+ReplicationReshardNotFound Not Found - Class does not exist
+
+swagger:response replicationReshardNotFound
+*/
+type ReplicationReshardNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationReshardNotFound creates ReplicationReshardNotFound with default headers values
+func NewReplicationReshardNotFound() *ReplicationReshardNotFound {
+
+	return &ReplicationReshardNotFound{}
+}
+
+// WithPayload adds the payload to the replication reshard not found response
+func (o *ReplicationReshardNotFound) WithPayload(payload *models.ErrorResponse) *ReplicationReshardNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication reshard not found response
+func (o *ReplicationReshardNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationReshardNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationReshardUnprocessableEntityCode is the HTTP code returned for type ReplicationReshardUnprocessableEntity
+const ReplicationReshardUnprocessableEntityCode int = 422
+
+/*
+ReplicationReshardUnprocessableEntity Invalid reshard request, e.g. the class has multi-tenancy enabled, is already being resharded or there are not enough nodes for the shard count.
+
+swagger:response replicationReshardUnprocessableEntity
+*/
+type ReplicationReshardUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationReshardUnprocessableEntity creates ReplicationReshardUnprocessableEntity with default headers values
+func NewReplicationReshardUnprocessableEntity() *ReplicationReshardUnprocessableEntity {
+
+	return &ReplicationReshardUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the replication reshard unprocessable entity response
+func (o *ReplicationReshardUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ReplicationReshardUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication reshard unprocessable entity response
+func (o *ReplicationReshardUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationReshardUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationReshardInternalServerErrorCode is the HTTP code returned for type ReplicationReshardInternalServerError
+const ReplicationReshardInternalServerErrorCode int = 500
+
+/*
+ReplicationReshardInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response replicationReshardInternalServerError
+*/
+type ReplicationReshardInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationReshardInternalServerError creates ReplicationReshardInternalServerError with default headers values
+func NewReplicationReshardInternalServerError() *ReplicationReshardInternalServerError {
+
+	return &ReplicationReshardInternalServerError{}
+}
+
+// WithPayload adds the payload to the replication reshard internal server error response
+func (o *ReplicationReshardInternalServerError) WithPayload(payload *models.ErrorResponse) *ReplicationReshardInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication reshard internal server error response
+func (o *ReplicationReshardInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationReshardInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationReshardStatusHandlerFunc turns a function with the right signature into a replication reshard status handler
+type ReplicationReshardStatusHandlerFunc func(ReplicationReshardStatusParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ReplicationReshardStatusHandlerFunc) Handle(params ReplicationReshardStatusParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ReplicationReshardStatusHandler interface for that can handle valid replication reshard status params
+type ReplicationReshardStatusHandler interface {
+	Handle(ReplicationReshardStatusParams, *models.Principal) middleware.Responder
+}
+
+// NewReplicationReshardStatus creates a new http.Handler for the replication reshard status operation
+func NewReplicationReshardStatus(ctx *middleware.Context, handler ReplicationReshardStatusHandler) *ReplicationReshardStatus {
+	return &ReplicationReshardStatus{Context: ctx, Handler: handler}
+}
+
+/*
+	ReplicationReshardStatus swagger:route GET /replication/reshard/{className} replication replicationReshardStatus
+
+Returns the progress of the running resharding of a class, or the outcome of the last one.
+*/
+type ReplicationReshardStatus struct {
+	Context *middleware.Context
+	Handler ReplicationReshardStatusHandler
+}
+
+func (o *ReplicationReshardStatus) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewReplicationReshardStatusParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
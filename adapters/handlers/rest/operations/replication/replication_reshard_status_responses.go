@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationReshardStatusOKCode is the HTTP code returned for type ReplicationReshardStatusOK
+const ReplicationReshardStatusOKCode int = 200
+
+/*
+ReplicationReshardStatusOK The state of the resharding
+
+swagger:response replicationReshardStatusOK
+*/
+type ReplicationReshardStatusOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ReshardingStatus `json:"body,omitempty"`
+}
+
+// NewReplicationReshardStatusOK creates ReplicationReshardStatusOK with default headers values
+func NewReplicationReshardStatusOK() *ReplicationReshardStatusOK {
+
+	return &ReplicationReshardStatusOK{}
+}
+
+// WithPayload adds the payload to the replication reshard status o k response
+func (o *ReplicationReshardStatusOK) WithPayload(payload *models.ReshardingStatus) *ReplicationReshardStatusOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication reshard status o k response
+func (o *ReplicationReshardStatusOK) SetPayload(payload *models.ReshardingStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationReshardStatusOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationReshardStatusUnauthorizedCode is the HTTP code returned for type ReplicationReshardStatusUnauthorized
+const ReplicationReshardStatusUnauthorizedCode int = 401
+
+/*
+ReplicationReshardStatusUnauthorized Unauthorized or invalid credentials.
+
+swagger:response replicationReshardStatusUnauthorized
+*/
+type ReplicationReshardStatusUnauthorized struct {
+}
+
+// NewReplicationReshardStatusUnauthorized creates ReplicationReshardStatusUnauthorized with default headers values
+func NewReplicationReshardStatusUnauthorized() *ReplicationReshardStatusUnauthorized {
+
+	return &ReplicationReshardStatusUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationReshardStatusUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ReplicationReshardStatusForbiddenCode is the HTTP code returned for type ReplicationReshardStatusForbidden
+const ReplicationReshardStatusForbiddenCode int = 403
+
+/*
+ReplicationReshardStatusForbidden Forbidden
+
+swagger:response replicationReshardStatusForbidden
+*/
+type ReplicationReshardStatusForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationReshardStatusForbidden creates ReplicationReshardStatusForbidden with default headers values
+func NewReplicationReshardStatusForbidden() *ReplicationReshardStatusForbidden {
+
+	return &ReplicationReshardStatusForbidden{}
+}
+
+// WithPayload adds the payload to the replication reshard status forbidden response
+func (o *ReplicationReshardStatusForbidden) WithPayload(payload *models.ErrorResponse) *ReplicationReshardStatusForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication reshard status forbidden response
+func (o *ReplicationReshardStatusForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationReshardStatusForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationReshardStatusNotFoundCode is the HTTP code returned for type ReplicationReshardStatusNotFound
+const ReplicationReshardStatusNotFoundCode int = 404
+
+/*
+ReplicationReshardStatusNotFound Not Found - Class does not exist or was never resharded
+
+swagger:response replicationReshardStatusNotFound
+*/
+type ReplicationReshardStatusNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationReshardStatusNotFound creates ReplicationReshardStatusNotFound with default headers values
+func NewReplicationReshardStatusNotFound() *ReplicationReshardStatusNotFound {
+
+	return &ReplicationReshardStatusNotFound{}
+}
+
+// WithPayload adds the payload to the replication reshard status not found response
+func (o *ReplicationReshardStatusNotFound) WithPayload(payload *models.ErrorResponse) *ReplicationReshardStatusNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication reshard status not found response
+func (o *ReplicationReshardStatusNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationReshardStatusNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationReshardStatusInternalServerErrorCode is the HTTP code returned for type ReplicationReshardStatusInternalServerError
+const ReplicationReshardStatusInternalServerErrorCode int = 500
+
+/*
+ReplicationReshardStatusInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response replicationReshardStatusInternalServerError
+*/
+type ReplicationReshardStatusInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationReshardStatusInternalServerError creates ReplicationReshardStatusInternalServerError with default headers values
+func NewReplicationReshardStatusInternalServerError() *ReplicationReshardStatusInternalServerError {
+
+	return &ReplicationReshardStatusInternalServerError{}
+}
+
+// WithPayload adds the payload to the replication reshard status internal server error response
+func (o *ReplicationReshardStatusInternalServerError) WithPayload(payload *models.ErrorResponse) *ReplicationReshardStatusInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication reshard status internal server error response
+func (o *ReplicationReshardStatusInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationReshardStatusInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationStatusOKCode is the HTTP code returned for type ReplicationStatusOK
+const ReplicationStatusOKCode int = 200
+
+/*
+ReplicationStatusOK Replication status successfully returned
+
+swagger:response replicationStatusOK
+*/
+type ReplicationStatusOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ReplicationStatusResponse `json:"body,omitempty"`
+}
+
+// NewReplicationStatusOK creates ReplicationStatusOK with default headers values
+func NewReplicationStatusOK() *ReplicationStatusOK {
+
+	return &ReplicationStatusOK{}
+}
+
+// WithPayload adds the payload to the replication status o k response
+func (o *ReplicationStatusOK) WithPayload(payload *models.ReplicationStatusResponse) *ReplicationStatusOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication status o k response
+func (o *ReplicationStatusOK) SetPayload(payload *models.ReplicationStatusResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationStatusOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationStatusUnauthorizedCode is the HTTP code returned for type ReplicationStatusUnauthorized
+const ReplicationStatusUnauthorizedCode int = 401
+
+/*
+ReplicationStatusUnauthorized Unauthorized or invalid credentials.
+
+swagger:response replicationStatusUnauthorized
+*/
+type ReplicationStatusUnauthorized struct {
+}
+
+// NewReplicationStatusUnauthorized creates ReplicationStatusUnauthorized with default headers values
+func NewReplicationStatusUnauthorized() *ReplicationStatusUnauthorized {
+
+	return &ReplicationStatusUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationStatusUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ReplicationStatusForbiddenCode is the HTTP code returned for type ReplicationStatusForbidden
+const ReplicationStatusForbiddenCode int = 403
+
+/*
+ReplicationStatusForbidden Forbidden
+
+swagger:response replicationStatusForbidden
+*/
+type ReplicationStatusForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationStatusForbidden creates ReplicationStatusForbidden with default headers values
+func NewReplicationStatusForbidden() *ReplicationStatusForbidden {
+
+	return &ReplicationStatusForbidden{}
+}
+
+// WithPayload adds the payload to the replication status forbidden response
+func (o *ReplicationStatusForbidden) WithPayload(payload *models.ErrorResponse) *ReplicationStatusForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication status forbidden response
+func (o *ReplicationStatusForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationStatusForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationStatusNotFoundCode is the HTTP code returned for type ReplicationStatusNotFound
+const ReplicationStatusNotFoundCode int = 404
+
+/*
+ReplicationStatusNotFound Not Found - Class does not exist
+
+swagger:response replicationStatusNotFound
+*/
+type ReplicationStatusNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationStatusNotFound creates ReplicationStatusNotFound with default headers values
+func NewReplicationStatusNotFound() *ReplicationStatusNotFound {
+
+	return &ReplicationStatusNotFound{}
+}
+
+// WithPayload adds the payload to the replication status not found response
+func (o *ReplicationStatusNotFound) WithPayload(payload *models.ErrorResponse) *ReplicationStatusNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication status not found response
+func (o *ReplicationStatusNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationStatusNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationStatusInternalServerErrorCode is the HTTP code returned for type ReplicationStatusInternalServerError
+const ReplicationStatusInternalServerErrorCode int = 500
+
+/*
+ReplicationStatusInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response replicationStatusInternalServerError
+*/
+type ReplicationStatusInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationStatusInternalServerError creates ReplicationStatusInternalServerError with default headers values
+func NewReplicationStatusInternalServerError() *ReplicationStatusInternalServerError {
+
+	return &ReplicationStatusInternalServerError{}
+}
+
+// WithPayload adds the payload to the replication status internal server error response
+func (o *ReplicationStatusInternalServerError) WithPayload(payload *models.ErrorResponse) *ReplicationStatusInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication status internal server error response
+func (o *ReplicationStatusInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationStatusInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationTransferHandlerFunc turns a function with the right signature into a replication transfer handler
+type ReplicationTransferHandlerFunc func(ReplicationTransferParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ReplicationTransferHandlerFunc) Handle(params ReplicationTransferParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ReplicationTransferHandler interface for that can handle valid replication transfer params
+type ReplicationTransferHandler interface {
+	Handle(ReplicationTransferParams, *models.Principal) middleware.Responder
+}
+
+// NewReplicationTransfer creates a new http.Handler for the replication transfer operation
+func NewReplicationTransfer(ctx *middleware.Context, handler ReplicationTransferHandler) *ReplicationTransfer {
+	return &ReplicationTransfer{Context: ctx, Handler: handler}
+}
+
+/*
+	ReplicationTransfer swagger:route POST /replication/transfer replication replicationTransfer
+
+Starts to copy or move a single shard (or tenant) between nodes while it keeps serving traffic. A snapshot of the shard is transferred to the target node, the writes and deletions received in the meantime are synced through the replication layer and the shard ownership is then updated in the cluster schema. The transfer runs in the background, its state is returned by GET /replication/transfer on the node which accepted it.
+*/
+type ReplicationTransfer struct {
+	Context *middleware.Context
+	Handler ReplicationTransferHandler
+}
+
+func (o *ReplicationTransfer) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewReplicationTransferParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationTransferListHandlerFunc turns a function with the right signature into a replication transfer list handler
+type ReplicationTransferListHandlerFunc func(ReplicationTransferListParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ReplicationTransferListHandlerFunc) Handle(params ReplicationTransferListParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ReplicationTransferListHandler interface for that can handle valid replication transfer list params
+type ReplicationTransferListHandler interface {
+	Handle(ReplicationTransferListParams, *models.Principal) middleware.Responder
+}
+
+// NewReplicationTransferList creates a new http.Handler for the replication transfer list operation
+func NewReplicationTransferList(ctx *middleware.Context, handler ReplicationTransferListHandler) *ReplicationTransferList {
+	return &ReplicationTransferList{Context: ctx, Handler: handler}
+}
+
+/*
+	ReplicationTransferList swagger:route GET /replication/transfer replication replicationTransferList
+
+Returns the shard transfers started through this node, running ones and the most recent finished ones.
+*/
+type ReplicationTransferList struct {
+	Context *middleware.Context
+	Handler ReplicationTransferListHandler
+}
+
+func (o *ReplicationTransferList) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewReplicationTransferListParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
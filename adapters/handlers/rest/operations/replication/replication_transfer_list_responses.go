@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationTransferListOKCode is the HTTP code returned for type ReplicationTransferListOK
+const ReplicationTransferListOKCode int = 200
+
+/*
+ReplicationTransferListOK The shard transfers, most recent last
+
+swagger:response replicationTransferListOK
+*/
+type ReplicationTransferListOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.ShardTransferStatus `json:"body,omitempty"`
+}
+
+// NewReplicationTransferListOK creates ReplicationTransferListOK with default headers values
+func NewReplicationTransferListOK() *ReplicationTransferListOK {
+
+	return &ReplicationTransferListOK{}
+}
+
+// WithPayload adds the payload to the replication transfer list o k response
+func (o *ReplicationTransferListOK) WithPayload(payload []*models.ShardTransferStatus) *ReplicationTransferListOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication transfer list o k response
+func (o *ReplicationTransferListOK) SetPayload(payload []*models.ShardTransferStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationTransferListOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.ShardTransferStatus, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ReplicationTransferListUnauthorizedCode is the HTTP code returned for type ReplicationTransferListUnauthorized
+const ReplicationTransferListUnauthorizedCode int = 401
+
+/*
+ReplicationTransferListUnauthorized Unauthorized or invalid credentials.
+
+swagger:response replicationTransferListUnauthorized
+*/
+type ReplicationTransferListUnauthorized struct {
+}
+
+// NewReplicationTransferListUnauthorized creates ReplicationTransferListUnauthorized with default headers values
+func NewReplicationTransferListUnauthorized() *ReplicationTransferListUnauthorized {
+
+	return &ReplicationTransferListUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationTransferListUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ReplicationTransferListForbiddenCode is the HTTP code returned for type ReplicationTransferListForbidden
+const ReplicationTransferListForbiddenCode int = 403
+
+/*
+ReplicationTransferListForbidden Forbidden
+
+swagger:response replicationTransferListForbidden
+*/
+type ReplicationTransferListForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationTransferListForbidden creates ReplicationTransferListForbidden with default headers values
+func NewReplicationTransferListForbidden() *ReplicationTransferListForbidden {
+
+	return &ReplicationTransferListForbidden{}
+}
+
+// WithPayload adds the payload to the replication transfer list forbidden response
+func (o *ReplicationTransferListForbidden) WithPayload(payload *models.ErrorResponse) *ReplicationTransferListForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication transfer list forbidden response
+func (o *ReplicationTransferListForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationTransferListForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationTransferListInternalServerErrorCode is the HTTP code returned for type ReplicationTransferListInternalServerError
+const ReplicationTransferListInternalServerErrorCode int = 500
+
+/*
+ReplicationTransferListInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response replicationTransferListInternalServerError
+*/
+type ReplicationTransferListInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationTransferListInternalServerError creates ReplicationTransferListInternalServerError with default headers values
+func NewReplicationTransferListInternalServerError() *ReplicationTransferListInternalServerError {
+
+	return &ReplicationTransferListInternalServerError{}
+}
+
+// WithPayload adds the payload to the replication transfer list internal server error response
+func (o *ReplicationTransferListInternalServerError) WithPayload(payload *models.ErrorResponse) *ReplicationTransferListInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication transfer list internal server error response
+func (o *ReplicationTransferListInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationTransferListInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationTransferAcceptedCode is the HTTP code returned for type ReplicationTransferAccepted
+const ReplicationTransferAcceptedCode int = 202
+
+/*
+ReplicationTransferAccepted Shard transfer started
+
+swagger:response replicationTransferAccepted
+*/
+type ReplicationTransferAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ShardTransferStatus `json:"body,omitempty"`
+}
+
+// NewReplicationTransferAccepted creates ReplicationTransferAccepted with default headers values
+func NewReplicationTransferAccepted() *ReplicationTransferAccepted {
+
+	return &ReplicationTransferAccepted{}
+}
+
+// WithPayload adds the payload to the replication transfer accepted response
+func (o *ReplicationTransferAccepted) WithPayload(payload *models.ShardTransferStatus) *ReplicationTransferAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication transfer accepted response
+func (o *ReplicationTransferAccepted) SetPayload(payload *models.ShardTransferStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationTransferAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationTransferUnauthorizedCode is the HTTP code returned for type ReplicationTransferUnauthorized
+const ReplicationTransferUnauthorizedCode int = 401
+
+/*
+ReplicationTransferUnauthorized Unauthorized or invalid credentials.
+
+swagger:response replicationTransferUnauthorized
+*/
+type ReplicationTransferUnauthorized struct {
+}
+
+// NewReplicationTransferUnauthorized creates ReplicationTransferUnauthorized with default headers values
+func NewReplicationTransferUnauthorized() *ReplicationTransferUnauthorized {
+
+	return &ReplicationTransferUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationTransferUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ReplicationTransferForbiddenCode is the HTTP code returned for type ReplicationTransferForbidden
+const ReplicationTransferForbiddenCode int = 403
+
+/*
+ReplicationTransferForbidden Forbidden
+
+swagger:response replicationTransferForbidden
+*/
+type ReplicationTransferForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationTransferForbidden creates ReplicationTransferForbidden with default headers values
+func NewReplicationTransferForbidden() *ReplicationTransferForbidden {
+
+	return &ReplicationTransferForbidden{}
+}
+
+// WithPayload adds the payload to the replication transfer forbidden response
+func (o *ReplicationTransferForbidden) WithPayload(payload *models.ErrorResponse) *ReplicationTransferForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication transfer forbidden response
+func (o *ReplicationTransferForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationTransferForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationTransferNotFoundCode is the HTTP code returned for type ReplicationTransferNotFound
+const ReplicationTransferNotFoundCode int = 404
+
+/*
+ReplicationTransferNotFound Not Found - Class or shard does not exist
+
+swagger:response replicationTransferNotFound
+*/
+type ReplicationTransferNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationTransferNotFound creates ReplicationTransferNotFound with default headers values
+func NewReplicationTransferNotFound() *ReplicationTransferNotFound {
+
+	return &ReplicationTransferNotFound{}
+}
+
+// WithPayload adds the payload to the replication transfer not found response
+func (o *ReplicationTransferNotFound) WithPayload(payload *models.ErrorResponse) *ReplicationTransferNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication transfer not found response
+func (o *ReplicationTransferNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationTransferNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationTransferUnprocessableEntityCode is the HTTP code returned for type ReplicationTransferUnprocessableEntity
+const ReplicationTransferUnprocessableEntityCode int = 422
+
+/*
+ReplicationTransferUnprocessableEntity Invalid transfer request, e.g. the source node does not hold the shard or the target node already does.
+
+swagger:response replicationTransferUnprocessableEntity
+*/
+type ReplicationTransferUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationTransferUnprocessableEntity creates ReplicationTransferUnprocessableEntity with default headers values
+func NewReplicationTransferUnprocessableEntity() *ReplicationTransferUnprocessableEntity {
+
+	return &ReplicationTransferUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the replication transfer unprocessable entity response
+func (o *ReplicationTransferUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ReplicationTransferUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication transfer unprocessable entity response
+func (o *ReplicationTransferUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationTransferUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationTransferInternalServerErrorCode is the HTTP code returned for type ReplicationTransferInternalServerError
+const ReplicationTransferInternalServerErrorCode int = 500
+
+/*
+ReplicationTransferInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response replicationTransferInternalServerError
+*/
+type ReplicationTransferInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationTransferInternalServerError creates ReplicationTransferInternalServerError with default headers values
+func NewReplicationTransferInternalServerError() *ReplicationTransferInternalServerError {
+
+	return &ReplicationTransferInternalServerError{}
+}
+
+// WithPayload adds the payload to the replication transfer internal server error response
+func (o *ReplicationTransferInternalServerError) WithPayload(payload *models.ErrorResponse) *ReplicationTransferInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication transfer internal server error response
+func (o *ReplicationTransferInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationTransferInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorRecallHandlerFunc turns a function with the right signature into a schema objects vector recall handler
+type SchemaObjectsVectorRecallHandlerFunc func(SchemaObjectsVectorRecallParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SchemaObjectsVectorRecallHandlerFunc) Handle(params SchemaObjectsVectorRecallParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SchemaObjectsVectorRecallHandler interface for that can handle valid schema objects vector recall params
+type SchemaObjectsVectorRecallHandler interface {
+	Handle(SchemaObjectsVectorRecallParams, *models.Principal) middleware.Responder
+}
+
+// NewSchemaObjectsVectorRecall creates a new http.Handler for the schema objects vector recall operation
+func NewSchemaObjectsVectorRecall(ctx *middleware.Context, handler SchemaObjectsVectorRecallHandler) *SchemaObjectsVectorRecall {
+	return &SchemaObjectsVectorRecall{Context: ctx, Handler: handler}
+}
+
+/*
+	SchemaObjectsVectorRecall swagger:route POST /schema/{className}/vector-recall schema schemaObjectsVectorRecall
+
+# Measure the recall of a vector index
+
+Samples objects of the class and searches their vectors with the vector index and with an exact search over all vectors. Reports the recall@k of the vector index, e.g. to verify the settings of a compressed index. The exact searches read all vectors of the class, so this is expensive on large classes.
+*/
+type SchemaObjectsVectorRecall struct {
+	Context *middleware.Context
+	Handler SchemaObjectsVectorRecallHandler
+}
+
+func (o *SchemaObjectsVectorRecall) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSchemaObjectsVectorRecallParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
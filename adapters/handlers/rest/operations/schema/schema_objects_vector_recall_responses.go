@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorRecallOKCode is the HTTP code returned for type SchemaObjectsVectorRecallOK
+const SchemaObjectsVectorRecallOKCode int = 200
+
+/*
+SchemaObjectsVectorRecallOK The recall was measured
+
+swagger:response schemaObjectsVectorRecallOK
+*/
+type SchemaObjectsVectorRecallOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.VectorRecallReport `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorRecallOK creates SchemaObjectsVectorRecallOK with default headers values
+func NewSchemaObjectsVectorRecallOK() *SchemaObjectsVectorRecallOK {
+
+	return &SchemaObjectsVectorRecallOK{}
+}
+
+// WithPayload adds the payload to the schema objects vector recall o k response
+func (o *SchemaObjectsVectorRecallOK) WithPayload(payload *models.VectorRecallReport) *SchemaObjectsVectorRecallOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector recall o k response
+func (o *SchemaObjectsVectorRecallOK) SetPayload(payload *models.VectorRecallReport) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorRecallOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorRecallUnauthorizedCode is the HTTP code returned for type SchemaObjectsVectorRecallUnauthorized
+const SchemaObjectsVectorRecallUnauthorizedCode int = 401
+
+/*
+SchemaObjectsVectorRecallUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaObjectsVectorRecallUnauthorized
+*/
+type SchemaObjectsVectorRecallUnauthorized struct {
+}
+
+// NewSchemaObjectsVectorRecallUnauthorized creates SchemaObjectsVectorRecallUnauthorized with default headers values
+func NewSchemaObjectsVectorRecallUnauthorized() *SchemaObjectsVectorRecallUnauthorized {
+
+	return &SchemaObjectsVectorRecallUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorRecallUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaObjectsVectorRecallForbiddenCode is the HTTP code returned for type SchemaObjectsVectorRecallForbidden
+const SchemaObjectsVectorRecallForbiddenCode int = 403
+
+/*
+SchemaObjectsVectorRecallForbidden Forbidden
+
+swagger:response schemaObjectsVectorRecallForbidden
+*/
+type SchemaObjectsVectorRecallForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorRecallForbidden creates SchemaObjectsVectorRecallForbidden with default headers values
+func NewSchemaObjectsVectorRecallForbidden() *SchemaObjectsVectorRecallForbidden {
+
+	return &SchemaObjectsVectorRecallForbidden{}
+}
+
+// WithPayload adds the payload to the schema objects vector recall forbidden response
+func (o *SchemaObjectsVectorRecallForbidden) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorRecallForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector recall forbidden response
+func (o *SchemaObjectsVectorRecallForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorRecallForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorRecallNotFoundCode is the HTTP code returned for type SchemaObjectsVectorRecallNotFound
+const SchemaObjectsVectorRecallNotFoundCode int = 404
+
+/*
+SchemaObjectsVectorRecallNotFound This class does not exist
+
+swagger:response schemaObjectsVectorRecallNotFound
+*/
+type SchemaObjectsVectorRecallNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorRecallNotFound creates SchemaObjectsVectorRecallNotFound with default headers values
+func NewSchemaObjectsVectorRecallNotFound() *SchemaObjectsVectorRecallNotFound {
+
+	return &SchemaObjectsVectorRecallNotFound{}
+}
+
+// WithPayload adds the payload to the schema objects vector recall not found response
+func (o *SchemaObjectsVectorRecallNotFound) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorRecallNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector recall not found response
+func (o *SchemaObjectsVectorRecallNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorRecallNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorRecallUnprocessableEntityCode is the HTTP code returned for type SchemaObjectsVectorRecallUnprocessableEntity
+const SchemaObjectsVectorRecallUnprocessableEntityCode int = 422
+
+/*
+SchemaObjectsVectorRecallUnprocessableEntity Invalid target vector, tenant or parameters
+
+swagger:response schemaObjectsVectorRecallUnprocessableEntity
+*/
+type SchemaObjectsVectorRecallUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorRecallUnprocessableEntity creates SchemaObjectsVectorRecallUnprocessableEntity with default headers values
+func NewSchemaObjectsVectorRecallUnprocessableEntity() *SchemaObjectsVectorRecallUnprocessableEntity {
+
+	return &SchemaObjectsVectorRecallUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the schema objects vector recall unprocessable entity response
+func (o *SchemaObjectsVectorRecallUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorRecallUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector recall unprocessable entity response
+func (o *SchemaObjectsVectorRecallUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorRecallUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorRecallInternalServerErrorCode is the HTTP code returned for type SchemaObjectsVectorRecallInternalServerError
+const SchemaObjectsVectorRecallInternalServerErrorCode int = 500
+
+/*
+SchemaObjectsVectorRecallInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaObjectsVectorRecallInternalServerError
+*/
+type SchemaObjectsVectorRecallInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorRecallInternalServerError creates SchemaObjectsVectorRecallInternalServerError with default headers values
+func NewSchemaObjectsVectorRecallInternalServerError() *SchemaObjectsVectorRecallInternalServerError {
+
+	return &SchemaObjectsVectorRecallInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema objects vector recall internal server error response
+func (o *SchemaObjectsVectorRecallInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorRecallInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector recall internal server error response
+func (o *SchemaObjectsVectorRecallInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorRecallInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorReindexCancelHandlerFunc turns a function with the right signature into a schema objects vector reindex cancel handler
+type SchemaObjectsVectorReindexCancelHandlerFunc func(SchemaObjectsVectorReindexCancelParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SchemaObjectsVectorReindexCancelHandlerFunc) Handle(params SchemaObjectsVectorReindexCancelParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SchemaObjectsVectorReindexCancelHandler interface for that can handle valid schema objects vector reindex cancel params
+type SchemaObjectsVectorReindexCancelHandler interface {
+	Handle(SchemaObjectsVectorReindexCancelParams, *models.Principal) middleware.Responder
+}
+
+// NewSchemaObjectsVectorReindexCancel creates a new http.Handler for the schema objects vector reindex cancel operation
+func NewSchemaObjectsVectorReindexCancel(ctx *middleware.Context, handler SchemaObjectsVectorReindexCancelHandler) *SchemaObjectsVectorReindexCancel {
+	return &SchemaObjectsVectorReindexCancel{Context: ctx, Handler: handler}
+}
+
+/*
+	SchemaObjectsVectorReindexCancel swagger:route DELETE /schema/{className}/vector-reindex schema schemaObjectsVectorReindexCancel
+
+# Cancel a vector reindexing job
+
+Stops the vector reindexing job of the target vector and removes the vectors computed so far. The class keeps its current vectorizer.
+*/
+type SchemaObjectsVectorReindexCancel struct {
+	Context *middleware.Context
+	Handler SchemaObjectsVectorReindexCancelHandler
+}
+
+func (o *SchemaObjectsVectorReindexCancel) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSchemaObjectsVectorReindexCancelParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
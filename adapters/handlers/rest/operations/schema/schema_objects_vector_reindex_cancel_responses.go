@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorReindexCancelOKCode is the HTTP code returned for type SchemaObjectsVectorReindexCancelOK
+const SchemaObjectsVectorReindexCancelOKCode int = 200
+
+/*
+SchemaObjectsVectorReindexCancelOK The job was cancelled
+
+swagger:response schemaObjectsVectorReindexCancelOK
+*/
+type SchemaObjectsVectorReindexCancelOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.VectorReindexStatus `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexCancelOK creates SchemaObjectsVectorReindexCancelOK with default headers values
+func NewSchemaObjectsVectorReindexCancelOK() *SchemaObjectsVectorReindexCancelOK {
+
+	return &SchemaObjectsVectorReindexCancelOK{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex cancel o k response
+func (o *SchemaObjectsVectorReindexCancelOK) WithPayload(payload *models.VectorReindexStatus) *SchemaObjectsVectorReindexCancelOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex cancel o k response
+func (o *SchemaObjectsVectorReindexCancelOK) SetPayload(payload *models.VectorReindexStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexCancelOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexCancelUnauthorizedCode is the HTTP code returned for type SchemaObjectsVectorReindexCancelUnauthorized
+const SchemaObjectsVectorReindexCancelUnauthorizedCode int = 401
+
+/*
+SchemaObjectsVectorReindexCancelUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaObjectsVectorReindexCancelUnauthorized
+*/
+type SchemaObjectsVectorReindexCancelUnauthorized struct {
+}
+
+// NewSchemaObjectsVectorReindexCancelUnauthorized creates SchemaObjectsVectorReindexCancelUnauthorized with default headers values
+func NewSchemaObjectsVectorReindexCancelUnauthorized() *SchemaObjectsVectorReindexCancelUnauthorized {
+
+	return &SchemaObjectsVectorReindexCancelUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexCancelUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaObjectsVectorReindexCancelForbiddenCode is the HTTP code returned for type SchemaObjectsVectorReindexCancelForbidden
+const SchemaObjectsVectorReindexCancelForbiddenCode int = 403
+
+/*
+SchemaObjectsVectorReindexCancelForbidden Forbidden
+
+swagger:response schemaObjectsVectorReindexCancelForbidden
+*/
+type SchemaObjectsVectorReindexCancelForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexCancelForbidden creates SchemaObjectsVectorReindexCancelForbidden with default headers values
+func NewSchemaObjectsVectorReindexCancelForbidden() *SchemaObjectsVectorReindexCancelForbidden {
+
+	return &SchemaObjectsVectorReindexCancelForbidden{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex cancel forbidden response
+func (o *SchemaObjectsVectorReindexCancelForbidden) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexCancelForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex cancel forbidden response
+func (o *SchemaObjectsVectorReindexCancelForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexCancelForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexCancelNotFoundCode is the HTTP code returned for type SchemaObjectsVectorReindexCancelNotFound
+const SchemaObjectsVectorReindexCancelNotFoundCode int = 404
+
+/*
+SchemaObjectsVectorReindexCancelNotFound There is no job in progress for the target vector
+
+swagger:response schemaObjectsVectorReindexCancelNotFound
+*/
+type SchemaObjectsVectorReindexCancelNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexCancelNotFound creates SchemaObjectsVectorReindexCancelNotFound with default headers values
+func NewSchemaObjectsVectorReindexCancelNotFound() *SchemaObjectsVectorReindexCancelNotFound {
+
+	return &SchemaObjectsVectorReindexCancelNotFound{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex cancel not found response
+func (o *SchemaObjectsVectorReindexCancelNotFound) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexCancelNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex cancel not found response
+func (o *SchemaObjectsVectorReindexCancelNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexCancelNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexCancelUnprocessableEntityCode is the HTTP code returned for type SchemaObjectsVectorReindexCancelUnprocessableEntity
+const SchemaObjectsVectorReindexCancelUnprocessableEntityCode int = 422
+
+/*
+SchemaObjectsVectorReindexCancelUnprocessableEntity The job can not be cancelled in its current status
+
+swagger:response schemaObjectsVectorReindexCancelUnprocessableEntity
+*/
+type SchemaObjectsVectorReindexCancelUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexCancelUnprocessableEntity creates SchemaObjectsVectorReindexCancelUnprocessableEntity with default headers values
+func NewSchemaObjectsVectorReindexCancelUnprocessableEntity() *SchemaObjectsVectorReindexCancelUnprocessableEntity {
+
+	return &SchemaObjectsVectorReindexCancelUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex cancel unprocessable entity response
+func (o *SchemaObjectsVectorReindexCancelUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexCancelUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex cancel unprocessable entity response
+func (o *SchemaObjectsVectorReindexCancelUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexCancelUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexCancelInternalServerErrorCode is the HTTP code returned for type SchemaObjectsVectorReindexCancelInternalServerError
+const SchemaObjectsVectorReindexCancelInternalServerErrorCode int = 500
+
+/*
+SchemaObjectsVectorReindexCancelInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaObjectsVectorReindexCancelInternalServerError
+*/
+type SchemaObjectsVectorReindexCancelInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexCancelInternalServerError creates SchemaObjectsVectorReindexCancelInternalServerError with default headers values
+func NewSchemaObjectsVectorReindexCancelInternalServerError() *SchemaObjectsVectorReindexCancelInternalServerError {
+
+	return &SchemaObjectsVectorReindexCancelInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex cancel internal server error response
+func (o *SchemaObjectsVectorReindexCancelInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexCancelInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex cancel internal server error response
+func (o *SchemaObjectsVectorReindexCancelInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexCancelInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorReindexGetHandlerFunc turns a function with the right signature into a schema objects vector reindex get handler
+type SchemaObjectsVectorReindexGetHandlerFunc func(SchemaObjectsVectorReindexGetParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SchemaObjectsVectorReindexGetHandlerFunc) Handle(params SchemaObjectsVectorReindexGetParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SchemaObjectsVectorReindexGetHandler interface for that can handle valid schema objects vector reindex get params
+type SchemaObjectsVectorReindexGetHandler interface {
+	Handle(SchemaObjectsVectorReindexGetParams, *models.Principal) middleware.Responder
+}
+
+// NewSchemaObjectsVectorReindexGet creates a new http.Handler for the schema objects vector reindex get operation
+func NewSchemaObjectsVectorReindexGet(ctx *middleware.Context, handler SchemaObjectsVectorReindexGetHandler) *SchemaObjectsVectorReindexGet {
+	return &SchemaObjectsVectorReindexGet{Context: ctx, Handler: handler}
+}
+
+/*
+	SchemaObjectsVectorReindexGet swagger:route GET /schema/{className}/vector-reindex schema schemaObjectsVectorReindexGet
+
+# Get the progress of a vector reindexing job
+
+Returns the last vector reindexing job of the target vector, and its progress on the nodes while it is in progress.
+*/
+type SchemaObjectsVectorReindexGet struct {
+	Context *middleware.Context
+	Handler SchemaObjectsVectorReindexGetHandler
+}
+
+func (o *SchemaObjectsVectorReindexGet) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSchemaObjectsVectorReindexGetParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
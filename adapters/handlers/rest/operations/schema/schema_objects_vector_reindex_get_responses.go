@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorReindexGetOKCode is the HTTP code returned for type SchemaObjectsVectorReindexGetOK
+const SchemaObjectsVectorReindexGetOKCode int = 200
+
+/*
+SchemaObjectsVectorReindexGetOK Found the job, returned as body
+
+swagger:response schemaObjectsVectorReindexGetOK
+*/
+type SchemaObjectsVectorReindexGetOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.VectorReindexStatus `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexGetOK creates SchemaObjectsVectorReindexGetOK with default headers values
+func NewSchemaObjectsVectorReindexGetOK() *SchemaObjectsVectorReindexGetOK {
+
+	return &SchemaObjectsVectorReindexGetOK{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex get o k response
+func (o *SchemaObjectsVectorReindexGetOK) WithPayload(payload *models.VectorReindexStatus) *SchemaObjectsVectorReindexGetOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex get o k response
+func (o *SchemaObjectsVectorReindexGetOK) SetPayload(payload *models.VectorReindexStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexGetOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexGetUnauthorizedCode is the HTTP code returned for type SchemaObjectsVectorReindexGetUnauthorized
+const SchemaObjectsVectorReindexGetUnauthorizedCode int = 401
+
+/*
+SchemaObjectsVectorReindexGetUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaObjectsVectorReindexGetUnauthorized
+*/
+type SchemaObjectsVectorReindexGetUnauthorized struct {
+}
+
+// NewSchemaObjectsVectorReindexGetUnauthorized creates SchemaObjectsVectorReindexGetUnauthorized with default headers values
+func NewSchemaObjectsVectorReindexGetUnauthorized() *SchemaObjectsVectorReindexGetUnauthorized {
+
+	return &SchemaObjectsVectorReindexGetUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexGetUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaObjectsVectorReindexGetForbiddenCode is the HTTP code returned for type SchemaObjectsVectorReindexGetForbidden
+const SchemaObjectsVectorReindexGetForbiddenCode int = 403
+
+/*
+SchemaObjectsVectorReindexGetForbidden Forbidden
+
+swagger:response schemaObjectsVectorReindexGetForbidden
+*/
+type SchemaObjectsVectorReindexGetForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexGetForbidden creates SchemaObjectsVectorReindexGetForbidden with default headers values
+func NewSchemaObjectsVectorReindexGetForbidden() *SchemaObjectsVectorReindexGetForbidden {
+
+	return &SchemaObjectsVectorReindexGetForbidden{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex get forbidden response
+func (o *SchemaObjectsVectorReindexGetForbidden) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexGetForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex get forbidden response
+func (o *SchemaObjectsVectorReindexGetForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexGetForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexGetNotFoundCode is the HTTP code returned for type SchemaObjectsVectorReindexGetNotFound
+const SchemaObjectsVectorReindexGetNotFoundCode int = 404
+
+/*
+SchemaObjectsVectorReindexGetNotFound This class does not exist, or its target vector was never reindexed
+
+swagger:response schemaObjectsVectorReindexGetNotFound
+*/
+type SchemaObjectsVectorReindexGetNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexGetNotFound creates SchemaObjectsVectorReindexGetNotFound with default headers values
+func NewSchemaObjectsVectorReindexGetNotFound() *SchemaObjectsVectorReindexGetNotFound {
+
+	return &SchemaObjectsVectorReindexGetNotFound{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex get not found response
+func (o *SchemaObjectsVectorReindexGetNotFound) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexGetNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex get not found response
+func (o *SchemaObjectsVectorReindexGetNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexGetNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexGetInternalServerErrorCode is the HTTP code returned for type SchemaObjectsVectorReindexGetInternalServerError
+const SchemaObjectsVectorReindexGetInternalServerErrorCode int = 500
+
+/*
+SchemaObjectsVectorReindexGetInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaObjectsVectorReindexGetInternalServerError
+*/
+type SchemaObjectsVectorReindexGetInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexGetInternalServerError creates SchemaObjectsVectorReindexGetInternalServerError with default headers values
+func NewSchemaObjectsVectorReindexGetInternalServerError() *SchemaObjectsVectorReindexGetInternalServerError {
+
+	return &SchemaObjectsVectorReindexGetInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex get internal server error response
+func (o *SchemaObjectsVectorReindexGetInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexGetInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex get internal server error response
+func (o *SchemaObjectsVectorReindexGetInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexGetInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorReindexPauseHandlerFunc turns a function with the right signature into a schema objects vector reindex pause handler
+type SchemaObjectsVectorReindexPauseHandlerFunc func(SchemaObjectsVectorReindexPauseParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SchemaObjectsVectorReindexPauseHandlerFunc) Handle(params SchemaObjectsVectorReindexPauseParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SchemaObjectsVectorReindexPauseHandler interface for that can handle valid schema objects vector reindex pause params
+type SchemaObjectsVectorReindexPauseHandler interface {
+	Handle(SchemaObjectsVectorReindexPauseParams, *models.Principal) middleware.Responder
+}
+
+// NewSchemaObjectsVectorReindexPause creates a new http.Handler for the schema objects vector reindex pause operation
+func NewSchemaObjectsVectorReindexPause(ctx *middleware.Context, handler SchemaObjectsVectorReindexPauseHandler) *SchemaObjectsVectorReindexPause {
+	return &SchemaObjectsVectorReindexPause{Context: ctx, Handler: handler}
+}
+
+/*
+	SchemaObjectsVectorReindexPause swagger:route POST /schema/{className}/vector-reindex/pause schema schemaObjectsVectorReindexPause
+
+# Pause a vector reindexing job
+
+Stops vectorizing objects until the job is resumed. Writes to the class are still vectorized for the new vector index.
+*/
+type SchemaObjectsVectorReindexPause struct {
+	Context *middleware.Context
+	Handler SchemaObjectsVectorReindexPauseHandler
+}
+
+func (o *SchemaObjectsVectorReindexPause) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSchemaObjectsVectorReindexPauseParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorReindexPauseOKCode is the HTTP code returned for type SchemaObjectsVectorReindexPauseOK
+const SchemaObjectsVectorReindexPauseOKCode int = 200
+
+/*
+SchemaObjectsVectorReindexPauseOK The job was paused
+
+swagger:response schemaObjectsVectorReindexPauseOK
+*/
+type SchemaObjectsVectorReindexPauseOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.VectorReindexStatus `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexPauseOK creates SchemaObjectsVectorReindexPauseOK with default headers values
+func NewSchemaObjectsVectorReindexPauseOK() *SchemaObjectsVectorReindexPauseOK {
+
+	return &SchemaObjectsVectorReindexPauseOK{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex pause o k response
+func (o *SchemaObjectsVectorReindexPauseOK) WithPayload(payload *models.VectorReindexStatus) *SchemaObjectsVectorReindexPauseOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex pause o k response
+func (o *SchemaObjectsVectorReindexPauseOK) SetPayload(payload *models.VectorReindexStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexPauseOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexPauseUnauthorizedCode is the HTTP code returned for type SchemaObjectsVectorReindexPauseUnauthorized
+const SchemaObjectsVectorReindexPauseUnauthorizedCode int = 401
+
+/*
+SchemaObjectsVectorReindexPauseUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaObjectsVectorReindexPauseUnauthorized
+*/
+type SchemaObjectsVectorReindexPauseUnauthorized struct {
+}
+
+// NewSchemaObjectsVectorReindexPauseUnauthorized creates SchemaObjectsVectorReindexPauseUnauthorized with default headers values
+func NewSchemaObjectsVectorReindexPauseUnauthorized() *SchemaObjectsVectorReindexPauseUnauthorized {
+
+	return &SchemaObjectsVectorReindexPauseUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexPauseUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaObjectsVectorReindexPauseForbiddenCode is the HTTP code returned for type SchemaObjectsVectorReindexPauseForbidden
+const SchemaObjectsVectorReindexPauseForbiddenCode int = 403
+
+/*
+SchemaObjectsVectorReindexPauseForbidden Forbidden
+
+swagger:response schemaObjectsVectorReindexPauseForbidden
+*/
+type SchemaObjectsVectorReindexPauseForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexPauseForbidden creates SchemaObjectsVectorReindexPauseForbidden with default headers values
+func NewSchemaObjectsVectorReindexPauseForbidden() *SchemaObjectsVectorReindexPauseForbidden {
+
+	return &SchemaObjectsVectorReindexPauseForbidden{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex pause forbidden response
+func (o *SchemaObjectsVectorReindexPauseForbidden) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexPauseForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex pause forbidden response
+func (o *SchemaObjectsVectorReindexPauseForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexPauseForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexPauseNotFoundCode is the HTTP code returned for type SchemaObjectsVectorReindexPauseNotFound
+const SchemaObjectsVectorReindexPauseNotFoundCode int = 404
+
+/*
+SchemaObjectsVectorReindexPauseNotFound There is no job in progress for the target vector
+
+swagger:response schemaObjectsVectorReindexPauseNotFound
+*/
+type SchemaObjectsVectorReindexPauseNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexPauseNotFound creates SchemaObjectsVectorReindexPauseNotFound with default headers values
+func NewSchemaObjectsVectorReindexPauseNotFound() *SchemaObjectsVectorReindexPauseNotFound {
+
+	return &SchemaObjectsVectorReindexPauseNotFound{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex pause not found response
+func (o *SchemaObjectsVectorReindexPauseNotFound) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexPauseNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex pause not found response
+func (o *SchemaObjectsVectorReindexPauseNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexPauseNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexPauseUnprocessableEntityCode is the HTTP code returned for type SchemaObjectsVectorReindexPauseUnprocessableEntity
+const SchemaObjectsVectorReindexPauseUnprocessableEntityCode int = 422
+
+/*
+SchemaObjectsVectorReindexPauseUnprocessableEntity The job is not running
+
+swagger:response schemaObjectsVectorReindexPauseUnprocessableEntity
+*/
+type SchemaObjectsVectorReindexPauseUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexPauseUnprocessableEntity creates SchemaObjectsVectorReindexPauseUnprocessableEntity with default headers values
+func NewSchemaObjectsVectorReindexPauseUnprocessableEntity() *SchemaObjectsVectorReindexPauseUnprocessableEntity {
+
+	return &SchemaObjectsVectorReindexPauseUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex pause unprocessable entity response
+func (o *SchemaObjectsVectorReindexPauseUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexPauseUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex pause unprocessable entity response
+func (o *SchemaObjectsVectorReindexPauseUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexPauseUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexPauseInternalServerErrorCode is the HTTP code returned for type SchemaObjectsVectorReindexPauseInternalServerError
+const SchemaObjectsVectorReindexPauseInternalServerErrorCode int = 500
+
+/*
+SchemaObjectsVectorReindexPauseInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaObjectsVectorReindexPauseInternalServerError
+*/
+type SchemaObjectsVectorReindexPauseInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexPauseInternalServerError creates SchemaObjectsVectorReindexPauseInternalServerError with default headers values
+func NewSchemaObjectsVectorReindexPauseInternalServerError() *SchemaObjectsVectorReindexPauseInternalServerError {
+
+	return &SchemaObjectsVectorReindexPauseInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex pause internal server error response
+func (o *SchemaObjectsVectorReindexPauseInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexPauseInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex pause internal server error response
+func (o *SchemaObjectsVectorReindexPauseInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexPauseInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
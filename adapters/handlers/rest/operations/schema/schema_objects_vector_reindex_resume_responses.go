@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorReindexResumeOKCode is the HTTP code returned for type SchemaObjectsVectorReindexResumeOK
+const SchemaObjectsVectorReindexResumeOKCode int = 200
+
+/*
+SchemaObjectsVectorReindexResumeOK The job was resumed
+
+swagger:response schemaObjectsVectorReindexResumeOK
+*/
+type SchemaObjectsVectorReindexResumeOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.VectorReindexStatus `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexResumeOK creates SchemaObjectsVectorReindexResumeOK with default headers values
+func NewSchemaObjectsVectorReindexResumeOK() *SchemaObjectsVectorReindexResumeOK {
+
+	return &SchemaObjectsVectorReindexResumeOK{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex resume o k response
+func (o *SchemaObjectsVectorReindexResumeOK) WithPayload(payload *models.VectorReindexStatus) *SchemaObjectsVectorReindexResumeOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex resume o k response
+func (o *SchemaObjectsVectorReindexResumeOK) SetPayload(payload *models.VectorReindexStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexResumeOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexResumeUnauthorizedCode is the HTTP code returned for type SchemaObjectsVectorReindexResumeUnauthorized
+const SchemaObjectsVectorReindexResumeUnauthorizedCode int = 401
+
+/*
+SchemaObjectsVectorReindexResumeUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaObjectsVectorReindexResumeUnauthorized
+*/
+type SchemaObjectsVectorReindexResumeUnauthorized struct {
+}
+
+// NewSchemaObjectsVectorReindexResumeUnauthorized creates SchemaObjectsVectorReindexResumeUnauthorized with default headers values
+func NewSchemaObjectsVectorReindexResumeUnauthorized() *SchemaObjectsVectorReindexResumeUnauthorized {
+
+	return &SchemaObjectsVectorReindexResumeUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexResumeUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaObjectsVectorReindexResumeForbiddenCode is the HTTP code returned for type SchemaObjectsVectorReindexResumeForbidden
+const SchemaObjectsVectorReindexResumeForbiddenCode int = 403
+
+/*
+SchemaObjectsVectorReindexResumeForbidden Forbidden
+
+swagger:response schemaObjectsVectorReindexResumeForbidden
+*/
+type SchemaObjectsVectorReindexResumeForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexResumeForbidden creates SchemaObjectsVectorReindexResumeForbidden with default headers values
+func NewSchemaObjectsVectorReindexResumeForbidden() *SchemaObjectsVectorReindexResumeForbidden {
+
+	return &SchemaObjectsVectorReindexResumeForbidden{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex resume forbidden response
+func (o *SchemaObjectsVectorReindexResumeForbidden) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexResumeForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex resume forbidden response
+func (o *SchemaObjectsVectorReindexResumeForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexResumeForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexResumeNotFoundCode is the HTTP code returned for type SchemaObjectsVectorReindexResumeNotFound
+const SchemaObjectsVectorReindexResumeNotFoundCode int = 404
+
+/*
+SchemaObjectsVectorReindexResumeNotFound There is no job in progress for the target vector
+
+swagger:response schemaObjectsVectorReindexResumeNotFound
+*/
+type SchemaObjectsVectorReindexResumeNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexResumeNotFound creates SchemaObjectsVectorReindexResumeNotFound with default headers values
+func NewSchemaObjectsVectorReindexResumeNotFound() *SchemaObjectsVectorReindexResumeNotFound {
+
+	return &SchemaObjectsVectorReindexResumeNotFound{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex resume not found response
+func (o *SchemaObjectsVectorReindexResumeNotFound) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexResumeNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex resume not found response
+func (o *SchemaObjectsVectorReindexResumeNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexResumeNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexResumeUnprocessableEntityCode is the HTTP code returned for type SchemaObjectsVectorReindexResumeUnprocessableEntity
+const SchemaObjectsVectorReindexResumeUnprocessableEntityCode int = 422
+
+/*
+SchemaObjectsVectorReindexResumeUnprocessableEntity The job is not paused
+
+swagger:response schemaObjectsVectorReindexResumeUnprocessableEntity
+*/
+type SchemaObjectsVectorReindexResumeUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexResumeUnprocessableEntity creates SchemaObjectsVectorReindexResumeUnprocessableEntity with default headers values
+func NewSchemaObjectsVectorReindexResumeUnprocessableEntity() *SchemaObjectsVectorReindexResumeUnprocessableEntity {
+
+	return &SchemaObjectsVectorReindexResumeUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex resume unprocessable entity response
+func (o *SchemaObjectsVectorReindexResumeUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexResumeUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex resume unprocessable entity response
+func (o *SchemaObjectsVectorReindexResumeUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexResumeUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexResumeInternalServerErrorCode is the HTTP code returned for type SchemaObjectsVectorReindexResumeInternalServerError
+const SchemaObjectsVectorReindexResumeInternalServerErrorCode int = 500
+
+/*
+SchemaObjectsVectorReindexResumeInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaObjectsVectorReindexResumeInternalServerError
+*/
+type SchemaObjectsVectorReindexResumeInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexResumeInternalServerError creates SchemaObjectsVectorReindexResumeInternalServerError with default headers values
+func NewSchemaObjectsVectorReindexResumeInternalServerError() *SchemaObjectsVectorReindexResumeInternalServerError {
+
+	return &SchemaObjectsVectorReindexResumeInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex resume internal server error response
+func (o *SchemaObjectsVectorReindexResumeInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexResumeInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex resume internal server error response
+func (o *SchemaObjectsVectorReindexResumeInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexResumeInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
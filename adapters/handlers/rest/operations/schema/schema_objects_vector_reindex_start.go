@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorReindexStartHandlerFunc turns a function with the right signature into a schema objects vector reindex start handler
+type SchemaObjectsVectorReindexStartHandlerFunc func(SchemaObjectsVectorReindexStartParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SchemaObjectsVectorReindexStartHandlerFunc) Handle(params SchemaObjectsVectorReindexStartParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SchemaObjectsVectorReindexStartHandler interface for that can handle valid schema objects vector reindex start params
+type SchemaObjectsVectorReindexStartHandler interface {
+	Handle(SchemaObjectsVectorReindexStartParams, *models.Principal) middleware.Responder
+}
+
+// NewSchemaObjectsVectorReindexStart creates a new http.Handler for the schema objects vector reindex start operation
+func NewSchemaObjectsVectorReindexStart(ctx *middleware.Context, handler SchemaObjectsVectorReindexStartHandler) *SchemaObjectsVectorReindexStart {
+	return &SchemaObjectsVectorReindexStart{Context: ctx, Handler: handler}
+}
+
+/*
+	SchemaObjectsVectorReindexStart swagger:route POST /schema/{className}/vector-reindex schema schemaObjectsVectorReindexStart
+
+# Recompute the vectors of a target vector
+
+Starts recomputing the vectors of all objects of the class with the given vectorizer module. The vectors are written into a new vector index, the current vectors are used for queries until the job completed. Afterwards the class uses the new vectorizer. Not supported for multi-tenant classes.
+*/
+type SchemaObjectsVectorReindexStart struct {
+	Context *middleware.Context
+	Handler SchemaObjectsVectorReindexStartHandler
+}
+
+func (o *SchemaObjectsVectorReindexStart) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSchemaObjectsVectorReindexStartParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
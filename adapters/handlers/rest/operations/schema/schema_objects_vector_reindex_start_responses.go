@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorReindexStartOKCode is the HTTP code returned for type SchemaObjectsVectorReindexStartOK
+const SchemaObjectsVectorReindexStartOKCode int = 200
+
+/*
+SchemaObjectsVectorReindexStartOK The job was started
+
+swagger:response schemaObjectsVectorReindexStartOK
+*/
+type SchemaObjectsVectorReindexStartOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.VectorReindexStatus `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexStartOK creates SchemaObjectsVectorReindexStartOK with default headers values
+func NewSchemaObjectsVectorReindexStartOK() *SchemaObjectsVectorReindexStartOK {
+
+	return &SchemaObjectsVectorReindexStartOK{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex start o k response
+func (o *SchemaObjectsVectorReindexStartOK) WithPayload(payload *models.VectorReindexStatus) *SchemaObjectsVectorReindexStartOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex start o k response
+func (o *SchemaObjectsVectorReindexStartOK) SetPayload(payload *models.VectorReindexStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexStartOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexStartUnauthorizedCode is the HTTP code returned for type SchemaObjectsVectorReindexStartUnauthorized
+const SchemaObjectsVectorReindexStartUnauthorizedCode int = 401
+
+/*
+SchemaObjectsVectorReindexStartUnauthorized Unauthorized or invalid credentials.
+
+swagger:response schemaObjectsVectorReindexStartUnauthorized
+*/
+type SchemaObjectsVectorReindexStartUnauthorized struct {
+}
+
+// NewSchemaObjectsVectorReindexStartUnauthorized creates SchemaObjectsVectorReindexStartUnauthorized with default headers values
+func NewSchemaObjectsVectorReindexStartUnauthorized() *SchemaObjectsVectorReindexStartUnauthorized {
+
+	return &SchemaObjectsVectorReindexStartUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexStartUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// SchemaObjectsVectorReindexStartForbiddenCode is the HTTP code returned for type SchemaObjectsVectorReindexStartForbidden
+const SchemaObjectsVectorReindexStartForbiddenCode int = 403
+
+/*
+SchemaObjectsVectorReindexStartForbidden Forbidden
+
+swagger:response schemaObjectsVectorReindexStartForbidden
+*/
+type SchemaObjectsVectorReindexStartForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexStartForbidden creates SchemaObjectsVectorReindexStartForbidden with default headers values
+func NewSchemaObjectsVectorReindexStartForbidden() *SchemaObjectsVectorReindexStartForbidden {
+
+	return &SchemaObjectsVectorReindexStartForbidden{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex start forbidden response
+func (o *SchemaObjectsVectorReindexStartForbidden) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexStartForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex start forbidden response
+func (o *SchemaObjectsVectorReindexStartForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexStartForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexStartNotFoundCode is the HTTP code returned for type SchemaObjectsVectorReindexStartNotFound
+const SchemaObjectsVectorReindexStartNotFoundCode int = 404
+
+/*
+SchemaObjectsVectorReindexStartNotFound This class does not exist
+
+swagger:response schemaObjectsVectorReindexStartNotFound
+*/
+type SchemaObjectsVectorReindexStartNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexStartNotFound creates SchemaObjectsVectorReindexStartNotFound with default headers values
+func NewSchemaObjectsVectorReindexStartNotFound() *SchemaObjectsVectorReindexStartNotFound {
+
+	return &SchemaObjectsVectorReindexStartNotFound{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex start not found response
+func (o *SchemaObjectsVectorReindexStartNotFound) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexStartNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex start not found response
+func (o *SchemaObjectsVectorReindexStartNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexStartNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexStartUnprocessableEntityCode is the HTTP code returned for type SchemaObjectsVectorReindexStartUnprocessableEntity
+const SchemaObjectsVectorReindexStartUnprocessableEntityCode int = 422
+
+/*
+SchemaObjectsVectorReindexStartUnprocessableEntity Invalid vectorizer or module config, or a job for the target vector is in progress already
+
+swagger:response schemaObjectsVectorReindexStartUnprocessableEntity
+*/
+type SchemaObjectsVectorReindexStartUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexStartUnprocessableEntity creates SchemaObjectsVectorReindexStartUnprocessableEntity with default headers values
+func NewSchemaObjectsVectorReindexStartUnprocessableEntity() *SchemaObjectsVectorReindexStartUnprocessableEntity {
+
+	return &SchemaObjectsVectorReindexStartUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex start unprocessable entity response
+func (o *SchemaObjectsVectorReindexStartUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexStartUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex start unprocessable entity response
+func (o *SchemaObjectsVectorReindexStartUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexStartUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SchemaObjectsVectorReindexStartInternalServerErrorCode is the HTTP code returned for type SchemaObjectsVectorReindexStartInternalServerError
+const SchemaObjectsVectorReindexStartInternalServerErrorCode int = 500
+
+/*
+SchemaObjectsVectorReindexStartInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response schemaObjectsVectorReindexStartInternalServerError
+*/
+type SchemaObjectsVectorReindexStartInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSchemaObjectsVectorReindexStartInternalServerError creates SchemaObjectsVectorReindexStartInternalServerError with default headers values
+func NewSchemaObjectsVectorReindexStartInternalServerError() *SchemaObjectsVectorReindexStartInternalServerError {
+
+	return &SchemaObjectsVectorReindexStartInternalServerError{}
+}
+
+// WithPayload adds the payload to the schema objects vector reindex start internal server error response
+func (o *SchemaObjectsVectorReindexStartInternalServerError) WithPayload(payload *models.ErrorResponse) *SchemaObjectsVectorReindexStartInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the schema objects vector reindex start internal server error response
+func (o *SchemaObjectsVectorReindexStartInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SchemaObjectsVectorReindexStartInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
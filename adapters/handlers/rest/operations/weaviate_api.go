@@ -39,6 +39,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/meta"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/nodes"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/replication"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/well_known"
 	"github.com/weaviate/weaviate/entities/models"
@@ -88,6 +89,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		BatchBatchObjectsDeleteHandler: batch.BatchObjectsDeleteHandlerFunc(func(params batch.BatchObjectsDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchObjectsDelete has not yet been implemented")
 		}),
+		BatchBatchObjectsUpdateHandler: batch.BatchObjectsUpdateHandlerFunc(func(params batch.BatchObjectsUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch.BatchObjectsUpdate has not yet been implemented")
+		}),
 		BatchBatchReferencesCreateHandler: batch.BatchReferencesCreateHandlerFunc(func(params batch.BatchReferencesCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchReferencesCreate has not yet been implemented")
 		}),
@@ -109,6 +113,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		MetaMetaGetHandler: meta.MetaGetHandlerFunc(func(params meta.MetaGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation meta.MetaGet has not yet been implemented")
 		}),
+		NodesNodesDynamicIndexUpdateHandler: nodes.NodesDynamicIndexUpdateHandlerFunc(func(params nodes.NodesDynamicIndexUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesDynamicIndexUpdate has not yet been implemented")
+		}),
 		NodesNodesGetHandler: nodes.NodesGetHandlerFunc(func(params nodes.NodesGetParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation nodes.NodesGet has not yet been implemented")
 		}),
@@ -172,6 +179,30 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ObjectsObjectsValidateHandler: objects.ObjectsValidateHandlerFunc(func(params objects.ObjectsValidateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsValidate has not yet been implemented")
 		}),
+		ReplicationReplicationCompareHandler: replication.ReplicationCompareHandlerFunc(func(params replication.ReplicationCompareParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationCompare has not yet been implemented")
+		}),
+		ReplicationReplicationRebalancerGetHandler: replication.ReplicationRebalancerGetHandlerFunc(func(params replication.ReplicationRebalancerGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationRebalancerGet has not yet been implemented")
+		}),
+		ReplicationReplicationRebalancerUpdateHandler: replication.ReplicationRebalancerUpdateHandlerFunc(func(params replication.ReplicationRebalancerUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationRebalancerUpdate has not yet been implemented")
+		}),
+		ReplicationReplicationReshardHandler: replication.ReplicationReshardHandlerFunc(func(params replication.ReplicationReshardParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationReshard has not yet been implemented")
+		}),
+		ReplicationReplicationReshardStatusHandler: replication.ReplicationReshardStatusHandlerFunc(func(params replication.ReplicationReshardStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationReshardStatus has not yet been implemented")
+		}),
+		ReplicationReplicationStatusHandler: replication.ReplicationStatusHandlerFunc(func(params replication.ReplicationStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationStatus has not yet been implemented")
+		}),
+		ReplicationReplicationTransferHandler: replication.ReplicationTransferHandlerFunc(func(params replication.ReplicationTransferParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationTransfer has not yet been implemented")
+		}),
+		ReplicationReplicationTransferListHandler: replication.ReplicationTransferListHandlerFunc(func(params replication.ReplicationTransferListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationTransferList has not yet been implemented")
+		}),
 		SchemaSchemaDumpHandler: schema.SchemaDumpHandlerFunc(func(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaDump has not yet been implemented")
 		}),
@@ -196,6 +227,24 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsUpdateHandler: schema.SchemaObjectsUpdateHandlerFunc(func(params schema.SchemaObjectsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsUpdate has not yet been implemented")
 		}),
+		SchemaSchemaObjectsVectorRecallHandler: schema.SchemaObjectsVectorRecallHandlerFunc(func(params schema.SchemaObjectsVectorRecallParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorRecall has not yet been implemented")
+		}),
+		SchemaSchemaObjectsVectorReindexCancelHandler: schema.SchemaObjectsVectorReindexCancelHandlerFunc(func(params schema.SchemaObjectsVectorReindexCancelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorReindexCancel has not yet been implemented")
+		}),
+		SchemaSchemaObjectsVectorReindexGetHandler: schema.SchemaObjectsVectorReindexGetHandlerFunc(func(params schema.SchemaObjectsVectorReindexGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorReindexGet has not yet been implemented")
+		}),
+		SchemaSchemaObjectsVectorReindexPauseHandler: schema.SchemaObjectsVectorReindexPauseHandlerFunc(func(params schema.SchemaObjectsVectorReindexPauseParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorReindexPause has not yet been implemented")
+		}),
+		SchemaSchemaObjectsVectorReindexResumeHandler: schema.SchemaObjectsVectorReindexResumeHandlerFunc(func(params schema.SchemaObjectsVectorReindexResumeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorReindexResume has not yet been implemented")
+		}),
+		SchemaSchemaObjectsVectorReindexStartHandler: schema.SchemaObjectsVectorReindexStartHandlerFunc(func(params schema.SchemaObjectsVectorReindexStartParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsVectorReindexStart has not yet been implemented")
+		}),
 		SchemaTenantExistsHandler: schema.TenantExistsHandlerFunc(func(params schema.TenantExistsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.TenantExists has not yet been implemented")
 		}),
@@ -286,6 +335,8 @@ type WeaviateAPI struct {
 	BatchBatchObjectsCreateHandler batch.BatchObjectsCreateHandler
 	// BatchBatchObjectsDeleteHandler sets the operation handler for the batch objects delete operation
 	BatchBatchObjectsDeleteHandler batch.BatchObjectsDeleteHandler
+	// BatchBatchObjectsUpdateHandler sets the operation handler for the batch objects update operation
+	BatchBatchObjectsUpdateHandler batch.BatchObjectsUpdateHandler
 	// BatchBatchReferencesCreateHandler sets the operation handler for the batch references create operation
 	BatchBatchReferencesCreateHandler batch.BatchReferencesCreateHandler
 	// ClassificationsClassificationsGetHandler sets the operation handler for the classifications get operation
@@ -300,6 +351,8 @@ type WeaviateAPI struct {
 	GraphqlGraphqlPostHandler graphql.GraphqlPostHandler
 	// MetaMetaGetHandler sets the operation handler for the meta get operation
 	MetaMetaGetHandler meta.MetaGetHandler
+	// NodesNodesDynamicIndexUpdateHandler sets the operation handler for the nodes dynamic index update operation
+	NodesNodesDynamicIndexUpdateHandler nodes.NodesDynamicIndexUpdateHandler
 	// NodesNodesGetHandler sets the operation handler for the nodes get operation
 	NodesNodesGetHandler nodes.NodesGetHandler
 	// NodesNodesGetClassHandler sets the operation handler for the nodes get class operation
@@ -342,6 +395,22 @@ type WeaviateAPI struct {
 	ObjectsObjectsUpdateHandler objects.ObjectsUpdateHandler
 	// ObjectsObjectsValidateHandler sets the operation handler for the objects validate operation
 	ObjectsObjectsValidateHandler objects.ObjectsValidateHandler
+	// ReplicationReplicationCompareHandler sets the operation handler for the replication compare operation
+	ReplicationReplicationCompareHandler replication.ReplicationCompareHandler
+	// ReplicationReplicationRebalancerGetHandler sets the operation handler for the replication rebalancer get operation
+	ReplicationReplicationRebalancerGetHandler replication.ReplicationRebalancerGetHandler
+	// ReplicationReplicationRebalancerUpdateHandler sets the operation handler for the replication rebalancer update operation
+	ReplicationReplicationRebalancerUpdateHandler replication.ReplicationRebalancerUpdateHandler
+	// ReplicationReplicationReshardHandler sets the operation handler for the replication reshard operation
+	ReplicationReplicationReshardHandler replication.ReplicationReshardHandler
+	// ReplicationReplicationReshardStatusHandler sets the operation handler for the replication reshard status operation
+	ReplicationReplicationReshardStatusHandler replication.ReplicationReshardStatusHandler
+	// ReplicationReplicationStatusHandler sets the operation handler for the replication status operation
+	ReplicationReplicationStatusHandler replication.ReplicationStatusHandler
+	// ReplicationReplicationTransferHandler sets the operation handler for the replication transfer operation
+	ReplicationReplicationTransferHandler replication.ReplicationTransferHandler
+	// ReplicationReplicationTransferListHandler sets the operation handler for the replication transfer list operation
+	ReplicationReplicationTransferListHandler replication.ReplicationTransferListHandler
 	// SchemaSchemaDumpHandler sets the operation handler for the schema dump operation
 	SchemaSchemaDumpHandler schema.SchemaDumpHandler
 	// SchemaSchemaObjectsCreateHandler sets the operation handler for the schema objects create operation
@@ -358,6 +427,18 @@ type WeaviateAPI struct {
 	SchemaSchemaObjectsShardsUpdateHandler schema.SchemaObjectsShardsUpdateHandler
 	// SchemaSchemaObjectsUpdateHandler sets the operation handler for the schema objects update operation
 	SchemaSchemaObjectsUpdateHandler schema.SchemaObjectsUpdateHandler
+	// SchemaSchemaObjectsVectorRecallHandler sets the operation handler for the schema objects vector recall operation
+	SchemaSchemaObjectsVectorRecallHandler schema.SchemaObjectsVectorRecallHandler
+	// SchemaSchemaObjectsVectorReindexCancelHandler sets the operation handler for the schema objects vector reindex cancel operation
+	SchemaSchemaObjectsVectorReindexCancelHandler schema.SchemaObjectsVectorReindexCancelHandler
+	// SchemaSchemaObjectsVectorReindexGetHandler sets the operation handler for the schema objects vector reindex get operation
+	SchemaSchemaObjectsVectorReindexGetHandler schema.SchemaObjectsVectorReindexGetHandler
+	// SchemaSchemaObjectsVectorReindexPauseHandler sets the operation handler for the schema objects vector reindex pause operation
+	SchemaSchemaObjectsVectorReindexPauseHandler schema.SchemaObjectsVectorReindexPauseHandler
+	// SchemaSchemaObjectsVectorReindexResumeHandler sets the operation handler for the schema objects vector reindex resume operation
+	SchemaSchemaObjectsVectorReindexResumeHandler schema.SchemaObjectsVectorReindexResumeHandler
+	// SchemaSchemaObjectsVectorReindexStartHandler sets the operation handler for the schema objects vector reindex start operation
+	SchemaSchemaObjectsVectorReindexStartHandler schema.SchemaObjectsVectorReindexStartHandler
 	// SchemaTenantExistsHandler sets the operation handler for the tenant exists operation
 	SchemaTenantExistsHandler schema.TenantExistsHandler
 	// SchemaTenantsCreateHandler sets the operation handler for the tenants create operation
@@ -479,6 +560,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.BatchBatchObjectsDeleteHandler == nil {
 		unregistered = append(unregistered, "batch.BatchObjectsDeleteHandler")
 	}
+	if o.BatchBatchObjectsUpdateHandler == nil {
+		unregistered = append(unregistered, "batch.BatchObjectsUpdateHandler")
+	}
 	if o.BatchBatchReferencesCreateHandler == nil {
 		unregistered = append(unregistered, "batch.BatchReferencesCreateHandler")
 	}
@@ -500,6 +584,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.MetaMetaGetHandler == nil {
 		unregistered = append(unregistered, "meta.MetaGetHandler")
 	}
+	if o.NodesNodesDynamicIndexUpdateHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesDynamicIndexUpdateHandler")
+	}
 	if o.NodesNodesGetHandler == nil {
 		unregistered = append(unregistered, "nodes.NodesGetHandler")
 	}
@@ -563,6 +650,30 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ObjectsObjectsValidateHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsValidateHandler")
 	}
+	if o.ReplicationReplicationCompareHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationCompareHandler")
+	}
+	if o.ReplicationReplicationRebalancerGetHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationRebalancerGetHandler")
+	}
+	if o.ReplicationReplicationRebalancerUpdateHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationRebalancerUpdateHandler")
+	}
+	if o.ReplicationReplicationReshardHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationReshardHandler")
+	}
+	if o.ReplicationReplicationReshardStatusHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationReshardStatusHandler")
+	}
+	if o.ReplicationReplicationStatusHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationStatusHandler")
+	}
+	if o.ReplicationReplicationTransferHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationTransferHandler")
+	}
+	if o.ReplicationReplicationTransferListHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationTransferListHandler")
+	}
 	if o.SchemaSchemaDumpHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaDumpHandler")
 	}
@@ -587,6 +698,24 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsUpdateHandler")
 	}
+	if o.SchemaSchemaObjectsVectorRecallHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorRecallHandler")
+	}
+	if o.SchemaSchemaObjectsVectorReindexCancelHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorReindexCancelHandler")
+	}
+	if o.SchemaSchemaObjectsVectorReindexGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorReindexGetHandler")
+	}
+	if o.SchemaSchemaObjectsVectorReindexPauseHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorReindexPauseHandler")
+	}
+	if o.SchemaSchemaObjectsVectorReindexResumeHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorReindexResumeHandler")
+	}
+	if o.SchemaSchemaObjectsVectorReindexStartHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsVectorReindexStartHandler")
+	}
 	if o.SchemaTenantExistsHandler == nil {
 		unregistered = append(unregistered, "schema.TenantExistsHandler")
 	}
@@ -739,6 +868,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/batch/objects"] = batch.NewBatchObjectsDelete(o.context, o.BatchBatchObjectsDeleteHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/batch/objects"] = batch.NewBatchObjectsUpdate(o.context, o.BatchBatchObjectsUpdateHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -767,6 +900,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/meta"] = meta.NewMetaGet(o.context, o.MetaMetaGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/nodes/{className}/dynamic-index"] = nodes.NewNodesDynamicIndexUpdate(o.context, o.NodesNodesDynamicIndexUpdateHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -851,6 +988,38 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/objects/validate"] = objects.NewObjectsValidate(o.context, o.ObjectsObjectsValidateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/replication/compare/{className}"] = replication.NewReplicationCompare(o.context, o.ReplicationReplicationCompareHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/replication/rebalancer"] = replication.NewReplicationRebalancerGet(o.context, o.ReplicationReplicationRebalancerGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/replication/rebalancer"] = replication.NewReplicationRebalancerUpdate(o.context, o.ReplicationReplicationRebalancerUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/replication/reshard"] = replication.NewReplicationReshard(o.context, o.ReplicationReplicationReshardHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/replication/reshard/{className}"] = replication.NewReplicationReshardStatus(o.context, o.ReplicationReplicationReshardStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/replication/status"] = replication.NewReplicationStatus(o.context, o.ReplicationReplicationStatusHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/replication/transfer"] = replication.NewReplicationTransfer(o.context, o.ReplicationReplicationTransferHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/replication/transfer"] = replication.NewReplicationTransferList(o.context, o.ReplicationReplicationTransferListHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -883,6 +1052,30 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/schema/{className}"] = schema.NewSchemaObjectsUpdate(o.context, o.SchemaSchemaObjectsUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/vector-recall"] = schema.NewSchemaObjectsVectorRecall(o.context, o.SchemaSchemaObjectsVectorRecallHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/{className}/vector-reindex"] = schema.NewSchemaObjectsVectorReindexCancel(o.context, o.SchemaSchemaObjectsVectorReindexCancelHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/vector-reindex"] = schema.NewSchemaObjectsVectorReindexGet(o.context, o.SchemaSchemaObjectsVectorReindexGetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/vector-reindex/pause"] = schema.NewSchemaObjectsVectorReindexPause(o.context, o.SchemaSchemaObjectsVectorReindexPauseHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/vector-reindex/resume"] = schema.NewSchemaObjectsVectorReindexResume(o.context, o.SchemaSchemaObjectsVectorReindexResumeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/vector-reindex"] = schema.NewSchemaObjectsVectorReindexStart(o.context, o.SchemaSchemaObjectsVectorReindexStartHandler)
 	if o.handlers["HEAD"] == nil {
 		o.handlers["HEAD"] = make(map[string]http.Handler)
 	}
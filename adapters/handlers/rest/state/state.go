@@ -34,11 +34,16 @@ import (
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/rebalancer"
 	"github.com/weaviate/weaviate/usecases/replica"
+	"github.com/weaviate/weaviate/usecases/resharding"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	"github.com/weaviate/weaviate/usecases/tenantdeactivator"
 	"github.com/weaviate/weaviate/usecases/traverser"
+	"github.com/weaviate/weaviate/usecases/vectorrecall"
+	"github.com/weaviate/weaviate/usecases/vectorreindex"
 )
 
 // State is the only source of application-wide state
@@ -57,6 +62,11 @@ type State struct {
 	Modules               *modules.Provider
 	SchemaManager         *schema.Manager
 	Scaler                *scaler.Scaler
+	Rebalancer            *rebalancer.Rebalancer
+	TenantDeactivator     *tenantdeactivator.Deactivator
+	VectorReindex         *vectorreindex.Manager
+	Resharding            *resharding.Manager
+	VectorRecall          *vectorrecall.Auditor
 	Cluster               *cluster.State
 	RemoteIndexIncoming   *sharding.RemoteIndexIncoming
 	RemoteNodeIncoming    *sharding.RemoteNodeIncoming
@@ -67,10 +77,13 @@ type State struct {
 	Metrics            *monitoring.PrometheusMetrics
 	BackupManager      *backup.Handler
 	DB                 *db.DB
-	BatchManager       *objects.BatchManager
-	ClusterHttpClient  *http.Client
-	ReindexCtxCancel   context.CancelFunc
-	MemWatch           *memwatch.Monitor
+	// DataPathLock keeps other processes, such as the repair of weaviate-tool,
+	// from writing to the data path while the server runs
+	DataPathLock      *db.DataPathLock
+	BatchManager      *objects.BatchManager
+	ClusterHttpClient *http.Client
+	ReindexCtxCancel  context.CancelFunc
+	MemWatch          *memwatch.Monitor
 
 	ClusterService *rCluster.Service
 	TenantActivity *tenantactivity.Handler
@@ -40,7 +40,10 @@ func (ua unfilteredAggregator) boolProperty(ctx context.Context,
 
 	// bool never has a frequency, so it's either a Set or RoaringSet
 	if b.Strategy() == lsmkv.StrategyRoaringSet {
-		c := b.CursorRoaringSet()
+		c, err := b.CursorRoaringSet()
+		if err != nil {
+			return nil, err
+		}
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -50,7 +53,10 @@ func (ua unfilteredAggregator) boolProperty(ctx context.Context,
 			}
 		}
 	} else {
-		c := b.SetCursor() // bool never has a frequency, so it's always a Set
+		c, err := b.SetCursor() // bool never has a frequency, so it's always a Set
+		if err != nil {
+			return nil, err
+		}
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -80,7 +86,10 @@ func (ua unfilteredAggregator) boolArrayProperty(ctx context.Context,
 
 	agg := newBoolAggregator()
 
-	c := b.Cursor()
+	c, err := b.Cursor()
+	if err != nil {
+		return nil, err
+	}
 	defer c.Close()
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -161,7 +170,10 @@ func (ua unfilteredAggregator) floatProperty(ctx context.Context,
 
 	// flat never has a frequency, so it's either a Set or RoaringSet
 	if b.Strategy() == lsmkv.StrategyRoaringSet {
-		c := b.CursorRoaringSet()
+		c, err := b.CursorRoaringSet()
+		if err != nil {
+			return nil, err
+		}
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -170,7 +182,10 @@ func (ua unfilteredAggregator) floatProperty(ctx context.Context,
 			}
 		}
 	} else {
-		c := b.SetCursor()
+		c, err := b.SetCursor()
+		if err != nil {
+			return nil, err
+		}
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -202,7 +217,10 @@ func (ua unfilteredAggregator) intProperty(ctx context.Context,
 
 	// int never has a frequency, so it's either a Set or RoaringSet
 	if b.Strategy() == lsmkv.StrategyRoaringSet {
-		c := b.CursorRoaringSet()
+		c, err := b.CursorRoaringSet()
+		if err != nil {
+			return nil, err
+		}
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -212,7 +230,10 @@ func (ua unfilteredAggregator) intProperty(ctx context.Context,
 		}
 	} else {
 
-		c := b.SetCursor()
+		c, err := b.SetCursor()
+		if err != nil {
+			return nil, err
+		}
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -244,7 +265,10 @@ func (ua unfilteredAggregator) dateProperty(ctx context.Context,
 
 	// dates don't have frequency, so it's either a Set or RoaringSet
 	if b.Strategy() == lsmkv.StrategyRoaringSet {
-		c := b.CursorRoaringSet()
+		c, err := b.CursorRoaringSet()
+		if err != nil {
+			return nil, err
+		}
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -253,7 +277,10 @@ func (ua unfilteredAggregator) dateProperty(ctx context.Context,
 			}
 		}
 	} else {
-		c := b.SetCursor()
+		c, err := b.SetCursor()
+		if err != nil {
+			return nil, err
+		}
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -315,7 +342,10 @@ func (ua unfilteredAggregator) dateArrayProperty(ctx context.Context,
 
 	agg := newDateAggregator()
 
-	c := b.Cursor()
+	c, err := b.Cursor()
+	if err != nil {
+		return nil, err
+	}
 	defer c.Close()
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -459,7 +489,10 @@ func (ua unfilteredAggregator) textProperty(ctx context.Context,
 
 	// we're looking at the whole object, so this is neither a Set, nor a Map, but
 	// a Replace strategy
-	c := b.Cursor()
+	c, err := b.Cursor()
+	if err != nil {
+		return nil, err
+	}
 	defer c.Close()
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -488,7 +521,10 @@ func (ua unfilteredAggregator) numberArrayProperty(ctx context.Context,
 
 	agg := newNumericalAggregator()
 
-	c := b.Cursor()
+	c, err := b.Cursor()
+	if err != nil {
+		return nil, err
+	}
 	defer c.Close()
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -14,10 +14,13 @@ package db
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/memwatch"
@@ -27,6 +30,8 @@ import (
 type batchQueue struct {
 	objects       []*storobj.Object
 	originalIndex []int
+	// expectedVersions are only set for the queue of conditional objects
+	expectedVersions []*int64
 }
 
 func (db *DB) BatchPutObjects(ctx context.Context, objs objects.BatchObjects,
@@ -40,11 +45,22 @@ func (db *DB) BatchPutObjects(ctx context.Context, objs objects.BatchObjects,
 		return nil, fmt.Errorf("cannot process batch: %w", err)
 	}
 
-	for _, item := range objs {
+	// version checks are only atomic within a transaction, conditional objects
+	// are put in one transaction per shard
+	conditionalByClass := make(map[string]batchQueue)
+	for i, item := range objs {
 		if item.Err != nil {
 			// item has a validation error or another reason to ignore
 			continue
 		}
+		if item.ExpectedVersion != nil {
+			queue := conditionalByClass[item.Object.Class]
+			queue.objects = append(queue.objects, storobj.FromObject(item.Object, item.Object.Vector, item.Object.Vectors))
+			queue.originalIndex = append(queue.originalIndex, i)
+			queue.expectedVersions = append(queue.expectedVersions, item.ExpectedVersion)
+			conditionalByClass[item.Object.Class] = queue
+			continue
+		}
 		queue := objectByClass[item.Object.Class]
 		queue.objects = append(queue.objects, storobj.FromObject(item.Object, item.Object.Vector, item.Object.Vectors))
 		queue.originalIndex = append(queue.originalIndex, item.OriginalIndex)
@@ -99,6 +115,92 @@ func (db *DB) BatchPutObjects(ctx context.Context, objs objects.BatchObjects,
 		}
 	}
 
+	for class, queue := range conditionalByClass {
+		errs := db.batchPutObjectsConditional(ctx, class, queue, repl, schemaVersion)
+		for i, err := range errs {
+			if err != nil {
+				objs[queue.originalIndex[i]].Err = err
+			}
+		}
+	}
+
+	return objs, nil
+}
+
+func (db *DB) batchPutObjectsConditional(ctx context.Context, class string, queue batchQueue,
+	repl *additional.ReplicationProperties, schemaVersion uint64,
+) []error {
+	index := func() *Index {
+		db.indexLock.RLock()
+		defer db.indexLock.RUnlock()
+
+		index, ok := db.indices[indexID(schema.ClassName(class))]
+		if !ok {
+			return nil
+		}
+		index.dropIndex.RLock()
+		return index
+	}()
+	if index == nil {
+		return duplicateErr(fmt.Errorf("could not find index for class %v. It might have been deleted in the meantime", class),
+			len(queue.objects))
+	}
+	defer index.dropIndex.RUnlock()
+
+	return index.putObjectBatchConditional(ctx, queue.objects, queue.expectedVersions, repl, schemaVersion)
+}
+
+// BatchPutObjectsTransactional puts all objects or none of them. All objects
+// must belong to the same class and shard, see Shard.PutObjectBatchTransactional.
+func (db *DB) BatchPutObjectsTransactional(ctx context.Context, objs objects.BatchObjects,
+	repl *additional.ReplicationProperties, schemaVersion uint64,
+) (objects.BatchObjects, error) {
+	if len(objs) == 0 {
+		return objs, nil
+	}
+	if err := db.memMonitor.CheckAlloc(estimateBatchMemory(objs)); err != nil {
+		db.logger.WithError(err).Errorf("memory pressure: cannot process batch")
+		return nil, fmt.Errorf("cannot process batch: %w", err)
+	}
+
+	class := objs[0].Object.Class
+	storObjs := make([]*storobj.Object, len(objs))
+	expectedVersions := make([]*int64, len(objs))
+	for i, item := range objs {
+		if item.Err != nil {
+			return nil, fmt.Errorf("transaction contains invalid object %d: %w", i, item.Err)
+		}
+		if item.Object.Class != class {
+			return nil, fmt.Errorf("transaction spans classes %q and %q, all objects must belong to the same class",
+				class, item.Object.Class)
+		}
+		storObjs[i] = storobj.FromObject(item.Object, item.Object.Vector, item.Object.Vectors)
+		expectedVersions[i] = item.ExpectedVersion
+	}
+
+	index := func() *Index {
+		db.indexLock.RLock()
+		defer db.indexLock.RUnlock()
+
+		index, ok := db.indices[indexID(schema.ClassName(class))]
+		if !ok {
+			return nil
+		}
+		index.dropIndex.RLock()
+		return index
+	}()
+	if index == nil {
+		return nil, fmt.Errorf("could not find index for class %v. It might have been deleted in the meantime", class)
+	}
+	defer index.dropIndex.RUnlock()
+
+	errs := index.putObjectBatchTransactional(ctx, storObjs, expectedVersions, repl, schemaVersion)
+	for i, err := range errs {
+		if err != nil {
+			objs[i].Err = err
+		}
+	}
+
 	return objs, nil
 }
 
@@ -175,21 +277,7 @@ func (db *DB) BatchDeleteObjects(ctx context.Context, params objects.BatchDelete
 		return objects.BatchDeleteResult{}, errors.Wrapf(err, "cannot find objects")
 	}
 	// prepare to be deleted list of DocIDs from all shards
-	toDelete := map[string][]strfmt.UUID{}
-	limit := db.config.QueryMaximumResults
-
-	matches := int64(0)
-	for shardName, docIDs := range shardDocIDs {
-		docIDsLength := int64(len(docIDs))
-		if matches <= limit {
-			if matches+docIDsLength <= limit {
-				toDelete[shardName] = docIDs
-			} else {
-				toDelete[shardName] = docIDs[:limit-matches]
-			}
-		}
-		matches += docIDsLength
-	}
+	toDelete, matches := limitShardUUIDs(shardDocIDs, db.config.QueryMaximumResults)
 
 	if err := db.memMonitor.CheckAlloc(memwatch.EstimateObjectDeleteMemory() * matches); err != nil {
 		db.logger.WithError(err).Errorf("memory pressure: cannot process batch delete object")
@@ -211,6 +299,130 @@ func (db *DB) BatchDeleteObjects(ctx context.Context, params objects.BatchDelete
 	return result, nil
 }
 
+// BatchUpdateObjects applies the same changes to the objects matching the
+// filter. The shards are updated in parallel. At most QueryMaximumResults
+// objects are updated, in the order of their ids, the result's Next continues
+// the update with the remaining objects.
+func (db *DB) BatchUpdateObjects(ctx context.Context, params objects.BatchUpdateParams,
+	repl *additional.ReplicationProperties, tenant string, schemaVersion uint64,
+) (objects.BatchUpdateResult, error) {
+	idx := db.GetIndex(params.ClassName)
+	if idx == nil {
+		return objects.BatchUpdateResult{}, errors.Errorf("cannot find index for class %v", params.ClassName)
+	}
+
+	shardUUIDs, err := idx.findUUIDs(ctx, params.Filters, tenant)
+	if err != nil {
+		return objects.BatchUpdateResult{}, errors.Wrapf(err, "cannot find objects")
+	}
+	toUpdate, matches, next := pageShardUUIDs(shardUUIDs, params.After, db.config.QueryMaximumResults)
+
+	updatedObjects := idx.batchUpdateObjects(ctx, toUpdate, params, repl, schemaVersion)
+
+	result := objects.BatchUpdateResult{
+		Matches: matches,
+		Limit:   db.config.QueryMaximumResults,
+		DryRun:  params.DryRun,
+		Objects: updatedObjects,
+		Next:    next,
+	}
+	if !params.DryRun {
+		for _, obj := range updatedObjects {
+			if obj.Err == nil {
+				result.Updated++
+			}
+		}
+	}
+	return result, nil
+}
+
+// limitShardUUIDs cuts the ids per shard so that there are at most limit ids
+// in total, it also returns the number of ids before cutting them
+func limitShardUUIDs(shardUUIDs map[string][]strfmt.UUID, limit int64,
+) (map[string][]strfmt.UUID, int64) {
+	out := map[string][]strfmt.UUID{}
+	matches := int64(0)
+	for shardName, uuids := range shardUUIDs {
+		uuidsLength := int64(len(uuids))
+		if matches <= limit {
+			if matches+uuidsLength <= limit {
+				out[shardName] = uuids
+			} else {
+				out[shardName] = uuids[:limit-matches]
+			}
+		}
+		matches += uuidsLength
+	}
+	return out, matches
+}
+
+// pageShardUUIDs orders the ids of all shards and keeps at most limit ids
+// greater than after. It also returns the number of ids before cutting them and
+// the last kept id if there are more ids after it.
+func pageShardUUIDs(shardUUIDs map[string][]strfmt.UUID, after strfmt.UUID, limit int64,
+) (map[string][]strfmt.UUID, int64, strfmt.UUID) {
+	type shardUUID struct {
+		shard string
+		id    strfmt.UUID
+		key   string
+	}
+
+	// ids are ordered case-insensitively, so that the order is stable across
+	// requests no matter how an id was written
+	afterKey := strings.ToLower(after.String())
+	var matches int64
+	var remaining []shardUUID
+	for shardName, uuids := range shardUUIDs {
+		matches += int64(len(uuids))
+		for _, id := range uuids {
+			key := strings.ToLower(id.String())
+			if key > afterKey {
+				remaining = append(remaining, shardUUID{shard: shardName, id: id, key: key})
+			}
+		}
+	}
+	sort.Slice(remaining, func(a, b int) bool { return remaining[a].key < remaining[b].key })
+
+	var next strfmt.UUID
+	if int64(len(remaining)) > limit {
+		remaining = remaining[:limit]
+		if limit > 0 {
+			next = remaining[limit-1].id
+		}
+	}
+
+	out := map[string][]strfmt.UUID{}
+	for _, id := range remaining {
+		out[id.shard] = append(out[id.shard], id.id)
+	}
+	return out, matches, next
+}
+
+// BatchMergeObjects merges the documents concurrently. The returned errors
+// match the order of docs.
+func (db *DB) BatchMergeObjects(ctx context.Context, docs []objects.MergeDocument,
+	repl *additional.ReplicationProperties, tenant string, schemaVersion uint64,
+) []error {
+	errs := make([]error, len(docs))
+	eg := enterrors.NewErrorGroupWrapper(db.logger)
+	eg.SetLimit(_NUMCPU * 2)
+	for i := range docs {
+		i := i
+		idx := db.GetIndex(schema.ClassName(docs[i].Class))
+		if idx == nil {
+			errs[i] = fmt.Errorf("merge from non-existing index for %s", docs[i].Class)
+			continue
+		}
+		eg.Go(func() error {
+			errs[i] = idx.mergeObject(ctx, docs[i], repl, tenant, schemaVersion)
+			return nil
+		}, docs[i].ID)
+	}
+	eg.Wait()
+
+	return errs
+}
+
 func estimateBatchMemory(objs objects.BatchObjects) int64 {
 	var sum int64
 	for _, item := range objs {
@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
@@ -345,6 +346,219 @@ func TestBatchDeleteObjects_Journey(t *testing.T) {
 	t.Run("batch delete journey things", testBatchDeleteObjectsJourney(repo, queryMaximumResults))
 }
 
+func TestBatchUpdateObjects(t *testing.T) {
+	className := "ThingForBatching"
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer func() {
+		require.Nil(t, repo.Shutdown(context.Background()))
+	}()
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the test class", testAddBatchObjectClass(repo, migrator, schemaGetter))
+
+	simpleInsertObjects(t, repo, className, 10)
+
+	ids := []strfmt.UUID{
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506003",
+		"8d5a3aa2-3c8d-4589-9ae1-3f638f506004",
+	}
+	params := objects.BatchUpdateParams{
+		ClassName: schema.ClassName(className),
+		Filters: &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorOr,
+			Operands: []filters.Clause{
+				{
+					Operator: filters.OperatorEqual,
+					On:       &filters.Path{Class: schema.ClassName(className), Property: "id"},
+					Value:    &filters.Value{Value: ids[0].String(), Type: schema.DataTypeText},
+				},
+				{
+					Operator: filters.OperatorEqual,
+					On:       &filters.Path{Class: schema.ClassName(className), Property: "id"},
+					Value:    &filters.Value{Value: ids[1].String(), Type: schema.DataTypeText},
+				},
+			},
+		}},
+		Changes:    objects.PropertyChanges{Set: map[string]interface{}{"stringProp": "updated"}},
+		UpdateTime: 100,
+	}
+	stringProp := func(t *testing.T, id strfmt.UUID) interface{} {
+		res, err := repo.Object(context.Background(), className, id, search.SelectProperties{},
+			additional.Properties{}, nil, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		return res.Schema.(map[string]interface{})["stringProp"]
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		dryRun := params
+		dryRun.DryRun = true
+		res, err := repo.BatchUpdateObjects(context.Background(), dryRun, nil, "", 0)
+		require.Nil(t, err)
+		assert.True(t, res.DryRun)
+		assert.Equal(t, int64(2), res.Matches)
+		assert.Equal(t, int64(0), res.Updated)
+		assert.Len(t, res.Objects, 2)
+		for _, id := range ids {
+			assert.Equal(t, fmt.Sprintf("element %s", id[len(id)-1:]), stringProp(t, id))
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		res, err := repo.BatchUpdateObjects(context.Background(), params, nil, "", 0)
+		require.Nil(t, err)
+		assert.False(t, res.DryRun)
+		assert.Equal(t, int64(2), res.Matches)
+		assert.Equal(t, int64(2), res.Updated)
+		for _, obj := range res.Objects {
+			assert.Nil(t, obj.Err)
+		}
+		for _, id := range ids {
+			assert.Equal(t, "updated", stringProp(t, id))
+		}
+		assert.Equal(t, "element 5", stringProp(t, "8d5a3aa2-3c8d-4589-9ae1-3f638f506005"))
+	})
+
+	t.Run("updated objects can be found through the inverted index", func(t *testing.T) {
+		dryRun := params
+		dryRun.DryRun = true
+		dryRun.Filters = &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: schema.ClassName(className), Property: "stringProp"},
+			Value:    &filters.Value{Value: "updated", Type: schema.DataTypeText},
+		}}
+		res, err := repo.BatchUpdateObjects(context.Background(), dryRun, nil, "", 0)
+		require.Nil(t, err)
+		assert.Equal(t, int64(2), res.Matches)
+	})
+
+	t.Run("an update beyond the limit is continued", func(t *testing.T) {
+		repo.config.QueryMaximumResults = 1
+		defer func() { repo.config.QueryMaximumResults = 10000 }()
+
+		paged := params
+		paged.Changes = objects.PropertyChanges{Set: map[string]interface{}{"stringProp": "paged"}}
+		res, err := repo.BatchUpdateObjects(context.Background(), paged, nil, "", 0)
+		require.Nil(t, err)
+		assert.Equal(t, int64(2), res.Matches)
+		assert.Equal(t, int64(1), res.Updated)
+		assert.Equal(t, ids[0], res.Next)
+		assert.Equal(t, "paged", stringProp(t, ids[0]))
+		assert.Equal(t, "updated", stringProp(t, ids[1]))
+
+		paged.After = res.Next
+		res, err = repo.BatchUpdateObjects(context.Background(), paged, nil, "", 0)
+		require.Nil(t, err)
+		assert.Equal(t, int64(1), res.Updated)
+		assert.Empty(t, res.Next)
+		assert.Equal(t, "paged", stringProp(t, ids[1]))
+	})
+}
+
+func TestBatchPutObjectsConditional(t *testing.T) {
+	className := "ThingForBatching"
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer func() {
+		require.Nil(t, repo.Shutdown(context.Background()))
+	}()
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the test class", testAddBatchObjectClass(repo, migrator, schemaGetter))
+
+	id := func(i int) strfmt.UUID {
+		return strfmt.UUID(fmt.Sprintf("8d5a3aa2-3c8d-4589-9ae1-3f638f506%03d", i))
+	}
+	version := func(v int64) *int64 { return &v }
+	batch := func(updateTime int64, expectedVersions ...*int64) objects.BatchObjects {
+		out := make(objects.BatchObjects, len(expectedVersions))
+		for i, expected := range expectedVersions {
+			out[i] = objects.BatchObject{
+				OriginalIndex: i,
+				Object: &models.Object{
+					Class:              className,
+					Properties:         map[string]interface{}{"stringProp": fmt.Sprintf("version %d", updateTime)},
+					ID:                 id(i),
+					Vector:             []float32{1, 2, 3},
+					LastUpdateTimeUnix: updateTime,
+				},
+				UUID:            id(i),
+				ExpectedVersion: expected,
+			}
+		}
+		return out
+	}
+	lastUpdate := func(t *testing.T, i int) int64 {
+		res, err := repo.Object(context.Background(), className, id(i), search.SelectProperties{},
+			additional.Properties{}, nil, "")
+		require.Nil(t, err)
+		if res == nil {
+			return 0
+		}
+		return res.Updated
+	}
+
+	res, err := repo.BatchPutObjects(context.Background(), batch(100, nil, nil, nil), nil, 0)
+	require.Nil(t, err)
+	for _, item := range res {
+		require.Nil(t, item.Err)
+	}
+
+	t.Run("a failed condition does not affect the other objects", func(t *testing.T) {
+		res, err := repo.BatchPutObjects(context.Background(),
+			batch(200, version(100), version(99), version(100), version(0)), nil, 0)
+		require.Nil(t, err)
+
+		assert.Nil(t, res[0].Err)
+		assert.ErrorAs(t, res[1].Err, &objects.ErrPreconditionFailed{})
+		assert.Nil(t, res[2].Err)
+		assert.Nil(t, res[3].Err)
+		assert.Equal(t, []int64{200, 100, 200, 200},
+			[]int64{lastUpdate(t, 0), lastUpdate(t, 1), lastUpdate(t, 2), lastUpdate(t, 3)})
+	})
+
+	t.Run("all conditions of a shard hold", func(t *testing.T) {
+		res, err := repo.BatchPutObjects(context.Background(),
+			batch(300, version(200), version(100), version(200), version(200)), nil, 0)
+		require.Nil(t, err)
+		for _, item := range res {
+			assert.Nil(t, item.Err)
+		}
+		for i := 0; i < 4; i++ {
+			assert.Equal(t, int64(300), lastUpdate(t, i))
+		}
+	})
+}
+
 func testAddBatchObjectClass(repo *DB, migrator *Migrator,
 	schemaGetter *fakeSchemaGetter,
 ) func(t *testing.T) {
@@ -599,7 +599,7 @@ func testDistributed(t *testing.T, dirName string, rnd *rand.Rand, batch bool) {
 			}
 
 			node := nodes[rnd.Intn(len(nodes))]
-			err := node.repo.DeleteObject(context.Background(), distributedClass, obj.ID, nil, "", 0)
+			err := node.repo.DeleteObject(context.Background(), distributedClass, obj.ID, nil, nil, "", 0)
 			require.Nil(t, err)
 		}
 	})
@@ -46,16 +46,17 @@ func (db *DB) PutObject(ctx context.Context, obj *models.Object,
 	return nil
 }
 
-// DeleteObject from of a specific class giving its ID
+// DeleteObject from of a specific class giving its ID. If expectedVersion is
+// set, the object is only deleted if its lastUpdateTimeUnix matches.
 func (db *DB) DeleteObject(ctx context.Context, class string, id strfmt.UUID,
-	repl *additional.ReplicationProperties, tenant string, schemaVersion uint64,
+	expectedVersion *int64, repl *additional.ReplicationProperties, tenant string, schemaVersion uint64,
 ) error {
 	idx := db.GetIndex(schema.ClassName(class))
 	if idx == nil {
 		return fmt.Errorf("delete from non-existing index for %s", class)
 	}
 
-	err := idx.deleteObject(ctx, id, repl, tenant, schemaVersion)
+	err := idx.deleteObject(ctx, id, expectedVersion, repl, tenant, schemaVersion)
 	if err != nil {
 		return fmt.Errorf("delete from index %q: %w", idx.ID(), err)
 	}
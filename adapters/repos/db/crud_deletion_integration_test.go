@@ -133,7 +133,7 @@ func TestDeleteJourney(t *testing.T) {
 		func(t *testing.T) {
 			id := updateTestData()[0].ID
 
-			err := repo.DeleteObject(context.Background(), "UpdateTestClass", id, nil, "", 0)
+			err := repo.DeleteObject(context.Background(), "UpdateTestClass", id, nil, nil, "", 0)
 			require.Nil(t, err)
 		})
 
@@ -175,7 +175,7 @@ func TestDeleteJourney(t *testing.T) {
 		func(t *testing.T) {
 			id := updateTestData()[1].ID
 
-			err := repo.DeleteObject(context.Background(), "UpdateTestClass", id, nil, "", 0)
+			err := repo.DeleteObject(context.Background(), "UpdateTestClass", id, nil, nil, "", 0)
 			require.Nil(t, err)
 		})
 
@@ -229,7 +229,7 @@ func TestDeleteJourney(t *testing.T) {
 
 		id := updateTestData()[2].ID
 
-		err = repo.DeleteObject(context.Background(), "UpdateTestClass", id, nil, "", 0)
+		err = repo.DeleteObject(context.Background(), "UpdateTestClass", id, nil, nil, "", 0)
 		require.Nil(t, err)
 
 		index := repo.GetIndex("UpdateTestClass")
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// dataPathLockFile is locked by every process which writes to a data path,
+// the server and Repair refuse to run on a data path locked by another one
+const dataPathLockFile = "weaviate.lock"
+
+var errDataPathLocked = errors.New("locked by another process")
+
+// DataPathLock is the exclusive lock of a data path. The lock is held until
+// Unlock is called or the process exits.
+type DataPathLock struct {
+	f *os.File
+}
+
+// LockDataPath takes the lock of the data path, it fails if another process
+// holds it already
+func LockDataPath(rootPath string) (*DataPathLock, error) {
+	if err := os.MkdirAll(rootPath, 0o777); err != nil {
+		return nil, fmt.Errorf("create data path: %w", err)
+	}
+
+	path := filepath.Join(rootPath, dataPathLockFile)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o666)
+	if err != nil {
+		return nil, fmt.Errorf("open lock file: %w", err)
+	}
+	if err := lockFile(f); err != nil {
+		f.Close()
+		if errors.Is(err, errDataPathLocked) {
+			return nil, fmt.Errorf("data path %s is in use, %s is %w", rootPath, path, err)
+		}
+		return nil, fmt.Errorf("lock %s: %w", path, err)
+	}
+
+	// the pid only helps to find the process holding the lock
+	if err := f.Truncate(0); err == nil {
+		_, _ = f.WriteAt([]byte(strconv.Itoa(os.Getpid())+"\n"), 0)
+	}
+	return &DataPathLock{f: f}, nil
+}
+
+// Unlock releases the lock, the lock file is kept
+func (l *DataPathLock) Unlock() error {
+	return l.f.Close()
+}
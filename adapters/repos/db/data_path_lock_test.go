@@ -0,0 +1,38 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockDataPath(t *testing.T) {
+	dir := t.TempDir()
+
+	lock, err := LockDataPath(dir)
+	require.Nil(t, err)
+
+	_, err = LockDataPath(dir)
+	assert.ErrorContains(t, err, "is in use")
+
+	_, err = Repair(context.Background(), Config{RootPath: dir}, nil, nil, nil)
+	assert.ErrorContains(t, err, "is in use")
+
+	require.Nil(t, lock.Unlock())
+	lock, err = LockDataPath(dir)
+	require.Nil(t, err)
+	require.Nil(t, lock.Unlock())
+}
@@ -214,7 +214,7 @@ func TestLimitOneAfterDeletion(t *testing.T) {
 	})
 
 	t.Run("delete first object", func(t *testing.T) {
-		err := repo.DeleteObject(context.Background(), "Test", firstID, nil, "", 0)
+		err := repo.DeleteObject(context.Background(), "Test", firstID, nil, nil, "", 0)
 		require.Nil(t, err)
 	})
 
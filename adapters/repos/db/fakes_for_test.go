@@ -198,7 +198,7 @@ func (f *fakeRemoteClient) Exists(ctx context.Context, hostName, indexName,
 }
 
 func (f *fakeRemoteClient) DeleteObject(ctx context.Context, hostName, indexName,
-	shardName string, id strfmt.UUID, schemaVersion uint64,
+	shardName string, id strfmt.UUID, expectedVersion *int64, schemaVersion uint64,
 ) error {
 	return nil
 }
@@ -291,6 +291,16 @@ func (f *fakeRemoteNodeClient) GetStatistics(ctx context.Context, hostName strin
 	return &models.Statistics{}, nil
 }
 
+func (f *fakeRemoteNodeClient) RequestAsyncReplicationComparison(ctx context.Context, hostName, className string) error {
+	return nil
+}
+
+func (f *fakeRemoteNodeClient) SetDynamicIndexUpgradePolicy(ctx context.Context,
+	hostName, className, tenant, targetVector, policy string,
+) error {
+	return nil
+}
+
 type fakeReplicationClient struct{}
 
 var _ replica.Client = (*fakeReplicationClient)(nil)
@@ -302,7 +312,7 @@ func (f *fakeReplicationClient) PutObject(ctx context.Context, host, index, shar
 }
 
 func (f *fakeReplicationClient) DeleteObject(ctx context.Context, host, index, shard, requestID string,
-	id strfmt.UUID, schemaVersion uint64,
+	id strfmt.UUID, expectedVersion *int64, schemaVersion uint64,
 ) (replica.SimpleResponse, error) {
 	return replica.SimpleResponse{}, nil
 }
@@ -313,6 +323,12 @@ func (f *fakeReplicationClient) PutObjects(ctx context.Context, host, index, sha
 	return replica.SimpleResponse{}, nil
 }
 
+func (f *fakeReplicationClient) PutObjectsTransactional(ctx context.Context, host, index, shard, requestID string,
+	objs []*storobj.Object, expectedVersions []*int64, schemaVersion uint64,
+) (replica.SimpleResponse, error) {
+	return replica.SimpleResponse{}, nil
+}
+
 func (f *fakeReplicationClient) MergeObject(ctx context.Context, host, index, shard, requestID string,
 	mergeDoc *objects.MergeDocument, schemaVersion uint64,
 ) (replica.SimpleResponse, error) {
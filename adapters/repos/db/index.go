@@ -37,6 +37,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
@@ -165,6 +166,7 @@ type Index struct {
 	remote                    *sharding.RemoteIndex
 	stopwords                 *stopwords.Detector
 	replicator                *replica.Replicator
+	nodeResolver              nodeResolver
 
 	shardState *sharding.State
 
@@ -206,6 +208,10 @@ type Index struct {
 	shardInUseLocks  *esync.KeyRWLocker
 
 	asyncReplicationLock sync.RWMutex
+	storageConfigLock    sync.RWMutex
+
+	// resharding is set while the class is resharded, see setResharding
+	resharding atomic.Pointer[sharding.State]
 }
 
 func (i *Index) GetShards() []ShardLike {
@@ -267,6 +273,7 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 		vectorIndexUserConfigs: vectorIndexUserConfigs,
 		stopwords:              sd,
 		replicator:             repl,
+		nodeResolver:           nodeResolver,
 		shardState:             shardState,
 		remote: sharding.NewRemoteIndex(cfg.ClassName.String(), sg,
 			nodeResolver, remoteClient),
@@ -279,6 +286,7 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 		shardInUseLocks:  esync.NewKeyRWLocker(),
 	}
 	index.closingCtx, index.closingCancel = context.WithCancel(context.Background())
+	index.setResharding(shardState)
 
 	index.initCycleCallbacks()
 
@@ -525,9 +533,16 @@ func (i *Index) updateVectorIndexConfigs(ctx context.Context,
 	i.vectorIndexUserConfigLock.Lock()
 	defer i.vectorIndexUserConfigLock.Unlock()
 
+	// the map is replaced rather than modified, as it is read without the
+	// lock, e.g. when shards are loaded or dimensions are tracked
+	configs := make(map[string]schemaConfig.VectorIndexConfig, len(i.vectorIndexUserConfigs)+len(updated))
+	for targetName, targetCfg := range i.vectorIndexUserConfigs {
+		configs[targetName] = targetCfg
+	}
 	for targetName, targetCfg := range updated {
-		i.vectorIndexUserConfigs[targetName] = targetCfg
+		configs[targetName] = targetCfg
 	}
+	i.vectorIndexUserConfigs = configs
 
 	return nil
 }
@@ -550,6 +565,37 @@ func (i *Index) updateInvertedIndexConfig(ctx context.Context,
 	return nil
 }
 
+// objectsCompression returns the compression of the objects bucket of the
+// shards of the index
+func (i *Index) objectsCompression() string {
+	i.storageConfigLock.RLock()
+	defer i.storageConfigLock.RUnlock()
+
+	return i.Config.ObjectsCompression
+}
+
+func (i *Index) updateStorageConfig(ctx context.Context, objectsCompression string) error {
+	i.storageConfigLock.Lock()
+	defer i.storageConfigLock.Unlock()
+
+	i.Config.ObjectsCompression = objectsCompression
+
+	return i.ForEachShard(func(name string, shard ShardLike) error {
+		// shards which are not loaded yet use the new compression once loaded
+		if lazy, ok := shard.(*LazyLoadShard); ok && !lazy.isLoaded() {
+			return nil
+		}
+		bucket := shard.Store().Bucket(helpers.ObjectsBucketLSM)
+		if bucket == nil {
+			return nil
+		}
+		if err := bucket.SetCompression(objectsCompression); err != nil {
+			return fmt.Errorf("update compression of shard %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
 func (i *Index) updateAsyncReplication(ctx context.Context, enabled bool) error {
 	i.asyncReplicationLock.Lock()
 	defer i.asyncReplicationLock.Unlock()
@@ -582,10 +628,18 @@ type IndexConfig struct {
 	MemtablesMaxActiveSeconds int
 	MaxSegmentSize            int64
 	HNSWMaxLogSize            int64
+	HNSWSnapshotInterval      time.Duration
+	LSMScrubInterval          time.Duration
+	LSMScrubRepair            bool
+	LSMCompactionPolicy       string
+	LSMCompactionFanOut       int
+	ObjectsCompression        string
 	ReplicationFactor         *atomic.Int64
 	AsyncReplicationEnabled   bool
 	AvoidMMap                 bool
 	DisableLazyLoadShards     bool
+	VectorCacheBudget         *cache.Budget
+	LSMTier                   *lsmkv.RemoteTier
 
 	TrackVectorDimensions bool
 }
@@ -651,6 +705,15 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object,
 		return objects.NewErrInvalidUserInput("determine shard: %v", err)
 	}
 
+	if err := i.putObjectToShard(ctx, shardName, object, replProps, schemaVersion); err != nil {
+		return err
+	}
+	return i.reshardingPutObject(ctx, object, replProps, schemaVersion)
+}
+
+func (i *Index) putObjectToShard(ctx context.Context, shardName string, object *storobj.Object,
+	replProps *additional.ReplicationProperties, schemaVersion uint64,
+) error {
 	if i.replicationEnabled() {
 		if replProps == nil {
 			replProps = defaultConsistency()
@@ -878,24 +941,7 @@ func (i *Index) putObjectBatch(ctx context.Context, objects []*storobj.Object,
 					debug.PrintStack()
 				}
 			}()
-			var errs []error
-			if replProps != nil {
-				errs = i.replicator.PutObjects(ctx, shardName, group.objects,
-					replica.ConsistencyLevel(replProps.ConsistencyLevel), schemaVersion)
-			} else {
-				shard, release, err := i.getLocalShardNoShutdown(shardName)
-				if err != nil {
-					errs = []error{err}
-				} else if shard != nil {
-					i.backupMutex.RLockGuard(func() error {
-						defer release()
-						errs = shard.PutObjectBatch(ctx, group.objects)
-						return nil
-					})
-				} else {
-					errs = i.remote.BatchPutObjects(ctx, shardName, group.objects, schemaVersion)
-				}
-			}
+			errs := i.putObjectBatchToShard(ctx, shardName, group.objects, replProps, schemaVersion)
 
 			for i, err := range errs {
 				desiredPos := group.pos[i]
@@ -907,9 +953,36 @@ func (i *Index) putObjectBatch(ctx context.Context, objects []*storobj.Object,
 
 	wg.Wait()
 
+	i.reshardingPutObjectBatch(ctx, objects, out, replProps, schemaVersion)
+
 	return out
 }
 
+func (i *Index) putObjectBatchToShard(ctx context.Context, shardName string,
+	objects []*storobj.Object, replProps *additional.ReplicationProperties, schemaVersion uint64,
+) []error {
+	if replProps != nil {
+		return i.replicator.PutObjects(ctx, shardName, objects,
+			replica.ConsistencyLevel(replProps.ConsistencyLevel), schemaVersion)
+	}
+
+	shard, release, err := i.getLocalShardNoShutdown(shardName)
+	if err != nil {
+		return []error{err}
+	}
+	if shard == nil {
+		return i.remote.BatchPutObjects(ctx, shardName, objects, schemaVersion)
+	}
+
+	var errs []error
+	i.backupMutex.RLockGuard(func() error {
+		defer release()
+		errs = shard.PutObjectBatch(ctx, objects)
+		return nil
+	})
+	return errs
+}
+
 func duplicateErr(in error, count int) []error {
 	out := make([]error, count)
 	for i := range out {
@@ -980,23 +1053,7 @@ func (i *Index) AddReferencesBatch(ctx context.Context, refs objects.BatchRefere
 	}
 
 	for shardName, group := range byShard {
-		var errs []error
-		if i.replicationEnabled() {
-			errs = i.replicator.AddReferences(ctx, shardName, group.refs, replica.ConsistencyLevel(replProps.ConsistencyLevel), schemaVersion)
-		} else {
-			shard, release, err := i.getLocalShardNoShutdown(shardName)
-			if err != nil {
-				errs = duplicateErr(err, len(group.refs))
-			} else if shard != nil {
-				i.backupMutex.RLockGuard(func() error {
-					defer release()
-					errs = shard.AddReferencesBatch(ctx, group.refs)
-					return nil
-				})
-			} else {
-				errs = i.remote.BatchAddReferences(ctx, shardName, group.refs, schemaVersion)
-			}
-		}
+		errs := i.addReferencesToShard(ctx, shardName, group.refs, replProps, schemaVersion)
 
 		for i, err := range errs {
 			desiredPos := group.pos[i]
@@ -1004,9 +1061,35 @@ func (i *Index) AddReferencesBatch(ctx context.Context, refs objects.BatchRefere
 		}
 	}
 
+	i.reshardingAddReferences(ctx, refs, out, replProps, schemaVersion)
+
 	return out
 }
 
+func (i *Index) addReferencesToShard(ctx context.Context, shardName string,
+	refs objects.BatchReferences, replProps *additional.ReplicationProperties, schemaVersion uint64,
+) []error {
+	if i.replicationEnabled() {
+		return i.replicator.AddReferences(ctx, shardName, refs, replica.ConsistencyLevel(replProps.ConsistencyLevel), schemaVersion)
+	}
+
+	shard, release, err := i.getLocalShardNoShutdown(shardName)
+	if err != nil {
+		return duplicateErr(err, len(refs))
+	}
+	if shard == nil {
+		return i.remote.BatchAddReferences(ctx, shardName, refs, schemaVersion)
+	}
+
+	var errs []error
+	i.backupMutex.RLockGuard(func() error {
+		defer release()
+		errs = shard.AddReferencesBatch(ctx, refs)
+		return nil
+	})
+	return errs
+}
+
 func (i *Index) IncomingBatchAddReferences(ctx context.Context, shardName string,
 	refs objects.BatchReferences, schemaVersion uint64,
 ) []error {
@@ -1696,7 +1779,7 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 	return res, resDists, nil
 }
 
-func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID,
+func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID, expectedVersion *int64,
 	replProps *additional.ReplicationProperties, tenant string, schemaVersion uint64,
 ) error {
 	if err := i.validateMultiTenancy(tenant); err != nil {
@@ -1708,12 +1791,21 @@ func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID,
 		return objects.NewErrInvalidUserInput("determine shard: %v", err)
 	}
 
+	if err := i.deleteObjectFromShard(ctx, shardName, id, expectedVersion, replProps, schemaVersion); err != nil {
+		return err
+	}
+	return i.reshardingDeleteObject(ctx, id, replProps, schemaVersion)
+}
+
+func (i *Index) deleteObjectFromShard(ctx context.Context, shardName string, id strfmt.UUID,
+	expectedVersion *int64, replProps *additional.ReplicationProperties, schemaVersion uint64,
+) error {
 	if i.replicationEnabled() {
 		if replProps == nil {
 			replProps = defaultConsistency()
 		}
 		cl := replica.ConsistencyLevel(replProps.ConsistencyLevel)
-		if err := i.replicator.DeleteObject(ctx, shardName, id, cl, schemaVersion); err != nil {
+		if err := i.replicator.DeleteObject(ctx, shardName, id, expectedVersion, cl, schemaVersion); err != nil {
 			return fmt.Errorf("replicate deletion: shard=%q %w", shardName, err)
 		}
 		return nil
@@ -1726,7 +1818,7 @@ func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID,
 	}
 
 	if shard == nil {
-		if err := i.remote.DeleteObject(ctx, shardName, id, schemaVersion); err != nil {
+		if err := i.remote.DeleteObject(ctx, shardName, id, expectedVersion, schemaVersion); err != nil {
 			return fmt.Errorf("delete remote object: shard=%q: %w", shardName, err)
 		}
 		return nil
@@ -1736,14 +1828,14 @@ func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID,
 	// no replication, local shard
 	i.backupMutex.RLock()
 	defer i.backupMutex.RUnlock()
-	if err = shard.DeleteObject(ctx, id); err != nil {
+	if err = shard.DeleteObject(ctx, id, expectedVersion); err != nil {
 		return fmt.Errorf("delete local object: shard=%q: %w", shardName, err)
 	}
 	return nil
 }
 
 func (i *Index) IncomingDeleteObject(ctx context.Context, shardName string,
-	id strfmt.UUID, schemaVersion uint64,
+	id strfmt.UUID, expectedVersion *int64, schemaVersion uint64,
 ) error {
 	i.backupMutex.RLock()
 	defer i.backupMutex.RUnlock()
@@ -1754,7 +1846,7 @@ func (i *Index) IncomingDeleteObject(ctx context.Context, shardName string,
 	}
 	defer release()
 
-	return shard.DeleteObject(ctx, id)
+	return shard.DeleteObject(ctx, id, expectedVersion)
 }
 
 // func (i *Index) localShard(name string) ShardLike {
@@ -1836,6 +1928,15 @@ func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument,
 		return objects.NewErrInvalidUserInput("determine shard: %v", err)
 	}
 
+	if err := i.mergeObjectInShard(ctx, shardName, merge, replProps, schemaVersion); err != nil {
+		return err
+	}
+	return i.reshardingMergeObject(ctx, merge.ID, replProps, schemaVersion)
+}
+
+func (i *Index) mergeObjectInShard(ctx context.Context, shardName string, merge objects.MergeDocument,
+	replProps *additional.ReplicationProperties, schemaVersion uint64,
+) error {
 	if i.replicationEnabled() {
 		if replProps == nil {
 			replProps = defaultConsistency()
@@ -2286,26 +2387,7 @@ func (i *Index) batchDeleteObjects(ctx context.Context, shardUUIDs map[string][]
 		f := func() {
 			defer wg.Done()
 
-			var objs objects.BatchSimpleObjects
-			if i.replicationEnabled() {
-				objs = i.replicator.DeleteObjects(ctx, shardName, uuids,
-					dryRun, replica.ConsistencyLevel(replProps.ConsistencyLevel), schemaVersion)
-			} else {
-				shard, release, err := i.getLocalShardNoShutdown(shardName)
-				if err != nil {
-					objs = objects.BatchSimpleObjects{
-						objects.BatchSimpleObject{Err: err},
-					}
-				} else if shard != nil {
-					i.backupMutex.RLockGuard(func() error {
-						defer release()
-						objs = shard.DeleteObjectBatch(ctx, uuids, dryRun)
-						return nil
-					})
-				} else {
-					objs = i.remote.DeleteObjectBatch(ctx, shardName, uuids, dryRun, schemaVersion)
-				}
-			}
+			objs := i.deleteObjectBatchFromShard(ctx, shardName, uuids, dryRun, replProps, schemaVersion)
 
 			ch <- result{objs}
 		}
@@ -2320,9 +2402,88 @@ func (i *Index) batchDeleteObjects(ctx context.Context, shardUUIDs map[string][]
 		out = append(out, res.objs...)
 	}
 
+	if !dryRun {
+		i.reshardingDeleteObjectBatch(ctx, out, replProps, schemaVersion)
+	}
+
 	return out, nil
 }
 
+func (i *Index) deleteObjectBatchFromShard(ctx context.Context, shardName string, uuids []strfmt.UUID,
+	dryRun bool, replProps *additional.ReplicationProperties, schemaVersion uint64,
+) objects.BatchSimpleObjects {
+	if i.replicationEnabled() {
+		return i.replicator.DeleteObjects(ctx, shardName, uuids,
+			dryRun, replica.ConsistencyLevel(replProps.ConsistencyLevel), schemaVersion)
+	}
+
+	shard, release, err := i.getLocalShardNoShutdown(shardName)
+	if err != nil {
+		return objects.BatchSimpleObjects{
+			objects.BatchSimpleObject{Err: err},
+		}
+	}
+	if shard == nil {
+		return i.remote.DeleteObjectBatch(ctx, shardName, uuids, dryRun, schemaVersion)
+	}
+
+	var objs objects.BatchSimpleObjects
+	i.backupMutex.RLockGuard(func() error {
+		defer release()
+		objs = shard.DeleteObjectBatch(ctx, uuids, dryRun)
+		return nil
+	})
+	return objs
+}
+
+// batchUpdateObjects merges the changes into the objects, shards are updated
+// in parallel
+func (i *Index) batchUpdateObjects(ctx context.Context, shardUUIDs map[string][]strfmt.UUID,
+	params objects.BatchUpdateParams, replProps *additional.ReplicationProperties, schemaVersion uint64,
+) objects.BatchSimpleObjects {
+	wg := &sync.WaitGroup{}
+	ch := make(chan objects.BatchSimpleObjects, len(shardUUIDs))
+	for shardName, uuids := range shardUUIDs {
+		uuids := uuids
+		shardName := shardName
+		wg.Add(1)
+		f := func() {
+			defer wg.Done()
+
+			objs := make(objects.BatchSimpleObjects, len(uuids))
+			for j, id := range uuids {
+				objs[j].UUID = id
+				if params.DryRun {
+					continue
+				}
+				merge := objects.MergeDocument{
+					Class:              i.Config.ClassName.String(),
+					ID:                 id,
+					PrimitiveSchema:    params.Changes.Set,
+					PropertiesToDelete: params.Changes.Unset,
+					PropertiesToAppend: params.Changes.Append,
+					UpdateTime:         params.UpdateTime,
+					RequireExisting:    true,
+				}
+				if objs[j].Err = i.mergeObjectInShard(ctx, shardName, merge, replProps, schemaVersion); objs[j].Err == nil {
+					objs[j].Err = i.reshardingMergeObject(ctx, id, replProps, schemaVersion)
+				}
+			}
+			ch <- objs
+		}
+		enterrors.GoWrapper(f, i.logger)
+	}
+
+	wg.Wait()
+	close(ch)
+
+	var out objects.BatchSimpleObjects
+	for objs := range ch {
+		out = append(out, objs...)
+	}
+	return out
+}
+
 func (i *Index) IncomingDeleteObjectBatch(ctx context.Context, shardName string,
 	uuids []strfmt.UUID, dryRun bool, schemaVersion uint64,
 ) objects.BatchSimpleObjects {
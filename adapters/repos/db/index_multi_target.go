@@ -0,0 +1,251 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// objectMultiTargetVectorSearch searches several named vectors at once. The
+// candidates found in the vector index of each target are merged and ranked
+// by the combination of their exact distances to all targets.
+func (i *Index) objectMultiTargetVectorSearch(ctx context.Context, searchVectors [][]float32,
+	targetVectors []string, combination *dto.TargetCombination, dist float32, limit int,
+	filters *filters.LocalFilter, addl additional.Properties,
+	replProps *additional.ReplicationProperties, tenant string,
+) ([]*storobj.Object, []float32, error) {
+	if len(searchVectors) != len(targetVectors) {
+		return nil, nil, fmt.Errorf("got %d search vectors for %d target vectors",
+			len(searchVectors), len(targetVectors))
+	}
+	if combination == nil {
+		combination = &dto.TargetCombination{Type: dto.Minimum}
+	}
+	providers, err := i.targetDistanceProviders(targetVectors)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the vectors of all targets are needed to compute the exact distances
+	candidateAddl := addl
+	candidateAddl.Vectors = targetVectors
+
+	candidateLimit := limit
+	if limit < 0 {
+		candidateLimit = hnsw.DefaultSearchByDistInitialLimit
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(i.logger, "tenant:", tenant)
+	m := &sync.Mutex{}
+	candidates := map[strfmt.UUID]*storobj.Object{}
+	for j := range targetVectors {
+		j := j
+		eg.Go(func() error {
+			res, _, err := i.objectVectorSearch(ctx, searchVectors[j], targetVectors[j], 0,
+				candidateLimit, filters, nil, nil, candidateAddl, replProps, tenant)
+			if err != nil {
+				return errors.Wrapf(err, "target vector %s", targetVectors[j])
+			}
+			m.Lock()
+			defer m.Unlock()
+			for _, obj := range res {
+				if _, ok := candidates[obj.ID()]; !ok {
+					candidates[obj.ID()] = obj
+				}
+			}
+			return nil
+		}, targetVectors[j])
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	objs := make([]*storobj.Object, 0, len(candidates))
+	targetDists := make([][]float32, 0, len(candidates))
+	for _, obj := range candidates {
+		dists, ok, err := targetDistances(obj, searchVectors, targetVectors, providers)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			// the object has no vector for at least one of the targets
+			continue
+		}
+		objs = append(objs, obj)
+		targetDists = append(targetDists, dists)
+	}
+
+	dists, err := combineTargetDistances(targetDists, targetVectors, combination)
+	if err != nil {
+		return nil, nil, err
+	}
+	out, dists := newDistancesSorter().sort(objs, dists)
+
+	if dist > 0 {
+		cut := len(dists)
+		for j, d := range dists {
+			if d > dist {
+				cut = j
+				break
+			}
+		}
+		out, dists = out[:cut], dists[:cut]
+	}
+	if limit > 0 && len(out) > limit {
+		out, dists = out[:limit], dists[:limit]
+	}
+
+	if len(addl.Vectors) == 0 {
+		for _, obj := range out {
+			obj.Vectors = nil
+			obj.Object.Vectors = nil
+		}
+	}
+	return out, dists, nil
+}
+
+func (i *Index) targetDistanceProviders(targetVectors []string) ([]distancer.Provider, error) {
+	i.vectorIndexUserConfigLock.Lock()
+	defer i.vectorIndexUserConfigLock.Unlock()
+
+	providers := make([]distancer.Provider, len(targetVectors))
+	for j, target := range targetVectors {
+		cfg, ok := i.vectorIndexUserConfigs[target]
+		if !ok {
+			return nil, fmt.Errorf("target vector %q not found", target)
+		}
+		provider, err := distanceProvider(cfg.DistanceName())
+		if err != nil {
+			return nil, fmt.Errorf("target vector %q: %w", target, err)
+		}
+		providers[j] = provider
+	}
+	return providers, nil
+}
+
+// targetDistances returns the exact distances of the object to all search
+// vectors, or false if the object has no vector for one of the targets
+func targetDistances(obj *storobj.Object, searchVectors [][]float32,
+	targetVectors []string, providers []distancer.Provider,
+) ([]float32, bool, error) {
+	dists := make([]float32, len(targetVectors))
+	for j, target := range targetVectors {
+		vec := obj.Vectors[target]
+		if len(vec) == 0 {
+			return nil, false, nil
+		}
+		query := searchVectors[j]
+		if providers[j].Type() == "cosine-dot" {
+			query, vec = distancer.Normalize(query), distancer.Normalize(vec)
+		}
+		d, ok, err := providers[j].SingleDist(query, vec)
+		if err != nil {
+			return nil, false, fmt.Errorf("distance to target vector %q: %w", target, err)
+		}
+		if !ok {
+			return nil, false, nil
+		}
+		dists[j] = d
+	}
+	return dists, true, nil
+}
+
+// combineTargetDistances combines the distances of every object to all
+// targets into a single distance
+func combineTargetDistances(targetDists [][]float32, targetVectors []string,
+	combination *dto.TargetCombination,
+) ([]float32, error) {
+	weights := make([]float32, len(targetVectors))
+	for j, target := range targetVectors {
+		w, ok := combination.Weights[target]
+		switch {
+		case ok:
+			weights[j] = w
+		case combination.Type == dto.ManualWeights:
+			return nil, fmt.Errorf("no weight for target vector %q", target)
+		default:
+			weights[j] = 1
+		}
+	}
+
+	if combination.Type == dto.RelativeScore {
+		normalizeTargetDistances(targetDists, len(targetVectors))
+	}
+
+	dists := make([]float32, len(targetDists))
+	for k, td := range targetDists {
+		switch combination.Type {
+		case dto.Minimum:
+			d := float32(math.MaxFloat32)
+			for _, v := range td {
+				if v < d {
+					d = v
+				}
+			}
+			dists[k] = d
+		case dto.Sum:
+			for _, v := range td {
+				dists[k] += v
+			}
+		case dto.Average:
+			for _, v := range td {
+				dists[k] += v
+			}
+			dists[k] /= float32(len(td))
+		case dto.ManualWeights, dto.RelativeScore:
+			for j, v := range td {
+				dists[k] += weights[j] * v
+			}
+		default:
+			return nil, fmt.Errorf("unknown target combination %d", combination.Type)
+		}
+	}
+	return dists, nil
+}
+
+// normalizeTargetDistances scales the distances to each target to [0, 1]
+// across all objects, so that targets with different distance ranges have
+// the same influence on the combined distance
+func normalizeTargetDistances(targetDists [][]float32, targets int) {
+	for j := 0; j < targets; j++ {
+		lo, hi := float32(math.MaxFloat32), float32(-math.MaxFloat32)
+		for _, td := range targetDists {
+			if td[j] < lo {
+				lo = td[j]
+			}
+			if td[j] > hi {
+				hi = td[j]
+			}
+		}
+		for _, td := range targetDists {
+			if hi > lo {
+				td[j] = (td[j] - lo) / (hi - lo)
+			} else {
+				td[j] = 0
+			}
+		}
+	}
+}
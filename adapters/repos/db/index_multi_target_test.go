@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func Test_CombineTargetDistances(t *testing.T) {
+	targets := []string{"first", "second"}
+	givenDists := func() [][]float32 {
+		return [][]float32{
+			{0.1, 0.9},
+			{0.5, 0.3},
+			{0.3, 0.5},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		combination *dto.TargetCombination
+		expected    []float32
+		expectedErr string
+	}{
+		{
+			name:        "minimum",
+			combination: &dto.TargetCombination{Type: dto.Minimum},
+			expected:    []float32{0.1, 0.3, 0.3},
+		},
+		{
+			name:        "sum",
+			combination: &dto.TargetCombination{Type: dto.Sum},
+			expected:    []float32{1.0, 0.8, 0.8},
+		},
+		{
+			name:        "average",
+			combination: &dto.TargetCombination{Type: dto.Average},
+			expected:    []float32{0.5, 0.4, 0.4},
+		},
+		{
+			name: "manual weights",
+			combination: &dto.TargetCombination{
+				Type:    dto.ManualWeights,
+				Weights: map[string]float32{"first": 2, "second": 0.5},
+			},
+			expected: []float32{0.65, 1.15, 0.85},
+		},
+		{
+			name: "manual weights with missing weight",
+			combination: &dto.TargetCombination{
+				Type:    dto.ManualWeights,
+				Weights: map[string]float32{"first": 2},
+			},
+			expectedErr: `no weight for target vector "second"`,
+		},
+		{
+			name:        "relative score",
+			combination: &dto.TargetCombination{Type: dto.RelativeScore},
+			// first: 0.1 -> 0, 0.5 -> 1, 0.3 -> 0.5
+			// second: 0.9 -> 1, 0.3 -> 0, 0.5 -> 1/3
+			expected: []float32{1, 1, 0.5 + 1.0/3},
+		},
+		{
+			name: "relative score with weights",
+			combination: &dto.TargetCombination{
+				Type:    dto.RelativeScore,
+				Weights: map[string]float32{"second": 3},
+			},
+			expected: []float32{3, 1, 1.5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dists, err := combineTargetDistances(givenDists(), targets, tt.combination)
+			if tt.expectedErr != "" {
+				require.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.Nil(t, err)
+			assert.InDeltaSlice(t, tt.expected, dists, 1e-6)
+		})
+	}
+}
+
+func Test_NormalizeTargetDistances_SameDistances(t *testing.T) {
+	dists := [][]float32{{0.4, 0.2}, {0.4, 0.6}}
+	normalizeTargetDistances(dists, 2)
+	assert.Equal(t, [][]float32{{0, 0}, {0, 1}}, dists)
+}
+
+func Test_TargetDistances(t *testing.T) {
+	providers := []distancer.Provider{
+		distancer.NewL2SquaredProvider(),
+		distancer.NewCosineDistanceProvider(),
+	}
+	targets := []string{"first", "second"}
+	searchVectors := [][]float32{{1, 0}, {0, 2}}
+
+	t.Run("with all target vectors", func(t *testing.T) {
+		obj := &storobj.Object{Vectors: map[string][]float32{
+			"first":  {3, 0},
+			"second": {0, 5},
+		}}
+		dists, ok, err := targetDistances(obj, searchVectors, targets, providers)
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.InDeltaSlice(t, []float32{4, 0}, dists, 1e-6)
+	})
+
+	t.Run("with a missing target vector", func(t *testing.T) {
+		obj := &storobj.Object{Vectors: map[string][]float32{
+			"first": {3, 0},
+		}}
+		_, ok, err := targetDistances(obj, searchVectors, targets, providers)
+		require.Nil(t, err)
+		assert.False(t, ok)
+	})
+}
@@ -126,6 +126,11 @@ type upgradableIndexer interface {
 	ShouldUpgrade() (bool, int)
 }
 
+type downgradableIndexer interface {
+	ShouldDowngrade() bool
+	Downgrade(callback func()) error
+}
+
 type shardStatusUpdater interface {
 	compareAndSwapStatus(old, new string) (storagestate.Status, error)
 }
@@ -640,12 +645,23 @@ func (q *IndexQueue) checkCompressionSettings() bool {
 		return false
 	}
 
+	if di, ok := q.Index.(downgradableIndexer); ok && di.ShouldDowngrade() {
+		q.pauseIndexing()
+		err := di.Downgrade(q.resumeIndexing)
+		if err != nil {
+			q.Logger.WithError(err).Error("failed to downgrade")
+		}
+
+		return true
+	}
+
 	shouldUpgrade, shouldUpgradeAt := ci.ShouldUpgrade()
 	if !shouldUpgrade || ci.Upgraded() {
 		return false
 	}
 
-	if q.Index.AlreadyIndexed() > uint64(shouldUpgradeAt) {
+	// a negative threshold requests the upgrade regardless of the size
+	if shouldUpgradeAt < 0 || q.Index.AlreadyIndexed() > uint64(shouldUpgradeAt) {
 		q.pauseIndexing()
 		err := ci.Upgrade(q.resumeIndexing)
 		if err != nil {
@@ -0,0 +1,287 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// While a class is resharded, every write is applied to the shard of the
+// current layout first and then to the shard of the new layout, so that the
+// new shards stay consistent with the current ones while the existing
+// objects are copied over in the background.
+
+// setResharding sets the sharding state whose resharding layout receives
+// writes in addition to the current layout. A state without resharding
+// layout stops the additional writes.
+func (i *Index) setResharding(state *sharding.State) {
+	if state == nil || state.Resharding == nil {
+		i.resharding.Store(nil)
+		return
+	}
+	i.resharding.Store(state)
+}
+
+// reshardingShard returns the shard of the new layout an object is written
+// to, or an empty string if the class is not being resharded
+func (i *Index) reshardingShard(id strfmt.UUID) string {
+	state := i.resharding.Load()
+	if state == nil {
+		return ""
+	}
+	parsed, err := uuid.Parse(id.String())
+	if err != nil {
+		return ""
+	}
+	return state.ReshardingShard(parsed[:])
+}
+
+func (i *Index) reshardingPutObject(ctx context.Context, object *storobj.Object,
+	replProps *additional.ReplicationProperties, schemaVersion uint64,
+) error {
+	target := i.reshardingShard(object.ID())
+	if target == "" {
+		return nil
+	}
+	if err := i.putObjectToShard(ctx, target, object, replProps, schemaVersion); err != nil {
+		return fmt.Errorf("resharding target: %w", err)
+	}
+	return nil
+}
+
+// reshardingMergeObject writes the merged object to the shard of the new
+// layout. The whole object is written, since the shard might not contain the
+// object yet, in which case merging would create an incomplete object.
+func (i *Index) reshardingMergeObject(ctx context.Context, id strfmt.UUID,
+	replProps *additional.ReplicationProperties, schemaVersion uint64,
+) error {
+	target := i.reshardingShard(id)
+	if target == "" {
+		return nil
+	}
+	merged, err := i.objectByID(ctx, id, nil, additional.Properties{}, replProps, "")
+	if err != nil {
+		return fmt.Errorf("resharding target: get merged object: %w", err)
+	}
+	if merged == nil {
+		return nil // deleted in the meantime
+	}
+	if err := i.putObjectToShard(ctx, target, merged, replProps, schemaVersion); err != nil {
+		return fmt.Errorf("resharding target: %w", err)
+	}
+	return nil
+}
+
+func (i *Index) reshardingDeleteObject(ctx context.Context, id strfmt.UUID,
+	replProps *additional.ReplicationProperties, schemaVersion uint64,
+) error {
+	target := i.reshardingShard(id)
+	if target == "" {
+		return nil
+	}
+	if err := i.deleteObjectFromShard(ctx, target, id, nil, replProps, schemaVersion); err != nil {
+		return fmt.Errorf("resharding target: %w", err)
+	}
+	return nil
+}
+
+// reshardingPutObjectBatch writes the objects which were written
+// successfully to the shards of the new layout. Errors are reported at the
+// position of the object in out.
+func (i *Index) reshardingPutObjectBatch(ctx context.Context, objs []*storobj.Object,
+	out []error, replProps *additional.ReplicationProperties, schemaVersion uint64,
+) {
+	if i.resharding.Load() == nil {
+		return
+	}
+	type objsAndPos struct {
+		objects []*storobj.Object
+		pos     []int
+	}
+	byShard := map[string]objsAndPos{}
+	for pos, obj := range objs {
+		if out[pos] != nil {
+			continue
+		}
+		target := i.reshardingShard(obj.ID())
+		if target == "" {
+			continue
+		}
+		group := byShard[target]
+		group.objects = append(group.objects, obj)
+		group.pos = append(group.pos, pos)
+		byShard[target] = group
+	}
+
+	for target, group := range byShard {
+		errs := i.putObjectBatchToShard(ctx, target, group.objects, replProps, schemaVersion)
+		for j, err := range errs {
+			if err != nil && j < len(group.pos) {
+				out[group.pos[j]] = fmt.Errorf("resharding target: %w", err)
+			}
+		}
+	}
+}
+
+// reshardingDeleteObjectBatch deletes the objects which were deleted
+// successfully from the shards of the new layout. Errors are reported in the
+// entry of the object in out.
+func (i *Index) reshardingDeleteObjectBatch(ctx context.Context, out objects.BatchSimpleObjects,
+	replProps *additional.ReplicationProperties, schemaVersion uint64,
+) {
+	if i.resharding.Load() == nil {
+		return
+	}
+	byShard := map[string][]int{}
+	for pos, obj := range out {
+		if obj.Err != nil {
+			continue
+		}
+		if target := i.reshardingShard(obj.UUID); target != "" {
+			byShard[target] = append(byShard[target], pos)
+		}
+	}
+
+	for target, positions := range byShard {
+		uuids := make([]strfmt.UUID, len(positions))
+		for j, pos := range positions {
+			uuids[j] = out[pos].UUID
+		}
+		res := i.deleteObjectBatchFromShard(ctx, target, uuids, false, replProps, schemaVersion)
+		errs := make(map[strfmt.UUID]error, len(res))
+		for _, r := range res {
+			if r.Err != nil {
+				errs[r.UUID] = r.Err
+			}
+		}
+		for _, pos := range positions {
+			if err, ok := errs[out[pos].UUID]; ok {
+				out[pos].Err = fmt.Errorf("resharding target: %w", err)
+			}
+		}
+	}
+}
+
+// reshardingAddReferences adds the references which were added successfully
+// to the shards of the new layout. Errors are reported at the position of
+// the reference in out.
+func (i *Index) reshardingAddReferences(ctx context.Context, refs objects.BatchReferences,
+	out []error, replProps *additional.ReplicationProperties, schemaVersion uint64,
+) {
+	if i.resharding.Load() == nil {
+		return
+	}
+	type refsAndPos struct {
+		refs objects.BatchReferences
+		pos  []int
+	}
+	byShard := map[string]refsAndPos{}
+	for pos, ref := range refs {
+		if out[pos] != nil {
+			continue
+		}
+		target := i.reshardingShard(ref.From.TargetID)
+		if target == "" {
+			continue
+		}
+		group := byShard[target]
+		group.refs = append(group.refs, ref)
+		group.pos = append(group.pos, pos)
+		byShard[target] = group
+	}
+
+	for target, group := range byShard {
+		errs := i.addReferencesToShard(ctx, target, group.refs, replProps, schemaVersion)
+		for j, err := range errs {
+			if err != nil && j < len(group.pos) {
+				out[group.pos[j]] = fmt.Errorf("resharding target: %w", err)
+			}
+		}
+	}
+}
+
+// ReshardShard copies the objects of the token ranges a local shard of the
+// new layout owns from the shards of the current layout. Objects written in
+// the meantime reach the shard through dual writes already, so the second
+// pass only removes objects which were deleted while being copied.
+func (db *DB) ReshardShard(ctx context.Context, class, shard string) error {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return enterrors.NewErrNotFound(fmt.Errorf("class %q not found", class))
+	}
+	state := db.schemaGetter.CopyShardingState(class)
+	if state == nil || state.Resharding == nil {
+		return enterrors.NewErrUnprocessable(fmt.Errorf("class %q is not being resharded", class))
+	}
+	if phys, ok := state.Resharding.Physical[shard]; !ok ||
+		!slices.Contains(phys.BelongsToNodes, db.schemaGetter.NodeName()) {
+		return enterrors.NewErrNotFound(fmt.Errorf("shard %q not found on this node", shard))
+	}
+
+	localShard, release, err := idx.getOrInitLocalShardNoShutdown(ctx, shard)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	ranges := state.ReshardingSources(shard)
+	for pass := 0; pass < 2; pass++ {
+		since := time.Now().UnixMilli()
+		for _, r := range ranges {
+			host, err := db.reshardingSourceHost(state, r.Source)
+			if err != nil {
+				return err
+			}
+			copied, deleted, err := localShard.copyTokenRange(ctx, r.Source, host, r, since)
+			if err != nil {
+				return fmt.Errorf("copy tokens [%d, %d] of shard %q: %w", r.From, r.To, r.Source, err)
+			}
+			db.logger.WithField("action", "reshard_shard").
+				WithField("class", class).
+				WithField("shard", shard).
+				WithField("source_shard", r.Source).
+				WithField("pass", pass).
+				WithField("objects_copied", copied).
+				WithField("objects_deleted", deleted).
+				Debug("token range copied")
+		}
+	}
+	return nil
+}
+
+// reshardingSourceHost returns the host of an owner of a shard of the
+// current layout, preferring the local node
+func (db *DB) reshardingSourceHost(state *sharding.State, source string) (string, error) {
+	owners := state.Physical[source].BelongsToNodes
+	if local := db.schemaGetter.NodeName(); slices.Contains(owners, local) {
+		owners = append([]string{local}, owners...)
+	}
+	for _, node := range owners {
+		if host, ok := db.nodeResolver.NodeHostname(node); ok && host != "" {
+			return host, nil
+		}
+	}
+	return "", fmt.Errorf("cannot resolve any owner of shard %q", source)
+}
@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+// putObjectBatchTransactional puts all objects or none of them, see
+// Shard.PutObjectBatchTransactional. All objects must belong to the same
+// shard. On replicated shards every replica applies the transaction on its
+// own, replicas which failed are repaired like after any other failed write.
+func (i *Index) putObjectBatchTransactional(ctx context.Context, objects []*storobj.Object,
+	expectedVersions []*int64, replProps *additional.ReplicationProperties, schemaVersion uint64,
+) []error {
+	shardName, err := i.transactionShard(objects)
+	if err != nil {
+		return duplicateErr(err, len(objects))
+	}
+
+	var out []error
+	shard, release, err := i.getLocalShardNoShutdown(shardName)
+	if err != nil {
+		return duplicateErr(err, len(objects))
+	}
+	if shard != nil && replProps == nil && !i.replicationEnabled() {
+		i.backupMutex.RLockGuard(func() error {
+			defer release()
+			out = shard.PutObjectBatchTransactional(ctx, objects, expectedVersions)
+			return nil
+		})
+	} else {
+		release()
+		// shards of other nodes are written through the replication protocol,
+		// which transports the transaction, even if the class is not replicated
+		if replProps == nil {
+			replProps = defaultConsistency()
+		}
+		out = i.replicator.PutObjectsTransactional(ctx, shardName, objects, expectedVersions,
+			replica.ConsistencyLevel(replProps.ConsistencyLevel), schemaVersion)
+	}
+
+	if firstError(out) == nil {
+		i.reshardingPutObjectBatch(ctx, objects, out, replProps, schemaVersion)
+	}
+	return out
+}
+
+// putObjectBatchConditional puts objects which have expected versions. The
+// objects of each shard are put in one transaction, which checks the versions
+// atomically with the writes. If the transaction of a shard fails, its objects
+// are put one by one, so that each object gets its own error and the other
+// objects are written nonetheless.
+func (i *Index) putObjectBatchConditional(ctx context.Context, objects []*storobj.Object,
+	expectedVersions []*int64, replProps *additional.ReplicationProperties, schemaVersion uint64,
+) []error {
+	type group struct {
+		objects  []*storobj.Object
+		versions []*int64
+		pos      []int
+	}
+
+	out := make([]error, len(objects))
+	byShard := map[string]*group{}
+	var shards []string
+	for pos, obj := range objects {
+		shardName, err := i.transactionShard(objects[pos : pos+1])
+		if err != nil {
+			out[pos] = err
+			continue
+		}
+		g, ok := byShard[shardName]
+		if !ok {
+			g = &group{}
+			byShard[shardName] = g
+			shards = append(shards, shardName)
+		}
+		g.objects = append(g.objects, obj)
+		g.versions = append(g.versions, expectedVersions[pos])
+		g.pos = append(g.pos, pos)
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(i.logger)
+	for _, shardName := range shards {
+		g := byShard[shardName]
+		eg.Go(func() error {
+			errs := i.putObjectBatchTransactional(ctx, g.objects, g.versions, replProps, schemaVersion)
+			if firstError(errs) != nil && len(g.objects) > 1 {
+				for j := range g.objects {
+					errs[j] = i.putObjectBatchTransactional(ctx, g.objects[j:j+1], g.versions[j:j+1],
+						replProps, schemaVersion)[0]
+				}
+			}
+			for j, err := range errs {
+				out[g.pos[j]] = err
+			}
+			return nil
+		})
+	}
+	eg.Wait()
+
+	return out
+}
+
+// transactionShard returns the shard all objects of a transaction belong to
+func (i *Index) transactionShard(objects []*storobj.Object) (string, error) {
+	if len(objects) == 0 {
+		return "", fmt.Errorf("transaction without objects")
+	}
+
+	tenant := objects[0].Object.Tenant
+	if err := i.validateMultiTenancy(tenant); err != nil {
+		return "", err
+	}
+	tenantsStatus := map[string]string{}
+	if tenant != "" {
+		var err error
+		tenantsStatus, err = i.getSchema.TenantsShards(i.Config.ClassName.String(), tenant)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var shardName string
+	for _, obj := range objects {
+		if obj.Object.Tenant != tenant {
+			return "", fmt.Errorf("transaction spans tenants %q and %q, all objects must belong to the same tenant",
+				tenant, obj.Object.Tenant)
+		}
+		name, err := i.determineObjectShardByStatus(obj.ID(), tenant, tenantsStatus)
+		if err != nil {
+			return "", err
+		}
+		if shardName != "" && name != shardName {
+			return "", fmt.Errorf("transaction spans shards %q and %q, all objects must belong to the same shard",
+				shardName, name)
+		}
+		shardName = name
+	}
+
+	return shardName, nil
+}
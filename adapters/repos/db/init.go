@@ -92,9 +92,17 @@ func (db *DB) init(ctx context.Context) error {
 				MemtablesMaxActiveSeconds: db.config.MemtablesMaxActiveSeconds,
 				MaxSegmentSize:            db.config.MaxSegmentSize,
 				HNSWMaxLogSize:            db.config.HNSWMaxLogSize,
+				HNSWSnapshotInterval:      db.config.HNSWSnapshotInterval,
+				LSMScrubInterval:          db.config.LSMScrubInterval,
+				LSMScrubRepair:            db.config.LSMScrubRepair,
+				LSMCompactionPolicy:       db.config.LSMCompactionPolicy,
+				LSMCompactionFanOut:       db.config.LSMCompactionFanOut,
+				ObjectsCompression:        objectsCompression(class),
 				TrackVectorDimensions:     db.config.TrackVectorDimensions,
 				AvoidMMap:                 db.config.AvoidMMap,
 				DisableLazyLoadShards:     db.config.DisableLazyLoadShards,
+				VectorCacheBudget:         db.vectorCacheBudget,
+				LSMTier:                   db.lsmTier,
 				ReplicationFactor:         NewAtomicInt64(class.ReplicationConfig.Factor),
 				AsyncReplicationEnabled:   class.ReplicationConfig.AsyncEnabled,
 			}, db.schemaGetter.CopyShardingState(class.Class),
@@ -120,7 +128,10 @@ func (db *DB) init(ctx context.Context) error {
 	// "n/a" that we need to actively aggregate node-wide metrics.
 	//
 	// See also https://github.com/weaviate/weaviate/issues/4396
-	if db.promMetrics != nil && db.promMetrics.Group {
+	//
+	// The observer also tracks the activity of tenants, which is needed
+	// regardless of metrics if it was asked for.
+	if db.groupedMetrics() || db.config.TrackTenantActivity {
 		db.metricsObserver = newNodeWideMetricsObserver(db)
 		enterrors.GoWrapper(func() { db.metricsObserver.Start() }, db.logger)
 	}
@@ -128,6 +139,10 @@ func (db *DB) init(ctx context.Context) error {
 	return nil
 }
 
+func (db *DB) groupedMetrics() bool {
+	return db.promMetrics != nil && db.promMetrics.Group
+}
+
 func (db *DB) LocalTenantActivity() tenantactivity.ByCollection {
 	return db.metricsObserver.Usage()
 }
@@ -250,7 +250,11 @@ func (m *filterableToSearchableMigrator) isPropToFix(ctx context.Context, prop *
 		if bucketSearchable != nil &&
 			bucketSearchable.Strategy() == lsmkv.StrategyMapCollection {
 
-			if m.isEmptyMapBucket(ctx, bucketSearchable) {
+			empty, err := m.isEmptyMapBucket(ctx, bucketSearchable)
+			if err != nil {
+				return false, err
+			}
+			if empty {
 				return true, nil
 			}
 			return false, fmt.Errorf("searchable bucket is not empty")
@@ -261,12 +265,15 @@ func (m *filterableToSearchableMigrator) isPropToFix(ctx context.Context, prop *
 	return false, nil
 }
 
-func (m *filterableToSearchableMigrator) isEmptyMapBucket(ctx context.Context, bucket *lsmkv.Bucket) bool {
-	cur := bucket.MapCursorKeyOnly()
+func (m *filterableToSearchableMigrator) isEmptyMapBucket(ctx context.Context, bucket *lsmkv.Bucket) (bool, error) {
+	cur, err := bucket.MapCursorKeyOnly()
+	if err != nil {
+		return false, err
+	}
 	defer cur.Close()
 
 	key, _ := cur.First(ctx)
-	return key == nil
+	return key == nil, nil
 }
 
 func (m *filterableToSearchableMigrator) pauseStoreActivity(
@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+// lsmTierBackupID is the backup the segments of the remote tier are stored
+// in, it is never listed as a backup as it has no backup descriptor
+const lsmTierBackupID = "lsm-tier"
+
+// lsmTierCacheDir holds the remote segments which are read, relative to the
+// data path
+const lsmTierCacheDir = "lsm-tier-cache"
+
+// backupTierStore stores the segments of the LSM remote tier with a backup
+// backend. The segments of a node are kept under its name, so that the nodes
+// of a cluster can share a backend. The backend must be able to delete the
+// segments which were compacted or dropped, they would be left in it
+// otherwise.
+type backupTierStore struct {
+	backend modulecapabilities.BackupBackend
+	deleter modulecapabilities.BackupBackendDeleter
+	node    string
+}
+
+func NewBackupTierStore(backend modulecapabilities.BackupBackend, node string) (lsmkv.RemoteStore, error) {
+	deleter, ok := backend.(modulecapabilities.BackupBackendDeleter)
+	if !ok {
+		return nil, fmt.Errorf("backup backend %s cannot delete objects, it cannot hold the remote tier",
+			backend.Name())
+	}
+	return &backupTierStore{backend: backend, deleter: deleter, node: node}, nil
+}
+
+func (s *backupTierStore) PutFile(ctx context.Context, key, srcPath string) error {
+	// backends read the files relative to the data path
+	rel, err := filepath.Rel(s.backend.SourceDataPath(), srcPath)
+	if err != nil {
+		return fmt.Errorf("segment %s is not in the data path of backend %s: %w",
+			srcPath, s.backend.Name(), err)
+	}
+	return s.backend.PutFile(ctx, lsmTierBackupID, path.Join(s.node, key), rel)
+}
+
+func (s *backupTierStore) WriteToFile(ctx context.Context, key, destPath string) error {
+	return s.backend.WriteToFile(ctx, lsmTierBackupID, path.Join(s.node, key), destPath)
+}
+
+func (s *backupTierStore) Delete(ctx context.Context, key string) error {
+	return s.deleter.Delete(ctx, lsmTierBackupID, path.Join(s.node, key))
+}
+
+func (db *DB) initLSMTier() error {
+	if db.config.LSMTierStore == nil {
+		return nil
+	}
+
+	tier, err := lsmkv.NewRemoteTier(lsmkv.RemoteTierConfig{
+		Store:     db.config.LSMTierStore,
+		Policy:    db.config.LSMTierPolicy,
+		RootPath:  db.config.RootPath,
+		CacheDir:  filepath.Join(db.config.RootPath, lsmTierCacheDir),
+		CacheSize: db.config.LSMTierCacheSize,
+		Logger:    db.logger,
+	})
+	if err != nil {
+		return err
+	}
+	db.lsmTier = tier
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"path/filepath"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -122,6 +123,26 @@ type Bucket struct {
 	// optional segment size limit. If set, a compaction will skip segments that
 	// sum to more than the specified value.
 	maxSegmentSize int64
+
+	// optional interval after which the checksums of a segment are verified
+	// again in the background, 0 disables the scrubber. onCorruption is called
+	// when a segment fails verification, either when read or scrubbed.
+	scrubInterval time.Duration
+	onCorruption  func(CorruptSegment)
+
+	// see WithCompactionPolicy
+	compactionPolicy string
+	compactionFanOut int
+
+	// codec new segments are compressed with, existing segments are rewritten
+	// by the compaction
+	codec atomic.Uint32
+
+	// see WithReadOnly
+	readOnly bool
+
+	// see WithRemoteTier
+	remoteTier *RemoteTier
 }
 
 func NewBucketCreator() *Bucket { return &Bucket{} }
@@ -142,10 +163,6 @@ func (*Bucket) NewBucket(ctx context.Context, dir, rootDir string, logger logrus
 	defaultFlushAfterDirty := FlushAfterDirtyDefault
 	defaultStrategy := StrategyReplace
 
-	if err := os.MkdirAll(dir, 0o700); err != nil {
-		return nil, err
-	}
-
 	b := &Bucket{
 		dir:                   dir,
 		rootDir:               rootDir,
@@ -153,6 +170,8 @@ func (*Bucket) NewBucket(ctx context.Context, dir, rootDir string, logger logrus
 		walThreshold:          defaultWalThreshold,
 		flushDirtyAfter:       defaultFlushAfterDirty,
 		strategy:              defaultStrategy,
+		compactionPolicy:      CompactionPolicyPairs,
+		compactionFanOut:      DefaultCompactionFanOut,
 		mmapContents:          true,
 		logger:                logger,
 		metrics:               metrics,
@@ -167,6 +186,12 @@ func (*Bucket) NewBucket(ctx context.Context, dir, rootDir string, logger logrus
 		}
 	}
 
+	if !b.readOnly {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return nil, err
+		}
+	}
+
 	if b.memtableResizer != nil {
 		b.memtableThreshold = uint64(b.memtableResizer.Initial())
 	}
@@ -183,6 +208,14 @@ func (*Bucket) NewBucket(ctx context.Context, dir, rootDir string, logger logrus
 			useBloomFilter:        b.useBloomFilter,
 			calcCountNetAdditions: b.calcCountNetAdditions,
 			maxSegmentSize:        b.maxSegmentSize,
+			scrubInterval:         b.scrubInterval,
+			onCorruption:          b.onCorruption,
+			codec:                 byte(b.codec.Load()),
+			compactionPolicy:      b.compactionPolicy,
+			compactionFanOut:      b.compactionFanOut,
+			compactionBaseSize:    int64(b.memtableThreshold),
+			readOnly:              b.readOnly,
+			tier:                  b.remoteTier,
 		}, b.allocChecker)
 	if err != nil {
 		return nil, fmt.Errorf("init disk segments: %w", err)
@@ -213,15 +246,30 @@ func (*Bucket) NewBucket(ctx context.Context, dir, rootDir string, logger logrus
 		sg.strategy = StrategyMapCollection
 	}
 
+	// read-only buckets are typically opened without knowing how they were
+	// created, the segments tell
+	if b.readOnly && len(sg.segments) > 0 {
+		b.strategy = strategyFromSegment(sg.segments[0].strategy)
+		b.secondaryIndices = sg.segments[0].secondaryIndexCount
+		sg.strategy = b.strategy
+	}
+
 	b.disk = sg
 
-	if err := b.mayRecoverFromCommitLogs(ctx); err != nil {
-		return nil, err
-	}
+	if b.readOnly {
+		if err := b.recoverFromCommitLogsIntoMemory(ctx); err != nil {
+			return nil, err
+		}
+		b.UpdateStatus(storagestate.StatusReadOnly)
+	} else {
+		if err := b.mayRecoverFromCommitLogs(ctx); err != nil {
+			return nil, err
+		}
 
-	err = b.setNewActiveMemtable()
-	if err != nil {
-		return nil, err
+		err = b.setNewActiveMemtable()
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	id := "bucket/flush/" + b.dir
@@ -252,6 +300,42 @@ func (b *Bucket) GetSecondaryIndices() uint16 {
 	return b.secondaryIndices
 }
 
+// CorruptSegments returns the segments of the bucket which failed checksum
+// verification
+func (b *Bucket) CorruptSegments() []CorruptSegment {
+	return b.disk.corruptSegments()
+}
+
+// QuarantineCorruptSegments removes the corrupted segments from the bucket
+// and returns their paths. Their data is lost and needs to be restored, e.g.
+// from replicas.
+func (b *Bucket) QuarantineCorruptSegments() ([]string, error) {
+	return b.disk.quarantineCorruptSegments()
+}
+
+// GetCompression returns the compression of new segments
+func (b *Bucket) GetCompression() string {
+	return codecToString(byte(b.codec.Load()))
+}
+
+// SetCompression changes the compression of new segments. Existing segments
+// are rewritten with the new compression in the background by the
+// compaction.
+func (b *Bucket) SetCompression(compression string) error {
+	codec, err := codecFromString(compression)
+	if err != nil {
+		return err
+	}
+
+	b.codec.Store(uint32(codec))
+	b.disk.setCodec(codec)
+
+	b.flushLock.RLock()
+	b.active.setCompression(codec, b.logger)
+	b.flushLock.RUnlock()
+	return nil
+}
+
 func (b *Bucket) GetStatus() storagestate.Status {
 	b.statusLock.RLock()
 	defer b.statusLock.RUnlock()
@@ -273,7 +357,10 @@ func (b *Bucket) GetFlushCallbackCtrl() cyclemanager.CycleCallbackCtrl {
 
 func (b *Bucket) IterateObjects(ctx context.Context, f func(object *storobj.Object) error) error {
 	i := 0
-	cursor := b.Cursor()
+	cursor, err := b.Cursor()
+	if err != nil {
+		return err
+	}
 	defer cursor.Close()
 
 	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
@@ -292,7 +379,10 @@ func (b *Bucket) IterateObjects(ctx context.Context, f func(object *storobj.Obje
 }
 
 func (b *Bucket) IterateMapObjects(ctx context.Context, f func([]byte, []byte, []byte, bool) error) error {
-	cursor := b.MapCursor()
+	cursor, err := b.MapCursor()
+	if err != nil {
+		return err
+	}
 	defer cursor.Close()
 
 	for kList, vList := cursor.First(ctx); kList != nil; kList, vList = cursor.Next(ctx) {
@@ -828,6 +918,7 @@ func (b *Bucket) setNewActiveMemtable() error {
 	if err != nil {
 		return err
 	}
+	mt.setCompression(byte(b.codec.Load()), b.logger)
 
 	b.active = mt
 	return nil
@@ -911,6 +1002,11 @@ func (b *Bucket) Shutdown(ctx context.Context) error {
 		return fmt.Errorf("long-running flush in progress: %w", ctx.Err())
 	}
 
+	if b.readOnly {
+		// nothing was written to disk, see WithReadOnly
+		return nil
+	}
+
 	b.flushLock.Lock()
 	if err := b.active.flush(); err != nil {
 		return err
@@ -991,6 +1087,10 @@ func (b *Bucket) UpdateStatus(status storagestate.Status) {
 	b.statusLock.Lock()
 	defer b.statusLock.Unlock()
 
+	if b.readOnly {
+		status = storagestate.StatusReadOnly
+	}
+
 	b.status = status
 	b.disk.UpdateStatus(status)
 }
@@ -1083,6 +1183,10 @@ func (b *Bucket) DesiredStrategy() string {
 // that the WAL is written before a successful response is returned to the
 // user.
 func (b *Bucket) WriteWAL() error {
+	if b.readOnly {
+		return nil
+	}
+
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
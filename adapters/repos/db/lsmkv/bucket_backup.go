@@ -69,12 +69,19 @@ func (b *Bucket) FlushMemtable() error {
 // ListFiles lists all files that currently exist in the Bucket. The files are only
 // in a stable state if the memtable is empty, and if compactions are paused. If one
 // of those conditions is not given, it errors
+//
+// Segments in the remote tier are downloaded first and listed in place of their
+// markers. The copies are removed when compactions are resumed.
 func (b *Bucket) ListFiles(ctx context.Context, basePath string) ([]string, error) {
 	var (
 		bucketRoot = b.disk.dir
 		files      []string
 	)
 
+	if err := b.disk.stageRemoteSegments(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to list files for bucket")
+	}
+
 	err := filepath.WalkDir(bucketRoot, func(currPath string, d fs.DirEntry, err error) error {
 		if d.IsDir() {
 			return nil
@@ -83,6 +90,10 @@ func (b *Bucket) ListFiles(ctx context.Context, basePath string) ([]string, erro
 		if filepath.Ext(currPath) == ".wal" {
 			return nil
 		}
+		// the remote segment was downloaded to the segment file
+		if filepath.Ext(currPath) == remoteMarkerExt {
+			return nil
+		}
 		files = append(files, path.Join(basePath, path.Base(currPath)))
 		return nil
 	})
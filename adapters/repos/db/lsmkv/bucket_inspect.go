@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/willf/bloom"
+)
+
+// SegmentInfo describes a disk segment of a bucket
+type SegmentInfo struct {
+	Path             string
+	Strategy         string
+	Version          uint16
+	Level            uint16
+	SecondaryIndices uint16
+	// Size of the segment file in bytes, the payload excludes the header and
+	// the indexes
+	Size        int64
+	PayloadSize int
+	// CountNetAdditions is the number of keys a segment of a replace bucket
+	// adds with respect to the segments below it
+	CountNetAdditions int
+	// Remote segments are in the remote tier, see WithRemoteTier
+	Remote bool
+}
+
+// Segments describes the disk segments of the bucket, oldest first
+func (b *Bucket) Segments() []SegmentInfo {
+	b.disk.maintenanceLock.RLock()
+	defer b.disk.maintenanceLock.RUnlock()
+
+	out := make([]SegmentInfo, len(b.disk.segments))
+	for i, seg := range b.disk.segments {
+		out[i] = SegmentInfo{
+			Path:              seg.path,
+			Strategy:          strategyFromSegment(seg.strategy),
+			Version:           seg.version,
+			Level:             seg.level,
+			SecondaryIndices:  seg.secondaryIndexCount,
+			Size:              seg.size,
+			PayloadSize:       seg.PayloadSize(),
+			CountNetAdditions: seg.countNetAdditions,
+			Remote:            seg.remote != nil,
+		}
+	}
+	return out
+}
+
+// SegmentVerification is the result of verifying a disk segment, the errors
+// are nil if the check passed or does not apply to the segment
+type SegmentVerification struct {
+	Path string
+	// Checksums of the segment data
+	Checksums error
+	// BloomFilters stored next to the segment must contain all its keys
+	BloomFilters error
+	// CountNetAdditions stored next to a replace segment must match a recount
+	CountNetAdditions error
+}
+
+// VerifySegments checks the data of all disk segments against their
+// checksums and the files derived from them against their contents. Missing
+// derived files are not an error, they are recreated on the next load.
+// Remote segments are fetched to be verified.
+func (b *Bucket) VerifySegments() []SegmentVerification {
+	b.disk.maintenanceLock.RLock()
+	segments := append([]*segment(nil), b.disk.segments...)
+	b.disk.maintenanceLock.RUnlock()
+
+	out := make([]SegmentVerification, len(segments))
+	for i, seg := range segments {
+		out[i].Path = seg.path
+		err := withRemoteSegments(b.disk.maintenanceLock.RLocker(), func() error {
+			// a segment which was replaced by a compaction in the meantime is
+			// not verified anymore
+			pos := slices.Index(b.disk.segments, seg)
+			if pos < 0 {
+				return nil
+			}
+			if err := seg.requireLoaded(); err != nil {
+				return err
+			}
+			out[i].Checksums = seg.verifyAll()
+			if b.useBloomFilter {
+				out[i].BloomFilters = seg.verifyBloomFilters()
+			}
+			if b.calcCountNetAdditions && seg.strategy == segmentindex.StrategyReplace {
+				err := seg.verifyCountNetAdditions(b.disk.makeExistsOnLower(pos))
+				var notLoaded *remoteNotLoadedError
+				if errors.As(err, &notLoaded) {
+					return err
+				}
+				out[i].CountNetAdditions = err
+			}
+			return nil
+		})
+		if err != nil {
+			out[i].Checksums = err
+		}
+	}
+	return out
+}
+
+func (s *segment) verifyBloomFilters() error {
+	if err := verifyBloomFilter(s.bloomFilterPath(), s.index); err != nil {
+		return err
+	}
+	for i := range s.secondaryIndices {
+		if err := verifyBloomFilter(s.bloomFilterSecondaryPath(i), s.secondaryIndices[i]); err != nil {
+			return fmt.Errorf("secondary index %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func verifyBloomFilter(path string, index diskIndex) error {
+	data, err := loadWithChecksum(path, -1)
+	if errors.Is(err, os.ErrNotExist) {
+		// derived files are created when the segment is loaded the next time
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("bloom filter %s: %w", path, err)
+	}
+
+	filter := new(bloom.BloomFilter)
+	if _, err := filter.ReadFrom(bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("read bloom filter %s: %w", path, err)
+	}
+
+	keys, err := index.AllKeys()
+	if err != nil {
+		return fmt.Errorf("read keys: %w", err)
+	}
+	missing := 0
+	for _, key := range keys {
+		if !filter.Test(key) {
+			missing++
+		}
+	}
+	if missing > 0 {
+		return fmt.Errorf("bloom filter %s is missing %d of %d keys", path, missing, len(keys))
+	}
+	return nil
+}
+
+func (s *segment) verifyCountNetAdditions(exists existsOnLowerSegmentsFn) error {
+	path := s.countNetPath()
+	data, err := loadWithChecksum(path, 12)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("net additions %s: %w", path, err)
+	}
+	stored := int(binary.LittleEndian.Uint64(data[0:8]))
+
+	counted, err := s.computeCountNetAdditions(exists)
+	if err != nil {
+		return fmt.Errorf("count net additions: %w", err)
+	}
+	if stored != counted {
+		return fmt.Errorf("net additions %s store %d, but the segment adds %d", path, stored, counted)
+	}
+	return nil
+}
@@ -127,6 +127,38 @@ func WithMonitorCount() BucketOption {
 	}
 }
 
+// WithCompression compresses the data of the segments of the bucket in
+// blocks, see ParseCompression for the supported compressions
+func WithCompression(compression string) BucketOption {
+	return func(b *Bucket) error {
+		codec, err := codecFromString(compression)
+		if err != nil {
+			return err
+		}
+		b.codec.Store(uint32(codec))
+		return nil
+	}
+}
+
+// WithCompactionPolicy selects which segments are compacted, see
+// CompactionPolicyPairs, CompactionPolicyTiered and CompactionPolicyLeveled.
+// The fan-out is the number of segments merged at once by the tiered policy
+// and the growth factor between the levels of the leveled policy.
+func WithCompactionPolicy(policy string, fanOut int) BucketOption {
+	return func(b *Bucket) error {
+		if err := ValidateCompactionPolicy(policy, fanOut); err != nil {
+			return err
+		}
+		if policy == "" {
+			policy = CompactionPolicyPairs
+		}
+
+		b.compactionPolicy = policy
+		b.compactionFanOut = fanOut
+		return nil
+	}
+}
+
 func WithKeepTombstones(keepTombstones bool) BucketOption {
 	return func(b *Bucket) error {
 		b.keepTombstones = keepTombstones
@@ -148,6 +180,30 @@ func WithCalcCountNetAdditions(calcCountNetAdditions bool) BucketOption {
 	}
 }
 
+// WithReadOnly opens the bucket without modifying its files, e.g. to inspect
+// the shard of a server which is not running. Write-ahead-logs are recovered
+// into memory, leftovers of interrupted flushes and compactions are ignored
+// and derived files such as bloom filters are not stored. The bucket is never
+// flushed nor compacted, writes are lost on shutdown.
+func WithReadOnly(readOnly bool) BucketOption {
+	return func(b *Bucket) error {
+		b.readOnly = readOnly
+		return nil
+	}
+}
+
+// WithRemoteTier moves the segments of the bucket which qualify for the
+// policy of the tier to its remote store. A nil tier keeps all segments
+// local, but a bucket with segments in the remote tier cannot be loaded
+// without one. Backups contain the data of remote segments, see
+// Bucket.ListFiles.
+func WithRemoteTier(tier *RemoteTier) BucketOption {
+	return func(b *Bucket) error {
+		b.remoteTier = tier
+		return nil
+	}
+}
+
 func WithMaxSegmentSize(maxSegmentSize int64) BucketOption {
 	return func(b *Bucket) error {
 		b.maxSegmentSize = maxSegmentSize
@@ -155,6 +211,19 @@ func WithMaxSegmentSize(maxSegmentSize int64) BucketOption {
 	}
 }
 
+// WithSegmentIntegrity verifies the checksums of every segment again after
+// scrubInterval (0 disables the scrubber) and calls onCorruption for every
+// segment which fails verification
+func WithSegmentIntegrity(scrubInterval time.Duration,
+	onCorruption func(CorruptSegment),
+) BucketOption {
+	return func(b *Bucket) error {
+		b.scrubInterval = scrubInterval
+		b.onCorruption = onCorruption
+		return nil
+	}
+}
+
 /*
 Background for this option:
 
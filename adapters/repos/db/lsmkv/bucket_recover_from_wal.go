@@ -69,6 +69,7 @@ func (b *Bucket) mayRecoverFromCommitLogs(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		mt.setCompression(byte(b.codec.Load()), b.logger)
 
 		b.logger.WithField("action", "lsm_recover_from_active_wal").
 			WithField("path", path).
@@ -108,3 +109,49 @@ func (b *Bucket) mayRecoverFromCommitLogs(ctx context.Context) error {
 
 	return nil
 }
+
+// recoverFromCommitLogsIntoMemory replays all write-ahead-logs into the
+// active memtable without modifying them, see WithReadOnly. The logs are
+// named by their creation time, later logs overwrite earlier ones.
+func (b *Bucket) recoverFromCommitLogsIntoMemory(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(err, "recover commit log")
+	}
+
+	list, err := os.ReadDir(b.dir)
+	if err != nil {
+		return err
+	}
+
+	// the paused commit logger never writes, the memtable is never flushed
+	cl := &commitLogger{paused: true}
+	mt, err := newMemtable(filepath.Join(b.dir, "segment-read-only"), b.strategy,
+		b.secondaryIndices, cl, b.metrics)
+	if err != nil {
+		return err
+	}
+	mt.setCompression(byte(b.codec.Load()), b.logger)
+
+	for _, fileInfo := range list {
+		if filepath.Ext(fileInfo.Name()) != ".wal" {
+			continue
+		}
+
+		path := filepath.Join(b.dir, fileInfo.Name())
+		f, err := os.Open(path)
+		if err != nil {
+			return errors.Wrap(err, "open commit log")
+		}
+
+		err = newCommitLoggerParser(b.strategy, bufio.NewReader(f), mt).Do()
+		f.Close()
+		if err != nil {
+			b.logger.WithField("action", "lsm_recover_from_active_wal_corruption").
+				WithField("path", path).
+				Error(errors.Wrap(err, "write-ahead-log ended abruptly, some elements may not have been recovered"))
+		}
+	}
+
+	b.active = mt
+	return nil
+}
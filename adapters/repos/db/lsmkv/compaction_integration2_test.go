@@ -197,7 +197,8 @@ func TestCompactionReplaceStrategyStraggler(t *testing.T) {
 	t.Run("verify control before compaction", func(t *testing.T) {
 		var retrieved []kv
 
-		c := bucket.Cursor()
+		c, err := bucket.Cursor()
+		require.Nil(t, err)
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -227,7 +228,8 @@ func TestCompactionReplaceStrategyStraggler(t *testing.T) {
 	t.Run("verify control after compaction", func(t *testing.T) {
 		var retrieved []kv
 
-		c := bucket.Cursor()
+		c, err := bucket.Cursor()
+		require.Nil(t, err)
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -57,7 +57,7 @@ func TestCompaction(t *testing.T) {
 		{
 			name: "compactionReplaceStrategy",
 			f: func(ctx context.Context, t *testing.T, opts []BucketOption) {
-				compactionReplaceStrategy(ctx, t, opts, 12116, 12116)
+				compactionReplaceStrategy(ctx, t, opts, 12140, 12140)
 			},
 			opts: []BucketOption{
 				WithStrategy(StrategyReplace),
@@ -66,7 +66,7 @@ func TestCompaction(t *testing.T) {
 		{
 			name: "compactionReplaceStrategy_KeepTombstones",
 			f: func(ctx context.Context, t *testing.T, opts []BucketOption) {
-				compactionReplaceStrategy(ctx, t, opts, 15266, 15266)
+				compactionReplaceStrategy(ctx, t, opts, 15290, 15290)
 			},
 			opts: []BucketOption{
 				WithStrategy(StrategyReplace),
@@ -156,7 +156,7 @@ func TestCompaction(t *testing.T) {
 		{
 			name: "compactionSetStrategy",
 			f: func(ctx context.Context, t *testing.T, opts []BucketOption) {
-				compactionSetStrategy(ctx, t, opts, 6836, 6836)
+				compactionSetStrategy(ctx, t, opts, 6860, 6860)
 			},
 			opts: []BucketOption{
 				WithStrategy(StrategySetCollection),
@@ -165,7 +165,7 @@ func TestCompaction(t *testing.T) {
 		{
 			name: "compactionSetStrategy_KeepTombstones",
 			f: func(ctx context.Context, t *testing.T, opts []BucketOption) {
-				compactionSetStrategy(ctx, t, opts, 9756, 9756)
+				compactionSetStrategy(ctx, t, opts, 9780, 9780)
 			},
 			opts: []BucketOption{
 				WithStrategy(StrategySetCollection),
@@ -206,7 +206,7 @@ func TestCompaction(t *testing.T) {
 		{
 			name: "compactionMapStrategy",
 			f: func(ctx context.Context, t *testing.T, opts []BucketOption) {
-				compactionMapStrategy(ctx, t, opts, 10676, 10676)
+				compactionMapStrategy(ctx, t, opts, 10700, 10700)
 			},
 			opts: []BucketOption{
 				WithStrategy(StrategyMapCollection),
@@ -215,7 +215,7 @@ func TestCompaction(t *testing.T) {
 		{
 			name: "compactionMapStrategy_KeepTombstones",
 			f: func(ctx context.Context, t *testing.T, opts []BucketOption) {
-				compactionMapStrategy(ctx, t, opts, 13416, 13416)
+				compactionMapStrategy(ctx, t, opts, 13440, 13440)
 			},
 			opts: []BucketOption{
 				WithStrategy(StrategyMapCollection),
@@ -271,7 +271,7 @@ func TestCompaction(t *testing.T) {
 		{
 			name: "compactionRoaringSetStrategy",
 			f: func(ctx context.Context, t *testing.T, opts []BucketOption) {
-				compactionRoaringSetStrategy(ctx, t, opts, 19168, 19168)
+				compactionRoaringSetStrategy(ctx, t, opts, 19192, 19192)
 			},
 			opts: []BucketOption{
 				WithStrategy(StrategyRoaringSet),
@@ -280,7 +280,7 @@ func TestCompaction(t *testing.T) {
 		{
 			name: "compactionRoaringSetStrategy_KeepTombstones",
 			f: func(ctx context.Context, t *testing.T, opts []BucketOption) {
-				compactionRoaringSetStrategy(ctx, t, opts, 29792, 29792)
+				compactionRoaringSetStrategy(ctx, t, opts, 29816, 29816)
 			},
 			opts: []BucketOption{
 				WithStrategy(StrategyRoaringSet),
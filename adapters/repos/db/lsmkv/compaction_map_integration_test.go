@@ -365,7 +365,8 @@ func compactionMapStrategy(ctx context.Context, t *testing.T, opts []BucketOptio
 	t.Run("verify control before compaction", func(t *testing.T) {
 		var retrieved []kv
 
-		c := bucket.MapCursor()
+		c, err := bucket.MapCursor()
+		require.Nil(t, err)
 		defer c.Close()
 
 		for k, v := c.First(ctx); k != nil; k, v = c.Next(ctx) {
@@ -387,7 +388,7 @@ func compactionMapStrategy(ctx context.Context, t *testing.T, opts []BucketOptio
 				// segment1 and segment2 merged
 				// none of them is root segment, so tombstones
 				// will not be removed regardless of keepTombstones setting
-				assertSecondSegmentOfSize(t, bucket, 11876, 11876)
+				assertSecondSegmentOfSize(t, bucket, 11900, 11900)
 			}
 			i++
 		}
@@ -397,7 +398,8 @@ func compactionMapStrategy(ctx context.Context, t *testing.T, opts []BucketOptio
 	t.Run("verify control after compaction using a cursor", func(t *testing.T) {
 		var retrieved []kv
 
-		c := bucket.MapCursor()
+		c, err := bucket.MapCursor()
+		require.Nil(t, err)
 		defer c.Close()
 
 		for k, v := c.First(ctx); k != nil; k, v = c.Next(ctx) {
@@ -508,7 +510,8 @@ func compactionMapStrategy_RemoveUnnecessary(ctx context.Context, t *testing.T,
 	t.Run("verify control before compaction", func(t *testing.T) {
 		var retrieved []kv
 
-		c := bucket.MapCursor()
+		c, err := bucket.MapCursor()
+		require.Nil(t, err)
 		defer c.Close()
 
 		for k, v := c.First(ctx); k != nil; k, v = c.Next(ctx) {
@@ -532,7 +535,8 @@ func compactionMapStrategy_RemoveUnnecessary(ctx context.Context, t *testing.T,
 	t.Run("verify control before compaction", func(t *testing.T) {
 		var retrieved []kv
 
-		c := bucket.MapCursor()
+		c, err := bucket.MapCursor()
+		require.Nil(t, err)
 		defer c.Close()
 
 		for k, v := c.First(ctx); k != nil; k, v = c.Next(ctx) {
@@ -0,0 +1,204 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// CompactionPolicyPairs merges two segments of the same level into a
+	// segment of the next level. It is the default policy.
+	CompactionPolicyPairs = "pairs"
+	// CompactionPolicyTiered waits for fan-out segments of the same level and
+	// merges them at once into a segment of the next level. Every object is
+	// rewritten once per level, which reduces the write amplification at the
+	// cost of more segments to search.
+	CompactionPolicyTiered = "tiered"
+	// CompactionPolicyLeveled keeps a single segment per level, each level
+	// fan-out times larger than the previous one. Fan-out flushed segments are
+	// merged into the first level, which grows until it is merged into the
+	// second one, and so on. Reads search few segments at the cost of a higher
+	// write amplification.
+	CompactionPolicyLeveled = "leveled"
+
+	DefaultCompactionFanOut = 4
+)
+
+// ValidateCompactionPolicy checks the name and fan-out of a compaction policy
+func ValidateCompactionPolicy(policy string, fanOut int) error {
+	switch policy {
+	case "", CompactionPolicyPairs:
+		return nil
+	case CompactionPolicyTiered, CompactionPolicyLeveled:
+		if fanOut < 2 {
+			return fmt.Errorf("fan-out of compaction policy %q must be at least 2, got %d",
+				policy, fanOut)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unrecognized compaction policy %q", policy)
+	}
+}
+
+// bestCompactionCandidates returns the positions of the adjacent segments,
+// oldest first, which are compacted next and the level of the new segment.
+func (sg *SegmentGroup) bestCompactionCandidates() ([]int, uint16) {
+	multiple := sg.compactionPolicy == CompactionPolicyTiered ||
+		sg.compactionPolicy == CompactionPolicyLeveled
+	if !multiple {
+		pair := sg.bestCompactionCandidatePair()
+		if pair == nil {
+			return nil, 0
+		}
+
+		sg.maintenanceLock.RLock()
+		defer sg.maintenanceLock.RUnlock()
+
+		// the assumption is that the first element is older, and/or a higher level
+		level := sg.segments[pair[0]].level
+		if level == sg.segments[pair[1]].level {
+			level = level + 1
+		}
+		return pair, level
+	}
+
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	// see bestCompactionCandidatePair
+	if sg.isReadyOnly() || len(sg.segments) < 2 {
+		return nil, 0
+	}
+
+	levels := make([]uint16, len(sg.segments))
+	sizes := make([]int64, len(sg.segments))
+	for i, seg := range sg.segments {
+		levels[i] = seg.level
+		sizes[i] = seg.size
+	}
+
+	if sg.compactionPolicy == CompactionPolicyLeveled {
+		return leveledCompactionCandidates(levels, sizes, sg.compactionFanOut,
+			sg.compactionBaseSize)
+	}
+	return tieredCompactionCandidates(levels, sg.compactionFanOut)
+}
+
+// tieredCompactionCandidates picks the oldest fanOut adjacent segments of the
+// lowest level which has that many
+func tieredCompactionCandidates(levels []uint16, fanOut int) ([]int, uint16) {
+	var run []int
+	for start := 0; start < len(levels); {
+		end := start
+		for end < len(levels) && levels[end] == levels[start] {
+			end++
+		}
+		if end-start >= fanOut && (run == nil || levels[start] < levels[run[0]]) {
+			run = positions(start, start+fanOut)
+		}
+		start = end
+	}
+
+	if run == nil {
+		return nil, 0
+	}
+	return run, levels[run[0]] + 1
+}
+
+// leveledCompactionCandidates merges fanOut flushed segments of level 0 into
+// the segment of level 1 before them. A segment which outgrows its level is
+// merged into the older segment before it. Level l holds up to
+// baseSize*fanOut^(l+1) bytes, the new segment takes the level its size
+// belongs to.
+func leveledCompactionCandidates(levels []uint16, sizes []int64, fanOut int,
+	baseSize int64,
+) ([]int, uint16) {
+	if baseSize < 1 {
+		baseSize = 1
+	}
+	capacity := func(level uint16) int64 {
+		c := baseSize
+		for i := uint16(0); i <= level; i++ {
+			if c > math.MaxInt64/int64(fanOut) {
+				return math.MaxInt64
+			}
+			c *= int64(fanOut)
+		}
+		return c
+	}
+	levelForSize := func(level uint16, size int64) uint16 {
+		for size > capacity(level) {
+			level++
+		}
+		return level
+	}
+	sum := func(run []int) int64 {
+		var total int64
+		for _, pos := range run {
+			total += sizes[pos]
+		}
+		return total
+	}
+
+	// flushed segments are merged once there are enough of them
+	start := len(levels)
+	for start > 0 && levels[start-1] == 0 {
+		start--
+	}
+	if len(levels)-start >= fanOut {
+		if start > 0 && levels[start-1] == 1 {
+			start--
+		}
+		run := positions(start, len(levels))
+		return run, levelForSize(1, sum(run))
+	}
+
+	// segments are merged into the next level once they are full, segments
+	// of the same level are merged right away
+	for pos := 1; pos < len(levels); pos++ {
+		if levels[pos] == 0 {
+			break
+		}
+		if sizes[pos] > capacity(levels[pos]) || levels[pos-1] == levels[pos] {
+			run := []int{pos - 1, pos}
+			level := levels[pos-1]
+			if levels[pos] > level {
+				level = levels[pos]
+			}
+			return run, levelForSize(level, sum(run))
+		}
+	}
+
+	return nil, 0
+}
+
+func positions(from, to int) []int {
+	out := make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		out = append(out, i)
+	}
+	return out
+}
+
+// segmentIDBetween reports whether a segment id lies strictly between two
+// others. Ids are decimal timestamps, a shorter id is the older one.
+func segmentIDBetween(id, from, to string) bool {
+	less := func(a, b string) bool {
+		if len(a) != len(b) {
+			return len(a) < len(b)
+		}
+		return a < b
+	}
+	return less(from, id) && less(id, to)
+}
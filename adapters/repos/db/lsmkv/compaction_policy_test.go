@@ -0,0 +1,334 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestCompactionPolicy_Tiered(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	newBucket := func() *Bucket {
+		b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace), WithSecondaryIndices(1),
+			WithCompactionPolicy(CompactionPolicyTiered, 3))
+		require.Nil(t, err)
+		return b
+	}
+	levels := func(b *Bucket) []uint16 {
+		b.disk.maintenanceLock.RLock()
+		defer b.disk.maintenanceLock.RUnlock()
+
+		var out []uint16
+		for _, seg := range b.disk.segments {
+			out = append(out, seg.level)
+		}
+		return out
+	}
+	compact := func(b *Bucket) {
+		for b.disk.compactIfLevelsMatch(func() bool { return false }) {
+		}
+	}
+
+	// every round overwrites the keys of the previous one and deletes one of
+	// them, the value of a key is the last round which wrote it
+	expected := map[string]string{}
+	put := func(b *Bucket, round int) {
+		for i := round; i < round+10; i++ {
+			key := fmt.Sprintf("key-%03d", i)
+			value := fmt.Sprintf("value-%03d-round-%d", i, round)
+			require.Nil(t, b.Put([]byte(key), []byte(value),
+				WithSecondaryKey(0, []byte("secondary-"+key))))
+			expected[key] = value
+		}
+		deleted := fmt.Sprintf("key-%03d", round+3)
+		require.Nil(t, b.Delete([]byte(deleted),
+			WithSecondaryKey(0, []byte("secondary-"+deleted))))
+		delete(expected, deleted)
+		require.Nil(t, b.FlushAndSwitch())
+	}
+	assertValues := func(t *testing.T, b *Bucket) {
+		for i := 0; i < 20; i++ {
+			key := fmt.Sprintf("key-%03d", i)
+			value, err := b.Get([]byte(key))
+			require.Nil(t, err)
+			if v, ok := expected[key]; ok {
+				assert.Equal(t, []byte(v), value, key)
+			} else {
+				assert.Nil(t, value, key)
+			}
+
+			value, err = b.GetBySecondary(0, []byte("secondary-"+key))
+			require.Nil(t, err)
+			if v, ok := expected[key]; ok {
+				assert.Equal(t, []byte(v), value, key)
+			} else {
+				assert.Nil(t, value, key)
+			}
+		}
+
+		c, err := b.Cursor()
+		require.Nil(t, err)
+		defer c.Close()
+		count := 0
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			assert.Equal(t, []byte(expected[string(k)]), v)
+			count++
+		}
+		assert.Equal(t, len(expected), count)
+	}
+
+	b := newBucket()
+	defer func() { b.Shutdown(ctx) }()
+
+	t.Run("segments are not compacted before the fan-out is reached", func(t *testing.T) {
+		put(b, 0)
+		put(b, 1)
+		compact(b)
+		assert.Equal(t, []uint16{0, 0}, levels(b))
+	})
+
+	t.Run("fan-out segments are compacted at once", func(t *testing.T) {
+		put(b, 2)
+		compact(b)
+		assert.Equal(t, []uint16{1}, levels(b))
+		assertValues(t, b)
+	})
+
+	t.Run("compacted segments are compacted into the next level", func(t *testing.T) {
+		for round := 3; round < 9; round++ {
+			put(b, round)
+		}
+		compact(b)
+		assert.Equal(t, []uint16{2}, levels(b))
+		assertValues(t, b)
+	})
+
+	t.Run("compacted segments survive a restart", func(t *testing.T) {
+		put(b, 9)
+		require.Nil(t, b.Shutdown(ctx))
+		b = newBucket()
+		assert.Equal(t, []uint16{2, 0}, levels(b))
+		assertValues(t, b)
+	})
+}
+
+func TestCompactionPolicy_TieredCollections(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	newBucket := func(t *testing.T, strategy string) *Bucket {
+		b, err := NewBucketCreator().NewBucket(ctx, t.TempDir(), "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(strategy), WithCompactionPolicy(CompactionPolicyTiered, 3))
+		require.Nil(t, err)
+		t.Cleanup(func() { b.Shutdown(ctx) })
+		return b
+	}
+	// every round adds a value to each key and removes the value of the
+	// previous round from one of them
+	compactRounds := func(t *testing.T, b *Bucket, round func(r int)) {
+		for r := 0; r < 3; r++ {
+			round(r)
+			require.Nil(t, b.FlushAndSwitch())
+		}
+		for b.disk.compactIfLevelsMatch(func() bool { return false }) {
+		}
+
+		b.disk.maintenanceLock.RLock()
+		defer b.disk.maintenanceLock.RUnlock()
+		require.Len(t, b.disk.segments, 1)
+		assert.Equal(t, uint16(1), b.disk.segments[0].level)
+	}
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%d", i)) }
+
+	t.Run("set", func(t *testing.T) {
+		b := newBucket(t, StrategySetCollection)
+		expected := map[string][]string{}
+		compactRounds(t, b, func(r int) {
+			for i := 0; i < 4; i++ {
+				v := fmt.Sprintf("value-%d", r)
+				require.Nil(t, b.SetAdd(key(i), [][]byte{[]byte(v)}))
+				expected[string(key(i))] = append(expected[string(key(i))], v)
+			}
+			if r > 0 {
+				v := fmt.Sprintf("value-%d", r-1)
+				require.Nil(t, b.SetDeleteSingle(key(r), []byte(v)))
+				expected[string(key(r))] = slices.DeleteFunc(expected[string(key(r))],
+					func(s string) bool { return s == v })
+			}
+		})
+
+		for k, values := range expected {
+			list, err := b.SetList([]byte(k))
+			require.Nil(t, err)
+			actual := make([]string, len(list))
+			for i := range list {
+				actual[i] = string(list[i])
+			}
+			assert.ElementsMatch(t, values, actual, k)
+		}
+	})
+
+	t.Run("map", func(t *testing.T) {
+		b := newBucket(t, StrategyMapCollection)
+		expected := map[string]map[string]string{}
+		compactRounds(t, b, func(r int) {
+			for i := 0; i < 4; i++ {
+				if expected[string(key(i))] == nil {
+					expected[string(key(i))] = map[string]string{}
+				}
+				for _, mk := range []string{fmt.Sprintf("mk-%d", r), "mk-shared"} {
+					v := fmt.Sprintf("value-%d", r)
+					require.Nil(t, b.MapSet(key(i), MapPair{Key: []byte(mk), Value: []byte(v)}))
+					expected[string(key(i))][mk] = v
+				}
+			}
+			if r > 0 {
+				mk := fmt.Sprintf("mk-%d", r-1)
+				require.Nil(t, b.MapDeleteKey(key(r), []byte(mk)))
+				delete(expected[string(key(r))], mk)
+			}
+		})
+
+		for k, pairs := range expected {
+			list, err := b.MapList(ctx, []byte(k))
+			require.Nil(t, err)
+			actual := map[string]string{}
+			for _, pair := range list {
+				actual[string(pair.Key)] = string(pair.Value)
+			}
+			assert.Equal(t, pairs, actual, k)
+		}
+	})
+
+	t.Run("roaring set", func(t *testing.T) {
+		b := newBucket(t, StrategyRoaringSet)
+		expected := map[string][]uint64{}
+		compactRounds(t, b, func(r int) {
+			for i := 0; i < 4; i++ {
+				require.Nil(t, b.RoaringSetAddOne(key(i), uint64(r)))
+				expected[string(key(i))] = append(expected[string(key(i))], uint64(r))
+			}
+			if r > 0 {
+				require.Nil(t, b.RoaringSetRemoveOne(key(r), uint64(r-1)))
+				expected[string(key(r))] = slices.DeleteFunc(expected[string(key(r))],
+					func(v uint64) bool { return v == uint64(r-1) })
+			}
+		})
+
+		for k, values := range expected {
+			bm, err := b.RoaringSetGet([]byte(k))
+			require.Nil(t, err)
+			assert.ElementsMatch(t, values, bm.ToArray(), k)
+		}
+	})
+}
+
+func TestCompactionPolicy_Options(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	_, err := NewBucketCreator().NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyReplace), WithCompactionPolicy("universal", 4))
+	assert.ErrorContains(t, err, `unrecognized compaction policy "universal"`)
+
+	_, err = NewBucketCreator().NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyReplace), WithCompactionPolicy(CompactionPolicyLeveled, 1))
+	assert.ErrorContains(t, err, "must be at least 2")
+}
+
+func TestCompactionPolicy_RecoverInterruptedCompaction(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	newBucket := func(dir string) *Bucket {
+		b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace), WithCompactionPolicy(CompactionPolicyTiered, 3))
+		require.Nil(t, err)
+		return b
+	}
+	segmentFiles := func(dir string) map[string][]byte {
+		files, err := filepath.Glob(filepath.Join(dir, "segment-*.db"))
+		require.Nil(t, err)
+		out := map[string][]byte{}
+		for _, file := range files {
+			content, err := os.ReadFile(file)
+			require.Nil(t, err)
+			out[filepath.Base(file)] = content
+		}
+		return out
+	}
+
+	b := newBucket(dir)
+	for i := 0; i < 3; i++ {
+		require.Nil(t, b.Put([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", i))))
+		require.Nil(t, b.FlushAndSwitch())
+	}
+	require.Nil(t, b.Shutdown(ctx))
+	flushed := segmentFiles(dir)
+	require.Len(t, flushed, 3)
+
+	b = newBucket(dir)
+	require.True(t, b.disk.compactIfLevelsMatch(func() bool { return false }))
+	require.Nil(t, b.Shutdown(ctx))
+	compacted := segmentFiles(dir)
+	require.Len(t, compacted, 1)
+
+	// the compaction was interrupted after the oldest segment was dropped, the
+	// other two and the compacted segment are still present
+	var names []string
+	for name := range flushed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	crashDir := t.TempDir()
+	for _, name := range names[1:] {
+		require.Nil(t, os.WriteFile(filepath.Join(crashDir, name), flushed[name], 0o644))
+	}
+	tmpName := "segment-" + segmentID(names[0]) + "_" + segmentID(names[2]) + ".db.tmp"
+	for _, content := range compacted {
+		require.Nil(t, os.WriteFile(filepath.Join(crashDir, tmpName), content, 0o644))
+	}
+
+	b = newBucket(crashDir)
+	defer b.Shutdown(ctx)
+	require.Len(t, b.disk.segments, 1)
+	assert.Equal(t, uint16(1), b.disk.segments[0].level)
+	for i := 0; i < 3; i++ {
+		value, err := b.Get([]byte(fmt.Sprintf("key-%d", i)))
+		require.Nil(t, err)
+		assert.Equal(t, []byte(fmt.Sprintf("value-%d", i)), value)
+	}
+	remaining := segmentFiles(crashDir)
+	assert.Len(t, remaining, 1)
+	assert.Contains(t, remaining, names[2])
+}
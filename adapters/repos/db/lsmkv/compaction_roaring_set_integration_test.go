@@ -397,7 +397,8 @@ func compactionRoaringSetStrategy(ctx context.Context, t *testing.T, opts []Buck
 	t.Run("verify control before compaction", func(t *testing.T) {
 		var retrieved []kv
 
-		c := bucket.CursorRoaringSet()
+		c, err := bucket.CursorRoaringSet()
+		require.Nil(t, err)
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -419,7 +420,7 @@ func compactionRoaringSetStrategy(ctx context.Context, t *testing.T, opts []Buck
 				// segment1 and segment2 merged
 				// none of them is root segment, so tombstones
 				// will not be removed regardless of keepTombstones setting
-				assertSecondSegmentOfSize(t, bucket, 26768, 26768)
+				assertSecondSegmentOfSize(t, bucket, 26792, 26792)
 			}
 			i++
 		}
@@ -429,7 +430,8 @@ func compactionRoaringSetStrategy(ctx context.Context, t *testing.T, opts []Buck
 	t.Run("verify control after compaction", func(t *testing.T) {
 		var retrieved []kv
 
-		c := bucket.CursorRoaringSet()
+		c, err := bucket.CursorRoaringSet()
+		require.Nil(t, err)
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -496,7 +498,8 @@ func compactionRoaringSetStrategy_RemoveUnnecessary(ctx context.Context, t *test
 			},
 		}
 
-		c := bucket.CursorRoaringSet()
+		c, err := bucket.CursorRoaringSet()
+		require.Nil(t, err)
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -526,7 +529,8 @@ func compactionRoaringSetStrategy_RemoveUnnecessary(ctx context.Context, t *test
 			},
 		}
 
-		c := bucket.CursorRoaringSet()
+		c, err := bucket.CursorRoaringSet()
+		require.Nil(t, err)
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
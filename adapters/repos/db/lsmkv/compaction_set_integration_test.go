@@ -293,7 +293,8 @@ func compactionSetStrategy(ctx context.Context, t *testing.T, opts []BucketOptio
 	t.Run("verify control before compaction", func(t *testing.T) {
 		var retrieved []kv
 
-		c := bucket.SetCursor()
+		c, err := bucket.SetCursor()
+		require.Nil(t, err)
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -315,7 +316,7 @@ func compactionSetStrategy(ctx context.Context, t *testing.T, opts []BucketOptio
 				// segment1 and segment2 merged
 				// none of them is root segment, so tombstones
 				// will not be removed regardless of keepTombstones setting
-				assertSecondSegmentOfSize(t, bucket, 8556, 8556)
+				assertSecondSegmentOfSize(t, bucket, 8580, 8580)
 			}
 			i++
 		}
@@ -325,7 +326,8 @@ func compactionSetStrategy(ctx context.Context, t *testing.T, opts []BucketOptio
 	t.Run("verify control after compaction", func(t *testing.T) {
 		var retrieved []kv
 
-		c := bucket.SetCursor()
+		c, err := bucket.SetCursor()
+		require.Nil(t, err)
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -394,7 +396,8 @@ func compactionSetStrategy_RemoveUnnecessary(ctx context.Context, t *testing.T,
 			},
 		}
 
-		c := bucket.SetCursor()
+		c, err := bucket.SetCursor()
+		require.Nil(t, err)
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -423,7 +426,8 @@ func compactionSetStrategy_RemoveUnnecessary(ctx context.Context, t *testing.T,
 			},
 		}
 
-		c := bucket.SetCursor()
+		c, err := bucket.SetCursor()
+		require.Nil(t, err)
 		defer c.Close()
 
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -22,10 +22,9 @@ import (
 )
 
 type compactorMap struct {
-	// c1 is always the older segment, so when there is a conflict c2 wins
-	// (because of the replace strategy)
-	c1 *segmentCursorCollectionReusable
-	c2 *segmentCursorCollectionReusable
+	// cursors are ordered from the oldest to the newest segment, so when there
+	// is a conflict the newer one wins (because of the replace strategy)
+	cursors []*segmentCursorCollectionReusable
 
 	// the level matching those of the cursors
 	currentLevel        uint16
@@ -37,6 +36,7 @@ type compactorMap struct {
 
 	w    io.WriteSeeker
 	bufw *bufio.Writer
+	cw   *segmentindex.ChecksumWriter
 
 	scratchSpacePath string
 
@@ -46,12 +46,11 @@ type compactorMap struct {
 }
 
 func newCompactorMapCollection(w io.WriteSeeker,
-	c1, c2 *segmentCursorCollectionReusable, level, secondaryIndexCount uint16,
+	cursors []*segmentCursorCollectionReusable, level, secondaryIndexCount uint16,
 	scratchSpacePath string, requiresSorting bool, cleanupTombstones bool,
 ) *compactorMap {
-	return &compactorMap{
-		c1:                  c1,
-		c2:                  c2,
+	c := &compactorMap{
+		cursors:             cursors,
 		w:                   w,
 		bufw:                bufio.NewWriterSize(w, 256*1024),
 		currentLevel:        level,
@@ -60,6 +59,8 @@ func newCompactorMapCollection(w io.WriteSeeker,
 		scratchSpacePath:    scratchSpacePath,
 		requiresSorting:     requiresSorting,
 	}
+	c.cw = segmentindex.NewChecksumWriter(c.bufw)
+	return c
 }
 
 func (c *compactorMap) do() error {
@@ -76,18 +77,29 @@ func (c *compactorMap) do() error {
 		return errors.Wrap(err, "write index")
 	}
 
-	// flush buffered, so we can safely seek on underlying writer
-	if err := c.bufw.Flush(); err != nil {
-		return errors.Wrap(err, "flush buffered")
-	}
-
 	var dataEnd uint64 = segmentindex.HeaderSize
 	if len(kis) > 0 {
 		dataEnd = uint64(kis[len(kis)-1].ValueEnd)
 	}
 
-	if err := c.writeHeader(c.currentLevel, 0, c.secondaryIndexCount,
-		dataEnd); err != nil {
+	h := &segmentindex.Header{
+		Level:            c.currentLevel,
+		Version:          segmentindex.CurrentSegmentVersion,
+		SecondaryIndices: c.secondaryIndexCount,
+		Strategy:         segmentindex.StrategyMapCollection,
+		IndexStart:       dataEnd,
+	}
+
+	if _, err := c.cw.WriteChecksums(h); err != nil {
+		return errors.Wrap(err, "write checksums")
+	}
+
+	// flush buffered, so we can safely seek on underlying writer
+	if err := c.bufw.Flush(); err != nil {
+		return errors.Wrap(err, "flush buffered")
+	}
+
+	if err := c.writeHeader(h); err != nil {
 		return errors.Wrap(err, "write header")
 	}
 
@@ -99,7 +111,7 @@ func (c *compactorMap) init() error {
 	// we will seek to the beginning and overwrite the actual header at the very
 	// end
 
-	if _, err := c.bufw.Write(make([]byte, segmentindex.HeaderSize)); err != nil {
+	if _, err := c.cw.Write(make([]byte, segmentindex.HeaderSize)); err != nil {
 		return errors.Wrap(err, "write empty header")
 	}
 
@@ -107,99 +119,88 @@ func (c *compactorMap) init() error {
 }
 
 func (c *compactorMap) writeKeys() ([]segmentindex.Key, error) {
-	key1, value1, _ := c.c1.first()
-	key2, value2, _ := c.c2.first()
+	keys := make([][]byte, len(c.cursors))
+	values := make([][]value, len(c.cursors))
+	for i, cursor := range c.cursors {
+		keys[i], values[i], _ = cursor.first()
+	}
 
 	// the (dummy) header was already written, this is our initial offset
 	offset := segmentindex.HeaderSize
 
 	var kis []segmentindex.Key
-	pairs := newReusableMapPairs()
+	pairs := newReusableMapPairs(len(c.cursors))
+	sources := make([]int, 0, len(c.cursors))
+	merge := make([][]MapPair, 0, len(c.cursors))
 	me := newMapEncoder()
 	ssm := newSortedMapMerger()
 
 	for {
-		if key1 == nil && key2 == nil {
+		// find the smallest key and all cursors positioned at it
+		var key []byte
+		sources = sources[:0]
+		for i := range c.cursors {
+			if keys[i] == nil {
+				continue
+			}
+			switch cmp := bytes.Compare(keys[i], key); {
+			case key == nil || cmp < 0:
+				key = keys[i]
+				sources = append(sources[:0], i)
+			case cmp == 0:
+				sources = append(sources, i)
+			}
+		}
+		if key == nil {
 			break
 		}
-		if bytes.Equal(key1, key2) {
-			pairs.ResizeLeft(len(value1))
-			pairs.ResizeRight(len(value2))
 
-			for i, v := range value1 {
-				if err := pairs.left[i].FromBytes(v.value, false); err != nil {
-					return nil, err
+		merged := values[sources[0]]
+		if len(sources) > 1 {
+			merge = merge[:0]
+			for _, i := range sources {
+				pairs.Resize(i, len(values[i]))
+				for j, v := range values[i] {
+					if err := pairs.pairs[i][j].FromBytes(v.value, false); err != nil {
+						return nil, err
+					}
+					pairs.pairs[i][j].Tombstone = v.tombstone
 				}
-				pairs.left[i].Tombstone = v.tombstone
-			}
 
-			for i, v := range value2 {
-				if err := pairs.right[i].FromBytes(v.value, false); err != nil {
-					return nil, err
+				if c.requiresSorting {
+					p := pairs.pairs[i]
+					sort.Slice(p, func(a, b int) bool {
+						return bytes.Compare(p[a].Key, p[b].Key) < 0
+					})
 				}
-				pairs.right[i].Tombstone = v.tombstone
+				merge = append(merge, pairs.pairs[i])
 			}
 
-			if c.requiresSorting {
-				sort.Slice(pairs.left, func(a, b int) bool {
-					return bytes.Compare(pairs.left[a].Key, pairs.left[b].Key) < 0
-				})
-				sort.Slice(pairs.right, func(a, b int) bool {
-					return bytes.Compare(pairs.right[a].Key, pairs.right[b].Key) < 0
-				})
-			}
-
-			ssm.reset([][]MapPair{pairs.left, pairs.right})
+			ssm.reset(merge)
 			mergedPairs, err := ssm.
 				doKeepTombstonesReusable()
 			if err != nil {
 				return nil, err
 			}
 
-			mergedEncoded, err := me.DoMultiReusable(mergedPairs)
+			merged, err = me.DoMultiReusable(mergedPairs)
 			if err != nil {
 				return nil, err
 			}
-
-			if values, skip := c.cleanupValues(mergedEncoded); !skip {
-				ki, err := c.writeIndividualNode(offset, key2, values)
-				if err != nil {
-					return nil, errors.Wrap(err, "write individual node (equal keys)")
-				}
-
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
-			}
-			// advance both!
-			key1, value1, _ = c.c1.next()
-			key2, value2, _ = c.c2.next()
-			continue
 		}
 
-		if (key1 != nil && bytes.Compare(key1, key2) == -1) || key2 == nil {
-			// key 1 is smaller
-			if values, skip := c.cleanupValues(value1); !skip {
-				ki, err := c.writeIndividualNode(offset, key1, values)
-				if err != nil {
-					return nil, errors.Wrap(err, "write individual node (key1 smaller)")
-				}
-
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
+		if merged, skip := c.cleanupValues(merged); !skip {
+			ki, err := c.writeIndividualNode(offset, key, merged)
+			if err != nil {
+				return nil, errors.Wrap(err, "write individual node")
 			}
-			key1, value1, _ = c.c1.next()
-		} else {
-			// key 2 is smaller
-			if values, skip := c.cleanupValues(value2); !skip {
-				ki, err := c.writeIndividualNode(offset, key2, values)
-				if err != nil {
-					return nil, errors.Wrap(err, "write individual node (key2 smaller)")
-				}
 
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
-			}
-			key2, value2, _ = c.c2.next()
+			offset = ki.ValueEnd
+			kis = append(kis, ki)
+		}
+
+		for _, i := range sources {
+			keys[i], values[i], _ = c.cursors[i].next()
 		}
 	}
 
@@ -226,7 +227,7 @@ func (c *compactorMap) writeIndividualNode(offset int, key []byte,
 		values:     values,
 		primaryKey: keyCopy,
 		offset:     offset,
-	}.KeyIndexAndWriteTo(c.bufw)
+	}.KeyIndexAndWriteTo(c.cw)
 }
 
 func (c *compactorMap) writeIndices(keys []segmentindex.Key) error {
@@ -236,28 +237,18 @@ func (c *compactorMap) writeIndices(keys []segmentindex.Key) error {
 		ScratchSpacePath:    c.scratchSpacePath,
 	}
 
-	_, err := indices.WriteTo(c.bufw)
+	_, err := indices.WriteTo(c.cw)
 	return err
 }
 
 // writeHeader assumes that everything has been written to the underlying
 // writer and it is now safe to seek to the beginning and override the initial
 // header
-func (c *compactorMap) writeHeader(level, version, secondaryIndices uint16,
-	startOfIndex uint64,
-) error {
+func (c *compactorMap) writeHeader(h *segmentindex.Header) error {
 	if _, err := c.w.Seek(0, io.SeekStart); err != nil {
 		return errors.Wrap(err, "seek to beginning to write header")
 	}
 
-	h := &segmentindex.Header{
-		Level:            level,
-		Version:          version,
-		SecondaryIndices: secondaryIndices,
-		Strategy:         segmentindex.StrategyMapCollection,
-		IndexStart:       startOfIndex,
-	}
-
 	if _, err := h.WriteTo(c.w); err != nil {
 		return err
 	}
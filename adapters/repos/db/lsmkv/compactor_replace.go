@@ -23,10 +23,10 @@ import (
 )
 
 type compactorReplace struct {
-	// c1 is always the older segment, so when there is a conflict c2 wins
-	// (because of the replace strategy)
-	c1 *segmentCursorReplace
-	c2 *segmentCursorReplace
+	// cursors of adjacent segments, ordered from the oldest to the newest. If a
+	// key is present in multiple segments, the newest one wins (because of the
+	// replace strategy)
+	cursors []*segmentCursorReplace
 
 	// the level matching those of the cursors
 	currentLevel uint16
@@ -38,16 +38,16 @@ type compactorReplace struct {
 
 	w                io.WriteSeeker
 	bufw             *bufio.Writer
+	cw               *segmentindex.ChecksumWriter
 	scratchSpacePath string
 }
 
 func newCompactorReplace(w io.WriteSeeker,
-	c1, c2 *segmentCursorReplace, level, secondaryIndexCount uint16,
+	cursors []*segmentCursorReplace, level, secondaryIndexCount uint16,
 	scratchSpacePath string, cleanupTombstones bool,
 ) *compactorReplace {
-	return &compactorReplace{
-		c1:                  c1,
-		c2:                  c2,
+	c := &compactorReplace{
+		cursors:             cursors,
 		w:                   w,
 		bufw:                bufio.NewWriterSize(w, 256*1024),
 		currentLevel:        level,
@@ -55,6 +55,8 @@ func newCompactorReplace(w io.WriteSeeker,
 		secondaryIndexCount: secondaryIndexCount,
 		scratchSpacePath:    scratchSpacePath,
 	}
+	c.cw = segmentindex.NewChecksumWriter(c.bufw)
+	return c
 }
 
 func (c *compactorReplace) do() error {
@@ -71,17 +73,29 @@ func (c *compactorReplace) do() error {
 		return fmt.Errorf("write indices: %w", err)
 	}
 
-	// flush buffered, so we can safely seek on underlying writer
-	if err := c.bufw.Flush(); err != nil {
-		return fmt.Errorf("flush buffered: %w", err)
-	}
-
 	var dataEnd uint64 = segmentindex.HeaderSize
 	if len(kis) > 0 {
 		dataEnd = uint64(kis[len(kis)-1].ValueEnd)
 	}
 
-	if err := c.writeHeader(c.currentLevel, 0, c.secondaryIndexCount, dataEnd); err != nil {
+	h := &segmentindex.Header{
+		Level:            c.currentLevel,
+		Version:          segmentindex.CurrentSegmentVersion,
+		SecondaryIndices: c.secondaryIndexCount,
+		Strategy:         segmentindex.StrategyReplace,
+		IndexStart:       dataEnd,
+	}
+
+	if _, err := c.cw.WriteChecksums(h); err != nil {
+		return fmt.Errorf("write checksums: %w", err)
+	}
+
+	// flush buffered, so we can safely seek on underlying writer
+	if err := c.bufw.Flush(); err != nil {
+		return fmt.Errorf("flush buffered: %w", err)
+	}
+
+	if err := c.writeHeader(h); err != nil {
 		return fmt.Errorf("write header: %w", err)
 	}
 
@@ -93,7 +107,7 @@ func (c *compactorReplace) init() error {
 	// we will seek to the beginning and overwrite the actual header at the very
 	// end
 
-	if _, err := c.bufw.Write(make([]byte, segmentindex.HeaderSize)); err != nil {
+	if _, err := c.cw.Write(make([]byte, segmentindex.HeaderSize)); err != nil {
 		return fmt.Errorf("write empty header: %w", err)
 	}
 
@@ -101,8 +115,11 @@ func (c *compactorReplace) init() error {
 }
 
 func (c *compactorReplace) writeKeys() ([]segmentindex.Key, error) {
-	res1, err1 := c.c1.firstWithAllKeys()
-	res2, err2 := c.c2.firstWithAllKeys()
+	nodes := make([]segmentReplaceNode, len(c.cursors))
+	errs := make([]error, len(c.cursors))
+	for i, cursor := range c.cursors {
+		nodes[i], errs[i] = cursor.firstWithAllKeys()
+	}
 
 	// the (dummy) header was already written, this is our initial offset
 	offset := segmentindex.HeaderSize
@@ -110,52 +127,37 @@ func (c *compactorReplace) writeKeys() ([]segmentindex.Key, error) {
 	var kis []segmentindex.Key
 
 	for {
-		if res1.primaryKey == nil && res2.primaryKey == nil {
+		// pick the smallest key, for equal keys the newest segment wins
+		winner := -1
+		for i := range nodes {
+			if nodes[i].primaryKey == nil {
+				continue
+			}
+			if winner == -1 || bytes.Compare(nodes[i].primaryKey, nodes[winner].primaryKey) <= 0 {
+				winner = i
+			}
+		}
+		if winner == -1 {
 			break
 		}
-		if bytes.Equal(res1.primaryKey, res2.primaryKey) {
-			if !(c.cleanupTombstones && errors.Is(err2, lsmkv.Deleted)) {
-				ki, err := c.writeIndividualNode(offset, res2.primaryKey, res2.value,
-					res2.secondaryKeys, errors.Is(err2, lsmkv.Deleted))
-				if err != nil {
-					return nil, fmt.Errorf("write individual node (equal keys): %w", err)
-				}
-
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
+
+		node, err := nodes[winner], errs[winner]
+		if !(c.cleanupTombstones && errors.Is(err, lsmkv.Deleted)) {
+			ki, err := c.writeIndividualNode(offset, node.primaryKey, node.value,
+				node.secondaryKeys, errors.Is(err, lsmkv.Deleted))
+			if err != nil {
+				return nil, fmt.Errorf("write individual node: %w", err)
 			}
-			// advance both!
-			res1, err1 = c.c1.nextWithAllKeys()
-			res2, err2 = c.c2.nextWithAllKeys()
-			continue
+
+			offset = ki.ValueEnd
+			kis = append(kis, ki)
 		}
 
-		if (res1.primaryKey != nil && bytes.Compare(res1.primaryKey, res2.primaryKey) == -1) || res2.primaryKey == nil {
-			// key 1 is smaller
-			if !(c.cleanupTombstones && errors.Is(err1, lsmkv.Deleted)) {
-				ki, err := c.writeIndividualNode(offset, res1.primaryKey, res1.value,
-					res1.secondaryKeys, errors.Is(err1, lsmkv.Deleted))
-				if err != nil {
-					return nil, fmt.Errorf("write individual node (res1.primaryKey smaller)")
-				}
-
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
-			}
-			res1, err1 = c.c1.nextWithAllKeys()
-		} else {
-			// key 2 is smaller
-			if !(c.cleanupTombstones && errors.Is(err2, lsmkv.Deleted)) {
-				ki, err := c.writeIndividualNode(offset, res2.primaryKey, res2.value,
-					res2.secondaryKeys, errors.Is(err2, lsmkv.Deleted))
-				if err != nil {
-					return nil, fmt.Errorf("write individual node (res2.primaryKey smaller): %w", err)
-				}
-
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
+		// advance all cursors positioned at the written key
+		for i := range nodes {
+			if bytes.Equal(nodes[i].primaryKey, node.primaryKey) {
+				nodes[i], errs[i] = c.cursors[i].nextWithAllKeys()
 			}
-			res2, err2 = c.c2.nextWithAllKeys()
 		}
 	}
 
@@ -174,7 +176,7 @@ func (c *compactorReplace) writeIndividualNode(offset int, key, value []byte,
 		secondaryKeys:       secondaryKeys,
 	}
 
-	return segNode.KeyIndexAndWriteTo(c.bufw)
+	return segNode.KeyIndexAndWriteTo(c.cw)
 }
 
 func (c *compactorReplace) writeIndices(keys []segmentindex.Key) error {
@@ -184,28 +186,18 @@ func (c *compactorReplace) writeIndices(keys []segmentindex.Key) error {
 		ScratchSpacePath:    c.scratchSpacePath,
 	}
 
-	_, err := indices.WriteTo(c.bufw)
+	_, err := indices.WriteTo(c.cw)
 	return err
 }
 
 // writeHeader assumes that everything has been written to the underlying
 // writer and it is now safe to seek to the beginning and override the initial
 // header
-func (c *compactorReplace) writeHeader(level, version, secondaryIndices uint16,
-	startOfIndex uint64,
-) error {
+func (c *compactorReplace) writeHeader(h *segmentindex.Header) error {
 	if _, err := c.w.Seek(0, io.SeekStart); err != nil {
 		return fmt.Errorf("seek to beginning to write header: %w", err)
 	}
 
-	h := &segmentindex.Header{
-		Level:            level,
-		Version:          version,
-		SecondaryIndices: secondaryIndices,
-		Strategy:         segmentindex.StrategyReplace,
-		IndexStart:       startOfIndex,
-	}
-
 	if _, err := h.WriteTo(c.w); err != nil {
 		return err
 	}
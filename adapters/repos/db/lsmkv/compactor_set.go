@@ -21,10 +21,9 @@ import (
 )
 
 type compactorSet struct {
-	// c1 is always the older segment, so when there is a conflict c2 wins
-	// (because of the replace strategy)
-	c1 *segmentCursorCollection
-	c2 *segmentCursorCollection
+	// cursors are ordered from the oldest to the newest segment, values of
+	// equal keys are merged in that order
+	cursors []*segmentCursorCollection
 
 	// the level matching those of the cursors
 	currentLevel        uint16
@@ -36,17 +35,17 @@ type compactorSet struct {
 
 	w    io.WriteSeeker
 	bufw *bufio.Writer
+	cw   *segmentindex.ChecksumWriter
 
 	scratchSpacePath string
 }
 
 func newCompactorSetCollection(w io.WriteSeeker,
-	c1, c2 *segmentCursorCollection, level, secondaryIndexCount uint16,
+	cursors []*segmentCursorCollection, level, secondaryIndexCount uint16,
 	scratchSpacePath string, cleanupTombstones bool,
 ) *compactorSet {
-	return &compactorSet{
-		c1:                  c1,
-		c2:                  c2,
+	c := &compactorSet{
+		cursors:             cursors,
 		w:                   w,
 		bufw:                bufio.NewWriterSize(w, 256*1024),
 		currentLevel:        level,
@@ -54,6 +53,8 @@ func newCompactorSetCollection(w io.WriteSeeker,
 		secondaryIndexCount: secondaryIndexCount,
 		scratchSpacePath:    scratchSpacePath,
 	}
+	c.cw = segmentindex.NewChecksumWriter(c.bufw)
+	return c
 }
 
 func (c *compactorSet) do() error {
@@ -70,18 +71,29 @@ func (c *compactorSet) do() error {
 		return errors.Wrap(err, "write index")
 	}
 
-	// flush buffered, so we can safely seek on underlying writer
-	if err := c.bufw.Flush(); err != nil {
-		return errors.Wrap(err, "flush buffered")
-	}
-
 	var dataEnd uint64 = segmentindex.HeaderSize
 	if len(kis) > 0 {
 		dataEnd = uint64(kis[len(kis)-1].ValueEnd)
 	}
 
-	if err := c.writeHeader(c.currentLevel, 0, c.secondaryIndexCount,
-		dataEnd); err != nil {
+	h := &segmentindex.Header{
+		Level:            c.currentLevel,
+		Version:          segmentindex.CurrentSegmentVersion,
+		SecondaryIndices: c.secondaryIndexCount,
+		Strategy:         segmentindex.StrategySetCollection,
+		IndexStart:       dataEnd,
+	}
+
+	if _, err := c.cw.WriteChecksums(h); err != nil {
+		return errors.Wrap(err, "write checksums")
+	}
+
+	// flush buffered, so we can safely seek on underlying writer
+	if err := c.bufw.Flush(); err != nil {
+		return errors.Wrap(err, "flush buffered")
+	}
+
+	if err := c.writeHeader(h); err != nil {
 		return errors.Wrap(err, "write header")
 	}
 
@@ -93,7 +105,7 @@ func (c *compactorSet) init() error {
 	// we will seek to the beginning and overwrite the actual header at the very
 	// end
 
-	if _, err := c.bufw.Write(make([]byte, segmentindex.HeaderSize)); err != nil {
+	if _, err := c.cw.Write(make([]byte, segmentindex.HeaderSize)); err != nil {
 		return errors.Wrap(err, "write empty header")
 	}
 
@@ -101,60 +113,59 @@ func (c *compactorSet) init() error {
 }
 
 func (c *compactorSet) writeKeys() ([]segmentindex.Key, error) {
-	key1, value1, _ := c.c1.first()
-	key2, value2, _ := c.c2.first()
+	keys := make([][]byte, len(c.cursors))
+	values := make([][]value, len(c.cursors))
+	for i, cursor := range c.cursors {
+		keys[i], values[i], _ = cursor.first()
+	}
 
 	// the (dummy) header was already written, this is our initial offset
 	offset := segmentindex.HeaderSize
 
 	var kis []segmentindex.Key
+	sources := make([]int, 0, len(c.cursors))
 
 	for {
-		if key1 == nil && key2 == nil {
+		// find the smallest key and all cursors positioned at it
+		var key []byte
+		sources = sources[:0]
+		for i := range c.cursors {
+			if keys[i] == nil {
+				continue
+			}
+			switch cmp := bytes.Compare(keys[i], key); {
+			case key == nil || cmp < 0:
+				key = keys[i]
+				sources = append(sources[:0], i)
+			case cmp == 0:
+				sources = append(sources, i)
+			}
+		}
+		if key == nil {
 			break
 		}
-		if bytes.Equal(key1, key2) {
-			values := append(value1, value2...)
-			valuesMerged := newSetDecoder().DoPartial(values)
-			if values, skip := c.cleanupValues(valuesMerged); !skip {
-				ki, err := c.writeIndividualNode(offset, key2, values)
-				if err != nil {
-					return nil, errors.Wrap(err, "write individual node (equal keys)")
-				}
-
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
+
+		merged := values[sources[0]]
+		if len(sources) > 1 {
+			merged = nil
+			for _, i := range sources {
+				merged = append(merged, values[i]...)
 			}
-			// advance both!
-			key1, value1, _ = c.c1.next()
-			key2, value2, _ = c.c2.next()
-			continue
+			merged = newSetDecoder().DoPartial(merged)
 		}
 
-		if (key1 != nil && bytes.Compare(key1, key2) == -1) || key2 == nil {
-			// key 1 is smaller
-			if values, skip := c.cleanupValues(value1); !skip {
-				ki, err := c.writeIndividualNode(offset, key1, values)
-				if err != nil {
-					return nil, errors.Wrap(err, "write individual node (key1 smaller)")
-				}
-
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
-			}
-			key1, value1, _ = c.c1.next()
-		} else {
-			// key 2 is smaller
-			if values, skip := c.cleanupValues(value2); !skip {
-				ki, err := c.writeIndividualNode(offset, key2, values)
-				if err != nil {
-					return nil, errors.Wrap(err, "write individual node (key2 smaller)")
-				}
-
-				offset = ki.ValueEnd
-				kis = append(kis, ki)
+		if merged, skip := c.cleanupValues(merged); !skip {
+			ki, err := c.writeIndividualNode(offset, key, merged)
+			if err != nil {
+				return nil, errors.Wrap(err, "write individual node")
 			}
-			key2, value2, _ = c.c2.next()
+
+			offset = ki.ValueEnd
+			kis = append(kis, ki)
+		}
+
+		for _, i := range sources {
+			keys[i], values[i], _ = c.cursors[i].next()
 		}
 	}
 
@@ -168,7 +179,7 @@ func (c *compactorSet) writeIndividualNode(offset int, key []byte,
 		values:     values,
 		primaryKey: key,
 		offset:     offset,
-	}).KeyIndexAndWriteTo(c.bufw)
+	}).KeyIndexAndWriteTo(c.cw)
 }
 
 func (c *compactorSet) writeIndices(keys []segmentindex.Key) error {
@@ -178,28 +189,18 @@ func (c *compactorSet) writeIndices(keys []segmentindex.Key) error {
 		ScratchSpacePath:    c.scratchSpacePath,
 	}
 
-	_, err := indices.WriteTo(c.bufw)
+	_, err := indices.WriteTo(c.cw)
 	return err
 }
 
 // writeHeader assumes that everything has been written to the underlying
 // writer and it is now safe to seek to the beginning and override the initial
 // header
-func (c *compactorSet) writeHeader(level, version, secondaryIndices uint16,
-	startOfIndex uint64,
-) error {
+func (c *compactorSet) writeHeader(h *segmentindex.Header) error {
 	if _, err := c.w.Seek(0, io.SeekStart); err != nil {
 		return errors.Wrap(err, "seek to beginning to write header")
 	}
 
-	h := &segmentindex.Header{
-		Level:            level,
-		Version:          version,
-		SecondaryIndices: secondaryIndices,
-		Strategy:         segmentindex.StrategySetCollection,
-		IndexStart:       startOfIndex,
-	}
-
 	if _, err := h.WriteTo(c.w); err != nil {
 		return err
 	}
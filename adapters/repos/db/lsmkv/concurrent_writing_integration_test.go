@@ -103,7 +103,8 @@ func TestConcurrentWriting_Replace(t *testing.T) {
 			targets[string(keys[i])] = values[i]
 		}
 
-		c := bucket.Cursor()
+		c, err := bucket.Cursor()
+		require.Nil(t, err)
 		defer c.Close()
 		for k, v := c.First(); k != nil; k, v = c.Next() {
 			control := targets[string(k)]
@@ -192,7 +193,8 @@ func TestConcurrentWriting_Set(t *testing.T) {
 			targets[string(keys[i])] = values[i]
 		}
 
-		c := bucket.SetCursor()
+		c, err := bucket.SetCursor()
+		require.Nil(t, err)
 		defer c.Close()
 		for k, v := c.First(); k != nil; k, v = c.Next() {
 			control := targets[string(k)]
@@ -274,7 +276,8 @@ func TestConcurrentWriting_Map(t *testing.T) {
 			targets[string(keys[i])] = values[i]
 		}
 
-		c := bucket.MapCursor()
+		c, err := bucket.MapCursor()
+		require.Nil(t, err)
 		defer c.Close()
 
 		ctx := context.Background()
@@ -41,15 +41,19 @@ type innerCursorMap interface {
 	seek([]byte) ([]byte, []MapPair, error)
 }
 
-func (b *Bucket) MapCursor(cfgs ...MapListOption) *CursorMap {
-	b.flushLock.RLock()
-
+// MapCursor holds a RLock for the flushing state. It needs to be closed using
+// the .Close() methods or otherwise the lock will never be released. It fails
+// if the segments of the bucket in the remote tier cannot be fetched.
+func (b *Bucket) MapCursor(cfgs ...MapListOption) (*CursorMap, error) {
 	c := MapListOptionConfig{}
 	for _, cfg := range cfgs {
 		cfg(&c)
 	}
 
-	innerCursors, unlockSegmentGroup := b.disk.newMapCursors()
+	innerCursors, unlock, err := newDiskCursors(b, b.disk.newMapCursors)
+	if err != nil {
+		return nil, err
+	}
 
 	// we have a flush-RLock, so we have the guarantee that the flushing state
 	// will not change for the lifetime of the cursor, thus there can only be two
@@ -61,21 +65,21 @@ func (b *Bucket) MapCursor(cfgs ...MapListOption) *CursorMap {
 	innerCursors = append(innerCursors, b.active.newMapCursor())
 
 	return &CursorMap{
-		unlock: func() {
-			unlockSegmentGroup()
-			b.flushLock.RUnlock()
-		},
+		unlock: unlock,
 		// cursor are in order from oldest to newest, with the memtable cursor
 		// being at the very top
 		innerCursors: innerCursors,
 		listCfg:      c,
-	}
+	}, nil
 }
 
-func (b *Bucket) MapCursorKeyOnly(cfgs ...MapListOption) *CursorMap {
-	c := b.MapCursor(cfgs...)
+func (b *Bucket) MapCursorKeyOnly(cfgs ...MapListOption) (*CursorMap, error) {
+	c, err := b.MapCursor(cfgs...)
+	if err != nil {
+		return nil, err
+	}
 	c.keyOnly = true
-	return c
+	return c, nil
 }
 
 func (c *CursorMap) Seek(ctx context.Context, key []byte) ([]byte, []MapPair) {
@@ -40,15 +40,17 @@ type cursorStateReplace struct {
 }
 
 // Cursor holds a RLock for the flushing state. It needs to be closed using the
-// .Close() methods or otherwise the lock will never be released
-func (b *Bucket) Cursor() *CursorReplace {
-	b.flushLock.RLock()
-
+// .Close() methods or otherwise the lock will never be released. It fails if
+// the segments of the bucket in the remote tier cannot be fetched.
+func (b *Bucket) Cursor() (*CursorReplace, error) {
 	if b.strategy != StrategyReplace {
 		panic("Cursor() called on strategy other than 'replace'")
 	}
 
-	innerCursors, unlockSegmentGroup := b.disk.newCursors()
+	innerCursors, unlock, err := newDiskCursors(b, b.disk.newCursors)
+	if err != nil {
+		return nil, err
+	}
 
 	// we have a flush-RLock, so we have the guarantee that the flushing state
 	// will not change for the lifetime of the cursor, thus there can only be two
@@ -63,23 +65,23 @@ func (b *Bucket) Cursor() *CursorReplace {
 		// cursor are in order from oldest to newest, with the memtable cursor
 		// being at the very top
 		innerCursors: innerCursors,
-		unlock: func() {
-			unlockSegmentGroup()
-			b.flushLock.RUnlock()
-		},
-	}
+		unlock:       unlock,
+	}, nil
 }
 
 // CursorWithSecondaryIndex holds a RLock for the flushing state. It needs to be closed using the
 // .Close() methods or otherwise the lock will never be released
-func (b *Bucket) CursorWithSecondaryIndex(pos int) *CursorReplace {
-	b.flushLock.RLock()
-
+func (b *Bucket) CursorWithSecondaryIndex(pos int) (*CursorReplace, error) {
 	if b.strategy != StrategyReplace {
 		panic("CursorWithSecondaryIndex() called on strategy other than 'replace'")
 	}
 
-	innerCursors, unlockSegmentGroup := b.disk.newCursorsWithSecondaryIndex(pos)
+	innerCursors, unlock, err := newDiskCursors(b, func() ([]innerCursorReplace, func(), error) {
+		return b.disk.newCursorsWithSecondaryIndex(pos)
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	// we have a flush-RLock, so we have the guarantee that the flushing state
 	// will not change for the lifetime of the cursor, thus there can only be two
@@ -94,11 +96,8 @@ func (b *Bucket) CursorWithSecondaryIndex(pos int) *CursorReplace {
 		// cursor are in order from oldest to newest, with the memtable cursor
 		// being at the very top
 		innerCursors: innerCursors,
-		unlock: func() {
-			unlockSegmentGroup()
-			b.flushLock.RUnlock()
-		},
-	}
+		unlock:       unlock,
+	}, nil
 }
 
 func (c *CursorReplace) Close() {
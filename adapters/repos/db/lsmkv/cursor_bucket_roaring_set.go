@@ -46,23 +46,28 @@ func (c *cursorRoaringSet) Close() {
 	c.unlock()
 }
 
-func (b *Bucket) CursorRoaringSet() CursorRoaringSet {
+// CursorRoaringSet holds a RLock for the flushing state. It needs to be
+// closed using the .Close() methods or otherwise the lock will never be
+// released. It fails if the segments of the bucket in the remote tier cannot
+// be fetched.
+func (b *Bucket) CursorRoaringSet() (CursorRoaringSet, error) {
 	return b.cursorRoaringSet(false)
 }
 
-func (b *Bucket) CursorRoaringSetKeyOnly() CursorRoaringSet {
+func (b *Bucket) CursorRoaringSetKeyOnly() (CursorRoaringSet, error) {
 	return b.cursorRoaringSet(true)
 }
 
-func (b *Bucket) cursorRoaringSet(keyOnly bool) CursorRoaringSet {
-	b.flushLock.RLock()
-
+func (b *Bucket) cursorRoaringSet(keyOnly bool) (CursorRoaringSet, error) {
 	// TODO move to helper func
 	if err := checkStrategyRoaringSet(b.strategy); err != nil {
 		panic(fmt.Sprintf("CursorRoaringSet() called on strategy other than '%s'", StrategyRoaringSet))
 	}
 
-	innerCursors, unlockSegmentGroup := b.disk.newRoaringSetCursors()
+	innerCursors, unlock, err := newDiskCursors(b, b.disk.newRoaringSetCursors)
+	if err != nil {
+		return nil, err
+	}
 
 	// we have a flush-RLock, so we have the guarantee that the flushing state
 	// will not change for the lifetime of the cursor, thus there can only be two
@@ -76,9 +81,6 @@ func (b *Bucket) cursorRoaringSet(keyOnly bool) CursorRoaringSet {
 	// being at the very top
 	return &cursorRoaringSet{
 		combinedCursor: roaringset.NewCombinedCursor(innerCursors, keyOnly),
-		unlock: func() {
-			unlockSegmentGroup()
-			b.flushLock.RUnlock()
-		},
-	}
+		unlock:         unlock,
+	}, nil
 }
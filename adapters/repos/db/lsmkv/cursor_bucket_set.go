@@ -39,15 +39,17 @@ type cursorStateCollection struct {
 }
 
 // SetCursor holds a RLock for the flushing state. It needs to be closed using the
-// .Close() methods or otherwise the lock will never be released
-func (b *Bucket) SetCursor() *CursorSet {
-	b.flushLock.RLock()
-
+// .Close() methods or otherwise the lock will never be released. It fails if
+// the segments of the bucket in the remote tier cannot be fetched.
+func (b *Bucket) SetCursor() (*CursorSet, error) {
 	if b.strategy != StrategySetCollection {
 		panic("SetCursor() called on strategy other than 'set'")
 	}
 
-	innerCursors, unlockSegmentGroup := b.disk.newCollectionCursors()
+	innerCursors, unlock, err := newDiskCursors(b, b.disk.newCollectionCursors)
+	if err != nil {
+		return nil, err
+	}
 
 	// we have a flush-RLock, so we have the guarantee that the flushing state
 	// will not change for the lifetime of the cursor, thus there can only be two
@@ -59,14 +61,11 @@ func (b *Bucket) SetCursor() *CursorSet {
 	innerCursors = append(innerCursors, b.active.newCollectionCursor())
 
 	return &CursorSet{
-		unlock: func() {
-			unlockSegmentGroup()
-			b.flushLock.RUnlock()
-		},
+		unlock: unlock,
 		// cursor are in order from oldest to newest, with the memtable cursor
 		// being at the very top
 		innerCursors: innerCursors,
-	}
+	}, nil
 }
 
 // SetCursorKeyOnly returns nil for all values. It has no control over the
@@ -75,10 +74,13 @@ func (b *Bucket) SetCursor() *CursorSet {
 // making this considerably more efficient if only keys are required.
 //
 // The same locking rules as for SetCursor apply.
-func (b *Bucket) SetCursorKeyOnly() *CursorSet {
-	c := b.SetCursor()
+func (b *Bucket) SetCursorKeyOnly() (*CursorSet, error) {
+	c, err := b.SetCursor()
+	if err != nil {
+		return nil, err
+	}
 	c.keyOnly = true
-	return c
+	return c, nil
 }
 
 func (c *CursorSet) Seek(key []byte) ([]byte, [][]byte) {
@@ -18,6 +18,8 @@ import (
 type segmentCursorCollection struct {
 	segment    *segment
 	nextOffset uint64
+	// the last decompressed block of compressed segments
+	blocks compressedReader
 }
 
 func (s *segment) newCollectionCursor() *segmentCursorCollection {
@@ -26,15 +28,19 @@ func (s *segment) newCollectionCursor() *segmentCursorCollection {
 	}
 }
 
-func (sg *SegmentGroup) newCollectionCursors() ([]innerCursorCollection, func()) {
+func (sg *SegmentGroup) newCollectionCursors() ([]innerCursorCollection, func(), error) {
 	sg.maintenanceLock.RLock()
+	if err := sg.requireAllLoaded(); err != nil {
+		sg.maintenanceLock.RUnlock()
+		return nil, nil, err
+	}
 	out := make([]innerCursorCollection, len(sg.segments))
 
 	for i, segment := range sg.segments {
 		out[i] = segment.newCollectionCursor()
 	}
 
-	return out, sg.maintenanceLock.RUnlock
+	return out, sg.maintenanceLock.RUnlock, nil
 }
 
 func (s *segmentCursorCollection) seek(key []byte) ([]byte, []value, error) {
@@ -88,7 +94,7 @@ func (s *segmentCursorCollection) first() ([]byte, []value, error) {
 }
 
 func (s *segmentCursorCollection) parseCollectionNode(offset nodeOffset) (segmentCollectionNode, error) {
-	r, err := s.segment.newNodeReader(offset)
+	r, err := s.segment.newCursorNodeReader(offset, &s.blocks)
 	if err != nil {
 		return segmentCollectionNode{}, err
 	}
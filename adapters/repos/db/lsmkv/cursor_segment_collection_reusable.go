@@ -19,6 +19,8 @@ type segmentCursorCollectionReusable struct {
 	segment    *segment
 	nextOffset uint64
 	nodeBuf    segmentCollectionNode
+	// the last decompressed block of compressed segments
+	blocks compressedReader
 }
 
 func (s *segment) newCollectionCursorReusable() *segmentCursorCollectionReusable {
@@ -74,7 +76,7 @@ func (s *segmentCursorCollectionReusable) first() ([]byte, []value, error) {
 }
 
 func (s *segmentCursorCollectionReusable) parseCollectionNodeInto(offset nodeOffset) error {
-	r, err := s.segment.newNodeReader(offset)
+	r, err := s.segment.newCursorNodeReader(offset, &s.blocks)
 	if err != nil {
 		return err
 	}
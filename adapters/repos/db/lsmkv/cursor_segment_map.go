@@ -16,6 +16,8 @@ import "github.com/weaviate/weaviate/entities/lsmkv"
 type segmentCursorMap struct {
 	segment    *segment
 	nextOffset uint64
+	// the last decompressed block of compressed segments
+	blocks compressedReader
 }
 
 func (s *segment) newMapCursor() *segmentCursorMap {
@@ -24,15 +26,19 @@ func (s *segment) newMapCursor() *segmentCursorMap {
 	}
 }
 
-func (sg *SegmentGroup) newMapCursors() ([]innerCursorMap, func()) {
+func (sg *SegmentGroup) newMapCursors() ([]innerCursorMap, func(), error) {
 	sg.maintenanceLock.RLock()
+	if err := sg.requireAllLoaded(); err != nil {
+		sg.maintenanceLock.RUnlock()
+		return nil, nil, err
+	}
 	out := make([]innerCursorMap, len(sg.segments))
 
 	for i, segment := range sg.segments {
 		out[i] = segment.newMapCursor()
 	}
 
-	return out, sg.maintenanceLock.RUnlock
+	return out, sg.maintenanceLock.RUnlock, nil
 }
 
 func (s *segmentCursorMap) seek(key []byte) ([]byte, []MapPair, error) {
@@ -110,7 +116,7 @@ func (s *segmentCursorMap) first() ([]byte, []MapPair, error) {
 }
 
 func (s *segmentCursorMap) parseCollectionNode(offset nodeOffset) (segmentCollectionNode, error) {
-	r, err := s.segment.newNodeReader(offset)
+	r, err := s.segment.newCursorNodeReader(offset, &s.blocks)
 	if err != nil {
 		return segmentCollectionNode{}, err
 	}
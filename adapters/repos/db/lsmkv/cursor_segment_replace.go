@@ -25,6 +25,8 @@ type segmentCursorReplace struct {
 	currOffset    uint64
 	reusableNode  *segmentReplaceNode
 	reusableBORW  byteops.ReadWriter
+	// the last decompressed block of compressed segments
+	blocks compressedReader
 }
 
 func (s *segment) newCursor() *segmentCursorReplace {
@@ -80,26 +82,34 @@ func (s *segment) newCursorWithSecondaryIndex(pos int) *segmentCursorReplace {
 	}
 }
 
-func (sg *SegmentGroup) newCursors() ([]innerCursorReplace, func()) {
+func (sg *SegmentGroup) newCursors() ([]innerCursorReplace, func(), error) {
 	sg.maintenanceLock.RLock()
+	if err := sg.requireAllLoaded(); err != nil {
+		sg.maintenanceLock.RUnlock()
+		return nil, nil, err
+	}
 	out := make([]innerCursorReplace, len(sg.segments))
 
 	for i, segment := range sg.segments {
 		out[i] = segment.newCursor()
 	}
 
-	return out, sg.maintenanceLock.RUnlock
+	return out, sg.maintenanceLock.RUnlock, nil
 }
 
-func (sg *SegmentGroup) newCursorsWithSecondaryIndex(pos int) ([]innerCursorReplace, func()) {
+func (sg *SegmentGroup) newCursorsWithSecondaryIndex(pos int) ([]innerCursorReplace, func(), error) {
 	sg.maintenanceLock.RLock()
+	if err := sg.requireAllLoaded(); err != nil {
+		sg.maintenanceLock.RUnlock()
+		return nil, nil, err
+	}
 	out := make([]innerCursorReplace, len(sg.segments))
 
 	for i, segment := range sg.segments {
 		out[i] = segment.newCursorWithSecondaryIndex(pos)
 	}
 
-	return out, sg.maintenanceLock.RUnlock
+	return out, sg.maintenanceLock.RUnlock, nil
 }
 
 func (s *segmentCursorReplace) seek(key []byte) ([]byte, []byte, error) {
@@ -110,8 +120,7 @@ func (s *segmentCursorReplace) seek(key []byte) ([]byte, []byte, error) {
 
 	s.currOffset = node.Start
 
-	err = s.parseReplaceNodeInto(nodeOffset{start: node.Start, end: node.End},
-		s.segment.contents[node.Start:node.End])
+	err = s.parseReplaceNodeInto(nodeOffset{start: node.Start, end: node.End})
 	if err != nil {
 		return s.keyFn(s.reusableNode), nil, err
 	}
@@ -131,8 +140,7 @@ func (s *segmentCursorReplace) next() ([]byte, []byte, error) {
 
 	s.currOffset = nextOffset
 
-	err = s.parseReplaceNodeInto(nodeOffset{start: s.currOffset},
-		s.segment.contents[s.currOffset:])
+	err = s.parseReplaceNodeInto(nodeOffset{start: s.currOffset})
 	if err != nil {
 		return s.keyFn(s.reusableNode), nil, err
 	}
@@ -148,8 +156,7 @@ func (s *segmentCursorReplace) first() ([]byte, []byte, error) {
 
 	s.currOffset = firstOffset
 
-	err = s.parseReplaceNodeInto(nodeOffset{start: s.currOffset},
-		s.segment.contents[s.currOffset:])
+	err = s.parseReplaceNodeInto(nodeOffset{start: s.currOffset})
 	if err != nil {
 		return s.keyFn(s.reusableNode), nil, err
 	}
@@ -192,7 +199,7 @@ func (s *segmentCursorReplace) firstWithAllKeys() (n segmentReplaceNode, err err
 }
 
 func (s *segmentCursorReplace) parseReplaceNode(offset nodeOffset) (segmentReplaceNode, error) {
-	r, err := s.segment.newNodeReader(offset)
+	r, err := s.segment.newCursorNodeReader(offset, &s.blocks)
 	if err != nil {
 		return segmentReplaceNode{}, err
 	}
@@ -203,12 +210,17 @@ func (s *segmentCursorReplace) parseReplaceNode(offset nodeOffset) (segmentRepla
 	return out, err
 }
 
-func (s *segmentCursorReplace) parseReplaceNodeInto(offset nodeOffset, buf []byte) error {
-	if s.segment.mmapContents {
-		return s.parse(buf)
+func (s *segmentCursorReplace) parseReplaceNodeInto(offset nodeOffset) error {
+	// compressed data is parsed from the decompressed blocks
+	if s.segment.mmapContents && s.segment.blocks == nil {
+		end := offset.end
+		if end == 0 {
+			end = s.segment.segmentEndPos
+		}
+		return s.parse(s.segment.contents[offset.start:end])
 	}
 
-	r, err := s.segment.newNodeReader(offset)
+	r, err := s.segment.newCursorNodeReader(offset, &s.blocks)
 	if err != nil {
 		return err
 	}
@@ -12,24 +12,62 @@
 package lsmkv
 
 import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	roaringset2 "github.com/weaviate/weaviate/adapters/repos/db/roaringset"
 )
 
 func (s *segment) newRoaringSetCursor() *roaringset2.SegmentCursor {
+	if s.blocks != nil {
+		return roaringset2.NewSegmentCursorReader(&roaringSetNodeReader{segment: s},
+			s.dataEndPos-s.dataStartPos, &roaringSetSeeker{s.index})
+	}
 	return roaringset2.NewSegmentCursor(s.contents[s.dataStartPos:s.dataEndPos],
 		&roaringSetSeeker{s.index})
 }
 
-func (sg *SegmentGroup) newRoaringSetCursors() ([]roaringset2.InnerCursor, func()) {
+// roaringSetNodeReader reads the nodes of compressed segments for
+// roaringset.SegmentCursor, offsets are relative to the payload
+type roaringSetNodeReader struct {
+	segment *segment
+	blocks  compressedReader
+}
+
+func (r *roaringSetNodeReader) ReadNode(offset uint64) ([]byte, error) {
+	r.blocks.reset(r.segment, r.segment.dataStartPos+offset, 0)
+
+	// a node starts with its length
+	var length [8]byte
+	if _, err := io.ReadFull(&r.blocks, length[:]); err != nil {
+		return nil, fmt.Errorf("read length of node at %d: %w", offset, err)
+	}
+	node := make([]byte, binary.LittleEndian.Uint64(length[:]))
+	if len(node) < len(length) {
+		return nil, fmt.Errorf("invalid length %d of node at %d", len(node), offset)
+	}
+	copy(node, length[:])
+	if _, err := io.ReadFull(&r.blocks, node[len(length):]); err != nil {
+		return nil, fmt.Errorf("read node at %d: %w", offset, err)
+	}
+	return node, nil
+}
+
+func (sg *SegmentGroup) newRoaringSetCursors() ([]roaringset2.InnerCursor, func(), error) {
 	sg.maintenanceLock.RLock()
+	if err := sg.requireAllLoaded(); err != nil {
+		sg.maintenanceLock.RUnlock()
+		return nil, nil, err
+	}
 	out := make([]roaringset2.InnerCursor, len(sg.segments))
 
 	for i, segment := range sg.segments {
 		out[i] = segment.newRoaringSetCursor()
 	}
 
-	return out, sg.maintenanceLock.RUnlock
+	return out, sg.maintenanceLock.RUnlock, nil
 }
 
 // diskIndex returns node's Start and End offsets
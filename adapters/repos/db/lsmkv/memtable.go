@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 )
@@ -38,6 +39,10 @@ type Memtable struct {
 	dirtyAt   time.Time
 	createdAt time.Time
 	metrics   *memtableMetrics
+	// codec the segment is compressed with when flushed, see WithCompression,
+	// the logger reports corruption of the uncompressed segment
+	codec  byte
+	logger logrus.FieldLogger
 }
 
 func newMemtable(path string, strategy string,
@@ -70,6 +75,14 @@ func newMemtable(path string, strategy string,
 	return m, nil
 }
 
+func (m *Memtable) setCompression(codec byte, logger logrus.FieldLogger) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.codec = codec
+	m.logger = logger
+}
+
 func (m *Memtable) get(key []byte) ([]byte, error) {
 	start := time.Now()
 	defer m.metrics.get(start.UnixNano())
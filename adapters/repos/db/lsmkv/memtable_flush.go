@@ -42,12 +42,24 @@ func (m *Memtable) flush() error {
 		return nil
 	}
 
-	f, err := os.OpenFile(m.path+".db", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o666)
+	m.RLock()
+	codec, logger := m.codec, m.logger
+	m.RUnlock()
+
+	// compressed segments are written uncompressed first, see
+	// compressSegmentFile
+	path := m.path + ".db"
+	if codec != codecNone {
+		path += uncompressedSegmentExt
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o666)
 	if err != nil {
 		return err
 	}
 
-	w := bufio.NewWriter(f)
+	bufw := bufio.NewWriter(f)
+	w := segmentindex.NewChecksumWriter(bufw)
 
 	var keys []segmentindex.Key
 	switch m.strategy {
@@ -85,7 +97,11 @@ func (m *Memtable) flush() error {
 		return err
 	}
 
-	if err := w.Flush(); err != nil {
+	if _, err := w.WriteChecksums(nil); err != nil {
+		return err
+	}
+
+	if err := bufw.Flush(); err != nil {
 		return err
 	}
 
@@ -97,6 +113,12 @@ func (m *Memtable) flush() error {
 		return err
 	}
 
+	if codec != codecNone {
+		if err := compressSegmentFile(path, m.path+".db", codec, logger); err != nil {
+			return err
+		}
+	}
+
 	// only now that the file has been flushed is it safe to delete the commit log
 	// TODO: there might be an interest in keeping the commit logs around for
 	// longer as they might come in handy for replication
@@ -112,7 +134,7 @@ func (m *Memtable) flushDataReplace(f io.Writer) ([]segmentindex.Key, error) {
 	header := segmentindex.Header{
 		IndexStart:       uint64(totalDataLength + perObjectAdditions + headerSize),
 		Level:            0, // always level zero on a new one
-		Version:          0, // always version 0 for now
+		Version:          segmentindex.CurrentSegmentVersion,
 		SecondaryIndices: m.secondaryIndices,
 		Strategy:         SegmentStrategyFromString(m.strategy),
 	}
@@ -189,7 +211,7 @@ func (m *Memtable) flushDataCollection(f io.Writer,
 	header := segmentindex.Header{
 		IndexStart:       uint64(totalDataLength + segmentindex.HeaderSize),
 		Level:            0, // always level zero on a new one
-		Version:          0, // always version 0 for now
+		Version:          segmentindex.CurrentSegmentVersion,
 		SecondaryIndices: m.secondaryIndices,
 		Strategy:         SegmentStrategyFromString(m.strategy),
 	}
@@ -40,6 +40,10 @@ type Metrics struct {
 	memtableDurations    prometheus.ObserverVec
 	memtableSize         *prometheus.GaugeVec
 	DimensionSum         *prometheus.GaugeVec
+	checksumFailures     *prometheus.CounterVec
+	scrubbedBytes        *prometheus.CounterVec
+	compactionWritten    *prometheus.CounterVec
+	flushedBytes         *prometheus.CounterVec
 
 	groupClasses bool
 }
@@ -126,6 +130,22 @@ func NewMetrics(promMetrics *monitoring.PrometheusMetrics, className,
 			"class_name": className,
 			"shard_name": shardName,
 		}),
+		checksumFailures: promMetrics.LSMSegmentChecksumFailures.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}),
+		scrubbedBytes: promMetrics.LSMSegmentScrubbedBytes.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}),
+		compactionWritten: promMetrics.LSMCompactionWrittenBytes.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}),
+		flushedBytes: promMetrics.LSMFlushedBytes.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}),
 	}
 }
 
@@ -179,6 +199,62 @@ func (m *Metrics) MemtableSizeSetter(path, strategy string) Setter {
 	}
 }
 
+func (m *Metrics) ChecksumFailure(strategy, path string) {
+	if m == nil {
+		return
+	}
+	if m.groupClasses {
+		path = "n/a"
+	}
+
+	m.checksumFailures.With(prometheus.Labels{
+		"strategy": strategy,
+		"path":     path,
+	}).Inc()
+}
+
+func (m *Metrics) ScrubbedBytes(strategy, path string, bytes int) {
+	if m == nil {
+		return
+	}
+	if m.groupClasses {
+		path = "n/a"
+	}
+
+	m.scrubbedBytes.With(prometheus.Labels{
+		"strategy": strategy,
+		"path":     path,
+	}).Add(float64(bytes))
+}
+
+func (m *Metrics) CompactionWrittenBytes(strategy, path string, bytes int64) {
+	if m == nil {
+		return
+	}
+	if m.groupClasses {
+		path = "n/a"
+	}
+
+	m.compactionWritten.With(prometheus.Labels{
+		"strategy": strategy,
+		"path":     path,
+	}).Add(float64(bytes))
+}
+
+func (m *Metrics) FlushedBytes(strategy, path string, bytes int64) {
+	if m == nil {
+		return
+	}
+	if m.groupClasses {
+		path = "n/a"
+	}
+
+	m.flushedBytes.With(prometheus.Labels{
+		"strategy": strategy,
+		"path":     path,
+	}).Add(float64(bytes))
+}
+
 func (m *Metrics) BloomFilterObserver(strategy, operation string) TimeObserver {
 	if m == nil {
 		return noOpTimeObserver
@@ -17,6 +17,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sync/atomic"
+	"time"
 
 	"github.com/edsrzf/mmap-go"
 	"github.com/sirupsen/logrus"
@@ -52,6 +54,24 @@ type segment struct {
 	// the net addition this segment adds with respect to all previous segments
 	calcCountNetAdditions bool // see bucket for more datails
 	countNetAdditions     int
+
+	// checksums of SegmentV1 segments. Data blocks are verified when they are
+	// read for the first time, the scrubber verifies them all periodically.
+	checksums      *segmentindex.Checksums
+	verifiedBlocks []atomic.Bool
+	corruption     atomic.Pointer[CorruptSegment]
+	onCorruption   func(CorruptSegment)
+	scrubbedAt     time.Time
+	scrubNextBlock int
+
+	// derived files are computed in memory, but not stored, see WithReadOnly
+	readOnly bool
+
+	// set for segments in the remote tier, see RemoteTier
+	remote *remoteSegment
+
+	// locates the compressed data of SegmentV2 segments
+	blocks *compressedBlocks
 }
 
 type diskIndex interface {
@@ -75,94 +95,143 @@ type diskIndex interface {
 func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 	existsLower existsOnLowerSegmentsFn, mmapContents bool,
 	useBloomFilter bool, calcCountNetAdditions bool, overwriteDerived bool,
+	readOnly bool,
 ) (*segment, error) {
+	seg := &segment{
+		path:                  path,
+		logger:                logger,
+		metrics:               metrics,
+		mmapContents:          mmapContents,
+		useBloomFilter:        useBloomFilter,
+		calcCountNetAdditions: calcCountNetAdditions,
+		readOnly:              readOnly,
+	}
+	if err := seg.mapFile(path); err != nil {
+		return nil, err
+	}
+
+	if seg.useBloomFilter {
+		if err := seg.initBloomFilters(metrics, overwriteDerived); err != nil {
+			seg.closeFile()
+			return nil, err
+		}
+	}
+	if seg.calcCountNetAdditions {
+		if err := seg.initCountNetAdditions(existsLower, overwriteDerived); err != nil {
+			seg.closeFile()
+			return nil, err
+		}
+	}
+
+	return seg, nil
+}
+
+// mapFile opens the segment file at path and parses its header and indexes.
+// The path differs from the segment path for segments in the remote tier.
+func (s *segment) mapFile(path string) (err error) {
 	file, err := os.Open(path)
 	if err != nil {
-		return nil, fmt.Errorf("open file: %w", err)
+		return fmt.Errorf("open file: %w", err)
 	}
+	defer func() {
+		// a segment which cannot be loaded must not keep the file open, so
+		// that it can be removed or loaded again
+		if err != nil {
+			file.Close()
+		}
+	}()
 
 	fileInfo, err := file.Stat()
 	if err != nil {
-		return nil, fmt.Errorf("stat file: %w", err)
+		return fmt.Errorf("stat file: %w", err)
+	}
+	if fileInfo.Size() < segmentindex.HeaderSize {
+		return fmt.Errorf("segment %s is too short to hold a header", s.path)
 	}
 
 	contents, err := mmap.MapRegion(file, int(fileInfo.Size()), mmap.RDONLY, 0, 0)
 	if err != nil {
-		return nil, fmt.Errorf("mmap file: %w", err)
+		return fmt.Errorf("mmap file: %w", err)
 	}
+	defer func() {
+		if err != nil {
+			contents.Unmap()
+			s.contents = nil
+			s.blocks = nil
+			s.index = nil
+			s.secondaryIndices = nil
+		}
+	}()
 
 	header, err := segmentindex.ParseHeader(bytes.NewReader(contents[:segmentindex.HeaderSize]))
 	if err != nil {
-		return nil, fmt.Errorf("parse header: %w", err)
+		return fmt.Errorf("parse header: %w", err)
 	}
 
 	switch header.Strategy {
 	case segmentindex.StrategyReplace, segmentindex.StrategySetCollection,
 		segmentindex.StrategyMapCollection, segmentindex.StrategyRoaringSet:
 	default:
-		return nil, fmt.Errorf("unsupported strategy in segment")
+		return fmt.Errorf("unsupported strategy in segment")
 	}
 
-	primaryIndex, err := header.PrimaryIndex(contents)
+	checksums, err := verifySegmentIndexes(contents, header)
 	if err != nil {
-		return nil, fmt.Errorf("extract primary index position: %w", err)
+		return fmt.Errorf("verify segment %s: %w", s.path, err)
 	}
+	indexContents := trimChecksums(contents, checksums)
 
-	primaryDiskIndex := segmentindex.NewDiskTree(primaryIndex)
-
-	seg := &segment{
-		level:                 header.Level,
-		path:                  path,
-		contents:              contents,
-		version:               header.Version,
-		secondaryIndexCount:   header.SecondaryIndices,
-		segmentStartPos:       header.IndexStart,
-		segmentEndPos:         uint64(fileInfo.Size()),
-		strategy:              header.Strategy,
-		dataStartPos:          segmentindex.HeaderSize, // fixed value that's the same for all strategies
-		dataEndPos:            header.IndexStart,
-		index:                 primaryDiskIndex,
-		logger:                logger,
-		metrics:               metrics,
-		size:                  fileInfo.Size(),
-		mmapContents:          mmapContents,
-		useBloomFilter:        useBloomFilter,
-		calcCountNetAdditions: calcCountNetAdditions,
+	primaryIndex, err := header.PrimaryIndex(indexContents)
+	if err != nil {
+		return fmt.Errorf("extract primary index position: %w", err)
 	}
 
-	// Using pread strategy requires file to remain open for segment lifetime
-	if seg.mmapContents {
-		defer file.Close()
-	} else {
-		seg.contentFile = file
+	s.level = header.Level
+	s.contents = contents
+	s.version = header.Version
+	s.secondaryIndexCount = header.SecondaryIndices
+	s.segmentStartPos = header.IndexStart
+	s.segmentEndPos = uint64(len(indexContents))
+	s.strategy = header.Strategy
+	s.dataStartPos = segmentindex.HeaderSize
+	s.dataEndPos = header.IndexStart // the end of the compressed data, see initCompressedBlocks
+	s.index = segmentindex.NewDiskTree(primaryIndex)
+	s.size = fileInfo.Size()
+	s.initChecksums(checksums)
+	if err := s.initCompressedBlocks(); err != nil {
+		return fmt.Errorf("segment %s: %w", s.path, err)
 	}
 
-	if seg.secondaryIndexCount > 0 {
-		seg.secondaryIndices = make([]diskIndex, seg.secondaryIndexCount)
-		for i := range seg.secondaryIndices {
-			secondary, err := header.SecondaryIndex(contents, uint16(i))
+	if s.secondaryIndexCount > 0 {
+		s.secondaryIndices = make([]diskIndex, s.secondaryIndexCount)
+		for i := range s.secondaryIndices {
+			secondary, err := header.SecondaryIndex(indexContents, uint16(i))
 			if err != nil {
-				return nil, fmt.Errorf("get position for secondary index at %d: %w", i, err)
+				return fmt.Errorf("get position for secondary index at %d: %w", i, err)
 			}
-			seg.secondaryIndices[i] = segmentindex.NewDiskTree(secondary)
+			s.secondaryIndices[i] = segmentindex.NewDiskTree(secondary)
 		}
 	}
 
-	if seg.useBloomFilter {
-		if err := seg.initBloomFilters(metrics, overwriteDerived); err != nil {
-			return nil, err
-		}
-	}
-	if seg.calcCountNetAdditions {
-		if err := seg.initCountNetAdditions(existsLower, overwriteDerived); err != nil {
-			return nil, err
-		}
+	// Using pread strategy requires file to remain open for segment lifetime
+	if s.mmapContents {
+		file.Close()
+	} else {
+		s.contentFile = file
 	}
 
-	return seg, nil
+	return nil
 }
 
 func (s *segment) close() error {
+	if s.remote != nil {
+		s.remote.closed.Store(true)
+		return s.unload()
+	}
+	return s.closeFile()
+}
+
+func (s *segment) closeFile() error {
 	var munmapErr, fileCloseErr error
 
 	m := mmap.MMap(s.contents)
@@ -197,6 +266,16 @@ func (s *segment) drop() error {
 		return fmt.Errorf("drop count net additions file: %w", err)
 	}
 
+	if s.remote != nil {
+		return s.dropRemote()
+	}
+
+	// a marker is left next to the segment if moving it to the remote tier was
+	// interrupted, see tierOnce
+	if err := os.RemoveAll(remoteMarkerPath(s.path)); err != nil {
+		return fmt.Errorf("drop remote marker: %w", err)
+	}
+
 	// for the segment itself, we're not using RemoveAll, but Remove. If there
 	// was a NotExists error here, something would be seriously wrong, and we
 	// don't want to ignore it.
@@ -235,8 +314,15 @@ func (s *segment) newNodeReader(offset nodeOffset) (*nodeReader, error) {
 		r   io.Reader
 		err error
 	)
+	if s.blocks != nil {
+		return s.newCursorNodeReader(offset, &compressedReader{})
+	}
+	if err := s.verifyRange(offset.start, offset.end); err != nil {
+		return nil, fmt.Errorf("new nodeReader: %w", err)
+	}
+
 	if s.mmapContents {
-		contents := s.contents[offset.start:]
+		contents := s.contents[offset.start:s.segmentEndPos]
 		if offset.end != 0 {
 			contents = s.contents[offset.start:offset.end]
 		}
@@ -250,7 +336,34 @@ func (s *segment) newNodeReader(offset nodeOffset) (*nodeReader, error) {
 	return &nodeReader{r: r}, nil
 }
 
+// newCursorNodeReader is newNodeReader for cursors. For compressed segments,
+// the block which was decompressed last is kept in blocks, as cursors read
+// the nodes of a block one after another.
+func (s *segment) newCursorNodeReader(offset nodeOffset, blocks *compressedReader) (*nodeReader, error) {
+	if s.blocks == nil {
+		return s.newNodeReader(offset)
+	}
+
+	// the blocks are verified when they are decompressed
+	if offset.start >= s.dataEndPos {
+		return nil, fmt.Errorf("new nodeReader: %w", lsmkv.NotFound)
+	}
+	blocks.reset(s, offset.start, offset.end)
+	return &nodeReader{r: blocks}, nil
+}
+
 func (s *segment) copyNode(b []byte, offset nodeOffset) error {
+	if s.blocks != nil {
+		if err := s.readCompressed(b, offset.start); err != nil {
+			return fmt.Errorf("copy node: %w", err)
+		}
+		return nil
+	}
+
+	if err := s.verifyRange(offset.start, offset.end); err != nil {
+		return fmt.Errorf("copy node: %w", err)
+	}
+
 	if s.mmapContents {
 		copy(b, s.contents[offset.start:offset.end])
 		return nil
@@ -275,6 +388,6 @@ func (s *segment) bufferedReaderAt(offset uint64) (*bufio.Reader, error) {
 		return nil, fmt.Errorf("nil contentFile for segment at %s", s.path)
 	}
 
-	r := io.NewSectionReader(s.contentFile, int64(offset), s.size)
+	r := io.NewSectionReader(s.contentFile, int64(offset), int64(s.segmentEndPos)-int64(offset))
 	return bufio.NewReader(r), nil
 }
@@ -97,6 +97,12 @@ func (s *segment) initBloomFilter(overwrite bool) error {
 }
 
 func (s *segment) computeAndStoreBloomFilter(path string) error {
+	s.pin()
+	defer s.unpin()
+	if err := s.load(); err != nil {
+		return err
+	}
+
 	keys, err := s.index.AllKeys()
 	if err != nil {
 		return err
@@ -164,6 +170,10 @@ func (s *segment) precomputeBloomFilter() error {
 }
 
 func (s *segment) storeBloomFilterOnDisk(path string) error {
+	if s.readOnly {
+		return nil
+	}
+
 	buf := new(bytes.Buffer)
 
 	_, err := s.bloomFilter.WriteTo(buf)
@@ -236,6 +246,12 @@ func (s *segment) initSecondaryBloomFilter(pos int, overwrite bool) error {
 }
 
 func (s *segment) computeAndStoreSecondaryBloomFilter(path string, pos int) error {
+	s.pin()
+	defer s.unpin()
+	if err := s.load(); err != nil {
+		return err
+	}
+
 	keys, err := s.secondaryIndices[pos].AllKeys()
 	if err != nil {
 		return err
@@ -280,6 +296,10 @@ func (s *segment) precomputeSecondaryBloomFilter(pos int) error {
 }
 
 func (s *segment) storeBloomFilterSecondaryOnDisk(path string, pos int) error {
+	if s.readOnly {
+		return nil
+	}
+
 	buf := new(bytes.Buffer)
 	_, err := s.secondaryBloomFilters[pos].WriteTo(buf)
 	if err != nil {
@@ -0,0 +1,181 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+)
+
+// CorruptSegment describes a segment which failed checksum verification
+type CorruptSegment struct {
+	Bucket     string
+	Path       string
+	Error      string
+	DetectedAt time.Time
+}
+
+// verifySegmentIndexes parses the checksums of SegmentV1 segments and
+// verifies the header and the indexes, they are read in full when the
+// segment is opened. It returns nil checksums for segments written before
+// checksums were introduced.
+func verifySegmentIndexes(contents []byte, header *segmentindex.Header,
+) (*segmentindex.Checksums, error) {
+	if header.Version < segmentindex.SegmentV1 {
+		return nil, nil
+	}
+
+	checksums, err := segmentindex.ParseChecksums(contents)
+	if err != nil {
+		return nil, err
+	}
+	if err := checksums.VerifyHeader(contents); err != nil {
+		return nil, err
+	}
+
+	first, last := checksums.BlockRange(header.IndexStart, checksums.End)
+	for block := first; block <= last; block++ {
+		if err := checksums.VerifyBlock(contents, block); err != nil {
+			return nil, err
+		}
+	}
+
+	return checksums, nil
+}
+
+// verifySegmentData verifies all blocks of the segment
+func verifySegmentData(contents []byte, checksums *segmentindex.Checksums) error {
+	if checksums == nil {
+		return nil
+	}
+
+	for block := 0; block < checksums.Blocks(); block++ {
+		if err := checksums.VerifyBlock(contents, block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// trimChecksums cuts off the checksum table, so that the last index ends at
+// the end of the returned contents
+func trimChecksums(contents []byte, checksums *segmentindex.Checksums) []byte {
+	if checksums == nil {
+		return contents
+	}
+	return contents[:checksums.End]
+}
+
+func (s *segment) initChecksums(checksums *segmentindex.Checksums) {
+	if checksums == nil {
+		return
+	}
+
+	s.checksums = checksums
+	s.verifiedBlocks = make([]atomic.Bool, checksums.Blocks())
+
+	// the indexes were verified when the segment was opened
+	first, last := checksums.BlockRange(s.segmentStartPos, checksums.End)
+	for block := first; block <= last; block++ {
+		s.verifiedBlocks[block].Store(true)
+	}
+}
+
+// verifyRange verifies the blocks overlapping with [start, end) which were
+// not verified before. If end is 0, only the first block is verified.
+func (s *segment) verifyRange(start, end uint64) error {
+	if s.checksums == nil {
+		return nil
+	}
+	if end == 0 {
+		end = start + 1
+	}
+
+	first, last := s.checksums.BlockRange(start, end)
+	for block := first; block <= last; block++ {
+		if s.verifiedBlocks[block].Load() {
+			continue
+		}
+		if err := s.verifyBlock(block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *segment) verifyBlock(block int) error {
+	if err := s.checksums.VerifyBlock(s.contents, block); err != nil {
+		err = fmt.Errorf("segment %s: %w", s.path, err)
+		s.markCorrupt(err)
+		return err
+	}
+
+	s.verifiedBlocks[block].Store(true)
+	return nil
+}
+
+// markCorrupt records the first checksum failure of the segment
+func (s *segment) markCorrupt(err error) {
+	corruption := &CorruptSegment{
+		Bucket:     filepath.Base(filepath.Dir(s.path)),
+		Path:       s.path,
+		Error:      err.Error(),
+		DetectedAt: time.Now(),
+	}
+	if !s.corruption.CompareAndSwap(nil, corruption) {
+		return
+	}
+
+	s.logger.WithField("action", "lsm_segment_checksum").
+		WithField("path", s.path).
+		WithError(err).
+		Error("segment is corrupted")
+
+	if s.onCorruption != nil {
+		s.onCorruption(*corruption)
+	}
+}
+
+// verifyAll verifies all blocks which were not verified before
+func (s *segment) verifyAll() error {
+	if s.checksums == nil {
+		return nil
+	}
+	return s.verifyRange(segmentindex.HeaderSize, s.checksums.End)
+}
+
+// scrub verifies up to maxBytes of the segment, continuing where the
+// previous call stopped. It returns the number of bytes verified and whether
+// the whole segment was verified. Unlike reads, the scrubber verifies blocks
+// even if they were verified before, as they may have been corrupted since.
+func (s *segment) scrub(maxBytes int) (scrubbed int, done bool) {
+	if s.checksums == nil || s.corruption.Load() != nil {
+		// nothing (more) to verify
+		return 0, true
+	}
+
+	for ; s.scrubNextBlock < s.checksums.Blocks(); s.scrubNextBlock++ {
+		if scrubbed >= maxBytes {
+			return scrubbed, false
+		}
+		if err := s.verifyBlock(s.scrubNextBlock); err != nil {
+			return scrubbed, true
+		}
+		scrubbed += segmentindex.ChecksumBlockSize
+	}
+
+	return scrubbed, true
+}
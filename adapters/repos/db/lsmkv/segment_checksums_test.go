@@ -0,0 +1,239 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+// corruptSegmentValue flips a bit of the first occurrence of value in the
+// only segment of the bucket directory
+func corruptSegmentValue(t *testing.T, dir string, value []byte) string {
+	files, err := os.ReadDir(dir)
+	require.Nil(t, err)
+	name, ok := findFileWithExt(files, ".db")
+	require.True(t, ok)
+
+	path := filepath.Join(dir, name)
+	contents, err := os.ReadFile(path)
+	require.Nil(t, err)
+	pos := bytes.Index(contents, value)
+	require.GreaterOrEqual(t, pos, 0)
+
+	f, err := os.OpenFile(path, os.O_WRONLY, 0o666)
+	require.Nil(t, err)
+	defer f.Close()
+	_, err = f.WriteAt([]byte{contents[pos] ^ 0x01}, int64(pos))
+	require.Nil(t, err)
+
+	return path
+}
+
+func TestSegmentChecksums_VerifiedOnRead(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	opts := []BucketOption{WithStrategy(StrategyReplace)}
+	b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+	require.Nil(t, b.Put([]byte("hello"), []byte("world")))
+	// the segment spans multiple checksum blocks, so that the first one only
+	// holds data
+	for i := 0; i < 100; i++ {
+		require.Nil(t, b.Put([]byte(fmt.Sprintf("key-%03d", i)), make([]byte, 2*1024)))
+	}
+	require.Nil(t, b.FlushAndSwitch())
+	require.Nil(t, b.Shutdown(ctx))
+
+	path := corruptSegmentValue(t, dir, []byte("world"))
+
+	var reported []CorruptSegment
+	opts = append(opts, WithSegmentIntegrity(0, func(corruption CorruptSegment) {
+		reported = append(reported, corruption)
+	}))
+	b, err = NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	_, err = b.Get([]byte("key-099"))
+	assert.Nil(t, err)
+	assert.Empty(t, reported)
+
+	_, err = b.Get([]byte("hello"))
+	assert.ErrorIs(t, err, segmentindex.ErrChecksumMismatch)
+
+	require.Len(t, reported, 1)
+	assert.Equal(t, path, reported[0].Path)
+	assert.Equal(t, []CorruptSegment{reported[0]}, b.CorruptSegments())
+}
+
+func TestSegmentChecksums_CorruptIndexQuarantinedOnLoad(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	opts := []BucketOption{WithStrategy(StrategyReplace)}
+	b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+	require.Nil(t, b.Put([]byte("hello"), []byte("world")))
+	require.Nil(t, b.FlushAndSwitch())
+	require.Nil(t, b.Shutdown(ctx))
+
+	// the segment is smaller than a checksum block, it cannot be opened
+	path := corruptSegmentValue(t, dir, []byte("world"))
+
+	var reported []CorruptSegment
+	opts = append(opts, WithSegmentIntegrity(0, func(corruption CorruptSegment) {
+		reported = append(reported, corruption)
+	}))
+	b, err = NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	require.Len(t, reported, 1)
+	assert.Equal(t, path, reported[0].Path)
+	assert.Len(t, b.CorruptSegments(), 1)
+	_, err = os.Stat(path + ".corrupt")
+	assert.Nil(t, err)
+
+	value, err := b.Get([]byte("hello"))
+	require.Nil(t, err)
+	assert.Nil(t, value)
+
+	quarantined, err := b.QuarantineCorruptSegments()
+	require.Nil(t, err)
+	assert.Equal(t, []string{path}, quarantined)
+	assert.Empty(t, b.CorruptSegments())
+}
+
+func TestSegmentChecksums_CorruptSegmentReleasedOnLoad(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	// openedFiles lists the files the process has open or mapped
+	openedFiles := func(t *testing.T) string {
+		maps, err := os.ReadFile("/proc/self/maps")
+		if err != nil {
+			t.Skip("no /proc/self/maps")
+		}
+		entries, err := os.ReadDir("/proc/self/fd")
+		require.Nil(t, err)
+		opened := string(maps)
+		for _, entry := range entries {
+			target, err := os.Readlink(filepath.Join("/proc/self/fd", entry.Name()))
+			if err == nil {
+				opened += "\n" + target
+			}
+		}
+		return opened
+	}
+
+	for _, mmapContents := range []bool{true, false} {
+		t.Run(fmt.Sprintf("mmap contents %v", mmapContents), func(t *testing.T) {
+			dir := t.TempDir()
+			b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+				cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+				WithStrategy(StrategyReplace))
+			require.Nil(t, err)
+			require.Nil(t, b.Put([]byte("hello"), []byte("world")))
+			require.Nil(t, b.FlushAndSwitch())
+			require.Nil(t, b.Shutdown(ctx))
+
+			files, err := os.ReadDir(dir)
+			require.Nil(t, err)
+			name, ok := findFileWithExt(files, ".db")
+			require.True(t, ok)
+			original, err := os.ReadFile(filepath.Join(dir, name))
+			require.Nil(t, err)
+
+			path := corruptSegmentValue(t, dir, []byte("world"))
+			_, err = newSegment(path, logger, nil, nil, mmapContents, false, false, false, false)
+			require.NotNil(t, err)
+			assert.NotContains(t, openedFiles(t), path)
+
+			// the repaired segment can be loaded again
+			require.Nil(t, os.WriteFile(path, original, 0o666))
+			seg, err := newSegment(path, logger, nil, nil, mmapContents, false, false, false, false)
+			require.Nil(t, err)
+			require.Nil(t, seg.close())
+			assert.NotContains(t, openedFiles(t), path)
+			require.Nil(t, os.Remove(path))
+		})
+	}
+}
+
+func TestSegmentChecksums_Scrubber(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	var reported []CorruptSegment
+	b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyReplace),
+		WithSegmentIntegrity(time.Nanosecond, func(corruption CorruptSegment) {
+			reported = append(reported, corruption)
+		}))
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	require.Nil(t, b.Put([]byte("hello"), []byte("world")))
+	require.Nil(t, b.FlushAndSwitch())
+
+	noAbort := func() bool { return false }
+
+	t.Run("intact segments are scrubbed without errors", func(t *testing.T) {
+		assert.True(t, b.disk.scrub(noAbort))
+		assert.Empty(t, reported)
+		assert.Empty(t, b.CorruptSegments())
+	})
+
+	t.Run("scrubber detects corruption on disk", func(t *testing.T) {
+		path := corruptSegmentValue(t, dir, []byte("world"))
+
+		b.disk.scrub(noAbort)
+		require.Len(t, reported, 1)
+		assert.Equal(t, path, reported[0].Path)
+		assert.Len(t, b.CorruptSegments(), 1)
+	})
+
+	t.Run("corrupted segments are quarantined", func(t *testing.T) {
+		quarantined, err := b.QuarantineCorruptSegments()
+		require.Nil(t, err)
+		require.Len(t, quarantined, 1)
+
+		_, err = os.Stat(quarantined[0] + ".corrupt")
+		assert.Nil(t, err)
+		assert.Empty(t, b.CorruptSegments())
+
+		value, err := b.Get([]byte("hello"))
+		require.Nil(t, err)
+		assert.Nil(t, value)
+	})
+}
@@ -30,6 +30,10 @@ func (s *segment) getCollection(key []byte) ([]value, error) {
 		return nil, lsmkv.NotFound
 	}
 
+	if err := s.requireLoaded(); err != nil {
+		return nil, err
+	}
+
 	node, err := s.index.Get(key)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,581 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+)
+
+const (
+	CompressionNone = "none"
+	CompressionZstd = "zstd"
+	CompressionLZ4  = "lz4"
+)
+
+// codecs of the blocks of SegmentV2 segments, every block is prefixed with
+// the codec it was compressed with
+const (
+	codecNone byte = 0
+	codecZstd byte = 1
+	codecLZ4  byte = 2
+)
+
+const (
+	// compressedBlockSize is the size of the data which is compressed as one
+	// block. Reading a node decompresses all blocks it overlaps with, larger
+	// blocks compress better, but make reads of single nodes more expensive.
+	compressedBlockSize = 32 * 1024
+
+	// blockTrailerSize is composed of 1 byte for the codec, 3 bytes of
+	// padding, 4 bytes for the block size and 8 bytes for the end of the data
+	// before it was compressed
+	blockTrailerSize = 16
+
+	// uncompressedSegmentExt is appended to the path of segments which are
+	// written uncompressed before they are compressed, see
+	// compressSegmentFile. Such files are leftovers of a crash on startup.
+	uncompressedSegmentExt = ".uncompressed"
+)
+
+// ParseCompression validates the name of a compression for the segments of a
+// bucket, an empty name disables compression. The data of the segments, i.e.
+// the values of replace buckets and the postings of set, map and roaring set
+// buckets, is compressed in blocks with zstd or LZ4. The indexes are not
+// compressed.
+func ParseCompression(compression string) (string, error) {
+	if _, err := codecFromString(compression); err != nil {
+		return "", err
+	}
+	if compression == "" {
+		return CompressionNone, nil
+	}
+	return compression, nil
+}
+
+func codecFromString(compression string) (byte, error) {
+	switch compression {
+	case "", CompressionNone:
+		return codecNone, nil
+	case CompressionZstd:
+		return codecZstd, nil
+	case CompressionLZ4:
+		return codecLZ4, nil
+	default:
+		return 0, fmt.Errorf("unrecognized compression %q", compression)
+	}
+}
+
+func codecToString(codec byte) string {
+	switch codec {
+	case codecZstd:
+		return CompressionZstd
+	case codecLZ4:
+		return CompressionLZ4
+	default:
+		return CompressionNone
+	}
+}
+
+// blockEncoder compresses the blocks of a single segment
+type blockEncoder struct {
+	codec byte
+	zstd  *zstd.Encoder
+	lz4   lz4.Compressor
+	buf   []byte
+}
+
+func newBlockEncoder(codec byte) (*blockEncoder, error) {
+	e := &blockEncoder{codec: codec}
+	if codec != codecZstd {
+		return e, nil
+	}
+
+	enc, err := zstd.NewWriter(nil,
+		zstd.WithEncoderLevel(zstd.SpeedDefault),
+		zstd.WithEncoderConcurrency(1),
+		// blocks are covered by the checksums of the segment
+		zstd.WithEncoderCRC(false))
+	if err != nil {
+		return nil, fmt.Errorf("init zstd encoder: %w", err)
+	}
+	e.zstd = enc
+	return e, nil
+}
+
+// encode compresses a block and prefixes it with its codec. Blocks which do
+// not shrink are stored uncompressed. The result is valid until the next call.
+func (e *blockEncoder) encode(block []byte) ([]byte, error) {
+	out := append(e.buf[:0], e.codec)
+	switch e.codec {
+	case codecZstd:
+		out = e.zstd.EncodeAll(block, out)
+	case codecLZ4:
+		out = slices.Grow(out, lz4.CompressBlockBound(len(block)))
+		n, err := e.lz4.CompressBlock(block, out[1:cap(out)])
+		if err != nil {
+			return nil, fmt.Errorf("lz4: %w", err)
+		}
+		// n is 0 if the block is incompressible
+		out = out[:1+n]
+	}
+
+	if len(out) == 1 || len(out) > len(block) {
+		out = append(out[:0], codecNone)
+		out = append(out, block...)
+	}
+	e.buf = out
+	return out, nil
+}
+
+func (e *blockEncoder) close() {
+	if e.zstd != nil {
+		e.zstd.Close()
+	}
+}
+
+// zstdDecoder decompresses the blocks of all segments, it is safe for
+// concurrent use
+var zstdDecoder = sync.OnceValues(func() (*zstd.Decoder, error) {
+	dec, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+	if err != nil {
+		return nil, fmt.Errorf("init zstd decoder: %w", err)
+	}
+	return dec, nil
+})
+
+// decodeBlock decompresses a block of size bytes into dst
+func decodeBlock(in, dst []byte, size int) ([]byte, error) {
+	if len(in) == 0 {
+		return nil, fmt.Errorf("empty block")
+	}
+
+	var out []byte
+	switch in[0] {
+	case codecNone:
+		out = append(dst[:0], in[1:]...)
+	case codecZstd:
+		dec, err := zstdDecoder()
+		if err != nil {
+			return nil, err
+		}
+		if out, err = dec.DecodeAll(in[1:], dst[:0]); err != nil {
+			return nil, fmt.Errorf("zstd: %w", err)
+		}
+	case codecLZ4:
+		out = slices.Grow(dst[:0], size)[:size]
+		n, err := lz4.UncompressBlock(in[1:], out)
+		if err != nil {
+			return nil, fmt.Errorf("lz4: %w", err)
+		}
+		out = out[:n]
+	default:
+		return nil, fmt.Errorf("unrecognized codec %d", in[0])
+	}
+
+	if len(out) != size {
+		return nil, fmt.Errorf("block has %d bytes, expected %d", len(out), size)
+	}
+	return out, nil
+}
+
+// compressedBlocks locates the blocks of the data of a SegmentV2 segment. The
+// data is followed by a table with the position of every block and a
+// trailer, see blockTrailerSize. The indexes point into the data as it was
+// before it was compressed, block i holds the data from HeaderSize +
+// i*blockSize.
+type compressedBlocks struct {
+	codec     byte
+	blockSize uint64
+	// dataEnd is the end of the data before it was compressed
+	dataEnd uint64
+	// offsets holds the position of every block in the segment, followed by
+	// the position of the table
+	offsets []uint64
+}
+
+func parseCompressedBlocks(contents []byte, indexStart uint64) (*compressedBlocks, error) {
+	if indexStart < segmentindex.HeaderSize+blockTrailerSize || indexStart > uint64(len(contents)) {
+		return nil, fmt.Errorf("block table: %w", io.ErrUnexpectedEOF)
+	}
+
+	trailer := contents[indexStart-blockTrailerSize : indexStart]
+	b := &compressedBlocks{
+		codec:     trailer[0],
+		blockSize: uint64(binary.LittleEndian.Uint32(trailer[4:8])),
+		dataEnd:   binary.LittleEndian.Uint64(trailer[8:16]),
+	}
+	if b.blockSize == 0 || b.dataEnd < segmentindex.HeaderSize {
+		return nil, fmt.Errorf("invalid block table trailer")
+	}
+
+	count := (b.dataEnd - segmentindex.HeaderSize + b.blockSize - 1) / b.blockSize
+	tableEnd := indexStart - blockTrailerSize
+	if count > (tableEnd-segmentindex.HeaderSize)/8 {
+		return nil, fmt.Errorf("block table of %d blocks: %w", count, io.ErrUnexpectedEOF)
+	}
+	tableStart := tableEnd - 8*count
+
+	b.offsets = make([]uint64, count+1)
+	for i := uint64(0); i < count; i++ {
+		b.offsets[i] = binary.LittleEndian.Uint64(contents[tableStart+8*i:])
+	}
+	b.offsets[count] = tableStart
+
+	prev := uint64(segmentindex.HeaderSize)
+	for i, offset := range b.offsets {
+		// every block holds at least its codec
+		if (i == 0 && offset != prev) || (i > 0 && offset <= prev) {
+			return nil, fmt.Errorf("invalid position %d of block %d", offset, i)
+		}
+		prev = offset
+	}
+
+	return b, nil
+}
+
+// tableStart is the position of the block table in the segment
+func (b *compressedBlocks) tableStart() uint64 {
+	return b.offsets[len(b.offsets)-1]
+}
+
+// blockAt returns the block holding the position pos of the data
+func (b *compressedBlocks) blockAt(pos uint64) int {
+	return int((pos - segmentindex.HeaderSize) / b.blockSize)
+}
+
+// blockStart returns the position of the block in the data before
+// compression
+func (b *compressedBlocks) blockStart(block int) uint64 {
+	return segmentindex.HeaderSize + uint64(block)*b.blockSize
+}
+
+func (b *compressedBlocks) blockLen(block int) int {
+	start := b.blockStart(block)
+	if end := start + b.blockSize; end < b.dataEnd {
+		return int(b.blockSize)
+	}
+	return int(b.dataEnd - start)
+}
+
+// initCompressedBlocks reads the block table of SegmentV2 segments. The data
+// ends where it ended before it was compressed, as all positions in the
+// indexes refer to the data before compression.
+func (s *segment) initCompressedBlocks() error {
+	if s.version < segmentindex.SegmentV2 {
+		return nil
+	}
+
+	if err := s.verifyRange(s.segmentStartPos-blockTrailerSize, s.segmentStartPos); err != nil {
+		return err
+	}
+	blocks, err := parseCompressedBlocks(s.contents, s.segmentStartPos)
+	if err != nil {
+		return err
+	}
+	if err := s.verifyRange(blocks.tableStart(), s.segmentStartPos); err != nil {
+		return err
+	}
+
+	s.blocks = blocks
+	s.dataEndPos = blocks.dataEnd
+	return nil
+}
+
+// codec returns the codec the data of the segment is compressed with
+func (s *segment) codec() byte {
+	if s.blocks == nil {
+		return codecNone
+	}
+	return s.blocks.codec
+}
+
+var compressedBlockPool = sync.Pool{
+	New: func() any {
+		buf := make([]byte, 0, compressedBlockSize)
+		return &buf
+	},
+}
+
+// compressedReader reads the data of a SegmentV2 segment from pos to end as it
+// was before compression. It decompresses one block at a time.
+type compressedReader struct {
+	s   *segment
+	pos uint64
+	end uint64
+
+	// block is the decompressed block starting at blockStart
+	block      []byte
+	blockStart uint64
+	// raw holds the compressed block if it is read with pread
+	raw []byte
+}
+
+// newCompressedReader reads the data from start to end, end 0 denotes the end
+// of the data
+func (s *segment) newCompressedReader(start, end uint64) *compressedReader {
+	r := &compressedReader{}
+	r.reset(s, start, end)
+	return r
+}
+
+// reset makes the reader read the data of s from start to end. The last
+// decompressed block is kept, as cursors read the nodes of a block one after
+// another.
+func (r *compressedReader) reset(s *segment, start, end uint64) {
+	if r.s != s {
+		r.block = r.block[:0]
+		r.blockStart = 0
+	}
+	if end == 0 || end > s.blocks.dataEnd {
+		end = s.blocks.dataEnd
+	}
+	r.s, r.pos, r.end = s, start, end
+}
+
+func (r *compressedReader) Read(p []byte) (int, error) {
+	if r.pos >= r.end {
+		return 0, io.EOF
+	}
+
+	if r.pos < r.blockStart || r.pos >= r.blockStart+uint64(len(r.block)) {
+		if err := r.load(r.s.blocks.blockAt(r.pos)); err != nil {
+			return 0, err
+		}
+	}
+
+	end := uint64(len(r.block))
+	if r.end-r.blockStart < end {
+		end = r.end - r.blockStart
+	}
+	n := copy(p, r.block[r.pos-r.blockStart:end])
+	r.pos += uint64(n)
+	return n, nil
+}
+
+func (r *compressedReader) load(block int) error {
+	s, blocks := r.s, r.s.blocks
+	start, end := blocks.offsets[block], blocks.offsets[block+1]
+	if err := s.verifyRange(start, end); err != nil {
+		return err
+	}
+
+	var in []byte
+	if s.mmapContents {
+		in = s.contents[start:end]
+	} else {
+		r.raw = slices.Grow(r.raw[:0], int(end-start))[:end-start]
+		if _, err := s.contentFile.ReadAt(r.raw, int64(start)); err != nil {
+			return fmt.Errorf("read block %d of segment %s: %w", block, s.path, err)
+		}
+		in = r.raw
+	}
+
+	out, err := decodeBlock(in, r.block, blocks.blockLen(block))
+	if err != nil {
+		return fmt.Errorf("decompress block %d of segment %s: %w", block, s.path, err)
+	}
+	r.block = out
+	r.blockStart = blocks.blockStart(block)
+	return nil
+}
+
+// readCompressed fills b with the data from start on as it was before
+// compression
+func (s *segment) readCompressed(b []byte, start uint64) error {
+	buf := compressedBlockPool.Get().(*[]byte)
+	defer compressedBlockPool.Put(buf)
+
+	r := s.newCompressedReader(start, start+uint64(len(b)))
+	r.block = (*buf)[:0]
+	_, err := io.ReadFull(r, b)
+	*buf = r.block[:0]
+	return err
+}
+
+// dataReader reads the whole data of the segment as it was before
+// compression
+func (s *segment) dataReader() io.Reader {
+	if s.blocks != nil {
+		return s.newCompressedReader(segmentindex.HeaderSize, s.dataEndPos)
+	}
+	if s.mmapContents {
+		return bytes.NewReader(s.contents[segmentindex.HeaderSize:s.dataEndPos])
+	}
+	return io.NewSectionReader(s.contentFile, segmentindex.HeaderSize,
+		int64(s.dataEndPos)-segmentindex.HeaderSize)
+}
+
+// writeWithCodec writes a copy of the segment to w with its data compressed
+// with codec, the copy is a SegmentV1 segment for codecNone. The indexes are
+// copied as they are, only the positions of the secondary indexes are moved.
+// The data must be verified before.
+func (s *segment) writeWithCodec(w io.WriteSeeker, codec byte) error {
+	bufw := bufio.NewWriterSize(w, 256*1024)
+	cw := segmentindex.NewChecksumWriter(bufw)
+
+	// the header is written at the end, once the position of the indexes is
+	// known
+	if _, err := cw.Write(make([]byte, segmentindex.HeaderSize)); err != nil {
+		return fmt.Errorf("write empty header: %w", err)
+	}
+
+	indexStart := uint64(segmentindex.HeaderSize)
+	version := segmentindex.SegmentV1
+	if codec == codecNone {
+		n, err := io.Copy(cw, s.dataReader())
+		if err != nil {
+			return fmt.Errorf("copy data: %w", err)
+		}
+		indexStart += uint64(n)
+	} else {
+		n, err := writeCompressedBlocks(cw, s.dataReader(), s.dataEndPos, codec)
+		if err != nil {
+			return err
+		}
+		indexStart += n
+		version = segmentindex.SegmentV2
+	}
+
+	indexes := s.contents[s.segmentStartPos:s.segmentEndPos]
+	positions := make([]byte, 8*int(s.secondaryIndexCount))
+	for i := 0; i < int(s.secondaryIndexCount); i++ {
+		pos := binary.LittleEndian.Uint64(indexes[8*i:])
+		binary.LittleEndian.PutUint64(positions[8*i:], pos-s.segmentStartPos+indexStart)
+	}
+	if _, err := cw.Write(positions); err != nil {
+		return fmt.Errorf("write secondary index positions: %w", err)
+	}
+	if _, err := cw.Write(indexes[len(positions):]); err != nil {
+		return fmt.Errorf("write indexes: %w", err)
+	}
+
+	h := &segmentindex.Header{
+		Level:            s.level,
+		Version:          version,
+		SecondaryIndices: s.secondaryIndexCount,
+		Strategy:         s.strategy,
+		IndexStart:       indexStart,
+	}
+	if _, err := cw.WriteChecksums(h); err != nil {
+		return fmt.Errorf("write checksums: %w", err)
+	}
+	if err := bufw.Flush(); err != nil {
+		return fmt.Errorf("flush buffered: %w", err)
+	}
+
+	if _, err := w.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("seek to beginning to write header: %w", err)
+	}
+	if _, err := h.WriteTo(w); err != nil {
+		return fmt.Errorf("write header: %w", err)
+	}
+	return nil
+}
+
+// writeCompressedBlocks compresses the data read from r in blocks and writes
+// them followed by the block table. It returns the number of bytes written.
+func writeCompressedBlocks(w io.Writer, r io.Reader, dataEnd uint64, codec byte) (uint64, error) {
+	enc, err := newBlockEncoder(codec)
+	if err != nil {
+		return 0, err
+	}
+	defer enc.close()
+
+	pos := uint64(segmentindex.HeaderSize)
+	var offsets []uint64
+	block := make([]byte, compressedBlockSize)
+	for {
+		n, err := io.ReadFull(r, block)
+		if n > 0 {
+			out, err := enc.encode(block[:n])
+			if err != nil {
+				return 0, fmt.Errorf("compress block %d: %w", len(offsets), err)
+			}
+			if _, err := w.Write(out); err != nil {
+				return 0, fmt.Errorf("write block %d: %w", len(offsets), err)
+			}
+			offsets = append(offsets, pos)
+			pos += uint64(len(out))
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("read data: %w", err)
+		}
+	}
+
+	table := make([]byte, 8*len(offsets)+blockTrailerSize)
+	for i, offset := range offsets {
+		binary.LittleEndian.PutUint64(table[8*i:], offset)
+	}
+	trailer := table[8*len(offsets):]
+	trailer[0] = codec
+	binary.LittleEndian.PutUint32(trailer[4:8], compressedBlockSize)
+	binary.LittleEndian.PutUint64(trailer[8:16], dataEnd)
+	if _, err := w.Write(table); err != nil {
+		return 0, fmt.Errorf("write block table: %w", err)
+	}
+
+	return pos + uint64(len(table)) - segmentindex.HeaderSize, nil
+}
+
+// compressSegmentFile writes the segment at src to dst with its data
+// compressed with codec and removes src. Segments are written uncompressed
+// first, as the positions of their nodes refer to the uncompressed data.
+func compressSegmentFile(src, dst string, codec byte, logger logrus.FieldLogger) error {
+	seg, err := newSegment(src, logger, nil, nil, true, false, false, false, true)
+	if err != nil {
+		return fmt.Errorf("open uncompressed segment: %w", err)
+	}
+
+	err = writeCompressedSegmentFile(seg, dst, codec)
+	if closeErr := seg.close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Remove(src)
+}
+
+func writeCompressedSegmentFile(seg *segment, path string, codec byte) error {
+	if err := seg.verifyAll(); err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := seg.writeWithCodec(f, codec); err != nil {
+		f.Close()
+		return fmt.Errorf("compress segment %s: %w", path, err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("fsync compressed segment %s: %w", path, err)
+	}
+	return f.Close()
+}
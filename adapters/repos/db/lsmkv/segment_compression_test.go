@@ -0,0 +1,402 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func compressibleValue(i int) []byte {
+	return bytes.Repeat([]byte(fmt.Sprintf(`{"name":"object %d","description":"lorem ipsum"}`, i)), 20)
+}
+
+func TestSegmentCompression(t *testing.T) {
+	for _, compression := range []string{CompressionZstd, CompressionLZ4} {
+		for _, pread := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s pread=%t", compression, pread), func(t *testing.T) {
+				testReplaceCompression(t, compression, pread)
+			})
+		}
+	}
+}
+
+func testReplaceCompression(t *testing.T, compression string, pread bool) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	newBucket := func(compression string) *Bucket {
+		b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace), WithSecondaryIndices(1), WithPread(pread),
+			WithCompression(compression))
+		require.Nil(t, err)
+		return b
+	}
+	put := func(b *Bucket, from, to int) {
+		for i := from; i < to; i++ {
+			key := []byte(fmt.Sprintf("key-%03d", i))
+			secondary := []byte(fmt.Sprintf("secondary-%03d", i))
+			require.Nil(t, b.Put(key, compressibleValue(i), WithSecondaryKey(0, secondary)))
+		}
+		require.Nil(t, b.FlushAndSwitch())
+	}
+	assertValues := func(t *testing.T, b *Bucket, count int) {
+		for i := 0; i < count; i++ {
+			value, err := b.Get([]byte(fmt.Sprintf("key-%03d", i)))
+			require.Nil(t, err)
+			assert.Equal(t, compressibleValue(i), value)
+
+			value, err = b.GetBySecondary(0, []byte(fmt.Sprintf("secondary-%03d", i)))
+			require.Nil(t, err)
+			assert.Equal(t, compressibleValue(i), value)
+		}
+
+		c, err := b.Cursor()
+		require.Nil(t, err)
+		defer c.Close()
+		i := 0
+		for k, v := c.First(); k != nil && i < count; k, v = c.Next() {
+			assert.Equal(t, []byte(fmt.Sprintf("key-%03d", i)), k)
+			assert.Equal(t, compressibleValue(i), v)
+			i++
+		}
+		assert.Equal(t, count, i)
+
+		k, v := c.Seek([]byte("key-010"))
+		assert.Equal(t, []byte("key-010"), k)
+		assert.Equal(t, compressibleValue(10), v)
+	}
+	versions := func(b *Bucket) []uint16 {
+		b.disk.maintenanceLock.RLock()
+		defer b.disk.maintenanceLock.RUnlock()
+
+		var out []uint16
+		for _, seg := range b.disk.segments {
+			out = append(out, seg.version)
+		}
+		return out
+	}
+
+	b := newBucket(CompressionNone)
+	put(b, 0, 50)
+	put(b, 50, 100)
+	require.Nil(t, b.Delete([]byte("key-099")))
+	require.Nil(t, b.FlushAndSwitch())
+	uncompressedSize := b.disk.segments[0].size
+	require.Nil(t, b.Shutdown(ctx))
+
+	b = newBucket(compression)
+	defer func() { b.Shutdown(ctx) }()
+	assert.Equal(t, compression, b.GetCompression())
+
+	t.Run("new segments are compressed", func(t *testing.T) {
+		put(b, 100, 150)
+		assert.Equal(t, []uint16{
+			segmentindex.SegmentV1, segmentindex.SegmentV1,
+			segmentindex.SegmentV1, segmentindex.SegmentV2,
+		}, versions(b))
+		assert.Less(t, b.disk.segments[3].size, uncompressedSize/4)
+	})
+
+	t.Run("values are read from mixed segments", func(t *testing.T) {
+		assertValues(t, b, 99)
+		value, err := b.Get([]byte("key-099"))
+		require.Nil(t, err)
+		assert.Nil(t, value)
+	})
+
+	t.Run("compaction rewrites uncompressed segments", func(t *testing.T) {
+		for {
+			compacted := b.disk.compactIfLevelsMatch(func() bool { return false })
+			if !compacted {
+				break
+			}
+		}
+		assert.Equal(t, []uint16{segmentindex.SegmentV2}, versions(b))
+		assertValues(t, b, 99)
+		value, err := b.Get([]byte("key-120"))
+		require.Nil(t, err)
+		assert.Equal(t, compressibleValue(120), value)
+	})
+
+	t.Run("single segments are rewritten when the codec changes", func(t *testing.T) {
+		other := CompressionZstd
+		if compression == CompressionZstd {
+			other = CompressionLZ4
+		}
+		require.Nil(t, b.SetCompression(other))
+
+		recompressed, err := b.disk.recompressOnce()
+		require.Nil(t, err)
+		assert.True(t, recompressed)
+		assert.Equal(t, []uint16{segmentindex.SegmentV2}, versions(b))
+		assert.Equal(t, other, codecToString(b.disk.segments[0].codec()))
+		assertValues(t, b, 99)
+	})
+
+	t.Run("single segments are rewritten when compression is disabled", func(t *testing.T) {
+		require.Nil(t, b.SetCompression(CompressionNone))
+		compressedSize := b.disk.segments[0].size
+
+		recompressed, err := b.disk.recompressOnce()
+		require.Nil(t, err)
+		assert.True(t, recompressed)
+		assert.Equal(t, []uint16{segmentindex.SegmentV1}, versions(b))
+		assert.Greater(t, b.disk.segments[0].size, compressedSize)
+		assertValues(t, b, 99)
+
+		recompressed, err = b.disk.recompressOnce()
+		require.Nil(t, err)
+		assert.False(t, recompressed)
+	})
+
+	t.Run("compression survives a restart", func(t *testing.T) {
+		require.Nil(t, b.SetCompression(compression))
+		put(b, 150, 160)
+		require.Nil(t, b.Shutdown(ctx))
+
+		b = newBucket(compression)
+		assert.Equal(t, []uint16{segmentindex.SegmentV1, segmentindex.SegmentV2}, versions(b))
+		assertValues(t, b, 99)
+	})
+}
+
+func TestPostingsCompression(t *testing.T) {
+	for _, compression := range []string{CompressionZstd, CompressionLZ4} {
+		for _, pread := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s pread=%t", compression, pread), func(t *testing.T) {
+				testPostingsCompression(t, compression, pread)
+			})
+		}
+	}
+}
+
+func testPostingsCompression(t *testing.T, compression string, pread bool) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	const keys = 200
+
+	newBucket := func(dir, strategy string) *Bucket {
+		b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(strategy), WithPread(pread), WithCompression(compression))
+		require.Nil(t, err)
+		return b
+	}
+	key := func(i int) []byte {
+		return []byte(fmt.Sprintf("term-%04d", i))
+	}
+	docIDs := func(i, from, to int) []uint64 {
+		var out []uint64
+		for id := from; id < to; id++ {
+			out = append(out, uint64(id*keys+i))
+		}
+		return out
+	}
+	docIDBytes := func(id uint64) []byte {
+		buf := make([]byte, 8)
+		binary.BigEndian.PutUint64(buf, id)
+		return buf
+	}
+	// every key gets 50 postings in each of two segments, the segments are
+	// then compacted into one
+	write := func(t *testing.T, b *Bucket, add func(i int, ids []uint64)) {
+		for _, from := range []int{0, 50} {
+			for i := 0; i < keys; i++ {
+				add(i, docIDs(i, from, from+50))
+			}
+			require.Nil(t, b.FlushAndSwitch())
+		}
+	}
+	compact := func(t *testing.T, b *Bucket) {
+		for b.disk.compactIfLevelsMatch(func() bool { return false }) {
+		}
+		require.Len(t, b.disk.segments, 1)
+		assert.Equal(t, segmentindex.SegmentV2, b.disk.segments[0].version)
+		assert.Equal(t, compression, codecToString(b.disk.segments[0].codec()))
+	}
+
+	t.Run("set", func(t *testing.T) {
+		b := newBucket(t.TempDir(), StrategySetCollection)
+		defer b.Shutdown(ctx)
+
+		write(t, b, func(i int, ids []uint64) {
+			values := make([][]byte, len(ids))
+			for j, id := range ids {
+				values[j] = docIDBytes(id)
+			}
+			require.Nil(t, b.SetAdd(key(i), values))
+		})
+		compact(t, b)
+
+		for i := 0; i < keys; i++ {
+			values, err := b.SetList(key(i))
+			require.Nil(t, err)
+			require.Len(t, values, 100)
+			assert.Equal(t, docIDBytes(docIDs(i, 99, 100)[0]), values[99])
+		}
+
+		c, err := b.SetCursor()
+		require.Nil(t, err)
+		defer c.Close()
+		count := 0
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			assert.Equal(t, key(count), k)
+			assert.Len(t, v, 100)
+			count++
+		}
+		assert.Equal(t, keys, count)
+		k, _ := c.Seek(key(123))
+		assert.Equal(t, key(123), k)
+	})
+
+	t.Run("map", func(t *testing.T) {
+		b := newBucket(t.TempDir(), StrategyMapCollection)
+		defer b.Shutdown(ctx)
+
+		write(t, b, func(i int, ids []uint64) {
+			for _, id := range ids {
+				require.Nil(t, b.MapSet(key(i), MapPair{
+					Key:   docIDBytes(id),
+					Value: []byte{1, 0, 0, 0, 0, 0, 0, 0},
+				}))
+			}
+		})
+		compact(t, b)
+
+		for i := 0; i < keys; i++ {
+			pairs, err := b.MapList(ctx, key(i))
+			require.Nil(t, err)
+			require.Len(t, pairs, 100)
+			assert.Equal(t, docIDBytes(docIDs(i, 0, 1)[0]), pairs[0].Key)
+		}
+
+		c, err := b.MapCursor()
+		require.Nil(t, err)
+		defer c.Close()
+		count := 0
+		for k, v := c.First(ctx); k != nil; k, v = c.Next(ctx) {
+			assert.Equal(t, key(count), k)
+			assert.Len(t, v, 100)
+			count++
+		}
+		assert.Equal(t, keys, count)
+	})
+
+	t.Run("roaring set", func(t *testing.T) {
+		b := newBucket(t.TempDir(), StrategyRoaringSet)
+		defer b.Shutdown(ctx)
+
+		write(t, b, func(i int, ids []uint64) {
+			require.Nil(t, b.RoaringSetAddList(key(i), ids))
+		})
+		compact(t, b)
+
+		for i := 0; i < keys; i++ {
+			bm, err := b.RoaringSetGet(key(i))
+			require.Nil(t, err)
+			assert.ElementsMatch(t, docIDs(i, 0, 100), bm.ToArray())
+		}
+
+		c, err := b.CursorRoaringSet()
+		require.Nil(t, err)
+		defer c.Close()
+		count := 0
+		for k, bm := c.First(); k != nil; k, bm = c.Next() {
+			assert.Equal(t, key(count), k)
+			assert.Equal(t, 100, bm.GetCardinality())
+			count++
+		}
+		assert.Equal(t, keys, count)
+		k, bm := c.Seek(key(77))
+		assert.Equal(t, key(77), k)
+		assert.ElementsMatch(t, docIDs(77, 0, 100), bm.ToArray())
+	})
+}
+
+func TestCompressionOptions(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyMapCollection), WithCompression(CompressionLZ4))
+	require.Nil(t, err)
+	assert.Equal(t, CompressionLZ4, b.GetCompression())
+	require.Nil(t, b.Shutdown(ctx))
+
+	_, err = NewBucketCreator().NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyReplace), WithCompression("lz5"))
+	assert.ErrorContains(t, err, `unrecognized compression "lz5"`)
+
+	compression, err := ParseCompression("")
+	require.Nil(t, err)
+	assert.Equal(t, CompressionNone, compression)
+}
+
+func TestCompressedBlocks(t *testing.T) {
+	random := make([]byte, 3*compressedBlockSize)
+	rand.New(rand.NewSource(1)).Read(random)
+
+	for _, codec := range []byte{codecZstd, codecLZ4} {
+		t.Run(codecToString(codec), func(t *testing.T) {
+			// compressible blocks, an incompressible block and a partial one
+			data := append(bytes.Repeat(compressibleValue(1), 2*compressedBlockSize/len(compressibleValue(1))),
+				random[:compressedBlockSize]...)
+			data = append(data, compressibleValue(2)...)
+
+			contents := make([]byte, segmentindex.HeaderSize)
+			buf := bytes.NewBuffer(nil)
+			dataEnd := uint64(segmentindex.HeaderSize + len(data))
+			n, err := writeCompressedBlocks(buf, bytes.NewReader(data), dataEnd, codec)
+			require.Nil(t, err)
+			contents = append(contents, buf.Bytes()...)
+			require.Equal(t, uint64(len(contents)), segmentindex.HeaderSize+n)
+
+			blocks, err := parseCompressedBlocks(contents, uint64(len(contents)))
+			require.Nil(t, err)
+			assert.Equal(t, codec, blocks.codec)
+			assert.Equal(t, dataEnd, blocks.dataEnd)
+			require.Len(t, blocks.offsets, 5)
+			assert.Less(t, blocks.offsets[2]-blocks.offsets[0], uint64(compressedBlockSize/4))
+			assert.Equal(t, codecNone, contents[blocks.offsets[2]], "incompressible block is stored as is")
+
+			seg := &segment{contents: contents, mmapContents: true, blocks: blocks, dataEndPos: dataEnd}
+			all, err := io.ReadAll(seg.dataReader())
+			require.Nil(t, err)
+			assert.Equal(t, data, all)
+
+			// a range across blocks
+			start := uint64(segmentindex.HeaderSize + compressedBlockSize - 10)
+			part := make([]byte, compressedBlockSize+20)
+			require.Nil(t, seg.readCompressed(part, start))
+			assert.Equal(t, data[compressedBlockSize-10:2*compressedBlockSize+10], part)
+
+			_, err = parseCompressedBlocks(contents[:len(contents)-1], uint64(len(contents)-1))
+			assert.NotNil(t, err)
+		})
+	}
+}
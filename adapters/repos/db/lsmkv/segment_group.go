@@ -20,10 +20,14 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/usecases/memwatch"
@@ -64,6 +68,31 @@ type SegmentGroup struct {
 
 	allocChecker   memwatch.AllocChecker
 	maxSegmentSize int64
+
+	scrubInterval     time.Duration
+	scrubCallbackCtrl cyclemanager.CycleCallbackCtrl
+	onCorruption      func(CorruptSegment)
+	// quarantined holds the segments which could not be opened due to
+	// checksum failures until they are collected by quarantineCorruptSegments
+	quarantined []CorruptSegment
+
+	// codec compacted segments are compressed with
+	codec atomic.Uint32
+
+	// see WithCompactionPolicy
+	compactionPolicy   string
+	compactionFanOut   int
+	compactionBaseSize int64
+
+	// see WithReadOnly
+	readOnly bool
+
+	// see WithRemoteTier
+	tier *RemoteTier
+	// staged holds the local copies of remote segments made for a backup,
+	// see stageRemoteSegments
+	staged     []string
+	stagedLock sync.Mutex
 }
 
 type sgConfig struct {
@@ -77,6 +106,14 @@ type sgConfig struct {
 	calcCountNetAdditions bool
 	forceCompaction       bool
 	maxSegmentSize        int64
+	scrubInterval         time.Duration
+	onCorruption          func(CorruptSegment)
+	codec                 byte
+	compactionPolicy      string
+	compactionFanOut      int
+	compactionBaseSize    int64
+	readOnly              bool
+	tier                  *RemoteTier
 }
 
 func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
@@ -103,7 +140,15 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 		compactLeftOverSegments: cfg.forceCompaction,
 		maxSegmentSize:          cfg.maxSegmentSize,
 		allocChecker:            allocChecker,
+		scrubInterval:           cfg.scrubInterval,
+		onCorruption:            cfg.onCorruption,
+		compactionPolicy:        cfg.compactionPolicy,
+		compactionFanOut:        cfg.compactionFanOut,
+		compactionBaseSize:      cfg.compactionBaseSize,
+		readOnly:                cfg.readOnly,
+		tier:                    cfg.tier,
 	}
+	sg.codec.Store(uint32(cfg.codec))
 
 	segmentIndex := 0
 
@@ -112,6 +157,18 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 	// Note: it's important to process first the compacted segments
 	// TODO: a single iteration may be possible
 
+	for _, entry := range list {
+		if filepath.Ext(entry.Name()) != uncompressedSegmentExt || sg.readOnly {
+			continue
+		}
+
+		// a flush or compaction was interrupted before the segment was
+		// compressed, it is redone from the WAL or the compacted segments
+		if err := os.Remove(filepath.Join(sg.dir, entry.Name())); err != nil {
+			return nil, fmt.Errorf("delete uncompressed segment %q: %w", entry.Name(), err)
+		}
+	}
+
 	for _, entry := range list {
 		if filepath.Ext(entry.Name()) != ".tmp" {
 			continue
@@ -141,17 +198,20 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 		leftSegmentPath := filepath.Join(sg.dir, leftSegmentFilename)
 		rightSegmentPath := filepath.Join(sg.dir, rightSegmentFilename)
 
-		leftSegmentFound, err := fileExists(leftSegmentPath)
+		leftSegmentFound, err := segmentExists(leftSegmentPath)
 		if err != nil {
 			return nil, fmt.Errorf("check for presence of segment %s: %w", leftSegmentFilename, err)
 		}
 
-		rightSegmentFound, err := fileExists(rightSegmentPath)
+		rightSegmentFound, err := segmentExists(rightSegmentPath)
 		if err != nil {
 			return nil, fmt.Errorf("check for presence of segment %s: %w", rightSegmentFilename, err)
 		}
 
 		if leftSegmentFound && rightSegmentFound {
+			if sg.readOnly {
+				continue
+			}
 			if err := os.Remove(filepath.Join(sg.dir, entry.Name())); err != nil {
 				return nil, fmt.Errorf("delete partially compacted segment %q: %w", entry.Name(), err)
 			}
@@ -162,12 +222,11 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 			return nil, fmt.Errorf("missing right segment %q", rightSegmentFilename)
 		}
 
-		if !leftSegmentFound && rightSegmentFound {
+		if !leftSegmentFound && rightSegmentFound && !sg.readOnly {
 			// segment is initialized just to be erased
 			// there is no need of bloom filters nor net addition counter re-calculation
-			rightSegment, err := newSegment(rightSegmentPath, logger,
-				metrics, sg.makeExistsOnLower(segmentIndex),
-				sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false)
+			rightSegment, err := sg.openSegment(rightSegmentPath,
+				fetchingExistsOnLower(sg.makeExistsOnLower(segmentIndex)), false, false)
 			if err != nil {
 				return nil, fmt.Errorf("init already compacted right segment %s: %w", rightSegmentFilename, err)
 			}
@@ -182,22 +241,65 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 				return nil, fmt.Errorf("delete already compacted right segment %s: %w", rightSegmentFilename, err)
 			}
 
+			// a compaction of more than two segments drops them oldest first,
+			// the ones in between may still be present
+			for _, other := range list {
+				otherName, ok := segmentFileName(other.Name())
+				if !ok || !segmentIDBetween(segmentID(otherName), jointSegmentsIDs[0], jointSegmentsIDs[1]) {
+					continue
+				}
+
+				otherPath := filepath.Join(sg.dir, otherName)
+				otherFound, err := segmentExists(otherPath)
+				if err != nil {
+					return nil, fmt.Errorf("check for presence of segment %s: %w", otherName, err)
+				}
+				if !otherFound {
+					continue
+				}
+
+				otherSegment, err := sg.openSegment(otherPath,
+					fetchingExistsOnLower(sg.makeExistsOnLower(segmentIndex)), false, false)
+				if err != nil {
+					return nil, fmt.Errorf("init already compacted segment %s: %w", otherName, err)
+				}
+				if err := otherSegment.close(); err != nil {
+					return nil, fmt.Errorf("close already compacted segment %s: %w", otherName, err)
+				}
+				if err := otherSegment.drop(); err != nil {
+					return nil, fmt.Errorf("delete already compacted segment %s: %w", otherName, err)
+				}
+				segmentsAlreadyRecoveredFromCompaction[otherName] = struct{}{}
+			}
+
 			err = fsync(sg.dir)
 			if err != nil {
 				return nil, fmt.Errorf("fsync segment directory %s: %w", sg.dir, err)
 			}
 		}
 
-		if err := os.Rename(filepath.Join(sg.dir, entry.Name()), rightSegmentPath); err != nil {
+		segmentPath := rightSegmentPath
+		if sg.readOnly {
+			// the compacted segment is read from the temporary file, it replaces
+			// all segments it was compacted from
+			segmentPath = filepath.Join(sg.dir, entry.Name())
+			for _, other := range list {
+				otherName, ok := segmentFileName(other.Name())
+				if ok && segmentIDBetween(segmentID(otherName), jointSegmentsIDs[0], jointSegmentsIDs[1]) {
+					segmentsAlreadyRecoveredFromCompaction[otherName] = struct{}{}
+				}
+			}
+		} else if err := os.Rename(filepath.Join(sg.dir, entry.Name()), rightSegmentPath); err != nil {
 			return nil, fmt.Errorf("rename compacted segment file %q as %q: %w", entry.Name(), rightSegmentFilename, err)
 		}
 
-		segment, err := newSegment(rightSegmentPath, logger,
-			metrics, sg.makeExistsOnLower(segmentIndex),
-			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, true)
+		segment, err := newSegment(segmentPath, logger,
+			metrics, fetchingExistsOnLower(sg.makeExistsOnLower(segmentIndex)),
+			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, !sg.readOnly, sg.readOnly)
 		if err != nil {
 			return nil, fmt.Errorf("init segment %s: %w", rightSegmentFilename, err)
 		}
+		segment.onCorruption = sg.segmentCorrupted
 
 		sg.segments[segmentIndex] = segment
 		segmentIndex++
@@ -206,17 +308,57 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 	}
 
 	for _, entry := range list {
-		if filepath.Ext(entry.Name()) != ".db" {
+		name, ok := segmentFileName(entry.Name())
+		if !ok {
 			// skip, this could be commit log, etc.
 			continue
 		}
 
-		_, alreadyRecoveredFromCompaction := segmentsAlreadyRecoveredFromCompaction[entry.Name()]
+		_, alreadyRecoveredFromCompaction := segmentsAlreadyRecoveredFromCompaction[name]
 		if alreadyRecoveredFromCompaction {
 			// the .db file was already removed and restored from a compacted segment
 			continue
 		}
 
+		if name != entry.Name() {
+			// the segment is in the remote tier, unless moving it was interrupted
+			// before the local file was removed, or a copy made for a backup was
+			// left behind. Then it is loaded from the local file, the marker and
+			// the remote copy are removed.
+			local, err := fileExists(filepath.Join(sg.dir, name))
+			if err != nil {
+				return nil, fmt.Errorf("check for presence of segment %s: %w", name, err)
+			}
+			if local {
+				if sg.readOnly {
+					continue
+				}
+				markerPath := filepath.Join(sg.dir, entry.Name())
+				marker, markerErr := readRemoteMarker(markerPath)
+				if err := os.Remove(markerPath); err != nil {
+					return nil, fmt.Errorf("delete remote marker %s: %w", entry.Name(), err)
+				}
+				if tier := sg.tier; tier != nil && markerErr == nil {
+					enterrors.GoWrapper(func() {
+						tier.delete(context.Background(), marker.Key)
+					}, logger)
+				}
+				continue
+			}
+
+			segment, err := newRemoteSegment(filepath.Join(sg.dir, name), sg.tier, &sg.maintenanceLock, logger,
+				metrics, fetchingExistsOnLower(sg.makeExistsOnLower(segmentIndex)),
+				sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false, sg.readOnly)
+			if err != nil {
+				return nil, fmt.Errorf("init remote segment %s: %w", name, err)
+			}
+			segment.onCorruption = sg.segmentCorrupted
+
+			sg.segments[segmentIndex] = segment
+			segmentIndex++
+			continue
+		}
+
 		// before we can mount this file, we need to check if a WAL exists for it.
 		// If yes, we must assume that the flush never finished, as otherwise the
 		// WAL would have been lsmkv.Deleted. Thus we must remove it.
@@ -228,6 +370,9 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 		}
 		if ok {
 			// the segment will be recovered from the WAL
+			if sg.readOnly {
+				continue
+			}
 			err := os.Remove(filepath.Join(sg.dir, entry.Name()))
 			if err != nil {
 				return nil, fmt.Errorf("delete partially written segment %s: %w", entry.Name(), err)
@@ -243,11 +388,30 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 		}
 
 		segment, err := newSegment(filepath.Join(sg.dir, entry.Name()), logger,
-			metrics, sg.makeExistsOnLower(segmentIndex),
-			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false)
+			metrics, fetchingExistsOnLower(sg.makeExistsOnLower(segmentIndex)),
+			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false, sg.readOnly)
+		if errors.Is(err, segmentindex.ErrChecksumMismatch) && sg.readOnly {
+			// the segment is reported as corrupt, but its files are left as they are
+			sg.quarantined = append(sg.quarantined, CorruptSegment{
+				Bucket:     filepath.Base(sg.dir),
+				Path:       filepath.Join(sg.dir, entry.Name()),
+				Error:      err.Error(),
+				DetectedAt: time.Now(),
+			})
+			continue
+		}
+		if errors.Is(err, segmentindex.ErrChecksumMismatch) {
+			// a corrupted segment must not prevent the bucket from loading, its
+			// data can be restored from replicas
+			if err := sg.quarantineUnreadableSegment(filepath.Join(sg.dir, entry.Name()), err); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		if err != nil {
 			return nil, fmt.Errorf("init segment %s: %w", entry.Name(), err)
 		}
+		segment.onCorruption = sg.segmentCorrupted
 
 		sg.segments[segmentIndex] = segment
 		segmentIndex++
@@ -262,9 +426,17 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 	id := "segmentgroup/compaction/" + sg.dir
 	sg.compactionCallbackCtrl = compactionCallbacks.Register(id, sg.compactIfLevelsMatch)
 
+	if sg.scrubInterval > 0 {
+		id := "segmentgroup/scrub/" + sg.dir
+		sg.scrubCallbackCtrl = compactionCallbacks.Register(id, sg.scrub)
+	}
+
 	return sg, nil
 }
 
+// makeExistsOnLower checks the segments below nextSegmentIndex, it fails
+// with a remoteNotLoadedError if it needs a remote segment which is not
+// loaded, see fetchingExistsOnLower
 func (sg *SegmentGroup) makeExistsOnLower(nextSegmentIndex int) existsOnLowerSegmentsFn {
 	return func(key []byte) (bool, error) {
 		if nextSegmentIndex == 0 {
@@ -284,26 +456,36 @@ func (sg *SegmentGroup) makeExistsOnLower(nextSegmentIndex int) existsOnLowerSeg
 }
 
 func (sg *SegmentGroup) add(path string) error {
-	sg.maintenanceLock.Lock()
-	defer sg.maintenanceLock.Unlock()
-
-	newSegmentIndex := len(sg.segments)
-	segment, err := newSegment(path, sg.logger,
-		sg.metrics, sg.makeExistsOnLower(newSegmentIndex),
-		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, true)
-	if err != nil {
-		return fmt.Errorf("init segment %s: %w", path, err)
-	}
-
-	sg.segments = append(sg.segments, segment)
-	return nil
+	// the net additions are counted against the lower segments, the remote
+	// ones are fetched without holding the lock and the segment is
+	// initialized again, reusing the derived files of the previous attempt
+	overwriteDerived := true
+	return withRemoteSegments(&sg.maintenanceLock, func() error {
+		newSegmentIndex := len(sg.segments)
+		segment, err := newSegment(path, sg.logger,
+			sg.metrics, sg.makeExistsOnLower(newSegmentIndex),
+			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, overwriteDerived, false)
+		overwriteDerived = false
+		if err != nil {
+			return fmt.Errorf("init segment %s: %w", path, err)
+		}
+		segment.onCorruption = sg.segmentCorrupted
+		// the segment was just written, there is no need to scrub it right away
+		segment.scrubbedAt = time.Now()
+		sg.metrics.FlushedBytes(sg.strategy, sg.dir, segment.size)
+
+		sg.segments = append(sg.segments, segment)
+		return nil
+	})
 }
 
 func (sg *SegmentGroup) get(key []byte) ([]byte, error) {
-	sg.maintenanceLock.RLock()
-	defer sg.maintenanceLock.RUnlock()
-
-	return sg.getWithUpperSegmentBoundary(key, len(sg.segments)-1)
+	var v []byte
+	err := withRemoteSegments(sg.maintenanceLock.RLocker(), func() (err error) {
+		v, err = sg.getWithUpperSegmentBoundary(key, len(sg.segments)-1)
+		return err
+	})
+	return v, err
 }
 
 // not thread-safe on its own, as the assumption is that this is called from a
@@ -324,6 +506,11 @@ func (sg *SegmentGroup) getWithUpperSegmentBoundary(key []byte, topMostSegment i
 				return nil, nil
 			}
 
+			var notLoaded *remoteNotLoadedError
+			if errors.Is(err, segmentindex.ErrChecksumMismatch) || errors.As(err, &notLoaded) {
+				return nil, err
+			}
+
 			panic(fmt.Sprintf("unsupported error in segmentGroup.get(): %v", err))
 		}
 
@@ -334,10 +521,12 @@ func (sg *SegmentGroup) getWithUpperSegmentBoundary(key []byte, topMostSegment i
 }
 
 func (sg *SegmentGroup) getErrDeleted(key []byte) ([]byte, error) {
-	sg.maintenanceLock.RLock()
-	defer sg.maintenanceLock.RUnlock()
-
-	return sg.getWithUpperSegmentBoundaryErrDeleted(key, len(sg.segments)-1)
+	var v []byte
+	err := withRemoteSegments(sg.maintenanceLock.RLocker(), func() (err error) {
+		v, err = sg.getWithUpperSegmentBoundaryErrDeleted(key, len(sg.segments)-1)
+		return err
+	})
+	return v, err
 }
 
 func (sg *SegmentGroup) getWithUpperSegmentBoundaryErrDeleted(key []byte, topMostSegment int) ([]byte, error) {
@@ -356,6 +545,11 @@ func (sg *SegmentGroup) getWithUpperSegmentBoundaryErrDeleted(key []byte, topMos
 				return nil, err
 			}
 
+			var notLoaded *remoteNotLoadedError
+			if errors.Is(err, segmentindex.ErrChecksumMismatch) || errors.As(err, &notLoaded) {
+				return nil, err
+			}
+
 			panic(fmt.Sprintf("unsupported error in segmentGroup.get(): %v", err))
 		}
 
@@ -366,9 +560,15 @@ func (sg *SegmentGroup) getWithUpperSegmentBoundaryErrDeleted(key []byte, topMos
 }
 
 func (sg *SegmentGroup) getBySecondaryIntoMemory(pos int, key []byte, buffer []byte) ([]byte, []byte, error) {
-	sg.maintenanceLock.RLock()
-	defer sg.maintenanceLock.RUnlock()
+	var v, allocatedBuff []byte
+	err := withRemoteSegments(sg.maintenanceLock.RLocker(), func() (err error) {
+		v, allocatedBuff, err = sg.getBySecondaryIntoMemoryLocked(pos, key, buffer)
+		return err
+	})
+	return v, allocatedBuff, err
+}
 
+func (sg *SegmentGroup) getBySecondaryIntoMemoryLocked(pos int, key []byte, buffer []byte) ([]byte, []byte, error) {
 	// assumes "replace" strategy
 
 	// start with latest and exit as soon as something is found, thus making sure
@@ -384,6 +584,11 @@ func (sg *SegmentGroup) getBySecondaryIntoMemory(pos int, key []byte, buffer []b
 				return nil, nil, nil
 			}
 
+			var notLoaded *remoteNotLoadedError
+			if errors.Is(err, segmentindex.ErrChecksumMismatch) || errors.As(err, &notLoaded) {
+				return nil, nil, err
+			}
+
 			panic(fmt.Sprintf("unsupported error in segmentGroup.get(): %v", err))
 		}
 
@@ -394,9 +599,15 @@ func (sg *SegmentGroup) getBySecondaryIntoMemory(pos int, key []byte, buffer []b
 }
 
 func (sg *SegmentGroup) getCollection(key []byte) ([]value, error) {
-	sg.maintenanceLock.RLock()
-	defer sg.maintenanceLock.RUnlock()
+	var out []value
+	err := withRemoteSegments(sg.maintenanceLock.RLocker(), func() (err error) {
+		out, err = sg.getCollectionLocked(key)
+		return err
+	})
+	return out, err
+}
 
+func (sg *SegmentGroup) getCollectionLocked(key []byte) ([]value, error) {
 	var out []value
 
 	// start with first and do not exit
@@ -421,9 +632,15 @@ func (sg *SegmentGroup) getCollection(key []byte) ([]value, error) {
 }
 
 func (sg *SegmentGroup) getCollectionBySegments(key []byte) ([][]value, error) {
-	sg.maintenanceLock.RLock()
-	defer sg.maintenanceLock.RUnlock()
+	var out [][]value
+	err := withRemoteSegments(sg.maintenanceLock.RLocker(), func() (err error) {
+		out, err = sg.getCollectionBySegmentsLocked(key)
+		return err
+	})
+	return out, err
+}
 
+func (sg *SegmentGroup) getCollectionBySegmentsLocked(key []byte) ([][]value, error) {
 	out := make([][]value, len(sg.segments))
 
 	i := 0
@@ -446,9 +663,15 @@ func (sg *SegmentGroup) getCollectionBySegments(key []byte) ([][]value, error) {
 }
 
 func (sg *SegmentGroup) roaringSetGet(key []byte) (roaringset.BitmapLayers, error) {
-	sg.maintenanceLock.RLock()
-	defer sg.maintenanceLock.RUnlock()
+	var out roaringset.BitmapLayers
+	err := withRemoteSegments(sg.maintenanceLock.RLocker(), func() (err error) {
+		out, err = sg.roaringSetGetLocked(key)
+		return err
+	})
+	return out, err
+}
 
+func (sg *SegmentGroup) roaringSetGetLocked(key []byte) (roaringset.BitmapLayers, error) {
 	var out roaringset.BitmapLayers
 
 	// start with first and do not exit
@@ -484,6 +707,11 @@ func (sg *SegmentGroup) shutdown(ctx context.Context) error {
 	if err := sg.compactionCallbackCtrl.Unregister(ctx); err != nil {
 		return fmt.Errorf("long-running compaction in progress: %w", ctx.Err())
 	}
+	if sg.scrubCallbackCtrl != nil {
+		if err := sg.scrubCallbackCtrl.Unregister(ctx); err != nil {
+			return fmt.Errorf("long-running scrub in progress: %w", ctx.Err())
+		}
+	}
 
 	// Lock acquirement placed after compaction cycle stop request, due to occasional deadlock,
 	// because compaction logic used in cycle also requires maintenance lock.
@@ -525,6 +753,39 @@ func (sg *SegmentGroup) isReadyOnly() bool {
 	return sg.status == storagestate.StatusReadOnly
 }
 
+// openSegment opens the segment at path, which may be in the remote tier
+func (sg *SegmentGroup) openSegment(path string, existsLower existsOnLowerSegmentsFn,
+	overwriteDerived bool, readOnly bool,
+) (*segment, error) {
+	local, err := fileExists(path)
+	if err != nil {
+		return nil, err
+	}
+	if local {
+		return newSegment(path, sg.logger, sg.metrics, existsLower,
+			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, overwriteDerived, readOnly)
+	}
+	return newRemoteSegment(path, sg.tier, &sg.maintenanceLock, sg.logger, sg.metrics, existsLower,
+		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, overwriteDerived, readOnly)
+}
+
+// segmentFileName returns the name of the segment file for both segment
+// files and the markers of segments in the remote tier
+func segmentFileName(name string) (string, bool) {
+	name = strings.TrimSuffix(name, remoteMarkerExt)
+	return name, filepath.Ext(name) == ".db"
+}
+
+// segmentExists checks if the segment at path is present locally or in the
+// remote tier
+func segmentExists(path string) (bool, error) {
+	ok, err := fileExists(path)
+	if err != nil || ok {
+		return ok, err
+	}
+	return fileExists(remoteMarkerPath(path))
+}
+
 func fileExists(path string) (bool, error) {
 	_, err := os.Stat(path)
 	if err == nil {
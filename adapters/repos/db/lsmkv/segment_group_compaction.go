@@ -17,6 +17,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -117,8 +118,8 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 	// that the array contents stay stable over the duration of an entire
 	// compaction. We do however need to protect against a read-while-write (race
 	// condition) on the array. Thus any read from sg.segments need to protected
-	pair := sg.bestCompactionCandidatePair()
-	if pair == nil {
+	run, level := sg.bestCompactionCandidates()
+	if run == nil {
 		// nothing to do
 		return false, nil
 	}
@@ -143,34 +144,57 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 		}
 	}
 
-	leftSegment := sg.segmentAtPos(pair[0])
-	rightSegment := sg.segmentAtPos(pair[1])
+	segments := make([]*segment, len(run))
+	for i, pos := range run {
+		segments[i] = sg.segmentAtPos(pos)
+	}
+	leftSegment := segments[0]
+	rightSegment := segments[len(segments)-1]
 
-	if !sg.compactionFitsSizeLimit(leftSegment, rightSegment) {
+	if !sg.compactionFitsSizeLimit(segments...) {
 		// nothing to do this round, let's wait for the next round in the hopes
 		// that we'll find smaller (lower-level) segments that can still fit.
 		return false, nil
 	}
 
+	// remote segments are pinned, so that they are not evicted while they are
+	// compacted
+	for _, segment := range segments {
+		segment.pin()
+		defer segment.unpin()
+		if err := segment.load(); err != nil {
+			return false, err
+		}
+	}
+
+	// a corrupted segment must not be compacted, the new segment would carry
+	// the corrupted data with valid checksums
+	for _, segment := range segments {
+		if segment.verifyAll() != nil {
+			return false, nil
+		}
+	}
+
 	path := filepath.Join(sg.dir, "segment-"+segmentID(leftSegment.path)+"_"+segmentID(rightSegment.path)+".db.tmp")
 
-	f, err := os.Create(path)
+	// compressed segments are written uncompressed first, see
+	// compressSegmentFile
+	codec := byte(sg.codec.Load())
+	writePath := path
+	if codec != codecNone {
+		writePath += uncompressedSegmentExt
+	}
+
+	f, err := os.Create(writePath)
 	if err != nil {
 		return false, err
 	}
 
 	scratchSpacePath := rightSegment.path + "compaction.scratch.d"
 
-	// the assumption is that the first element is older, and/or a higher level
-	level := leftSegment.level
 	secondaryIndices := leftSegment.secondaryIndexCount
-
-	if level == rightSegment.level {
-		level = level + 1
-	}
-
 	strategy := leftSegment.strategy
-	cleanupTombstones := !sg.keepTombstones && pair[0] == 0
+	cleanupTombstones := !sg.keepTombstones && run[0] == 0
 
 	pathLabel := "n/a"
 	if sg.metrics != nil && !sg.metrics.groupClasses {
@@ -181,8 +205,12 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 	// TODO: call metrics just once with variable strategy label
 
 	case segmentindex.StrategyReplace:
-		c := newCompactorReplace(f, leftSegment.newCursor(),
-			rightSegment.newCursor(), level, secondaryIndices, scratchSpacePath, cleanupTombstones)
+		cursors := make([]*segmentCursorReplace, len(segments))
+		for i, segment := range segments {
+			cursors[i] = segment.newCursor()
+		}
+		c := newCompactorReplace(f, cursors, level, secondaryIndices, scratchSpacePath,
+			cleanupTombstones)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionReplace.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -193,8 +221,11 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 			return false, err
 		}
 	case segmentindex.StrategySetCollection:
-		c := newCompactorSetCollection(f, leftSegment.newCollectionCursor(),
-			rightSegment.newCollectionCursor(), level, secondaryIndices,
+		cursors := make([]*segmentCursorCollection, len(segments))
+		for i, segment := range segments {
+			cursors[i] = segment.newCollectionCursor()
+		}
+		c := newCompactorSetCollection(f, cursors, level, secondaryIndices,
 			scratchSpacePath, cleanupTombstones)
 
 		if sg.metrics != nil {
@@ -206,10 +237,12 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 			return false, err
 		}
 	case segmentindex.StrategyMapCollection:
-		c := newCompactorMapCollection(f,
-			leftSegment.newCollectionCursorReusable(),
-			rightSegment.newCollectionCursorReusable(),
-			level, secondaryIndices, scratchSpacePath, sg.mapRequiresSorting, cleanupTombstones)
+		cursors := make([]*segmentCursorCollectionReusable, len(segments))
+		for i, segment := range segments {
+			cursors[i] = segment.newCollectionCursorReusable()
+		}
+		c := newCompactorMapCollection(f, cursors, level, secondaryIndices,
+			scratchSpacePath, sg.mapRequiresSorting, cleanupTombstones)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionMap.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -220,11 +253,12 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 			return false, err
 		}
 	case segmentindex.StrategyRoaringSet:
-		leftCursor := leftSegment.newRoaringSetCursor()
-		rightCursor := rightSegment.newRoaringSetCursor()
-
-		c := roaringset.NewCompactor(f, leftCursor, rightCursor,
-			level, scratchSpacePath, cleanupTombstones)
+		cursors := make([]*roaringset.SegmentCursor, len(segments))
+		for i, segment := range segments {
+			cursors[i] = segment.newRoaringSetCursor()
+		}
+		c := roaringset.NewCompactor(f, cursors, level, scratchSpacePath,
+			cleanupTombstones)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionRoaringSet.With(prometheus.Labels{"path": pathLabel}).Set(1)
@@ -239,57 +273,76 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 		return false, errors.Errorf("unrecognized strategy %v", strategy)
 	}
 
-	if err := f.Sync(); err != nil {
-		return false, errors.Wrap(err, "fsync compacted segment file")
+	if err := sg.finishCompactedSegmentFile(f); err != nil {
+		return false, err
 	}
 
-	if err := f.Close(); err != nil {
-		return false, errors.Wrap(err, "close compacted segment file")
+	if codec != codecNone {
+		if err := compressSegmentFile(writePath, path, codec, sg.logger); err != nil {
+			return false, errors.Wrap(err, "compress compacted segment")
+		}
 	}
 
-	if err := sg.replaceCompactedSegments(pair[0], pair[1], path); err != nil {
+	if err := sg.replaceCompactedSegments(run, path); err != nil {
 		return false, errors.Wrap(err, "replace compacted segments")
 	}
 
 	return true, nil
 }
 
-func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
+// finishCompactedSegmentFile syncs and closes the file written by a
+// compaction and tracks its size
+func (sg *SegmentGroup) finishCompactedSegmentFile(f *os.File) error {
+	if err := f.Sync(); err != nil {
+		return errors.Wrap(err, "fsync compacted segment file")
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Wrap(err, "stat compacted segment file")
+	}
+	sg.metrics.CompactionWrittenBytes(sg.strategy, sg.dir, info.Size())
+
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "close compacted segment file")
+	}
+
+	return nil
+}
+
+// replaceCompactedSegments swaps the adjacent segments at the positions of run
+// for the segment written to newPathTmp, which takes the place and name of
+// the newest one. A run of a single segment is a rewrite of the segment.
+func (sg *SegmentGroup) replaceCompactedSegments(run []int,
 	newPathTmp string,
 ) error {
+	first, last := run[0], run[len(run)-1]
+
 	sg.maintenanceLock.RLock()
-	updatedCountNetAdditions := sg.segments[old1].countNetAdditions +
-		sg.segments[old2].countNetAdditions
+	oldSegments := append([]*segment(nil), sg.segments[first:last+1]...)
 	sg.maintenanceLock.RUnlock()
 
-	err := func() error {
-		sg.maintenanceLock.Lock()
-		defer sg.maintenanceLock.Unlock()
+	var updatedCountNetAdditions int
+	for _, segment := range oldSegments {
+		updatedCountNetAdditions += segment.countNetAdditions
+	}
 
-		leftSegment := sg.segments[old1]
-		rightSegment := sg.segments[old2]
-		newSegmentId := "segment-" + segmentID(leftSegment.path) + "_" + segmentID(rightSegment.path)
+	leftSegment := oldSegments[0]
+	rightSegment := oldSegments[len(oldSegments)-1]
+	leftID, rightID := segmentID(leftSegment.path), segmentID(rightSegment.path)
 
-		// delete any existing bloom tmp files in the form of segment-<seg1>_<seg2>*bloom.tmp
-		tmpFiles, err := filepath.Glob(filepath.Join(sg.dir, newSegmentId+"*bloom.tmp"))
-		if err != nil {
-			return errors.Wrap(err, "glob tmp files")
-		}
-
-		for _, tmpFile := range tmpFiles {
-			err = os.Remove(tmpFile)
-			if err != nil {
-				return errors.Wrap(err, "remove tmp file")
-			}
-		}
-		return nil
-	}()
+	// delete any existing bloom tmp files in the form of segment-<seg1>_<seg2>*bloom.tmp
+	tmpFiles, err := filepath.Glob(filepath.Join(sg.dir, "segment-"+leftID+"_"+rightID+"*bloom.tmp"))
 	if err != nil {
-		return err
+		return errors.Wrap(err, "glob tmp files")
 	}
 
-	leftSegment := sg.segments[old1]
-	rightSegment := sg.segments[old2]
+	for _, tmpFile := range tmpFiles {
+		err = os.Remove(tmpFile)
+		if err != nil {
+			return errors.Wrap(err, "remove tmp file")
+		}
+	}
 
 	// WIP: we could add a random suffix to the tmp file to avoid conflicts
 	precomputedFiles, err := preComputeSegmentMeta(newPathTmp,
@@ -302,20 +355,18 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 	sg.maintenanceLock.Lock()
 	defer sg.maintenanceLock.Unlock()
 
-	if err := leftSegment.close(); err != nil {
-		return errors.Wrap(err, "close disk segment")
-	}
-
-	if err := rightSegment.close(); err != nil {
-		return errors.Wrap(err, "close disk segment")
-	}
-
-	if err := leftSegment.drop(); err != nil {
-		return errors.Wrap(err, "drop disk segment")
+	for _, segment := range oldSegments {
+		if err := segment.close(); err != nil {
+			return errors.Wrap(err, "close disk segment")
+		}
 	}
 
-	if err := rightSegment.drop(); err != nil {
-		return errors.Wrap(err, "drop disk segment")
+	// the oldest segment is dropped first, an interrupted compaction is
+	// completed on startup once it is missing, see newSegmentGroup
+	for _, segment := range oldSegments {
+		if err := segment.drop(); err != nil {
+			return errors.Wrap(err, "drop disk segment")
+		}
 	}
 
 	err = fsync(sg.dir)
@@ -323,15 +374,16 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 		return fmt.Errorf("fsync segment directory %s: %w", sg.dir, err)
 	}
 
-	sg.segments[old1] = nil
-	sg.segments[old2] = nil
+	for pos := first; pos <= last; pos++ {
+		sg.segments[pos] = nil
+	}
 
 	var newPath string
 	// the old segments have been deleted, we can now safely remove the .tmp
 	// extension from the new segment itself and the pre-computed files which
-	// carried the name of the second old segment
+	// carried the name of the newest old segment
 	for i, path := range precomputedFiles {
-		updated, err := sg.stripTmpExtension(path, segmentID(leftSegment.path), segmentID(rightSegment.path))
+		updated, err := sg.stripTmpExtension(path, leftID, rightID)
 		if err != nil {
 			return errors.Wrap(err, "strip .tmp extension of new segment")
 		}
@@ -343,14 +395,17 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 	}
 
 	seg, err := newSegment(newPath, sg.logger, sg.metrics, nil,
-		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false)
+		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false, false)
 	if err != nil {
 		return errors.Wrap(err, "create new segment")
 	}
+	seg.onCorruption = sg.segmentCorrupted
+	// the segment was verified in full before it was swapped in
+	seg.scrubbedAt = time.Now()
 
-	sg.segments[old2] = seg
+	sg.segments[last] = seg
 
-	sg.segments = append(sg.segments[:old1], sg.segments[old1+1:]...)
+	sg.segments = append(sg.segments[:first], sg.segments[last:]...)
 
 	return nil
 }
@@ -382,6 +437,33 @@ func (sg *SegmentGroup) compactIfLevelsMatch(shouldAbort cyclemanager.ShouldAbor
 			Errorf("compaction failed")
 	}
 
+	if !compacted && err == nil {
+		compacted, err = sg.recompressOnce()
+		if err != nil {
+			sg.logger.WithField("action", "lsm_recompression").
+				WithField("path", sg.dir).
+				WithError(err).
+				Errorf("recompression failed")
+		}
+	}
+
+	if !compacted && err == nil {
+		compacted, err = sg.tierOnce()
+		if err != nil {
+			sg.logger.WithField("action", "lsm_remote_tier").
+				WithField("path", sg.dir).
+				WithError(err).
+				Errorf("moving segment to remote tier failed")
+		}
+	}
+
+	if err := sg.evictRemoteSegments(); err != nil {
+		sg.logger.WithField("action", "lsm_remote_tier").
+			WithField("path", sg.dir).
+			WithError(err).
+			Errorf("evicting remote segments failed")
+	}
+
 	if compacted {
 		return true
 	} else {
@@ -509,12 +591,15 @@ func (s *segmentLevelStats) report(metrics *Metrics,
 	}
 }
 
-func (sg *SegmentGroup) compactionFitsSizeLimit(left, right *segment) bool {
+func (sg *SegmentGroup) compactionFitsSizeLimit(segments ...*segment) bool {
 	if sg.maxSegmentSize == 0 {
 		// no limit is set, always return true
 		return true
 	}
 
-	totalSize := left.size + right.size
+	var totalSize int64
+	for _, segment := range segments {
+		totalSize += segment.size
+	}
 	return totalSize <= sg.maxSegmentSize
 }
@@ -99,3 +99,111 @@ func TestSegmenGroup_CompactionLargerThanMaxSize(t *testing.T) {
 	assert.False(t, ok, "segments are too large to run")
 	assert.Nil(t, err)
 }
+
+func TestTieredCompactionCandidates(t *testing.T) {
+	tests := []struct {
+		name          string
+		levels        []uint16
+		expectedRun   []int
+		expectedLevel uint16
+	}{
+		{
+			name:        "not enough segments",
+			levels:      []uint16{0, 0, 0},
+			expectedRun: nil,
+		},
+		{
+			name:          "oldest segments of a level",
+			levels:        []uint16{0, 0, 0, 0, 0},
+			expectedRun:   []int{0, 1, 2, 3},
+			expectedLevel: 1,
+		},
+		{
+			name:          "lowest level is picked",
+			levels:        []uint16{1, 1, 1, 1, 0, 0, 0, 0},
+			expectedRun:   []int{4, 5, 6, 7},
+			expectedLevel: 1,
+		},
+		{
+			name:          "only adjacent segments are merged",
+			levels:        []uint16{1, 1, 0, 0, 1, 1, 2, 2, 2, 2},
+			expectedRun:   []int{6, 7, 8, 9},
+			expectedLevel: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			run, level := tieredCompactionCandidates(test.levels, 4)
+			assert.Equal(t, test.expectedRun, run)
+			assert.Equal(t, test.expectedLevel, level)
+		})
+	}
+}
+
+func TestLeveledCompactionCandidates(t *testing.T) {
+	// level 0 holds up to 400 bytes, level 1 up to 1600 and level 2 up to 6400
+	tests := []struct {
+		name          string
+		levels        []uint16
+		sizes         []int64
+		expectedRun   []int
+		expectedLevel uint16
+	}{
+		{
+			name:        "not enough flushed segments",
+			levels:      []uint16{1, 0, 0, 0},
+			sizes:       []int64{1000, 100, 100, 100},
+			expectedRun: nil,
+		},
+		{
+			name:          "flushed segments are merged into level 1",
+			levels:        []uint16{2, 1, 0, 0, 0, 0},
+			sizes:         []int64{5000, 400, 100, 100, 100, 100},
+			expectedRun:   []int{1, 2, 3, 4, 5},
+			expectedLevel: 1,
+		},
+		{
+			name:          "flushed segments without level 1",
+			levels:        []uint16{2, 0, 0, 0, 0},
+			sizes:         []int64{5000, 100, 100, 100, 100},
+			expectedRun:   []int{1, 2, 3, 4},
+			expectedLevel: 1,
+		},
+		{
+			name:          "merged segments take the level of their size",
+			levels:        []uint16{1, 0, 0, 0, 0},
+			sizes:         []int64{1500, 100, 100, 100, 100},
+			expectedRun:   []int{0, 1, 2, 3, 4},
+			expectedLevel: 2,
+		},
+		{
+			name:          "full level is merged into the next one",
+			levels:        []uint16{2, 1, 0},
+			sizes:         []int64{5000, 2000, 100},
+			expectedRun:   []int{0, 1},
+			expectedLevel: 3,
+		},
+		{
+			name:          "segments of the same level are merged",
+			levels:        []uint16{2, 2, 1},
+			sizes:         []int64{3000, 2000, 1000},
+			expectedRun:   []int{0, 1},
+			expectedLevel: 2,
+		},
+		{
+			name:        "nothing to do",
+			levels:      []uint16{2, 1, 0},
+			sizes:       []int64{5000, 1000, 100},
+			expectedRun: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			run, level := leveledCompactionCandidates(test.levels, test.sizes, 4, 100)
+			assert.Equal(t, test.expectedRun, run)
+			assert.Equal(t, test.expectedLevel, level)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+func (sg *SegmentGroup) setCodec(codec byte) {
+	sg.codec.Store(uint32(codec))
+}
+
+// segmentToRecompress returns the position of the oldest segment which is not
+// compressed the way the bucket is configured, or -1 if there is none
+func (sg *SegmentGroup) segmentToRecompress() int {
+	codec := byte(sg.codec.Load())
+
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	for i, seg := range sg.segments {
+		// remote segments are recompressed when they are compacted
+		if seg.remote == nil && seg.codec() != codec {
+			return i
+		}
+	}
+	return -1
+}
+
+// recompressOnce rewrites a single segment which is not compressed with the
+// codec of the bucket. It only runs if there is nothing to compact, as a
+// compaction rewrites both segments anyway. Only the data is rewritten, the
+// indexes are copied.
+func (sg *SegmentGroup) recompressOnce() (bool, error) {
+	pos := sg.segmentToRecompress()
+	if pos < 0 {
+		return false, nil
+	}
+
+	if sg.allocChecker != nil {
+		// see compactOnce
+		if err := sg.allocChecker.CheckAlloc(100 * 1024 * 1024); err != nil {
+			return false, nil
+		}
+	}
+
+	segment := sg.segmentAtPos(pos)
+	// a corrupted segment must not be rewritten, see compactOnce
+	if segment.verifyAll() != nil {
+		return false, nil
+	}
+
+	// the segment is named like a compaction of the segment with itself, so
+	// that an interrupted rewrite is recovered like an interrupted compaction
+	id := segmentID(segment.path)
+	path := filepath.Join(sg.dir, "segment-"+id+"_"+id+".db.tmp")
+
+	f, err := os.Create(path)
+	if err != nil {
+		return false, err
+	}
+
+	if err := segment.writeWithCodec(f, byte(sg.codec.Load())); err != nil {
+		f.Close()
+		return false, errors.Wrapf(err, "recompress segment %s", segment.path)
+	}
+
+	if err := sg.finishCompactedSegmentFile(f); err != nil {
+		return false, err
+	}
+
+	if err := sg.replaceCompactedSegments([]int{pos}, path); err != nil {
+		return false, errors.Wrap(err, "replace recompressed segment")
+	}
+
+	return true, nil
+}
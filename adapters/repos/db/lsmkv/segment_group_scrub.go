@@ -0,0 +1,195 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storagestate"
+)
+
+// scrubBytesPerCycle limits the data verified in a single scrub cycle, so
+// that the scrubber does not compete with queries for disk bandwidth
+const scrubBytesPerCycle = 64 * 1024 * 1024
+
+// scrub verifies the checksums of segments which were not verified for the
+// scrub interval, oldest first. Large segments are verified over multiple
+// cycles.
+func (sg *SegmentGroup) scrub(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	now := time.Now()
+	due := make([]*segment, 0, len(sg.segments))
+	for _, seg := range sg.segments {
+		if seg.remote != nil && !seg.remote.loaded.Load() {
+			// remote segments are verified when they are fetched
+			continue
+		}
+		if now.Sub(seg.scrubbedAt) >= sg.scrubInterval {
+			due = append(due, seg)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].scrubbedAt.Before(due[j].scrubbedAt)
+	})
+
+	budget := scrubBytesPerCycle
+	scrubbed := false
+	for _, seg := range due {
+		if budget <= 0 || shouldAbort() {
+			break
+		}
+
+		n, done := seg.scrub(budget)
+		budget -= n
+		if n > 0 {
+			scrubbed = true
+			sg.metrics.ScrubbedBytes(sg.strategy, sg.dir, n)
+		}
+		if done {
+			seg.scrubbedAt = now
+			seg.scrubNextBlock = 0
+		}
+	}
+
+	return scrubbed
+}
+
+func (sg *SegmentGroup) segmentCorrupted(corruption CorruptSegment) {
+	sg.metrics.ChecksumFailure(sg.strategy, sg.dir)
+	if sg.onCorruption != nil {
+		sg.onCorruption(corruption)
+	}
+}
+
+// corruptSegments returns the segments which failed checksum verification
+func (sg *SegmentGroup) corruptSegments() []CorruptSegment {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	out := append([]CorruptSegment(nil), sg.quarantined...)
+	for _, seg := range sg.segments {
+		if corruption := seg.corruption.Load(); corruption != nil {
+			out = append(out, *corruption)
+		}
+	}
+	return out
+}
+
+// quarantineCorruptSegments removes corrupted segments from the group. The
+// files are kept with a .corrupt extension for inspection, they are ignored
+// when the bucket is loaded. The data of the segments is lost, it needs to be
+// restored from replicas. Segments which were quarantined when the group was
+// loaded are returned as well.
+func (sg *SegmentGroup) quarantineCorruptSegments() ([]string, error) {
+	if sg.readOnly {
+		return nil, fmt.Errorf("quarantine corrupt segments: %w", storagestate.ErrStatusReadOnly)
+	}
+
+	sg.maintenanceLock.Lock()
+	defer sg.maintenanceLock.Unlock()
+
+	var quarantined []string
+	for _, corruption := range sg.quarantined {
+		quarantined = append(quarantined, corruption.Path)
+	}
+	sg.quarantined = nil
+
+	remaining := sg.segments[:0]
+	for i, seg := range sg.segments {
+		if seg.corruption.Load() == nil {
+			remaining = append(remaining, seg)
+			continue
+		}
+
+		if err := sg.quarantineSegment(seg); err != nil {
+			// keep the segments which were not quarantined
+			remaining = append(remaining, sg.segments[i:]...)
+			sg.segments = remaining
+			return quarantined, err
+		}
+		quarantined = append(quarantined, seg.path)
+	}
+	sg.segments = remaining
+
+	if len(quarantined) > 0 {
+		if err := fsync(sg.dir); err != nil {
+			return quarantined, fmt.Errorf("fsync segment directory %s: %w", sg.dir, err)
+		}
+	}
+	return quarantined, nil
+}
+
+func (sg *SegmentGroup) quarantineSegment(seg *segment) error {
+	if err := seg.close(); err != nil {
+		return err
+	}
+	if seg.remote != nil {
+		// the marker is quarantined, the segment is left in the remote store
+		return sg.quarantineSegmentFiles(remoteMarkerPath(seg.path))
+	}
+	return sg.quarantineSegmentFiles(seg.path)
+}
+
+// quarantineUnreadableSegment quarantines a segment whose header or indexes
+// are corrupted, it is called while the group is loaded
+func (sg *SegmentGroup) quarantineUnreadableSegment(path string, err error) error {
+	if err := sg.quarantineSegmentFiles(path); err != nil {
+		return err
+	}
+	if err := fsync(sg.dir); err != nil {
+		return fmt.Errorf("fsync segment directory %s: %w", sg.dir, err)
+	}
+
+	corruption := CorruptSegment{
+		Bucket:     filepath.Base(sg.dir),
+		Path:       path,
+		Error:      err.Error(),
+		DetectedAt: time.Now(),
+	}
+	sg.quarantined = append(sg.quarantined, corruption)
+	sg.segmentCorrupted(corruption)
+	return nil
+}
+
+func (sg *SegmentGroup) quarantineSegmentFiles(path string) error {
+	if err := os.Rename(path, path+".corrupt"); err != nil {
+		return fmt.Errorf("quarantine segment: %w", err)
+	}
+
+	// the derived files are removed, so that a new segment with the same name
+	// cannot pick them up
+	segmentPath := strings.TrimSuffix(path, remoteMarkerExt)
+	extless := strings.TrimSuffix(segmentPath, filepath.Ext(segmentPath))
+	derived, err := filepath.Glob(extless + ".secondary.*.bloom")
+	if err != nil {
+		return fmt.Errorf("find secondary bloom filters: %w", err)
+	}
+	derived = append(derived, extless+".bloom", countNetPathFromSegmentPath(segmentPath))
+	for _, file := range derived {
+		if err := os.RemoveAll(file); err != nil {
+			return fmt.Errorf("drop derived segment file: %w", err)
+		}
+	}
+
+	sg.logger.WithField("action", "lsm_segment_quarantine").
+		WithField("path", path).
+		Warn("moved corrupted segment out of the bucket")
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/lsmkv"
 )
 
 // ErrInvalidChecksum indicates that the read file should not be trusted. For
@@ -77,6 +78,28 @@ func (s *segment) initCountNetAdditions(exists existsOnLowerSegmentsFn, overwrit
 		}
 	}
 
+	countNet, err := s.computeCountNetAdditions(exists)
+	s.countNetAdditions = countNet
+	if err != nil {
+		return err
+	}
+
+	if err := s.storeCountNetOnDisk(); err != nil {
+		return fmt.Errorf("store count net additions on disk: %w", err)
+	}
+
+	return nil
+}
+
+// computeCountNetAdditions counts the keys the segment adds with respect to
+// the segments below it
+func (s *segment) computeCountNetAdditions(exists existsOnLowerSegmentsFn) (int, error) {
+	s.pin()
+	defer s.unpin()
+	if err := s.load(); err != nil {
+		return 0, err
+	}
+
 	var lastErr error
 	countNet := 0
 	cb := func(key []byte, tombstone bool) {
@@ -94,25 +117,41 @@ func (s *segment) initCountNetAdditions(exists existsOnLowerSegmentsFn, overwrit
 		}
 	}
 
+	if s.blocks != nil {
+		// the extractor reads the data in place, compressed data is read
+		// with a cursor instead
+		if err := s.forEachKeyAndTombstone(cb); err != nil {
+			return 0, err
+		}
+		return countNet, lastErr
+	}
+
 	extr := newBufferedKeyAndTombstoneExtractor(s.contents, s.dataStartPos,
 		s.dataEndPos, 10e6, s.secondaryIndexCount, cb)
 
 	extr.do()
 
-	s.countNetAdditions = countNet
-
-	if lastErr != nil {
-		return lastErr
-	}
+	return countNet, lastErr
+}
 
-	if err := s.storeCountNetOnDisk(); err != nil {
-		return fmt.Errorf("store count net additions on disk: %w", err)
+func (s *segment) forEachKeyAndTombstone(cb keyAndTombstoneCallbackFn) error {
+	c := s.newCursor()
+	node, err := c.firstWithAllKeys()
+	for !errors.Is(err, lsmkv.NotFound) {
+		if err != nil && !errors.Is(err, lsmkv.Deleted) {
+			return err
+		}
+		cb(node.primaryKey, node.tombstone)
+		node, err = c.nextWithAllKeys()
 	}
-
 	return nil
 }
 
 func (s *segment) storeCountNetOnDisk() error {
+	if s.readOnly {
+		return nil
+	}
+
 	return storeCountNetOnDisk(s.countNetPath(), s.countNetAdditions)
 }
 
@@ -69,7 +69,18 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		return nil, fmt.Errorf("unsupported strategy in segment")
 	}
 
-	primaryIndex, err := header.PrimaryIndex(contents)
+	// the segment was just written, its checksums are verified as a whole
+	// to detect corruption introduced while writing it
+	checksums, err := verifySegmentIndexes(contents, header)
+	if err != nil {
+		return nil, fmt.Errorf("verify segment %s: %w", path, err)
+	}
+	if err := verifySegmentData(contents, checksums); err != nil {
+		return nil, fmt.Errorf("verify segment %s: %w", path, err)
+	}
+	indexContents := trimChecksums(contents, checksums)
+
+	primaryIndex, err := header.PrimaryIndex(indexContents)
 	if err != nil {
 		return nil, fmt.Errorf("extract primary index position: %w", err)
 	}
@@ -89,20 +100,24 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		version:               header.Version,
 		secondaryIndexCount:   header.SecondaryIndices,
 		segmentStartPos:       header.IndexStart,
-		segmentEndPos:         uint64(fileInfo.Size()),
+		segmentEndPos:         uint64(len(indexContents)),
 		strategy:              header.Strategy,
-		dataStartPos:          segmentindex.HeaderSize, // fixed value that's the same for all strategies
-		dataEndPos:            header.IndexStart,
+		dataStartPos:          segmentindex.HeaderSize,
+		dataEndPos:            header.IndexStart, // see initCompressedBlocks
 		index:                 primaryDiskIndex,
 		logger:                logger,
 		useBloomFilter:        useBloomFilter,
 		calcCountNetAdditions: calcCountNetAdditions,
 	}
 
+	if err := seg.initCompressedBlocks(); err != nil {
+		return nil, fmt.Errorf("segment %s: %w", path, err)
+	}
+
 	if seg.secondaryIndexCount > 0 {
 		seg.secondaryIndices = make([]diskIndex, seg.secondaryIndexCount)
 		for i := range seg.secondaryIndices {
-			secondary, err := header.SecondaryIndex(contents, uint16(i))
+			secondary, err := header.SecondaryIndex(indexContents, uint16(i))
 			if err != nil {
 				return nil, errors.Wrapf(err, "get position for secondary index at %d", i)
 			}
@@ -0,0 +1,579 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// remoteMarkerExt is appended to the path of a segment in the remote tier.
+// The marker replaces the segment file, the derived files stay local.
+const remoteMarkerExt = ".remote"
+
+func remoteMarkerPath(segmentPath string) string {
+	return segmentPath + remoteMarkerExt
+}
+
+// remoteMarker holds what is needed to plan compactions of a remote segment
+// without fetching it
+type remoteMarker struct {
+	Key    string `json:"key"`
+	Size   int64  `json:"size"`
+	Header []byte `json:"header"`
+}
+
+// remoteSegment is the state of a segment in the remote tier. Its contents
+// and indexes are only set while it is loaded into the cache of the tier.
+type remoteSegment struct {
+	tier *RemoteTier
+	key  string
+	// maintenance lock of the segment group, it is held to unload the segment
+	groupLock *sync.RWMutex
+
+	// serializes concurrent loads, they do not hold the maintenance lock
+	sync.Mutex
+	loaded   atomic.Bool
+	lastUsed atomic.Int64
+	// pins counts the readers which fetched the segment and are about to read
+	// it, a pinned segment is not evicted
+	pins atomic.Int32
+	// closed is set once the segment is no longer part of its group
+	closed atomic.Bool
+}
+
+// remoteNotLoadedError is returned by reads of a remote segment which is not
+// loaded, as segments are not fetched while holding the maintenance lock.
+// The segment is fetched and the read is repeated, see withRemoteSegments.
+type remoteNotLoadedError struct {
+	seg *segment
+}
+
+func (e *remoteNotLoadedError) Error() string {
+	return fmt.Sprintf("remote segment %s is not loaded", e.seg.path)
+}
+
+func newRemoteSegment(path string, tier *RemoteTier, groupLock *sync.RWMutex, logger logrus.FieldLogger,
+	metrics *Metrics, existsLower existsOnLowerSegmentsFn, mmapContents bool,
+	useBloomFilter bool, calcCountNetAdditions bool, overwriteDerived bool,
+	readOnly bool,
+) (*segment, error) {
+	if tier == nil {
+		return nil, fmt.Errorf("segment %s is in the remote tier, but no remote tier is configured", path)
+	}
+
+	marker, err := readRemoteMarker(remoteMarkerPath(path))
+	if err != nil {
+		return nil, err
+	}
+	if len(marker.Header) != segmentindex.HeaderSize {
+		return nil, fmt.Errorf("remote marker %s has no segment header", remoteMarkerPath(path))
+	}
+	header, err := segmentindex.ParseHeader(bytes.NewReader(marker.Header))
+	if err != nil {
+		return nil, fmt.Errorf("parse header: %w", err)
+	}
+
+	seg := &segment{
+		path:                  path,
+		level:                 header.Level,
+		version:               header.Version,
+		secondaryIndexCount:   header.SecondaryIndices,
+		segmentStartPos:       header.IndexStart,
+		strategy:              header.Strategy,
+		dataStartPos:          segmentindex.HeaderSize,
+		dataEndPos:            header.IndexStart,
+		size:                  marker.Size,
+		logger:                logger,
+		metrics:               metrics,
+		mmapContents:          mmapContents,
+		useBloomFilter:        useBloomFilter,
+		calcCountNetAdditions: calcCountNetAdditions,
+		readOnly:              readOnly,
+		remote:                &remoteSegment{tier: tier, key: marker.Key, groupLock: groupLock},
+	}
+
+	// derived files which are missing are computed from the fetched segment
+	if seg.useBloomFilter {
+		if err := seg.initBloomFilters(metrics, overwriteDerived); err != nil {
+			return nil, err
+		}
+	}
+	if seg.calcCountNetAdditions {
+		if err := seg.initCountNetAdditions(existsLower, overwriteDerived); err != nil {
+			return nil, err
+		}
+	}
+
+	return seg, nil
+}
+
+// requireLoaded is called by reads which hold the maintenance lock, it fails
+// with a remoteNotLoadedError for a remote segment which is not loaded
+func (s *segment) requireLoaded() error {
+	r := s.remote
+	if r == nil {
+		return nil
+	}
+
+	r.lastUsed.Store(time.Now().UnixNano())
+	if r.loaded.Load() {
+		return nil
+	}
+	return &remoteNotLoadedError{seg: s}
+}
+
+// pin keeps a remote segment from being evicted once it is loaded
+func (s *segment) pin() {
+	if s.remote != nil {
+		s.remote.pins.Add(1)
+	}
+}
+
+func (s *segment) unpin() {
+	if s.remote != nil {
+		s.remote.pins.Add(-1)
+	}
+}
+
+// load fetches a remote segment into the cache of the tier, if it is not
+// loaded yet. It is a no-op for local segments. It must not be called while
+// holding the maintenance lock of the segment group, and the segment must be
+// pinned for as long as it is read without holding the lock.
+func (s *segment) load() error {
+	r := s.remote
+	if r == nil {
+		return nil
+	}
+
+	r.lastUsed.Store(time.Now().UnixNano())
+	if r.loaded.Load() {
+		return nil
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if r.loaded.Load() {
+		return nil
+	}
+	if r.closed.Load() {
+		return fmt.Errorf("remote segment %s was closed", s.path)
+	}
+
+	if err := r.tier.cache.evict(s.size); err != nil {
+		return err
+	}
+
+	path := r.tier.cache.path(r.key)
+	if err := r.tier.download(context.Background(), r.key, path); err != nil {
+		os.Remove(path)
+		return fmt.Errorf("fetch remote segment %s: %w", s.path, err)
+	}
+	if err := s.mapFile(path); err != nil {
+		os.Remove(path)
+		if errors.Is(err, segmentindex.ErrChecksumMismatch) {
+			s.markCorrupt(err)
+		}
+		return fmt.Errorf("load remote segment %s: %w", s.path, err)
+	}
+
+	r.tier.cache.add(s)
+	r.loaded.Store(true)
+
+	// the segment may have been closed while it was fetched, either the close
+	// or this load unloads it
+	if r.closed.Load() {
+		if err := s.unload(); err != nil {
+			return err
+		}
+		return fmt.Errorf("remote segment %s was closed", s.path)
+	}
+	return nil
+}
+
+// unload removes a remote segment from the cache, it must only be called
+// while holding the maintenance lock of the segment group, or for a segment
+// which was closed
+func (s *segment) unload() error {
+	r := s.remote
+	if r == nil || !r.loaded.CompareAndSwap(true, false) {
+		return nil
+	}
+
+	if err := s.closeFile(); err != nil {
+		return err
+	}
+	s.contents = nil
+	s.contentFile = nil
+	s.index = nil
+	s.secondaryIndices = nil
+
+	if err := os.Remove(r.tier.cache.path(r.key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove cached segment: %w", err)
+	}
+	r.tier.cache.remove(s)
+	return nil
+}
+
+// withRemoteSegments runs read while holding lock. If read needs a remote
+// segment which is not loaded, the segment is fetched without holding the
+// lock and read is repeated. Fetched segments stay pinned until read
+// succeeded.
+func withRemoteSegments(lock sync.Locker, read func() error) error {
+	var pinned []*segment
+	defer func() {
+		for _, seg := range pinned {
+			seg.unpin()
+		}
+	}()
+
+	for {
+		lock.Lock()
+		err := read()
+		lock.Unlock()
+
+		var notLoaded *remoteNotLoadedError
+		if !errors.As(err, &notLoaded) {
+			return err
+		}
+
+		seg := notLoaded.seg
+		seg.pin()
+		pinned = append(pinned, seg)
+		// a segment which was closed in the meantime was replaced by a
+		// compaction, the read is repeated on the new segments
+		if err := seg.load(); err != nil && !seg.remote.closed.Load() {
+			return err
+		}
+	}
+}
+
+// noLock is used with withRemoteSegments by callers which own the segment
+// group, while it is loaded
+type noLock struct{}
+
+func (noLock) Lock()   {}
+func (noLock) Unlock() {}
+
+// fetchingExistsOnLower fetches the remote segments exists needs, for
+// callers which do not hold the maintenance lock
+func fetchingExistsOnLower(exists existsOnLowerSegmentsFn) existsOnLowerSegmentsFn {
+	return func(key []byte) (bool, error) {
+		var ok bool
+		err := withRemoteSegments(noLock{}, func() (err error) {
+			ok, err = exists(key)
+			return err
+		})
+		return ok, err
+	}
+}
+
+// pinRemoteSegments pins and fetches the remote segments of the group for a
+// cursor, which reads all segments while holding the maintenance lock. It
+// fails if they do not fit into the cache of the tier.
+func (sg *SegmentGroup) pinRemoteSegments() (unpin func(), err error) {
+	if sg.tier == nil {
+		return func() {}, nil
+	}
+
+	sg.maintenanceLock.RLock()
+	var remote []*segment
+	var size int64
+	for _, seg := range sg.segments {
+		if seg.remote != nil {
+			seg.pin()
+			remote = append(remote, seg)
+			size += seg.size
+		}
+	}
+	sg.maintenanceLock.RUnlock()
+
+	unpin = func() {
+		for _, seg := range remote {
+			seg.unpin()
+		}
+	}
+
+	if size > sg.tier.cache.maxSize {
+		unpin()
+		return nil, fmt.Errorf("remote segments of %s need %d bytes, the remote tier cache holds %d",
+			sg.dir, size, sg.tier.cache.maxSize)
+	}
+
+	for _, seg := range remote {
+		if err := seg.load(); err != nil && !seg.remote.closed.Load() {
+			unpin()
+			return nil, err
+		}
+	}
+	return unpin, nil
+}
+
+// newDiskCursors takes the flush lock of the bucket and creates the cursors
+// of its disk segments with newCursors, the returned unlock releases both. The
+// remote segments are fetched before, without holding either lock.
+func newDiskCursors[T any](b *Bucket, newCursors func() ([]T, func(), error)) ([]T, func(), error) {
+	for {
+		unpin, err := b.disk.pinRemoteSegments()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		b.flushLock.RLock()
+		cursors, unlockSegmentGroup, err := newCursors()
+		if err == nil {
+			return cursors, func() {
+				unlockSegmentGroup()
+				b.flushLock.RUnlock()
+				unpin()
+			}, nil
+		}
+		b.flushLock.RUnlock()
+		unpin()
+
+		// a segment which was moved to the remote tier in the meantime is
+		// fetched in the next round
+		var notLoaded *remoteNotLoadedError
+		if !errors.As(err, &notLoaded) {
+			return nil, nil, err
+		}
+	}
+}
+
+// requireAllLoaded is called by cursors which hold the maintenance lock,
+// see pinRemoteSegments
+func (sg *SegmentGroup) requireAllLoaded() error {
+	for _, seg := range sg.segments {
+		if err := seg.requireLoaded(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropRemote removes the marker of a remote segment and the segment from
+// the remote store in the background
+func (s *segment) dropRemote() error {
+	if err := os.Remove(remoteMarkerPath(s.path)); err != nil {
+		return fmt.Errorf("drop remote marker: %w", err)
+	}
+
+	tier, key := s.remote.tier, s.remote.key
+	enterrors.GoWrapper(func() {
+		tier.delete(context.Background(), key)
+	}, s.logger)
+	return nil
+}
+
+// tierOnce moves the oldest local segment the tier policy applies to to the
+// remote store
+func (sg *SegmentGroup) tierOnce() (bool, error) {
+	if sg.tier == nil || sg.readOnly {
+		return false, nil
+	}
+
+	now := time.Now()
+	var seg *segment
+	sg.maintenanceLock.RLock()
+	for _, candidate := range sg.segments {
+		if candidate.remote == nil && candidate.corruption.Load() == nil &&
+			sg.tier.config.Policy.applies(candidate, now) {
+			seg = candidate
+			break
+		}
+	}
+	sg.maintenanceLock.RUnlock()
+
+	if seg == nil {
+		return false, nil
+	}
+
+	// a corrupted segment must not be moved, the remote copy would be the only
+	// one left, see compactOnce
+	if seg.verifyAll() != nil {
+		return false, nil
+	}
+
+	key, err := sg.tier.key(seg.path)
+	if err != nil {
+		return false, err
+	}
+	// segments are named after the newest segment they were compacted from,
+	// the key must not be reused by a segment which replaces this one
+	key += "." + strconv.FormatInt(now.UnixNano(), 10)
+
+	if err := sg.tier.upload(context.Background(), key, seg.path); err != nil {
+		return false, fmt.Errorf("upload segment %s: %w", seg.path, err)
+	}
+
+	marker := remoteMarker{
+		Key:    key,
+		Size:   seg.size,
+		Header: append([]byte(nil), seg.contents[:segmentindex.HeaderSize]...),
+	}
+	// the marker is written before the segment is removed, a segment which
+	// is present next to its marker is kept on startup, see newSegmentGroup
+	if err := writeRemoteMarker(remoteMarkerPath(seg.path), marker); err != nil {
+		return false, err
+	}
+
+	sg.maintenanceLock.Lock()
+	defer sg.maintenanceLock.Unlock()
+
+	present := false
+	for _, other := range sg.segments {
+		if other == seg {
+			present = true
+			break
+		}
+	}
+	if !present {
+		// the segment was quarantined in the meantime
+		return false, os.Remove(remoteMarkerPath(seg.path))
+	}
+
+	if err := seg.closeFile(); err != nil {
+		return false, err
+	}
+	if err := os.Remove(seg.path); err != nil {
+		return false, fmt.Errorf("remove tiered segment: %w", err)
+	}
+	if err := fsync(sg.dir); err != nil {
+		return false, fmt.Errorf("fsync segment directory %s: %w", sg.dir, err)
+	}
+
+	seg.contents = nil
+	seg.contentFile = nil
+	seg.index = nil
+	seg.secondaryIndices = nil
+	seg.remote = &remoteSegment{tier: sg.tier, key: key, groupLock: &sg.maintenanceLock}
+
+	sg.logger.WithField("action", "lsm_remote_tier").
+		WithField("path", seg.path).
+		WithField("key", key).
+		Debug("moved segment to remote tier")
+
+	return true, nil
+}
+
+func readRemoteMarker(path string) (remoteMarker, error) {
+	var marker remoteMarker
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return marker, fmt.Errorf("read remote marker: %w", err)
+	}
+	if err := json.Unmarshal(data, &marker); err != nil {
+		return marker, fmt.Errorf("parse remote marker %s: %w", path, err)
+	}
+	return marker, nil
+}
+
+func writeRemoteMarker(path string, marker remoteMarker) error {
+	data, err := json.Marshal(marker)
+	if err != nil {
+		return fmt.Errorf("marshal remote marker: %w", err)
+	}
+
+	tmpPath := path + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("create remote marker: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("write remote marker: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("fsync remote marker: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close remote marker: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("rename remote marker: %w", err)
+	}
+	return nil
+}
+
+// stageRemoteSegments downloads the remote segments of the group to the
+// paths of their segment files, so that a backup contains their data. The
+// copies are removed with unstageRemoteSegments, compactions must be paused
+// in between. A copy left behind by a crash is loaded instead of the remote
+// segment on startup.
+func (sg *SegmentGroup) stageRemoteSegments(ctx context.Context) error {
+	if sg.tier == nil {
+		return nil
+	}
+
+	sg.maintenanceLock.RLock()
+	var remote []*segment
+	for _, seg := range sg.segments {
+		if seg.remote != nil {
+			remote = append(remote, seg)
+		}
+	}
+	sg.maintenanceLock.RUnlock()
+
+	sg.stagedLock.Lock()
+	defer sg.stagedLock.Unlock()
+
+	for _, seg := range remote {
+		tmpPath := seg.path + ".tmp"
+		if err := sg.tier.download(ctx, seg.remote.key, tmpPath); err != nil {
+			os.Remove(tmpPath)
+			return fmt.Errorf("download remote segment %s: %w", seg.path, err)
+		}
+		if err := os.Rename(tmpPath, seg.path); err != nil {
+			return fmt.Errorf("stage remote segment %s: %w", seg.path, err)
+		}
+		sg.staged = append(sg.staged, seg.path)
+	}
+	return nil
+}
+
+// unstageRemoteSegments removes the copies made by stageRemoteSegments
+func (sg *SegmentGroup) unstageRemoteSegments() error {
+	sg.stagedLock.Lock()
+	defer sg.stagedLock.Unlock()
+
+	for len(sg.staged) > 0 {
+		path := sg.staged[0]
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("remove staged remote segment %s: %w", path, err)
+		}
+		sg.staged = sg.staged[1:]
+	}
+	return nil
+}
+
+// evictRemoteSegments unloads the segments which have not been used for the
+// longest time, if the cache of the tier exceeds its size
+func (sg *SegmentGroup) evictRemoteSegments() error {
+	if sg.tier == nil {
+		return nil
+	}
+	return sg.tier.cache.evict(0)
+}
@@ -33,6 +33,10 @@ func (s *segment) get(key []byte) ([]byte, error) {
 		return nil, lsmkv.NotFound
 	}
 
+	if err := s.requireLoaded(); err != nil {
+		return nil, err
+	}
+
 	node, err := s.index.Get(key)
 	if err != nil {
 		if errors.Is(err, lsmkv.NotFound) {
@@ -75,7 +79,7 @@ func (s *segment) getBySecondaryIntoMemory(pos int, key []byte, buffer []byte) (
 		return nil, fmt.Errorf("get only possible for strategy %q", StrategyReplace), nil
 	}
 
-	if pos > len(s.secondaryIndices) || s.secondaryIndices[pos] == nil {
+	if pos >= int(s.secondaryIndexCount) {
 		return nil, fmt.Errorf("no secondary index at pos %d", pos), nil
 	}
 
@@ -83,6 +87,10 @@ func (s *segment) getBySecondaryIntoMemory(pos int, key []byte, buffer []byte) (
 		return nil, lsmkv.NotFound, nil
 	}
 
+	if err := s.requireLoaded(); err != nil {
+		return nil, err, nil
+	}
+
 	node, err := s.secondaryIndices[pos].Get(key)
 	if err != nil {
 		return nil, err, nil
@@ -30,6 +30,10 @@ func (s *segment) roaringSetGet(key []byte) (roaringset.BitmapLayer, error) {
 		return out, lsmkv.NotFound
 	}
 
+	if err := s.requireLoaded(); err != nil {
+		return out, err
+	}
+
 	node, err := s.index.Get(key)
 	if err != nil {
 		return out, err
@@ -50,7 +54,12 @@ func (s *segment) roaringSetGet(key []byte) (roaringset.BitmapLayer, error) {
 
 func (s *segment) segmentNodeFromBuffer(offset nodeOffset) (*roaringset.SegmentNode, error) {
 	var contents []byte
-	if s.mmapContents {
+	if s.blocks != nil {
+		contents = make([]byte, offset.end-offset.start)
+		if err := s.readCompressed(contents, offset.start); err != nil {
+			return nil, err
+		}
+	} else if s.mmapContents {
 		contents = s.contents[offset.start:offset.end]
 	} else {
 		contents = make([]byte, offset.end-offset.start)
@@ -0,0 +1,228 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package segmentindex
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+const (
+	// SegmentV0 segments consist of the header, the data and the indexes
+	SegmentV0 uint16 = 0
+	// SegmentV1 segments are followed by a table of CRC32C checksums, one for
+	// every ChecksumBlockSize bytes of data and indexes, see ChecksumWriter
+	SegmentV1 uint16 = 1
+	// SegmentV2 segments are SegmentV1 segments whose data is compressed in
+	// blocks, which are followed by a table of their positions. The header
+	// points to the indexes in the file, the nodes in the indexes point into
+	// the data as it was before compression.
+	SegmentV2 uint16 = 2
+
+	CurrentSegmentVersion = SegmentV1
+	// LatestSegmentVersion is the highest version which can be read
+	LatestSegmentVersion = SegmentV2
+
+	ChecksumBlockSize = 64 * 1024
+
+	// checksumFooterSize is composed of 4 bytes for the header checksum, 4
+	// bytes for the block size, 8 bytes for the start of the checksum table
+	// and 4 bytes for the checksum of the table and footer itself
+	checksumFooterSize = 20
+)
+
+// ErrChecksumMismatch indicates that a segment was corrupted on disk
+var ErrChecksumMismatch = errors.New("segment checksum mismatch")
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// ChecksumWriter calculates the checksums of a segment while it is written.
+// The header is excluded from the block checksums, as it is typically
+// overwritten once the segment is complete, it has a checksum of its own.
+type ChecksumWriter struct {
+	w       io.Writer
+	written uint64
+	header  []byte
+	block   uint32
+	filled  int
+	blocks  []uint32
+}
+
+func NewChecksumWriter(w io.Writer) *ChecksumWriter {
+	return &ChecksumWriter{w: w, header: make([]byte, 0, HeaderSize)}
+}
+
+func (c *ChecksumWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.update(p[:n])
+	return n, err
+}
+
+func (c *ChecksumWriter) update(p []byte) {
+	if missing := HeaderSize - len(c.header); missing > 0 {
+		if missing > len(p) {
+			missing = len(p)
+		}
+		c.header = append(c.header, p[:missing]...)
+		c.written += uint64(missing)
+		p = p[missing:]
+	}
+
+	for len(p) > 0 {
+		chunk := ChecksumBlockSize - c.filled
+		if chunk > len(p) {
+			chunk = len(p)
+		}
+		c.block = crc32.Update(c.block, crc32c, p[:chunk])
+		c.filled += chunk
+		c.written += uint64(chunk)
+		p = p[chunk:]
+
+		if c.filled == ChecksumBlockSize {
+			c.blocks = append(c.blocks, c.block)
+			c.block = 0
+			c.filled = 0
+		}
+	}
+}
+
+// WriteChecksums appends the checksum table to the segment, it must be called
+// after the indexes were written. If h is nil, the checksum of the header
+// which was written through the ChecksumWriter is stored.
+func (c *ChecksumWriter) WriteChecksums(h *Header) (int64, error) {
+	header := c.header
+	if h != nil {
+		buf := bytes.NewBuffer(make([]byte, 0, HeaderSize))
+		if _, err := h.WriteTo(buf); err != nil {
+			return 0, err
+		}
+		header = buf.Bytes()
+	}
+	if len(header) != HeaderSize {
+		return 0, fmt.Errorf("checksum header: expected %d bytes, got %d", HeaderSize, len(header))
+	}
+
+	blocks := c.blocks
+	if c.filled > 0 {
+		blocks = append(blocks, c.block)
+	}
+
+	buf := make([]byte, 4*len(blocks)+checksumFooterSize)
+	for i, sum := range blocks {
+		binary.LittleEndian.PutUint32(buf[4*i:], sum)
+	}
+	footer := buf[4*len(blocks):]
+	binary.LittleEndian.PutUint32(footer[0:4], crc32.Checksum(header, crc32c))
+	binary.LittleEndian.PutUint32(footer[4:8], ChecksumBlockSize)
+	binary.LittleEndian.PutUint64(footer[8:16], c.written)
+	binary.LittleEndian.PutUint32(footer[16:20], crc32.Checksum(buf[:len(buf)-4], crc32c))
+
+	n, err := c.w.Write(buf)
+	return int64(n), err
+}
+
+// Checksums of a SegmentV1 segment
+type Checksums struct {
+	// End is the position of the checksum table, i.e. the end of the indexes
+	End       uint64
+	blockSize uint64
+	header    uint32
+	blocks    []uint32
+}
+
+// ParseChecksums reads the checksum table from the end of the segment
+func ParseChecksums(contents []byte) (*Checksums, error) {
+	if len(contents) < HeaderSize+checksumFooterSize {
+		return nil, fmt.Errorf("%w: segment too small for checksums", ErrChecksumMismatch)
+	}
+
+	footer := contents[len(contents)-checksumFooterSize:]
+	c := &Checksums{
+		header:    binary.LittleEndian.Uint32(footer[0:4]),
+		blockSize: uint64(binary.LittleEndian.Uint32(footer[4:8])),
+		End:       binary.LittleEndian.Uint64(footer[8:16]),
+	}
+
+	if c.blockSize == 0 || c.End < HeaderSize ||
+		c.End > uint64(len(contents)-checksumFooterSize) {
+		return nil, fmt.Errorf("%w: invalid checksum footer", ErrChecksumMismatch)
+	}
+
+	tableSize := uint64(len(contents)-checksumFooterSize) - c.End
+	if tableSize != 4*c.blockCount() {
+		return nil, fmt.Errorf("%w: checksum table has %d bytes, expected %d",
+			ErrChecksumMismatch, tableSize, 4*c.blockCount())
+	}
+
+	table := contents[c.End : len(contents)-4]
+	if crc32.Checksum(table, crc32c) != binary.LittleEndian.Uint32(footer[16:20]) {
+		return nil, fmt.Errorf("%w: checksum table", ErrChecksumMismatch)
+	}
+
+	c.blocks = make([]uint32, c.blockCount())
+	for i := range c.blocks {
+		c.blocks[i] = binary.LittleEndian.Uint32(table[4*i:])
+	}
+
+	return c, nil
+}
+
+func (c *Checksums) blockCount() uint64 {
+	return (c.End - HeaderSize + c.blockSize - 1) / c.blockSize
+}
+
+// Blocks returns the number of checksummed blocks
+func (c *Checksums) Blocks() int {
+	return len(c.blocks)
+}
+
+// BlockRange returns the first and last block overlapping with the range
+// [start, end) of the segment, end 0 denotes the end of the segment
+func (c *Checksums) BlockRange(start, end uint64) (first, last int) {
+	if end == 0 || end > c.End {
+		end = c.End
+	}
+	if start < HeaderSize {
+		start = HeaderSize
+	}
+	if start >= end {
+		return 0, -1
+	}
+
+	return int((start - HeaderSize) / c.blockSize), int((end - 1 - HeaderSize) / c.blockSize)
+}
+
+func (c *Checksums) VerifyHeader(contents []byte) error {
+	if crc32.Checksum(contents[:HeaderSize], crc32c) != c.header {
+		return fmt.Errorf("%w: header", ErrChecksumMismatch)
+	}
+	return nil
+}
+
+// VerifyBlock verifies the block with the given number, contents must hold
+// the whole segment
+func (c *Checksums) VerifyBlock(contents []byte, block int) error {
+	start := HeaderSize + uint64(block)*c.blockSize
+	end := start + c.blockSize
+	if end > c.End {
+		end = c.End
+	}
+
+	if crc32.Checksum(contents[start:end], crc32c) != c.blocks[block] {
+		return fmt.Errorf("%w: block %d at offset %d", ErrChecksumMismatch, block, start)
+	}
+	return nil
+}
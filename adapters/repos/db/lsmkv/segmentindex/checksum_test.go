@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package segmentindex
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeChecksummedSegment(t *testing.T, dataSize int) ([]byte, *Header) {
+	buf := &bytes.Buffer{}
+	w := NewChecksumWriter(buf)
+
+	// a dummy header is overwritten once the segment is complete, just like
+	// the compactors do
+	_, err := w.Write(make([]byte, HeaderSize))
+	require.Nil(t, err)
+
+	data := make([]byte, dataSize)
+	rand.New(rand.NewSource(int64(dataSize))).Read(data)
+	_, err = w.Write(data)
+	require.Nil(t, err)
+
+	h := &Header{
+		Version:    CurrentSegmentVersion,
+		Strategy:   StrategyReplace,
+		IndexStart: uint64(HeaderSize + dataSize),
+	}
+	_, err = w.WriteChecksums(h)
+	require.Nil(t, err)
+
+	contents := buf.Bytes()
+	headerBuf := &bytes.Buffer{}
+	_, err = h.WriteTo(headerBuf)
+	require.Nil(t, err)
+	copy(contents, headerBuf.Bytes())
+
+	return contents, h
+}
+
+func TestChecksums(t *testing.T) {
+	sizes := []int{1, ChecksumBlockSize - 1, ChecksumBlockSize, 3*ChecksumBlockSize + 17}
+
+	for _, size := range sizes {
+		contents, _ := writeChecksummedSegment(t, size)
+
+		checksums, err := ParseChecksums(contents)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(HeaderSize+size), checksums.End)
+		assert.Equal(t, (size+ChecksumBlockSize-1)/ChecksumBlockSize, checksums.Blocks())
+		assert.Nil(t, checksums.VerifyHeader(contents))
+		for i := 0; i < checksums.Blocks(); i++ {
+			assert.Nil(t, checksums.VerifyBlock(contents, i))
+		}
+	}
+}
+
+func TestChecksums_BlockRange(t *testing.T) {
+	contents, _ := writeChecksummedSegment(t, 3*ChecksumBlockSize+17)
+	checksums, err := ParseChecksums(contents)
+	require.Nil(t, err)
+
+	first, last := checksums.BlockRange(0, 0)
+	assert.Equal(t, 0, first)
+	assert.Equal(t, 3, last)
+
+	first, last = checksums.BlockRange(HeaderSize+ChecksumBlockSize, HeaderSize+ChecksumBlockSize+1)
+	assert.Equal(t, 1, first)
+	assert.Equal(t, 1, last)
+
+	first, last = checksums.BlockRange(HeaderSize+ChecksumBlockSize-1, HeaderSize+ChecksumBlockSize+1)
+	assert.Equal(t, 0, first)
+	assert.Equal(t, 1, last)
+
+	_, last = checksums.BlockRange(checksums.End, 0)
+	assert.Equal(t, -1, last)
+}
+
+func TestChecksums_DetectsCorruption(t *testing.T) {
+	t.Run("data", func(t *testing.T) {
+		contents, _ := writeChecksummedSegment(t, 2*ChecksumBlockSize+5)
+		contents[HeaderSize+ChecksumBlockSize+3] ^= 0x01
+
+		checksums, err := ParseChecksums(contents)
+		require.Nil(t, err)
+		assert.Nil(t, checksums.VerifyBlock(contents, 0))
+		assert.ErrorIs(t, checksums.VerifyBlock(contents, 1), ErrChecksumMismatch)
+		assert.Nil(t, checksums.VerifyBlock(contents, 2))
+	})
+
+	t.Run("header", func(t *testing.T) {
+		contents, _ := writeChecksummedSegment(t, 100)
+		contents[2] ^= 0x01
+
+		checksums, err := ParseChecksums(contents)
+		require.Nil(t, err)
+		assert.ErrorIs(t, checksums.VerifyHeader(contents), ErrChecksumMismatch)
+	})
+
+	t.Run("checksum table", func(t *testing.T) {
+		contents, _ := writeChecksummedSegment(t, 100)
+		contents[len(contents)-checksumFooterSize-1] ^= 0x01
+
+		_, err := ParseChecksums(contents)
+		assert.ErrorIs(t, err, ErrChecksumMismatch)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		contents, _ := writeChecksummedSegment(t, 100)
+
+		_, err := ParseChecksums(contents[:len(contents)-7])
+		assert.ErrorIs(t, err, ErrChecksumMismatch)
+	})
+}
@@ -121,7 +121,7 @@ func ParseHeader(r io.Reader) (*Header, error) {
 		return nil, err
 	}
 
-	if out.Version != 0 {
+	if out.Version > LatestSegmentVersion {
 		return nil, fmt.Errorf("unsupported version %d", out.Version)
 	}
 
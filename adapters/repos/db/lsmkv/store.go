@@ -18,6 +18,7 @@ import (
 	"path"
 	"path/filepath"
 	"runtime/debug"
+	"sort"
 	"strings"
 	"sync"
 
@@ -50,6 +51,9 @@ type Store struct {
 	// Prevent concurrent manipulations to the same Bucket, specially if there is
 	// action on the bucket in the meantime.
 	bucketsLocks *wsync.KeyLocker
+
+	// see NewReadOnly
+	readOnly bool
 }
 
 // New initializes a new [Store] based on the root dir. If state is present on
@@ -72,6 +76,39 @@ func New(dir, rootDir string, logger logrus.FieldLogger, metrics *Metrics,
 	return s, s.init()
 }
 
+// NewReadOnly opens the [Store] in dir and all buckets in it without
+// modifying any files, see [WithReadOnly]. The buckets are neither flushed
+// nor compacted.
+func NewReadOnly(ctx context.Context, dir string, logger logrus.FieldLogger,
+	metrics *Metrics,
+) (*Store, error) {
+	s := &Store{
+		dir:           dir,
+		rootDir:       dir,
+		bucketsByName: map[string]*Bucket{},
+		bucketsLocks:  wsync.NewKeyLocker(),
+		bcreator:      NewBucketCreator(),
+		logger:        logger,
+		metrics:       metrics,
+		readOnly:      true,
+	}
+	s.initCycleCallbacks(cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+
+	list, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range list {
+		if !entry.IsDir() {
+			continue
+		}
+		if err := s.CreateOrLoadBucket(ctx, entry.Name()); err != nil {
+			return nil, fmt.Errorf("load bucket %s: %w", entry.Name(), err)
+		}
+	}
+	return s, nil
+}
+
 func (s *Store) Bucket(name string) *Bucket {
 	s.bucketAccessLock.RLock()
 	defer s.bucketAccessLock.RUnlock()
@@ -79,6 +116,25 @@ func (s *Store) Bucket(name string) *Bucket {
 	return s.bucketsByName[name]
 }
 
+// CorruptSegments returns the segments of all buckets which failed checksum
+// verification, sorted by bucket
+func (s *Store) CorruptSegments() []CorruptSegment {
+	s.bucketAccessLock.RLock()
+	defer s.bucketAccessLock.RUnlock()
+
+	var out []CorruptSegment
+	for _, b := range s.bucketsByName {
+		out = append(out, b.CorruptSegments()...)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Bucket != out[j].Bucket {
+			return out[i].Bucket < out[j].Bucket
+		}
+		return out[i].Path < out[j].Path
+	})
+	return out
+}
+
 func (s *Store) UpdateBucketsStatus(targetStatus storagestate.Status) {
 	// UpdateBucketsStatus is a write operation on the bucket itself, but from
 	// the perspective of our bucket access map this is a read-only operation,
@@ -156,6 +212,10 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 		return nil
 	}
 
+	if s.readOnly {
+		opts = append(opts, WithReadOnly(true))
+	}
+
 	// bucket can be concurrently loaded with another buckets but
 	// the same bucket will be loaded only once
 	b, err := s.bcreator.NewBucket(ctx, s.bucketDir(bucketName), s.rootDir, s.logger, s.metrics,
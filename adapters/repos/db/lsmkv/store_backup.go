@@ -50,6 +50,14 @@ func (s *Store) PauseCompaction(ctx context.Context) error {
 // ResumeCompaction starts the compaction cycle again.
 // It errors if compactions were not paused
 func (s *Store) ResumeCompaction(ctx context.Context) error {
+	// the copies of remote segments made for a backup must be gone before
+	// the segments are compacted, see Bucket.ListFiles
+	for _, b := range s.bucketsByName {
+		if err := b.disk.unstageRemoteSegments(); err != nil {
+			return err
+		}
+	}
+
 	s.cycleCallbacks.compactionCallbacksCtrl.Activate()
 
 	// TODO common_cycle_manager maybe not necessary, or to be replaced with store pause stats
@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestStoreReadOnly(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	store, err := New(dirName, dirName, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	require.Nil(t, store.CreateOrLoadBucket(ctx, "objects",
+		WithStrategy(StrategyReplace), WithSecondaryIndices(1)))
+	require.Nil(t, store.CreateOrLoadBucket(ctx, "set", WithStrategy(StrategySetCollection)))
+	require.Nil(t, store.CreateOrLoadBucket(ctx, "roaring", WithStrategy(StrategyRoaringSet)))
+
+	require.Nil(t, store.Bucket("objects").Put([]byte("flushed"), []byte("a"),
+		WithSecondaryKey(0, []byte("1"))))
+	require.Nil(t, store.Bucket("set").SetAdd([]byte("key"), [][]byte{[]byte("a")}))
+	require.Nil(t, store.Bucket("roaring").RoaringSetAddOne([]byte("key"), 7))
+	for _, b := range store.GetBucketsByName() {
+		require.Nil(t, b.FlushAndSwitch())
+	}
+
+	// the store is not shut down, the last write is only in the WAL
+	require.Nil(t, store.Bucket("objects").Put([]byte("logged"), []byte("b"),
+		WithSecondaryKey(0, []byte("2"))))
+	require.Nil(t, store.WriteWALs())
+
+	before := dirContents(t, dirName)
+
+	t.Run("reads segments and logs without modifying them", func(t *testing.T) {
+		readOnly, err := NewReadOnly(ctx, dirName, logger, nil)
+		require.Nil(t, err)
+		require.Len(t, readOnly.GetBucketsByName(), 3)
+
+		objects := readOnly.Bucket("objects")
+		assert.Equal(t, StrategyReplace, objects.GetStrategy())
+		value, err := objects.Get([]byte("flushed"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("a"), value)
+		value, err = objects.GetBySecondary(0, []byte("2"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("b"), value)
+
+		set := readOnly.Bucket("set")
+		assert.Equal(t, StrategySetCollection, set.GetStrategy())
+		list, err := set.SetList([]byte("key"))
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte("a")}, list)
+
+		roaring := readOnly.Bucket("roaring")
+		assert.Equal(t, StrategyRoaringSet, roaring.GetStrategy())
+		bm, err := roaring.RoaringSetGet([]byte("key"))
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{7}, bm.ToArray())
+
+		segments := objects.Segments()
+		require.Len(t, segments, 1)
+		assert.Equal(t, StrategyReplace, segments[0].Strategy)
+		assert.Equal(t, uint16(1), segments[0].SecondaryIndices)
+		assert.Equal(t, 1, segments[0].CountNetAdditions)
+
+		for _, b := range readOnly.GetBucketsByName() {
+			for _, verification := range b.VerifySegments() {
+				assert.Nil(t, verification.Checksums)
+				assert.Nil(t, verification.BloomFilters)
+				assert.Nil(t, verification.CountNetAdditions)
+			}
+		}
+
+		require.Nil(t, readOnly.Shutdown(ctx))
+		assert.Equal(t, before, dirContents(t, dirName))
+	})
+
+	t.Run("verification detects stale derived files", func(t *testing.T) {
+		segment := store.Bucket("objects").Segments()[0]
+		require.Nil(t, storeCountNetOnDisk(countNetPathFromSegmentPath(segment.Path), 5))
+
+		readOnly, err := NewReadOnly(ctx, dirName, logger, nil)
+		require.Nil(t, err)
+		verifications := readOnly.Bucket("objects").VerifySegments()
+		require.Len(t, verifications, 1)
+		assert.Nil(t, verifications[0].Checksums)
+		assert.NotNil(t, verifications[0].CountNetAdditions)
+		require.Nil(t, readOnly.Shutdown(ctx))
+	})
+}
+
+// dirContents maps the files in dir to their size and modification time
+func dirContents(t *testing.T, dir string) map[string]string {
+	out := map[string]string{}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		out[path] = fmt.Sprintf("%s %d", info.ModTime().Format(time.RFC3339Nano), info.Size())
+		return nil
+	})
+	require.Nil(t, err)
+	return out
+}
@@ -40,6 +40,21 @@ func SegmentStrategyFromString(in string) segmentindex.Strategy {
 	}
 }
 
+func strategyFromSegment(in segmentindex.Strategy) string {
+	switch in {
+	case segmentindex.StrategyReplace:
+		return StrategyReplace
+	case segmentindex.StrategySetCollection:
+		return StrategySetCollection
+	case segmentindex.StrategyMapCollection:
+		return StrategyMapCollection
+	case segmentindex.StrategyRoaringSet:
+		return StrategyRoaringSet
+	default:
+		return fmt.Sprintf("unknown(%d)", in)
+	}
+}
+
 func IsExpectedStrategy(strategy string, expectedStrategies ...string) bool {
 	if len(expectedStrategies) == 0 {
 		expectedStrategies = []string{StrategyReplace, StrategySetCollection, StrategyMapCollection, StrategyRoaringSet}
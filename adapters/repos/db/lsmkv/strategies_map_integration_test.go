@@ -815,7 +815,8 @@ func mapCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]MapPair
-			c := b.MapCursor()
+			c, err := b.MapCursor()
+			require.Nil(t, err)
 			defer c.Close()
 			for k, v := c.Seek(ctx, []byte("row-016")); k != nil; k, v = c.Next(ctx) {
 				retrievedKeys = append(retrievedKeys, k)
@@ -856,7 +857,8 @@ func mapCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]MapPair
-			c := b.MapCursor()
+			c, err := b.MapCursor()
+			require.Nil(t, err)
 			defer c.Close()
 			retrieved := 0
 			for k, v := c.First(ctx); k != nil && retrieved < 3; k, v = c.Next(ctx) {
@@ -908,7 +910,8 @@ func mapCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]MapPair
-			c := b.MapCursor()
+			c, err := b.MapCursor()
+			require.Nil(t, err)
 			defer c.Close()
 			retrieved := 0
 			for k, v := c.Seek(ctx, []byte("row-001")); k != nil && retrieved < 2; k, v = c.Next(ctx) {
@@ -1093,7 +1096,8 @@ func mapCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]MapPair
-			c := b.MapCursor()
+			c, err := b.MapCursor()
+			require.Nil(t, err)
 			defer c.Close()
 			for k, v := c.Seek(ctx, []byte("row-016")); k != nil; k, v = c.Next(ctx) {
 				retrievedKeys = append(retrievedKeys, k)
@@ -1134,7 +1138,8 @@ func mapCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]MapPair
-			c := b.MapCursor()
+			c, err := b.MapCursor()
+			require.Nil(t, err)
 			defer c.Close()
 			retrieved := 0
 			for k, v := c.First(ctx); k != nil && retrieved < 3; k, v = c.Next(ctx) {
@@ -1186,7 +1191,8 @@ func mapCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]MapPair
-			c := b.MapCursor()
+			c, err := b.MapCursor()
+			require.Nil(t, err)
 			defer c.Close()
 			retrieved := 0
 			for k, v := c.Seek(ctx, []byte("row-001")); k != nil && retrieved < 2; k, v = c.Next(ctx) {
@@ -1227,7 +1233,8 @@ func mapCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]MapPair
-			c := b.MapCursor()
+			c, err := b.MapCursor()
+			require.Nil(t, err)
 			defer c.Close()
 			retrieved := 0
 			for k, v := c.Seek(ctx, []byte("row-001")); k != nil && retrieved < 2; k, v = c.Next(ctx) {
@@ -874,7 +874,8 @@ func replaceCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]byte
-			c := b.Cursor()
+			c, err := b.Cursor()
+			require.Nil(t, err)
 			defer c.Close()
 			for k, v := c.Seek([]byte("key-016")); k != nil; k, v = c.Next() {
 				retrievedKeys = copyAndAppend(retrievedKeys, k)
@@ -899,7 +900,8 @@ func replaceCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]byte
-			c := b.Cursor()
+			c, err := b.Cursor()
+			require.Nil(t, err)
 			defer c.Close()
 			retrieved := 0
 			for k, v := c.First(); k != nil && retrieved < 3; k, v = c.Next() {
@@ -930,7 +932,8 @@ func replaceCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]byte
-			c := b.Cursor()
+			c, err := b.Cursor()
+			require.Nil(t, err)
 			defer c.Close()
 			retrieved := 0
 			for k, v := c.Seek([]byte("key-001")); k != nil && retrieved < 2; k, v = c.Next() {
@@ -960,7 +963,8 @@ func replaceCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 				}
 				var retrievedKeys [][]byte
 				var retrievedValues [][]byte
-				c := b.Cursor()
+				c, err := b.Cursor()
+				require.Nil(t, err)
 				defer c.Close()
 				retrieved := 0
 				for k, v := c.Seek([]byte("key-001")); k != nil && retrieved < 2; k, v = c.Next() {
@@ -987,7 +991,8 @@ func replaceCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 				var retrievedKeys [][]byte
 				var retrievedValues [][]byte
-				c := b.Cursor()
+				c, err := b.Cursor()
+				require.Nil(t, err)
 				defer c.Close()
 				retrieved := 0
 				for k, v := c.First(); k != nil && retrieved < 3; k, v = c.Next() {
@@ -1018,7 +1023,8 @@ func replaceCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 				}
 				var retrievedKeys [][]byte
 				var retrievedValues [][]byte
-				c := b.Cursor()
+				c, err := b.Cursor()
+				require.Nil(t, err)
 				defer c.Close()
 				retrieved := 0
 				for k, v := c.Seek([]byte("key-000")); k != nil && retrieved < 2; k, v = c.Next() {
@@ -1043,7 +1049,8 @@ func replaceCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 				var retrievedKeys [][]byte
 				var retrievedValues [][]byte
-				c := b.Cursor()
+				c, err := b.Cursor()
+				require.Nil(t, err)
 				defer c.Close()
 				retrieved := 0
 				for k, v := c.First(); k != nil && retrieved < 2; k, v = c.Next() {
@@ -1111,7 +1118,8 @@ func replaceCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]byte
-			c := b.Cursor()
+			c, err := b.Cursor()
+			require.Nil(t, err)
 			defer c.Close()
 			for k, v := c.Seek([]byte("key-016")); k != nil; k, v = c.Next() {
 				retrievedKeys = copyAndAppend(retrievedKeys, k)
@@ -1136,7 +1144,8 @@ func replaceCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]byte
-			c := b.Cursor()
+			c, err := b.Cursor()
+			require.Nil(t, err)
 			defer c.Close()
 			retrieved := 0
 			for k, v := c.First(); k != nil && retrieved < 3; k, v = c.Next() {
@@ -1270,7 +1279,8 @@ func replaceCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]byte
-			c := b.Cursor()
+			c, err := b.Cursor()
+			require.Nil(t, err)
 			defer c.Close()
 			for k, v := c.Seek([]byte("key-016")); k != nil; k, v = c.Next() {
 				retrievedKeys = copyAndAppend(retrievedKeys, k)
@@ -1298,7 +1308,8 @@ func replaceCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]byte
-			c := b.Cursor()
+			c, err := b.Cursor()
+			require.Nil(t, err)
 			defer c.Close()
 			retrieved := 0
 			for k, v := c.First(); k != nil && retrieved < 4; k, v = c.Next() {
@@ -1333,7 +1344,8 @@ func replaceCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]byte
-			c := b.Cursor()
+			c, err := b.Cursor()
+			require.Nil(t, err)
 			defer c.Close()
 			for k, v := c.Seek([]byte("key-016")); k != nil; k, v = c.Next() {
 				retrievedKeys = copyAndAppend(retrievedKeys, k)
@@ -1360,7 +1372,8 @@ func replaceCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]byte
-			c := b.Cursor()
+			c, err := b.Cursor()
+			require.Nil(t, err)
 			defer c.Close()
 			retrieved := 0
 			for k, v := c.First(); k != nil && retrieved < 4; k, v = c.Next() {
@@ -1393,7 +1406,8 @@ func replaceCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]byte
-			c := b.Cursor()
+			c, err := b.Cursor()
+			require.Nil(t, err)
 			defer c.Close()
 			for k, v := c.Seek([]byte("key-016")); k != nil; k, v = c.Next() {
 				retrievedKeys = copyAndAppend(retrievedKeys, k)
@@ -1420,7 +1434,8 @@ func replaceCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]byte
-			c := b.Cursor()
+			c, err := b.Cursor()
+			require.Nil(t, err)
 			defer c.Close()
 			retrieved := 0
 			for k, v := c.First(); k != nil && retrieved < 4; k, v = c.Next() {
@@ -1483,7 +1498,8 @@ func replaceCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][]byte
-			c := b.Cursor()
+			c, err := b.Cursor()
+			require.Nil(t, err)
 			defer c.Close()
 			retrieved := 0
 			for k, v := c.First(); k != nil && retrieved < 4; k, v = c.Next() {
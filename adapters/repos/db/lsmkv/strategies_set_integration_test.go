@@ -761,7 +761,8 @@ func collectionCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][][]byte
-			c := b.SetCursor()
+			c, err := b.SetCursor()
+			require.Nil(t, err)
 			defer c.Close()
 			for k, v := c.Seek([]byte("key-016")); k != nil; k, v = c.Next() {
 				retrievedKeys = append(retrievedKeys, k)
@@ -786,7 +787,8 @@ func collectionCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][][]byte
-			c := b.SetCursor()
+			c, err := b.SetCursor()
+			require.Nil(t, err)
 			defer c.Close()
 			retrieved := 0
 			for k, v := c.First(); k != nil && retrieved < 3; k, v = c.Next() {
@@ -821,7 +823,8 @@ func collectionCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][][]byte
-			c := b.SetCursor()
+			c, err := b.SetCursor()
+			require.Nil(t, err)
 			defer c.Close()
 			retrieved := 0
 			for k, v := c.Seek([]byte("key-001")); k != nil && retrieved < 2; k, v = c.Next() {
@@ -962,7 +965,8 @@ func collectionCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][][]byte
-			c := b.SetCursor()
+			c, err := b.SetCursor()
+			require.Nil(t, err)
 			defer c.Close()
 			for k, v := c.Seek([]byte("key-016")); k != nil; k, v = c.Next() {
 				retrievedKeys = append(retrievedKeys, k)
@@ -987,7 +991,8 @@ func collectionCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][][]byte
-			c := b.SetCursor()
+			c, err := b.SetCursor()
+			require.Nil(t, err)
 			defer c.Close()
 			retrieved := 0
 			for k, v := c.First(); k != nil && retrieved < 3; k, v = c.Next() {
@@ -1030,7 +1035,8 @@ func collectionCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][][]byte
-			c := b.SetCursor()
+			c, err := b.SetCursor()
+			require.Nil(t, err)
 			defer c.Close()
 			retrieved := 0
 			for k, v := c.Seek([]byte("key-001")); k != nil && retrieved < 2; k, v = c.Next() {
@@ -1066,7 +1072,8 @@ func collectionCursors(ctx context.Context, t *testing.T, opts []BucketOption) {
 
 			var retrievedKeys [][]byte
 			var retrievedValues [][][]byte
-			c := b.SetCursor()
+			c, err := b.SetCursor()
+			require.Nil(t, err)
 			defer c.Close()
 			retrieved := 0
 			for k, v := c.Seek([]byte("key-001")); k != nil && retrieved < 2; k, v = c.Next() {
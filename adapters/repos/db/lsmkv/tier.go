@@ -0,0 +1,255 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// RemoteStore holds the segments of the remote tier
+type RemoteStore interface {
+	// PutFile uploads the file at srcPath as key
+	PutFile(ctx context.Context, key, srcPath string) error
+	// WriteToFile downloads key to the file at destPath
+	WriteToFile(ctx context.Context, key, destPath string) error
+	// Delete removes key, it is used for segments which were compacted or
+	// dropped. Deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// TierPolicy selects the segments which are moved to the remote tier. A
+// segment is moved if it reached either of the thresholds, a zero threshold
+// is disabled.
+type TierPolicy struct {
+	MinLevel uint16
+	// MinAge is the time since the newest data of the segment was flushed
+	MinAge time.Duration
+}
+
+func (p TierPolicy) enabled() bool {
+	return p.MinLevel > 0 || p.MinAge > 0
+}
+
+func (p TierPolicy) applies(seg *segment, now time.Time) bool {
+	if p.MinLevel > 0 && seg.level >= p.MinLevel {
+		return true
+	}
+	if p.MinAge > 0 {
+		// segments are named after the time they were flushed, compacted
+		// segments after the newest segment they were compacted from
+		flushed, err := strconv.ParseInt(segmentID(seg.path), 10, 64)
+		if err == nil && now.Sub(time.Unix(0, flushed)) >= p.MinAge {
+			return true
+		}
+	}
+	return false
+}
+
+type RemoteTierConfig struct {
+	// Store is resolved when it is used for the first time, as the modules
+	// providing it may be initialized after the buckets are loaded
+	Store  func() (RemoteStore, error)
+	Policy TierPolicy
+	// RootPath is the data path, keys of the segments are relative to it
+	RootPath string
+	// CacheDir holds the remote segments which are read, it is cleared when
+	// the tier is created
+	CacheDir string
+	// CacheSize is the size in bytes of the remote segments which are kept in
+	// the cache. Segments are read in full, so the cache may exceed the size
+	// while more segments are read at once. Cursors fail if the remote
+	// segments of their bucket exceed the size.
+	CacheSize int64
+	Logger    logrus.FieldLogger
+}
+
+// RemoteTier moves cold segments of the buckets it is configured for to a
+// remote store. They are evicted from the local disk and fetched into a
+// cache of bounded size when they are read. A single tier and cache is
+// shared by all buckets of a node, see WithRemoteTier.
+type RemoteTier struct {
+	config RemoteTierConfig
+	cache  *tierCache
+}
+
+func NewRemoteTier(config RemoteTierConfig) (*RemoteTier, error) {
+	if config.Store == nil {
+		return nil, fmt.Errorf("remote tier requires a store")
+	}
+	if !config.Policy.enabled() {
+		return nil, fmt.Errorf("remote tier requires a minimum level or age")
+	}
+	if config.CacheSize <= 0 {
+		return nil, fmt.Errorf("remote tier requires a cache size")
+	}
+
+	if err := os.RemoveAll(config.CacheDir); err != nil {
+		return nil, fmt.Errorf("clear remote tier cache: %w", err)
+	}
+	if err := os.MkdirAll(config.CacheDir, 0o700); err != nil {
+		return nil, fmt.Errorf("create remote tier cache: %w", err)
+	}
+
+	return &RemoteTier{
+		config: config,
+		cache: &tierCache{
+			dir:     config.CacheDir,
+			maxSize: config.CacheSize,
+			loaded:  map[*segment]struct{}{},
+		},
+	}, nil
+}
+
+// key of the segment in the remote store
+func (t *RemoteTier) key(segmentPath string) (string, error) {
+	rel, err := filepath.Rel(t.config.RootPath, segmentPath)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("segment %s is not in the data path %s", segmentPath, t.config.RootPath)
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+func (t *RemoteTier) upload(ctx context.Context, key, path string) error {
+	store, err := t.config.Store()
+	if err != nil {
+		return fmt.Errorf("remote store: %w", err)
+	}
+	return store.PutFile(ctx, key, path)
+}
+
+func (t *RemoteTier) download(ctx context.Context, key, path string) error {
+	store, err := t.config.Store()
+	if err != nil {
+		return fmt.Errorf("remote store: %w", err)
+	}
+	return store.WriteToFile(ctx, key, path)
+}
+
+// delete the segment from the store
+func (t *RemoteTier) delete(ctx context.Context, key string) {
+	store, err := t.config.Store()
+	if err != nil {
+		t.config.Logger.WithError(err).WithField("key", key).
+			Warn("remote segment was not deleted")
+		return
+	}
+	if err := store.Delete(ctx, key); err != nil {
+		t.config.Logger.WithError(err).WithField("key", key).
+			Warn("remote segment was not deleted")
+	}
+}
+
+// tierCache tracks the size of the remote segments which are loaded from the
+// cache directory. Segments are only unloaded while holding the maintenance
+// lock of the segment group they belong to, see evict.
+type tierCache struct {
+	dir     string
+	maxSize int64
+
+	sync.Mutex
+	size   int64
+	loaded map[*segment]struct{}
+}
+
+func (c *tierCache) path(key string) string {
+	return filepath.Join(c.dir, strings.ReplaceAll(key, "/", "_"))
+}
+
+func (c *tierCache) add(seg *segment) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.loaded[seg] = struct{}{}
+	c.size += seg.size
+}
+
+func (c *tierCache) remove(seg *segment) {
+	c.Lock()
+	defer c.Unlock()
+
+	if _, ok := c.loaded[seg]; !ok {
+		return
+	}
+	delete(c.loaded, seg)
+	c.size -= seg.size
+}
+
+// evict unloads the least recently used segments which are not pinned, until
+// size more bytes fit into the cache. Segments of groups whose maintenance
+// lock is held are skipped, the cache exceeds its size until a later call
+// evicts them.
+func (c *tierCache) evict(size int64) error {
+	for _, seg := range c.victims(size) {
+		r := seg.remote
+		if !r.groupLock.TryLock() {
+			continue
+		}
+		var err error
+		if r.pins.Load() == 0 {
+			err = seg.unload()
+		}
+		r.groupLock.Unlock()
+		if err != nil {
+			return fmt.Errorf("unload remote segment %s: %w", seg.path, err)
+		}
+	}
+	return nil
+}
+
+// victims returns the least recently used segments which are not pinned and
+// have to be unloaded for size more bytes to fit into the cache
+func (c *tierCache) victims(size int64) []*segment {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.size+size <= c.maxSize {
+		return nil
+	}
+
+	segments := make([]*segment, 0, len(c.loaded))
+	for seg := range c.loaded {
+		if seg.remote.pins.Load() == 0 {
+			segments = append(segments, seg)
+		}
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].remote.lastUsed.Load() < segments[j].remote.lastUsed.Load()
+	})
+
+	var victims []*segment
+	total := c.size + size
+	for _, seg := range segments {
+		if total <= c.maxSize {
+			break
+		}
+		victims = append(victims, seg)
+		total -= seg.size
+	}
+	return victims
+}
+
+func (c *tierCache) usedSize() int64 {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.size
+}
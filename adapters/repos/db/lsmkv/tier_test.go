@@ -0,0 +1,285 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+// fakeRemoteStore keeps the objects in a local directory
+type fakeRemoteStore struct {
+	dir string
+
+	sync.Mutex
+	downloads int
+}
+
+func (s *fakeRemoteStore) path(key string) string {
+	return filepath.Join(s.dir, strings.ReplaceAll(key, "/", "_"))
+}
+
+func (s *fakeRemoteStore) PutFile(ctx context.Context, key, srcPath string) error {
+	data, err := os.ReadFile(srcPath)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path(key), data, 0o644)
+}
+
+func (s *fakeRemoteStore) WriteToFile(ctx context.Context, key, destPath string) error {
+	s.Lock()
+	s.downloads++
+	s.Unlock()
+
+	data, err := os.ReadFile(s.path(key))
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(destPath, data, 0o644)
+}
+
+func (s *fakeRemoteStore) Delete(ctx context.Context, key string) error {
+	return os.Remove(s.path(key))
+}
+
+func (s *fakeRemoteStore) objects(t *testing.T) []string {
+	entries, err := os.ReadDir(s.dir)
+	require.Nil(t, err)
+	var out []string
+	for _, entry := range entries {
+		out = append(out, entry.Name())
+	}
+	return out
+}
+
+func (s *fakeRemoteStore) downloadCount() int {
+	s.Lock()
+	defer s.Unlock()
+	return s.downloads
+}
+
+func TestRemoteTier(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	dir := filepath.Join(root, "bucket")
+	logger, _ := test.NewNullLogger()
+	store := &fakeRemoteStore{dir: t.TempDir()}
+
+	newTier := func(cacheSize int64) *RemoteTier {
+		tier, err := NewRemoteTier(RemoteTierConfig{
+			Store:     func() (RemoteStore, error) { return store, nil },
+			Policy:    TierPolicy{MinLevel: 1},
+			RootPath:  root,
+			CacheDir:  filepath.Join(root, "cache"),
+			CacheSize: cacheSize,
+			Logger:    logger,
+		})
+		require.Nil(t, err)
+		return tier
+	}
+	newBucket := func(tier *RemoteTier) (*Bucket, error) {
+		return NewBucketCreator().NewBucket(ctx, dir, root, logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace), WithSecondaryIndices(1), WithRemoteTier(tier))
+	}
+	compact := func(b *Bucket) {
+		for b.disk.compactIfLevelsMatch(func() bool { return false }) {
+		}
+	}
+	remote := func(b *Bucket) []bool {
+		var out []bool
+		for _, seg := range b.Segments() {
+			out = append(out, seg.Remote)
+		}
+		return out
+	}
+
+	expected := map[string]string{}
+	put := func(b *Bucket, round int) {
+		for i := round; i < round+10; i++ {
+			key := fmt.Sprintf("key-%03d", i)
+			value := fmt.Sprintf("value-%03d-round-%d", i, round)
+			require.Nil(t, b.Put([]byte(key), []byte(value),
+				WithSecondaryKey(0, []byte("secondary-"+key))))
+			expected[key] = value
+		}
+		require.Nil(t, b.FlushAndSwitch())
+	}
+	assertValues := func(t *testing.T, b *Bucket) {
+		for i := 0; i < 20; i++ {
+			key := fmt.Sprintf("key-%03d", i)
+			value, err := b.Get([]byte(key))
+			require.Nil(t, err)
+			if v, ok := expected[key]; ok {
+				assert.Equal(t, []byte(v), value, key)
+			} else {
+				assert.Nil(t, value, key)
+			}
+
+			value, err = b.GetBySecondary(0, []byte("secondary-"+key))
+			require.Nil(t, err)
+			if v, ok := expected[key]; ok {
+				assert.Equal(t, []byte(v), value, key)
+			} else {
+				assert.Nil(t, value, key)
+			}
+		}
+
+		c, err := b.Cursor()
+		require.Nil(t, err)
+		defer c.Close()
+		count := 0
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			assert.Equal(t, []byte(expected[string(k)]), v)
+			count++
+		}
+		assert.Equal(t, len(expected), count)
+
+		assert.Equal(t, len(expected), b.Count())
+	}
+
+	tier := newTier(1 << 20)
+	b, err := newBucket(tier)
+	require.Nil(t, err)
+
+	t.Run("compacted segment is moved to the remote tier", func(t *testing.T) {
+		put(b, 0)
+		put(b, 1)
+		compact(b)
+
+		assert.Equal(t, []bool{true}, remote(b))
+		assert.Len(t, store.objects(t), 1)
+		segments, err := filepath.Glob(filepath.Join(dir, "*.db"))
+		require.Nil(t, err)
+		assert.Empty(t, segments)
+		markers, err := filepath.Glob(filepath.Join(dir, "*.db.remote"))
+		require.Nil(t, err)
+		assert.Len(t, markers, 1)
+	})
+
+	t.Run("remote segment is fetched once when read", func(t *testing.T) {
+		assertValues(t, b)
+		assertValues(t, b)
+		assert.Equal(t, 1, store.downloadCount())
+	})
+
+	t.Run("remote segment is evicted once the cache is full", func(t *testing.T) {
+		tier.cache.maxSize = 0
+		compact(b)
+		assert.Equal(t, int64(0), tier.cache.usedSize())
+		cached, err := os.ReadDir(tier.config.CacheDir)
+		require.Nil(t, err)
+		assert.Empty(t, cached)
+
+		tier.cache.maxSize = 1 << 20
+		assertValues(t, b)
+		assert.Equal(t, 2, store.downloadCount())
+	})
+
+	t.Run("remote segment is loaded from its marker", func(t *testing.T) {
+		require.Nil(t, b.Shutdown(ctx))
+
+		tier = newTier(1 << 20)
+		b, err = newBucket(tier)
+		require.Nil(t, err)
+		assert.Equal(t, []bool{true}, remote(b))
+		assertValues(t, b)
+	})
+
+	t.Run("remote segment is compacted with local segments", func(t *testing.T) {
+		put(b, 5)
+		put(b, 10)
+		compact(b)
+
+		assert.Equal(t, []bool{true}, remote(b))
+		assert.Equal(t, uint16(2), b.Segments()[0].Level)
+		// the compacted remote segment was deleted from the store
+		assert.Eventually(t, func() bool { return len(store.objects(t)) == 1 },
+			time.Second, 10*time.Millisecond)
+		assertValues(t, b)
+		assert.Equal(t, []SegmentVerification{{Path: b.Segments()[0].Path}}, b.VerifySegments())
+	})
+
+	t.Run("cursor fails if the remote segments exceed the cache", func(t *testing.T) {
+		tier.cache.maxSize = 0
+		defer func() { tier.cache.maxSize = 1 << 20 }()
+
+		_, err := b.Cursor()
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "the remote tier cache holds 0")
+	})
+
+	t.Run("backup contains the data of remote segments", func(t *testing.T) {
+		segmentPath := b.Segments()[0].Path
+		files, err := b.ListFiles(ctx, "bucket")
+		require.Nil(t, err)
+		assert.Contains(t, files, filepath.Join("bucket", filepath.Base(segmentPath)))
+		for _, file := range files {
+			assert.NotEqual(t, remoteMarkerExt, filepath.Ext(file))
+		}
+		_, err = os.Stat(segmentPath)
+		require.Nil(t, err)
+
+		require.Nil(t, b.disk.unstageRemoteSegments())
+		_, err = os.Stat(segmentPath)
+		assert.True(t, os.IsNotExist(err))
+		assert.Equal(t, []bool{true}, remote(b))
+	})
+
+	t.Run("remote segments require a remote tier", func(t *testing.T) {
+		require.Nil(t, b.Shutdown(ctx))
+
+		_, err := newBucket(nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "no remote tier is configured")
+	})
+
+	t.Run("interrupted move keeps the local segment and deletes the remote copy", func(t *testing.T) {
+		markers, err := filepath.Glob(filepath.Join(dir, "*.db.remote"))
+		require.Nil(t, err)
+		require.Len(t, markers, 1)
+		segmentPath := strings.TrimSuffix(markers[0], remoteMarkerExt)
+		require.Nil(t, store.WriteToFile(ctx, readMarker(t, markers[0]).Key, segmentPath))
+
+		b, err = newBucket(newTier(1 << 20))
+		require.Nil(t, err)
+		defer b.Shutdown(ctx)
+
+		assert.Equal(t, []bool{false}, remote(b))
+		_, err = os.Stat(markers[0])
+		assert.True(t, os.IsNotExist(err))
+		assert.Eventually(t, func() bool { return len(store.objects(t)) == 0 },
+			time.Second, 10*time.Millisecond)
+		assertValues(t, b)
+	})
+}
+
+func readMarker(t *testing.T, path string) remoteMarker {
+	data, err := os.ReadFile(path)
+	require.Nil(t, err)
+	var marker remoteMarker
+	require.Nil(t, json.Unmarshal(data, &marker))
+	return marker
+}
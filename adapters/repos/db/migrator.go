@@ -14,12 +14,15 @@ package db
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/diskann"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
@@ -65,9 +68,17 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			MemtablesMaxActiveSeconds: m.db.config.MemtablesMaxActiveSeconds,
 			MaxSegmentSize:            m.db.config.MaxSegmentSize,
 			HNSWMaxLogSize:            m.db.config.HNSWMaxLogSize,
+			HNSWSnapshotInterval:      m.db.config.HNSWSnapshotInterval,
+			LSMScrubInterval:          m.db.config.LSMScrubInterval,
+			LSMScrubRepair:            m.db.config.LSMScrubRepair,
+			LSMCompactionPolicy:       m.db.config.LSMCompactionPolicy,
+			LSMCompactionFanOut:       m.db.config.LSMCompactionFanOut,
+			ObjectsCompression:        objectsCompression(class),
 			TrackVectorDimensions:     m.db.config.TrackVectorDimensions,
 			AvoidMMap:                 m.db.config.AvoidMMap,
 			DisableLazyLoadShards:     m.db.config.DisableLazyLoadShards,
+			VectorCacheBudget:         m.db.vectorCacheBudget,
+			LSMTier:                   m.db.lsmTier,
 			ReplicationFactor:         NewAtomicInt64(class.ReplicationConfig.Factor),
 			AsyncReplicationEnabled:   class.ReplicationConfig.AsyncEnabled,
 		},
@@ -144,6 +155,8 @@ func (m *Migrator) UpdateIndex(ctx context.Context, incomingClass *models.Class,
 		}
 	}
 
+	idx.setResharding(incomingSS)
+
 	{ // add/remove missing shards
 		if incomingSS.PartitioningEnabled {
 			if err := m.updateIndexTenants(ctx, idx, incomingClass, incomingSS); err != nil {
@@ -294,6 +307,99 @@ func (m *Migrator) UpdateShardStatus(ctx context.Context, className, shardName,
 	return idx.updateShardStatus(ctx, shardName, targetStatus, schemaVersion)
 }
 
+// UpdateShardOwners loads or drops a local shard depending on whether this
+// node is still part of the nodes the shard belongs to
+func (m *Migrator) UpdateShardOwners(ctx context.Context, className, shardName string, nodes []string) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update shard owners of a non-existing index for %s", className)
+	}
+
+	if !slices.Contains(nodes, m.db.schemaGetter.NodeName()) {
+		// the shard was moved away from this node
+		if err := idx.dropShards([]string{shardName}); err != nil {
+			return fmt.Errorf("drop shard %s: %w", shardName, err)
+		}
+		return nil
+	}
+
+	if idx.partitioningEnabled() {
+		phys, ok := m.db.schemaGetter.CopyShardingState(className).Physical[shardName]
+		if !ok || !schemaUC.IsLocalActiveTenant(&phys, m.db.schemaGetter.NodeName()) {
+			return nil // inactive tenants are loaded once activated
+		}
+	}
+
+	if _, err := idx.getOrInitLocalShard(ctx, shardName); err != nil {
+		return fmt.Errorf("init shard %s: %w", shardName, err)
+	}
+	return nil
+}
+
+// StartResharding creates the local shards of the layout a class is
+// resharded to and starts writing objects to them, in addition to the
+// shards of the current layout
+func (m *Migrator) StartResharding(ctx context.Context, className string) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot start resharding of a non-existing index for %s", className)
+	}
+	state := m.db.schemaGetter.CopyShardingState(className)
+	if state == nil || state.Resharding == nil {
+		return errors.Errorf("class %s is not being resharded", className)
+	}
+
+	nodeName := m.db.schemaGetter.NodeName()
+	for name, phys := range state.Resharding.Physical {
+		if !slices.Contains(phys.BelongsToNodes, nodeName) {
+			continue
+		}
+		if _, err := idx.getOrInitLocalShard(ctx, name); err != nil {
+			return fmt.Errorf("init shard %s: %w", name, err)
+		}
+	}
+	idx.setResharding(state)
+	return nil
+}
+
+// FinishResharding stops writing objects to a second layout and drops the
+// local shards which are not part of the current layout of the class. After
+// a commit these are the shards of the previous layout, after an abort the
+// ones of the discarded layout.
+func (m *Migrator) FinishResharding(ctx context.Context, className string) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot finish resharding of a non-existing index for %s", className)
+	}
+	state := m.db.schemaGetter.CopyShardingState(className)
+	if state == nil {
+		return errors.Errorf("sharding state of class %s not found", className)
+	}
+	idx.setResharding(state)
+
+	nodeName := m.db.schemaGetter.NodeName()
+	for name, phys := range state.Physical {
+		if !slices.Contains(phys.BelongsToNodes, nodeName) {
+			continue
+		}
+		if _, err := idx.getOrInitLocalShard(ctx, name); err != nil {
+			return fmt.Errorf("init shard %s: %w", name, err)
+		}
+	}
+
+	var obsolete []string
+	idx.shards.Range(func(name string, _ ShardLike) error {
+		if _, ok := state.Physical[name]; !ok {
+			obsolete = append(obsolete, name)
+		}
+		return nil
+	})
+	if err := idx.dropShards(obsolete); err != nil {
+		return fmt.Errorf("drop shards: %w", err)
+	}
+	return nil
+}
+
 // NewTenants creates new partitions
 func (m *Migrator) NewTenants(ctx context.Context, class *models.Class, creates []*schemaUC.CreateTenantPayload) error {
 	idx := m.db.GetIndex(schema.ClassName(class.Class))
@@ -450,6 +556,8 @@ func (m *Migrator) ValidateVectorIndexConfigUpdate(
 		return flat.ValidateUserConfigUpdate(old, updated)
 	case vectorindex.VectorIndexTypeDYNAMIC:
 		return dynamic.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeDISKANN:
+		return diskann.ValidateUserConfigUpdate(old, updated)
 	}
 	return fmt.Errorf("Invalid index type: %s", old.IndexType())
 }
@@ -482,6 +590,25 @@ func (m *Migrator) UpdateInvertedIndexConfig(ctx context.Context, className stri
 	return idx.updateInvertedIndexConfig(ctx, conf)
 }
 
+func (m *Migrator) UpdateStorageConfig(ctx context.Context, className string,
+	updated *models.StorageConfig,
+) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update storage config of non-existing index for %s", className)
+	}
+
+	compression := lsmkv.CompressionNone
+	if updated != nil {
+		var err error
+		if compression, err = lsmkv.ParseCompression(updated.ObjectsCompression); err != nil {
+			return err
+		}
+	}
+
+	return idx.updateStorageConfig(ctx, compression)
+}
+
 func (m *Migrator) UpdateReplicationFactor(ctx context.Context, className string, factor int64) error {
 	idx := m.db.GetIndex(schema.ClassName(className))
 	if idx == nil {
@@ -808,3 +935,12 @@ func (m *Migrator) Shutdown(ctx context.Context) error {
 	m.logger.Info("closing loaded database ...")
 	return m.db.Shutdown(ctx)
 }
+
+// objectsCompression returns the compression of the objects bucket configured
+// for the class, classes created before it was configurable are uncompressed
+func objectsCompression(class *models.Class) string {
+	if class.StorageConfig == nil || class.StorageConfig.ObjectsCompression == "" {
+		return lsmkv.CompressionNone
+	}
+	return class.StorageConfig.ObjectsCompression
+}
@@ -58,7 +58,9 @@ func (o *nodeWideMetricsObserver) Start() {
 		case <-t10.C:
 			o.observeActivity()
 		case <-t30.C:
-			o.observeIfShardsReady()
+			if o.db.groupedMetrics() {
+				o.observeIfShardsReady()
+			}
 		}
 	}
 }
@@ -206,13 +206,17 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 		}
 
 		shardStatus := &models.NodeShardStatus{
-			Name:                 name,
-			Class:                shard.Index().Config.ClassName.String(),
-			ObjectCount:          objectCount,
-			VectorIndexingStatus: shard.GetStatus().String(),
-			VectorQueueLength:    queueLen,
-			Compressed:           compressed,
-			Loaded:               true,
+			Name:                   name,
+			Class:                  shard.Index().Config.ClassName.String(),
+			ObjectCount:            objectCount,
+			VectorIndexingStatus:   shard.GetStatus().String(),
+			VectorQueueLength:      queueLen,
+			Compressed:             compressed,
+			Loaded:                 true,
+			AsyncReplicationStatus: shard.asyncReplicationStatus(),
+			VectorIndexRebuilds:    shard.vectorIndexRebuildStatus(),
+			DynamicVectorIndexes:   shard.dynamicVectorIndexStatus(),
+			SegmentIntegrity:       shard.segmentIntegrityStatus(),
 		}
 		*status = append(*status, shardStatus)
 		shardCount++
@@ -221,6 +225,64 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 	return
 }
 
+// RequestAsyncReplicationComparison makes all shards of the given class
+// compare their hashtrees with the ones of their replicas, on every node
+func (db *DB) RequestAsyncReplicationComparison(ctx context.Context, className string) error {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+	if !idx.asyncReplicationEnabled() {
+		return enterrors.NewErrUnprocessable(
+			fmt.Errorf("async replication is not enabled for class %q", className))
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(db.logger)
+	eg.SetLimit(_NUMCPU)
+	for _, nodeName := range db.schemaGetter.Nodes() {
+		nodeName := nodeName
+		eg.Go(func() error {
+			var err error
+			if db.schemaGetter.NodeName() == nodeName {
+				err = db.localAsyncReplicationComparison(className)
+			} else {
+				err = db.remoteNode.RequestAsyncReplicationComparison(ctx, nodeName, className)
+			}
+			if err != nil {
+				return fmt.Errorf("node: %v: %w", nodeName, err)
+			}
+			return nil
+		}, nodeName)
+	}
+
+	return eg.Wait()
+}
+
+// IncomingRequestAsyncReplicationComparison triggers the comparison of the
+// local shards of the given class
+func (db *DB) IncomingRequestAsyncReplicationComparison(ctx context.Context, className string) error {
+	return db.localAsyncReplicationComparison(className)
+}
+
+func (db *DB) localAsyncReplicationComparison(className string) error {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		// the class may not exist yet on this node
+		return nil
+	}
+	if !idx.asyncReplicationEnabled() {
+		return enterrors.NewErrUnprocessable(
+			fmt.Errorf("async replication is not enabled for class %q", className))
+	}
+
+	return idx.ForEachShard(func(name string, shard ShardLike) error {
+		if err := shard.requestAsyncReplicationComparison(); err != nil {
+			return fmt.Errorf("shard %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
 func (db *DB) GetNodeStatistics(ctx context.Context) ([]*models.Statistics, error) {
 	nodeStatistics := make([]*models.Statistics, len(db.schemaGetter.Nodes()))
 	eg := enterrors.NewErrorGroupWrapper(db.logger)
@@ -0,0 +1,707 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// RepairTask is a migration or repair of the files of a shard. Some of them
+// also run at startup when enabled by environment variables, see Repair for
+// running them while the node is stopped.
+type RepairTask string
+
+const (
+	// RepairSetToRoaringSet converts filterable buckets of the set strategy to
+	// roaring sets, like REINDEX_SET_TO_ROARINGSET_AT_STARTUP
+	RepairSetToRoaringSet RepairTask = "set-to-roaringset"
+	// RepairMissingTextFilterable creates the filterable buckets of text
+	// properties, like INDEX_MISSING_TEXT_FILTERABLE_AT_STARTUP
+	RepairMissingTextFilterable RepairTask = "missing-text-filterable"
+	// RepairCommitLogs deletes hnsw commit logs whose condensing was
+	// interrupted, like loading the hnsw index does
+	RepairCommitLogs RepairTask = "hnsw-commit-logs"
+	// RepairPropertyLengths recounts the property lengths used by BM25
+	RepairPropertyLengths RepairTask = "property-lengths"
+	// RepairVectorDimensions recomputes the dimensions bucket of shards which
+	// track vector dimensions
+	RepairVectorDimensions RepairTask = "vector-dimensions"
+	// RepairHNSW builds the hnsw indexes from the vectors in the objects bucket
+	RepairHNSW RepairTask = "hnsw-rebuild"
+)
+
+// RepairTasks are all tasks, in the order they are run
+var RepairTasks = []RepairTask{
+	RepairCommitLogs,
+	RepairSetToRoaringSet,
+	RepairMissingTextFilterable,
+	RepairPropertyLengths,
+	RepairVectorDimensions,
+	RepairHNSW,
+}
+
+// RepairMigrations are the tasks which only change shards that need it. The
+// other tasks rebuild the files of every shard they run on.
+var RepairMigrations = []RepairTask{
+	RepairCommitLogs,
+	RepairSetToRoaringSet,
+	RepairMissingTextFilterable,
+}
+
+func ParseRepairTask(name string) (RepairTask, error) {
+	for _, task := range RepairTasks {
+		if string(task) == name {
+			return task, nil
+		}
+	}
+	return "", fmt.Errorf("unknown repair task %q", name)
+}
+
+// RepairAction is a change to a shard, which is either planned by
+// PlanRepair or made by Repair
+type RepairAction struct {
+	Task   RepairTask `json:"task"`
+	Class  string     `json:"class"`
+	Shard  string     `json:"shard"`
+	Detail string     `json:"detail"`
+}
+
+// PlanRepair returns the changes Repair would make to the local shards of
+// the schema. No files are modified, so it can also be used on the data
+// path of a running node.
+func PlanRepair(ctx context.Context, rootPath string, sg schemaUC.SchemaGetter,
+	tasks []RepairTask, logger logrus.FieldLogger,
+) ([]RepairAction, error) {
+	var missingFilterable map[string]map[string]struct{}
+	if hasRepairTask(tasks, RepairMissingTextFilterable) {
+		// read without loadMigrationState, which creates the file
+		state := filterableToSearchableMigrationState{}
+		data, err := os.ReadFile(newFilterableToSearchableMigrationFiles(rootPath).stateFileName)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, errors.Wrap(err, "read filterable migration state")
+		}
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &state); err != nil {
+				return nil, errors.Wrap(err, "read filterable migration state")
+			}
+		}
+		missingFilterable = state.MissingFilterableClass2Props
+	}
+
+	var actions []RepairAction
+	for _, class := range repairClasses(sg) {
+		state := sg.CopyShardingState(class.Class)
+		if state == nil {
+			continue
+		}
+		shards := state.AllLocalPhysicalShards()
+		sort.Strings(shards)
+
+		for _, shardName := range shards {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			p := &shardRepairPlanner{
+				class:     class,
+				shardName: shardName,
+				shardPath: shardPath(path.Join(rootPath, indexID(schema.ClassName(class.Class))), shardName),
+				logger:    logger,
+			}
+			shardActions, err := p.plan(ctx, tasks, missingFilterable[class.Class])
+			p.close(ctx)
+			if err != nil {
+				return nil, errors.Wrapf(err, "plan repair of shard %q of class %q", shardName, class.Class)
+			}
+			actions = append(actions, shardActions...)
+		}
+	}
+	return actions, nil
+}
+
+func repairClasses(sg schemaUC.SchemaGetter) []*models.Class {
+	objects := sg.GetSchemaSkipAuth().Objects
+	if objects == nil {
+		return nil
+	}
+	classes := append([]*models.Class{}, objects.Classes...)
+	sort.Slice(classes, func(i, j int) bool {
+		return classes[i].Class < classes[j].Class
+	})
+	return classes
+}
+
+func hasRepairTask(tasks []RepairTask, task RepairTask) bool {
+	for _, t := range tasks {
+		if t == task {
+			return true
+		}
+	}
+	return false
+}
+
+type shardRepairPlanner struct {
+	class     *models.Class
+	shardName string
+	shardPath string
+	logger    logrus.FieldLogger
+	store     *lsmkv.Store
+}
+
+// readOnlyStore opens the lsm store of the shard on first use
+func (p *shardRepairPlanner) readOnlyStore(ctx context.Context) (*lsmkv.Store, error) {
+	if p.store != nil {
+		return p.store, nil
+	}
+	store, err := lsmkv.NewReadOnly(ctx, path.Join(p.shardPath, "lsm"), p.logger, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "open lsm store")
+	}
+	p.store = store
+	return store, nil
+}
+
+func (p *shardRepairPlanner) close(ctx context.Context) {
+	if p.store != nil {
+		p.store.Shutdown(ctx)
+	}
+}
+
+func (p *shardRepairPlanner) action(task RepairTask, format string, args ...interface{}) RepairAction {
+	return RepairAction{
+		Task:   task,
+		Class:  p.class.Class,
+		Shard:  p.shardName,
+		Detail: fmt.Sprintf(format, args...),
+	}
+}
+
+func (p *shardRepairPlanner) plan(ctx context.Context, tasks []RepairTask,
+	missingFilterable map[string]struct{},
+) ([]RepairAction, error) {
+	if _, err := os.Stat(p.shardPath); err != nil {
+		if os.IsNotExist(err) {
+			// the shard was never written on this node
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var actions []RepairAction
+	for _, task := range RepairTasks {
+		if !hasRepairTask(tasks, task) {
+			continue
+		}
+
+		var taskActions []RepairAction
+		var err error
+		switch task {
+		case RepairCommitLogs:
+			taskActions, err = p.planCommitLogs()
+		case RepairSetToRoaringSet:
+			taskActions, err = p.planSetToRoaringSet(ctx)
+		case RepairMissingTextFilterable:
+			taskActions, err = p.planMissingTextFilterable(ctx, missingFilterable)
+		case RepairPropertyLengths:
+			taskActions, err = p.planObjectsScan(ctx, task, "recount property lengths of %d objects")
+		case RepairVectorDimensions:
+			taskActions, err = p.planVectorDimensions(ctx)
+		case RepairHNSW:
+			taskActions, err = p.planHNSW(ctx)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, string(task))
+		}
+		actions = append(actions, taskActions...)
+	}
+	return actions, nil
+}
+
+func (p *shardRepairPlanner) planCommitLogs() ([]RepairAction, error) {
+	indexes, err := hnswIndexIDs(p.shardPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []RepairAction
+	for _, id := range indexes {
+		corrupt, err := hnsw.FindCorruptCommitLogs(p.shardPath, id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "vector index %q", id)
+		}
+		for _, fileName := range corrupt {
+			actions = append(actions, p.action(RepairCommitLogs,
+				"delete interrupted condensing %s", path.Base(fileName)))
+		}
+	}
+	return actions, nil
+}
+
+// hnswIndexIDs returns the ids of the hnsw indexes with a commit log
+// directory in the shard, including all generations
+func hnswIndexIDs(shardPath string) ([]string, error) {
+	entries, err := os.ReadDir(shardPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "read shard directory")
+	}
+
+	suffix := ".hnsw.commitlog.d"
+	var ids []string
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasSuffix(entry.Name(), suffix) {
+			ids = append(ids, strings.TrimSuffix(entry.Name(), suffix))
+		}
+	}
+	return ids, nil
+}
+
+func (p *shardRepairPlanner) planSetToRoaringSet(ctx context.Context) ([]RepairAction, error) {
+	store, err := p.readOnlyStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for name, bucket := range store.GetBucketsByName() {
+		if bucket.Strategy() != lsmkv.StrategySetCollection {
+			continue
+		}
+		// same index types as ShardInvertedReindexTaskSetToRoaringSet. The
+		// desired strategy isn't known without loading the shard, but the id
+		// bucket is the only one which is meant to be a set.
+		propName, indexType := GetPropNameAndIndexTypeFromBucketName(name)
+		if propName == filters.InternalPropID {
+			continue
+		}
+		switch indexType {
+		case IndexTypePropValue, IndexTypePropLength, IndexTypePropNull:
+			names = append(names, name)
+		default:
+		}
+	}
+	sort.Strings(names)
+
+	actions := make([]RepairAction, len(names))
+	for i, name := range names {
+		actions[i] = p.action(RepairSetToRoaringSet, "convert bucket %s to %s", name, lsmkv.StrategyRoaringSet)
+	}
+	return actions, nil
+}
+
+func (p *shardRepairPlanner) planMissingTextFilterable(ctx context.Context,
+	props map[string]struct{},
+) ([]RepairAction, error) {
+	if len(props) == 0 {
+		return nil, nil
+	}
+	store, err := p.readOnlyStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var actions []RepairAction
+	for _, propName := range names {
+		// same conditions as shardInvertedReindexTaskMissingTextFilterable
+		searchable := store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+		filterable := store.Bucket(helpers.BucketFromPropNameLSM(propName))
+		if searchable == nil || searchable.Strategy() != lsmkv.StrategyMapCollection {
+			continue
+		}
+		if filterable == nil || filterable.Strategy() == lsmkv.StrategyRoaringSet {
+			actions = append(actions, p.action(RepairMissingTextFilterable,
+				"create filterable index of property %s", propName))
+		}
+	}
+	return actions, nil
+}
+
+func (p *shardRepairPlanner) planObjectsScan(ctx context.Context, task RepairTask,
+	format string,
+) ([]RepairAction, error) {
+	store, err := p.readOnlyStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects := store.Bucket(helpers.ObjectsBucketLSM)
+	if objects == nil {
+		return nil, nil
+	}
+	return []RepairAction{p.action(task, format, objects.Count())}, nil
+}
+
+func (p *shardRepairPlanner) planVectorDimensions(ctx context.Context) ([]RepairAction, error) {
+	store, err := p.readOnlyStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if store.Bucket(helpers.DimensionsBucketLSM) == nil {
+		// vector dimensions were not tracked
+		return nil, nil
+	}
+	return p.planObjectsScan(ctx, RepairVectorDimensions, "recompute vector dimensions of %d objects")
+}
+
+func (p *shardRepairPlanner) planHNSW(ctx context.Context) ([]RepairAction, error) {
+	var targetVectors []string
+	if len(p.class.VectorConfig) > 0 {
+		for name, cfg := range p.class.VectorConfig {
+			if isHNSWIndex(cfg.VectorIndexConfig) {
+				targetVectors = append(targetVectors, name)
+			}
+		}
+		sort.Strings(targetVectors)
+	} else if isHNSWIndex(p.class.VectorIndexConfig) {
+		targetVectors = []string{""}
+	}
+	if len(targetVectors) == 0 {
+		return nil, nil
+	}
+
+	store, err := p.readOnlyStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	objects := store.Bucket(helpers.ObjectsBucketLSM)
+	if objects == nil {
+		return nil, nil
+	}
+
+	actions := make([]RepairAction, len(targetVectors))
+	for i, targetVector := range targetVectors {
+		name := targetVector
+		if name == "" {
+			name = "default"
+		}
+		actions[i] = p.action(RepairHNSW, "rebuild vector index %s from %d objects", name, objects.Count())
+	}
+	return actions, nil
+}
+
+func isHNSWIndex(cfg interface{}) bool {
+	parsed, ok := cfg.(schemaConfig.VectorIndexConfig)
+	return ok && parsed.IndexType() == vectorindex.VectorIndexTypeHNSW
+}
+
+// Repair runs the tasks on the local shards of the schema. Weaviate must not
+// be running on the data path, Repair refuses to run if another process holds
+// the lock of the data path, see LockDataPath. The repair is planned with
+// PlanRepair first, and only the shards which need changes are loaded. The
+// planned actions are returned once they are done.
+func Repair(ctx context.Context, config Config, sg schemaUC.SchemaGetter,
+	tasks []RepairTask, logger logrus.FieldLogger,
+) ([]RepairAction, error) {
+	lock, err := LockDataPath(config.RootPath)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	planned, err := PlanRepair(ctx, config.RootPath, sg, tasks, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// commit logs are repaired before the vector indexes are loaded, as
+	// loading them would repair them as well
+	loadShards := map[string]map[string]struct{}{}
+	for _, action := range planned {
+		if action.Task == RepairCommitLogs {
+			continue
+		}
+		if loadShards[action.Class] == nil {
+			loadShards[action.Class] = map[string]struct{}{}
+		}
+		loadShards[action.Class][action.Shard] = struct{}{}
+	}
+	if hasRepairTask(tasks, RepairCommitLogs) {
+		if err := repairCommitLogs(config.RootPath, planned, logger); err != nil {
+			return nil, err
+		}
+	}
+	if len(loadShards) == 0 {
+		return planned, nil
+	}
+
+	config.DisableLazyLoadShards = true
+	// the dimensions bucket is loaded by the repair only for shards which
+	// had it, so tracking isn't enabled for the others
+	config.TrackVectorDimensions = false
+	db, err := New(logger, config, nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "create db")
+	}
+	db.SetSchemaGetter(&repairSchemaGetter{SchemaGetter: sg, shards: loadShards})
+	if err := db.WaitForStartup(ctx); err != nil {
+		return nil, errors.Wrap(err, "load shards")
+	}
+
+	err = db.repairShards(ctx, planned)
+	if shutdownErr := db.Shutdown(context.Background()); shutdownErr != nil && err == nil {
+		err = errors.Wrap(shutdownErr, "shut down db")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return planned, nil
+}
+
+func repairCommitLogs(rootPath string, planned []RepairAction, logger logrus.FieldLogger) error {
+	done := map[string]struct{}{}
+	for _, action := range planned {
+		if action.Task != RepairCommitLogs {
+			continue
+		}
+		shardPath := shardPath(path.Join(rootPath, indexID(schema.ClassName(action.Class))), action.Shard)
+		if _, ok := done[shardPath]; ok {
+			continue
+		}
+		done[shardPath] = struct{}{}
+
+		ids, err := hnswIndexIDs(shardPath)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if _, err := hnsw.FixCorruptCommitLogs(shardPath, id, logger); err != nil {
+				return errors.Wrapf(err, "repair commit logs of shard %q of class %q", action.Shard, action.Class)
+			}
+		}
+	}
+	return nil
+}
+
+func (db *DB) repairShards(ctx context.Context, planned []RepairAction) error {
+	type shardTask struct {
+		task         RepairTask
+		class, shard string
+	}
+	var todo []shardTask
+	seen := map[shardTask]struct{}{}
+	for _, action := range planned {
+		st := shardTask{task: action.Task, class: action.Class, shard: action.Shard}
+		if _, ok := seen[st]; ok || st.task == RepairCommitLogs {
+			continue
+		}
+		seen[st] = struct{}{}
+		todo = append(todo, st)
+	}
+
+	var missingFilterable *shardInvertedReindexTaskMissingTextFilterable
+	missingFilterableClasses := map[string]struct{}{}
+	for _, st := range todo {
+		index := db.GetIndex(schema.ClassName(st.class))
+		if index == nil {
+			return fmt.Errorf("class %q not loaded", st.class)
+		}
+		shard, ok := index.shards.Load(st.shard).(*Shard)
+		if !ok {
+			return fmt.Errorf("shard %q of class %q not loaded", st.shard, st.class)
+		}
+		logger := db.logger.WithFields(logrus.Fields{
+			"action": "repair",
+			"task":   st.task,
+			"class":  st.class,
+			"shard":  st.shard,
+		})
+		logger.Info("repairing shard")
+
+		var err error
+		switch st.task {
+		case RepairSetToRoaringSet:
+			reindexer := NewShardInvertedReindexer(shard, logger)
+			reindexer.AddTask(&ShardInvertedReindexTaskSetToRoaringSet{})
+			err = reindexer.Do(ctx)
+		case RepairMissingTextFilterable:
+			if missingFilterable == nil {
+				missingFilterable = newShardInvertedReindexTaskMissingTextFilterable(NewMigrator(db, db.logger))
+				if err := missingFilterable.init(); err != nil {
+					return errors.Wrap(err, "init missing text filterable task")
+				}
+			}
+			reindexer := NewShardInvertedReindexer(shard, logger)
+			reindexer.AddTask(missingFilterable)
+			err = reindexer.Do(ctx)
+			missingFilterableClasses[st.class] = struct{}{}
+		case RepairPropertyLengths:
+			err = shard.recountPropertyLengths(ctx)
+		case RepairVectorDimensions:
+			err = shard.recomputeDimensions(ctx)
+		case RepairHNSW:
+			err = shard.rebuildVectorIndexes(ctx)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "%s on shard %q of class %q", st.task, st.shard, st.class)
+		}
+	}
+
+	for className := range missingFilterableClasses {
+		if err := missingFilterable.updateMigrationStateAndSave(className); err != nil {
+			return errors.Wrapf(err, "update migration state of class %q", className)
+		}
+	}
+	return nil
+}
+
+// recountPropertyLengths replaces the property lengths with the ones of the
+// objects in the shard
+func (s *Shard) recountPropertyLengths(ctx context.Context) error {
+	tracker := s.GetPropertyLengthTracker()
+	tracker.Clear()
+
+	if err := scanObjects(ctx, s.store, func(objs []*storobj.Object) error {
+		for _, obj := range objs {
+			props, _, err := s.AnalyzeObject(obj)
+			if err != nil {
+				return errors.Wrapf(err, "analyze object %d", obj.DocID)
+			}
+			if err := s.SetPropertyLengths(props); err != nil {
+				return errors.Wrapf(err, "add property lengths of object %d", obj.DocID)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return tracker.Flush()
+}
+
+// recomputeDimensions fills a new dimensions bucket from the objects in the
+// shard, which replaces the current one
+func (s *Shard) recomputeDimensions(ctx context.Context) error {
+	if err := s.store.CreateOrLoadBucket(ctx, helpers.DimensionsBucketLSM,
+		s.dimensionsBucketOptions()...); err != nil {
+		return errors.Wrap(err, "load dimensions bucket")
+	}
+
+	tempName := helpers.TempBucketFromBucketName(helpers.DimensionsBucketLSM)
+	if err := s.store.CreateBucket(ctx, tempName, s.dimensionsBucketOptions()...); err != nil {
+		return errors.Wrap(err, "create temporary dimensions bucket")
+	}
+	temp := s.store.Bucket(tempName)
+
+	targetVectors := s.hasTargetVectors()
+	if err := scanObjects(ctx, s.store, func(objs []*storobj.Object) error {
+		for _, obj := range objs {
+			if !targetVectors {
+				if err := addDimensions(temp, len(obj.Vector), obj.DocID, "", false); err != nil {
+					return err
+				}
+				continue
+			}
+			for vecName, vec := range obj.Vectors {
+				if err := addDimensions(temp, len(vec), obj.DocID, vecName, false); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := temp.FlushMemtable(); err != nil {
+		return errors.Wrap(err, "flush temporary dimensions bucket")
+	}
+	return s.store.ReplaceBuckets(ctx, helpers.DimensionsBucketLSM, tempName)
+}
+
+// rebuildVectorIndexes rebuilds all hnsw indexes of the shard, one after the
+// other
+func (s *Shard) rebuildVectorIndexes(ctx context.Context) error {
+	indexes := map[string]VectorIndex{"": s.VectorIndex()}
+	if s.hasTargetVectors() {
+		indexes = s.VectorIndexes()
+	}
+
+	names := make([]string, 0, len(indexes))
+	for name := range indexes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		rebuildable, ok := indexes[name].(*rebuildableVectorIndex)
+		if !ok {
+			continue
+		}
+		if err := rebuildable.rebuildNow(ctx); err != nil {
+			return errors.Wrapf(err, "rebuild vector index %q", name)
+		}
+	}
+	return nil
+}
+
+// repairSchemaGetter only exposes the classes and shards which are repaired,
+// so no other shards are loaded
+type repairSchemaGetter struct {
+	schemaUC.SchemaGetter
+	shards map[string]map[string]struct{}
+}
+
+func (g *repairSchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	sch := g.SchemaGetter.GetSchemaSkipAuth()
+	if sch.Objects == nil {
+		return sch
+	}
+
+	objects := *sch.Objects
+	objects.Classes = nil
+	for _, class := range sch.Objects.Classes {
+		if _, ok := g.shards[class.Class]; ok {
+			objects.Classes = append(objects.Classes, class)
+		}
+	}
+	sch.Objects = &objects
+	return sch
+}
+
+func (g *repairSchemaGetter) CopyShardingState(class string) *sharding.State {
+	state := g.SchemaGetter.CopyShardingState(class)
+	if state == nil {
+		return nil
+	}
+	filtered := *state
+	filtered.Physical = map[string]sharding.Physical{}
+	for name, physical := range state.Physical {
+		if _, ok := g.shards[class][name]; ok {
+			filtered.Physical[name] = physical
+		}
+	}
+	return &filtered
+}
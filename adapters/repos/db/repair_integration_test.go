@@ -0,0 +1,182 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestRepair(t *testing.T) {
+	dirName := t.TempDir()
+	logger := logrus.New()
+	config := Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       100,
+		MaxImportGoroutinesFactor: 1,
+		TrackVectorDimensions:     true,
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	class := &models.Class{
+		Class:               "RepairTest",
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		ReplicationConfig:   &models.ReplicationConfig{Factor: 1},
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+			},
+		},
+	}
+	shardName := schemaGetter.shardState.AllLocalPhysicalShards()[0]
+	shardDir := shardPath(filepath.Join(dirName, indexID(schema.ClassName(class.Class))), shardName)
+	dim := 4
+
+	openDB := func(t *testing.T) *DB {
+		repo, err := New(logger, config, &fakeRemoteClient{}, &fakeNodeResolver{},
+			&fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(schemaGetter)
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	t.Run("import objects without tracking dimensions", func(t *testing.T) {
+		repo := openDB(t)
+		require.Nil(t, NewMigrator(repo, logger).AddClass(context.Background(), class, schemaGetter.shardState))
+		schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+
+		repo.config.TrackVectorDimensions = false
+		for i := 0; i < 10; i++ {
+			obj := &models.Object{
+				Class:      class.Class,
+				ID:         strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String()),
+				Properties: map[string]interface{}{"name": "one two three"},
+			}
+			vec := make([]float32, dim)
+			vec[i%dim] = 1
+			require.Nil(t, repo.PutObject(context.Background(), obj, vec, nil, nil, 0))
+		}
+		require.Nil(t, repo.Shutdown(context.Background()))
+	})
+
+	var condensed string
+	t.Run("damage the shard", func(t *testing.T) {
+		require.Nil(t, os.Remove(filepath.Join(shardDir, "proplengths")))
+
+		logDir := filepath.Join(shardDir, "main.hnsw.commitlog.d")
+		entries, err := os.ReadDir(logDir)
+		require.Nil(t, err)
+		require.NotEmpty(t, entries)
+		name := entries[len(entries)-1].Name()
+		data, err := os.ReadFile(filepath.Join(logDir, name))
+		require.Nil(t, err)
+		condensed = filepath.Join(logDir, name+".condensed")
+		require.Nil(t, os.WriteFile(condensed, data, 0o666))
+	})
+
+	t.Run("plan repair", func(t *testing.T) {
+		before := listFiles(t, dirName)
+
+		actions, err := PlanRepair(context.Background(), dirName, schemaGetter, RepairTasks, logger)
+		require.Nil(t, err)
+
+		tasks := map[RepairTask]string{}
+		for _, action := range actions {
+			assert.Equal(t, class.Class, action.Class)
+			assert.Equal(t, shardName, action.Shard)
+			tasks[action.Task] = action.Detail
+		}
+		assert.Equal(t, map[RepairTask]string{
+			RepairCommitLogs:       "delete interrupted condensing " + filepath.Base(condensed),
+			RepairPropertyLengths:  "recount property lengths of 10 objects",
+			RepairVectorDimensions: "recompute vector dimensions of 10 objects",
+			RepairHNSW:             "rebuild vector index default from 10 objects",
+		}, tasks)
+
+		assert.Equal(t, before, listFiles(t, dirName), "planning must not modify files")
+	})
+
+	t.Run("repair", func(t *testing.T) {
+		actions, err := Repair(context.Background(), config, schemaGetter, RepairTasks, logger)
+		require.Nil(t, err)
+		assert.Len(t, actions, 4)
+
+		_, err = os.Stat(condensed)
+		assert.True(t, os.IsNotExist(err))
+
+		state, ok, err := readHNSWBuildState(hnswBuildStatePath(shardDir, "main"))
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, 1, state.Generation)
+	})
+
+	t.Run("repaired shard", func(t *testing.T) {
+		repo := openDB(t)
+		defer repo.Shutdown(context.Background())
+
+		shards := repo.GetIndex(schema.ClassName(class.Class)).GetShards()
+		require.Len(t, shards, 1)
+		shard := shards[0]
+
+		assert.Equal(t, 10*dim, shard.Dimensions(context.Background()))
+		mean, err := shard.GetPropertyLengthTracker().PropertyMean("name")
+		require.Nil(t, err)
+		assert.Equal(t, float32(3), mean)
+
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{1, 0, 0, 0},
+			Pagination:   &filters.Pagination{Limit: 10},
+		})
+		require.Nil(t, err)
+		assert.Len(t, res, 10)
+	})
+}
+
+func listFiles(t *testing.T, root string) map[string]int64 {
+	files := map[string]int64{}
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			files[path] = info.Size()
+		}
+		return nil
+	})
+	require.Nil(t, err)
+	return files
+}
@@ -38,10 +38,12 @@ type Replicator interface {
 		object *storobj.Object) replica.SimpleResponse
 	ReplicateObjects(ctx context.Context, shardName, requestID string,
 		objects []*storobj.Object) replica.SimpleResponse
+	ReplicateObjectsTransactional(ctx context.Context, shardName, requestID string,
+		objects []*storobj.Object, expectedVersions []*int64) replica.SimpleResponse
 	ReplicateUpdate(ctx context.Context, shard, requestID string,
 		doc *objects.MergeDocument) replica.SimpleResponse
 	ReplicateDeletion(ctx context.Context, shardName, requestID string,
-		uuid strfmt.UUID) replica.SimpleResponse
+		uuid strfmt.UUID, expectedVersion *int64) replica.SimpleResponse
 	ReplicateDeletions(ctx context.Context, shardName, requestID string,
 		uuids []strfmt.UUID, dryRun bool, schemaVersion uint64) replica.SimpleResponse
 	ReplicateReferences(ctx context.Context, shard, requestID string,
@@ -74,6 +76,17 @@ func (db *DB) ReplicateObjects(ctx context.Context, class,
 	return index.ReplicateObjects(ctx, shard, requestID, objects, schemaVersion)
 }
 
+func (db *DB) ReplicateObjectsTransactional(ctx context.Context, class,
+	shard, requestID string, objects []*storobj.Object, expectedVersions []*int64, schemaVersion uint64,
+) replica.SimpleResponse {
+	index, pr := db.replicatedIndex(class)
+	if pr != nil {
+		return *pr
+	}
+
+	return index.ReplicateObjectsTransactional(ctx, shard, requestID, objects, expectedVersions, schemaVersion)
+}
+
 func (db *DB) ReplicateUpdate(ctx context.Context, class,
 	shard, requestID string, mergeDoc *objects.MergeDocument,
 ) replica.SimpleResponse {
@@ -86,14 +99,14 @@ func (db *DB) ReplicateUpdate(ctx context.Context, class,
 }
 
 func (db *DB) ReplicateDeletion(ctx context.Context, class,
-	shard, requestID string, uuid strfmt.UUID,
+	shard, requestID string, uuid strfmt.UUID, expectedVersion *int64,
 ) replica.SimpleResponse {
 	index, pr := db.replicatedIndex(class)
 	if pr != nil {
 		return *pr
 	}
 
-	return index.ReplicateDeletion(ctx, shard, requestID, uuid)
+	return index.ReplicateDeletion(ctx, shard, requestID, uuid, expectedVersion)
 }
 
 func (db *DB) ReplicateDeletions(ctx context.Context, class,
@@ -140,6 +153,57 @@ func (db *DB) AbortReplication(class,
 	return index.AbortReplication(shard, requestID)
 }
 
+// SyncShardReplica pushes the objects of a local shard which are missing or
+// outdated on targetNode and deletes the objects deleted since the snapshot,
+// see TrackShardDeletions. It is used to catch up a replica created from a
+// snapshot of the shard while writes kept coming in.
+func (db *DB) SyncShardReplica(ctx context.Context, class, shard, targetNode string) error {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return enterrors.NewErrNotFound(fmt.Errorf("class %q not found", class))
+	}
+	host, ok := db.nodeResolver.NodeHostname(targetNode)
+	if !ok || host == "" {
+		return fmt.Errorf("cannot resolve node name: %s", targetNode)
+	}
+
+	localShard, release, err := idx.getOrInitLocalShardNoShutdown(ctx, shard)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	n, err := localShard.syncReplica(ctx, host)
+	if err != nil {
+		return fmt.Errorf("sync shard %q with node %q: %w", shard, targetNode, err)
+	}
+	db.logger.WithField("action", "sync_shard_replica").
+		WithField("class", class).
+		WithField("shard", shard).
+		WithField("target_node", targetNode).
+		WithField("objects_propagated", n).
+		Debug("shard replica synced")
+	return nil
+}
+
+// TrackShardDeletions starts or stops recording the objects deleted from a
+// local shard, they are deleted on the target of a transfer by
+// SyncShardReplica. It must be started before the shard is copied.
+func (db *DB) TrackShardDeletions(ctx context.Context, class, shard string, track bool) error {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return enterrors.NewErrNotFound(fmt.Errorf("class %q not found", class))
+	}
+
+	localShard, release, err := idx.getOrInitLocalShardNoShutdown(ctx, shard)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return localShard.trackDeletions(ctx, track)
+}
+
 func (db *DB) replicatedIndex(name string) (idx *Index, resp *replica.SimpleResponse) {
 	if !db.StartupComplete() {
 		return nil, &replica.SimpleResponse{Errors: []replica.Error{
@@ -186,12 +250,14 @@ func (i *Index) ReplicateUpdate(ctx context.Context, shard, requestID string, do
 	return localShard.prepareMergeObject(ctx, requestID, doc)
 }
 
-func (i *Index) ReplicateDeletion(ctx context.Context, shard, requestID string, uuid strfmt.UUID) replica.SimpleResponse {
+func (i *Index) ReplicateDeletion(ctx context.Context, shard, requestID string, uuid strfmt.UUID,
+	expectedVersion *int64,
+) replica.SimpleResponse {
 	localShard, pr := i.writableShard(shard)
 	if pr != nil {
 		return *pr
 	}
-	return localShard.prepareDeleteObject(ctx, requestID, uuid)
+	return localShard.prepareDeleteObject(ctx, requestID, uuid, expectedVersion)
 }
 
 func (i *Index) ReplicateObjects(ctx context.Context, shard, requestID string, objects []*storobj.Object, schemaVersion uint64) replica.SimpleResponse {
@@ -202,6 +268,16 @@ func (i *Index) ReplicateObjects(ctx context.Context, shard, requestID string, o
 	return localShard.preparePutObjects(ctx, requestID, objects)
 }
 
+func (i *Index) ReplicateObjectsTransactional(ctx context.Context, shard, requestID string,
+	objects []*storobj.Object, expectedVersions []*int64, schemaVersion uint64,
+) replica.SimpleResponse {
+	localShard, pr := i.writableShard(shard)
+	if pr != nil {
+		return *pr
+	}
+	return localShard.preparePutObjectsTransactional(ctx, requestID, objects, expectedVersions)
+}
+
 func (i *Index) ReplicateDeletions(ctx context.Context, shard, requestID string, uuids []strfmt.UUID, dryRun bool, schemaVersion uint64) replica.SimpleResponse {
 	localShard, pr := i.writableShard(shard)
 	if pr != nil {
@@ -320,6 +396,13 @@ func (i *Index) OverwriteObjects(ctx context.Context,
 		return nil, fmt.Errorf("shard %q not found locally", shard)
 	}
 	for i, u := range updates {
+		if u.Deleted {
+			if r := overwriteDeletion(ctx, s, u); r != nil {
+				result = append(result, *r)
+			}
+			continue
+		}
+
 		// Just in case but this should not happen
 		data := u.LatestObject
 		if data == nil || data.ID == "" {
@@ -362,6 +445,22 @@ func (i *Index) OverwriteObjects(ctx context.Context,
 	return result, nil
 }
 
+// overwriteDeletion deletes the object if it was not changed since
+// u.StaleUpdateTime, it returns nil on success
+func overwriteDeletion(ctx context.Context, s ShardLike, u *objects.VObject) *replica.RepairResponse {
+	err := s.DeleteObject(ctx, u.ID, &u.StaleUpdateTime)
+	if err == nil {
+		return nil
+	}
+	r := &replica.RepairResponse{ID: u.ID.String()}
+	if errors.As(err, &objects.ErrPreconditionFailed{}) {
+		r.Err = "conflict"
+	} else {
+		r.Err = fmt.Sprintf("delete stale object: %v", err)
+	}
+	return r
+}
+
 func (i *Index) IncomingOverwriteObjects(ctx context.Context,
 	shardName string, vobjects []*objects.VObject,
 ) ([]replica.RepairResponse, error) {
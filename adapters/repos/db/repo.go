@@ -26,6 +26,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcheckpoint"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
 	"github.com/weaviate/weaviate/cluster/utils"
 	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -36,6 +38,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/replica"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	"golang.org/x/time/rate"
 )
 
 type DB struct {
@@ -53,6 +56,8 @@ type DB struct {
 	startupComplete   atomic.Bool
 	resourceScanState *resourceScanState
 	memMonitor        *memwatch.Monitor
+	vectorCacheBudget *cache.Budget
+	lsmTier           *lsmkv.RemoteTier
 
 	// indexLock is an RWMutex which allows concurrent access to various indexes,
 	// but only one modification at a time. R/W can be a bit confusing here,
@@ -87,6 +92,10 @@ type DB struct {
 	// in the case of metrics grouping we need to observe some metrics
 	// node-centric, rather than shard-centric
 	metricsObserver *nodeWideMetricsObserver
+
+	// the rate limits of vector reindexing jobs apply to all shards of a node
+	vectorReindexLock     sync.Mutex
+	vectorReindexLimiters map[string]*rate.Limiter
 }
 
 func (db *DB) GetSchemaGetter() schemaUC.SchemaGetter {
@@ -159,6 +168,18 @@ func New(logger logrus.FieldLogger, config Config,
 	if db.maxNumberGoroutines == 0 {
 		return db, errors.New("no workers to add batch-jobs configured.")
 	}
+	if config.VectorCacheBudget > 0 {
+		db.vectorCacheBudget = cache.NewBudget(cache.BudgetConfig{
+			Limit:             config.VectorCacheBudget,
+			IdleTimeout:       config.VectorCacheBudgetIdleTimeout,
+			AllocChecker:      memMonitor,
+			Logger:            logger,
+			PrometheusMetrics: promMetrics,
+		})
+	}
+	if err := db.initLSMTier(); err != nil {
+		return db, errors.Wrap(err, "init lsm remote tier")
+	}
 	if !asyncEnabled() {
 		db.jobQueueCh = make(chan job, 100000)
 		db.shutDownWg.Add(db.maxNumberGoroutines)
@@ -198,12 +219,29 @@ type Config struct {
 	MemtablesMaxActiveSeconds int
 	MaxSegmentSize            int64
 	HNSWMaxLogSize            int64
+	HNSWSnapshotInterval      time.Duration
+	LSMScrubInterval          time.Duration
+	LSMScrubRepair            bool
+	LSMCompactionPolicy       string
+	LSMCompactionFanOut       int
 	TrackVectorDimensions     bool
 	ServerVersion             string
 	GitHash                   string
 	AvoidMMap                 bool
 	DisableLazyLoadShards     bool
 	Replication               replication.GlobalConfig
+	// VectorCacheBudget is the memory in bytes the vector caches of all shards
+	// may use together, 0 limits every cache on its own
+	VectorCacheBudget            int64
+	VectorCacheBudgetIdleTimeout time.Duration
+	// LSMTierStore, if set, holds the segments which qualify for the
+	// LSMTierPolicy, they are read through a cache of LSMTierCacheSize bytes
+	LSMTierStore     func() (lsmkv.RemoteStore, error)
+	LSMTierPolicy    lsmkv.TierPolicy
+	LSMTierCacheSize int64
+	// TrackTenantActivity records when the tenants of the node were last used,
+	// even if metrics are not grouped, see LocalTenantActivity
+	TrackTenantActivity bool
 }
 
 // GetIndex returns the index if it exists or nil if it doesn't
@@ -322,6 +360,8 @@ func (db *DB) Shutdown(ctx context.Context) error {
 		db.indexCheckpoints.Close()
 	}
 
+	db.vectorCacheBudget.Shutdown()
+
 	return nil
 }
 
@@ -22,32 +22,33 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 )
 
-// Compactor takes in a left and a right segment and merges them into a single
-// segment. The input segments are represented by cursors without their
+// Compactor takes in a run of consecutive segments and merges them into a
+// single segment. The input segments are represented by cursors without their
 // respective segmentindexes. A new segmentindex is built from the merged nodes
 // without taking the old indexes into account at all.
 //
-// The left segment must precede the right one in its creation time, as the
-// compactor applies latest-takes-presence rules when there is a conflict.
+// The cursors must be ordered by the creation time of their segments, oldest
+// first, as the compactor applies latest-takes-presence rules when there is a
+// conflict.
 //
 // # Merging independent key/value pairs
 //
 // The new segment's nodes will be in sorted fashion (this is a requirement for
 // the segment index and segment cursors to function). To achieve a sorted end
-// result, the Compactor goes over both input cursors simultaneously and always
-// works on the smaller of the two keys. After a key/value pair has been added
-// to the output only the input cursor that provided the pair is advanced.
+// result, the Compactor goes over all input cursors simultaneously and always
+// works on the smallest of their keys. After a key/value pair has been added
+// to the output only the input cursors that provided the pair are advanced.
 //
 // # Merging key/value pairs with identical keys
 //
-// When both segment have a key/value pair with an overlapping key, the value
-// has to be merged. The merge logic is not part of the compactor itself.
+// When several segments have a key/value pair with an overlapping key, the
+// value has to be merged. The merge logic is not part of the compactor itself.
 // Instead it makes use of [BitmapLayers.Merge].
 //
 // # Exit Criterium
 //
-// When both cursors no longer return values, all key/value pairs are
-// considered compacted. The compactor then deals with metadata.
+// When no cursor returns values anymore, all key/value pairs are considered
+// compacted. The compactor then deals with metadata.
 //
 // # Index and Header metadata
 //
@@ -57,11 +58,11 @@ import (
 // of the file. Because of this, the input writer must be an [io.WriteSeeker],
 // such as [*os.File].
 //
-// The level of the resulting segment is the input level increased by one.
+// The level of the resulting segment is the level passed in by the caller.
 // Levels help the "eligible for compaction" cycle to find suitable compaction
 // pairs.
 type Compactor struct {
-	left, right  *SegmentCursor
+	cursors      []*SegmentCursor
 	currentLevel uint16
 	// Tells if deletions or keys without corresponding values
 	// can be removed from merged segment.
@@ -70,26 +71,28 @@ type Compactor struct {
 
 	w    io.WriteSeeker
 	bufw *bufio.Writer
+	cw   *segmentindex.ChecksumWriter
 
 	scratchSpacePath string
 }
 
-// NewCompactor from left (older) and right (newer) seeker. See [Compactor] for
-// an explanation of what goes on under the hood, and why the input
-// requirements are the way they are.
+// NewCompactor from cursors ordered from the oldest to the newest segment.
+// See [Compactor] for an explanation of what goes on under the hood, and why
+// the input requirements are the way they are.
 func NewCompactor(w io.WriteSeeker,
-	left, right *SegmentCursor, level uint16,
+	cursors []*SegmentCursor, level uint16,
 	scratchSpacePath string, cleanupDeletions bool,
 ) *Compactor {
-	return &Compactor{
-		left:             left,
-		right:            right,
+	c := &Compactor{
+		cursors:          cursors,
 		w:                w,
 		bufw:             bufio.NewWriterSize(w, 256*1024),
 		currentLevel:     level,
 		cleanupDeletions: cleanupDeletions,
 		scratchSpacePath: scratchSpacePath,
 	}
+	c.cw = segmentindex.NewChecksumWriter(c.bufw)
+	return c
 }
 
 // Do starts a compaction. See [Compactor] for an explanation of this process.
@@ -107,18 +110,28 @@ func (c *Compactor) Do() error {
 		return fmt.Errorf("write index: %w", err)
 	}
 
-	// flush buffered, so we can safely seek on underlying writer
-	if err := c.bufw.Flush(); err != nil {
-		return fmt.Errorf("flush buffered: %w", err)
-	}
-
 	var dataEnd uint64 = segmentindex.HeaderSize
 	if len(kis) > 0 {
 		dataEnd = uint64(kis[len(kis)-1].ValueEnd)
 	}
 
-	if err := c.writeHeader(c.currentLevel, 0, 0,
-		dataEnd); err != nil {
+	h := &segmentindex.Header{
+		Level:      c.currentLevel,
+		Version:    segmentindex.CurrentSegmentVersion,
+		Strategy:   segmentindex.StrategyRoaringSet,
+		IndexStart: dataEnd,
+	}
+
+	if _, err := c.cw.WriteChecksums(h); err != nil {
+		return fmt.Errorf("write checksums: %w", err)
+	}
+
+	// flush buffered, so we can safely seek on underlying writer
+	if err := c.bufw.Flush(); err != nil {
+		return fmt.Errorf("flush buffered: %w", err)
+	}
+
+	if err := c.writeHeader(h); err != nil {
 		return fmt.Errorf("write header: %w", err)
 	}
 
@@ -130,7 +143,7 @@ func (c *Compactor) init() error {
 	// we will seek to the beginning and overwrite the actual header at the very
 	// end
 
-	if _, err := c.bufw.Write(make([]byte, segmentindex.HeaderSize)); err != nil {
+	if _, err := c.cw.Write(make([]byte, segmentindex.HeaderSize)); err != nil {
 		return errors.Wrap(err, "write empty header")
 	}
 
@@ -140,12 +153,14 @@ func (c *Compactor) init() error {
 // nodeCompactor is a helper type to improve the code structure of merging
 // nodes in a compaction
 type nodeCompactor struct {
-	left, right           *SegmentCursor
-	keyLeft, keyRight     []byte
-	valueLeft, valueRight BitmapLayer
-	output                []segmentindex.Key
-	offset                int
-	bufw                  *bufio.Writer
+	cursors []*SegmentCursor
+	keys    [][]byte
+	values  []BitmapLayer
+	// sources are the positions of the cursors holding the current key
+	sources []int
+	output  []segmentindex.Key
+	offset  int
+	bufw    io.Writer
 
 	cleanupDeletions bool
 	emptyBitmap      *sroar.Bitmap
@@ -153,9 +168,11 @@ type nodeCompactor struct {
 
 func (c *Compactor) writeNodes() ([]segmentindex.Key, error) {
 	nc := &nodeCompactor{
-		left:             c.left,
-		right:            c.right,
-		bufw:             c.bufw,
+		cursors:          c.cursors,
+		keys:             make([][]byte, len(c.cursors)),
+		values:           make([]BitmapLayer, len(c.cursors)),
+		sources:          make([]int, 0, len(c.cursors)),
+		bufw:             c.cw,
 		cleanupDeletions: c.cleanupDeletions,
 		emptyBitmap:      sroar.NewBitmap(),
 	}
@@ -170,8 +187,9 @@ func (c *Compactor) writeNodes() ([]segmentindex.Key, error) {
 }
 
 func (c *nodeCompactor) init() {
-	c.keyLeft, c.valueLeft, _ = c.left.First()
-	c.keyRight, c.valueRight, _ = c.right.First()
+	for i, cursor := range c.cursors {
+		c.keys[i], c.values[i], _ = cursor.First()
+	}
 
 	// the (dummy) header was already written, this is our initial offset
 	c.offset = segmentindex.HeaderSize
@@ -179,102 +197,68 @@ func (c *nodeCompactor) init() {
 
 func (c *nodeCompactor) loopThroughKeys() error {
 	for {
-		if c.keyLeft == nil && c.keyRight == nil {
+		key := c.smallestKey()
+		if key == nil {
 			return nil
 		}
 
-		if c.keysEqual() {
-			if err := c.mergeIdenticalKeys(); err != nil {
-				return err
-			}
-		} else if c.leftKeySmallerOrRightNotSet() {
-			if err := c.takeLeftKey(); err != nil {
-				return err
-			}
-		} else {
-			if err := c.takeRightKey(); err != nil {
-				return err
-			}
+		if err := c.takeKey(key); err != nil {
+			return err
 		}
-	}
-}
 
-func (c *nodeCompactor) keysEqual() bool {
-	return bytes.Equal(c.keyLeft, c.keyRight)
-}
-
-func (c *nodeCompactor) leftKeySmallerOrRightNotSet() bool {
-	return (c.keyLeft != nil && bytes.Compare(c.keyLeft, c.keyRight) == -1) || c.keyRight == nil
-}
-
-func (c *nodeCompactor) mergeIdenticalKeys() error {
-	layers := BitmapLayers{
-		{Additions: c.valueLeft.Additions, Deletions: c.valueLeft.Deletions},
-		{Additions: c.valueRight.Additions, Deletions: c.valueRight.Deletions},
-	}
-	merged, err := layers.Merge()
-	if err != nil {
-		return fmt.Errorf("merge bitmap layers for identical keys: %w", err)
+		for _, i := range c.sources {
+			c.keys[i], c.values[i], _ = c.cursors[i].Next()
+		}
 	}
+}
 
-	if additions, deletions, skip := c.cleanupValues(merged.Additions, merged.Deletions); !skip {
-		sn, err := NewSegmentNode(c.keyRight, additions, deletions)
-		if err != nil {
-			return fmt.Errorf("new segment node for merged key: %w", err)
+// smallestKey returns the smallest key of all cursors and sets sources to the
+// cursors positioned at it. It returns nil once all cursors are exhausted.
+func (c *nodeCompactor) smallestKey() []byte {
+	var key []byte
+	c.sources = c.sources[:0]
+	for i := range c.cursors {
+		if c.keys[i] == nil {
+			continue
 		}
-
-		ki, err := sn.KeyIndexAndWriteTo(c.bufw, c.offset)
-		if err != nil {
-			return fmt.Errorf("write individual node (merged key): %w", err)
+		switch cmp := bytes.Compare(c.keys[i], key); {
+		case key == nil || cmp < 0:
+			key = c.keys[i]
+			c.sources = append(c.sources[:0], i)
+		case cmp == 0:
+			c.sources = append(c.sources, i)
 		}
-
-		c.offset = ki.ValueEnd
-		c.output = append(c.output, ki)
 	}
-
-	// advance both!
-	c.keyLeft, c.valueLeft, _ = c.left.Next()
-	c.keyRight, c.valueRight, _ = c.right.Next()
-	return nil
+	return key
 }
 
-func (c *nodeCompactor) takeLeftKey() error {
-	if additions, deletions, skip := c.cleanupValues(c.valueLeft.Additions, c.valueLeft.Deletions); !skip {
-		sn, err := NewSegmentNode(c.keyLeft, additions, deletions)
-		if err != nil {
-			return fmt.Errorf("new segment node for left key: %w", err)
-		}
-
-		ki, err := sn.KeyIndexAndWriteTo(c.bufw, c.offset)
+func (c *nodeCompactor) takeKey(key []byte) error {
+	// merge the layers of identical keys one by one from the oldest to the
+	// newest segment
+	value := c.values[c.sources[0]]
+	for _, i := range c.sources[1:] {
+		merged, err := BitmapLayers{value, c.values[i]}.Merge()
 		if err != nil {
-			return fmt.Errorf("write individual node (left key): %w", err)
+			return fmt.Errorf("merge bitmap layers for identical keys: %w", err)
 		}
-
-		c.offset = ki.ValueEnd
-		c.output = append(c.output, ki)
+		value = merged
 	}
 
-	c.keyLeft, c.valueLeft, _ = c.left.Next()
-	return nil
-}
-
-func (c *nodeCompactor) takeRightKey() error {
-	if additions, deletions, skip := c.cleanupValues(c.valueRight.Additions, c.valueRight.Deletions); !skip {
-		sn, err := NewSegmentNode(c.keyRight, additions, deletions)
+	if additions, deletions, skip := c.cleanupValues(value.Additions, value.Deletions); !skip {
+		sn, err := NewSegmentNode(key, additions, deletions)
 		if err != nil {
-			return fmt.Errorf("new segment node for right key: %w", err)
+			return fmt.Errorf("new segment node: %w", err)
 		}
 
 		ki, err := sn.KeyIndexAndWriteTo(c.bufw, c.offset)
 		if err != nil {
-			return fmt.Errorf("write individual node (right key): %w", err)
+			return fmt.Errorf("write individual node: %w", err)
 		}
 
 		c.offset = ki.ValueEnd
 		c.output = append(c.output, ki)
 	}
 
-	c.keyRight, c.valueRight, _ = c.right.Next()
 	return nil
 }
 
@@ -296,28 +280,18 @@ func (c *Compactor) writeIndexes(keys []segmentindex.Key) error {
 		ScratchSpacePath:    c.scratchSpacePath,
 	}
 
-	_, err := indexes.WriteTo(c.bufw)
+	_, err := indexes.WriteTo(c.cw)
 	return err
 }
 
 // writeHeader assumes that everything has been written to the underlying
 // writer and it is now safe to seek to the beginning and override the initial
 // header
-func (c *Compactor) writeHeader(level, version, secondaryIndices uint16,
-	startOfIndex uint64,
-) error {
+func (c *Compactor) writeHeader(h *segmentindex.Header) error {
 	if _, err := c.w.Seek(0, io.SeekStart); err != nil {
 		return errors.Wrap(err, "seek to beginning to write header")
 	}
 
-	h := &segmentindex.Header{
-		Level:            level,
-		Version:          version,
-		SecondaryIndices: secondaryIndices,
-		Strategy:         segmentindex.StrategyRoaringSet,
-		IndexStart:       startOfIndex,
-	}
-
 	if _, err := h.WriteTo(c.w); err != nil {
 		return err
 	}
@@ -413,7 +413,7 @@ func Test_Compactor(t *testing.T) {
 			f, err := os.Create(segmentFile)
 			require.NoError(t, err)
 
-			c := NewCompactor(f, leftCursor, rightCursor, 5, dir+"/scratch", false)
+			c := NewCompactor(f, []*SegmentCursor{leftCursor, rightCursor}, 5, dir+"/scratch", false)
 			require.NoError(t, c.Do())
 
 			require.NoError(t, f.Close())
@@ -454,7 +454,7 @@ func Test_Compactor(t *testing.T) {
 			f, err := os.Create(segmentFile)
 			require.NoError(t, err)
 
-			c := NewCompactor(f, leftCursor, rightCursor, 5, dir+"/scratch", true)
+			c := NewCompactor(f, []*SegmentCursor{leftCursor, rightCursor}, 5, dir+"/scratch", true)
 			require.NoError(t, c.Do())
 
 			require.NoError(t, f.Close())
@@ -485,6 +485,61 @@ func Test_Compactor(t *testing.T) {
 	}
 }
 
+func Test_CompactorMultipleSegments(t *testing.T) {
+	dir := t.TempDir()
+
+	segments := [][]byte{
+		createSegmentsFromKeys(t, []keyWithBML{
+			{key: []byte("aaa"), additions: []uint64{1, 2}},
+			{key: []byte("ccc"), additions: []uint64{7}},
+		}),
+		createSegmentsFromKeys(t, []keyWithBML{
+			{key: []byte("aaa"), deletions: []uint64{2}},
+			{key: []byte("bbb"), additions: []uint64{5}},
+		}),
+		createSegmentsFromKeys(t, []keyWithBML{
+			{key: []byte("aaa"), additions: []uint64{2, 3}},
+			{key: []byte("bbb"), deletions: []uint64{5}},
+		}),
+	}
+	cursors := make([]*SegmentCursor, len(segments))
+	for i, segment := range segments {
+		cursors[i] = NewSegmentCursor(segment, nil)
+	}
+
+	segmentFile := filepath.Join(dir, "result.db")
+	f, err := os.Create(segmentFile)
+	require.NoError(t, err)
+
+	c := NewCompactor(f, cursors, 5, dir+"/scratch", false)
+	require.NoError(t, c.Do())
+	require.NoError(t, f.Close())
+
+	f, err = os.Open(segmentFile)
+	require.NoError(t, err)
+	header, err := segmentindex.ParseHeader(f)
+	require.NoError(t, err)
+	segmentBytes, err := io.ReadAll(f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	expected := []keyWithBML{
+		{key: []byte("aaa"), additions: []uint64{1, 2, 3}},
+		{key: []byte("bbb"), deletions: []uint64{5}},
+		{key: []byte("ccc"), additions: []uint64{7}},
+	}
+
+	cu := NewSegmentCursor(segmentBytes[:header.IndexStart-segmentindex.HeaderSize], nil)
+	i := 0
+	for k, v, _ := cu.First(); k != nil; k, v, _ = cu.Next() {
+		assert.Equal(t, expected[i].key, k)
+		assert.ElementsMatch(t, expected[i].additions, v.Additions.ToArray())
+		assert.ElementsMatch(t, expected[i].deletions, v.Deletions.ToArray())
+		i++
+	}
+	assert.Equal(t, len(expected), i, "all expected keys must have been hit")
+}
+
 type keyWithBML struct {
 	key       []byte
 	additions []uint64
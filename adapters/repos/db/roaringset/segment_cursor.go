@@ -25,9 +25,19 @@ type Seeker interface {
 type SegmentCursor struct {
 	index      Seeker
 	data       []byte
+	nodes      NodeReader
+	size       uint64
 	nextOffset uint64
 }
 
+// NodeReader reads the nodes of a segment whose payload is not held in a
+// continuous buffer, e.g. because it is compressed
+type NodeReader interface {
+	// ReadNode returns the node at offset, relative to the start of the
+	// payload
+	ReadNode(offset uint64) ([]byte, error)
+}
+
 // NewSegmentCursor creates a cursor for a single disk segment. Make sure that
 // the data buf is already sliced correctly to start at the payload, as calling
 // [*SegmentCursor.First] will start reading at offset 0 relative to the passed
@@ -37,15 +47,31 @@ type SegmentCursor struct {
 // Therefore if the payload is part of a longer continuous buffer, the cursor
 // should be initialized with data[payloadStartPos:payloadEndPos]
 func NewSegmentCursor(data []byte, index Seeker) *SegmentCursor {
-	return &SegmentCursor{index: index, data: data, nextOffset: 0}
+	return &SegmentCursor{index: index, data: data, size: uint64(len(data)), nextOffset: 0}
+}
+
+// NewSegmentCursorReader creates a cursor for a single disk segment whose
+// nodes are read with r, size is the size of the payload. Offsets are
+// relative to the payload like for [NewSegmentCursor].
+func NewSegmentCursorReader(r NodeReader, size uint64, index Seeker) *SegmentCursor {
+	return &SegmentCursor{index: index, nodes: r, size: size, nextOffset: 0}
 }
 
 func (c *SegmentCursor) Next() ([]byte, BitmapLayer, error) {
-	if c.nextOffset >= uint64(len(c.data)) {
+	if c.nextOffset >= c.size {
 		return nil, BitmapLayer{}, nil
 	}
 
-	sn := NewSegmentNodeFromBuffer(c.data[c.nextOffset:])
+	var sn *SegmentNode
+	if c.nodes != nil {
+		buf, err := c.nodes.ReadNode(c.nextOffset)
+		if err != nil {
+			return nil, BitmapLayer{}, err
+		}
+		sn = NewSegmentNodeFromBuffer(buf)
+	} else {
+		sn = NewSegmentNodeFromBuffer(c.data[c.nextOffset:])
+	}
 	c.nextOffset += sn.Len()
 	layer := BitmapLayer{
 		Additions: sn.Additions(),
@@ -106,7 +106,7 @@ func (db *DB) Search(ctx context.Context, params dto.GetParams) ([]search.Result
 func (db *DB) VectorSearch(ctx context.Context,
 	params dto.GetParams,
 ) ([]search.Result, error) {
-	if params.SearchVector == nil {
+	if params.SearchVector == nil && len(params.SearchVectors) == 0 {
 		return db.Search(ctx, params)
 	}
 
@@ -120,10 +120,20 @@ func (db *DB) VectorSearch(ctx context.Context,
 		return nil, fmt.Errorf("tried to browse non-existing index for %s", params.ClassName)
 	}
 
-	targetDist := extractDistanceFromParams(params)
-	res, dists, err := idx.objectVectorSearch(ctx, params.SearchVector, params.TargetVector,
-		targetDist, totalLimit, params.Filters, params.Sort, params.GroupBy,
-		params.AdditionalProperties, params.ReplicationProperties, params.Tenant)
+	var (
+		res        []*storobj.Object
+		dists      []float32
+		targetDist = extractDistanceFromParams(params)
+	)
+	if len(params.TargetVectors) > 1 {
+		res, dists, err = idx.objectMultiTargetVectorSearch(ctx, params.SearchVectors,
+			params.TargetVectors, params.TargetVectorCombination, targetDist, totalLimit,
+			params.Filters, params.AdditionalProperties, params.ReplicationProperties, params.Tenant)
+	} else {
+		res, dists, err = idx.objectVectorSearch(ctx, params.SearchVector, params.TargetVector,
+			targetDist, totalLimit, params.Filters, params.Sort, params.GroupBy,
+			params.AdditionalProperties, params.ReplicationProperties, params.Tenant)
+	}
 	if err != nil {
 		return nil, errors.Wrapf(err, "object vector search at index %s", idx.ID())
 	}
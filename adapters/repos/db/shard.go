@@ -39,6 +39,8 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
+	vectorcommon "github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/diskann"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
@@ -59,15 +61,18 @@ import (
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	diskannent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
 	dynamicent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorreindex"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/replica/hashtree"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	"golang.org/x/time/rate"
 )
 
 const IdLockPoolSize = 128
@@ -90,6 +95,7 @@ type ShardLike interface {
 
 	PutObject(context.Context, *storobj.Object) error
 	PutObjectBatch(context.Context, []*storobj.Object) []error
+	PutObjectBatchTransactional(context.Context, []*storobj.Object, []*int64) []error
 	ObjectByID(ctx context.Context, id strfmt.UUID, props search.SelectProperties, additional additional.Properties) (*storobj.Object, error)
 	ObjectByIDErrDeleted(ctx context.Context, id strfmt.UUID, props search.SelectProperties, additional additional.Properties) (*storobj.Object, error)
 	Exists(ctx context.Context, id strfmt.UUID) (bool, error)
@@ -100,7 +106,7 @@ type ShardLike interface {
 	UpdateAsyncReplication(ctx context.Context, enabled bool) error
 	AddReferencesBatch(ctx context.Context, refs objects.BatchReferences) []error
 	DeleteObjectBatch(ctx context.Context, ids []strfmt.UUID, dryRun bool) objects.BatchSimpleObjects // Delete many objects by id
-	DeleteObject(ctx context.Context, id strfmt.UUID) error                                           // Delete object by id
+	DeleteObject(ctx context.Context, id strfmt.UUID, expectedVersion *int64) error                   // Delete object by id
 	MultiObjectByID(ctx context.Context, query []multi.Identifier) ([]*storobj.Object, error)
 	ObjectDigestsByTokenRange(ctx context.Context, initialToken, finalToken uint64, limit int) (objs []replica.RepairResponse, lastTokenRead uint64, err error)
 	ID() string // Get the shard id
@@ -136,8 +142,9 @@ type ShardLike interface {
 
 	preparePutObject(context.Context, string, *storobj.Object) replica.SimpleResponse
 	preparePutObjects(context.Context, string, []*storobj.Object) replica.SimpleResponse
+	preparePutObjectsTransactional(context.Context, string, []*storobj.Object, []*int64) replica.SimpleResponse
 	prepareMergeObject(context.Context, string, *objects.MergeDocument) replica.SimpleResponse
-	prepareDeleteObject(context.Context, string, strfmt.UUID) replica.SimpleResponse
+	prepareDeleteObject(context.Context, string, strfmt.UUID, *int64) replica.SimpleResponse
 	prepareDeleteObjects(context.Context, string, []strfmt.UUID, bool) replica.SimpleResponse
 	prepareAddReferences(context.Context, string, []objects.BatchReference) replica.SimpleResponse
 
@@ -171,6 +178,18 @@ type ShardLike interface {
 	updateVectorIndexesIgnoreDelete(vectors map[string][]float32, status objectInsertStatus) error
 	hasGeoIndex() bool
 
+	asyncReplicationStatus() []*models.AsyncReplicationStatus
+	vectorIndexRebuildStatus() []*models.VectorIndexRebuildStatus
+	dynamicVectorIndexStatus() []*models.DynamicVectorIndexStatus
+	segmentIntegrityStatus() *models.SegmentIntegrityStatus
+	setDynamicUpgradePolicy(targetVector, policy string) error
+	reconcileVectorReindex(job vectorreindex.Job, class *models.Class, vectorizer vectorreindex.Vectorizer, limiter *rate.Limiter)
+	vectorReindexStatus(job vectorreindex.Job) *vectorreindex.ShardStatus
+	requestAsyncReplicationComparison() error
+	syncReplica(ctx context.Context, host string) (int, error)
+	trackDeletions(ctx context.Context, track bool) error
+	copyTokenRange(ctx context.Context, source, host string, r sharding.TokenRange, since int64) (int, int, error)
+
 	Metrics() *Metrics
 
 	// A thread-safe counter that goes up any time there is activity on this
@@ -183,16 +202,19 @@ type ShardLike interface {
 // database files for all the objects it owns. How a shard is determined for a
 // target object (e.g. Murmur hash, etc.) is still open at this point
 type Shard struct {
-	index             *Index // a reference to the underlying index, which in turn contains schema information
-	class             *models.Class
-	queue             *IndexQueue
-	queues            map[string]*IndexQueue
-	name              string
-	store             *lsmkv.Store
-	counter           *indexcounter.Counter
-	indexCheckpoints  *indexcheckpoint.Checkpoints
-	vectorIndex       VectorIndex
-	vectorIndexes     map[string]VectorIndex
+	index            *Index // a reference to the underlying index, which in turn contains schema information
+	class            *models.Class
+	queue            *IndexQueue
+	queues           map[string]*IndexQueue
+	name             string
+	store            *lsmkv.Store
+	counter          *indexcounter.Counter
+	indexCheckpoints *indexcheckpoint.Checkpoints
+	vectorIndex      VectorIndex
+	vectorIndexes    map[string]VectorIndex
+	// protects vectorIndexes and queues, which are replaced when target
+	// vectors are added to the class. The maps are never modified.
+	vectorIndexesLock sync.RWMutex
 	metrics           *Metrics
 	promMetrics       *monitoring.PrometheusMetrics
 	slowQueryReporter helpers.SlowQueryReporter
@@ -212,6 +234,13 @@ type Shard struct {
 	lastComparedHosts    []string
 	lastComparedHostsMux sync.RWMutex
 
+	asyncReplicationPeers    map[string]*asyncReplicationPeerStatus
+	asyncReplicationPeersMux sync.RWMutex
+
+	// records deleted objects while the shard is transferred to another node,
+	// see syncReplica
+	deletions deletionTracker
+
 	status              storagestate.Status
 	statusLock          sync.Mutex
 	propertyIndicesLock sync.RWMutex
@@ -222,6 +251,11 @@ type Shard struct {
 	docIdLock []sync.Mutex
 	// replication
 	replicationMap pendingReplicaTasks
+	// held exclusively by transactional batches and shared by all other reads
+	// and writes, see shard_write_transaction.go
+	transactionLock sync.RWMutex
+	// serializes transactional batches, which share the undo log
+	transactionWriteLock sync.Mutex
 
 	// Indicates whether searchable buckets should be used
 	// when filterable buckets are missing for text/text[] properties
@@ -241,6 +275,16 @@ type Shard struct {
 
 	// indicates whether shard is shut down or dropped (or ongoing)
 	shut bool
+	// backfills of target vectors added to the class, by target vector
+	vectorBackfillLock    sync.Mutex
+	vectorBackfills       map[string]*vectorBackfill
+	vectorBackfillsClosed bool
+	// repair of the shard from its replicas after segments of the objects
+	// bucket failed checksum verification, see onSegmentCorruption
+	segmentRepairLock    sync.Mutex
+	segmentRepair        *models.SegmentRepairStatus
+	segmentRepairPending bool
+
 	// indicates whether shard in being used at the moment (e.g. write request)
 	inUseCounter atomic.Int64
 	// allows concurrent shut read/write
@@ -315,11 +359,15 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 
 	s.initDimensionTracking(ctx)
 
+	if err := s.rollbackPendingTransaction(ctx); err != nil {
+		return nil, errors.Wrapf(err, "roll back pending transaction of shard %q", s.ID())
+	}
+
 	if asyncEnabled() {
 		f := func() {
 			// preload unindexed objects in the background
 			if s.hasTargetVectors() {
-				for targetVector, queue := range s.queues {
+				for targetVector, queue := range s.Queues() {
 					err := queue.PreloadShard(s)
 					if err != nil {
 						queue.Logger.WithError(err).Errorf("preload shard for target vector: %s", targetVector)
@@ -335,6 +383,8 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 		enterrors.GoWrapper(f, s.index.logger)
 	}
 	s.NotifyReady()
+	// corruptions detected while the shard was loaded are repaired now
+	s.startPendingSegmentRepair()
 
 	if exists {
 		s.index.logger.Printf("Completed loading shard %s in %s", s.ID(), time.Since(before))
@@ -380,6 +430,41 @@ func (s *Shard) initTargetQueues() error {
 	return nil
 }
 
+// addTargetVector creates the vector index and queue of a target vector which
+// was added to the class. The index is empty, objects get a vector for it
+// when they are written or backfilled.
+func (s *Shard) addTargetVector(ctx context.Context, targetVector string,
+	vectorIndexConfig schemaConfig.VectorIndexConfig,
+) error {
+	vectorIndex, err := s.initVectorIndex(ctx, targetVector, vectorIndexConfig)
+	if err != nil {
+		return fmt.Errorf("cannot create vector index for %q: %w", targetVector, err)
+	}
+	queue, err := NewIndexQueue(s.ID(), targetVector, s, vectorIndex, s.centralJobQueue,
+		s.indexCheckpoints, IndexQueueOptions{Logger: s.index.logger})
+	if err != nil {
+		return fmt.Errorf("cannot create index queue for %q: %w", targetVector, err)
+	}
+
+	s.vectorIndexesLock.Lock()
+	defer s.vectorIndexesLock.Unlock()
+
+	vectorIndexes := make(map[string]VectorIndex, len(s.vectorIndexes)+1)
+	for name, index := range s.vectorIndexes {
+		vectorIndexes[name] = index
+	}
+	vectorIndexes[targetVector] = vectorIndex
+	queues := make(map[string]*IndexQueue, len(s.queues)+1)
+	for name, q := range s.queues {
+		queues[name] = q
+	}
+	queues[targetVector] = queue
+
+	s.vectorIndexes = vectorIndexes
+	s.queues = queues
+	return nil
+}
+
 func (s *Shard) initLegacyVector(ctx context.Context) error {
 	vectorindex, err := s.initVectorIndex(ctx, "", s.index.vectorIndexUserConfig)
 	if err != nil {
@@ -399,26 +484,30 @@ func (s *Shard) initLegacyQueue() error {
 	return nil
 }
 
-func (s *Shard) initVectorIndex(ctx context.Context,
-	targetVector string, vectorIndexUserConfig schemaConfig.VectorIndexConfig,
-) (VectorIndex, error) {
-	var distProv distancer.Provider
-
-	switch vectorIndexUserConfig.DistanceName() {
+func distanceProvider(distanceName string) (distancer.Provider, error) {
+	switch distanceName {
 	case "", common.DistanceCosine:
-		distProv = distancer.NewCosineDistanceProvider()
+		return distancer.NewCosineDistanceProvider(), nil
 	case common.DistanceDot:
-		distProv = distancer.NewDotProductProvider()
+		return distancer.NewDotProductProvider(), nil
 	case common.DistanceL2Squared:
-		distProv = distancer.NewL2SquaredProvider()
+		return distancer.NewL2SquaredProvider(), nil
 	case common.DistanceManhattan:
-		distProv = distancer.NewManhattanProvider()
+		return distancer.NewManhattanProvider(), nil
 	case common.DistanceHamming:
-		distProv = distancer.NewHammingProvider()
+		return distancer.NewHammingProvider(), nil
 	default:
-		return nil, fmt.Errorf("init vector index: %w",
-			errors.Errorf("unrecognized distance metric %q,"+
-				"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", vectorIndexUserConfig.DistanceName()))
+		return nil, errors.Errorf("unrecognized distance metric %q,"+
+			"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", distanceName)
+	}
+}
+
+func (s *Shard) initVectorIndex(ctx context.Context,
+	targetVector string, vectorIndexUserConfig schemaConfig.VectorIndexConfig,
+) (VectorIndex, error) {
+	distProv, err := distanceProvider(vectorIndexUserConfig.DistanceName())
+	if err != nil {
+		return nil, fmt.Errorf("init vector index: %w", err)
 	}
 
 	var vectorIndex VectorIndex
@@ -438,35 +527,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			s.index.cycleCallbacks.vectorCommitLoggerCycle.Start()
 			s.index.cycleCallbacks.vectorTombstoneCleanupCycle.Start()
 
-			// a shard can actually have multiple vector indexes:
-			// - the main index, which is used for all normal object vectors
-			// - a geo property index for each geo prop in the schema
-			//
-			// here we label the main vector index as such.
-			vecIdxID := s.vectorIndexID(targetVector)
-
-			vi, err := hnsw.New(hnsw.Config{
-				Logger:               s.index.logger,
-				RootPath:             s.path(),
-				ID:                   vecIdxID,
-				ShardName:            s.name,
-				ClassName:            s.index.Config.ClassName.String(),
-				PrometheusMetrics:    s.promMetrics,
-				VectorForIDThunk:     hnsw.NewVectorForIDThunk(targetVector, s.vectorByIndexID),
-				TempVectorForIDThunk: hnsw.NewTempVectorForIDThunk(targetVector, s.readVectorByIndexIDIntoSlice),
-				DistanceProvider:     distProv,
-				MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-					return hnsw.NewCommitLogger(s.path(), vecIdxID,
-						s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks,
-						hnsw.WithAllocChecker(s.index.allocChecker),
-						hnsw.WithCommitlogThresholdForCombining(s.index.Config.HNSWMaxLogSize),
-						// consistent with previous logic where the individual limit is 1/5 of the combined limit
-						hnsw.WithCommitlogThreshold(s.index.Config.HNSWMaxLogSize/5),
-					)
-				},
-				AllocChecker: s.index.allocChecker,
-			}, hnswUserConfig, s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
-				s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks, s.store)
+			vi, err := s.initRebuildableHNSW(targetVector, hnswUserConfig, distProv)
 			if err != nil {
 				return nil, errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
 			}
@@ -526,7 +587,8 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			TempVectorForIDThunk: hnsw.NewTempVectorForIDThunk(targetVector, s.readVectorByIndexIDIntoSlice),
 			MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
 				return hnsw.NewCommitLogger(s.path(), vecIdxID,
-					s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks)
+					s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks,
+					hnsw.WithSnapshotInterval(s.index.Config.HNSWSnapshotInterval))
 			},
 			TombstoneCallbacks:       s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
 			ShardCompactionCallbacks: s.cycleCallbacks.compactionCallbacks,
@@ -536,14 +598,93 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			return nil, errors.Wrapf(err, "init shard %q: dynamic index", s.ID())
 		}
 		vectorIndex = vi
+	case vectorindex.VectorIndexTypeDISKANN:
+		diskannUserConfig, ok := vectorIndexUserConfig.(diskannent.UserConfig)
+		if !ok {
+			return nil, errors.Errorf("diskann vector index: config is not diskann.UserConfig: %T",
+				vectorIndexUserConfig)
+		}
+
+		s.index.cycleCallbacks.vectorCommitLoggerCycle.Start()
+		s.index.cycleCallbacks.vectorTombstoneCleanupCycle.Start()
+
+		vi, err := diskann.New(diskann.Config{
+			ID:                   s.vectorIndexID(targetVector),
+			TargetVector:         targetVector,
+			RootPath:             s.path(),
+			Logger:               s.index.logger,
+			DistanceProvider:     distProv,
+			CheckpointCallbacks:  s.cycleCallbacks.vectorCommitLoggerCallbacks,
+			ConsolidateCallbacks: s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
+		}, diskannUserConfig)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: diskann index", s.ID())
+		}
+		vectorIndex = vi
 	default:
-		return nil, fmt.Errorf("Unknown vector index type: %q. Choose one from [\"%s\", \"%s\", \"%s\"]",
-			vectorIndexUserConfig.IndexType(), vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC)
+		return nil, fmt.Errorf("Unknown vector index type: %q. Choose one from [\"%s\", \"%s\", \"%s\", \"%s\"]",
+			vectorIndexUserConfig.IndexType(), vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT,
+			vectorindex.VectorIndexTypeDYNAMIC, vectorindex.VectorIndexTypeDISKANN)
 	}
 	defer vectorIndex.PostStartup()
 	return vectorIndex, nil
 }
 
+// newHNSWIndex creates an hnsw index which reads the vectors of the objects.
+// If staged is set, vectors computed by a vector reindexing job are read
+// from the staging bucket instead, until they were written to the objects.
+func (s *Shard) newHNSWIndex(targetVector, id string, uc hnswent.UserConfig,
+	distProv distancer.Provider, staged *stagedVectors,
+) (VectorIndex, error) {
+	vectorForID := s.vectorByIndexID
+	tempVectorForID := s.readVectorByIndexIDIntoSlice
+	if staged != nil {
+		vectorForID = func(ctx context.Context, indexID uint64, targetVector string) ([]float32, error) {
+			if vec, err := staged.get(indexID, nil); vec != nil || err != nil {
+				return vec, err
+			}
+			return s.vectorByIndexID(ctx, indexID, targetVector)
+		}
+		tempVectorForID = func(ctx context.Context, indexID uint64, container *vectorcommon.VectorSlice,
+			targetVector string,
+		) ([]float32, error) {
+			if vec, err := staged.get(indexID, container.Slice); vec != nil || err != nil {
+				return vec, err
+			}
+			return s.readVectorByIndexIDIntoSlice(ctx, indexID, container, targetVector)
+		}
+	}
+
+	vi, err := hnsw.New(hnsw.Config{
+		Logger:               s.index.logger,
+		RootPath:             s.path(),
+		ID:                   id,
+		ShardName:            s.name,
+		ClassName:            s.index.Config.ClassName.String(),
+		PrometheusMetrics:    s.promMetrics,
+		VectorForIDThunk:     hnsw.NewVectorForIDThunk(targetVector, vectorForID),
+		TempVectorForIDThunk: hnsw.NewTempVectorForIDThunk(targetVector, tempVectorForID),
+		DistanceProvider:     distProv,
+		MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
+			return hnsw.NewCommitLogger(s.path(), id,
+				s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks,
+				hnsw.WithAllocChecker(s.index.allocChecker),
+				hnsw.WithCommitlogThresholdForCombining(s.index.Config.HNSWMaxLogSize),
+				// consistent with previous logic where the individual limit is 1/5 of the combined limit
+				hnsw.WithCommitlogThreshold(s.index.Config.HNSWMaxLogSize/5),
+				hnsw.WithSnapshotInterval(s.index.Config.HNSWSnapshotInterval),
+			)
+		},
+		AllocChecker:      s.index.allocChecker,
+		VectorCacheBudget: s.index.Config.VectorCacheBudget,
+	}, uc, s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
+		s.cycleCallbacks.compactionCallbacks, s.cycleCallbacks.flushCallbacks, s.store)
+	if err != nil {
+		return nil, err
+	}
+	return vi, nil
+}
+
 func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 	err := s.initLSMStore(ctx)
 	if err != nil {
@@ -643,7 +784,11 @@ func (s *Shard) initLSMStore(ctx context.Context) error {
 		s.dynamicMemtableSizing(),
 		s.memtableDirtyConfig(),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
+		s.segmentIntegrity(),
+		s.compactionPolicy(),
+		s.remoteTier(),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
+		lsmkv.WithCompression(s.index.objectsCompression()),
 	}
 
 	if s.metrics != nil && !s.metrics.grouped {
@@ -840,6 +985,7 @@ func (s *Shard) UpdateAsyncReplication(ctx context.Context, enabled bool) error
 	s.stopHashBeater()
 	s.hashtree = nil
 	s.hashtreeInitialized.Store(false)
+	s.resetAsyncReplicationStatus()
 
 	return nil
 }
@@ -863,7 +1009,15 @@ func (s *Shard) buildMultiSegmentHashTree() (hashtree.AggregatedHashTree, error)
 		virtualNodesPos[v.Name] = i
 	}
 
-	physical := shardState.Physical[s.name]
+	physical, ok := shardState.Physical[s.name]
+	if !ok {
+		// the shard was created after the index was loaded, e.g. by resharding
+		// the class. The virtual shards are the same in every layout.
+		latest := s.index.getSchema.CopyShardingState(s.index.Config.ClassName.String())
+		if physical, ok = latest.Physical[s.name]; !ok && latest.Resharding != nil {
+			physical = latest.Resharding.Physical[s.name]
+		}
+	}
 
 	segments := make([]hashtree.Segment, len(physical.OwnsVirtual))
 
@@ -944,6 +1098,7 @@ func (s *Shard) drop() (err error) {
 	s.metrics.DeleteShardLabels(s.index.Config.ClassName.String(), s.name)
 	s.metrics.baseMetrics.StartUnloadingShard(s.index.Config.ClassName.String())
 	s.replicationMap.clear()
+	s.stopVectorBackfills()
 
 	if s.index.Config.TrackVectorDimensions {
 		// tracking vector dimensions goroutine only works when tracking is enabled
@@ -996,12 +1151,12 @@ func (s *Shard) drop() (err error) {
 
 	if s.hasTargetVectors() {
 		// TODO run in parallel?
-		for targetVector, queue := range s.queues {
+		for targetVector, queue := range s.Queues() {
 			if err = queue.Drop(); err != nil {
 				return fmt.Errorf("close queue of vector %q at %s: %w", targetVector, s.path(), err)
 			}
 		}
-		for targetVector, vectorIndex := range s.vectorIndexes {
+		for targetVector, vectorIndex := range s.VectorIndexes() {
 			if err = vectorIndex.Drop(ctx); err != nil {
 				return fmt.Errorf("remove vector index of vector %q at %s: %w", targetVector, s.path(), err)
 			}
@@ -1046,6 +1201,9 @@ func (s *Shard) addIDProperty(ctx context.Context) error {
 		lsmkv.WithStrategy(lsmkv.StrategySetCollection),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
+		s.segmentIntegrity(),
+		s.compactionPolicy(),
+		s.remoteTier(),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 	)
 }
@@ -1055,14 +1213,9 @@ func (s *Shard) addDimensionsProperty(ctx context.Context) error {
 		return storagestate.ErrStatusReadOnly
 	}
 
-	// Note: this data would fit the "Set" type better, but since the "Map" type
-	// is currently optimized better, it is more efficient to use a Map here.
 	err := s.store.CreateOrLoadBucket(ctx,
 		helpers.DimensionsBucketLSM,
-		lsmkv.WithStrategy(lsmkv.StrategyMapCollection),
-		lsmkv.WithPread(s.index.Config.AvoidMMap),
-		lsmkv.WithAllocChecker(s.index.allocChecker),
-		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
+		s.dimensionsBucketOptions()...,
 	)
 	if err != nil {
 		return err
@@ -1071,6 +1224,20 @@ func (s *Shard) addDimensionsProperty(ctx context.Context) error {
 	return nil
 }
 
+func (s *Shard) dimensionsBucketOptions() []lsmkv.BucketOption {
+	// Note: this data would fit the "Set" type better, but since the "Map" type
+	// is currently optimized better, it is more efficient to use a Map here.
+	return []lsmkv.BucketOption{
+		lsmkv.WithStrategy(lsmkv.StrategyMapCollection),
+		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithAllocChecker(s.index.allocChecker),
+		s.segmentIntegrity(),
+		s.compactionPolicy(),
+		s.remoteTier(),
+		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
+	}
+}
+
 func (s *Shard) addTimestampProperties(ctx context.Context) error {
 	if s.isReadOnly() {
 		return storagestate.ErrStatusReadOnly
@@ -1093,6 +1260,9 @@ func (s *Shard) addCreationTimeUnixProperty(ctx context.Context) error {
 		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
+		s.segmentIntegrity(),
+		s.compactionPolicy(),
+		s.remoteTier(),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 	)
 }
@@ -1104,6 +1274,9 @@ func (s *Shard) addLastUpdateTimeUnixProperty(ctx context.Context) error {
 		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
+		s.segmentIntegrity(),
+		s.compactionPolicy(),
+		s.remoteTier(),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 	)
 }
@@ -1122,6 +1295,15 @@ func (s *Shard) dynamicMemtableSizing() lsmkv.BucketOption {
 	)
 }
 
+func (s *Shard) compactionPolicy() lsmkv.BucketOption {
+	return lsmkv.WithCompactionPolicy(s.index.Config.LSMCompactionPolicy,
+		s.index.Config.LSMCompactionFanOut)
+}
+
+func (s *Shard) remoteTier() lsmkv.BucketOption {
+	return lsmkv.WithRemoteTier(s.index.Config.LSMTier)
+}
+
 func (s *Shard) createPropertyIndex(ctx context.Context, eg *enterrors.ErrorGroupWrapper, props ...*models.Property) error {
 	for _, prop := range props {
 		if !inverted.HasInvertedIndex(prop) {
@@ -1170,6 +1352,9 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 		s.dynamicMemtableSizing(),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
+		s.segmentIntegrity(),
+		s.compactionPolicy(),
+		s.remoteTier(),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 	}
 
@@ -1231,6 +1416,9 @@ func (s *Shard) createPropertyLengthIndex(ctx context.Context, prop *models.Prop
 		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
+		s.segmentIntegrity(),
+		s.compactionPolicy(),
+		s.remoteTier(),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 	)
 }
@@ -1245,6 +1433,9 @@ func (s *Shard) createPropertyNullIndex(ctx context.Context, prop *models.Proper
 		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
+		s.segmentIntegrity(),
+		s.compactionPolicy(),
+		s.remoteTier(),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 	)
 }
@@ -1275,8 +1466,15 @@ func (s *Shard) UpdateVectorIndexConfigs(ctx context.Context, updated map[string
 	wg := new(sync.WaitGroup)
 	var err error
 	for targetName, targetCfg := range updated {
+		vectorIndex := s.VectorIndexForName(targetName)
+		if vectorIndex == nil {
+			if err = s.addTargetVector(ctx, targetName, targetCfg); err != nil {
+				break
+			}
+			continue
+		}
 		wg.Add(1)
-		if err = s.VectorIndexForName(targetName).UpdateUserConfig(targetCfg, wg.Done); err != nil {
+		if err = vectorIndex.UpdateUserConfig(targetCfg, wg.Done); err != nil {
 			break
 		}
 	}
@@ -1318,6 +1516,8 @@ func (s *Shard) Shutdown(ctx context.Context) (err error) {
 		return
 	}
 
+	s.stopVectorBackfills()
+
 	if s.index.Config.TrackVectorDimensions {
 		// tracking vector dimensions goroutine only works when tracking is enabled
 		// that's why we are trying to stop it only in this case
@@ -1347,12 +1547,12 @@ func (s *Shard) Shutdown(ctx context.Context) (err error) {
 
 	if s.hasTargetVectors() {
 		// TODO run in parallel?
-		for targetVector, queue := range s.queues {
+		for targetVector, queue := range s.Queues() {
 			if err = queue.Close(); err != nil {
 				return fmt.Errorf("shut down vector index queue of vector %q: %w", targetVector, err)
 			}
 		}
-		for targetVector, vectorIndex := range s.vectorIndexes {
+		for targetVector, vectorIndex := range s.VectorIndexes() {
 			if err = vectorIndex.Flush(); err != nil {
 				return fmt.Errorf("flush vector index commitlog of vector %q: %w", targetVector, err)
 			}
@@ -25,6 +25,8 @@ func (s *Shard) Queue() *IndexQueue {
 }
 
 func (s *Shard) Queues() map[string]*IndexQueue {
+	s.vectorIndexesLock.RLock()
+	defer s.vectorIndexesLock.RUnlock()
 	return s.queues
 }
 
@@ -33,10 +35,14 @@ func (s *Shard) VectorIndex() VectorIndex {
 }
 
 func (s *Shard) VectorIndexes() map[string]VectorIndex {
+	s.vectorIndexesLock.RLock()
+	defer s.vectorIndexesLock.RUnlock()
 	return s.vectorIndexes
 }
 
 func (s *Shard) VectorIndexForName(targetVector string) VectorIndex {
+	s.vectorIndexesLock.RLock()
+	defer s.vectorIndexesLock.RUnlock()
 	return s.vectorIndexes[targetVector]
 }
 
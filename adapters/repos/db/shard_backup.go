@@ -45,7 +45,7 @@ func (s *Shard) BeginBackup(ctx context.Context) (err error) {
 		return fmt.Errorf("pause geo props maintenance: %w", err)
 	}
 	if s.hasTargetVectors() {
-		for targetVector, vectorIndex := range s.vectorIndexes {
+		for targetVector, vectorIndex := range s.VectorIndexes() {
 			if err = vectorIndex.SwitchCommitLogs(ctx); err != nil {
 				return fmt.Errorf("switch commit logs of vector %q: %w", targetVector, err)
 			}
@@ -70,7 +70,7 @@ func (s *Shard) ListBackupFiles(ctx context.Context, ret *backup.ShardDescriptor
 	}
 
 	if s.hasTargetVectors() {
-		for targetVector, vectorIndex := range s.vectorIndexes {
+		for targetVector, vectorIndex := range s.VectorIndexes() {
 			files, err := vectorIndex.ListFiles(ctx, s.index.Config.RootPath)
 			if err != nil {
 				return fmt.Errorf("list files of vector %q: %w", targetVector, err)
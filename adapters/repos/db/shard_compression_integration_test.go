@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/additional"
+)
+
+func TestShardObjectsCompression(t *testing.T) {
+	ctx := context.Background()
+	className := "CompressedClass"
+
+	shd, idx := testShard(t, ctx, className, func(i *Index) {
+		i.Config.ObjectsCompression = lsmkv.CompressionZstd
+	})
+	shard := loadedShard(t, shd)
+
+	bucket := shard.Store().Bucket(helpers.ObjectsBucketLSM)
+	assert.Equal(t, lsmkv.CompressionZstd, bucket.GetCompression())
+
+	objs := createRandomObjects(getRandomSeed(), className, 100, 16)
+	for _, err := range shard.PutObjectBatch(ctx, objs) {
+		require.Nil(t, err)
+	}
+	require.Nil(t, bucket.FlushAndSwitch())
+
+	for _, obj := range objs {
+		found, err := shard.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, obj.ID(), found.ID())
+		assert.Equal(t, obj.Vector, found.Vector)
+	}
+
+	require.Nil(t, idx.updateStorageConfig(ctx, lsmkv.CompressionNone))
+	assert.Equal(t, lsmkv.CompressionNone, bucket.GetCompression())
+	assert.Equal(t, lsmkv.CompressionNone, idx.objectsCompression())
+}
@@ -104,7 +104,14 @@ func (s *Shard) calcDimensions(ctx context.Context, calcEntry func(k []byte, v [
 		return 0
 	}
 
-	c := b.MapCursor()
+	c, err := b.MapCursor()
+	if err != nil {
+		s.index.logger.WithError(err).
+			WithField("action", "calc_dimensions").
+			WithField("shard", s.name).
+			Error("could not read vector dimensions")
+		return 0
+	}
 	defer c.Close()
 
 	sum := 0
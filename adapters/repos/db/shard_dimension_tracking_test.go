@@ -258,7 +258,7 @@ func Test_DimensionTracking(t *testing.T) {
 		quantDimBefore := GetQuantizedDimensionsFromRepo(context.Background(), repo, "Test", 64)
 		for i := 0; i < 10; i++ {
 			id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
-			err := repo.DeleteObject(context.Background(), "Test", id, nil, "", 0)
+			err := repo.DeleteObject(context.Background(), "Test", id, nil, nil, "", 0)
 			require.Nil(t, err)
 		}
 		dimAfter := GetDimensionsFromRepo(context.Background(), repo, "Test")
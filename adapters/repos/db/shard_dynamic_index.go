@@ -0,0 +1,199 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	dynamicent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+)
+
+// dynamicVectorIndex is implemented by vector indexes which switch between
+// flat and hnsw depending on their size
+type dynamicVectorIndex interface {
+	Status() dynamic.Status
+	SetUpgradePolicy(policy string) error
+}
+
+// dynamicVectorIndexStatus returns the backend and migration progress of the
+// dynamic vector indexes of the shard
+func (s *Shard) dynamicVectorIndexStatus() []*models.DynamicVectorIndexStatus {
+	indexes := s.VectorIndexes()
+	if !s.hasTargetVectors() {
+		indexes = map[string]VectorIndex{"": s.VectorIndex()}
+	}
+
+	var status []*models.DynamicVectorIndexStatus
+	for _, index := range indexes {
+		if dynamicIndex, ok := index.(dynamicVectorIndex); ok {
+			status = append(status, dynamicStatusToModel(dynamicIndex.Status()))
+		}
+	}
+	sort.Slice(status, func(i, j int) bool {
+		return status[i].TargetVector < status[j].TargetVector
+	})
+	return status
+}
+
+func dynamicStatusToModel(status dynamic.Status) *models.DynamicVectorIndexStatus {
+	out := &models.DynamicVectorIndexStatus{
+		TargetVector:       status.TargetVector,
+		Backend:            status.Backend,
+		UpgradePolicy:      status.UpgradePolicy,
+		Threshold:          int64(status.Threshold),
+		DowngradeThreshold: int64(status.DowngradeThreshold),
+	}
+	if m := status.Migration; m != nil {
+		out.Migration = &models.DynamicVectorIndexMigration{
+			Direction:         m.Direction,
+			Status:            models.DynamicVectorIndexMigrationStatusRUNNING,
+			ObjectsProcessed:  m.ObjectsProcessed,
+			ObjectsTotal:      m.ObjectsTotal,
+			StartedUnixMillis: m.StartTime.UnixMilli(),
+			Error:             m.Error,
+		}
+		if !m.FinishTime.IsZero() {
+			out.Migration.FinishedUnixMillis = m.FinishTime.UnixMilli()
+			out.Migration.Status = models.DynamicVectorIndexMigrationStatusCOMPLETED
+			if m.Error != "" {
+				out.Migration.Status = models.DynamicVectorIndexMigrationStatusFAILED
+			}
+		}
+		if !m.EstimatedFinishTime.IsZero() {
+			out.Migration.EstimatedFinishUnixMillis = m.EstimatedFinishTime.UnixMilli()
+		}
+	}
+	return out
+}
+
+// setDynamicUpgradePolicy sets the upgrade policy of the dynamic index of the
+// given target vector
+func (s *Shard) setDynamicUpgradePolicy(targetVector, policy string) error {
+	var index VectorIndex
+	if s.hasTargetVectors() {
+		index = s.VectorIndexForName(targetVector)
+	} else if targetVector == "" {
+		index = s.VectorIndex()
+	}
+
+	dynamicIndex, ok := index.(dynamicVectorIndex)
+	if !ok {
+		return enterrors.NewErrUnprocessable(
+			fmt.Errorf("target vector %q does not use a dynamic index", targetVector))
+	}
+	return dynamicIndex.SetUpgradePolicy(policy)
+}
+
+// SetDynamicIndexUpgradePolicy sets the upgrade policy of the dynamic vector
+// indexes of the given class on every node. If tenant is empty, the indexes of
+// all shards are updated.
+func (db *DB) SetDynamicIndexUpgradePolicy(ctx context.Context,
+	className, tenant, targetVector, policy string,
+) error {
+	if err := dynamic.ValidateUpgradePolicy(policy); err != nil {
+		return enterrors.NewErrUnprocessable(err)
+	}
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+	if !idx.usesDynamicIndex(targetVector) {
+		return enterrors.NewErrUnprocessable(
+			fmt.Errorf("target vector %q of class %q does not use a dynamic index", targetVector, className))
+	}
+	if tenant != "" {
+		if !idx.partitioningEnabled() {
+			return enterrors.NewErrUnprocessable(
+				fmt.Errorf("class %q is not multi-tenant", className))
+		}
+		tenants, err := db.schemaGetter.OptimisticTenantStatus(className, tenant)
+		if err != nil {
+			return err
+		}
+		switch tenants[tenant] {
+		case "":
+			return enterrors.NewErrNotFound(fmt.Errorf("%w: %q", enterrors.ErrTenantNotFound, tenant))
+		case models.TenantActivityStatusHOT:
+		default:
+			return enterrors.NewErrUnprocessable(fmt.Errorf("%w: %q", enterrors.ErrTenantNotActive, tenant))
+		}
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(db.logger)
+	eg.SetLimit(_NUMCPU)
+	for _, nodeName := range db.schemaGetter.Nodes() {
+		nodeName := nodeName
+		eg.Go(func() error {
+			var err error
+			if db.schemaGetter.NodeName() == nodeName {
+				err = db.localSetDynamicIndexUpgradePolicy(className, tenant, targetVector, policy)
+			} else {
+				err = db.remoteNode.SetDynamicIndexUpgradePolicy(ctx, nodeName, className, tenant, targetVector, policy)
+			}
+			if err != nil {
+				return fmt.Errorf("node: %v: %w", nodeName, err)
+			}
+			return nil
+		}, nodeName)
+	}
+
+	return eg.Wait()
+}
+
+// IncomingSetDynamicIndexUpgradePolicy sets the upgrade policy of the dynamic
+// vector indexes of the local shards of the given class
+func (db *DB) IncomingSetDynamicIndexUpgradePolicy(ctx context.Context,
+	className, tenant, targetVector, policy string,
+) error {
+	if err := dynamic.ValidateUpgradePolicy(policy); err != nil {
+		return enterrors.NewErrUnprocessable(err)
+	}
+	return db.localSetDynamicIndexUpgradePolicy(className, tenant, targetVector, policy)
+}
+
+func (db *DB) localSetDynamicIndexUpgradePolicy(className, tenant, targetVector, policy string) error {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		// the class may not exist yet on this node
+		return nil
+	}
+
+	return idx.ForEachShard(func(name string, shard ShardLike) error {
+		if tenant != "" && name != tenant {
+			return nil
+		}
+		if err := shard.setDynamicUpgradePolicy(targetVector, policy); err != nil {
+			return fmt.Errorf("shard %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
+// usesDynamicIndex returns whether the given target vector, or the legacy
+// vector if it is empty, is configured with a dynamic index
+func (i *Index) usesDynamicIndex(targetVector string) bool {
+	i.vectorIndexUserConfigLock.Lock()
+	defer i.vectorIndexUserConfigLock.Unlock()
+
+	cfg := i.vectorIndexUserConfig
+	if targetVector != "" || len(i.vectorIndexUserConfigs) > 0 {
+		cfg = i.vectorIndexUserConfigs[targetVector]
+	}
+	_, ok := cfg.(dynamicent.UserConfig)
+	return ok
+}
@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	dynamicent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+)
+
+func TestDynamicIndexUpgradePolicy(t *testing.T) {
+	t.Setenv("ASYNC_INDEXING", "true")
+	t.Setenv("ASYNC_BATCH_SIZE", "50")
+	t.Setenv("ASYNC_INDEX_INTERVAL", "10ms")
+	ctx := context.Background()
+	className := "DynamicClass"
+
+	uc := dynamicent.NewDefaultUserConfig()
+	uc.Threshold = 1000
+	shd, idx := testShardWithSettings(t, ctx, &models.Class{Class: className}, uc, false, true)
+	shard := loadedShard(t, shd)
+
+	objs := createRandomObjects(getRandomSeed(), className, 200, 16)
+	for _, err := range shard.PutObjectBatch(ctx, objs) {
+		require.Nil(t, err)
+	}
+	require.Eventually(t, func() bool {
+		return shard.Queue().Size() == 0
+	}, 30*time.Second, 10*time.Millisecond)
+
+	t.Run("a small index is flat", func(t *testing.T) {
+		status := shard.dynamicVectorIndexStatus()
+		require.Len(t, status, 1)
+		assert.Equal(t, dynamic.BackendFlat, status[0].Backend)
+		assert.Equal(t, dynamic.UpgradePolicyAuto, status[0].UpgradePolicy)
+		assert.Equal(t, int64(1000), status[0].Threshold)
+		assert.Nil(t, status[0].Migration)
+		assert.True(t, idx.usesDynamicIndex(""))
+	})
+
+	t.Run("unknown target vector", func(t *testing.T) {
+		err := shard.setDynamicUpgradePolicy("other", dynamic.UpgradePolicyForce)
+		assert.True(t, errors.As(err, &enterrors.ErrUnprocessable{}))
+	})
+
+	t.Run("forcing the upgrade builds hnsw", func(t *testing.T) {
+		require.Nil(t, shard.setDynamicUpgradePolicy("", dynamic.UpgradePolicyForce))
+
+		require.Eventually(t, func() bool {
+			status := shard.dynamicVectorIndexStatus()
+			return status[0].Migration != nil &&
+				status[0].Migration.Status == models.DynamicVectorIndexMigrationStatusCOMPLETED
+		}, 30*time.Second, 10*time.Millisecond)
+
+		status := shard.dynamicVectorIndexStatus()[0]
+		assert.Equal(t, dynamic.BackendHNSW, status.Backend)
+		assert.Equal(t, dynamic.UpgradePolicyForce, status.UpgradePolicy)
+		assert.Equal(t, dynamic.MigrationUpgrade, status.Migration.Direction)
+		assert.Equal(t, int64(len(objs)), status.Migration.ObjectsProcessed)
+		assert.NotZero(t, status.Migration.FinishedUnixMillis)
+		assert.Empty(t, status.Migration.Error)
+
+		res, _, err := shard.ObjectVectorSearch(ctx, objs[0].Vector, "", 0, 1, nil, nil, nil,
+			additional.Properties{})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, objs[0].ID(), res[0].ID())
+	})
+}
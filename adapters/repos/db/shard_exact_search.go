@@ -0,0 +1,206 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// vectorSearcher is implemented by the index queues and by the exact searcher
+type vectorSearcher interface {
+	SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error)
+	SearchByVectorDistance(vector []float32, targetDistance float32, maxLimit int64,
+		allowList helpers.AllowList) ([]uint64, []float32, error)
+}
+
+// getVectorSearcher returns the exact searcher if exact is set and the index
+// queue of the target vector otherwise
+func (s *Shard) getVectorSearcher(targetVector string, exact bool) (vectorSearcher, error) {
+	if !exact {
+		return s.getIndexQueue(targetVector)
+	}
+	if s.hasTargetVectors() && targetVector == "" {
+		return nil, fmt.Errorf("exact search: missing target vector")
+	}
+	distProv, err := s.index.vectorDistanceProvider(targetVector)
+	if err != nil {
+		return nil, fmt.Errorf("exact search: %w", err)
+	}
+	return &exactVectorSearcher{shard: s, targetVector: targetVector, distancer: distProv}, nil
+}
+
+// vectorDistanceProvider returns the distancer of the vector index of the
+// target vector
+func (i *Index) vectorDistanceProvider(targetVector string) (distancer.Provider, error) {
+	if targetVector != "" {
+		providers, err := i.targetDistanceProviders([]string{targetVector})
+		if err != nil {
+			return nil, err
+		}
+		return providers[0], nil
+	}
+
+	i.vectorIndexUserConfigLock.Lock()
+	cfg := i.vectorIndexUserConfig
+	i.vectorIndexUserConfigLock.Unlock()
+	if cfg == nil {
+		return nil, fmt.Errorf("no vector index configured")
+	}
+	return distanceProvider(cfg.DistanceName())
+}
+
+// exactVectorSearcher computes the distances between the query and the vectors
+// stored with the objects of a shard. It doesn't use the vector index, so
+// neither the graph nor any compression of the index affects the results.
+// The cost is linear in the number of objects, or allowed objects if the
+// search is filtered.
+type exactVectorSearcher struct {
+	shard        *Shard
+	targetVector string
+	distancer    distancer.Provider
+}
+
+func (e *exactVectorSearcher) SearchByVector(vector []float32, k int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if k <= 0 {
+		return nil, nil, nil
+	}
+
+	heap := priorityqueue.NewMax[any](k)
+	err := e.forEachDistance(vector, allowList, func(docID uint64, dist float32) {
+		if heap.Len() < k {
+			heap.Insert(docID, dist)
+		} else if dist < heap.Top().Dist {
+			heap.Pop()
+			heap.Insert(docID, dist)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids := make([]uint64, heap.Len())
+	dists := make([]float32, heap.Len())
+	for i := len(ids) - 1; i >= 0; i-- {
+		item := heap.Pop()
+		ids[i] = item.ID
+		dists[i] = item.Dist
+	}
+	return ids, dists, nil
+}
+
+func (e *exactVectorSearcher) SearchByVectorDistance(vector []float32,
+	targetDistance float32, maxLimit int64, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	var results []priorityqueue.Item[any]
+	err := e.forEachDistance(vector, allowList, func(docID uint64, dist float32) {
+		if dist <= targetDistance {
+			results = append(results, priorityqueue.Item[any]{ID: docID, Dist: dist})
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.Slice(results, func(a, b int) bool { return results[a].Dist < results[b].Dist })
+	if maxLimit >= 0 && int64(len(results)) > maxLimit {
+		results = results[:maxLimit]
+	}
+	ids := make([]uint64, len(results))
+	dists := make([]float32, len(results))
+	for i := range results {
+		ids[i] = results[i].ID
+		dists[i] = results[i].Dist
+	}
+	return ids, dists, nil
+}
+
+// forEachDistance calls fn with the distance of every object which has a
+// vector for the target vector and is contained in the allow list, if any
+func (e *exactVectorSearcher) forEachDistance(query []float32, allowList helpers.AllowList,
+	fn func(docID uint64, dist float32),
+) error {
+	if e.distancer.Type() == "cosine-dot" {
+		query = distancer.Normalize(query)
+	}
+
+	visit := func(docID uint64, vector []float32) error {
+		if len(vector) == 0 {
+			return nil
+		}
+		if e.distancer.Type() == "cosine-dot" {
+			vector = distancer.Normalize(vector)
+		}
+		dist, ok, err := e.distancer.SingleDist(query, vector)
+		if err != nil {
+			return errors.Wrapf(err, "distance to doc id %d", docID)
+		}
+		if ok {
+			fn(docID, dist)
+		}
+		return nil
+	}
+
+	if allowList != nil {
+		container := &common.VectorSlice{Buff8: make([]byte, 8)}
+		it := allowList.Iterator()
+		for docID, ok := it.Next(); ok; docID, ok = it.Next() {
+			vector, err := e.shard.readVectorByIndexIDIntoSlice(context.Background(),
+				docID, container, e.targetVector)
+			if err != nil {
+				var notFound storobj.ErrNotFound
+				if errors.As(err, &notFound) || errors.Is(err, storobj.ErrVectorNotFound) {
+					continue
+				}
+				return errors.Wrapf(err, "read vector of doc id %d", docID)
+			}
+			if err := visit(docID, vector); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	cursor, err := e.shard.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	if err != nil {
+		return err
+	}
+	defer cursor.Close()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		docID, _, err := storobj.DocIDFromBinary(v)
+		if err != nil {
+			return errors.Wrap(err, "read doc id")
+		}
+		vector, err := storobj.VectorFromBinary(v, nil, e.targetVector)
+		if err != nil {
+			if errors.Is(err, storobj.ErrVectorNotFound) {
+				continue
+			}
+			return errors.Wrapf(err, "read vector of doc id %d", docID)
+		}
+		if err := visit(docID, vector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestExactVectorSearch(t *testing.T) {
+	ctx := context.Background()
+	className := "ExactSearchClass"
+	class := &models.Class{Class: className, Vectorizer: "none"}
+
+	shd, _ := testShardWithSettings(t, ctx, class, enthnsw.NewDefaultUserConfig(), false, false)
+	shard := loadedShard(t, shd)
+
+	objs := createRandomObjects(getRandomSeed(), className, 500, 32)
+	for _, err := range shard.PutObjectBatch(ctx, objs) {
+		require.Nil(t, err)
+	}
+	query := objs[0].Vector
+
+	// bruteForce returns the ids of the k nearest objects by cosine distance
+	bruteForce := func(k int, allowed func(docID uint64) bool) ([]uint64, []float32) {
+		type result struct {
+			docID uint64
+			dist  float32
+		}
+		var results []result
+		q := distancer.Normalize(query)
+		for _, obj := range objs {
+			if !allowed(obj.DocID) {
+				continue
+			}
+			dist, _, err := distancer.NewCosineDistanceProvider().SingleDist(q, distancer.Normalize(obj.Vector))
+			require.Nil(t, err)
+			results = append(results, result{obj.DocID, dist})
+		}
+		sort.Slice(results, func(a, b int) bool { return results[a].dist < results[b].dist })
+		ids := make([]uint64, 0, k)
+		dists := make([]float32, 0, k)
+		for _, r := range results[:k] {
+			ids = append(ids, r.docID)
+			dists = append(dists, r.dist)
+		}
+		return ids, dists
+	}
+
+	searcher, err := shard.getVectorSearcher("", true)
+	require.Nil(t, err)
+	require.IsType(t, &exactVectorSearcher{}, searcher)
+
+	t.Run("search by vector", func(t *testing.T) {
+		ids, dists, err := searcher.SearchByVector(query, 20, nil)
+		require.Nil(t, err)
+
+		expectedIDs, expectedDists := bruteForce(20, func(uint64) bool { return true })
+		assert.Equal(t, expectedIDs, ids)
+		assert.InDeltaSlice(t, expectedDists, dists, 1e-5)
+	})
+
+	t.Run("search by vector with allow list", func(t *testing.T) {
+		even := helpers.NewAllowList()
+		for _, obj := range objs {
+			if obj.DocID%2 == 0 {
+				even.Insert(obj.DocID)
+			}
+		}
+		// ids which don't exist are skipped
+		even.Insert(100_000)
+
+		ids, dists, err := searcher.SearchByVector(query, 20, even)
+		require.Nil(t, err)
+
+		expectedIDs, expectedDists := bruteForce(20, func(docID uint64) bool { return docID%2 == 0 })
+		assert.Equal(t, expectedIDs, ids)
+		assert.InDeltaSlice(t, expectedDists, dists, 1e-5)
+	})
+
+	t.Run("search by vector distance", func(t *testing.T) {
+		expectedIDs, expectedDists := bruteForce(10, func(uint64) bool { return true })
+
+		ids, dists, err := searcher.SearchByVectorDistance(query, expectedDists[9], 100, nil)
+		require.Nil(t, err)
+		assert.Equal(t, expectedIDs, ids)
+		assert.InDeltaSlice(t, expectedDists, dists, 1e-5)
+
+		ids, _, err = searcher.SearchByVectorDistance(query, expectedDists[9], 5, nil)
+		require.Nil(t, err)
+		assert.Equal(t, expectedIDs[:5], ids)
+	})
+
+	t.Run("object vector search with exact search", func(t *testing.T) {
+		res, _, err := shard.ObjectVectorSearch(ctx, query, "", 0, 20, nil, nil, nil,
+			additional.Properties{ExactSearch: true})
+		require.Nil(t, err)
+
+		expectedIDs, _ := bruteForce(20, func(uint64) bool { return true })
+		ids := make([]uint64, len(res))
+		for i := range res {
+			ids[i] = res[i].DocID
+		}
+		assert.Equal(t, expectedIDs, ids)
+	})
+
+	t.Run("deleted objects are not returned", func(t *testing.T) {
+		require.Nil(t, shard.DeleteObject(ctx, objs[0].ID(), nil))
+
+		ids, _, err := searcher.SearchByVector(query, 1, nil)
+		require.Nil(t, err)
+		require.Len(t, ids, 1)
+		assert.NotEqual(t, objs[0].DocID, ids[0])
+	})
+}
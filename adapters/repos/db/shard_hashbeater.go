@@ -15,12 +15,14 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/interval"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -219,7 +221,7 @@ func (s *Shard) hashBeat() (stats hashBeatStats, err error) {
 
 	diffCalculationStart := time.Now()
 
-	replyCh, hosts, err := s.index.replicator.CollectShardDifferences(s.hashBeaterCtx, s.name, s.hashtree)
+	replyCh, hosts, err := s.index.replicator.CollectShardDifferences(s.hashBeaterCtx, s.name, s.hashtree, s.recordShardComparison)
 	if err != nil {
 		return stats, fmt.Errorf("collecting differences: %w", err)
 	}
@@ -291,6 +293,8 @@ func (s *Shard) hashBeat() (stats hashBeatStats, err error) {
 
 		stats.hostStats = append(stats.hostStats, stat)
 
+		s.recordObjectsPropagated(shardDiffReader.Host, objectsPropagated)
+
 		diffCollectionDone = true
 		diffCollectionErr = nil
 	}
@@ -424,3 +428,112 @@ func (s *Shard) stepsTowardsShardConsistency(ctx context.Context,
 func (s *Shard) stopHashBeater() {
 	s.hashBeaterCancelFunc()
 }
+
+// asyncReplicationPeerStatus keeps track of the async replication of the
+// shard with one of its replicas
+type asyncReplicationPeerStatus struct {
+	node              string
+	lastComparison    time.Time
+	outOfSyncSince    time.Time // zero when in sync at the last comparison
+	diffLeaves        int
+	objectsPropagated int
+}
+
+func (s *Shard) recordShardComparison(c replica.ShardComparison) {
+	s.asyncReplicationPeersMux.Lock()
+	defer s.asyncReplicationPeersMux.Unlock()
+
+	peer := s.asyncReplicationPeer(c.Host)
+	if c.Node != "" {
+		peer.node = c.Node
+	}
+
+	now := time.Now()
+	peer.lastComparison = now
+	peer.diffLeaves = c.DiffLeaves
+
+	if c.DiffLeaves == 0 {
+		peer.outOfSyncSince = time.Time{}
+	} else if peer.outOfSyncSince.IsZero() {
+		peer.outOfSyncSince = now
+	}
+}
+
+func (s *Shard) recordObjectsPropagated(host string, objectsPropagated int) {
+	s.asyncReplicationPeersMux.Lock()
+	defer s.asyncReplicationPeersMux.Unlock()
+
+	s.asyncReplicationPeer(host).objectsPropagated += objectsPropagated
+}
+
+// asyncReplicationPeer must be called while holding asyncReplicationPeersMux
+func (s *Shard) asyncReplicationPeer(host string) *asyncReplicationPeerStatus {
+	if s.asyncReplicationPeers == nil {
+		s.asyncReplicationPeers = make(map[string]*asyncReplicationPeerStatus)
+	}
+
+	peer, ok := s.asyncReplicationPeers[host]
+	if !ok {
+		peer = &asyncReplicationPeerStatus{node: host}
+		s.asyncReplicationPeers[host] = peer
+	}
+
+	return peer
+}
+
+func (s *Shard) resetAsyncReplicationStatus() {
+	s.asyncReplicationPeersMux.Lock()
+	defer s.asyncReplicationPeersMux.Unlock()
+
+	s.asyncReplicationPeers = nil
+}
+
+func (s *Shard) asyncReplicationStatus() []*models.AsyncReplicationStatus {
+	s.asyncReplicationPeersMux.RLock()
+	defer s.asyncReplicationPeersMux.RUnlock()
+
+	if len(s.asyncReplicationPeers) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	status := make([]*models.AsyncReplicationStatus, 0, len(s.asyncReplicationPeers))
+	for _, peer := range s.asyncReplicationPeers {
+		var lag time.Duration
+		if !peer.outOfSyncSince.IsZero() {
+			lag = now.Sub(peer.outOfSyncSince)
+		}
+
+		var lastComparison int64
+		if !peer.lastComparison.IsZero() {
+			lastComparison = peer.lastComparison.UnixMilli()
+		}
+
+		status = append(status, &models.AsyncReplicationStatus{
+			TargetNode:               peer.node,
+			LastComparisonUnixMillis: lastComparison,
+			DifferingLeaves:          int64(peer.diffLeaves),
+			ObjectsPropagated:        int64(peer.objectsPropagated),
+			LagMillis:                lag.Milliseconds(),
+		})
+	}
+
+	sort.Slice(status, func(i, j int) bool {
+		return status[i].TargetNode < status[j].TargetNode
+	})
+
+	return status
+}
+
+// requestAsyncReplicationComparison makes the hashbeater compare the shard
+// with its replicas as soon as possible, regardless of recent writes
+func (s *Shard) requestAsyncReplicationComparison() error {
+	if !s.hashtreeInitialized.Load() {
+		return fmt.Errorf("async replication not initialized on shard %q", s.ID())
+	}
+
+	s.objectPropagationRequired()
+
+	return nil
+}
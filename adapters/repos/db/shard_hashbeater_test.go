@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+func TestShardAsyncReplicationStatus(t *testing.T) {
+	s := &Shard{}
+
+	t.Run("no comparison yet", func(t *testing.T) {
+		assert.Nil(t, s.asyncReplicationStatus())
+	})
+
+	t.Run("replicas out of sync", func(t *testing.T) {
+		s.recordShardComparison(replica.ShardComparison{Node: "node2", Host: "10.0.0.2:7001", DiffLeaves: 3})
+		s.recordShardComparison(replica.ShardComparison{Node: "node1", Host: "10.0.0.1:7001", DiffLeaves: 0})
+		s.recordObjectsPropagated("10.0.0.2:7001", 5)
+
+		time.Sleep(5 * time.Millisecond)
+
+		status := s.asyncReplicationStatus()
+		require.Len(t, status, 2)
+
+		assert.Equal(t, "node1", status[0].TargetNode)
+		assert.Equal(t, int64(0), status[0].DifferingLeaves)
+		assert.Equal(t, int64(0), status[0].LagMillis)
+		assert.NotZero(t, status[0].LastComparisonUnixMillis)
+
+		assert.Equal(t, "node2", status[1].TargetNode)
+		assert.Equal(t, int64(3), status[1].DifferingLeaves)
+		assert.Equal(t, int64(5), status[1].ObjectsPropagated)
+		assert.GreaterOrEqual(t, status[1].LagMillis, int64(5))
+	})
+
+	t.Run("lag is measured from the first comparison out of sync", func(t *testing.T) {
+		before := s.asyncReplicationStatus()[1].LagMillis
+
+		s.recordShardComparison(replica.ShardComparison{Node: "node2", Host: "10.0.0.2:7001", DiffLeaves: 1})
+
+		status := s.asyncReplicationStatus()
+		assert.Equal(t, int64(1), status[1].DifferingLeaves)
+		assert.GreaterOrEqual(t, status[1].LagMillis, before)
+	})
+
+	t.Run("replicas back in sync", func(t *testing.T) {
+		s.recordShardComparison(replica.ShardComparison{Node: "node2", Host: "10.0.0.2:7001", DiffLeaves: 0})
+		s.recordObjectsPropagated("10.0.0.2:7001", 2)
+
+		status := s.asyncReplicationStatus()
+		require.Len(t, status, 2)
+		assert.Equal(t, int64(0), status[1].DifferingLeaves)
+		assert.Equal(t, int64(0), status[1].LagMillis)
+		assert.Equal(t, int64(7), status[1].ObjectsPropagated)
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		s.resetAsyncReplicationStatus()
+		assert.Nil(t, s.asyncReplicationStatus())
+	})
+}
@@ -37,12 +37,15 @@ import (
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorreindex"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/replica/hashtree"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	"golang.org/x/time/rate"
 )
 
 type LazyLoadShard struct {
@@ -202,6 +205,15 @@ func (l *LazyLoadShard) PutObjectBatch(ctx context.Context, objects []*storobj.O
 	return l.shard.PutObjectBatch(ctx, objects)
 }
 
+func (l *LazyLoadShard) PutObjectBatchTransactional(ctx context.Context, objects []*storobj.Object,
+	expectedVersions []*int64,
+) []error {
+	if err := l.Load(ctx); err != nil {
+		return duplicateErr(err, len(objects))
+	}
+	return l.shard.PutObjectBatchTransactional(ctx, objects, expectedVersions)
+}
+
 func (l *LazyLoadShard) ObjectByID(ctx context.Context, id strfmt.UUID, props search.SelectProperties, additional additional.Properties) (*storobj.Object, error) {
 	if err := l.Load(ctx); err != nil {
 		return nil, err
@@ -270,11 +282,11 @@ func (l *LazyLoadShard) DeleteObjectBatch(ctx context.Context, ids []strfmt.UUID
 	return l.shard.DeleteObjectBatch(ctx, ids, dryRun)
 }
 
-func (l *LazyLoadShard) DeleteObject(ctx context.Context, id strfmt.UUID) error {
+func (l *LazyLoadShard) DeleteObject(ctx context.Context, id strfmt.UUID, expectedVersion *int64) error {
 	if err := l.Load(ctx); err != nil {
 		return err
 	}
-	return l.shard.DeleteObject(ctx, id)
+	return l.shard.DeleteObject(ctx, id, expectedVersion)
 }
 
 func (l *LazyLoadShard) MultiObjectByID(ctx context.Context, query []multi.Identifier) ([]*storobj.Object, error) {
@@ -505,14 +517,23 @@ func (l *LazyLoadShard) preparePutObjects(ctx context.Context, shardID string, o
 	return l.shard.preparePutObjects(ctx, shardID, objects)
 }
 
+func (l *LazyLoadShard) preparePutObjectsTransactional(ctx context.Context, shardID string,
+	objects []*storobj.Object, expectedVersions []*int64,
+) replica.SimpleResponse {
+	l.mustLoadCtx(ctx)
+	return l.shard.preparePutObjectsTransactional(ctx, shardID, objects, expectedVersions)
+}
+
 func (l *LazyLoadShard) prepareMergeObject(ctx context.Context, shardID string, object *objects.MergeDocument) replica.SimpleResponse {
 	l.mustLoadCtx(ctx)
 	return l.shard.prepareMergeObject(ctx, shardID, object)
 }
 
-func (l *LazyLoadShard) prepareDeleteObject(ctx context.Context, shardID string, id strfmt.UUID) replica.SimpleResponse {
+func (l *LazyLoadShard) prepareDeleteObject(ctx context.Context, shardID string, id strfmt.UUID,
+	expectedVersion *int64,
+) replica.SimpleResponse {
 	l.mustLoadCtx(ctx)
-	return l.shard.prepareDeleteObject(ctx, shardID, id)
+	return l.shard.prepareDeleteObject(ctx, shardID, id, expectedVersion)
 }
 
 func (l *LazyLoadShard) prepareDeleteObjects(ctx context.Context, shardID string, ids []strfmt.UUID, dryRun bool) replica.SimpleResponse {
@@ -643,6 +664,92 @@ func (l *LazyLoadShard) Metrics() *Metrics {
 	return l.shard.Metrics()
 }
 
+func (l *LazyLoadShard) asyncReplicationStatus() []*models.AsyncReplicationStatus {
+	if !l.isLoaded() {
+		return nil
+	}
+	return l.shard.asyncReplicationStatus()
+}
+
+func (l *LazyLoadShard) vectorIndexRebuildStatus() []*models.VectorIndexRebuildStatus {
+	if !l.isLoaded() {
+		return nil
+	}
+	return l.shard.vectorIndexRebuildStatus()
+}
+
+func (l *LazyLoadShard) dynamicVectorIndexStatus() []*models.DynamicVectorIndexStatus {
+	if !l.isLoaded() {
+		return nil
+	}
+	return l.shard.dynamicVectorIndexStatus()
+}
+
+func (l *LazyLoadShard) segmentIntegrityStatus() *models.SegmentIntegrityStatus {
+	if !l.isLoaded() {
+		return nil
+	}
+	return l.shard.segmentIntegrityStatus()
+}
+
+func (l *LazyLoadShard) setDynamicUpgradePolicy(targetVector, policy string) error {
+	l.mustLoad()
+	return l.shard.setDynamicUpgradePolicy(targetVector, policy)
+}
+
+func (l *LazyLoadShard) reconcileVectorReindex(job vectorreindex.Job, class *models.Class,
+	vectorizer vectorreindex.Vectorizer, limiter *rate.Limiter,
+) {
+	// finished jobs only affect shards which reindexed their vectors already
+	if job.Finished() && !l.isLoaded() {
+		return
+	}
+	if err := l.Load(context.Background()); err != nil {
+		return
+	}
+	l.shard.reconcileVectorReindex(job, class, vectorizer, limiter)
+}
+
+func (l *LazyLoadShard) vectorReindexStatus(job vectorreindex.Job) *vectorreindex.ShardStatus {
+	if !l.isLoaded() {
+		return nil
+	}
+	return l.shard.vectorReindexStatus(job)
+}
+
+func (l *LazyLoadShard) requestAsyncReplicationComparison() error {
+	if err := l.Load(context.Background()); err != nil {
+		return err
+	}
+	return l.shard.requestAsyncReplicationComparison()
+}
+
+func (l *LazyLoadShard) syncReplica(ctx context.Context, host string) (int, error) {
+	if err := l.Load(ctx); err != nil {
+		return 0, err
+	}
+	return l.shard.syncReplica(ctx, host)
+}
+
+func (l *LazyLoadShard) trackDeletions(ctx context.Context, track bool) error {
+	if !track && !l.isLoaded() {
+		return nil
+	}
+	if err := l.Load(ctx); err != nil {
+		return err
+	}
+	return l.shard.trackDeletions(ctx, track)
+}
+
+func (l *LazyLoadShard) copyTokenRange(ctx context.Context, source, host string,
+	r sharding.TokenRange, since int64,
+) (int, int, error) {
+	if err := l.Load(ctx); err != nil {
+		return 0, 0, err
+	}
+	return l.shard.copyTokenRange(ctx, source, host, r, since)
+}
+
 func (l *LazyLoadShard) isLoaded() bool {
 	l.mutex.Lock()
 	defer l.mutex.Unlock()
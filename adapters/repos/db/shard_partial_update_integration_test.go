@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestShardPartialUpdates(t *testing.T) {
+	ctx := context.Background()
+	className := "PartialUpdateClass"
+	class := &models.Class{
+		Class: className,
+		Properties: []*models.Property{
+			{
+				Name:         "tags",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:     "popularity",
+				DataType: schema.DataTypeInt.PropString(),
+			},
+		},
+	}
+	shard, _ := testShardWithSettings(t, ctx, class, hnsw.UserConfig{Skip: true}, true, false)
+
+	id := strfmt.UUID(uuid.NewString())
+	obj := storobj.FromObject(&models.Object{
+		Class: className,
+		ID:    id,
+		Properties: map[string]interface{}{
+			"tags":       []string{"a"},
+			"popularity": float64(1),
+		},
+		LastUpdateTimeUnix: 1,
+	}, []float32{1, 2, 3}, nil)
+	require.Nil(t, shard.PutObject(ctx, obj))
+
+	search := func(t *testing.T, prop string, value interface{}, dataType schema.DataType) []*storobj.Object {
+		found, _, err := shard.ObjectSearch(ctx, 10, &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: schema.ClassName(className), Property: schema.PropertyName(prop)},
+			Value:    &filters.Value{Value: value, Type: dataType},
+		}}, nil, nil, nil, additional.Properties{})
+		require.Nil(t, err)
+		return found
+	}
+
+	t.Run("set and append without a vector", func(t *testing.T) {
+		err := shard.MergeObject(ctx, objects.MergeDocument{
+			Class:              className,
+			ID:                 id,
+			PrimitiveSchema:    map[string]interface{}{"popularity": float64(2)},
+			PropertiesToAppend: map[string]interface{}{"tags": []interface{}{"b"}},
+			UpdateTime:         2,
+			RequireExisting:    true,
+		})
+		require.Nil(t, err)
+
+		found, err := shard.ObjectByID(ctx, id, nil, additional.Properties{})
+		require.Nil(t, err)
+		props := found.Properties().(map[string]interface{})
+		assert.Equal(t, []string{"a", "b"}, props["tags"])
+		assert.Equal(t, float64(2), props["popularity"])
+		assert.Equal(t, []float32{1, 2, 3}, found.Vector)
+
+		assert.Equal(t, uint64(1), shard.Counter().Get(), "doc id is preserved")
+		assert.Len(t, search(t, "tags", "a", schema.DataTypeText), 1)
+		assert.Len(t, search(t, "tags", "b", schema.DataTypeText), 1)
+		assert.Len(t, search(t, "popularity", 2, schema.DataTypeInt), 1)
+		assert.Len(t, search(t, "popularity", 1, schema.DataTypeInt), 0)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		err := shard.MergeObject(ctx, objects.MergeDocument{
+			Class:              className,
+			ID:                 id,
+			PropertiesToDelete: []string{"tags"},
+			UpdateTime:         3,
+			RequireExisting:    true,
+		})
+		require.Nil(t, err)
+
+		found, err := shard.ObjectByID(ctx, id, nil, additional.Properties{})
+		require.Nil(t, err)
+		assert.NotContains(t, found.Properties(), "tags")
+		assert.Len(t, search(t, "tags", "a", schema.DataTypeText), 0)
+	})
+
+	t.Run("missing object", func(t *testing.T) {
+		missing := strfmt.UUID(uuid.NewString())
+		err := shard.MergeObject(ctx, objects.MergeDocument{
+			Class:           className,
+			ID:              missing,
+			PrimitiveSchema: map[string]interface{}{"popularity": float64(2)},
+			UpdateTime:      4,
+			RequireExisting: true,
+		})
+		assert.ErrorAs(t, err, &objects.ErrNotFound{})
+
+		found, err := shard.ObjectByID(ctx, missing, nil, additional.Properties{})
+		require.Nil(t, err)
+		assert.Nil(t, found)
+	})
+}
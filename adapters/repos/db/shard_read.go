@@ -40,6 +40,9 @@ import (
 var maxUUID [16]byte = [16]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
 
 func (s *Shard) ObjectByIDErrDeleted(ctx context.Context, id strfmt.UUID, props search.SelectProperties, additional additional.Properties) (*storobj.Object, error) {
+	s.transactionLock.RLock()
+	defer s.transactionLock.RUnlock()
+
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
 	if err != nil {
 		return nil, err
@@ -63,6 +66,9 @@ func (s *Shard) ObjectByIDErrDeleted(ctx context.Context, id strfmt.UUID, props
 }
 
 func (s *Shard) ObjectByID(ctx context.Context, id strfmt.UUID, props search.SelectProperties, additional additional.Properties) (*storobj.Object, error) {
+	s.transactionLock.RLock()
+	defer s.transactionLock.RUnlock()
+
 	s.activityTracker.Add(1)
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
 	if err != nil {
@@ -87,6 +93,9 @@ func (s *Shard) ObjectByID(ctx context.Context, id strfmt.UUID, props search.Sel
 }
 
 func (s *Shard) MultiObjectByID(ctx context.Context, query []multi.Identifier) ([]*storobj.Object, error) {
+	s.transactionLock.RLock()
+	defer s.transactionLock.RUnlock()
+
 	s.activityTracker.Add(1)
 	objects := make([]*storobj.Object, len(query))
 
@@ -131,7 +140,10 @@ func (s *Shard) ObjectDigestsByTokenRange(ctx context.Context,
 		return nil, 0, fmt.Errorf("secondary index for token ranges not available")
 	}
 
-	cursor := bucket.CursorWithSecondaryIndex(helpers.ObjectsBucketLSMTokenRangeSecondaryIndex)
+	cursor, err := bucket.CursorWithSecondaryIndex(helpers.ObjectsBucketLSMTokenRangeSecondaryIndex)
+	if err != nil {
+		return nil, 0, err
+	}
 	defer cursor.Close()
 
 	n := 0
@@ -189,6 +201,9 @@ func (s *Shard) ObjectDigestsByTokenRange(ctx context.Context,
 // of a true negative would be considerably faster. For a (false) positive,
 // we'd still need to check, though.
 func (s *Shard) Exists(ctx context.Context, id strfmt.UUID) (bool, error) {
+	s.transactionLock.RLock()
+	defer s.transactionLock.RUnlock()
+
 	s.activityTracker.Add(1)
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
 	if err != nil {
@@ -257,6 +272,9 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor,
 	additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
+	s.transactionLock.RLock()
+	defer s.transactionLock.RUnlock()
+
 	var err error
 
 	// Report slow queries if this method takes longer than expected
@@ -329,7 +347,7 @@ func (s *Shard) getIndexQueue(targetVector string) (*IndexQueue, error) {
 		if targetVector == "" {
 			return nil, fmt.Errorf("index queue: missing target vector")
 		}
-		queue, ok := s.queues[targetVector]
+		queue, ok := s.Queues()[targetVector]
 		if !ok {
 			return nil, fmt.Errorf("index queue for target vector: %s doesn't exist", targetVector)
 		}
@@ -339,6 +357,9 @@ func (s *Shard) getIndexQueue(targetVector string) (*IndexQueue, error) {
 }
 
 func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVector []float32, targetVector string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties) ([]*storobj.Object, []float32, error) {
+	s.transactionLock.RLock()
+	defer s.transactionLock.RUnlock()
+
 	startTime := time.Now()
 	defer func() {
 		s.slowQueryReporter.LogIfSlow(startTime, map[string]any{
@@ -368,20 +389,20 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVector []float32,
 		s.metrics.FilteredVectorFilter(time.Since(beforeFilter))
 	}
 
-	queue, err := s.getIndexQueue(targetVector)
+	searcher, err := s.getVectorSearcher(targetVector, additional.ExactSearch)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	beforeVector := time.Now()
 	if limit < 0 {
-		ids, dists, err = queue.SearchByVectorDistance(
+		ids, dists, err = searcher.SearchByVectorDistance(
 			searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "vector search by distance")
 		}
 	} else {
-		ids, dists, err = queue.SearchByVector(searchVector, limit, allowList)
+		ids, dists, err = searcher.SearchByVector(searchVector, limit, allowList)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "vector search")
 		}
@@ -426,6 +447,9 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVector []float32,
 }
 
 func (s *Shard) ObjectList(ctx context.Context, limit int, sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties, className schema.ClassName) ([]*storobj.Object, error) {
+	s.transactionLock.RLock()
+	defer s.transactionLock.RUnlock()
+
 	s.activityTracker.Add(1)
 	if len(sort) > 0 {
 		docIDs, err := s.sortedObjectList(ctx, limit, sort, className)
@@ -446,7 +470,10 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 	additional additional.Properties,
 	className schema.ClassName,
 ) ([]*storobj.Object, error) {
-	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	cursor, err := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	if err != nil {
+		return nil, err
+	}
 	defer cursor.Close()
 
 	var key, val []byte
@@ -544,6 +571,11 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 		return err
 	}
 
+	// see deleteObject
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
+	defer lock.Unlock()
+
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	existing, err := bucket.Get(idBytes)
 	if err != nil {
@@ -573,7 +605,7 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 	}
 
 	if s.hasTargetVectors() {
-		for targetVector, queue := range s.queues {
+		for targetVector, queue := range s.Queues() {
 			if err = queue.Delete(docID); err != nil {
 				return fmt.Errorf("delete from vector index queue of vector %q: %w", targetVector, err)
 			}
@@ -584,6 +616,8 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID) error {
 		}
 	}
 
+	s.deletions.record(idBytes)
+
 	if err = s.mayDeleteObjectHashTree(idBytes, updateTime); err != nil {
 		return errors.Wrap(err, "object deletion in hashtree")
 	}
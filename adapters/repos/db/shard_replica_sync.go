@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// deletionTracker records the objects deleted from a shard while it is
+// copied to other nodes. A copy is made from a snapshot of the shard, the
+// objects deleted afterwards are only known to the source until it pushes
+// the deletions, see syncReplica.
+type deletionTracker struct {
+	sync.Mutex
+	// transfers is the number of transfers the deletions are tracked for
+	transfers int
+	// deleted holds the time of deletion in ms by object id
+	deleted map[strfmt.UUID]int64
+}
+
+func (t *deletionTracker) start() {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.transfers == 0 {
+		t.deleted = map[strfmt.UUID]int64{}
+	}
+	t.transfers++
+}
+
+func (t *deletionTracker) stop() {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.transfers == 0 {
+		return
+	}
+	t.transfers--
+	if t.transfers == 0 {
+		t.deleted = nil
+	}
+}
+
+func (t *deletionTracker) record(idBytes []byte) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.deleted == nil {
+		return
+	}
+	id, err := uuid.FromBytes(idBytes)
+	if err != nil {
+		return
+	}
+	t.deleted[strfmt.UUID(id.String())] = time.Now().UnixMilli()
+}
+
+// snapshot returns the deletions recorded so far, ok is false if the
+// deletions are not tracked
+func (t *deletionTracker) snapshot() (deleted map[strfmt.UUID]int64, ok bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.deleted == nil {
+		return nil, false
+	}
+	deleted = make(map[strfmt.UUID]int64, len(t.deleted))
+	for id, at := range t.deleted {
+		deleted[id] = at
+	}
+	return deleted, true
+}
+
+// trackDeletions starts or stops recording the deleted objects of the shard
+// for a transfer. It must be started before the snapshot of the shard is
+// taken.
+func (s *Shard) trackDeletions(ctx context.Context, track bool) error {
+	if track {
+		s.deletions.start()
+	} else {
+		s.deletions.stop()
+	}
+	return nil
+}
+
+// syncReplica pushes all objects of the shard which are missing or outdated
+// on the given host, regardless of the state of the hashtree, and deletes the
+// objects on the host which were deleted since the deletions are tracked.
+// It returns the number of objects which were propagated.
+func (s *Shard) syncReplica(ctx context.Context, host string) (int, error) {
+	// without the deletions since the snapshot deleted objects would come
+	// back once the replica takes over
+	deleted, ok := s.deletions.snapshot()
+	if !ok {
+		return 0, fmt.Errorf("deletions of shard %q are not tracked, the transfer must be restarted", s.name)
+	}
+
+	_, _, propagations, err := s.stepsTowardsShardConsistency(ctx, s.name, host, 0, math.MaxUint64)
+	if err != nil {
+		return propagations, err
+	}
+
+	deletions, err := s.propagateDeletions(ctx, host, deleted)
+	return propagations + deletions, err
+}
+
+// propagateDeletions deletes the given objects on host, unless they were
+// written again after the deletion
+func (s *Shard) propagateDeletions(ctx context.Context, host string,
+	deleted map[strfmt.UUID]int64,
+) (int, error) {
+	const limit = 100
+
+	ids := make([]strfmt.UUID, 0, len(deleted))
+	for id := range deleted {
+		exists, err := s.Exists(ctx, id)
+		if err != nil {
+			return 0, fmt.Errorf("check local object %s: %w", id, err)
+		}
+		// objects created again are propagated like other objects
+		if !exists {
+			ids = append(ids, id)
+		}
+	}
+
+	propagations := 0
+	for start := 0; start < len(ids); start += limit {
+		end := start + limit
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		digests, err := s.index.replicator.DigestObjects(ctx, host, s.name, ids[start:end])
+		if err != nil {
+			return propagations, fmt.Errorf("fetching remote object digests: %w", err)
+		}
+
+		var deletions []*objects.VObject
+		for _, d := range digests {
+			if d.Deleted || d.UpdateTime == 0 || d.UpdateTime > deleted[strfmt.UUID(d.ID)] {
+				continue
+			}
+			deletions = append(deletions, &objects.VObject{
+				ID:              strfmt.UUID(d.ID),
+				Deleted:         true,
+				StaleUpdateTime: d.UpdateTime,
+			})
+		}
+		if len(deletions) == 0 {
+			continue
+		}
+
+		resp, err := s.index.replicator.Overwrite(ctx, host, s.class.Class, s.name, deletions)
+		if err != nil {
+			return propagations, fmt.Errorf("propagating deletions: %w", err)
+		}
+		for _, r := range resp {
+			// a conflict means the object was written in the meantime
+			if r.Err != "" && r.Err != "conflict" {
+				return propagations, fmt.Errorf("propagating deletion of %s: %s", r.ID, r.Err)
+			}
+		}
+		propagations += len(deletions)
+	}
+
+	return propagations, nil
+}
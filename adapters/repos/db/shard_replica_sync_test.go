@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeletionTracker(t *testing.T) {
+	id := uuid.New()
+	idBytes, err := id.MarshalBinary()
+	require.Nil(t, err)
+
+	var tracker deletionTracker
+	tracker.record(idBytes)
+	_, ok := tracker.snapshot()
+	assert.False(t, ok, "deletions are only recorded while tracked")
+
+	tracker.start()
+	tracker.start()
+	tracker.record(idBytes)
+	deleted, ok := tracker.snapshot()
+	require.True(t, ok)
+	assert.Contains(t, deleted, strfmt.UUID(id.String()))
+
+	tracker.stop()
+	deleted, ok = tracker.snapshot()
+	require.True(t, ok, "deletions are tracked until all transfers stopped")
+	assert.Len(t, deleted, 1)
+
+	tracker.stop()
+	_, ok = tracker.snapshot()
+	assert.False(t, ok)
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
@@ -81,6 +82,9 @@ func (s *Shard) preparePutObject(ctx context.Context, requestID string, object *
 		}}}
 	}
 	task := func(ctx context.Context) interface{} {
+		s.transactionLock.RLock()
+		defer s.transactionLock.RUnlock()
+
 		resp := replica.SimpleResponse{}
 		if err := s.putOne(ctx, uuid, object); err != nil {
 			resp.Errors = []replica.Error{
@@ -100,12 +104,24 @@ func (s *Shard) prepareMergeObject(ctx context.Context, requestID string, doc *o
 			{Code: replica.StatusPreconditionFailed, Msg: err.Error()},
 		}}
 	}
+	// the version is checked again when the merge is committed, checking it
+	// now lets the coordinator abort the merge on all replicas
+	if doc.ExpectedVersion != nil {
+		prev, err := fetchObject(s.store.Bucket(helpers.ObjectsBucketLSM), uuid)
+		if err == nil {
+			err = checkObjectVersionUpdate(doc.ID, prev, *doc.ExpectedVersion, doc.UpdateTime)
+		}
+		if err != nil {
+			return replica.SimpleResponse{Errors: []replica.Error{replicaWriteError(err)}}
+		}
+	}
 	task := func(ctx context.Context) interface{} {
+		s.transactionLock.RLock()
+		defer s.transactionLock.RUnlock()
+
 		resp := replica.SimpleResponse{}
 		if err := s.merge(ctx, uuid, *doc); err != nil {
-			resp.Errors = []replica.Error{
-				{Code: replica.StatusConflict, Msg: err.Error()},
-			}
+			resp.Errors = []replica.Error{replicaWriteError(err)}
 		}
 		return resp
 	}
@@ -113,7 +129,9 @@ func (s *Shard) prepareMergeObject(ctx context.Context, requestID string, doc *o
 	return replica.SimpleResponse{}
 }
 
-func (s *Shard) prepareDeleteObject(ctx context.Context, requestID string, uuid strfmt.UUID) replica.SimpleResponse {
+func (s *Shard) prepareDeleteObject(ctx context.Context, requestID string, uuid strfmt.UUID,
+	expectedVersion *int64,
+) replica.SimpleResponse {
 	bucket, obj, idBytes, docID, updateTime, err := s.canDeleteOne(ctx, uuid)
 	if err != nil {
 		return replica.SimpleResponse{
@@ -122,8 +140,23 @@ func (s *Shard) prepareDeleteObject(ctx context.Context, requestID string, uuid
 			},
 		}
 	}
+	if expectedVersion != nil {
+		if err := checkVersion(uuid, updateTime, *expectedVersion); err != nil {
+			return replica.SimpleResponse{Errors: []replica.Error{replicaWriteError(err)}}
+		}
+	}
 	task := func(ctx context.Context) interface{} {
+		s.transactionLock.RLock()
+		defer s.transactionLock.RUnlock()
+
 		resp := replica.SimpleResponse{}
+		if expectedVersion != nil {
+			// the object may have changed since the deletion was prepared
+			if err := s.deleteObject(ctx, uuid, expectedVersion); err != nil {
+				resp.Errors = []replica.Error{replicaWriteError(err)}
+			}
+			return resp
+		}
 		if err := s.deleteOne(ctx, bucket, obj, idBytes, docID, updateTime); err != nil {
 			resp.Errors = []replica.Error{
 				{Code: replica.StatusConflict, Msg: err.Error()},
@@ -137,6 +170,9 @@ func (s *Shard) prepareDeleteObject(ctx context.Context, requestID string, uuid
 
 func (s *Shard) preparePutObjects(ctx context.Context, requestID string, objects []*storobj.Object) replica.SimpleResponse {
 	task := func(ctx context.Context) interface{} {
+		s.transactionLock.RLock()
+		defer s.transactionLock.RUnlock()
+
 		rawErrs := s.putBatch(ctx, objects)
 		resp := replica.SimpleResponse{Errors: make([]replica.Error, len(rawErrs))}
 		for i, err := range rawErrs {
@@ -152,6 +188,9 @@ func (s *Shard) preparePutObjects(ctx context.Context, requestID string, objects
 
 func (s *Shard) prepareDeleteObjects(ctx context.Context, requestID string, uuids []strfmt.UUID, dryRun bool) replica.SimpleResponse {
 	task := func(ctx context.Context) interface{} {
+		s.transactionLock.RLock()
+		defer s.transactionLock.RUnlock()
+
 		result := newDeleteObjectsBatcher(s).Delete(ctx, uuids, dryRun)
 		resp := replica.DeleteBatchResponse{
 			Batch: make([]replica.UUID2Error, len(result)),
@@ -172,6 +211,9 @@ func (s *Shard) prepareDeleteObjects(ctx context.Context, requestID string, uuid
 
 func (s *Shard) prepareAddReferences(ctx context.Context, requestID string, refs []objects.BatchReference) replica.SimpleResponse {
 	task := func(ctx context.Context) interface{} {
+		s.transactionLock.RLock()
+		defer s.transactionLock.RUnlock()
+
 		rawErrs := newReferencesBatcher(s).References(ctx, refs)
 		resp := replica.SimpleResponse{Errors: make([]replica.Error, len(rawErrs))}
 		for i, err := range rawErrs {
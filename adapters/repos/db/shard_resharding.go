@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// copyTokenRange makes the objects of this shard within the token range
+// match the ones of the shard source on host:
+//
+//   - objects which are missing or outdated are fetched from the source,
+//     unless they were deleted from this shard in the meantime
+//   - objects which do not exist on the source and were last updated before
+//     since are deleted. These were copied while being deleted on the source.
+//
+// It returns the number of objects which were copied and deleted.
+func (s *Shard) copyTokenRange(ctx context.Context, source, host string,
+	r sharding.TokenRange, since int64,
+) (copied, deleted int, err error) {
+	const limit = 100
+
+	for from := r.From; ; {
+		digests, last, err := s.index.replicator.DigestObjectsInTokenRange(ctx,
+			source, host, from, r.To, limit)
+		if err != nil {
+			return copied, deleted, fmt.Errorf("fetching source object digests: %w", err)
+		}
+
+		remote := make(map[string]int64, len(digests))
+		for _, d := range digests {
+			if !d.Deleted {
+				remote[d.ID] = d.UpdateTime
+			}
+		}
+		local, err := s.digestsInTokenRange(ctx, from, last)
+		if err != nil {
+			return copied, deleted, err
+		}
+
+		var outdated []strfmt.UUID
+		for id, updateTime := range remote {
+			if localUpdateTime, ok := local[id]; !ok || localUpdateTime < updateTime {
+				outdated = append(outdated, strfmt.UUID(id))
+			}
+		}
+		n, err := s.copyObjects(ctx, source, host, outdated)
+		copied += n
+		if err != nil {
+			return copied, deleted, err
+		}
+
+		for id, updateTime := range local {
+			if _, ok := remote[id]; ok || updateTime >= since {
+				continue
+			}
+			if err := s.DeleteObject(ctx, strfmt.UUID(id), nil); err != nil {
+				return copied, deleted, fmt.Errorf("delete object %s: %w", id, err)
+			}
+			deleted++
+		}
+
+		if last >= r.To {
+			return copied, deleted, nil
+		}
+		if last == from {
+			// more than limit objects share a token, which is very unlikely
+			last++
+		}
+		from = last
+	}
+}
+
+// digestsInTokenRange returns the last update time of all objects of the
+// shard within the token range by object id
+func (s *Shard) digestsInTokenRange(ctx context.Context, from, to uint64) (map[string]int64, error) {
+	const limit = 1000
+
+	digests := map[string]int64{}
+	for {
+		page, last, err := s.ObjectDigestsByTokenRange(ctx, from, to, limit)
+		if err != nil && !errors.Is(err, storobj.ErrLimitReached) {
+			return nil, fmt.Errorf("fetching local object digests: %w", err)
+		}
+		for _, d := range page {
+			digests[d.ID] = d.UpdateTime
+		}
+		if err == nil || last >= to {
+			return digests, nil
+		}
+		if last == from {
+			last++
+		}
+		from = last
+	}
+}
+
+// copyObjects fetches the objects from the shard source on host and writes
+// the ones which are newer than their local version
+func (s *Shard) copyObjects(ctx context.Context, source, host string, ids []strfmt.UUID) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	replicas, err := s.index.replicator.FetchObjects(ctx, host, source, ids)
+	if err != nil {
+		return 0, fmt.Errorf("fetching source objects: %w", err)
+	}
+
+	copied := 0
+	for _, r := range replicas {
+		if r.Deleted || r.Object == nil {
+			continue
+		}
+		// a dual write may have updated or deleted the object since it was
+		// fetched, it must not be replaced by the older copy
+		ok, err := s.putObjectIfNewer(ctx, r.Object)
+		if err != nil {
+			return copied, fmt.Errorf("put object %s: %w", r.ID, err)
+		}
+		if ok {
+			copied++
+		}
+	}
+	return copied, nil
+}
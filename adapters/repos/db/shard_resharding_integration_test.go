@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestShardReshardingCopy(t *testing.T) {
+	ctx := context.Background()
+	className := "ReshardingCopyClass"
+
+	shd, _ := testShard(t, ctx, className)
+	shard := loadedShard(t, shd)
+
+	obj := createRandomObjects(getRandomSeed(), className, 1, 16)[0]
+	version := func(updateTime int64) *storobj.Object {
+		v := *obj
+		v.Object.LastUpdateTimeUnix = updateTime
+		return &v
+	}
+	lastUpdate := func(t *testing.T) int64 {
+		found, err := shard.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		if found == nil {
+			return 0
+		}
+		return found.LastUpdateTimeUnix()
+	}
+
+	t.Run("copy of a missing object is written", func(t *testing.T) {
+		ok, err := shard.putObjectIfNewer(ctx, version(100))
+		require.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, int64(100), lastUpdate(t))
+	})
+
+	t.Run("older copy does not replace a dual write", func(t *testing.T) {
+		require.Nil(t, shard.PutObject(ctx, version(200)))
+
+		ok, err := shard.putObjectIfNewer(ctx, version(150))
+		require.Nil(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, int64(200), lastUpdate(t))
+	})
+
+	t.Run("copy does not restore an object deleted by a dual write", func(t *testing.T) {
+		require.Nil(t, shard.DeleteObject(ctx, obj.ID(), nil))
+
+		ok, err := shard.putObjectIfNewer(ctx, version(300))
+		require.Nil(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, int64(0), lastUpdate(t))
+	})
+
+	t.Run("copies interleaved with dual writes keep the newest version", func(t *testing.T) {
+		obj = createRandomObjects(getRandomSeed(), className, 1, 16)[0]
+		const writes = 200
+
+		wg := sync.WaitGroup{}
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := int64(1); i <= writes; i++ {
+				assert.Nil(t, shard.PutObject(ctx, version(1000+i)))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			// the copy fetched the object from the source before the dual
+			// writes started
+			for i := 0; i < writes; i++ {
+				_, err := shard.putObjectIfNewer(ctx, version(1000))
+				assert.Nil(t, err)
+			}
+		}()
+		wg.Wait()
+
+		assert.Equal(t, int64(1000+writes), lastUpdate(t))
+	})
+}
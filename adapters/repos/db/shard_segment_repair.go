@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func (s *Shard) segmentIntegrity() lsmkv.BucketOption {
+	return lsmkv.WithSegmentIntegrity(s.index.Config.LSMScrubInterval, s.onSegmentCorruption)
+}
+
+// onSegmentCorruption is called by the buckets of the shard when a segment
+// fails checksum verification. If repairs are enabled, corrupted segments of
+// the objects bucket are removed and their objects are restored from the
+// replicas of the shard. Other buckets can only be restored by reindexing.
+func (s *Shard) onSegmentCorruption(corruption lsmkv.CorruptSegment) {
+	if !s.index.Config.LSMScrubRepair {
+		return
+	}
+	if corruption.Bucket != helpers.ObjectsBucketLSM {
+		s.index.logger.WithField("action", "lsm_segment_repair").
+			WithField("shard", s.ID()).
+			WithField("path", corruption.Path).
+			Warn("corrupted segment cannot be repaired from replicas, the shard needs to be reindexed")
+		return
+	}
+
+	s.segmentRepairLock.Lock()
+	s.segmentRepairPending = true
+	s.segmentRepairLock.Unlock()
+
+	s.startPendingSegmentRepair()
+}
+
+// startPendingSegmentRepair starts a repair if segments were corrupted since
+// the last one was started. Only one repair runs at a time and none is
+// started before the shard is loaded.
+func (s *Shard) startPendingSegmentRepair() {
+	if s.GetStatus() == storagestate.StatusLoading {
+		return
+	}
+
+	s.segmentRepairLock.Lock()
+	defer s.segmentRepairLock.Unlock()
+
+	if !s.segmentRepairPending {
+		return
+	}
+	if s.segmentRepair != nil && s.segmentRepair.Status == models.SegmentRepairStatusStatusRUNNING {
+		return
+	}
+
+	s.segmentRepairPending = false
+	s.segmentRepair = &models.SegmentRepairStatus{
+		Status:            models.SegmentRepairStatusStatusRUNNING,
+		StartedUnixMillis: time.Now().UnixMilli(),
+	}
+
+	enterrors.GoWrapper(func() {
+		err := s.repairCorruptSegments(s.index.closingCtx)
+		s.finishSegmentRepair(err)
+		// segments may have been corrupted while the repair was running
+		s.startPendingSegmentRepair()
+	}, s.index.logger)
+}
+
+func (s *Shard) repairCorruptSegments(ctx context.Context) error {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	if bucket == nil {
+		return fmt.Errorf("objects bucket not found")
+	}
+
+	quarantined, err := bucket.QuarantineCorruptSegments()
+	s.updateSegmentRepair(func(status *models.SegmentRepairStatus) {
+		status.QuarantinedSegments = append(status.QuarantinedSegments, quarantined...)
+	})
+	if err != nil {
+		return fmt.Errorf("quarantine corrupted segments: %w", err)
+	}
+
+	className := s.index.Config.ClassName.String()
+	replicas, err := s.index.getSchema.ShardReplicas(className, s.name)
+	if err != nil {
+		return fmt.Errorf("get replicas of shard: %w", err)
+	}
+
+	// the objects are copied from every replica, as the objects of the
+	// quarantined segments may not have reached all of them yet
+	var restoredFrom []string
+	var lastErr error
+	r := sharding.TokenRange{Source: s.name, From: 0, To: math.MaxUint64}
+	for _, node := range replicas {
+		if node == s.index.getSchema.NodeName() {
+			continue
+		}
+		host, ok := s.index.nodeResolver.NodeHostname(node)
+		if !ok || host == "" {
+			lastErr = fmt.Errorf("cannot resolve host of node %q", node)
+			continue
+		}
+
+		copied, _, err := s.copyTokenRange(ctx, s.name, host, r, 0)
+		s.updateSegmentRepair(func(status *models.SegmentRepairStatus) {
+			status.ObjectsCopied += int64(copied)
+		})
+		if err != nil {
+			lastErr = fmt.Errorf("copy objects from node %q: %w", node, err)
+			continue
+		}
+		restoredFrom = append(restoredFrom, node)
+	}
+	if len(restoredFrom) == 0 {
+		if lastErr == nil {
+			lastErr = fmt.Errorf("shard has no other replicas")
+		}
+		return lastErr
+	}
+
+	// the hashtree still contains the objects of the quarantined segments
+	if s.index.asyncReplicationEnabled() {
+		if err := s.UpdateAsyncReplication(ctx, false); err != nil {
+			return err
+		}
+		if err := s.UpdateAsyncReplication(ctx, true); err != nil {
+			return err
+		}
+	}
+
+	sort.Strings(restoredFrom)
+	s.index.logger.WithFields(logrus.Fields{
+		"action":        "lsm_segment_repair",
+		"shard":         s.ID(),
+		"quarantined":   quarantined,
+		"restored_from": restoredFrom,
+	}).Info("restored corrupted segments from replicas")
+	return nil
+}
+
+func (s *Shard) updateSegmentRepair(update func(status *models.SegmentRepairStatus)) {
+	s.segmentRepairLock.Lock()
+	defer s.segmentRepairLock.Unlock()
+
+	update(s.segmentRepair)
+}
+
+func (s *Shard) finishSegmentRepair(err error) {
+	s.updateSegmentRepair(func(status *models.SegmentRepairStatus) {
+		status.FinishedUnixMillis = time.Now().UnixMilli()
+		if err != nil {
+			status.Status = models.SegmentRepairStatusStatusFAILED
+			status.Error = err.Error()
+			return
+		}
+		status.Status = models.SegmentRepairStatusStatusCOMPLETED
+	})
+
+	if err != nil {
+		s.index.logger.WithField("action", "lsm_segment_repair").
+			WithField("shard", s.ID()).
+			WithError(err).
+			Error("failed to repair corrupted segments")
+	}
+}
+
+// segmentIntegrityStatus returns the segments of the shard which failed
+// checksum verification and the running or last repair of the shard
+func (s *Shard) segmentIntegrityStatus() *models.SegmentIntegrityStatus {
+	var status models.SegmentIntegrityStatus
+	if s.store != nil {
+		for _, corruption := range s.store.CorruptSegments() {
+			status.CorruptSegments = append(status.CorruptSegments, &models.CorruptSegment{
+				Bucket:             corruption.Bucket,
+				Path:               corruption.Path,
+				Error:              corruption.Error,
+				DetectedUnixMillis: corruption.DetectedAt.UnixMilli(),
+			})
+		}
+	}
+
+	s.segmentRepairLock.Lock()
+	if s.segmentRepair != nil {
+		repair := *s.segmentRepair
+		repair.QuarantinedSegments = append([]string(nil), s.segmentRepair.QuarantinedSegments...)
+		status.Repair = &repair
+	}
+	s.segmentRepairLock.Unlock()
+
+	if status.CorruptSegments == nil && status.Repair == nil {
+		return nil
+	}
+	return &status
+}
@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestShardSegmentCorruption(t *testing.T) {
+	ctx := context.Background()
+	className := "CorruptedClass"
+
+	shd, _ := testShard(t, ctx, className, func(i *Index) {
+		i.Config.LSMScrubRepair = true
+	})
+	shard := loadedShard(t, shd)
+
+	// enough objects for the segment to span multiple checksum blocks
+	objs := createRandomObjects(getRandomSeed(), className, 1000, 16)
+	for _, err := range shard.PutObjectBatch(ctx, objs) {
+		require.Nil(t, err)
+	}
+	bucket := shard.Store().Bucket(helpers.ObjectsBucketLSM)
+	require.Nil(t, bucket.FlushAndSwitch())
+	assert.Nil(t, shard.segmentIntegrityStatus())
+
+	t.Run("corrupt the first block of the segment", func(t *testing.T) {
+		entries, err := os.ReadDir(bucket.GetDir())
+		require.Nil(t, err)
+		var path string
+		for _, entry := range entries {
+			if strings.HasSuffix(entry.Name(), ".db") {
+				path = filepath.Join(bucket.GetDir(), entry.Name())
+			}
+		}
+		require.NotEmpty(t, path)
+
+		f, err := os.OpenFile(path, os.O_RDWR, 0o666)
+		require.Nil(t, err)
+		defer f.Close()
+		b := make([]byte, 1)
+		_, err = f.ReadAt(b, segmentindex.HeaderSize+100)
+		require.Nil(t, err)
+		_, err = f.WriteAt([]byte{b[0] ^ 0x01}, segmentindex.HeaderSize+100)
+		require.Nil(t, err)
+	})
+
+	t.Run("reading the corrupted objects fails", func(t *testing.T) {
+		var corrupted int
+		for _, obj := range objs {
+			_, err := shard.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+			if err != nil {
+				require.True(t, errors.Is(err, segmentindex.ErrChecksumMismatch), err)
+				corrupted++
+			}
+		}
+		assert.Greater(t, corrupted, 0)
+	})
+
+	t.Run("the shard cannot be repaired without replicas", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			status := shard.segmentIntegrityStatus()
+			return status != nil && status.Repair != nil &&
+				status.Repair.Status != models.SegmentRepairStatusStatusRUNNING
+		}, 10*time.Second, 10*time.Millisecond)
+
+		status := shard.segmentIntegrityStatus()
+		assert.Equal(t, models.SegmentRepairStatusStatusFAILED, status.Repair.Status)
+		assert.Equal(t, "shard has no other replicas", status.Repair.Error)
+		assert.Len(t, status.Repair.QuarantinedSegments, 1)
+		// the corrupted segment was removed from the bucket
+		assert.Empty(t, status.CorruptSegments)
+		_, err := os.Stat(status.Repair.QuarantinedSegments[0] + ".corrupt")
+		assert.Nil(t, err)
+	})
+}
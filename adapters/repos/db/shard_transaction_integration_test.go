@@ -0,0 +1,271 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestShardTransactionalBatch(t *testing.T) {
+	ctx := context.Background()
+	className := "TransactionClass"
+
+	shd, _ := testShard(t, ctx, className)
+	shard := loadedShard(t, shd)
+
+	objs := createRandomObjects(getRandomSeed(), className, 3, 16)
+	for i, obj := range objs {
+		obj.Object.LastUpdateTimeUnix = int64(100 + i)
+	}
+	notExisting := int64(0)
+
+	lastUpdate := func(t *testing.T, obj *storobj.Object) int64 {
+		found, err := shard.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		if found == nil {
+			return 0
+		}
+		return found.LastUpdateTimeUnix()
+	}
+
+	t.Run("commit a transaction", func(t *testing.T) {
+		errs := shard.PutObjectBatchTransactional(ctx, objs[:2],
+			[]*int64{&notExisting, &notExisting})
+		for _, err := range errs {
+			require.Nil(t, err)
+		}
+		assert.Equal(t, int64(100), lastUpdate(t, objs[0]))
+		assert.Equal(t, int64(101), lastUpdate(t, objs[1]))
+
+		_, err := os.Stat(filepath.Join(shard.path(), transactionUndoLog))
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+	})
+
+	t.Run("abort a transaction with an outdated version", func(t *testing.T) {
+		update := *objs[0]
+		update.Object.LastUpdateTimeUnix = 200
+		outdated := int64(99)
+
+		errs := shard.PutObjectBatchTransactional(ctx, []*storobj.Object{objs[2], &update},
+			[]*int64{&notExisting, &outdated})
+		require.Len(t, errs, 2)
+		assert.ErrorContains(t, errs[0], "transaction rolled back, object 1 failed")
+		assert.ErrorAs(t, errs[1], &objects.ErrPreconditionFailed{})
+
+		assert.Equal(t, int64(0), lastUpdate(t, objs[2]))
+		assert.Equal(t, int64(100), lastUpdate(t, objs[0]))
+	})
+
+	t.Run("commit a transaction with matching versions", func(t *testing.T) {
+		update := *objs[0]
+		update.Object.LastUpdateTimeUnix = 200
+		current := int64(100)
+
+		errs := shard.PutObjectBatchTransactional(ctx, []*storobj.Object{&update, objs[2]},
+			[]*int64{&current, nil})
+		for _, err := range errs {
+			require.Nil(t, err)
+		}
+		assert.Equal(t, int64(200), lastUpdate(t, objs[0]))
+		assert.Equal(t, int64(102), lastUpdate(t, objs[2]))
+	})
+
+	t.Run("roll back a transaction interrupted by a crash", func(t *testing.T) {
+		previous, err := shard.ObjectByID(ctx, objs[1].ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		added := createRandomObjects(getRandomSeed(), className, 1, 16)[0]
+		id1, err := parseBytesUUID(objs[1].ID())
+		require.Nil(t, err)
+		idAdded, err := parseBytesUUID(added.ID())
+		require.Nil(t, err)
+
+		require.Nil(t, shard.prepareUndoLog([]beforeImage{
+			{id: id1, object: previous},
+			{id: idAdded},
+		}))
+		require.Nil(t, shard.activateUndoLog())
+		update := *objs[1]
+		update.Object.LastUpdateTimeUnix = 300
+		added.Object.LastUpdateTimeUnix = 300
+		for _, err := range shard.putBatch(ctx, []*storobj.Object{&update, added}) {
+			require.Nil(t, err)
+		}
+		// an undo log which was not completed is discarded
+		require.Nil(t, os.WriteFile(filepath.Join(shard.path(), transactionUndoLog+".tmp"),
+			[]byte("incomplete"), 0o666))
+
+		require.Nil(t, shard.rollbackPendingTransaction(ctx))
+
+		assert.Equal(t, int64(101), lastUpdate(t, objs[1]))
+		assert.Equal(t, int64(0), lastUpdate(t, added))
+		entries, err := os.ReadDir(shard.path())
+		require.Nil(t, err)
+		for _, entry := range entries {
+			assert.NotContains(t, entry.Name(), transactionUndoLog)
+		}
+	})
+}
+
+func TestShardTransactionalBatchConcurrentList(t *testing.T) {
+	ctx := context.Background()
+	className := "TransactionListClass"
+
+	shd, _ := testShard(t, ctx, className)
+	shard := loadedShard(t, shd)
+
+	objs := createRandomObjects(getRandomSeed(), className, 2, 16)
+	versions := make([]*int64, len(objs))
+	for i := range objs {
+		versions[i] = new(int64)
+	}
+	// every transaction updates both objects to the same version
+	update := func(version int64) []*storobj.Object {
+		out := make([]*storobj.Object, len(objs))
+		for i, obj := range objs {
+			updated := *obj
+			updated.Object.LastUpdateTimeUnix = version
+			out[i] = &updated
+		}
+		return out
+	}
+
+	done := make(chan struct{})
+	listed := make(chan error, 1)
+	go func() {
+		defer close(listed)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			list, err := shard.ObjectList(ctx, 10, nil, nil, additional.Properties{}, "")
+			if err != nil {
+				listed <- err
+				return
+			}
+			if len(list) == 0 {
+				continue
+			}
+			if len(list) != len(objs) {
+				listed <- fmt.Errorf("listed %d of %d objects", len(list), len(objs))
+				return
+			}
+			if list[0].LastUpdateTimeUnix() != list[1].LastUpdateTimeUnix() {
+				listed <- fmt.Errorf("listed versions %d and %d",
+					list[0].LastUpdateTimeUnix(), list[1].LastUpdateTimeUnix())
+				return
+			}
+		}
+	}()
+
+	for version := int64(1); version <= 100; version++ {
+		for _, err := range shard.PutObjectBatchTransactional(ctx, update(version), versions) {
+			require.Nil(t, err)
+		}
+		for i := range versions {
+			*versions[i] = version
+		}
+	}
+	close(done)
+	assert.Nil(t, <-listed)
+}
+
+func TestShardConditionalWrites(t *testing.T) {
+	ctx := context.Background()
+	className := "ConditionalClass"
+
+	shd, _ := testShard(t, ctx, className)
+	shard := loadedShard(t, shd)
+
+	obj := createRandomObjects(getRandomSeed(), className, 1, 16)[0]
+	obj.Object.LastUpdateTimeUnix = 100
+	require.Nil(t, shard.PutObject(ctx, obj))
+
+	lastUpdate := func(t *testing.T) int64 {
+		found, err := shard.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		if found == nil {
+			return 0
+		}
+		return found.LastUpdateTimeUnix()
+	}
+	version := func(v int64) *int64 { return &v }
+	merge := func(updateTime int64, expectedVersion *int64) objects.MergeDocument {
+		// merges which do not change the object are skipped, so change the vector
+		vector := make([]float32, len(obj.Vector))
+		for i := range vector {
+			vector[i] = float32(updateTime + int64(i))
+		}
+		return objects.MergeDocument{
+			Class:           className,
+			ID:              obj.ID(),
+			PrimitiveSchema: map[string]interface{}{},
+			Vector:          vector,
+			UpdateTime:      updateTime,
+			ExpectedVersion: expectedVersion,
+		}
+	}
+
+	t.Run("merge with an outdated version", func(t *testing.T) {
+		err := shard.MergeObject(ctx, merge(200, version(99)))
+		assert.ErrorAs(t, err, &objects.ErrPreconditionFailed{})
+		assert.Equal(t, int64(100), lastUpdate(t))
+	})
+
+	t.Run("merge with the current version", func(t *testing.T) {
+		require.Nil(t, shard.MergeObject(ctx, merge(200, version(100))))
+		assert.Equal(t, int64(200), lastUpdate(t))
+	})
+
+	t.Run("merge which would not advance the version", func(t *testing.T) {
+		err := shard.MergeObject(ctx, merge(200, version(200)))
+		assert.ErrorAs(t, err, &objects.ErrPreconditionFailed{})
+		assert.ErrorContains(t, err, "retry the write")
+
+		update := *obj
+		update.Object.LastUpdateTimeUnix = 200
+		errs := shard.PutObjectBatchTransactional(ctx, []*storobj.Object{&update}, []*int64{version(200)})
+		assert.ErrorAs(t, errs[0], &objects.ErrPreconditionFailed{})
+		assert.Equal(t, int64(200), lastUpdate(t))
+	})
+
+	t.Run("delete with an outdated version", func(t *testing.T) {
+		err := shard.DeleteObject(ctx, obj.ID(), version(100))
+		assert.ErrorAs(t, err, &objects.ErrPreconditionFailed{})
+		assert.Equal(t, int64(200), lastUpdate(t))
+	})
+
+	t.Run("delete with the current version", func(t *testing.T) {
+		require.Nil(t, shard.DeleteObject(ctx, obj.ID(), version(200)))
+		assert.Equal(t, int64(0), lastUpdate(t))
+	})
+
+	t.Run("delete a missing object with a version", func(t *testing.T) {
+		err := shard.DeleteObject(ctx, obj.ID(), version(200))
+		assert.ErrorAs(t, err, &objects.ErrPreconditionFailed{})
+		assert.Nil(t, shard.DeleteObject(ctx, obj.ID(), version(0)))
+	})
+}
@@ -30,6 +30,9 @@ import (
 
 // return value map[int]error gives the error for the index as it received it
 func (s *Shard) DeleteObjectBatch(ctx context.Context, uuids []strfmt.UUID, dryRun bool) objects.BatchSimpleObjects {
+	s.transactionLock.RLock()
+	defer s.transactionLock.RUnlock()
+
 	s.activityTracker.Add(1)
 	if s.isReadOnly() {
 		return objects.BatchSimpleObjects{
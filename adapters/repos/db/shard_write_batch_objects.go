@@ -33,6 +33,9 @@ import (
 func (s *Shard) PutObjectBatch(ctx context.Context,
 	objects []*storobj.Object,
 ) []error {
+	s.transactionLock.RLock()
+	defer s.transactionLock.RUnlock()
+
 	s.activityTracker.Add(1)
 	if s.isReadOnly() {
 		return []error{storagestate.ErrStatusReadOnly}
@@ -327,7 +330,7 @@ func (ob *objectsBatcher) storeAdditionalStorageWithAsyncQueue(ctx context.Conte
 
 		// skip vector update, as vector was not changed
 		// https://github.com/weaviate/weaviate/issues/3948
-		if status.docIDPreserved {
+		if status.docIDPreserved && len(status.addedTargetVectors) == 0 {
 			continue
 		}
 
@@ -337,6 +340,9 @@ func (ob *objectsBatcher) storeAdditionalStorageWithAsyncQueue(ctx context.Conte
 
 		if hasTargetVectors {
 			for targetVector, vector := range object.Vectors {
+				if !status.updatesVectorIndex(targetVector) {
+					continue
+				}
 				targetVectors[targetVector] = append(targetVectors[targetVector], vectorDescriptor{
 					id:     status.docID,
 					vector: vector,
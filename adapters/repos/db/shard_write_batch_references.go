@@ -28,6 +28,9 @@ import (
 
 // return value map[int]error gives the error for the index as it received it
 func (s *Shard) AddReferencesBatch(ctx context.Context, refs objects.BatchReferences) []error {
+	s.transactionLock.RLock()
+	defer s.transactionLock.RUnlock()
+
 	s.activityTracker.Add(1)
 	if s.isReadOnly() {
 		return []error{errors.Errorf("shard is read-only")}
@@ -25,16 +25,32 @@ import (
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
-func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID) error {
+// DeleteObject deletes the object if it exists. If expectedVersion is set,
+// the object is only deleted if its lastUpdateTimeUnix matches, 0 expects the
+// object not to exist.
+func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID, expectedVersion *int64) error {
+	s.transactionLock.RLock()
+	defer s.transactionLock.RUnlock()
+
 	if s.isReadOnly() {
 		return storagestate.ErrStatusReadOnly
 	}
 
+	return s.deleteObject(ctx, id, expectedVersion)
+}
+
+func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID, expectedVersion *int64) error {
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
 	if err != nil {
 		return err
 	}
 
+	// see comment in shard_write_put.go::putObjectLSM, conditional writes
+	// must not miss a deletion between their check and their write
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
+	defer lock.Unlock()
+
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	existing, err := bucket.Get([]byte(idBytes))
 	if err != nil {
@@ -42,6 +58,9 @@ func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID) error {
 	}
 
 	if existing == nil {
+		if expectedVersion != nil {
+			return checkVersion(id, 0, *expectedVersion)
+		}
 		// nothing to do
 		return nil
 	}
@@ -52,6 +71,11 @@ func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID) error {
 	if err != nil {
 		return fmt.Errorf("get existing doc id from object binary: %w", err)
 	}
+	if expectedVersion != nil {
+		if err := checkVersion(id, updateTime, *expectedVersion); err != nil {
+			return err
+		}
+	}
 
 	err = bucket.Delete(idBytes)
 	if err != nil {
@@ -68,7 +92,7 @@ func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID) error {
 	}
 
 	if s.hasTargetVectors() {
-		for targetVector, queue := range s.queues {
+		for targetVector, queue := range s.Queues() {
 			if err = queue.Delete(docID); err != nil {
 				return fmt.Errorf("delete from vector index of vector %q: %w", targetVector, err)
 			}
@@ -87,6 +111,8 @@ func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID) error {
 		}
 	}
 
+	s.deletions.record(idBytes)
+
 	if err = s.mayDeleteObjectHashTree(idBytes, updateTime); err != nil {
 		return fmt.Errorf("object deletion in hashtree: %w", err)
 	}
@@ -122,7 +148,11 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 	if obj == nil || bucket == nil {
 		return nil
 	}
+	// see deleteObject
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
 	err := bucket.Delete(idBytes)
+	lock.Unlock()
 	if err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
@@ -137,12 +167,12 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 	}
 
 	if s.hasTargetVectors() {
-		for targetVector, queue := range s.queues {
+		for targetVector, queue := range s.Queues() {
 			if err = queue.Delete(docID); err != nil {
 				return fmt.Errorf("delete from vector index of vector %q: %w", targetVector, err)
 			}
 		}
-		for targetVector, vectorIndex := range s.vectorIndexes {
+		for targetVector, vectorIndex := range s.VectorIndexes() {
 			if err = vectorIndex.Flush(); err != nil {
 				return fmt.Errorf("flush all vector index buffered WALs of vector %q: %w", targetVector, err)
 			}
@@ -156,6 +186,8 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 		}
 	}
 
+	s.deletions.record(idBytes)
+
 	if err = s.mayDeleteObjectHashTree(idBytes, updateTime); err != nil {
 		return fmt.Errorf("store object deletion in hashtree: %w", err)
 	}
@@ -267,7 +267,12 @@ func (s *Shard) addToDimensionBucket(
 	if b == nil {
 		return errors.Errorf("no bucket dimensions")
 	}
+	return addDimensions(b, dimLength, docID, vecName, tombstone)
+}
 
+func addDimensions(b *lsmkv.Bucket, dimLength int, docID uint64, vecName string,
+	tombstone bool,
+) error {
 	tv := []byte(vecName)
 	// 8 bytes for doc id (map key)
 	// 4 bytes for dim count (row key)
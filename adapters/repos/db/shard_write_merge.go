@@ -25,6 +25,9 @@ import (
 )
 
 func (s *Shard) MergeObject(ctx context.Context, merge objects.MergeDocument) error {
+	s.transactionLock.RLock()
+	defer s.transactionLock.RUnlock()
+
 	s.activityTracker.Add(1)
 	if s.isReadOnly() {
 		return storagestate.ErrStatusReadOnly
@@ -120,6 +123,15 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 		if err != nil {
 			return errors.Wrap(err, "get bucket")
 		}
+		if merge.ExpectedVersion != nil {
+			if err := checkObjectVersionUpdate(merge.ID, prevObj, *merge.ExpectedVersion,
+				merge.UpdateTime); err != nil {
+				return err
+			}
+		}
+		if prevObj == nil && merge.RequireExisting {
+			return objects.NewErrNotFound("object %s does not exist", merge.ID)
+		}
 
 		obj, _, err = s.mergeObjectData(prevObj, merge)
 		if err != nil {
@@ -269,6 +281,10 @@ func mergeProps(previous *storobj.Object,
 		properties[propName] = value
 	}
 
+	for propName, values := range merge.PropertiesToAppend {
+		properties[propName] = objects.AppendValues(properties[propName], values)
+	}
+
 	for _, ref := range merge.References {
 		propName := ref.From.Property.String()
 		prop := properties[propName]
@@ -26,12 +26,16 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	entlsmkv "github.com/weaviate/weaviate/entities/lsmkv"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
 func (s *Shard) PutObject(ctx context.Context, object *storobj.Object) error {
+	s.transactionLock.RLock()
+	defer s.transactionLock.RUnlock()
+
 	s.activityTracker.Add(1)
 	if s.isReadOnly() {
 		return storagestate.ErrStatusReadOnly
@@ -43,18 +47,55 @@ func (s *Shard) PutObject(ctx context.Context, object *storobj.Object) error {
 	return s.putOne(ctx, uuid, object)
 }
 
+// putObjectIfNewer puts the object unless the stored object was deleted or is
+// at least as new. The stored object is checked while holding its docIdLock,
+// so that a concurrent write is never replaced by an older version. It
+// reports whether the object was written.
+func (s *Shard) putObjectIfNewer(ctx context.Context, object *storobj.Object) (bool, error) {
+	s.transactionLock.RLock()
+	defer s.transactionLock.RUnlock()
+
+	if s.isReadOnly() {
+		return false, storagestate.ErrStatusReadOnly
+	}
+	uuid, err := uuid.MustParse(object.ID().String()).MarshalBinary()
+	if err != nil {
+		return false, err
+	}
+	return s.putOneIf(ctx, uuid, object, func(prev *storobj.Object, deleted bool) bool {
+		return !deleted && (prev == nil || prev.LastUpdateTimeUnix() < object.LastUpdateTimeUnix())
+	})
+}
+
 func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object) error {
-	status, err := s.putObjectLSM(object, uuid)
+	_, err := s.putOneIf(ctx, uuid, object, nil)
+	return err
+}
+
+func (s *Shard) putOneIf(ctx context.Context, uuid []byte, object *storobj.Object,
+	cond putCondition,
+) (bool, error) {
+	status, err := s.putObjectLSMIf(object, uuid, cond)
 	if err != nil {
-		return errors.Wrap(err, "store object in LSM store")
+		return false, errors.Wrap(err, "store object in LSM store")
+	}
+	if status.conditionFailed {
+		return false, nil
 	}
 
 	// object was not changed, no further updates are required
 	// https://github.com/weaviate/weaviate/issues/3949
 	if status.skipUpsert {
-		return nil
+		return true, nil
 	}
 
+	if err := s.putOneIndexes(object, uuid, status); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *Shard) putOneIndexes(object *storobj.Object, uuid []byte, status objectInsertStatus) error {
 	if s.hasTargetVectors() {
 		for targetVector, vector := range object.Vectors {
 			if err := s.updateVectorIndexForName(vector, status, targetVector); err != nil {
@@ -121,7 +162,7 @@ func (s *Shard) updateVectorIndexesIgnoreDelete(vectors map[string][]float32,
 	// vector was not changed, object was not changed or changed without changing vector
 	// https://github.com/weaviate/weaviate/issues/3948
 	// https://github.com/weaviate/weaviate/issues/3949
-	if status.docIDPreserved || status.skipUpsert {
+	if status.skipUpsert {
 		return nil
 	}
 
@@ -132,6 +173,9 @@ func (s *Shard) updateVectorIndexesIgnoreDelete(vectors map[string][]float32,
 	}
 
 	for targetVector, vector := range vectors {
+		if !status.updatesVectorIndex(targetVector) {
+			continue
+		}
 		if vectorIndex := s.VectorIndexForName(targetVector); vectorIndex != nil {
 			if err := vectorIndex.Add(status.docID, vector); err != nil {
 				return errors.Wrapf(err, "insert doc id %d to vector index for target vector %s", status.docID, targetVector)
@@ -151,7 +195,7 @@ func (s *Shard) updateVectorIndex(vector []float32,
 func (s *Shard) updateVectorIndexForName(vector []float32,
 	status objectInsertStatus, targetVector string,
 ) error {
-	queue, ok := s.queues[targetVector]
+	queue, ok := s.Queues()[targetVector]
 	if !ok {
 		return fmt.Errorf("vector queue not found for target vector %s", targetVector)
 	}
@@ -159,6 +203,11 @@ func (s *Shard) updateVectorIndexForName(vector []float32,
 	if vectorIndex == nil {
 		return fmt.Errorf("vector index not found for target vector %s", targetVector)
 	}
+	if status.docIDPreserved && status.updatesVectorIndex(targetVector) {
+		// the vector was added to the object, it is inserted with the
+		// preserved docID
+		status.docIDPreserved = false
+	}
 	return s.updateVectorInVectorIndex(vector, status, queue, vectorIndex)
 }
 
@@ -215,7 +264,36 @@ func fetchObject(bucket *lsmkv.Bucket, idBytes []byte) (*storobj.Object, error)
 	return obj, nil
 }
 
+// putCondition is checked against the stored object while its docIdLock is
+// held, prev is nil if the object does not exist or was deleted. The put is
+// skipped if it returns false.
+type putCondition func(prev *storobj.Object, deleted bool) bool
+
+func fetchObjectErrDeleted(bucket *lsmkv.Bucket, idBytes []byte) (*storobj.Object, bool, error) {
+	objBytes, err := bucket.GetErrDeleted(idBytes)
+	if errors.Is(err, entlsmkv.Deleted) {
+		return nil, true, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if len(objBytes) == 0 {
+		return nil, false, nil
+	}
+
+	obj, err := storobj.FromBinary(objBytes)
+	if err != nil {
+		return nil, false, err
+	}
+	return obj, false, nil
+}
+
 func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
+) (status objectInsertStatus, err error) {
+	return s.putObjectLSMIf(obj, idBytes, nil)
+}
+
+func (s *Shard) putObjectLSMIf(obj *storobj.Object, idBytes []byte, cond putCondition,
 ) (status objectInsertStatus, err error) {
 	before := time.Now()
 	defer s.metrics.PutObject(before)
@@ -256,9 +334,22 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 		var err error
 
 		before = time.Now()
-		prevObj, err = fetchObject(bucket, idBytes)
-		if err != nil {
-			return err
+		if cond == nil {
+			prevObj, err = fetchObject(bucket, idBytes)
+			if err != nil {
+				return err
+			}
+		} else {
+			var deleted bool
+			prevObj, deleted, err = fetchObjectErrDeleted(bucket, idBytes)
+			if err != nil {
+				return err
+			}
+			if !cond(prevObj, deleted) {
+				status.skipUpsert = true
+				status.conditionFailed = true
+				return nil
+			}
 		}
 
 		status, err = s.determineInsertStatus(prevObj, obj)
@@ -358,6 +449,26 @@ type objectInsertStatus struct {
 	// the one already stored. No object update, inverted indexes update and vector index
 	// update is required.
 	skipUpsert bool
+	// the condition of the put did not hold, nothing was written
+	conditionFailed bool
+	// target vectors which the previous object didn't have. If nothing else
+	// requires a new docID, the docID is preserved and only these vectors are
+	// added to their vector indexes.
+	addedTargetVectors []string
+}
+
+// updatesVectorIndex returns whether the vector of the target vector has to
+// be added to its vector index
+func (s objectInsertStatus) updatesVectorIndex(targetVector string) bool {
+	if !s.docIDPreserved {
+		return true
+	}
+	for _, added := range s.addedTargetVectors {
+		if added == targetVector {
+			return true
+		}
+	}
+	return false
 }
 
 // to be called with the current contents of a row, if the row is empty (i.e.
@@ -396,6 +507,15 @@ func (s *Shard) determineInsertStatus(prevObj, nextObj *storobj.Object) (objectI
 		return out, nil
 	}
 
+	// Vectors for target vectors added to the class don't require a new docID
+	// either, so the other vectors of the object aren't inserted again.
+	if added := addedTargetVectors(prevObj, nextObj); len(added) > 0 {
+		out.docID = prevObj.DocID
+		out.docIDPreserved = true
+		out.addedTargetVectors = added
+		return out, nil
+	}
+
 	docID, err := s.counter.GetAndInc()
 	if err != nil {
 		return out, errors.Wrap(err, "doc id update: get new doc id from counter")
@@ -570,6 +690,41 @@ func compareObjsForInsertStatus(prevObj, nextObj *storobj.Object) (preserve, ski
 	return false, true
 }
 
+// addedTargetVectors returns the target vectors of nextObj which prevObj
+// doesn't have, if all other vectors and geo properties are unchanged
+func addedTargetVectors(prevObj, nextObj *storobj.Object) []string {
+	if len(nextObj.Vectors) <= len(prevObj.Vectors) {
+		return nil
+	}
+	// objects without a legacy vector are read back with an empty one
+	if (len(prevObj.Vector) > 0 || len(nextObj.Vector) > 0) &&
+		!common.VectorsEqual(prevObj.Vector, nextObj.Vector) {
+		return nil
+	}
+	prevProps, ok := prevObj.Object.Properties.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	nextProps, ok := nextObj.Object.Properties.(map[string]interface{})
+	if !ok || !geoPropsEqual(prevProps, nextProps) {
+		return nil
+	}
+
+	for targetVector, prevVector := range prevObj.Vectors {
+		nextVector, ok := nextObj.Vectors[targetVector]
+		if !ok || !common.VectorsEqual(prevVector, nextVector) {
+			return nil
+		}
+	}
+	added := make([]string, 0, len(nextObj.Vectors)-len(prevObj.Vectors))
+	for targetVector := range nextObj.Vectors {
+		if _, ok := prevObj.Vectors[targetVector]; !ok {
+			added = append(added, targetVector)
+		}
+	}
+	return added
+}
+
 func geoPropsEqual(prevProps, nextProps map[string]interface{}) bool {
 	geoPropsCompared := map[string]struct{}{}
 
@@ -0,0 +1,535 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+// A transactional batch applies all of its objects or none of them.
+// Transactional batches are applied one at a time, as they share the undo log.
+// While a batch is written, the shard's transactionLock is held exclusively, so
+// that reads and other writes, which hold it shared, never observe a partially
+// applied transaction.
+//
+// Before anything is written, the previous state of every object of the
+// transaction is persisted in an undo log. The objects are validated and the
+// undo log is prepared without the transactionLock, only the check that no
+// other write changed the objects in the meantime, activating the undo log and
+// the writes themselves happen while it is held. The objects are written
+// directly to the shard, they are not staged.
+//
+// The transaction commits by removing the undo log. If a write fails, the
+// previous state is restored, if the node crashes, it is restored when the
+// shard is loaded again. Restoring deletes the objects and puts their previous
+// state again, so restored objects get new docIDs, just as if they had been
+// updated. DocIDs are never reused, because the vector indexes must not see a
+// deleted docID again.
+//
+// The new docIDs do not break the all-or-nothing visibility: the rollback runs
+// before the exclusive transactionLock is released, so no read observes the
+// objects of the transaction, nor their deletion in between. Afterwards reads
+// find the previous state of every object, the docID is internal to the shard
+// and every read path resolves it while holding the lock. A rollback after a
+// crash completes while the shard is loaded, before it serves any read.
+const transactionUndoLog = "transaction.undo"
+
+// PutObjectBatchTransactional puts all objects or none of them. An expected
+// version is the lastUpdateTimeUnix the stored object must have, 0 expects the
+// object not to exist and nil skips the check.
+func (s *Shard) PutObjectBatchTransactional(ctx context.Context,
+	objs []*storobj.Object, expectedVersions []*int64,
+) []error {
+	s.activityTracker.Add(1)
+	if s.isReadOnly() {
+		return duplicateErr(storagestate.ErrStatusReadOnly, len(objs))
+	}
+
+	return s.putBatchTransactional(ctx, objs, expectedVersions)
+}
+
+func (s *Shard) putBatchTransactional(ctx context.Context,
+	objs []*storobj.Object, expectedVersions []*int64,
+) []error {
+	s.transactionWriteLock.Lock()
+	defer s.transactionWriteLock.Unlock()
+
+	if errs := s.validateTransaction(objs); errs != nil {
+		return abortTransaction(errs)
+	}
+	images, errs := s.beforeImages(objs, expectedVersions)
+	if errs != nil {
+		return abortTransaction(errs)
+	}
+	if err := s.prepareUndoLog(images); err != nil {
+		return duplicateErr(fmt.Errorf("begin transaction: %w", err), len(objs))
+	}
+
+	s.transactionLock.Lock()
+	defer s.transactionLock.Unlock()
+
+	// other writes may have changed the objects while the undo log was prepared
+	current, errs := s.beforeImages(objs, expectedVersions)
+	if errs != nil {
+		s.discardUndoLog()
+		return abortTransaction(errs)
+	}
+	if !sameBeforeImages(images, current) {
+		images = current
+		if err := s.prepareUndoLog(images); err != nil {
+			return duplicateErr(fmt.Errorf("begin transaction: %w", err), len(objs))
+		}
+	}
+	if err := s.activateUndoLog(); err != nil {
+		return duplicateErr(fmt.Errorf("begin transaction: %w", err), len(objs))
+	}
+
+	errs = s.putBatch(ctx, objs)
+	if firstError(errs) == nil {
+		if err := s.removeUndoLog(); err != nil {
+			// the transaction is rolled back when the shard is loaded again
+			return duplicateErr(fmt.Errorf("commit transaction: %w", err), len(objs))
+		}
+		return errs
+	}
+
+	// the context may have been cancelled, which must not prevent the rollback
+	if err := s.rollbackTransaction(context.Background(), images); err != nil {
+		s.index.logger.WithField("action", "transaction_rollback").
+			WithField("shard", s.ID()).WithError(err).
+			Error("roll back transaction, retrying when the shard is loaded again")
+		return duplicateErr(fmt.Errorf("roll back transaction: %w", err), len(objs))
+	}
+	if err := s.removeUndoLog(); err != nil {
+		return duplicateErr(fmt.Errorf("roll back transaction: %w", err), len(objs))
+	}
+
+	return abortTransaction(errs)
+}
+
+// beforeImage is the state of an object before a transaction, object and data
+// are nil if it did not exist
+type beforeImage struct {
+	id     []byte
+	object *storobj.Object
+	// data is the stored binary object
+	data []byte
+}
+
+// validateTransaction validates all objects before anything is written, so
+// that a transaction rarely needs to be rolled back. It returns an error per
+// object or nil if all of them are valid.
+func (s *Shard) validateTransaction(objs []*storobj.Object) []error {
+	errs := make([]error, len(objs))
+	for i, obj := range objs {
+		if _, err := parseBytesUUID(obj.ID()); err != nil {
+			errs[i] = err
+			continue
+		}
+		errs[i] = s.validateVectors(obj)
+	}
+
+	if firstError(errs) != nil {
+		return errs
+	}
+	return nil
+}
+
+// beforeImages checks the expected versions and returns the previous state of
+// the objects or an error per object
+func (s *Shard) beforeImages(objs []*storobj.Object, expectedVersions []*int64,
+) ([]beforeImage, []error) {
+	errs := make([]error, len(objs))
+	images := make([]beforeImage, 0, len(objs))
+	seen := make(map[strfmt.UUID]struct{}, len(objs))
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+
+	for i, obj := range objs {
+		id, err := parseBytesUUID(obj.ID())
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+
+		image := beforeImage{id: id}
+		data, err := bucket.Get(id)
+		if err != nil {
+			errs[i] = fmt.Errorf("get previous object: %w", err)
+			continue
+		}
+		if len(data) > 0 {
+			image.data = slices.Clone(data)
+			if image.object, err = storobj.FromBinary(image.data); err != nil {
+				errs[i] = fmt.Errorf("get previous object: %w", err)
+				continue
+			}
+		}
+		if i < len(expectedVersions) && expectedVersions[i] != nil {
+			if err := checkObjectVersionUpdate(obj.ID(), image.object, *expectedVersions[i],
+				obj.LastUpdateTimeUnix()); err != nil {
+				errs[i] = err
+				continue
+			}
+		}
+
+		// the first occurrence holds the state before the transaction
+		if _, ok := seen[obj.ID()]; !ok {
+			seen[obj.ID()] = struct{}{}
+			images = append(images, image)
+		}
+	}
+
+	if firstError(errs) != nil {
+		return nil, errs
+	}
+	return images, nil
+}
+
+func sameBeforeImages(a, b []beforeImage) bool {
+	return slices.EqualFunc(a, b, func(x, y beforeImage) bool {
+		return bytes.Equal(x.id, y.id) && bytes.Equal(x.data, y.data)
+	})
+}
+
+func (s *Shard) validateVectors(obj *storobj.Object) error {
+	if s.hasTargetVectors() {
+		for targetVector, vector := range obj.Vectors {
+			if vectorIndex := s.VectorIndexForName(targetVector); vectorIndex != nil {
+				if err := vectorIndex.ValidateBeforeInsert(vector); err != nil {
+					return fmt.Errorf("validate vector index for target vector %s: %w", targetVector, err)
+				}
+			}
+		}
+		return nil
+	}
+
+	if obj.Vector != nil {
+		if err := s.vectorIndex.ValidateBeforeInsert(obj.Vector); err != nil {
+			return fmt.Errorf("validate vector index: %w", err)
+		}
+	}
+	return nil
+}
+
+// checkObjectVersion compares the lastUpdateTimeUnix of the stored object
+// with the expected one, 0 expects the object not to exist
+func checkObjectVersion(id strfmt.UUID, prev *storobj.Object, expected int64) error {
+	var actual int64
+	if prev != nil {
+		actual = prev.LastUpdateTimeUnix()
+	}
+	return checkVersion(id, actual, expected)
+}
+
+// checkObjectVersionUpdate checks the expected version of a conditional write
+// and that the write advances the version to next. The version has a
+// resolution of milliseconds, a second write in the same millisecond would
+// keep the version, so that the first version would still match. Such writes
+// are refused and need to be retried.
+func checkObjectVersionUpdate(id strfmt.UUID, prev *storobj.Object, expected, next int64) error {
+	if err := checkObjectVersion(id, prev, expected); err != nil {
+		return err
+	}
+	if prev != nil && next <= prev.LastUpdateTimeUnix() {
+		return objects.NewErrPreconditionFailed(
+			"object %s has version %d, the write would not advance it to a newer version, retry the write",
+			id, prev.LastUpdateTimeUnix())
+	}
+	return nil
+}
+
+// checkVersion compares the version of a stored object, which is 0 if it does
+// not exist, with the expected one
+func checkVersion(id strfmt.UUID, actual, expected int64) error {
+	if actual != expected {
+		return objects.NewErrPreconditionFailed(
+			"object %s has version %d, expected version %d", id, actual, expected)
+	}
+	return nil
+}
+
+// rollbackTransaction restores the state of all objects of a transaction, no
+// matter which of them were written already. The current object is deleted
+// before its previous state is put again, an unchanged object would be
+// skipped otherwise and keep the update time of the transaction.
+func (s *Shard) rollbackTransaction(ctx context.Context, images []beforeImage) error {
+	for _, image := range images {
+		id, err := uuid.FromBytes(image.id)
+		if err != nil {
+			return err
+		}
+		bucket, obj, idBytes, docID, updateTime, err := s.canDeleteOne(ctx, strfmt.UUID(id.String()))
+		if err != nil {
+			return fmt.Errorf("delete object %s: %w", id, err)
+		}
+		if err := s.deleteOne(ctx, bucket, obj, idBytes, docID, updateTime); err != nil {
+			return fmt.Errorf("delete object %s: %w", id, err)
+		}
+
+		if image.object != nil {
+			if err := s.putOne(ctx, image.id, image.object); err != nil {
+				return fmt.Errorf("restore object %s: %w", id, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// rollbackPendingTransaction rolls back a transaction which was interrupted
+// by a crash, it runs while the shard is loaded
+func (s *Shard) rollbackPendingTransaction(ctx context.Context) error {
+	path := filepath.Join(s.path(), transactionUndoLog)
+
+	// the transaction did not start writing before the undo log was activated
+	if err := os.Remove(path + ".tmp"); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove incomplete undo log: %w", err)
+	}
+
+	images, err := readUndoLog(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read undo log: %w", err)
+	}
+
+	s.index.logger.WithField("action", "transaction_rollback").
+		WithField("shard", s.ID()).WithField("objects", len(images)).
+		Warn("rolling back transaction which was interrupted")
+
+	if err := s.rollbackTransaction(ctx, images); err != nil {
+		return err
+	}
+	return s.removeUndoLog()
+}
+
+// prepareUndoLog persists the before images in a temporary file, each entry
+// is the uuid followed by the length of the binary object, which is 0 if the
+// object did not exist. The undo log only takes effect once it is activated.
+func (s *Shard) prepareUndoLog(images []beforeImage) error {
+	path := filepath.Join(s.path(), transactionUndoLog)
+
+	f, err := os.Create(path + ".tmp")
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	lengthBuf := make([]byte, 8)
+	for _, image := range images {
+		data := image.data
+		if data == nil && image.object != nil {
+			if data, err = image.object.MarshalBinary(); err != nil {
+				return fmt.Errorf("marshal object %s: %w", image.object.ID(), err)
+			}
+		}
+
+		binary.LittleEndian.PutUint64(lengthBuf, uint64(len(data)))
+		if _, err := w.Write(image.id); err != nil {
+			return err
+		}
+		if _, err := w.Write(lengthBuf); err != nil {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+// activateUndoLog makes a prepared undo log take effect, from now on the
+// transaction is rolled back if the shard is loaded before it is removed
+func (s *Shard) activateUndoLog() error {
+	path := filepath.Join(s.path(), transactionUndoLog)
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return err
+	}
+	return fsyncDir(s.path())
+}
+
+// discardUndoLog removes a prepared undo log of an aborted transaction
+func (s *Shard) discardUndoLog() {
+	path := filepath.Join(s.path(), transactionUndoLog+".tmp")
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		s.index.logger.WithField("action", "transaction_abort").
+			WithField("shard", s.ID()).WithError(err).
+			Warn("remove prepared undo log")
+	}
+}
+
+func readUndoLog(path string) ([]beforeImage, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var images []beforeImage
+	r := bufio.NewReader(f)
+	lengthBuf := make([]byte, 8)
+	for {
+		id := make([]byte, 16)
+		if _, err := io.ReadFull(r, id); err != nil {
+			if errors.Is(err, io.EOF) {
+				return images, nil
+			}
+			return nil, err
+		}
+		if _, err := io.ReadFull(r, lengthBuf); err != nil {
+			return nil, err
+		}
+
+		image := beforeImage{id: id}
+		if length := binary.LittleEndian.Uint64(lengthBuf); length > 0 {
+			data := make([]byte, length)
+			if _, err := io.ReadFull(r, data); err != nil {
+				return nil, err
+			}
+			if image.object, err = storobj.FromBinary(data); err != nil {
+				return nil, err
+			}
+		}
+		images = append(images, image)
+	}
+}
+
+func (s *Shard) removeUndoLog() error {
+	if err := os.Remove(filepath.Join(s.path(), transactionUndoLog)); err != nil {
+		return err
+	}
+	return fsyncDir(s.path())
+}
+
+func fsyncDir(path string) error {
+	dir, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
+
+// abortTransaction turns the errors of the failed objects into errors for
+// all objects of the transaction
+func abortTransaction(errs []error) []error {
+	pos, cause := 0, error(nil)
+	for i, err := range errs {
+		if err != nil {
+			pos, cause = i, err
+			break
+		}
+	}
+
+	out := make([]error, len(errs))
+	for i, err := range errs {
+		if err != nil {
+			out[i] = err
+		} else {
+			out[i] = fmt.Errorf("transaction rolled back, object %d failed: %w", pos, cause)
+		}
+	}
+	return out
+}
+
+func firstError(errs []error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Shard) preparePutObjectsTransactional(ctx context.Context, requestID string,
+	objs []*storobj.Object, expectedVersions []*int64,
+) replica.SimpleResponse {
+	// the versions are checked again when the transaction is committed,
+	// checking them now lets the coordinator abort it on all replicas
+	if errs := s.checkObjectVersions(objs, expectedVersions); firstError(errs) != nil {
+		resp := replica.SimpleResponse{Errors: make([]replica.Error, len(errs))}
+		for i, err := range abortTransaction(errs) {
+			resp.Errors[i] = replicaWriteError(err)
+		}
+		return resp
+	}
+
+	task := func(ctx context.Context) interface{} {
+		rawErrs := s.putBatchTransactional(ctx, objs, expectedVersions)
+		resp := replica.SimpleResponse{Errors: make([]replica.Error, len(rawErrs))}
+		for i, err := range rawErrs {
+			if err != nil {
+				resp.Errors[i] = replicaWriteError(err)
+			}
+		}
+		return resp
+	}
+	s.replicationMap.set(requestID, task)
+	return replica.SimpleResponse{}
+}
+
+// checkObjectVersions compares the stored objects with the expected versions
+// without holding any lock
+func (s *Shard) checkObjectVersions(objs []*storobj.Object, expectedVersions []*int64) []error {
+	errs := make([]error, len(objs))
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	for i, obj := range objs {
+		if i >= len(expectedVersions) || expectedVersions[i] == nil {
+			continue
+		}
+		id, err := parseBytesUUID(obj.ID())
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		prev, err := fetchObject(bucket, id)
+		if err != nil {
+			errs[i] = fmt.Errorf("get previous object: %w", err)
+			continue
+		}
+		errs[i] = checkObjectVersionUpdate(obj.ID(), prev, *expectedVersions[i],
+			obj.LastUpdateTimeUnix())
+	}
+	return errs
+}
+
+// replicaWriteError reports a failed write to the coordinator, which turns a
+// precondition failure back into objects.ErrPreconditionFailed
+func replicaWriteError(err error) replica.Error {
+	if errors.As(err, &objects.ErrPreconditionFailed{}) {
+		return replica.Error{Code: replica.StatusPreconditionFailed, Msg: err.Error()}
+	}
+	return replica.Error{Code: replica.StatusConflict, Msg: err.Error()}
+}
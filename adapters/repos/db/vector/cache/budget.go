@@ -0,0 +1,274 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cache
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+const (
+	defaultBudgetInterval = time.Second
+	// the budget evicts down to this fraction of its limit, so that it isn't
+	// exceeded again by the next few cache misses
+	budgetLowWatermark = 0.9
+	// the fraction of the used memory kept when the node runs out of memory
+	budgetPressureWatermark = 0.75
+)
+
+// Reasons for evicting vectors from a cache which shares a budget
+const (
+	EvictionReasonIdle           = "idle"
+	EvictionReasonLimit          = "limit"
+	EvictionReasonMemoryPressure = "memory_pressure"
+)
+
+type BudgetConfig struct {
+	// Limit is the memory in bytes the caches may use together
+	Limit int64
+	// IdleTimeout is the time after which a cache which wasn't read from is
+	// emptied, 0 disables it
+	IdleTimeout time.Duration
+	// Interval is the time between two checks of the budget
+	Interval          time.Duration
+	AllocChecker      memwatch.AllocChecker
+	Logger            logrus.FieldLogger
+	PrometheusMetrics *monitoring.PrometheusMetrics
+}
+
+// Budget limits the memory used by the vector caches of all shards of a node
+// together, instead of limiting every cache on its own. Vectors are evicted
+// from the least recently used caches first, and within a cache with a CLOCK
+// sweep, so that frequently queried shards keep most of the memory. Caches
+// which weren't read from for the idle timeout give all their memory back.
+type Budget struct {
+	limit        int64
+	idleTimeout  time.Duration
+	interval     time.Duration
+	allocChecker memwatch.AllocChecker
+	logger       logrus.FieldLogger
+
+	used atomic.Int64
+	// now is a coarse clock used to record when a cache was accessed last,
+	// it is much cheaper to read than time.Now() on every cache hit
+	now atomic.Int64
+
+	membersLock sync.Mutex
+	members     map[budgetMember]struct{}
+
+	trigger  chan struct{}
+	shutdown chan struct{}
+	done     chan struct{}
+
+	usedGauge  prometheus.Gauge
+	limitGauge prometheus.Gauge
+	evictions  *prometheus.CounterVec
+}
+
+// budgetMember is a cache sharing a budget
+type budgetMember interface {
+	// lastAccess returns the time of the last read (in ns since epoch)
+	lastAccess() int64
+	// usedBytes returns the memory held by the cache
+	usedBytes() int64
+	// evict removes at least the given number of bytes from the cache, if it
+	// holds that many, and returns the number of vectors evicted. Vectors
+	// read since the last sweep are given a second chance.
+	evict(bytes int64) int64
+	// evictAll empties the cache and returns the number of vectors evicted
+	evictAll() int64
+}
+
+func NewBudget(cfg BudgetConfig) *Budget {
+	if cfg.Interval == 0 {
+		cfg.Interval = defaultBudgetInterval
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = logrus.New()
+	}
+
+	b := &Budget{
+		limit:        cfg.Limit,
+		idleTimeout:  cfg.IdleTimeout,
+		interval:     cfg.Interval,
+		allocChecker: cfg.AllocChecker,
+		logger:       cfg.Logger.WithField("action", "vector_cache_budget"),
+		members:      map[budgetMember]struct{}{},
+		trigger:      make(chan struct{}, 1),
+		shutdown:     make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+	b.now.Store(time.Now().UnixNano())
+
+	if prom := cfg.PrometheusMetrics; prom != nil {
+		b.usedGauge = prom.VectorCacheBudgetUsed
+		b.limitGauge = prom.VectorCacheBudgetLimit
+		b.evictions = prom.VectorCacheBudgetEvictions
+		b.limitGauge.Set(float64(b.limit))
+	}
+
+	enterrors.GoWrapper(b.run, b.logger)
+	return b
+}
+
+// Shutdown stops the background eviction, the caches keep their vectors
+func (b *Budget) Shutdown() {
+	if b == nil {
+		return
+	}
+	close(b.shutdown)
+	<-b.done
+}
+
+// Used returns the memory used by all caches sharing the budget
+func (b *Budget) Used() int64 {
+	return b.used.Load()
+}
+
+func (b *Budget) Limit() int64 {
+	return b.limit
+}
+
+func (b *Budget) register(m budgetMember) {
+	b.membersLock.Lock()
+	defer b.membersLock.Unlock()
+	b.members[m] = struct{}{}
+}
+
+func (b *Budget) unregister(m budgetMember) {
+	b.membersLock.Lock()
+	defer b.membersLock.Unlock()
+	delete(b.members, m)
+}
+
+// grow records memory added to or, if negative, removed from a cache. The
+// background eviction is triggered right away if the limit is exceeded.
+func (b *Budget) grow(delta int64) {
+	if b.used.Add(delta) > b.limit && delta > 0 {
+		select {
+		case b.trigger <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (b *Budget) clock() int64 {
+	return b.now.Load()
+}
+
+func (b *Budget) run() {
+	defer close(b.done)
+
+	t := time.NewTicker(b.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-b.shutdown:
+			return
+		case <-t.C:
+			b.now.Store(time.Now().UnixNano())
+			b.evictIdle()
+			b.enforce()
+		case <-b.trigger:
+			b.enforce()
+		}
+		if b.usedGauge != nil {
+			b.usedGauge.Set(float64(b.used.Load()))
+		}
+	}
+}
+
+// membersByLastAccess returns the caches, least recently used first
+func (b *Budget) membersByLastAccess() []budgetMember {
+	b.membersLock.Lock()
+	members := make([]budgetMember, 0, len(b.members))
+	for m := range b.members {
+		members = append(members, m)
+	}
+	b.membersLock.Unlock()
+
+	lastAccess := make(map[budgetMember]int64, len(members))
+	for _, m := range members {
+		lastAccess[m] = m.lastAccess()
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return lastAccess[members[i]] < lastAccess[members[j]]
+	})
+	return members
+}
+
+func (b *Budget) evictIdle() {
+	if b.idleTimeout <= 0 {
+		return
+	}
+
+	idleSince := b.clock() - int64(b.idleTimeout)
+	for _, m := range b.membersByLastAccess() {
+		if m.lastAccess() >= idleSince {
+			// sorted by last access, all further caches are active
+			return
+		}
+		if m.usedBytes() > 0 {
+			b.recordEvictions(EvictionReasonIdle, m.evictAll())
+		}
+	}
+}
+
+// enforce evicts vectors from the least recently used caches until the
+// budget is back below its low watermark. If the node is running out of
+// memory, a quarter of the cached vectors are evicted regardless of the
+// limit.
+func (b *Budget) enforce() {
+	used := b.used.Load()
+	target := int64(float64(b.limit) * budgetLowWatermark)
+	reason := EvictionReasonLimit
+	if b.allocChecker != nil && b.allocChecker.CheckAlloc(0) != nil {
+		if pressureTarget := int64(float64(used) * budgetPressureWatermark); pressureTarget < target {
+			target = pressureTarget
+			reason = EvictionReasonMemoryPressure
+		}
+	}
+	if used <= b.limit && reason == EvictionReasonLimit {
+		return
+	}
+
+	for _, m := range b.membersByLastAccess() {
+		excess := b.used.Load() - target
+		if excess <= 0 {
+			return
+		}
+		if m.usedBytes() == 0 {
+			continue
+		}
+		b.recordEvictions(reason, m.evict(excess))
+	}
+}
+
+func (b *Budget) recordEvictions(reason string, count int64) {
+	if count == 0 {
+		return
+	}
+	b.logger.WithField("reason", reason).WithField("count", count).
+		Debug("evicted vectors from vector cache")
+	if b.evictions != nil {
+		b.evictions.WithLabelValues(reason).Add(float64(count))
+	}
+}
@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func budgetTestVector(ctx context.Context, id uint64) ([]float32, error) {
+	return make([]float32, 8), nil
+}
+
+func fillCache(t *testing.T, c Cache[float32], from, to uint64) {
+	for id := from; id < to; id++ {
+		_, err := c.Get(context.Background(), id)
+		require.Nil(t, err)
+	}
+}
+
+func TestBudget_EvictsLeastRecentlyUsedCacheFirst(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vectorSize := vectorSize(make([]float32, 8))
+
+	budget := NewBudget(BudgetConfig{
+		Limit:    100 * vectorSize,
+		Interval: time.Hour,
+		Logger:   logger,
+	})
+	defer budget.Shutdown()
+
+	cold := NewShardedFloat32LockCache(budgetTestVector, 1_000_000, logger, false, time.Hour, nil, WithBudget(budget))
+	defer cold.Drop()
+	hot := NewShardedFloat32LockCache(budgetTestVector, 1_000_000, logger, false, time.Hour, nil, WithBudget(budget))
+	defer hot.Drop()
+
+	budget.now.Store(1)
+	fillCache(t, cold, 0, 100)
+	assert.Equal(t, 100*vectorSize, budget.Used())
+
+	budget.now.Store(2)
+	fillCache(t, hot, 0, 50)
+
+	assert.Eventually(t, func() bool {
+		return budget.Used() <= budget.Limit()
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, int64(50), hot.CountVectors())
+	assert.Less(t, cold.CountVectors(), int64(50))
+	assert.Equal(t, (hot.CountVectors()+cold.CountVectors())*vectorSize, budget.Used())
+}
+
+func TestBudget_SecondChanceForRecentlyReadVectors(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vectorSize := vectorSize(make([]float32, 8))
+
+	budget := NewBudget(BudgetConfig{
+		Limit:    1_000 * vectorSize,
+		Interval: time.Hour,
+		Logger:   logger,
+	})
+	defer budget.Shutdown()
+
+	c := NewShardedFloat32LockCache(budgetTestVector, 1_000_000, logger, false, time.Hour, nil, WithBudget(budget))
+	defer c.Drop()
+	fillCache(t, c, 0, 100)
+
+	// the first sweep clears the referenced bits set by the misses and evicts
+	// from the start of the cache
+	member := c.(*shardedLockCache[float32])
+	assert.Equal(t, int64(10), member.evict(10*vectorSize))
+
+	// vectors read since then survive the next sweep
+	fillCache(t, c, 10, 20)
+	assert.Equal(t, int64(10), member.evict(10*vectorSize))
+	for id := uint64(10); id < 20; id++ {
+		assert.NotNil(t, member.cache[id])
+	}
+	assert.Equal(t, int64(80), c.CountVectors())
+	assert.Equal(t, 80*vectorSize, budget.Used())
+}
+
+func TestBudget_EmptiesIdleCaches(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	budget := NewBudget(BudgetConfig{
+		Limit:       1 << 30,
+		IdleTimeout: 50 * time.Millisecond,
+		Interval:    10 * time.Millisecond,
+		Logger:      logger,
+	})
+	defer budget.Shutdown()
+
+	c := NewShardedFloat32LockCache(budgetTestVector, 1_000_000, logger, false, time.Hour, nil, WithBudget(budget))
+	defer c.Drop()
+	fillCache(t, c, 0, 100)
+	assert.Equal(t, int64(100), c.CountVectors())
+
+	assert.Eventually(t, func() bool {
+		return c.CountVectors() == 0 && budget.Used() == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+type fakeAllocChecker struct {
+	err error
+}
+
+func (f *fakeAllocChecker) CheckAlloc(sizeInBytes int64) error { return f.err }
+
+func (f *fakeAllocChecker) CheckMappingAndReserve(numberMappings int64, reservationTimeInS int) error {
+	return nil
+}
+
+func (f *fakeAllocChecker) Refresh(updateMappings bool) {}
+
+func TestBudget_EvictsUnderMemoryPressure(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vectorSize := vectorSize(make([]float32, 8))
+
+	budget := NewBudget(BudgetConfig{
+		Limit:        1 << 30,
+		Interval:     time.Hour,
+		AllocChecker: &fakeAllocChecker{err: errors.New("not enough memory")},
+		Logger:       logger,
+	})
+	defer budget.Shutdown()
+
+	c := NewShardedFloat32LockCache(budgetTestVector, 1_000_000, logger, false, time.Hour, nil, WithBudget(budget))
+	defer c.Drop()
+	fillCache(t, c, 0, 100)
+
+	budget.enforce()
+	assert.Equal(t, int64(75), c.CountVectors())
+	assert.Equal(t, 75*vectorSize, budget.Used())
+}
+
+func TestBudget_DropReleasesMemory(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	budget := NewBudget(BudgetConfig{Limit: 1 << 30, Interval: time.Hour, Logger: logger})
+	defer budget.Shutdown()
+
+	c := NewShardedFloat32LockCache(budgetTestVector, 1_000_000, logger, false, time.Hour, nil, WithBudget(budget))
+	fillCache(t, c, 0, 100)
+	c.Delete(context.Background(), 0)
+	assert.Equal(t, 99*vectorSize(make([]float32, 8)), budget.Used())
+
+	c.Drop()
+	assert.Equal(t, int64(0), budget.Used())
+	assert.Empty(t, budget.membersByLastAccess())
+}
@@ -21,6 +21,7 @@ import (
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
@@ -41,6 +42,59 @@ type shardedLockCache[T float32 | byte | uint64] struct {
 	// The maintenanceLock makes sure that only one maintenance operation, such
 	// as growing the cache or clearing the cache happens at the same time.
 	maintenanceLock sync.RWMutex
+
+	// budget is shared with the caches of the other shards of the node, if
+	// set. The caches then record the memory they use, when they were read
+	// last and which vectors were read since the last eviction sweep.
+	budget     *Budget
+	referenced []uint32
+	used       atomic.Int64
+	accessedAt atomic.Int64
+	hand       uint64
+	evictLock  sync.Mutex
+
+	hits   prometheus.Counter
+	misses prometheus.Counter
+}
+
+type options struct {
+	budget *Budget
+	hits   prometheus.Counter
+	misses prometheus.Counter
+}
+
+type Option func(o *options)
+
+// WithBudget makes the cache share the memory of the given budget with the
+// caches of the other shards
+func WithBudget(budget *Budget) Option {
+	return func(o *options) {
+		o.budget = budget
+	}
+}
+
+// WithHitMetrics counts the vectors read from the cache and from disk
+func WithHitMetrics(hits, misses prometheus.Counter) Option {
+	return func(o *options) {
+		o.hits = hits
+		o.misses = misses
+	}
+}
+
+func (s *shardedLockCache[T]) applyOptions(opts []Option) {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	s.hits = o.hits
+	s.misses = o.misses
+	if o.budget != nil {
+		s.budget = o.budget
+		s.referenced = make([]uint32, len(s.cache))
+		s.accessedAt.Store(o.budget.clock())
+		o.budget.register(s)
+	}
 }
 
 const (
@@ -51,7 +105,7 @@ const (
 
 func NewShardedFloat32LockCache(vecForID common.VectorForID[float32], maxSize int,
 	logger logrus.FieldLogger, normalizeOnRead bool, deletionInterval time.Duration,
-	allocChecker memwatch.AllocChecker,
+	allocChecker memwatch.AllocChecker, opts ...Option,
 ) Cache[float32] {
 	vc := &shardedLockCache[float32]{
 		vectorForID: func(ctx context.Context, id uint64) ([]float32, error) {
@@ -76,13 +130,14 @@ func NewShardedFloat32LockCache(vecForID common.VectorForID[float32], maxSize in
 		allocChecker:     allocChecker,
 	}
 
+	vc.applyOptions(opts)
 	vc.watchForDeletion()
 	return vc
 }
 
 func NewShardedByteLockCache(vecForID common.VectorForID[byte], maxSize int,
 	logger logrus.FieldLogger, deletionInterval time.Duration,
-	allocChecker memwatch.AllocChecker,
+	allocChecker memwatch.AllocChecker, opts ...Option,
 ) Cache[byte] {
 	vc := &shardedLockCache[byte]{
 		vectorForID:      vecForID,
@@ -98,13 +153,14 @@ func NewShardedByteLockCache(vecForID common.VectorForID[byte], maxSize int,
 		allocChecker:     allocChecker,
 	}
 
+	vc.applyOptions(opts)
 	vc.watchForDeletion()
 	return vc
 }
 
 func NewShardedUInt64LockCache(vecForID common.VectorForID[uint64], maxSize int,
 	logger logrus.FieldLogger, deletionInterval time.Duration,
-	allocChecker memwatch.AllocChecker,
+	allocChecker memwatch.AllocChecker, opts ...Option,
 ) Cache[uint64] {
 	vc := &shardedLockCache[uint64]{
 		vectorForID:      vecForID,
@@ -120,6 +176,7 @@ func NewShardedUInt64LockCache(vecForID common.VectorForID[uint64], maxSize int,
 		allocChecker:     allocChecker,
 	}
 
+	vc.applyOptions(opts)
 	vc.watchForDeletion()
 	return vc
 }
@@ -131,15 +188,60 @@ func (s *shardedLockCache[T]) All() [][]T {
 func (s *shardedLockCache[T]) Get(ctx context.Context, id uint64) ([]T, error) {
 	s.shardedLocks.RLock(id)
 	vec := s.cache[id]
+	if vec != nil {
+		s.touch(id)
+	}
 	s.shardedLocks.RUnlock(id)
 
 	if vec != nil {
+		if s.hits != nil {
+			s.hits.Inc()
+		}
 		return vec, nil
 	}
 
 	return s.handleCacheMiss(ctx, id)
 }
 
+// touch records that the vector was read, it must be called with the lock
+// for the id held
+func (s *shardedLockCache[T]) touch(id uint64) {
+	if s.budget == nil {
+		return
+	}
+	atomic.StoreUint32(&s.referenced[id], 1)
+	if now := s.budget.clock(); s.accessedAt.Load() != now {
+		s.accessedAt.Store(now)
+	}
+}
+
+// set stores the vector for the id and records the change of the memory used
+// by the cache, it must be called with the lock for the id held
+func (s *shardedLockCache[T]) set(id uint64, vec []T) {
+	old := s.cache[id]
+	s.cache[id] = vec
+	if s.budget == nil {
+		return
+	}
+
+	if vec != nil {
+		s.touch(id)
+	}
+	if delta := vectorSize(vec) - vectorSize(old); delta != 0 {
+		s.used.Add(delta)
+		s.budget.grow(delta)
+	}
+}
+
+// vectorSize estimates the memory held by a cached vector
+func vectorSize[T any](vec []T) int64 {
+	if vec == nil {
+		return 0
+	}
+	var zero T
+	return int64(unsafe.Sizeof(vec)) + int64(cap(vec))*int64(unsafe.Sizeof(zero))
+}
+
 func (s *shardedLockCache[T]) Delete(ctx context.Context, id uint64) {
 	s.shardedLocks.Lock(id)
 	defer s.shardedLocks.Unlock(id)
@@ -148,7 +250,7 @@ func (s *shardedLockCache[T]) Delete(ctx context.Context, id uint64) {
 		return
 	}
 
-	s.cache[id] = nil
+	s.set(id, nil)
 	atomic.AddInt64(&s.count, -1)
 }
 
@@ -173,6 +275,10 @@ func (s *shardedLockCache[T]) handleCacheMiss(ctx context.Context, id uint64) ([
 		}
 	}
 
+	if s.misses != nil {
+		s.misses.Inc()
+	}
+
 	vec, err := s.vectorForID(ctx, id)
 	if err != nil {
 		return nil, err
@@ -180,7 +286,7 @@ func (s *shardedLockCache[T]) handleCacheMiss(ctx context.Context, id uint64) ([
 
 	atomic.AddInt64(&s.count, 1)
 	s.shardedLocks.Lock(id)
-	s.cache[id] = vec
+	s.set(id, vec)
 	s.shardedLocks.Unlock(id)
 
 	return vec, nil
@@ -193,8 +299,14 @@ func (s *shardedLockCache[T]) MultiGet(ctx context.Context, ids []uint64) ([][]T
 	for i, id := range ids {
 		s.shardedLocks.RLock(id)
 		vec := s.cache[id]
+		if vec != nil {
+			s.touch(id)
+		}
 		s.shardedLocks.RUnlock(id)
 
+		if vec != nil && s.hits != nil {
+			s.hits.Inc()
+		}
 		if vec == nil {
 			vecFromDisk, err := s.handleCacheMiss(ctx, id)
 			errs[i] = err
@@ -224,7 +336,7 @@ func (s *shardedLockCache[T]) Preload(id uint64, vec []T) {
 	defer s.shardedLocks.Unlock(id)
 
 	atomic.AddInt64(&s.count, 1)
-	s.cache[id] = vec
+	s.set(id, vec)
 }
 
 func (s *shardedLockCache[T]) Grow(node uint64) {
@@ -251,6 +363,12 @@ func (s *shardedLockCache[T]) Grow(node uint64) {
 	newCache := make([][]T, newSize)
 	copy(newCache, s.cache)
 	s.cache = newCache
+
+	if s.budget != nil {
+		newReferenced := make([]uint32, newSize)
+		copy(newReferenced, s.referenced)
+		s.referenced = newReferenced
+	}
 }
 
 func (s *shardedLockCache[T]) Len() int32 {
@@ -265,6 +383,9 @@ func (s *shardedLockCache[T]) CountVectors() int64 {
 }
 
 func (s *shardedLockCache[T]) Drop() {
+	if s.budget != nil {
+		s.budget.unregister(s)
+	}
 	s.deleteAllVectors()
 	if s.deletionInterval != 0 {
 		s.cancel <- true
@@ -282,6 +403,9 @@ func (s *shardedLockCache[T]) deleteAllVectors() {
 	}
 
 	atomic.StoreInt64(&s.count, 0)
+	if s.budget != nil {
+		s.budget.grow(-s.used.Swap(0))
+	}
 }
 
 func (s *shardedLockCache[T]) watchForDeletion() {
@@ -317,6 +441,61 @@ func (s *shardedLockCache[T]) CopyMaxSize() int64 {
 	return sizeCopy
 }
 
+func (s *shardedLockCache[T]) lastAccess() int64 {
+	return s.accessedAt.Load()
+}
+
+func (s *shardedLockCache[T]) usedBytes() int64 {
+	return s.used.Load()
+}
+
+func (s *shardedLockCache[T]) evictAll() int64 {
+	count := atomic.LoadInt64(&s.count)
+	s.deleteAllVectors()
+	return count
+}
+
+// evict runs a CLOCK sweep over the cache: vectors read since the hand
+// passed them last are skipped once, the others are evicted until the given
+// number of bytes is freed. The hand goes around at most twice, so that the
+// requested bytes are freed if the cache holds them.
+func (s *shardedLockCache[T]) evict(bytes int64) int64 {
+	s.evictLock.Lock()
+	defer s.evictLock.Unlock()
+
+	// prevents the cache from growing during the sweep
+	s.maintenanceLock.RLock()
+	defer s.maintenanceLock.RUnlock()
+
+	size := uint64(len(s.cache))
+	if size == 0 {
+		return 0
+	}
+
+	var freed, evicted int64
+	for step := uint64(0); step < 2*size && freed < bytes; step++ {
+		id := s.hand % size
+		s.hand++
+
+		s.shardedLocks.Lock(id)
+		if vec := s.cache[id]; vec != nil {
+			if atomic.LoadUint32(&s.referenced[id]) == 1 {
+				atomic.StoreUint32(&s.referenced[id], 0)
+			} else {
+				freed += vectorSize(vec)
+				evicted++
+				s.cache[id] = nil
+				atomic.AddInt64(&s.count, -1)
+			}
+		}
+		s.shardedLocks.Unlock(id)
+	}
+
+	s.used.Add(-freed)
+	s.budget.grow(-freed)
+	return evicted
+}
+
 // noopCache can be helpful in debugging situations, where we want to
 // explicitly pass through each vectorForID call to the underlying vectorForID
 // function without caching in between.
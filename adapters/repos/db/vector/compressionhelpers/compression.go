@@ -42,7 +42,7 @@ type VectorCompressor interface {
 	Delete(ctx context.Context, id uint64)
 	Preload(id uint64, vector []float32)
 	Prefetch(id uint64)
-	PrefillCache()
+	PrefillCache() error
 
 	DistanceBetweenCompressedVectorsFromIDs(ctx context.Context, x, y uint64) (float32, error)
 	DistanceBetweenCompressedAndUncompressedVectorsFromID(ctx context.Context, x uint64, y []float32) (float32, error)
@@ -206,8 +206,11 @@ func (compressor *quantizedVectorsCompressor[T]) initCompressedStore() error {
 	return nil
 }
 
-func (compressor *quantizedVectorsCompressor[T]) PrefillCache() {
-	cursor := compressor.compressedStore.Bucket(helpers.VectorsCompressedBucketLSM).Cursor()
+func (compressor *quantizedVectorsCompressor[T]) PrefillCache() error {
+	cursor, err := compressor.compressedStore.Bucket(helpers.VectorsCompressedBucketLSM).Cursor()
+	if err != nil {
+		return errors.Wrap(err, "prefill compressed vector cache")
+	}
 	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
 		id := compressor.loadId(k)
 		compressor.cache.Grow(id)
@@ -217,6 +220,7 @@ func (compressor *quantizedVectorsCompressor[T]) PrefillCache() {
 		compressor.cache.Preload(id, compressor.quantizer.FromCompressedBytes(vc))
 	}
 	cursor.Close()
+	return nil
 }
 
 func (compressor *quantizedVectorsCompressor[T]) ExposeFields() PQData {
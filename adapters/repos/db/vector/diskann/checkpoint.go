@@ -0,0 +1,245 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+// idRun holds the state of consecutive ids, so it can be written at once
+type idRun struct {
+	first  uint64
+	status []byte
+	slots  []byte
+	codes  []byte
+}
+
+// checkpointData is a copy of the changes to persist
+type checkpointData struct {
+	records   map[uint64][]byte
+	ids       []uint64
+	runs      []idRun
+	codeSize  int
+	header    *graphHeader
+	codebook  []byte
+	walSeq    uint64
+	hasChange bool
+}
+
+func (i *diskann) checkpointCycle(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	i.stateLock.RLock()
+	pending := i.hasChanges()
+	i.stateLock.RUnlock()
+	if !pending {
+		return false
+	}
+
+	if err := i.checkpoint(); err != nil {
+		i.logger.WithField("action", "diskann_checkpoint").
+			WithField("id", i.id).
+			WithError(err).
+			Error("checkpoint of disk-resident index failed")
+		return false
+	}
+	return true
+}
+
+func (i *diskann) hasChanges() bool {
+	return len(i.dirtyIDs) > 0 || len(i.records) > 0 || i.dirtyHeader || i.codebook != nil
+}
+
+// checkpoint writes the changes since the last checkpoint to the data files
+// and deletes the write-ahead log segments containing them. Changes made
+// meanwhile are written to a new segment and are persisted by the next
+// checkpoint.
+func (i *diskann) checkpoint() error {
+	i.checkpointLock.Lock()
+	defer i.checkpointLock.Unlock()
+
+	cp, err := i.startCheckpoint()
+	if err != nil || !cp.hasChange {
+		return err
+	}
+
+	if err := i.persist(cp); err != nil {
+		i.stateLock.Lock()
+		i.abortCheckpoint(cp)
+		i.stateLock.Unlock()
+		return errors.Wrap(err, "checkpoint")
+	}
+	if err := i.wal.removeBefore(cp.walSeq); err != nil {
+		return errors.Wrap(err, "checkpoint")
+	}
+
+	i.stateLock.Lock()
+	defer i.stateLock.Unlock()
+	for slot, record := range cp.records {
+		// records replaced during the checkpoint are kept for the next one
+		if current, ok := i.records[slot]; ok && &current[0] == &record[0] {
+			delete(i.records, slot)
+		}
+	}
+	return nil
+}
+
+// startCheckpoint copies the changes and starts a new write-ahead log segment
+// for the changes made after the copy
+func (i *diskann) startCheckpoint() (*checkpointData, error) {
+	i.stateLock.Lock()
+	defer i.stateLock.Unlock()
+
+	if !i.hasChanges() {
+		return &checkpointData{}, nil
+	}
+
+	seq, err := i.wal.rotate()
+	if err != nil {
+		return nil, errors.Wrap(err, "checkpoint")
+	}
+
+	cp := &checkpointData{
+		records:   make(map[uint64][]byte, len(i.records)),
+		ids:       make([]uint64, 0, len(i.dirtyIDs)),
+		codebook:  i.codebook,
+		walSeq:    seq,
+		hasChange: true,
+	}
+	for slot, record := range i.records {
+		cp.records[slot] = record
+	}
+	for id := range i.dirtyIDs {
+		cp.ids = append(cp.ids, id)
+	}
+	sort.Slice(cp.ids, func(a, b int) bool { return cp.ids[a] < cp.ids[b] })
+
+	if i.pq != nil {
+		cp.codeSize = i.pqSegments
+	}
+	for start := 0; start < len(cp.ids); {
+		end := start + 1
+		for end < len(cp.ids) && cp.ids[end] == cp.ids[end-1]+1 {
+			end++
+		}
+		first, last := cp.ids[start], cp.ids[end-1]+1
+		run := idRun{
+			first:  first,
+			status: append([]byte{}, i.status[first:last]...),
+			slots:  make([]byte, 0, 8*(last-first)),
+		}
+		for _, slot := range i.slots[first:last] {
+			run.slots = binary.LittleEndian.AppendUint64(run.slots, slot)
+		}
+		if cp.codeSize > 0 {
+			run.codes = append([]byte{}, i.codes[first*uint64(cp.codeSize):last*uint64(cp.codeSize)]...)
+		}
+		cp.runs = append(cp.runs, run)
+		start = end
+	}
+
+	if i.dirtyHeader {
+		cp.header = &graphHeader{
+			dims:          i.graph.dims,
+			maxDegree:     i.graph.maxDegree,
+			entrypoint:    i.entrypoint,
+			hasEntrypoint: i.hasEntrypoint,
+		}
+	}
+
+	i.dirtyIDs = map[uint64]struct{}{}
+	i.dirtyHeader = false
+	i.codebook = nil
+	return cp, nil
+}
+
+// abortCheckpoint marks the changes of a failed checkpoint as not persisted
+func (i *diskann) abortCheckpoint(cp *checkpointData) {
+	for _, id := range cp.ids {
+		i.dirtyIDs[id] = struct{}{}
+	}
+	if cp.header != nil {
+		i.dirtyHeader = true
+	}
+	if cp.codebook != nil && i.codebook == nil {
+		i.codebook = cp.codebook
+	}
+}
+
+func (i *diskann) persist(cp *checkpointData) error {
+	if cp.codebook != nil {
+		if err := i.writeCodebook(cp.codebook); err != nil {
+			return err
+		}
+	}
+
+	for slot, record := range cp.records {
+		if err := i.graph.writeRecord(slot, record); err != nil {
+			return err
+		}
+	}
+	for _, run := range cp.runs {
+		if _, err := i.statusFd.WriteAt(run.status, int64(run.first)); err != nil {
+			return errors.Wrap(err, "write status file")
+		}
+		if _, err := i.slotsFd.WriteAt(run.slots, int64(run.first)*8); err != nil {
+			return errors.Wrap(err, "write slots file")
+		}
+		if run.codes != nil {
+			if _, err := i.codesFd.WriteAt(run.codes, int64(run.first)*int64(cp.codeSize)); err != nil {
+				return errors.Wrap(err, "write codes file")
+			}
+		}
+	}
+	if cp.header != nil {
+		if err := i.graph.writeHeader(*cp.header); err != nil {
+			return err
+		}
+	}
+
+	for _, fd := range []*os.File{i.graph.fd, i.statusFd, i.slotsFd, i.codesFd} {
+		if err := fd.Sync(); err != nil {
+			return errors.Wrapf(err, "fsync %q", fd.Name())
+		}
+	}
+	return nil
+}
+
+// writeCodebook persists the codebook atomically
+func (i *diskann) writeCodebook(data []byte) error {
+	path := filepath.Join(i.dir, codebookFileName)
+	tmpPath := path + ".tmp"
+
+	fd, err := os.Create(tmpPath)
+	if err != nil {
+		return errors.Wrap(err, "create codebook")
+	}
+	if _, err := fd.Write(data); err != nil {
+		fd.Close()
+		return errors.Wrap(err, "write codebook")
+	}
+	if err := fd.Sync(); err != nil {
+		fd.Close()
+		return errors.Wrap(err, "fsync codebook")
+	}
+	if err := fd.Close(); err != nil {
+		return errors.Wrap(err, "close codebook")
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return errors.Wrap(err, "rename codebook")
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+// consolidateThreshold is the share of deleted nodes from which they are
+// consolidated. Consolidating reads the adjacency lists of all nodes, so
+// deletes are collected first.
+const consolidateThreshold = 0.05
+
+func (i *diskann) consolidateCycle(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	live, deleted := i.countByStatus()
+	if deleted == 0 || float64(deleted) < consolidateThreshold*float64(live+deleted) {
+		return false
+	}
+
+	if err := i.consolidate(shouldAbort); err != nil {
+		i.logger.WithField("action", "diskann_consolidate").
+			WithField("id", i.id).
+			WithError(err).
+			Error("consolidation of deleted nodes failed")
+	}
+	return true
+}
+
+func (i *diskann) countByStatus() (live, deleted int) {
+	i.stateLock.RLock()
+	defer i.stateLock.RUnlock()
+
+	for _, status := range i.status {
+		switch status {
+		case statusLive:
+			live++
+		case statusDeleted:
+			deleted++
+		}
+	}
+	return live, deleted
+}
+
+// consolidate removes the deleted nodes from the graph. The nodes pointing
+// to them are connected to their neighbors instead while inserts and searches
+// continue. Afterwards no node points to them anymore, so they are removed
+// and their slots are reused by new nodes. If aborted, the consolidation
+// starts over in the next cycle.
+func (i *diskann) consolidate(shouldAbort cyclemanager.ShouldAbortCallback) error {
+	before := time.Now()
+
+	i.RLock()
+	deleted := map[uint64]struct{}{}
+	var live []uint64
+	i.stateLock.RLock()
+	for id, status := range i.status {
+		switch status {
+		case statusLive:
+			live = append(live, uint64(id))
+		case statusDeleted:
+			deleted[uint64(id)] = struct{}{}
+		}
+	}
+	i.stateLock.RUnlock()
+
+	for j, id := range live {
+		if j%1000 == 0 && shouldAbort() {
+			i.RUnlock()
+			return nil
+		}
+		if err := i.reconnect(id, deleted); err != nil {
+			i.RUnlock()
+			return err
+		}
+	}
+	i.RUnlock()
+
+	i.Lock()
+	defer i.Unlock()
+
+	if err := i.moveEntrypoint(deleted); err != nil {
+		return err
+	}
+
+	ops := make([]walOp, 0, len(deleted))
+	for id := range deleted {
+		// nodes added again since are kept
+		if i.statusOf(id) == statusDeleted {
+			ops = append(ops, walOp{typ: opStatus, id: id, status: statusAbsent})
+		}
+	}
+	if len(ops) > 0 {
+		if err := i.commit(ops...); err != nil {
+			return err
+		}
+	}
+
+	i.logger.WithField("action", "diskann_consolidate").
+		WithField("id", i.id).
+		WithField("deleted", len(ops)).
+		WithField("took", time.Since(before)).
+		Info("consolidated deleted nodes of disk-resident index")
+	return nil
+}
+
+// reconnect replaces the deleted neighbors of the node by their neighbors,
+// following the delete consolidation of FreshDiskANN
+func (i *diskann) reconnect(id uint64, deleted map[uint64]struct{}) error {
+	i.nodeLocks.Lock(id)
+	defer i.nodeLocks.Unlock(id)
+
+	node, err := i.readNode(id)
+	if err != nil {
+		return err
+	}
+
+	candidates := map[uint64]struct{}{}
+	changed := false
+	for _, neighbor := range node.neighbors {
+		if _, ok := deleted[neighbor]; !ok {
+			candidates[neighbor] = struct{}{}
+			continue
+		}
+
+		changed = true
+		removed, err := i.readNode(neighbor)
+		if err != nil {
+			return err
+		}
+		for _, candidate := range removed.neighbors {
+			candidates[candidate] = struct{}{}
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	ids := make([]uint64, 0, len(candidates))
+	for candidate := range candidates {
+		if _, ok := deleted[candidate]; !ok && candidate != id {
+			ids = append(ids, candidate)
+		}
+	}
+	scored, err := i.scoreCandidates(node, i.withStatus(ids, statusLive))
+	if err != nil {
+		return err
+	}
+	sort.Slice(scored, func(a, b int) bool {
+		return scored[a].dist < scored[b].dist
+	})
+
+	pruned, err := i.robustPrune(id, scored)
+	if err != nil {
+		return err
+	}
+	node.neighbors = nodeIDs(pruned)
+	return i.commit(walOp{typ: opRecord, id: id, data: i.graph.encodeNode(node)})
+}
+
+// moveEntrypoint picks a live node as the entrypoint if it is about to be
+// removed, the exclusive lock must be held
+func (i *diskann) moveEntrypoint(deleted map[uint64]struct{}) error {
+	if _, ok := deleted[i.entrypoint]; !ok || !i.hasEntrypoint {
+		return nil
+	}
+
+	entrypoint, err := i.readNode(i.entrypoint)
+	if err != nil {
+		return errors.Wrap(err, "read entrypoint")
+	}
+	candidates := i.withStatus(entrypoint.neighbors, statusLive)
+	if len(candidates) == 0 {
+		i.stateLock.RLock()
+		for id, status := range i.status {
+			if status == statusLive {
+				candidates = append(candidates, uint64(id))
+				break
+			}
+		}
+		i.stateLock.RUnlock()
+	}
+
+	op := walOp{typ: opEntrypoint}
+	if len(candidates) > 0 {
+		op.id, op.hasEntrypoint = candidates[0], true
+	}
+	return i.commit(op)
+}
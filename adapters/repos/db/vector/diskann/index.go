@@ -0,0 +1,701 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package diskann implements a disk-resident graph index based on Vamana, the
+// graph used by DiskANN. Only the PQ codes of the vectors and the status and
+// slot of every node are kept in memory. Adjacency lists and full vectors are
+// stored in fixed-size records on disk and are read during the beam search,
+// the full vectors are used to rescore the candidates.
+//
+// Changes are appended to a write-ahead log and kept in memory until a
+// checkpoint writes them to the data files, so the data files are always
+// consistent. Checkpoints are paused during backups.
+//
+// Deleted nodes are kept in the graph to preserve its connectivity and are
+// never returned. Once enough nodes are deleted, they are consolidated: the
+// nodes pointing to them are connected to their neighbors instead, and their
+// slots are reused for new nodes.
+package diskann
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
+)
+
+type diskann struct {
+	// searches, inserts and deletes share the lock, training the codebook and
+	// freeing consolidated nodes are exclusive
+	sync.RWMutex
+	// nodeLocks protect updating the adjacency list of a node
+	nodeLocks *common.ShardedRWLocks
+
+	id                string
+	targetVector      string
+	dir               string
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+
+	maxDegree           int
+	searchListSize      int
+	buildSearchListSize int
+	alpha               float32
+	beamWidth           int
+	pqSegments          int
+	pqTrainingLimit     int
+
+	graph    *graphFile
+	statusFd *os.File
+	slotsFd  *os.File
+	codesFd  *os.File
+
+	entrypoint    uint64
+	hasEntrypoint bool
+	count         uint64
+
+	// pq is nil until enough vectors were added to train the codebook, until
+	// then candidates are compared using the full vectors from disk
+	pq *compressionhelpers.ProductQuantizer
+
+	// stateLock protects the state of the nodes and the changes which were
+	// not checkpointed yet
+	stateLock sync.RWMutex
+	wal       *wal
+	status    []uint8
+	slots     []uint64
+	codes     []byte
+	freeSlots []uint64
+	nextSlot  uint64
+	// records written since the last checkpoint by slot, they are never
+	// modified once added
+	records map[uint64][]byte
+	// ids whose status, slot or code changed since the last checkpoint
+	dirtyIDs    map[uint64]struct{}
+	dirtyHeader bool
+	// codebook to persist on the next checkpoint
+	codebook []byte
+
+	checkpointLock          sync.Mutex
+	checkpointCallbackCtrl  cyclemanager.CycleCallbackCtrl
+	consolidateCallbackCtrl cyclemanager.CycleCallbackCtrl
+}
+
+func New(cfg Config, uc ent.UserConfig) (*diskann, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		l := logrus.New()
+		l.Out = io.Discard
+		logger = l
+	}
+
+	index := &diskann{
+		id:                  cfg.ID,
+		targetVector:        cfg.TargetVector,
+		dir:                 filepath.Join(cfg.RootPath, fmt.Sprintf("%s.diskann.d", cfg.ID)),
+		logger:              logger,
+		distancerProvider:   cfg.DistanceProvider,
+		nodeLocks:           common.NewDefaultShardedRWLocks(),
+		maxDegree:           uc.MaxDegree,
+		searchListSize:      uc.SearchListSize,
+		buildSearchListSize: uc.BuildSearchListSize,
+		alpha:               float32(uc.Alpha),
+		beamWidth:           uc.BeamWidth,
+		pqSegments:          uc.PQSegments,
+		pqTrainingLimit:     uc.PQTrainingLimit,
+		records:             map[uint64][]byte{},
+		dirtyIDs:            map[uint64]struct{}{},
+	}
+
+	if err := index.load(); err != nil {
+		index.closeFiles()
+		return nil, errors.Wrapf(err, "load diskann index %q", cfg.ID)
+	}
+
+	checkpointCallbacks := cfg.CheckpointCallbacks
+	if checkpointCallbacks == nil {
+		checkpointCallbacks = cyclemanager.NewCallbackGroupNoop()
+	}
+	consolidateCallbacks := cfg.ConsolidateCallbacks
+	if consolidateCallbacks == nil {
+		consolidateCallbacks = cyclemanager.NewCallbackGroupNoop()
+	}
+	index.checkpointCallbackCtrl = checkpointCallbacks.Register(
+		strings.Join([]string{"diskann", "checkpoint", index.dir}, "/"), index.checkpointCycle)
+	index.consolidateCallbackCtrl = consolidateCallbacks.Register(
+		strings.Join([]string{"diskann", "consolidate", index.dir}, "/"), index.consolidateCycle)
+
+	return index, nil
+}
+
+func (i *diskann) load() error {
+	if err := os.MkdirAll(i.dir, os.ModePerm); err != nil {
+		return errors.Wrap(err, "create index directory")
+	}
+
+	graph, header, err := openGraphFile(filepath.Join(i.dir, graphFileName))
+	if err != nil {
+		return err
+	}
+	i.graph = graph
+	if header != nil {
+		// the layout of the records on disk can't change after the first
+		// insert, the max degree is immutable in the user config
+		i.maxDegree = header.maxDegree
+		i.entrypoint = header.entrypoint
+		i.hasEntrypoint = header.hasEntrypoint
+	}
+
+	i.statusFd, err = os.OpenFile(filepath.Join(i.dir, statusFileName), os.O_RDWR|os.O_CREATE, 0o666)
+	if err != nil {
+		return errors.Wrap(err, "open status file")
+	}
+	i.status, err = io.ReadAll(i.statusFd)
+	if err != nil {
+		return errors.Wrap(err, "read status file")
+	}
+
+	i.slotsFd, err = os.OpenFile(filepath.Join(i.dir, slotsFileName), os.O_RDWR|os.O_CREATE, 0o666)
+	if err != nil {
+		return errors.Wrap(err, "open slots file")
+	}
+	slots, persisted, err := readSlots(i.slotsFd, len(i.status))
+	if err != nil {
+		return errors.Wrap(err, "read slots file")
+	}
+	i.slots = slots
+	i.status = append(i.status, make([]uint8, len(i.slots)-len(i.status))...)
+	for id := persisted; id < len(i.status); id++ {
+		// persist the slots of nodes added before slots were reused
+		if i.status[id] != statusAbsent {
+			i.dirtyIDs[uint64(id)] = struct{}{}
+		}
+	}
+
+	i.codesFd, err = os.OpenFile(filepath.Join(i.dir, codesFileName), os.O_RDWR|os.O_CREATE, 0o666)
+	if err != nil {
+		return errors.Wrap(err, "open codes file")
+	}
+	if err := i.loadCodes(); err != nil {
+		return err
+	}
+
+	for _, status := range i.status {
+		if status == statusLive {
+			i.count++
+		}
+	}
+
+	if err := i.replay(); err != nil {
+		return err
+	}
+	i.restoreSlots()
+
+	return nil
+}
+
+func (i *diskann) loadCodes() error {
+	data, err := os.ReadFile(filepath.Join(i.dir, codebookFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "read codebook")
+	}
+	pq, segments, err := i.deserializeCodebook(data)
+	if err != nil {
+		return err
+	}
+
+	codes, err := io.ReadAll(i.codesFd)
+	if err != nil {
+		return errors.Wrap(err, "read codes file")
+	}
+	if expected := len(i.status) * segments; len(codes) < expected {
+		codes = append(codes, make([]byte, expected-len(codes))...)
+	}
+	i.pq = pq
+	i.pqSegments = segments
+	i.codes = codes
+
+	return nil
+}
+
+// replay applies the changes of the write-ahead log which were not
+// checkpointed before the index was closed, and checkpoints them
+func (i *diskann) replay() error {
+	w, segments, err := openWAL(i.dir)
+	if err != nil {
+		return err
+	}
+	i.wal = w
+
+	for _, segment := range segments {
+		entries, err := readSegment(segment, func(ops []walOp) error {
+			for _, op := range ops {
+				if err := i.apply(op); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return errors.Wrapf(err, "replay %q", segment)
+		}
+		i.logger.WithField("action", "diskann_replay_wal").
+			WithField("id", i.id).
+			WithField("segment", segment).
+			WithField("entries", entries).
+			Debug("replayed write-ahead log of disk-resident index")
+	}
+
+	if err := i.checkpoint(); err != nil {
+		return err
+	}
+	return i.wal.removeBefore(i.wal.seq)
+}
+
+// restoreSlots rebuilds the list of free slots from the slots of the nodes
+func (i *diskann) restoreSlots() {
+	used := map[uint64]struct{}{}
+	i.nextSlot = 0
+	for id, status := range i.status {
+		if status == statusAbsent {
+			continue
+		}
+		slot := i.slots[id]
+		used[slot] = struct{}{}
+		if slot >= i.nextSlot {
+			i.nextSlot = slot + 1
+		}
+	}
+
+	i.freeSlots = i.freeSlots[:0]
+	for slot := uint64(0); slot < i.nextSlot; slot++ {
+		if _, ok := used[slot]; !ok {
+			i.freeSlots = append(i.freeSlots, slot)
+		}
+	}
+}
+
+func (i *diskann) normalized(vector []float32) []float32 {
+	if i.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		return distancer.Normalize(vector)
+	}
+	return vector
+}
+
+func (i *diskann) statusOf(id uint64) uint8 {
+	i.stateLock.RLock()
+	defer i.stateLock.RUnlock()
+
+	return i.statusOfLocked(id)
+}
+
+func (i *diskann) statusOfLocked(id uint64) uint8 {
+	if id >= uint64(len(i.status)) {
+		return statusAbsent
+	}
+	return i.status[id]
+}
+
+// commit appends the changes to the write-ahead log and applies them. New
+// nodes are assigned a slot.
+func (i *diskann) commit(ops ...walOp) error {
+	i.stateLock.Lock()
+	defer i.stateLock.Unlock()
+
+	for j := range ops {
+		if ops[j].typ != opRecord {
+			continue
+		}
+		if i.statusOfLocked(ops[j].id) != statusAbsent {
+			ops[j].slot = i.slots[ops[j].id]
+		} else {
+			ops[j].slot = i.allocSlot()
+		}
+	}
+
+	if err := i.wal.append(ops); err != nil {
+		return err
+	}
+	for _, op := range ops {
+		if err := i.apply(op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *diskann) allocSlot() uint64 {
+	if n := len(i.freeSlots); n > 0 {
+		slot := i.freeSlots[n-1]
+		i.freeSlots = i.freeSlots[:n-1]
+		return slot
+	}
+	slot := i.nextSlot
+	i.nextSlot++
+	return slot
+}
+
+// apply changes the in-memory state, the changes are persisted by the next
+// checkpoint
+func (i *diskann) apply(op walOp) error {
+	switch op.typ {
+	case opRecord:
+		i.grow(op.id)
+		i.slots[op.id] = op.slot
+		i.records[op.slot] = op.data
+		i.dirtyIDs[op.id] = struct{}{}
+		if op.slot >= i.nextSlot {
+			i.nextSlot = op.slot + 1
+		}
+	case opStatus:
+		i.grow(op.id)
+		previous := i.status[op.id]
+		i.status[op.id] = op.status
+		i.dirtyIDs[op.id] = struct{}{}
+		if previous != statusLive && op.status == statusLive {
+			atomic.AddUint64(&i.count, 1)
+		} else if previous == statusLive && op.status != statusLive {
+			atomic.AddUint64(&i.count, ^uint64(0))
+		}
+		if previous != statusAbsent && op.status == statusAbsent {
+			i.freeSlots = append(i.freeSlots, i.slots[op.id])
+		}
+	case opCode:
+		i.grow(op.id)
+		copy(i.code(op.id), op.data)
+		i.dirtyIDs[op.id] = struct{}{}
+	case opLayout:
+		i.graph.setLayout(op.dims, op.maxDegree)
+		i.dirtyHeader = true
+	case opEntrypoint:
+		i.entrypoint = op.id
+		i.hasEntrypoint = op.hasEntrypoint
+		i.dirtyHeader = true
+	case opCodebook:
+		pq, segments, err := i.deserializeCodebook(op.data)
+		if err != nil {
+			return err
+		}
+		i.pq = pq
+		i.pqSegments = segments
+		i.codes = make([]byte, len(i.status)*segments)
+		i.codebook = op.data
+	default:
+		return errors.Errorf("unknown op %d", op.typ)
+	}
+	return nil
+}
+
+// grow makes sure the in-memory state can hold the given id
+func (i *diskann) grow(id uint64) {
+	if id < uint64(len(i.status)) {
+		return
+	}
+
+	size := uint64(len(i.status)) + uint64(len(i.status))/4
+	if size <= id {
+		size = id + 1
+	}
+	status := make([]uint8, size)
+	copy(status, i.status)
+	i.status = status
+
+	slots := make([]uint64, size)
+	copy(slots, i.slots)
+	i.slots = slots
+
+	if i.pq != nil {
+		codes := make([]byte, size*uint64(i.pqSegments))
+		copy(codes, i.codes)
+		i.codes = codes
+	}
+}
+
+func (i *diskann) Delete(ids ...uint64) error {
+	i.RLock()
+	defer i.RUnlock()
+
+	for _, id := range ids {
+		if err := i.delete(id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *diskann) delete(id uint64) error {
+	i.nodeLocks.Lock(id)
+	defer i.nodeLocks.Unlock(id)
+
+	if i.statusOf(id) != statusLive {
+		return nil
+	}
+	return i.commit(walOp{typ: opStatus, id: id, status: statusDeleted})
+}
+
+func (i *diskann) UpdateUserConfig(updated schemaConfig.VectorIndexConfig, callback func()) error {
+	parsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		callback()
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	i.Lock()
+	i.searchListSize = parsed.SearchListSize
+	i.buildSearchListSize = parsed.BuildSearchListSize
+	i.alpha = float32(parsed.Alpha)
+	i.beamWidth = parsed.BeamWidth
+	i.Unlock()
+
+	callback()
+	return nil
+}
+
+func (i *diskann) Drop(ctx context.Context) error {
+	if err := i.unregisterCallbacks(ctx); err != nil {
+		return err
+	}
+
+	i.Lock()
+	defer i.Unlock()
+
+	if err := i.closeFiles(); err != nil {
+		return errors.Wrap(err, "close diskann files prior to delete")
+	}
+
+	if err := os.RemoveAll(i.dir); err != nil {
+		return errors.Wrap(err, "delete diskann directory")
+	}
+	return nil
+}
+
+// Flush makes the changes durable by syncing the write-ahead log, the data
+// files are only written by checkpoints
+func (i *diskann) Flush() error {
+	i.stateLock.Lock()
+	defer i.stateLock.Unlock()
+
+	return i.wal.flush()
+}
+
+func (i *diskann) Shutdown(ctx context.Context) error {
+	if err := i.unregisterCallbacks(ctx); err != nil {
+		return err
+	}
+	if err := i.checkpoint(); err != nil {
+		return err
+	}
+
+	i.Lock()
+	defer i.Unlock()
+	return i.closeFiles()
+}
+
+func (i *diskann) unregisterCallbacks(ctx context.Context) error {
+	if err := i.checkpointCallbackCtrl.Unregister(ctx); err != nil {
+		return errors.Wrap(err, "unregister checkpoint callback")
+	}
+	if err := i.consolidateCallbackCtrl.Unregister(ctx); err != nil {
+		return errors.Wrap(err, "unregister consolidation callback")
+	}
+	return nil
+}
+
+func (i *diskann) closeFiles() error {
+	if i.wal != nil {
+		if err := i.wal.close(); err != nil {
+			return err
+		}
+	}
+
+	var fds []*os.File
+	if i.graph != nil {
+		fds = append(fds, i.graph.fd)
+	}
+	for _, fd := range []*os.File{i.statusFd, i.slotsFd, i.codesFd} {
+		if fd != nil {
+			fds = append(fds, fd)
+		}
+	}
+
+	for _, fd := range fds {
+		if err := fd.Close(); err != nil && !errors.Is(err, os.ErrClosed) {
+			return errors.Wrapf(err, "close %q", fd.Name())
+		}
+	}
+	return nil
+}
+
+// SwitchCommitLogs checkpoints the index before a backup. Checkpoints are
+// paused until the backup is released, so the listed data files don't change
+// while they are copied, changes are kept in the write-ahead log meanwhile.
+func (i *diskann) SwitchCommitLogs(context.Context) error {
+	return i.checkpoint()
+}
+
+// ListFiles lists the data files, the write-ahead log is not part of backups
+// as the files contain all changes up to the checkpoint before the backup
+func (i *diskann) ListFiles(ctx context.Context, basePath string) ([]string, error) {
+	entries, err := os.ReadDir(i.dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "browse diskann directory")
+	}
+
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasSuffix(entry.Name(), ".tmp") ||
+			strings.HasSuffix(entry.Name(), walSuffix) {
+			continue
+		}
+
+		rel, err := filepath.Rel(basePath, filepath.Join(i.dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, rel)
+	}
+	return files, nil
+}
+
+func (i *diskann) PostStartup() {}
+
+func (i *diskann) Compressed() bool {
+	i.RLock()
+	defer i.RUnlock()
+
+	return i.pq != nil
+}
+
+func (i *diskann) ValidateBeforeInsert(vector []float32) error {
+	i.RLock()
+	dims := i.graph.dims
+	i.RUnlock()
+
+	// no vectors exist
+	if dims == 0 {
+		return nil
+	}
+
+	// check if vector length is the same as existing nodes
+	if dims != len(vector) {
+		return fmt.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), dims)
+	}
+
+	return nil
+}
+
+func (i *diskann) Dump(labels ...string) {
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", strings.Join(labels, ", "))
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", i.id)
+	fmt.Printf("Entrypoint: %d\n", i.entrypoint)
+	fmt.Printf("Nodes: %d\n", atomic.LoadUint64(&i.count))
+	fmt.Printf("--------------------------------------------------\n")
+}
+
+func (i *diskann) DistanceBetweenVectors(x, y []float32) (float32, bool, error) {
+	return i.distancerProvider.SingleDist(x, y)
+}
+
+func (i *diskann) ContainsNode(id uint64) bool {
+	return i.statusOf(id) == statusLive
+}
+
+func (i *diskann) AlreadyIndexed() uint64 {
+	return atomic.LoadUint64(&i.count)
+}
+
+func (i *diskann) DistancerProvider() distancer.Provider {
+	return i.distancerProvider
+}
+
+type immutableParameter struct {
+	accessor func(c ent.UserConfig) interface{}
+	name     string
+}
+
+func validateImmutableField(u immutableParameter,
+	previous, next ent.UserConfig,
+) error {
+	oldField := u.accessor(previous)
+	newField := u.accessor(next)
+	if oldField != newField {
+		return errors.Errorf("%s is immutable: attempted change from \"%v\" to \"%v\"",
+			u.name, oldField, newField)
+	}
+
+	return nil
+}
+
+func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) error {
+	initialParsed, ok := initial.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("initial is not UserConfig, but %T", initial)
+	}
+
+	updatedParsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("updated is not UserConfig, but %T", updated)
+	}
+
+	immutableFields := []immutableParameter{
+		{
+			name:     "distance",
+			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
+		},
+		{
+			name:     "maxDegree",
+			accessor: func(c ent.UserConfig) interface{} { return c.MaxDegree },
+		},
+		{
+			name:     "pqSegments",
+			accessor: func(c ent.UserConfig) interface{} { return c.PQSegments },
+		},
+		{
+			name:     "pqTrainingLimit",
+			accessor: func(c ent.UserConfig) interface{} { return c.PQTrainingLimit },
+		},
+	}
+
+	for _, u := range immutableFields {
+		if err := validateImmutableField(u, initialParsed, updatedParsed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
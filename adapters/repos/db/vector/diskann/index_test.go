@@ -0,0 +1,378 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
+	"golang.org/x/sync/errgroup"
+)
+
+func testIndex(t *testing.T, rootPath string, uc ent.UserConfig) *diskann {
+	logger, _ := test.NewNullLogger()
+	index, err := New(Config{
+		ID:               "vectors",
+		RootPath:         rootPath,
+		Logger:           logger,
+		DistanceProvider: distancer.NewL2SquaredProvider(),
+	}, uc)
+	require.Nil(t, err)
+	return index
+}
+
+func distanceWrapper(provider distancer.Provider) func(x, y []float32) float32 {
+	return func(x, y []float32) float32 {
+		dist, _, _ := provider.SingleDist(x, y)
+		return dist
+	}
+}
+
+func recall(t *testing.T, index *diskann, vectors, queries [][]float32, k int) float32 {
+	logger, _ := test.NewNullLogger()
+	var matches uint64
+	for _, query := range queries {
+		truth, _ := testinghelpers.BruteForce(logger, vectors, query, k,
+			distanceWrapper(distancer.NewL2SquaredProvider()))
+		ids, _, err := index.SearchByVector(query, k, nil)
+		require.Nil(t, err)
+		matches += testinghelpers.MatchesInLists(truth, ids)
+	}
+	return float32(matches) / float32(k*len(queries))
+}
+
+func TestDiskANN(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	vectors, queries := testinghelpers.RandomVecs(1000, 20, 32)
+	ids := make([]uint64, len(vectors))
+	for i := range ids {
+		ids[i] = uint64(i)
+	}
+
+	uc := ent.NewDefaultUserConfig()
+	uc.Distance = "l2-squared"
+	uc.MaxDegree = 24
+	uc.PQTrainingLimit = 500
+
+	index := testIndex(t, rootPath, uc)
+
+	t.Run("search before the codebook is trained", func(t *testing.T) {
+		require.Nil(t, index.AddBatch(ctx, ids[:400], vectors[:400]))
+		assert.False(t, index.Compressed())
+		assert.Greater(t, recall(t, index, vectors[:400], queries, 10), float32(0.9))
+	})
+
+	t.Run("search with pq codes", func(t *testing.T) {
+		require.Nil(t, index.AddBatch(ctx, ids[400:], vectors[400:]))
+		assert.True(t, index.Compressed())
+		assert.Equal(t, uint64(len(vectors)), index.AlreadyIndexed())
+		assert.Greater(t, recall(t, index, vectors, queries, 10), float32(0.9))
+	})
+
+	t.Run("adding an indexed node again is a no-op", func(t *testing.T) {
+		require.Nil(t, index.Add(0, vectors[0]))
+		assert.Equal(t, uint64(len(vectors)), index.AlreadyIndexed())
+	})
+
+	t.Run("filtered search", func(t *testing.T) {
+		allow := helpers.NewAllowList()
+		for i := uint64(0); i < uint64(len(vectors)); i += 10 {
+			allow.Insert(i)
+		}
+
+		res, _, err := index.SearchByVector(queries[0], 5, allow)
+		require.Nil(t, err)
+		require.Len(t, res, 5)
+		for _, id := range res {
+			assert.True(t, allow.Contains(id))
+		}
+	})
+
+	t.Run("deleted nodes are not returned", func(t *testing.T) {
+		res, _, err := index.SearchByVector(vectors[42], 1, nil)
+		require.Nil(t, err)
+		require.Equal(t, []uint64{42}, res)
+
+		require.Nil(t, index.Delete(42))
+		assert.False(t, index.ContainsNode(42))
+
+		res, _, err = index.SearchByVector(vectors[42], 10, nil)
+		require.Nil(t, err)
+		assert.NotContains(t, res, uint64(42))
+	})
+
+	t.Run("restart", func(t *testing.T) {
+		require.Nil(t, index.Flush())
+		require.Nil(t, index.Shutdown(ctx))
+
+		index = testIndex(t, rootPath, uc)
+		assert.True(t, index.Compressed())
+		assert.Equal(t, uint64(len(vectors)-1), index.AlreadyIndexed())
+		assert.False(t, index.ContainsNode(42))
+		assert.True(t, index.ContainsNode(43))
+
+		res, _, err := index.SearchByVector(vectors[43], 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{43}, res)
+	})
+
+	t.Run("search by distance", func(t *testing.T) {
+		res, dists, err := index.SearchByVectorDistance(vectors[43], 0.0001, 100, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{43}, res)
+		assert.Len(t, dists, 1)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		require.Nil(t, index.Drop(ctx))
+		assert.NoDirExists(t, index.dir)
+	})
+}
+
+func TestDiskANNDimensionMismatch(t *testing.T) {
+	uc := ent.NewDefaultUserConfig()
+	index := testIndex(t, t.TempDir(), uc)
+	defer index.Shutdown(context.Background())
+
+	require.Nil(t, index.Add(0, []float32{1, 2, 3}))
+	assert.NotNil(t, index.Add(1, []float32{1, 2}))
+}
+
+func TestDiskANNValidateUserConfigUpdate(t *testing.T) {
+	initial := ent.NewDefaultUserConfig()
+
+	updated := initial
+	updated.SearchListSize = 200
+	updated.BeamWidth = 8
+	assert.Nil(t, ValidateUserConfigUpdate(initial, updated))
+
+	updated = initial
+	updated.MaxDegree = 32
+	assert.NotNil(t, ValidateUserConfigUpdate(initial, updated))
+}
+
+func TestDiskANNRecovery(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	vectors, _ := testinghelpers.RandomVecs(300, 0, 16)
+
+	uc := ent.NewDefaultUserConfig()
+	uc.Distance = "l2-squared"
+	uc.PQTrainingLimit = 256
+
+	index := testIndex(t, rootPath, uc)
+	for i, vector := range vectors {
+		require.Nil(t, index.Add(uint64(i), vector))
+	}
+	require.Nil(t, index.Delete(7))
+	require.Nil(t, index.Flush())
+
+	// crash before the changes were checkpointed, the last entry was only
+	// partially written
+	segment := filepath.Join(index.dir, segmentName(index.wal.seq))
+	require.Nil(t, index.closeFiles())
+	fd, err := os.OpenFile(segment, os.O_APPEND|os.O_WRONLY, 0o666)
+	require.Nil(t, err)
+	_, err = fd.Write([]byte{100, 0, 0, 0, 1, 2, 3})
+	require.Nil(t, err)
+	require.Nil(t, fd.Close())
+
+	index = testIndex(t, rootPath, uc)
+	defer index.Shutdown(ctx)
+
+	assert.True(t, index.Compressed())
+	assert.Equal(t, uint64(len(vectors)-1), index.AlreadyIndexed())
+	assert.False(t, index.ContainsNode(7))
+	res, _, err := index.SearchByVector(vectors[42], 1, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{42}, res)
+
+	segments, err := filepath.Glob(filepath.Join(index.dir, "*"+walSuffix))
+	require.Nil(t, err)
+	assert.Len(t, segments, 1, "replayed segments are deleted")
+}
+
+func TestDiskANNBackup(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	vectors, _ := testinghelpers.RandomVecs(200, 0, 16)
+
+	index := testIndex(t, rootPath, ent.NewDefaultUserConfig())
+	defer index.Shutdown(ctx)
+
+	for i, vector := range vectors[:100] {
+		require.Nil(t, index.Add(uint64(i), vector))
+	}
+	require.Nil(t, index.SwitchCommitLogs(ctx))
+
+	files, err := index.ListFiles(ctx, rootPath)
+	require.Nil(t, err)
+	assert.ElementsMatch(t, []string{
+		"vectors.diskann.d/graph.bin",
+		"vectors.diskann.d/status.bin",
+		"vectors.diskann.d/slots.bin",
+		"vectors.diskann.d/codes.bin",
+	}, files)
+	contents := func() map[string][]byte {
+		contents := map[string][]byte{}
+		for _, file := range files {
+			data, err := os.ReadFile(filepath.Join(rootPath, file))
+			require.Nil(t, err)
+			contents[file] = data
+		}
+		return contents
+	}
+	before := contents()
+
+	// checkpoints are paused until the backup is released
+	for i, vector := range vectors[100:] {
+		require.Nil(t, index.Add(uint64(100+i), vector))
+	}
+	require.Nil(t, index.Delete(1, 2, 3))
+	require.Nil(t, index.Flush())
+	assert.Equal(t, before, contents())
+
+	require.Nil(t, index.checkpoint())
+	assert.NotEqual(t, before, contents())
+}
+
+func TestDiskANNConsolidate(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	vectors, queries := testinghelpers.RandomVecs(1500, 20, 32)
+
+	uc := ent.NewDefaultUserConfig()
+	uc.Distance = "l2-squared"
+	uc.MaxDegree = 24
+	uc.PQTrainingLimit = 500
+
+	index := testIndex(t, rootPath, uc)
+	for i, vector := range vectors[:1000] {
+		require.Nil(t, index.Add(uint64(i), vector))
+	}
+
+	// delete every other node
+	var remaining [][]float32
+	remainingIDs := map[uint64]uint64{}
+	for i := range vectors[:1000] {
+		if i%2 == 0 {
+			require.Nil(t, index.Delete(uint64(i)))
+		} else {
+			remainingIDs[uint64(len(remaining))] = uint64(i)
+			remaining = append(remaining, vectors[i])
+		}
+	}
+	require.Nil(t, index.checkpoint())
+	stat, err := index.graph.fd.Stat()
+	require.Nil(t, err)
+	graphSize := stat.Size()
+
+	require.True(t, index.consolidateCycle(func() bool { return false }))
+	assert.Equal(t, uint64(500), index.AlreadyIndexed())
+	assert.Len(t, index.freeSlots, 500)
+	for i := 1; i < 1000; i += 2 {
+		node, err := index.readNode(uint64(i))
+		require.Nil(t, err)
+		for _, neighbor := range node.neighbors {
+			assert.Equal(t, statusLive, index.statusOf(neighbor))
+		}
+	}
+	assert.Equal(t, statusLive, index.statusOf(index.entrypoint))
+
+	logger, _ := test.NewNullLogger()
+	var matches uint64
+	for _, query := range queries {
+		truth, _ := testinghelpers.BruteForce(logger, remaining, query, 10,
+			distanceWrapper(distancer.NewL2SquaredProvider()))
+		for j := range truth {
+			truth[j] = remainingIDs[truth[j]]
+		}
+		ids, _, err := index.SearchByVector(query, 10, nil)
+		require.Nil(t, err)
+		matches += testinghelpers.MatchesInLists(truth, ids)
+	}
+	assert.Greater(t, float32(matches)/float32(10*len(queries)), float32(0.9))
+
+	t.Run("new nodes reuse the slots", func(t *testing.T) {
+		for i, vector := range vectors[1000:] {
+			require.Nil(t, index.Add(uint64(1000+i), vector))
+		}
+		require.Nil(t, index.checkpoint())
+		assert.Empty(t, index.freeSlots)
+
+		stat, err := index.graph.fd.Stat()
+		require.Nil(t, err)
+		assert.Equal(t, graphSize, stat.Size())
+	})
+
+	t.Run("restart", func(t *testing.T) {
+		require.Nil(t, index.Shutdown(ctx))
+		index = testIndex(t, rootPath, uc)
+		defer index.Shutdown(ctx)
+
+		assert.Equal(t, uint64(1000), index.AlreadyIndexed())
+		assert.False(t, index.ContainsNode(0))
+		assert.Empty(t, index.freeSlots)
+		res, _, err := index.SearchByVector(vectors[1200], 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{1200}, res)
+	})
+}
+
+func TestDiskANNConcurrentInserts(t *testing.T) {
+	ctx := context.Background()
+	vectors, queries := testinghelpers.RandomVecs(1000, 20, 32)
+
+	uc := ent.NewDefaultUserConfig()
+	uc.Distance = "l2-squared"
+	uc.MaxDegree = 24
+	uc.PQTrainingLimit = 300
+
+	index := testIndex(t, t.TempDir(), uc)
+	defer index.Shutdown(ctx)
+
+	workers := 8
+	eg := errgroup.Group{}
+	for w := 0; w < workers; w++ {
+		w := w
+		eg.Go(func() error {
+			for i := w; i < len(vectors); i += workers {
+				if err := index.Add(uint64(i), vectors[i]); err != nil {
+					return err
+				}
+				if _, _, err := index.SearchByVector(queries[i%len(queries)], 5, nil); err != nil {
+					return err
+				}
+				if i%10 == 0 {
+					if err := index.checkpoint(); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		})
+	}
+	require.Nil(t, eg.Wait())
+
+	assert.Equal(t, uint64(len(vectors)), index.AlreadyIndexed())
+	assert.Greater(t, recall(t, index, vectors, queries, 10), float32(0.9))
+}
@@ -0,0 +1,291 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+func (i *diskann) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	if len(ids) != len(vectors) {
+		return errors.Errorf("ids and vectors sizes does not match")
+	}
+	if len(ids) == 0 {
+		return errors.Errorf("insertBatch called with empty lists")
+	}
+
+	for j := range ids {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := i.Add(ids[j], vectors[j]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *diskann) Add(id uint64, vector []float32) error {
+	if len(vector) == 0 {
+		return errors.Errorf("insert called with nil-vector")
+	}
+	vector = i.normalized(vector)
+
+	inserted, err := i.addFirst(id, vector)
+	if err != nil {
+		return err
+	}
+	if !inserted {
+		i.RLock()
+		err = i.insert(id, vector)
+		i.RUnlock()
+		if err != nil {
+			return errors.Wrapf(err, "insert node %d", id)
+		}
+	}
+
+	return i.trainPQIfNeeded()
+}
+
+// addFirst sets the layout of the records and inserts the entrypoint if the
+// graph is empty. It returns whether the node was inserted.
+func (i *diskann) addFirst(id uint64, vector []float32) (bool, error) {
+	i.RLock()
+	ready := i.hasEntrypoint && i.graph.dims == len(vector)
+	i.RUnlock()
+	if ready {
+		return false, nil
+	}
+
+	i.Lock()
+	defer i.Unlock()
+
+	if i.graph.dims == 0 {
+		if err := i.commit(walOp{typ: opLayout, dims: len(vector), maxDegree: i.maxDegree}); err != nil {
+			return false, err
+		}
+	}
+	if len(vector) != i.graph.dims {
+		return false, errors.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), i.graph.dims)
+	}
+	if i.hasEntrypoint {
+		return false, nil
+	}
+
+	node := &diskNode{id: id, vector: vector}
+	ops := append(i.nodeOps(node, statusLive),
+		walOp{typ: opEntrypoint, id: id, hasEntrypoint: true})
+	if err := i.commit(ops...); err != nil {
+		return false, errors.Wrapf(err, "insert node %d", id)
+	}
+	return true, nil
+}
+
+func (i *diskann) trainPQIfNeeded() error {
+	i.RLock()
+	needed := i.pq == nil && atomic.LoadUint64(&i.count) >= uint64(i.pqTrainingLimit)
+	i.RUnlock()
+	if !needed {
+		return nil
+	}
+
+	i.Lock()
+	defer i.Unlock()
+
+	if i.pq != nil {
+		return nil
+	}
+	if err := i.trainPQ(); err != nil {
+		return errors.Wrap(err, "train product quantizer")
+	}
+	return nil
+}
+
+// nodeOps returns the changes to write the node with its code
+func (i *diskann) nodeOps(node *diskNode, status uint8) []walOp {
+	ops := []walOp{{typ: opRecord, id: node.id, data: i.graph.encodeNode(node)}}
+	if i.pq != nil {
+		ops = append(ops, walOp{typ: opCode, id: node.id, data: i.pq.Encode(node.vector)})
+	}
+	return append(ops, walOp{typ: opStatus, id: node.id, status: status})
+}
+
+// insert adds the node following the Vamana insert: search the graph for the
+// new vector, pick the neighbors among the expanded nodes using robustPrune
+// and add the reverse edges, pruning the neighbors which exceed the maximum
+// degree. Concurrent inserts only lock the node whose adjacency list they
+// change.
+func (i *diskann) insert(id uint64, vector []float32) error {
+	if len(vector) != i.graph.dims {
+		return errors.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), i.graph.dims)
+	}
+
+	node, neighbors, err := i.link(id, vector)
+	if err != nil || node == nil {
+		return err
+	}
+
+	for _, neighbor := range neighbors {
+		if err := i.addReverseEdge(neighbor.node.id, node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// link writes the node with its neighbors, it returns a nil node if the node
+// already exists
+func (i *diskann) link(id uint64, vector []float32) (*diskNode, []scoredNode, error) {
+	i.nodeLocks.Lock(id)
+	defer i.nodeLocks.Unlock(id)
+
+	if i.statusOf(id) == statusLive {
+		// the node was already indexed, e.g. when the index queue is replayed
+		// after a crash
+		return nil, nil, nil
+	}
+
+	expanded, err := i.beamSearch(vector, i.buildSearchListSize, i.beamWidth)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "search neighbors")
+	}
+
+	neighbors, err := i.robustPrune(id, expanded)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	node := &diskNode{id: id, neighbors: nodeIDs(neighbors), vector: vector}
+	if err := i.commit(i.nodeOps(node, statusLive)...); err != nil {
+		return nil, nil, err
+	}
+	return node, neighbors, nil
+}
+
+func (i *diskann) addReverseEdge(fromID uint64, to *diskNode) error {
+	i.nodeLocks.Lock(fromID)
+	defer i.nodeLocks.Unlock(fromID)
+
+	// the adjacency list might have changed since the search
+	from, err := i.readNode(fromID)
+	if err != nil {
+		return err
+	}
+	for _, neighbor := range from.neighbors {
+		if neighbor == to.id {
+			return nil
+		}
+	}
+
+	if len(from.neighbors) < i.graph.maxDegree {
+		from.neighbors = append(from.neighbors, to.id)
+		return i.commit(walOp{typ: opRecord, id: from.id, data: i.graph.encodeNode(from)})
+	}
+
+	// the adjacency list is full, pick the best ones among the existing
+	// neighbors and the new node
+	candidates, err := i.scoreCandidates(from, from.neighbors)
+	if err != nil {
+		return err
+	}
+	dist, _, err := i.distancerProvider.SingleDist(from.vector, to.vector)
+	if err != nil {
+		return err
+	}
+	candidates = append(candidates, scoredNode{node: to, dist: dist})
+	sort.Slice(candidates, func(a, b int) bool {
+		return candidates[a].dist < candidates[b].dist
+	})
+
+	pruned, err := i.robustPrune(from.id, candidates)
+	if err != nil {
+		return err
+	}
+	from.neighbors = nodeIDs(pruned)
+	return i.commit(walOp{typ: opRecord, id: from.id, data: i.graph.encodeNode(from)})
+}
+
+// scoreCandidates reads the existing candidates and scores them by their
+// distance to the node, absent ones are skipped
+func (i *diskann) scoreCandidates(node *diskNode, ids []uint64) ([]scoredNode, error) {
+	existing := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		if i.statusOf(id) != statusAbsent {
+			existing = append(existing, id)
+		}
+	}
+	nodes, err := i.readNodes(existing)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read neighbors of node %d", node.id)
+	}
+
+	candidates := make([]scoredNode, 0, len(nodes)+1)
+	for _, candidate := range nodes {
+		dist, _, err := i.distancerProvider.SingleDist(node.vector, candidate.vector)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, scoredNode{node: candidate, dist: dist})
+	}
+	return candidates, nil
+}
+
+// robustPrune selects up to maxDegree neighbors from the candidates, which
+// must be ordered by their distance to the node. A candidate is skipped if an
+// already selected neighbor is closer to it, by a factor of alpha, than the
+// node itself. This keeps long edges to other regions of the graph, which
+// makes the graph navigable in few steps. Deleted nodes are never selected.
+func (i *diskann) robustPrune(id uint64, candidates []scoredNode) ([]scoredNode, error) {
+	selected := make([]scoredNode, 0, i.graph.maxDegree)
+
+outer:
+	for _, c := range candidates {
+		if len(selected) == i.graph.maxDegree {
+			break
+		}
+		if c.node.id == id || i.statusOf(c.node.id) != statusLive {
+			continue
+		}
+
+		for _, s := range selected {
+			if s.node.id == c.node.id {
+				continue outer
+			}
+
+			dist, _, err := i.distancerProvider.SingleDist(s.node.vector, c.node.vector)
+			if err != nil {
+				return nil, err
+			}
+			if i.alpha*dist <= c.dist {
+				continue outer
+			}
+		}
+
+		selected = append(selected, c)
+	}
+
+	return selected, nil
+}
+
+func nodeIDs(nodes []scoredNode) []uint64 {
+	ids := make([]uint64, len(nodes))
+	for j, node := range nodes {
+		ids[j] = node.node.id
+	}
+	return ids
+}
@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// pqReadBatchSize is the number of vectors read concurrently while training
+// the codebook and encoding the existing nodes
+const pqReadBatchSize = 256
+
+func (i *diskann) pqConfig(segments int) hnswent.PQConfig {
+	return hnswent.PQConfig{
+		Enabled:       true,
+		Segments:      segments,
+		Centroids:     256,
+		TrainingLimit: i.pqTrainingLimit,
+		Encoder: hnswent.PQEncoder{
+			Type:         hnswent.PQEncoderTypeKMeans,
+			Distribution: hnswent.PQEncoderDistributionLogNormal,
+		},
+	}
+}
+
+// segmentsFor picks a segment per four dimensions, which compresses the
+// vectors 16x
+func segmentsFor(dims int) int {
+	for segments := dims / 4; segments > 1; segments-- {
+		if dims%segments == 0 {
+			return segments
+		}
+	}
+	return dims
+}
+
+// trainPQ fits the codebook on the first nodes of the graph and encodes all
+// nodes added so far. The codebook and the codes are committed together, so
+// the codes of all nodes are known once the codebook is restored.
+func (i *diskann) trainPQ() error {
+	before := time.Now()
+
+	segments := i.pqSegments
+	if segments == 0 {
+		segments = segmentsFor(i.graph.dims)
+	}
+
+	pq, err := compressionhelpers.NewProductQuantizer(i.pqConfig(segments),
+		i.distancerProvider, i.graph.dims, i.logger)
+	if err != nil {
+		return errors.Wrap(err, "create product quantizer")
+	}
+
+	ids := i.existingIDs()
+	training := ids[:min(len(ids), i.pqTrainingLimit)]
+	data := make([][]float32, 0, len(training))
+	for start := 0; start < len(training); start += pqReadBatchSize {
+		vectors, err := i.readVectors(training[start:min(start+pqReadBatchSize, len(training))])
+		if err != nil {
+			return errors.Wrap(err, "read training data")
+		}
+		data = append(data, vectors...)
+	}
+
+	if err := pq.Fit(data); err != nil {
+		return errors.Wrap(err, "fit product quantizer")
+	}
+
+	ops := make([]walOp, 0, len(ids)+1)
+	ops = append(ops, walOp{typ: opCodebook, data: serializeCodebook(pq)})
+	for start := 0; start < len(ids); start += pqReadBatchSize {
+		batch := ids[start:min(start+pqReadBatchSize, len(ids))]
+		vectors, err := i.readVectors(batch)
+		if err != nil {
+			return errors.Wrap(err, "read vectors to encode")
+		}
+		for j, id := range batch {
+			ops = append(ops, walOp{typ: opCode, id: id, data: pq.Encode(vectors[j])})
+		}
+	}
+	if err := i.commit(ops...); err != nil {
+		return err
+	}
+
+	i.logger.WithField("action", "diskann_train_pq").
+		WithField("id", i.id).
+		WithField("segments", segments).
+		WithField("nodes", len(ids)).
+		WithField("took", time.Since(before)).
+		Info("trained product quantizer of disk-resident index")
+
+	return nil
+}
+
+// existingIDs returns the ids of the live and deleted nodes
+func (i *diskann) existingIDs() []uint64 {
+	i.stateLock.RLock()
+	defer i.stateLock.RUnlock()
+
+	var ids []uint64
+	for id, status := range i.status {
+		if status != statusAbsent {
+			ids = append(ids, uint64(id))
+		}
+	}
+	return ids
+}
+
+// code returns the code of the node, stateLock must be held
+func (i *diskann) code(id uint64) []byte {
+	return i.codes[int(id)*i.pqSegments : int(id+1)*i.pqSegments]
+}
+
+// serializeCodebook uses the same layout as the PQ data in the hnsw commit
+// log
+func serializeCodebook(pq *compressionhelpers.ProductQuantizer) []byte {
+	data := pq.ExposeFields()
+	buf := make([]byte, 9)
+	binary.LittleEndian.PutUint16(buf[0:2], data.Dimensions)
+	buf[2] = byte(data.EncoderType)
+	binary.LittleEndian.PutUint16(buf[3:5], data.Ks)
+	binary.LittleEndian.PutUint16(buf[5:7], data.M)
+	buf[7] = data.EncoderDistribution
+	if data.UseBitsEncoding {
+		buf[8] = 1
+	}
+	for _, encoder := range data.Encoders {
+		buf = append(buf, encoder.ExposeDataForRestore()...)
+	}
+	return buf
+}
+
+// deserializeCodebook returns the product quantizer and its number of
+// segments
+func (i *diskann) deserializeCodebook(data []byte) (*compressionhelpers.ProductQuantizer, int, error) {
+	res := &hnsw.DeserializationResult{}
+	if err := hnsw.NewDeserializer(i.logger).ReadPQ(bufio.NewReader(bytes.NewReader(data)), res); err != nil {
+		return nil, 0, errors.Wrap(err, "read codebook")
+	}
+
+	pq, err := compressionhelpers.NewProductQuantizerWithEncoders(i.pqConfig(int(res.PQData.M)),
+		i.distancerProvider, int(res.PQData.Dimensions), res.PQData.Encoders, i.logger)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "restore product quantizer")
+	}
+
+	return pq, int(res.PQData.M), nil
+}
@@ -0,0 +1,326 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+)
+
+// flatSearchCutoff is the size of an allow list up to which the allowed
+// vectors are compared directly instead of searching the graph. The graph
+// search only sees a limited number of candidates, which might not contain
+// enough allowed nodes for very restrictive filters.
+const flatSearchCutoff = 4096
+
+type candidate struct {
+	id       uint64
+	dist     float32
+	expanded bool
+}
+
+type scoredNode struct {
+	node *diskNode
+	dist float32
+}
+
+// approxDistancer returns a function to calculate the distance between the
+// query and the given nodes. Once the codebook is trained, the in-memory PQ
+// codes are used, before that the full vectors are read from disk.
+func (i *diskann) approxDistancer(query []float32) (func(ids []uint64) ([]float32, error), func()) {
+	if i.pq == nil {
+		return func(ids []uint64) ([]float32, error) {
+			vectors, err := i.readVectors(ids)
+			if err != nil {
+				return nil, err
+			}
+			dists := make([]float32, len(ids))
+			for j, vector := range vectors {
+				dists[j], _, err = i.distancerProvider.SingleDist(query, vector)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return dists, nil
+		}, func() {}
+	}
+
+	distancer := i.pq.NewDistancer(query)
+	return func(ids []uint64) ([]float32, error) {
+		i.stateLock.RLock()
+		defer i.stateLock.RUnlock()
+
+		dists := make([]float32, len(ids))
+		for j, id := range ids {
+			dist, _, err := distancer.Distance(i.code(id))
+			if err != nil {
+				return nil, err
+			}
+			dists[j] = dist
+		}
+		return dists, nil
+	}, func() { i.pq.ReturnDistancer(distancer) }
+}
+
+// beamSearch traverses the graph starting at the entrypoint. In every step
+// the beamWidth closest candidates which weren't expanded yet are read from
+// disk in one batch. Their full vectors are used to calculate their exact
+// distance, their neighbors become new candidates based on their approximate
+// distance. The candidate list is limited to listSize.
+//
+// All expanded nodes are returned, ordered by their exact distance.
+func (i *diskann) beamSearch(query []float32, listSize, beamWidth int) ([]scoredNode, error) {
+	approx, release := i.approxDistancer(query)
+	defer release()
+
+	dists, err := approx([]uint64{i.entrypoint})
+	if err != nil {
+		return nil, errors.Wrap(err, "distance to entrypoint")
+	}
+
+	visited := map[uint64]struct{}{i.entrypoint: {}}
+	candidates := []candidate{{id: i.entrypoint, dist: dists[0]}}
+	var expanded []scoredNode
+
+	batch := make([]uint64, 0, beamWidth)
+	for {
+		batch = batch[:0]
+		for j := range candidates {
+			if len(batch) == beamWidth {
+				break
+			}
+			if !candidates[j].expanded {
+				candidates[j].expanded = true
+				batch = append(batch, candidates[j].id)
+			}
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		nodes, err := i.readNodes(batch)
+		if err != nil {
+			return nil, err
+		}
+
+		var next []uint64
+		for _, node := range nodes {
+			dist, _, err := i.distancerProvider.SingleDist(query, node.vector)
+			if err != nil {
+				return nil, err
+			}
+			expanded = append(expanded, scoredNode{node: node, dist: dist})
+
+			for _, neighbor := range node.neighbors {
+				if _, ok := visited[neighbor]; ok {
+					continue
+				}
+				visited[neighbor] = struct{}{}
+				next = append(next, neighbor)
+			}
+		}
+		// adjacency lists of deleted nodes can still contain consolidated ones
+		next = i.withStatus(next, statusLive, statusDeleted)
+
+		if len(next) == 0 {
+			continue
+		}
+		dists, err := approx(next)
+		if err != nil {
+			return nil, err
+		}
+		for j, id := range next {
+			candidates = insertCandidate(candidates, candidate{id: id, dist: dists[j]}, listSize)
+		}
+	}
+
+	sort.Slice(expanded, func(a, b int) bool {
+		return expanded[a].dist < expanded[b].dist
+	})
+	return expanded, nil
+}
+
+// withStatus filters the ids in place, keeping the ones with any of the given
+// statuses
+func (i *diskann) withStatus(ids []uint64, statuses ...uint8) []uint64 {
+	i.stateLock.RLock()
+	defer i.stateLock.RUnlock()
+
+	filtered := ids[:0]
+	for _, id := range ids {
+		if slices.Contains(statuses, i.statusOfLocked(id)) {
+			filtered = append(filtered, id)
+		}
+	}
+	return filtered
+}
+
+// insertCandidate keeps the candidates ordered by distance and limited to
+// listSize
+func insertCandidate(candidates []candidate, c candidate, listSize int) []candidate {
+	pos := sort.Search(len(candidates), func(j int) bool {
+		return candidates[j].dist > c.dist
+	})
+	if pos >= listSize {
+		return candidates
+	}
+
+	if len(candidates) < listSize {
+		candidates = append(candidates, candidate{})
+	}
+	copy(candidates[pos+1:], candidates[pos:])
+	candidates[pos] = c
+	return candidates
+}
+
+func (i *diskann) SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	vector = i.normalized(vector)
+
+	i.RLock()
+	defer i.RUnlock()
+
+	if !i.hasEntrypoint || k <= 0 {
+		return nil, nil, nil
+	}
+
+	if allow != nil && allow.Len() <= flatSearchCutoff {
+		return i.flatSearch(vector, k, allow)
+	}
+
+	listSize := i.searchListSize
+	if listSize < k {
+		listSize = k
+	}
+
+	expanded, err := i.beamSearch(vector, listSize, i.beamWidth)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "beam search")
+	}
+
+	ids := make([]uint64, 0, k)
+	dists := make([]float32, 0, k)
+	i.stateLock.RLock()
+	for _, scored := range expanded {
+		if len(ids) == k {
+			break
+		}
+		if i.statusOfLocked(scored.node.id) != statusLive {
+			continue
+		}
+		if allow != nil && !allow.Contains(scored.node.id) {
+			continue
+		}
+		ids = append(ids, scored.node.id)
+		dists = append(dists, scored.dist)
+	}
+	i.stateLock.RUnlock()
+
+	return ids, dists, nil
+}
+
+// flatSearch compares the query to all allowed vectors
+func (i *diskann) flatSearch(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	ids := make([]uint64, 0, allow.Len())
+	it := allow.Iterator()
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		ids = append(ids, id)
+	}
+	ids = i.withStatus(ids, statusLive)
+
+	results := make([]candidate, 0, len(ids))
+	for start := 0; start < len(ids); start += pqReadBatchSize {
+		batch := ids[start:min(start+pqReadBatchSize, len(ids))]
+		vectors, err := i.readVectors(batch)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "flat search")
+		}
+		for j, id := range batch {
+			dist, _, err := i.distancerProvider.SingleDist(vector, vectors[j])
+			if err != nil {
+				return nil, nil, err
+			}
+			results = append(results, candidate{id: id, dist: dist})
+		}
+	}
+
+	sort.Slice(results, func(a, b int) bool {
+		return results[a].dist < results[b].dist
+	})
+	if len(results) > k {
+		results = results[:k]
+	}
+
+	resultIDs := make([]uint64, len(results))
+	resultDists := make([]float32, len(results))
+	for j, result := range results {
+		resultIDs[j] = result.id
+		resultDists[j] = result.dist
+	}
+	return resultIDs, resultDists, nil
+}
+
+func (i *diskann) SearchByVectorDistance(vector []float32, targetDistance float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	searchParams := common.NewSearchByDistParams(0, common.DefaultSearchByDistInitialLimit,
+		common.DefaultSearchByDistInitialLimit, maxLimit)
+
+	var resultIDs []uint64
+	var resultDist []float32
+	for {
+		totalLimit := searchParams.TotalLimit()
+		ids, dist, err := i.SearchByVector(vector, totalLimit, allow)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "vector search")
+		}
+
+		// if there is less results than given limit search can be stopped
+		shouldContinue := len(ids) >= totalLimit
+
+		// ensures the indexes aren't out of range
+		offsetCap := searchParams.OffsetCapacity(ids)
+		totalLimitCap := searchParams.TotalLimitCapacity(ids)
+		ids, dist = ids[offsetCap:totalLimitCap], dist[offsetCap:totalLimitCap]
+		for j := range ids {
+			if dist[j] <= targetDistance ||
+				floatcomp.InDelta(float64(dist[j]), float64(targetDistance), 1e-6) {
+				resultIDs = append(resultIDs, ids[j])
+				resultDist = append(resultDist, dist[j])
+			} else {
+				// as soon as we encounter a distance which is above the
+				// threshold, we can stop searching
+				shouldContinue = false
+				break
+			}
+		}
+
+		if !shouldContinue {
+			break
+		}
+
+		searchParams.Iterate()
+		if searchParams.MaxLimitReached() {
+			i.logger.
+				WithField("action", "unlimited_vector_search").
+				Warnf("maximum search limit of %d results has been reached",
+					searchParams.MaximumSearchLimit())
+			break
+		}
+	}
+
+	return resultIDs, resultDist, nil
+}
@@ -0,0 +1,296 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+
+	"github.com/pkg/errors"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+const (
+	graphFileName    = "graph.bin"
+	statusFileName   = "status.bin"
+	slotsFileName    = "slots.bin"
+	codesFileName    = "codes.bin"
+	codebookFileName = "codebook.bin"
+
+	graphMagic   = "WVDSKANN"
+	graphVersion = 1
+	// the header is padded, so records never share a page with it
+	graphHeaderSize = 4096
+)
+
+// the status of every node is kept in memory and in the status file, one
+// byte per id
+const (
+	statusAbsent uint8 = iota
+	statusLive
+	// deleted nodes are no longer returned, but are still used to navigate
+	// the graph until they are consolidated
+	statusDeleted
+)
+
+type diskNode struct {
+	id        uint64
+	neighbors []uint64
+	vector    []float32
+}
+
+// graphFile contains a fixed-size record per slot, so a node can be read
+// with a single read at a known offset. The adjacency list is placed next to
+// the full vector, so expanding a node during the search also provides the
+// vector for rescoring. The slot of every node is kept in the slots file,
+// slots of consolidated nodes are reused.
+//
+// Header: magic [8]byte, version uint8, dims uint32, maxDegree uint32,
+// entrypoint uint64, hasEntrypoint uint8
+//
+// Record: degree uint32, maxDegree * uint64 neighbors, dims * float32 vector
+type graphFile struct {
+	fd         *os.File
+	dims       int
+	maxDegree  int
+	recordSize int64
+}
+
+type graphHeader struct {
+	dims          int
+	maxDegree     int
+	entrypoint    uint64
+	hasEntrypoint bool
+}
+
+func openGraphFile(path string) (*graphFile, *graphHeader, error) {
+	fd, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o666)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "open graph file %q", path)
+	}
+
+	stat, err := fd.Stat()
+	if err != nil {
+		fd.Close()
+		return nil, nil, errors.Wrapf(err, "stat graph file %q", path)
+	}
+
+	g := &graphFile{fd: fd}
+	if stat.Size() == 0 {
+		// dimensions are only known once the first vector is added
+		return g, nil, nil
+	}
+
+	buf := make([]byte, 26)
+	if _, err := fd.ReadAt(buf, 0); err != nil {
+		fd.Close()
+		return nil, nil, errors.Wrapf(err, "read header of graph file %q", path)
+	}
+	if string(buf[:8]) != graphMagic {
+		fd.Close()
+		return nil, nil, errors.Errorf("graph file %q: invalid header", path)
+	}
+	if buf[8] != graphVersion {
+		fd.Close()
+		return nil, nil, errors.Errorf("graph file %q: unsupported version %d", path, buf[8])
+	}
+
+	header := &graphHeader{
+		dims:          int(binary.LittleEndian.Uint32(buf[9:13])),
+		maxDegree:     int(binary.LittleEndian.Uint32(buf[13:17])),
+		entrypoint:    binary.LittleEndian.Uint64(buf[17:25]),
+		hasEntrypoint: buf[25] == 1,
+	}
+	g.setLayout(header.dims, header.maxDegree)
+
+	return g, header, nil
+}
+
+func (g *graphFile) setLayout(dims, maxDegree int) {
+	g.dims = dims
+	g.maxDegree = maxDegree
+	g.recordSize = int64(4 + 8*maxDegree + 4*dims)
+}
+
+func (g *graphFile) writeHeader(header graphHeader) error {
+	buf := make([]byte, 26)
+	copy(buf, graphMagic)
+	buf[8] = graphVersion
+	binary.LittleEndian.PutUint32(buf[9:13], uint32(header.dims))
+	binary.LittleEndian.PutUint32(buf[13:17], uint32(header.maxDegree))
+	binary.LittleEndian.PutUint64(buf[17:25], header.entrypoint)
+	if header.hasEntrypoint {
+		buf[25] = 1
+	}
+
+	if _, err := g.fd.WriteAt(buf, 0); err != nil {
+		return errors.Wrap(err, "write graph header")
+	}
+	return nil
+}
+
+func (g *graphFile) offset(slot uint64) int64 {
+	return graphHeaderSize + int64(slot)*g.recordSize
+}
+
+func (g *graphFile) readRecord(slot uint64) ([]byte, error) {
+	buf := make([]byte, g.recordSize)
+	if _, err := g.fd.ReadAt(buf, g.offset(slot)); err != nil {
+		return nil, errors.Wrapf(err, "read record %d", slot)
+	}
+	return buf, nil
+}
+
+func (g *graphFile) writeRecord(slot uint64, record []byte) error {
+	if _, err := g.fd.WriteAt(record, g.offset(slot)); err != nil {
+		return errors.Wrapf(err, "write record %d", slot)
+	}
+	return nil
+}
+
+func (g *graphFile) encodeNode(node *diskNode) []byte {
+	buf := make([]byte, g.recordSize)
+	binary.LittleEndian.PutUint32(buf[0:4], uint32(len(node.neighbors)))
+	for i, neighbor := range node.neighbors {
+		binary.LittleEndian.PutUint64(buf[4+i*8:], neighbor)
+	}
+	vectorStart := 4 + 8*g.maxDegree
+	for i, v := range node.vector {
+		binary.LittleEndian.PutUint32(buf[vectorStart+i*4:], math.Float32bits(v))
+	}
+	return buf
+}
+
+func (g *graphFile) decodeNode(id uint64, record []byte) (*diskNode, error) {
+	degree := int(binary.LittleEndian.Uint32(record[0:4]))
+	if degree > g.maxDegree {
+		return nil, errors.Errorf("node %d: degree %d exceeds maximum of %d",
+			id, degree, g.maxDegree)
+	}
+
+	node := &diskNode{
+		id:        id,
+		neighbors: make([]uint64, degree),
+		vector:    g.decodeVector(record),
+	}
+	for i := range node.neighbors {
+		node.neighbors[i] = binary.LittleEndian.Uint64(record[4+i*8:])
+	}
+	return node, nil
+}
+
+func (g *graphFile) decodeVector(record []byte) []float32 {
+	vectorStart := 4 + 8*g.maxDegree
+	vector := make([]float32, g.dims)
+	for i := range vector {
+		vector[i] = math.Float32frombits(
+			binary.LittleEndian.Uint32(record[vectorStart+i*4:]))
+	}
+	return vector
+}
+
+// record returns the current record of the node, which is either one written
+// since the last checkpoint or the one on disk
+func (i *diskann) record(id uint64) ([]byte, error) {
+	// the lock is held while reading from disk, records are only written to
+	// disk by checkpoints while they are still kept in memory, so a record
+	// read from disk is never written at the same time
+	i.stateLock.RLock()
+	defer i.stateLock.RUnlock()
+
+	if i.statusOfLocked(id) == statusAbsent {
+		return nil, errors.Errorf("node %d does not exist", id)
+	}
+	slot := i.slots[id]
+	if record, ok := i.records[slot]; ok {
+		return record, nil
+	}
+	return i.graph.readRecord(slot)
+}
+
+func (i *diskann) readNode(id uint64) (*diskNode, error) {
+	record, err := i.record(id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read node %d", id)
+	}
+	return i.graph.decodeNode(id, record)
+}
+
+func (i *diskann) readVector(id uint64) ([]float32, error) {
+	record, err := i.record(id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read vector of node %d", id)
+	}
+	return i.graph.decodeVector(record), nil
+}
+
+// readNodes reads all nodes concurrently, so the reads of one search step
+// are issued as a batch instead of one after the other
+func (i *diskann) readNodes(ids []uint64) ([]*diskNode, error) {
+	nodes := make([]*diskNode, len(ids))
+	if len(ids) == 1 {
+		node, err := i.readNode(ids[0])
+		nodes[0] = node
+		return nodes, err
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(i.logger)
+	for j, id := range ids {
+		j, id := j, id
+		eg.Go(func() error {
+			node, err := i.readNode(id)
+			nodes[j] = node
+			return err
+		})
+	}
+
+	return nodes, eg.Wait()
+}
+
+// readVectors reads all vectors concurrently, see readNodes
+func (i *diskann) readVectors(ids []uint64) ([][]float32, error) {
+	vectors := make([][]float32, len(ids))
+	eg := enterrors.NewErrorGroupWrapper(i.logger)
+	for j, id := range ids {
+		j, id := j, id
+		eg.Go(func() error {
+			vector, err := i.readVector(id)
+			vectors[j] = vector
+			return err
+		})
+	}
+
+	return vectors, eg.Wait()
+}
+
+// readSlots reads the slot of every node and returns how many slots were
+// persisted. Indexes created before slots were reused have no slots file,
+// they store every node at the slot of its id.
+func readSlots(fd *os.File, ids int) ([]uint64, int, error) {
+	buf, err := io.ReadAll(fd)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	persisted := len(buf) / 8
+	slots := make([]uint64, max(ids, persisted))
+	for id := range slots {
+		if id < persisted {
+			slots[id] = binary.LittleEndian.Uint64(buf[id*8:])
+		} else {
+			slots[id] = uint64(id)
+		}
+	}
+	return slots, persisted, nil
+}
@@ -0,0 +1,334 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const walSuffix = ".wal"
+
+type walOpType uint8
+
+const (
+	// opRecord replaces the record of a node, new nodes are assigned a slot
+	opRecord walOpType = iota + 1
+	opStatus
+	opCode
+	opLayout
+	opEntrypoint
+	opCodebook
+)
+
+// walOp is a single change of the index. The changes of one operation, e.g.
+// an insert with its reverse edges, are written as one entry and are
+// replayed together or not at all.
+type walOp struct {
+	typ walOpType
+	id  uint64
+	// slot of opRecord, assigned on commit
+	slot   uint64
+	status uint8
+	// record of opRecord, code of opCode or codebook of opCodebook
+	data []byte
+	// dims and maxDegree of opLayout
+	dims      int
+	maxDegree int
+	// hasEntrypoint of opEntrypoint
+	hasEntrypoint bool
+}
+
+func encodeOps(ops []walOp) []byte {
+	size := 0
+	for _, op := range ops {
+		size += 1 + 8 + 8 + 1 + 4 + len(op.data) + 8 + 1
+	}
+
+	buf := make([]byte, 0, size)
+	for _, op := range ops {
+		buf = append(buf, byte(op.typ))
+		buf = binary.LittleEndian.AppendUint64(buf, op.id)
+		switch op.typ {
+		case opRecord:
+			buf = binary.LittleEndian.AppendUint64(buf, op.slot)
+			buf = appendData(buf, op.data)
+		case opStatus:
+			buf = append(buf, op.status)
+		case opCode, opCodebook:
+			buf = appendData(buf, op.data)
+		case opLayout:
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(op.dims))
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(op.maxDegree))
+		case opEntrypoint:
+			if op.hasEntrypoint {
+				buf = append(buf, 1)
+			} else {
+				buf = append(buf, 0)
+			}
+		}
+	}
+	return buf
+}
+
+func appendData(buf, data []byte) []byte {
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(data)))
+	return append(buf, data...)
+}
+
+func decodeOps(buf []byte) ([]walOp, error) {
+	var ops []walOp
+	for len(buf) > 0 {
+		if len(buf) < 9 {
+			return nil, io.ErrUnexpectedEOF
+		}
+		op := walOp{typ: walOpType(buf[0]), id: binary.LittleEndian.Uint64(buf[1:9])}
+		buf = buf[9:]
+
+		var err error
+		switch op.typ {
+		case opRecord:
+			if len(buf) < 8 {
+				return nil, io.ErrUnexpectedEOF
+			}
+			op.slot = binary.LittleEndian.Uint64(buf)
+			op.data, buf, err = readData(buf[8:])
+		case opStatus:
+			if len(buf) < 1 {
+				return nil, io.ErrUnexpectedEOF
+			}
+			op.status, buf = buf[0], buf[1:]
+		case opCode, opCodebook:
+			op.data, buf, err = readData(buf)
+		case opLayout:
+			if len(buf) < 8 {
+				return nil, io.ErrUnexpectedEOF
+			}
+			op.dims = int(binary.LittleEndian.Uint32(buf))
+			op.maxDegree = int(binary.LittleEndian.Uint32(buf[4:]))
+			buf = buf[8:]
+		case opEntrypoint:
+			if len(buf) < 1 {
+				return nil, io.ErrUnexpectedEOF
+			}
+			op.hasEntrypoint, buf = buf[0] == 1, buf[1:]
+		default:
+			return nil, errors.Errorf("unknown op %d", op.typ)
+		}
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+func readData(buf []byte) ([]byte, []byte, error) {
+	if len(buf) < 4 {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+	size := int(binary.LittleEndian.Uint32(buf))
+	if len(buf) < 4+size {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+	return buf[4 : 4+size], buf[4+size:], nil
+}
+
+// wal is the write-ahead log of the index. Changes are only written to the
+// data files by checkpoints, every checkpoint starts a new segment and
+// deletes the segments it persisted.
+//
+// Entry: size uint32, crc32 uint32, ops
+type wal struct {
+	dir string
+	seq uint64
+	fd  *os.File
+	w   *bufio.Writer
+}
+
+// openWAL starts a new segment and returns the existing ones, which need to
+// be replayed, in the order they were written
+func openWAL(dir string) (*wal, []string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "browse wal segments")
+	}
+
+	var seqs []uint64
+	for _, entry := range entries {
+		if seq, ok := segmentSeq(entry.Name()); ok {
+			seqs = append(seqs, seq)
+		}
+	}
+	sort.Slice(seqs, func(a, b int) bool { return seqs[a] < seqs[b] })
+
+	segments := make([]string, len(seqs))
+	for j, seq := range seqs {
+		segments[j] = filepath.Join(dir, segmentName(seq))
+	}
+
+	w := &wal{dir: dir}
+	if len(seqs) > 0 {
+		w.seq = seqs[len(seqs)-1]
+	}
+	if err := w.next(); err != nil {
+		return nil, nil, err
+	}
+	return w, segments, nil
+}
+
+func segmentName(seq uint64) string {
+	return fmt.Sprintf("%d%s", seq, walSuffix)
+}
+
+func segmentSeq(name string) (uint64, bool) {
+	if !strings.HasSuffix(name, walSuffix) {
+		return 0, false
+	}
+	seq, err := strconv.ParseUint(strings.TrimSuffix(name, walSuffix), 10, 64)
+	return seq, err == nil
+}
+
+func (w *wal) next() error {
+	w.seq++
+	fd, err := os.OpenFile(filepath.Join(w.dir, segmentName(w.seq)),
+		os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o666)
+	if err != nil {
+		return errors.Wrap(err, "create wal segment")
+	}
+	w.fd = fd
+	w.w = bufio.NewWriter(fd)
+	return nil
+}
+
+func (w *wal) append(ops []walOp) error {
+	payload := encodeOps(ops)
+	header := make([]byte, 8)
+	binary.LittleEndian.PutUint32(header[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(header[4:8], crc32.ChecksumIEEE(payload))
+
+	if _, err := w.w.Write(header); err != nil {
+		return errors.Wrap(err, "write wal entry")
+	}
+	if _, err := w.w.Write(payload); err != nil {
+		return errors.Wrap(err, "write wal entry")
+	}
+	return nil
+}
+
+// flush makes the written entries durable
+func (w *wal) flush() error {
+	if err := w.w.Flush(); err != nil {
+		return errors.Wrap(err, "flush wal")
+	}
+	if err := w.fd.Sync(); err != nil {
+		return errors.Wrap(err, "fsync wal")
+	}
+	return nil
+}
+
+// rotate completes the current segment and starts a new one. It returns the
+// sequence of the new segment, all segments before it can be deleted once
+// their changes are persisted.
+func (w *wal) rotate() (uint64, error) {
+	if err := w.flush(); err != nil {
+		return 0, err
+	}
+	if err := w.fd.Close(); err != nil {
+		return 0, errors.Wrap(err, "close wal segment")
+	}
+	if err := w.next(); err != nil {
+		return 0, err
+	}
+	return w.seq, nil
+}
+
+// removeBefore deletes the segments before seq
+func (w *wal) removeBefore(seq uint64) error {
+	entries, err := os.ReadDir(w.dir)
+	if err != nil {
+		return errors.Wrap(err, "browse wal segments")
+	}
+	for _, entry := range entries {
+		if s, ok := segmentSeq(entry.Name()); ok && s < seq {
+			if err := os.Remove(filepath.Join(w.dir, entry.Name())); err != nil {
+				return errors.Wrap(err, "delete wal segment")
+			}
+		}
+	}
+	return nil
+}
+
+func (w *wal) close() error {
+	if err := w.flush(); err != nil && !errors.Is(err, os.ErrClosed) {
+		return err
+	}
+	if err := w.fd.Close(); err != nil && !errors.Is(err, os.ErrClosed) {
+		return errors.Wrap(err, "close wal segment")
+	}
+	return nil
+}
+
+// readSegment calls fn for every entry of the segment. It stops at the first
+// incomplete or corrupt entry, which was not fully written before a crash.
+func readSegment(path string, fn func(ops []walOp) error) (int, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		return 0, errors.Wrap(err, "open wal segment")
+	}
+	defer fd.Close()
+
+	stat, err := fd.Stat()
+	if err != nil {
+		return 0, errors.Wrap(err, "stat wal segment")
+	}
+	remaining := stat.Size()
+
+	r := bufio.NewReader(fd)
+	header := make([]byte, 8)
+	entries := 0
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			return entries, nil
+		}
+		size := int64(binary.LittleEndian.Uint32(header[0:4]))
+		remaining -= 8 + size
+		if remaining < 0 {
+			return entries, nil
+		}
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(r, payload); err != nil {
+			return entries, nil
+		}
+		if crc32.ChecksumIEEE(payload) != binary.LittleEndian.Uint32(header[4:8]) {
+			return entries, nil
+		}
+		ops, err := decodeOps(payload)
+		if err != nil {
+			return entries, nil
+		}
+		if err := fn(ops); err != nil {
+			return entries, err
+		}
+		entries++
+	}
+}
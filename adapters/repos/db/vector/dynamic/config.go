@@ -72,6 +72,16 @@ func ValidateUserConfigUpdate(initial, updated schemaconfig.VectorIndexConfig) e
 			name:     "distance",
 			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
 		},
+		// unlike a plain hnsw index, the hnsw index of a dynamic index is not
+		// rebuilt when these change
+		{
+			name:     "hnsw.efConstruction",
+			accessor: func(c ent.UserConfig) interface{} { return c.HnswUC.EFConstruction },
+		},
+		{
+			name:     "hnsw.maxConnections",
+			accessor: func(c ent.UserConfig) interface{} { return c.HnswUC.MaxConnections },
+		},
 	}
 
 	for _, u := range immutableFields {
@@ -23,6 +23,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -35,17 +36,30 @@ import (
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	werrors "github.com/weaviate/weaviate/entities/errors"
 	schemaconfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/configbase"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	bolt "go.etcd.io/bbolt"
 )
 
-const composerUpgradedKey = "upgraded"
+const (
+	composerUpgradedKey = "upgraded"
+	upgradePolicyKey    = "upgradePolicy"
+)
 
 var dynamicBucket = []byte("dynamic")
 
+// downgradeCheckInterval limits how often the vectors of an upgraded index
+// are counted to decide whether it should be downgraded
+var downgradeCheckInterval = time.Minute
+
+// downgradeBatchSize is the number of vectors read from the object store and
+// added to the flat index at once during a downgrade
+const downgradeBatchSize = 1000
+
 type VectorIndex interface {
 	Dump(labels ...string)
 	Add(id uint64, vector []float32) error
@@ -75,6 +89,10 @@ type upgradableIndexer interface {
 	ShouldUpgrade() (bool, int)
 }
 
+type iterableIndexer interface {
+	Iterate(fn func(id uint64) bool)
+}
+
 type dynamic struct {
 	sync.RWMutex
 	id                       string
@@ -89,14 +107,24 @@ type dynamic struct {
 	tempVectorForIDThunk     common.TempVectorForID
 	distanceProvider         distancer.Provider
 	makeCommitLoggerThunk    hnsw.MakeCommitLogger
-	threshold                uint64
+	threshold                atomic.Uint64
+	downgradeThreshold       atomic.Uint64
 	index                    VectorIndex
 	upgraded                 atomic.Bool
 	tombstoneCallbacks       cyclemanager.CycleCallbackGroup
 	shardCompactionCallbacks cyclemanager.CycleCallbackGroup
 	shardFlushCallbacks      cyclemanager.CycleCallbackGroup
-	hnswUC                   hnswent.UserConfig
 	db                       *bolt.DB
+	metrics                  *Metrics
+
+	// stateLock guards the fields below
+	stateLock          sync.Mutex
+	hnswUC             hnswent.UserConfig
+	flatUC             flatent.UserConfig
+	upgradePolicy      string
+	migration          *migration
+	lastMigration      *MigrationStatus
+	downgradeCheckedAt time.Time
 }
 
 func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
@@ -114,13 +142,6 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 		logger = l
 	}
 
-	flatConfig := flat.Config{
-		ID:               cfg.ID,
-		TargetVector:     cfg.TargetVector,
-		Logger:           cfg.Logger,
-		DistanceProvider: cfg.DistanceProvider,
-	}
-
 	index := &dynamic{
 		id:                       cfg.ID,
 		targetVector:             cfg.TargetVector,
@@ -134,14 +155,20 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 		distanceProvider:         cfg.DistanceProvider,
 		makeCommitLoggerThunk:    cfg.MakeCommitLoggerThunk,
 		store:                    store,
-		threshold:                uc.Threshold,
 		tombstoneCallbacks:       cfg.TombstoneCallbacks,
 		shardCompactionCallbacks: cfg.ShardCompactionCallbacks,
 		shardFlushCallbacks:      cfg.ShardFlushCallbacks,
 		hnswUC:                   uc.HnswUC,
+		flatUC:                   uc.FlatUC,
+		upgradePolicy:            UpgradePolicyAuto,
+		metrics:                  NewMetrics(cfg.PrometheusMetrics, cfg.ClassName, cfg.ShardName, cfg.TargetVector),
 	}
+	index.threshold.Store(uc.Threshold)
+	index.downgradeThreshold.Store(uc.DowngradeThreshold)
 
-	path := filepath.Join(cfg.RootPath, "index.db")
+	path := index.statePath()
+	_, statErr := os.Stat(path)
+	stateExists := statErr == nil
 
 	db, err := bolt.Open(path, 0o600, nil)
 	if err != nil {
@@ -158,6 +185,10 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 	upgraded := false
 	err = db.View(func(tx *bolt.Tx) error {
 		b := tx.Bucket(dynamicBucket)
+		if v := b.Get([]byte(upgradePolicyKey)); v != nil {
+			index.upgradePolicy = string(v)
+		}
+
 		v := b.Get([]byte(composerUpgradedKey))
 		if v == nil {
 			return nil
@@ -170,43 +201,81 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 		return nil, errors.Wrap(err, "get dynamic state")
 	}
 
+	if !stateExists && index.targetVector != "" {
+		// named vectors used to share the state file of the shard, an index
+		// with a commit log was upgraded before
+		if _, err := os.Stat(hnsw.CommitLogDirectory(index.rootPath, index.id)); err == nil {
+			upgraded = true
+			err = db.Update(func(tx *bolt.Tx) error {
+				return tx.Bucket(dynamicBucket).Put([]byte(composerUpgradedKey), []byte{1})
+			})
+			if err != nil {
+				return nil, errors.Wrap(err, "update dynamic")
+			}
+		}
+	}
+
 	index.db = db
 	if upgraded {
 		index.upgraded.Store(true)
-		hnsw, err := hnsw.New(
-			hnsw.Config{
-				Logger:                index.logger,
-				RootPath:              index.rootPath,
-				ID:                    index.id,
-				ShardName:             index.shardName,
-				ClassName:             index.className,
-				PrometheusMetrics:     index.prometheusMetrics,
-				VectorForIDThunk:      index.vectorForIDThunk,
-				TempVectorForIDThunk:  index.tempVectorForIDThunk,
-				DistanceProvider:      index.distanceProvider,
-				MakeCommitLoggerThunk: index.makeCommitLoggerThunk,
-			},
-			index.hnswUC,
-			index.tombstoneCallbacks,
-			index.shardCompactionCallbacks,
-			index.shardFlushCallbacks,
-			index.store,
-		)
+		hnsw, err := index.newHNSW(uc.HnswUC)
 		if err != nil {
 			return nil, err
 		}
 		index.index = hnsw
 	} else {
-		flat, err := flat.New(flatConfig, uc.FlatUC, store)
+		flat, err := index.newFlat(uc.FlatUC)
 		if err != nil {
 			return nil, err
 		}
 		index.index = flat
 	}
+	index.metrics.SetUpgraded(upgraded)
 
 	return index, nil
 }
 
+// statePath returns the path of the file the state of the index is persisted
+// in. Every named vector has its own file, as a shard can hold several
+// dynamic indexes.
+func (dynamic *dynamic) statePath() string {
+	if dynamic.targetVector == "" {
+		return filepath.Join(dynamic.rootPath, "index.db")
+	}
+	return filepath.Join(dynamic.rootPath, fmt.Sprintf("index_%s.db", dynamic.targetVector))
+}
+
+func (dynamic *dynamic) newHNSW(uc hnswent.UserConfig) (VectorIndex, error) {
+	return hnsw.New(
+		hnsw.Config{
+			Logger:                dynamic.logger,
+			RootPath:              dynamic.rootPath,
+			ID:                    dynamic.id,
+			ShardName:             dynamic.shardName,
+			ClassName:             dynamic.className,
+			PrometheusMetrics:     dynamic.prometheusMetrics,
+			VectorForIDThunk:      dynamic.vectorForIDThunk,
+			TempVectorForIDThunk:  dynamic.tempVectorForIDThunk,
+			DistanceProvider:      dynamic.distanceProvider,
+			MakeCommitLoggerThunk: dynamic.makeCommitLoggerThunk,
+		},
+		uc,
+		dynamic.tombstoneCallbacks,
+		dynamic.shardCompactionCallbacks,
+		dynamic.shardFlushCallbacks,
+		dynamic.store,
+	)
+}
+
+func (dynamic *dynamic) newFlat(uc flatent.UserConfig) (VectorIndex, error) {
+	return flat.New(flat.Config{
+		ID:               dynamic.id,
+		TargetVector:     dynamic.targetVector,
+		Logger:           dynamic.logger,
+		DistanceProvider: dynamic.distanceProvider,
+	}, uc, dynamic.store)
+}
+
 func (dynamic *dynamic) Compressed() bool {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
@@ -216,19 +285,42 @@ func (dynamic *dynamic) Compressed() bool {
 func (dynamic *dynamic) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
-	return dynamic.index.AddBatch(ctx, ids, vectors)
+	if err := dynamic.index.AddBatch(ctx, ids, vectors); err != nil {
+		return err
+	}
+	dynamic.recordWrite(pendingOp{ids: ids, vectors: vectors})
+	return nil
 }
 
 func (dynamic *dynamic) Add(id uint64, vector []float32) error {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
-	return dynamic.index.Add(id, vector)
+	if err := dynamic.index.Add(id, vector); err != nil {
+		return err
+	}
+	dynamic.recordWrite(pendingOp{ids: []uint64{id}, vectors: [][]float32{vector}})
+	return nil
 }
 
 func (dynamic *dynamic) Delete(ids ...uint64) error {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
-	return dynamic.index.Delete(ids...)
+	if err := dynamic.index.Delete(ids...); err != nil {
+		return err
+	}
+	dynamic.recordWrite(pendingOp{ids: ids, delete: true})
+	return nil
+}
+
+// recordWrite keeps writes which happen while the index is migrated, so they
+// can be applied to the new index as well
+func (dynamic *dynamic) recordWrite(op pendingOp) {
+	dynamic.stateLock.Lock()
+	m := dynamic.migration
+	dynamic.stateLock.Unlock()
+	if m != nil {
+		m.record(op)
+	}
 }
 
 func (dynamic *dynamic) SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
@@ -249,14 +341,18 @@ func (dynamic *dynamic) UpdateUserConfig(updated schemaconfig.VectorIndexConfig,
 		callback()
 		return errors.Errorf("config is not UserConfig, but %T", updated)
 	}
+	dynamic.threshold.Store(parsed.Threshold)
+	dynamic.downgradeThreshold.Store(parsed.DowngradeThreshold)
+	dynamic.stateLock.Lock()
+	dynamic.hnswUC = parsed.HnswUC
+	dynamic.flatUC = parsed.FlatUC
+	dynamic.stateLock.Unlock()
+
+	dynamic.RLock()
+	defer dynamic.RUnlock()
 	if dynamic.upgraded.Load() {
-		dynamic.RLock()
-		defer dynamic.RUnlock()
 		dynamic.index.UpdateUserConfig(parsed.HnswUC, callback)
 	} else {
-		dynamic.hnswUC = parsed.HnswUC
-		dynamic.RLock()
-		defer dynamic.RUnlock()
 		dynamic.index.UpdateUserConfig(parsed.FlatUC, callback)
 	}
 	return nil
@@ -268,7 +364,7 @@ func (dynamic *dynamic) Drop(ctx context.Context) error {
 	if err := dynamic.db.Close(); err != nil {
 		return err
 	}
-	os.Remove(filepath.Join(dynamic.rootPath, "index.db"))
+	os.Remove(dynamic.statePath())
 	return dynamic.index.Drop(ctx)
 }
 
@@ -345,9 +441,19 @@ func (dynamic *dynamic) DistancerProvider() distancer.Provider {
 	return dynamic.index.DistancerProvider()
 }
 
+// ShouldUpgrade returns whether the index should be upgraded and the number of
+// indexed vectors at which to do so. A negative number requests the upgrade
+// regardless of the number of vectors.
 func (dynamic *dynamic) ShouldUpgrade() (bool, int) {
 	if !dynamic.upgraded.Load() {
-		return true, int(dynamic.threshold)
+		switch dynamic.UpgradePolicy() {
+		case UpgradePolicyDefer:
+			return false, 0
+		case UpgradePolicyForce:
+			return true, -1
+		default:
+			return true, int(dynamic.threshold.Load())
+		}
 	}
 	dynamic.RLock()
 	defer dynamic.RUnlock()
@@ -367,38 +473,56 @@ func float32SliceFromByteSlice(vector []byte, slice []float32) []float32 {
 	return slice
 }
 
+// Upgrade switches a flat index to hnsw. The hnsw index is built from the
+// vectors of the flat index, which keeps serving searches in the meantime.
+// If the index was upgraded already, the hnsw index is asked to upgrade,
+// i.e. to compress its vectors.
 func (dynamic *dynamic) Upgrade(callback func()) error {
-	dynamic.Lock()
-	defer dynamic.Unlock()
 	if dynamic.upgraded.Load() {
+		dynamic.Lock()
+		defer dynamic.Unlock()
 		return dynamic.index.(upgradableIndexer).Upgrade(callback)
 	}
+	defer callback()
 
-	index, err := hnsw.New(
-		hnsw.Config{
-			Logger:                dynamic.logger,
-			RootPath:              dynamic.rootPath,
-			ID:                    dynamic.id,
-			ShardName:             dynamic.shardName,
-			ClassName:             dynamic.className,
-			PrometheusMetrics:     dynamic.prometheusMetrics,
-			VectorForIDThunk:      dynamic.vectorForIDThunk,
-			TempVectorForIDThunk:  dynamic.tempVectorForIDThunk,
-			DistanceProvider:      dynamic.distanceProvider,
-			MakeCommitLoggerThunk: dynamic.makeCommitLoggerThunk,
-		},
-		dynamic.hnswUC,
-		dynamic.tombstoneCallbacks,
-		dynamic.shardCompactionCallbacks,
-		dynamic.shardFlushCallbacks,
-		dynamic.store,
-	)
+	m, err := dynamic.startMigration(MigrationUpgrade)
 	if err != nil {
-		callback()
 		return err
 	}
 
-	bucket := dynamic.store.Bucket(helpers.VectorsBucketLSM)
+	dynamic.stateLock.Lock()
+	hnswUC := dynamic.hnswUC
+	dynamic.stateLock.Unlock()
+	index, err := dynamic.newHNSW(hnswUC)
+	if err != nil {
+		dynamic.finishMigration(m, err)
+		return err
+	}
+
+	dynamic.RLock()
+	m.total.Store(int64(dynamic.index.AlreadyIndexed()))
+	dynamic.RUnlock()
+
+	if err := dynamic.buildHNSW(index, m); err != nil {
+		dynamic.discard(index)
+		dynamic.finishMigration(m, err)
+		return errors.Wrap(err, "upgrade")
+	}
+
+	if _, err := dynamic.swap(index, m, true); err != nil {
+		dynamic.discard(index)
+		dynamic.finishMigration(m, err)
+		return errors.Wrap(err, "upgrade")
+	}
+	dynamic.finishMigration(m, nil)
+	return nil
+}
+
+func (dynamic *dynamic) buildHNSW(index VectorIndex, m *migration) error {
+	bucket := dynamic.store.Bucket(flat.BucketName(dynamic.targetVector))
+	if bucket == nil {
+		return errors.Errorf("bucket of flat index %q not found", dynamic.id)
+	}
 
 	g := werrors.NewErrorGroupWrapper(dynamic.logger)
 	workerCount := runtime.GOMAXPROCS(0)
@@ -416,13 +540,19 @@ func (dynamic *dynamic) Upgrade(callback func()) error {
 				if err != nil {
 					return err
 				}
+				m.processed.Add(1)
 			}
 
 			return nil
 		})
 	}
 
-	cursor := bucket.Cursor()
+	cursor, err := bucket.Cursor()
+	if err != nil {
+		close(ch)
+		g.Wait()
+		return err
+	}
 
 	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
 		id := binary.BigEndian.Uint64(k)
@@ -430,27 +560,260 @@ func (dynamic *dynamic) Upgrade(callback func()) error {
 		float32SliceFromByteSlice(v, vc)
 
 		ch <- task{id: id, vector: vc}
+		dynamic.metrics.MigrationProgress(m.status())
 	}
 	cursor.Close()
 
 	close(ch)
 
-	err = g.Wait()
+	return g.Wait()
+}
+
+// ShouldDowngrade returns whether an upgraded index has shrunk below the
+// downgrade threshold. The vectors are counted at most once per
+// downgradeCheckInterval.
+func (dynamic *dynamic) ShouldDowngrade() bool {
+	threshold := dynamic.downgradeThreshold.Load()
+	if threshold == 0 || !dynamic.upgraded.Load() {
+		return false
+	}
+
+	dynamic.stateLock.Lock()
+	if dynamic.upgradePolicy == UpgradePolicyForce || dynamic.migration != nil ||
+		time.Since(dynamic.downgradeCheckedAt) < downgradeCheckInterval {
+		dynamic.stateLock.Unlock()
+		return false
+	}
+	dynamic.downgradeCheckedAt = time.Now()
+	dynamic.stateLock.Unlock()
+
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	iterable, ok := dynamic.index.(iterableIndexer)
+	if !ok {
+		return false
+	}
+	var count uint64
+	iterable.Iterate(func(id uint64) bool {
+		count++
+		return count < threshold
+	})
+	return count < threshold
+}
+
+// Downgrade switches an hnsw index back to flat. The flat index is built from
+// the vectors stored with the objects, while the hnsw index keeps serving
+// searches.
+func (dynamic *dynamic) Downgrade(callback func()) error {
+	defer callback()
+	if !dynamic.upgraded.Load() {
+		return nil
+	}
+
+	m, err := dynamic.startMigration(MigrationDowngrade)
 	if err != nil {
-		callback()
-		return errors.Wrap(err, "upgrade")
+		return err
 	}
 
-	err = dynamic.db.Update(func(tx *bolt.Tx) error {
+	index, err := dynamic.buildFlat(m)
+	if err != nil {
+		dynamic.finishMigration(m, err)
+		return errors.Wrap(err, "downgrade")
+	}
+
+	previous, err := dynamic.swap(index, m, false)
+	if err != nil {
+		dynamic.finishMigration(m, err)
+		return errors.Wrap(err, "downgrade")
+	}
+	dynamic.discard(previous)
+	dynamic.finishMigration(m, nil)
+	return nil
+}
+
+func (dynamic *dynamic) buildFlat(m *migration) (VectorIndex, error) {
+	var ids []uint64
+	dynamic.RLock()
+	iterable, ok := dynamic.index.(iterableIndexer)
+	if ok {
+		iterable.Iterate(func(id uint64) bool {
+			ids = append(ids, id)
+			return true
+		})
+	}
+	dynamic.RUnlock()
+	if !ok {
+		return nil, errors.Errorf("index %q can't be iterated", dynamic.id)
+	}
+	m.total.Store(int64(len(ids)))
+
+	ctx := context.Background()
+	dynamic.stateLock.Lock()
+	flatUC := dynamic.flatUC
+	dynamic.stateLock.Unlock()
+
+	// the buckets still hold the vectors from before the upgrade
+	if err := flat.ClearBuckets(ctx, dynamic.store, dynamic.targetVector, flatUC); err != nil {
+		return nil, err
+	}
+	index, err := dynamic.newFlat(flatUC)
+	if err != nil {
+		return nil, err
+	}
+
+	for start := 0; start < len(ids); start += downgradeBatchSize {
+		end := start + downgradeBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		batchIDs := make([]uint64, 0, end-start)
+		vectors := make([][]float32, 0, end-start)
+		for _, id := range ids[start:end] {
+			vector, err := dynamic.vectorForIDThunk(ctx, id)
+			if err != nil {
+				var notFound storobj.ErrNotFound
+				if errors.As(err, &notFound) {
+					// deleted in the meantime
+					continue
+				}
+				return nil, errors.Wrapf(err, "read vector of doc id %d", id)
+			}
+			batchIDs = append(batchIDs, id)
+			vectors = append(vectors, vector)
+		}
+		if err := index.AddBatch(ctx, batchIDs, vectors); err != nil {
+			return nil, err
+		}
+		m.processed.Add(int64(end - start))
+		dynamic.metrics.MigrationProgress(m.status())
+	}
+	return index, nil
+}
+
+// startMigration registers a migration, only one can run at a time
+func (dynamic *dynamic) startMigration(direction string) (*migration, error) {
+	dynamic.stateLock.Lock()
+	defer dynamic.stateLock.Unlock()
+	if dynamic.migration != nil {
+		return nil, errors.Errorf("%s of index %q is running already",
+			strings.ToLower(dynamic.migration.direction), dynamic.id)
+	}
+	dynamic.migration = newMigration(direction)
+	return dynamic.migration, nil
+}
+
+func (dynamic *dynamic) finishMigration(m *migration, err error) {
+	status := m.status()
+	status.FinishTime = time.Now()
+	status.EstimatedFinishTime = time.Time{}
+	if err != nil {
+		status.Error = err.Error()
+		dynamic.logger.WithField("action", "dynamic_index_migration").
+			WithField("direction", m.direction).
+			WithField("index_id", dynamic.id).
+			WithError(err).Error("migration of dynamic index failed")
+	}
+
+	dynamic.stateLock.Lock()
+	dynamic.migration = nil
+	dynamic.lastMigration = &status
+	dynamic.stateLock.Unlock()
+	dynamic.metrics.MigrationDone(m.direction, err)
+}
+
+// swap replaces the current index with the new one, once the writes which
+// happened during the migration are applied to it. It returns the previous
+// index.
+func (dynamic *dynamic) swap(index VectorIndex, m *migration, upgraded bool) (VectorIndex, error) {
+	dynamic.Lock()
+	defer dynamic.Unlock()
+
+	if err := m.replay(index); err != nil {
+		return nil, errors.Wrap(err, "apply writes during migration")
+	}
+
+	var value byte
+	if upgraded {
+		value = 1
+	}
+	err := dynamic.db.Update(func(tx *bolt.Tx) error {
 		b := tx.Bucket(dynamicBucket)
-		return b.Put([]byte(composerUpgradedKey), []byte{1})
+		return b.Put([]byte(composerUpgradedKey), []byte{value})
 	})
 	if err != nil {
-		return errors.Wrap(err, "update dynamic")
+		return nil, errors.Wrap(err, "update dynamic")
 	}
 
+	previous := dynamic.index
 	dynamic.index = index
-	dynamic.upgraded.Store(true)
-	callback()
+	dynamic.upgraded.Store(upgraded)
+	dynamic.metrics.SetUpgraded(upgraded)
+	return previous, nil
+}
+
+// discard drops an index which isn't used anymore. The buckets of a flat
+// index are kept, as they are shared with the flat index created on the
+// next downgrade.
+func (dynamic *dynamic) discard(index VectorIndex) {
+	if err := index.Drop(context.Background()); err != nil {
+		dynamic.logger.WithField("action", "dynamic_index_migration").
+			WithField("index_id", dynamic.id).
+			WithError(err).Warn("drop previous index of dynamic index")
+	}
+}
+
+func (dynamic *dynamic) UpgradePolicy() string {
+	dynamic.stateLock.Lock()
+	defer dynamic.stateLock.Unlock()
+	return dynamic.upgradePolicy
+}
+
+// SetUpgradePolicy persists the upgrade policy of the index. The index queue
+// picks up a forced upgrade on its next tick.
+func (dynamic *dynamic) SetUpgradePolicy(policy string) error {
+	if err := ValidateUpgradePolicy(policy); err != nil {
+		return err
+	}
+
+	dynamic.stateLock.Lock()
+	defer dynamic.stateLock.Unlock()
+	err := dynamic.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(dynamicBucket).Put([]byte(upgradePolicyKey), []byte(policy))
+	})
+	if err != nil {
+		return errors.Wrap(err, "persist upgrade policy")
+	}
+	dynamic.upgradePolicy = policy
+	// a policy change should be reflected right away
+	dynamic.downgradeCheckedAt = time.Time{}
 	return nil
 }
+
+// Status returns the backend of the index and the progress of the running or
+// last migration
+func (dynamic *dynamic) Status() Status {
+	backend := BackendFlat
+	if dynamic.upgraded.Load() {
+		backend = BackendHNSW
+	}
+
+	dynamic.stateLock.Lock()
+	defer dynamic.stateLock.Unlock()
+	status := Status{
+		TargetVector:       dynamic.targetVector,
+		Backend:            backend,
+		UpgradePolicy:      dynamic.upgradePolicy,
+		Threshold:          dynamic.threshold.Load(),
+		DowngradeThreshold: dynamic.downgradeThreshold.Load(),
+	}
+	if dynamic.migration != nil {
+		migration := dynamic.migration.status()
+		status.Migration = &migration
+		dynamic.metrics.MigrationProgress(migration)
+	} else if dynamic.lastMigration != nil {
+		migration := *dynamic.lastMigration
+		status.Migration = &migration
+	}
+	return status
+}
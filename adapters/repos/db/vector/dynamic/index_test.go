@@ -21,6 +21,7 @@ import (
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
@@ -140,6 +141,135 @@ func TestDynamicReturnsErrorIfNoAsync(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestDynamicUpgradePolicyAndDowngrade(t *testing.T) {
+	currentIndexing := os.Getenv("ASYNC_INDEXING")
+	os.Setenv("ASYNC_INDEXING", "true")
+	defer os.Setenv("ASYNC_INDEXING", currentIndexing)
+	dimensions := 20
+	vectors_size := 1_000
+	queries_size := 10
+	k := 10
+
+	vectors, queries := testinghelpers.RandomVecs(vectors_size, queries_size, dimensions)
+	rootPath := t.TempDir()
+	distancer := distancer.NewL2SquaredProvider()
+	truths := make([][]uint64, queries_size)
+	compressionhelpers.Concurrently(logger, uint64(len(queries)), func(i uint64) {
+		truths[i], _ = testinghelpers.BruteForce(logger, vectors, queries[i], k, distanceWrapper(distancer))
+	})
+	noopCallback := cyclemanager.NewCallbackGroupNoop()
+	fuc := flatent.UserConfig{}
+	fuc.SetDefaults()
+	hnswuc := hnswent.UserConfig{
+		MaxConnections:        30,
+		EFConstruction:        64,
+		EF:                    64,
+		VectorCacheMaxObjects: 1_000_000,
+	}
+	store := testinghelpers.NewDummyStore(t)
+	cfg := dynamic.Config{
+		RootPath:              rootPath,
+		ID:                    "policy-test",
+		TargetVector:          "named",
+		MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+		DistanceProvider:      distancer,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk:     TempVectorForIDThunk(vectors),
+		TombstoneCallbacks:       noopCallback,
+		ShardCompactionCallbacks: noopCallback,
+		ShardFlushCallbacks:      noopCallback,
+	}
+	uc := ent.UserConfig{
+		Threshold:          uint64(10 * vectors_size),
+		DowngradeThreshold: uint64(vectors_size),
+		Distance:           distancer.Type(),
+		HnswUC:             hnswuc,
+		FlatUC:             fuc,
+	}
+	index, err := dynamic.New(cfg, uc, store)
+	require.Nil(t, err)
+
+	compressionhelpers.Concurrently(logger, uint64(vectors_size), func(i uint64) {
+		index.Add(i, vectors[i])
+	})
+
+	t.Run("defer", func(t *testing.T) {
+		require.Nil(t, index.SetUpgradePolicy(dynamic.UpgradePolicyDefer))
+		shouldUpgrade, _ := index.ShouldUpgrade()
+		assert.False(t, shouldUpgrade)
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		assert.NotNil(t, index.SetUpgradePolicy("SOMETIMES"))
+	})
+
+	t.Run("force", func(t *testing.T) {
+		require.Nil(t, index.SetUpgradePolicy(dynamic.UpgradePolicyForce))
+		shouldUpgrade, at := index.ShouldUpgrade()
+		assert.True(t, shouldUpgrade)
+		assert.Equal(t, -1, at)
+
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		require.Nil(t, index.Upgrade(wg.Done))
+		wg.Wait()
+
+		status := index.Status()
+		assert.Equal(t, dynamic.BackendHNSW, status.Backend)
+		assert.Equal(t, dynamic.UpgradePolicyForce, status.UpgradePolicy)
+		require.NotNil(t, status.Migration)
+		assert.Equal(t, dynamic.MigrationUpgrade, status.Migration.Direction)
+		assert.Equal(t, int64(vectors_size), status.Migration.ObjectsProcessed)
+		assert.Equal(t, int64(vectors_size), status.Migration.ObjectsTotal)
+		assert.False(t, status.Migration.FinishTime.IsZero())
+		assert.Empty(t, status.Migration.Error)
+
+		recall, _ := recallAndLatency(queries, k, index, truths)
+		assert.True(t, recall > 0.9)
+
+		// forced indexes are not downgraded
+		assert.False(t, index.ShouldDowngrade())
+	})
+
+	t.Run("state survives restarts", func(t *testing.T) {
+		require.Nil(t, index.Shutdown(context.Background()))
+		index, err = dynamic.New(cfg, uc, store)
+		require.Nil(t, err)
+
+		status := index.Status()
+		assert.Equal(t, dynamic.BackendHNSW, status.Backend)
+		assert.Equal(t, dynamic.UpgradePolicyForce, status.UpgradePolicy)
+	})
+
+	t.Run("downgrade", func(t *testing.T) {
+		compressionhelpers.Concurrently(logger, uint64(vectors_size), func(i uint64) {
+			index.Add(i, vectors[i])
+		})
+		require.Nil(t, index.SetUpgradePolicy(dynamic.UpgradePolicyAuto))
+		require.Nil(t, index.Delete(0, 1, 2))
+		require.True(t, index.ShouldDowngrade())
+
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		require.Nil(t, index.Downgrade(wg.Done))
+		wg.Wait()
+
+		status := index.Status()
+		assert.Equal(t, dynamic.BackendFlat, status.Backend)
+		require.NotNil(t, status.Migration)
+		assert.Equal(t, dynamic.MigrationDowngrade, status.Migration.Direction)
+		assert.Equal(t, int64(vectors_size-3), status.Migration.ObjectsTotal)
+		assert.False(t, index.ContainsNode(0))
+		assert.True(t, index.ContainsNode(3))
+
+		ids, _, err := index.SearchByVector(vectors[10], 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{10}, ids)
+	})
+}
+
 func recallAndLatency(queries [][]float32, k int, index dynamic.VectorIndex, truths [][]uint64) (float32, float32) {
 	var relevant uint64
 	retrieved := k * len(queries)
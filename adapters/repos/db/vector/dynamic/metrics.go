@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+type Metrics struct {
+	enabled    bool
+	upgraded   prometheus.Gauge
+	progress   prometheus.Gauge
+	remaining  prometheus.Gauge
+	migrations *prometheus.CounterVec
+}
+
+func NewMetrics(prom *monitoring.PrometheusMetrics,
+	className, shardName, targetVector string,
+) *Metrics {
+	if prom == nil {
+		return &Metrics{enabled: false}
+	}
+
+	if prom.Group {
+		className = "n/a"
+		shardName = "n/a"
+	}
+
+	labels := prometheus.Labels{
+		"class_name":    className,
+		"shard_name":    shardName,
+		"target_vector": targetVector,
+	}
+
+	return &Metrics{
+		enabled:    true,
+		upgraded:   prom.VectorIndexDynamicUpgraded.With(labels),
+		progress:   prom.VectorIndexDynamicMigrationProgress.With(labels),
+		remaining:  prom.VectorIndexDynamicMigrationRemaining.With(labels),
+		migrations: prom.VectorIndexDynamicMigrations.MustCurryWith(labels),
+	}
+}
+
+func (m *Metrics) SetUpgraded(upgraded bool) {
+	if !m.enabled {
+		return
+	}
+
+	if upgraded {
+		m.upgraded.Set(1)
+	} else {
+		m.upgraded.Set(0)
+	}
+}
+
+func (m *Metrics) MigrationProgress(status MigrationStatus) {
+	if !m.enabled {
+		return
+	}
+
+	m.progress.Set(status.progress())
+	remaining := time.Duration(0)
+	if !status.EstimatedFinishTime.IsZero() {
+		remaining = time.Until(status.EstimatedFinishTime)
+	}
+	m.remaining.Set(remaining.Seconds())
+}
+
+func (m *Metrics) MigrationDone(direction string, err error) {
+	if !m.enabled {
+		return
+	}
+
+	m.progress.Set(0)
+	m.remaining.Set(0)
+	result := "succeeded"
+	if err != nil {
+		result = "failed"
+	}
+	m.migrations.With(prometheus.Labels{
+		"direction": strings.ToLower(direction),
+		"result":    result,
+	}).Inc()
+}
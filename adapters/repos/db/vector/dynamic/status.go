@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dynamic
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Backends a dynamic index switches between
+const (
+	BackendFlat = "flat"
+	BackendHNSW = "hnsw"
+)
+
+// Upgrade policies of a single dynamic index, they are persisted with the
+// index and survive restarts
+const (
+	// UpgradePolicyAuto upgrades the index once it grows beyond the threshold
+	// and downgrades it once it shrinks below the downgrade threshold
+	UpgradePolicyAuto = "AUTO"
+	// UpgradePolicyDefer doesn't upgrade the index, regardless of its size
+	UpgradePolicyDefer = "DEFER"
+	// UpgradePolicyForce upgrades the index right away and doesn't downgrade it
+	UpgradePolicyForce = "FORCE"
+)
+
+// Directions of a migration between the backends
+const (
+	MigrationUpgrade   = "UPGRADE"
+	MigrationDowngrade = "DOWNGRADE"
+)
+
+func ValidateUpgradePolicy(policy string) error {
+	switch policy {
+	case UpgradePolicyAuto, UpgradePolicyDefer, UpgradePolicyForce:
+		return nil
+	default:
+		return fmt.Errorf("invalid upgrade policy %q, must be one of %s, %s or %s",
+			policy, UpgradePolicyAuto, UpgradePolicyDefer, UpgradePolicyForce)
+	}
+}
+
+// Status describes which backend a dynamic index uses and the progress of a
+// running migration to the other one
+type Status struct {
+	TargetVector       string
+	Backend            string
+	UpgradePolicy      string
+	Threshold          uint64
+	DowngradeThreshold uint64
+	// Migration is the running upgrade or downgrade, or the last one since the
+	// index was loaded if none is running
+	Migration *MigrationStatus
+}
+
+type MigrationStatus struct {
+	Direction        string
+	ObjectsProcessed int64
+	ObjectsTotal     int64
+	StartTime        time.Time
+	// FinishTime is zero while the migration is running
+	FinishTime time.Time
+	// EstimatedFinishTime is zero if the migration isn't running or hasn't
+	// made enough progress to estimate it
+	EstimatedFinishTime time.Time
+	Error               string
+}
+
+func (s MigrationStatus) progress() float64 {
+	if s.ObjectsTotal == 0 {
+		return 0
+	}
+	return float64(s.ObjectsProcessed) / float64(s.ObjectsTotal)
+}
+
+// migration tracks an upgrade or downgrade while the new index is built next
+// to the current one
+type migration struct {
+	direction string
+	started   time.Time
+	processed atomic.Int64
+	total     atomic.Int64
+
+	// writes to the current index while the new one is built. They are
+	// replayed on the new index before it replaces the current one.
+	opsLock sync.Mutex
+	ops     []pendingOp
+}
+
+type pendingOp struct {
+	ids     []uint64
+	vectors [][]float32
+	delete  bool
+}
+
+func newMigration(direction string) *migration {
+	return &migration{direction: direction, started: time.Now()}
+}
+
+func (m *migration) record(op pendingOp) {
+	m.opsLock.Lock()
+	defer m.opsLock.Unlock()
+	m.ops = append(m.ops, op)
+}
+
+// replay applies the recorded writes to the new index, it must be called
+// while no more writes can be recorded
+func (m *migration) replay(index VectorIndex) error {
+	m.opsLock.Lock()
+	defer m.opsLock.Unlock()
+
+	for _, op := range m.ops {
+		if op.delete {
+			if err := index.Delete(op.ids...); err != nil {
+				return err
+			}
+			continue
+		}
+		for i, id := range op.ids {
+			// the vector might have been read by the build already
+			if index.ContainsNode(id) {
+				continue
+			}
+			if err := index.Add(id, op.vectors[i]); err != nil {
+				return err
+			}
+		}
+	}
+	m.ops = nil
+	return nil
+}
+
+func (m *migration) status() MigrationStatus {
+	status := MigrationStatus{
+		Direction:        m.direction,
+		ObjectsProcessed: m.processed.Load(),
+		ObjectsTotal:     m.total.Load(),
+		StartTime:        m.started,
+	}
+	if status.ObjectsProcessed > status.ObjectsTotal {
+		// the total is estimated before the migration starts
+		status.ObjectsTotal = status.ObjectsProcessed
+	}
+	if status.ObjectsProcessed > 0 {
+		elapsed := time.Since(m.started)
+		remaining := float64(elapsed) * float64(status.ObjectsTotal-status.ObjectsProcessed) /
+			float64(status.ObjectsProcessed)
+		status.EstimatedFinishTime = time.Now().Add(time.Duration(remaining))
+	}
+	return status
+}
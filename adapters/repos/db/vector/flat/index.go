@@ -162,32 +162,46 @@ func (index *flat) Compressed() bool {
 }
 
 func (index *flat) getBucketName() string {
-	if index.targetVector != "" {
-		return fmt.Sprintf("%s_%s", helpers.VectorsBucketLSM, index.targetVector)
+	return BucketName(index.targetVector)
+}
+
+func (index *flat) getCompressedBucketName() string {
+	return compressedBucketName(index.targetVector)
+}
+
+// BucketName returns the name of the bucket the uncompressed vectors of a
+// flat index of the target vector are stored in
+func BucketName(targetVector string) string {
+	if targetVector != "" {
+		return fmt.Sprintf("%s_%s", helpers.VectorsBucketLSM, targetVector)
 	}
 	return helpers.VectorsBucketLSM
 }
 
-func (index *flat) getCompressedBucketName() string {
-	if index.targetVector != "" {
-		return fmt.Sprintf("%s_%s", helpers.VectorsCompressedBucketLSM, index.targetVector)
+func compressedBucketName(targetVector string) string {
+	if targetVector != "" {
+		return fmt.Sprintf("%s_%s", helpers.VectorsCompressedBucketLSM, targetVector)
 	}
 	return helpers.VectorsCompressedBucketLSM
 }
 
-func (index *flat) initBuckets(ctx context.Context) error {
-	if err := index.store.CreateOrLoadBucket(ctx, index.getBucketName(),
+func bucketOptions() []lsmkv.BucketOption {
+	return []lsmkv.BucketOption{
 		lsmkv.WithForceCompation(true),
 		lsmkv.WithUseBloomFilter(false),
 		lsmkv.WithCalcCountNetAdditions(false),
+	}
+}
+
+func (index *flat) initBuckets(ctx context.Context) error {
+	if err := index.store.CreateOrLoadBucket(ctx, index.getBucketName(),
+		bucketOptions()...,
 	); err != nil {
 		return fmt.Errorf("Create or load flat vectors bucket: %w", err)
 	}
 	if index.isBQ() {
 		if err := index.store.CreateOrLoadBucket(ctx, index.getCompressedBucketName(),
-			lsmkv.WithForceCompation(true),
-			lsmkv.WithUseBloomFilter(false),
-			lsmkv.WithCalcCountNetAdditions(false),
+			bucketOptions()...,
 		); err != nil {
 			return fmt.Errorf("Create or load flat compressed vectors bucket: %w", err)
 		}
@@ -195,6 +209,34 @@ func (index *flat) initBuckets(ctx context.Context) error {
 	return nil
 }
 
+// ClearBuckets removes all vectors stored by a flat index of the target
+// vector, so a flat index created afterwards starts out empty. It must not be
+// called while such an index is in use.
+func ClearBuckets(ctx context.Context, store *lsmkv.Store, targetVector string, uc flatent.UserConfig) error {
+	names := []string{BucketName(targetVector)}
+	if compressed := compressedBucketName(targetVector); extractCompression(uc) == compressionBQ || store.Bucket(compressed) != nil {
+		names = append(names, compressed)
+	}
+	for _, name := range names {
+		if store.Bucket(name) == nil {
+			// not loaded, creating the bucket removes any files left on disk
+			if err := store.CreateBucket(ctx, name, bucketOptions()...); err != nil {
+				return fmt.Errorf("clear bucket %q: %w", name, err)
+			}
+			continue
+		}
+
+		replacement := name + "__clear"
+		if err := store.CreateBucket(ctx, replacement, bucketOptions()...); err != nil {
+			return fmt.Errorf("clear bucket %q: %w", name, err)
+		}
+		if err := store.ReplaceBuckets(ctx, name, replacement); err != nil {
+			return fmt.Errorf("clear bucket %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
 func (index *flat) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
 	if err := ctx.Err(); err != nil {
 		return err
@@ -409,7 +451,7 @@ func (index *flat) vectorById(id uint64) ([]byte, error) {
 // populates given heap with smallest distances and corresponding ids calculated by
 // distanceCalc
 func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
-	allow helpers.AllowList, limit int, cursorFn func() *lsmkv.CursorReplace,
+	allow helpers.AllowList, limit int, cursorFn func() (*lsmkv.CursorReplace, error),
 	distanceCalc distanceCalc,
 ) error {
 	var key []byte
@@ -417,7 +459,10 @@ func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 	var id uint64
 	allowMax := uint64(0)
 
-	cursor := cursorFn()
+	cursor, err := cursorFn()
+	if err != nil {
+		return err
+	}
 	defer cursor.Close()
 
 	if allow != nil {
@@ -641,7 +686,13 @@ func (index *flat) PostStartup() {
 	if !index.isBQCached() {
 		return
 	}
-	cursor := index.store.Bucket(index.getCompressedBucketName()).Cursor()
+	cursor, err := index.store.Bucket(index.getCompressedBucketName()).Cursor()
+	if err != nil {
+		index.logger.WithError(err).
+			WithField("action", "prefill_bq_cache").
+			Error("could not prefill the compressed vector cache")
+		return
+	}
 	defer cursor.Close()
 
 	// The idea here is to first read everything from disk in one go, then grow
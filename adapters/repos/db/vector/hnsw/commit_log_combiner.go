@@ -14,6 +14,7 @@ package hnsw
 import (
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -25,6 +26,10 @@ type CommitLogCombiner struct {
 	id        string
 	threshold int64
 	logger    logrus.FieldLogger
+
+	// snapshotBoundary is the timestamp of the last commit log contained in
+	// the latest snapshot, -1 if there is none
+	snapshotBoundary int64
 }
 
 func NewCommitLogCombiner(rootPath, id string, threshold int64,
@@ -40,6 +45,16 @@ func NewCommitLogCombiner(rootPath, id string, threshold int64,
 
 func (c *CommitLogCombiner) Do() (bool, error) {
 	executed := false
+
+	snapshot, err := latestSnapshot(c.rootPath, c.id)
+	if err != nil {
+		return executed, errors.Wrap(err, "obtain latest snapshot")
+	}
+	c.snapshotBoundary = -1
+	if snapshot != nil {
+		c.snapshotBoundary = snapshot.boundary
+	}
+
 	for {
 		// fileNames will already be in order
 		fileNames, err := getCommitFileNames(c.rootPath, c.id)
@@ -80,6 +95,16 @@ func (c *CommitLogCombiner) combineFirstMatch(fileNames []string) (bool, error)
 			continue
 		}
 
+		crosses, err := c.crossesSnapshotBoundary(fileName, fileNames[i+1])
+		if err != nil {
+			return false, err
+		}
+		if crosses {
+			// the combined file would be named after the first file, so the
+			// contents of the second file would seem to be part of the snapshot
+			continue
+		}
+
 		currentStat, err := os.Stat(fileName)
 		if err != nil {
 			return false, errors.Wrapf(err, "stat file %q", fileName)
@@ -110,6 +135,23 @@ func (c *CommitLogCombiner) combineFirstMatch(fileNames []string) (bool, error)
 	return false, nil
 }
 
+func (c *CommitLogCombiner) crossesSnapshotBoundary(first, second string) (bool, error) {
+	if c.snapshotBoundary < 0 {
+		return false, nil
+	}
+
+	ts1, err := asTimeStamp(filepath.Base(first))
+	if err != nil {
+		return false, err
+	}
+	ts2, err := asTimeStamp(filepath.Base(second))
+	if err != nil {
+		return false, err
+	}
+
+	return ts1 <= c.snapshotBoundary && ts2 > c.snapshotBoundary, nil
+}
+
 func (c *CommitLogCombiner) combine(first, second string) error {
 	// all names are based on the first file, so that once file1 + file2 are
 	// combined it is as if file2 had never existed and file 1 was just always
@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+)
+
+// CommitLogStats summarizes the graph recorded in the commit logs of an
+// index, see ReadCommitLogStats
+type CommitLogStats struct {
+	// Snapshot the commit logs were replayed on, empty if there is none
+	Snapshot string
+	Files    []CommitLogFile
+
+	Nodes      int
+	Links      int
+	Tombstones int
+	Entrypoint uint64
+	MaxLevel   uint16
+	Compressed bool
+}
+
+// CommitLogFile describes a replayed commit log
+type CommitLogFile struct {
+	Path string
+	Size int64
+	// ValidLength is smaller than Size if the log ended abruptly
+	ValidLength int
+	// Err is set if the log could not be read until its end
+	Err error
+}
+
+// ReadCommitLogStats replays the commit logs of the index the way they are
+// loaded on startup, but without modifying any of the files. Corrupted logs
+// are read up to the corruption.
+func ReadCommitLogStats(rootPath, name string, logger logrus.FieldLogger) (*CommitLogStats, error) {
+	files, err := os.ReadDir(commitLogDirectory(rootPath, name))
+	if err != nil {
+		return nil, errors.Wrap(err, "browse commit logger directory")
+	}
+
+	var fileNames []string
+	for _, file := range removeTmpScratchOrHiddenFiles(files) {
+		if strings.HasSuffix(file.Name(), ".combined.tmp") {
+			// an incomplete combination, the original logs still exist
+			continue
+		}
+		fileNames = append(fileNames, commitLogFileName(rootPath, name, file.Name()))
+	}
+
+	ec := &errorcompounder.ErrorCompounder{}
+	sort.Slice(fileNames, func(a, b int) bool {
+		ts1, err := asTimeStamp(filepath.Base(fileNames[a]))
+		ec.Add(err)
+		ts2, err := asTimeStamp(filepath.Base(fileNames[b]))
+		ec.Add(err)
+		return ts1 < ts2
+	})
+	if err := ec.ToError(); err != nil {
+		return nil, err
+	}
+
+	stats := &CommitLogStats{}
+	var state *DeserializationResult
+
+	snapshot, err := latestSnapshot(rootPath, name)
+	if err != nil {
+		return nil, errors.Wrap(err, "find latest snapshot")
+	}
+	if snapshot != nil {
+		// an unusable snapshot is ignored, just like on startup
+		remaining, err := commitLogsAfter(fileNames, snapshot.boundary)
+		if err == nil && len(remaining) > 0 {
+			if restored, err := readSnapshot(snapshot.path, logger); err == nil {
+				state = restored
+				stats.Snapshot = snapshot.path
+				fileNames = remaining
+			}
+		}
+	}
+
+	for _, fileName := range fileNames {
+		file := CommitLogFile{Path: fileName}
+		state, file.ValidLength, file.Err = replayCommitLogPartially(fileName, state, logger)
+		if info, err := os.Stat(fileName); err == nil {
+			file.Size = info.Size()
+		}
+		stats.Files = append(stats.Files, file)
+	}
+
+	if state == nil {
+		return stats, nil
+	}
+	for _, node := range state.Nodes {
+		if node == nil {
+			continue
+		}
+		stats.Nodes++
+		for _, connections := range node.connections {
+			stats.Links += len(connections)
+		}
+	}
+	stats.Tombstones = len(state.Tombstones)
+	stats.Entrypoint = state.Entrypoint
+	stats.MaxLevel = state.Level
+	stats.Compressed = state.Compressed
+	return stats, nil
+}
+
+// replayCommitLogPartially returns the state up to a corruption, unlike
+// replayCommitLog. The previous state is kept if the log can't be read at all.
+func replayCommitLogPartially(fileName string, state *DeserializationResult,
+	logger logrus.FieldLogger,
+) (*DeserializationResult, int, error) {
+	fd, err := os.Open(fileName)
+	if err != nil {
+		return state, 0, errors.Wrapf(err, "open commit log %q for reading", fileName)
+	}
+	defer fd.Close()
+
+	next, valid, err := NewDeserializer(logger).Do(bufio.NewReaderSize(fd, 256*1024), state, false)
+	if next == nil {
+		next = state
+	}
+	return next, valid, err
+}
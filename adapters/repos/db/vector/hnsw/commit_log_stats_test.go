@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"os"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/commitlog"
+)
+
+func TestReadCommitLogStats(t *testing.T) {
+	rootPath := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	require.Nil(t, os.MkdirAll(commitLogDirectory(rootPath, "main"), 0o777))
+
+	writeLog := func(name string, write func(l *commitlog.Logger)) string {
+		fileName := commitLogFileName(rootPath, "main", name)
+		l := commitlog.NewLogger(fileName)
+		write(l)
+		require.Nil(t, l.Flush())
+		require.Nil(t, l.Close())
+		return fileName
+	}
+
+	writeLog("1000", func(l *commitlog.Logger) {
+		require.Nil(t, l.AddNode(0, 1))
+		require.Nil(t, l.AddNode(1, 0))
+		require.Nil(t, l.AddNode(2, 0))
+		require.Nil(t, l.SetEntryPointWithMaxLayer(0, 1))
+		require.Nil(t, l.AddLinkAtLevel(0, 0, 1))
+		require.Nil(t, l.AddLinkAtLevel(0, 0, 2))
+		require.Nil(t, l.AddLinkAtLevel(1, 0, 0))
+	})
+	second := writeLog("1001", func(l *commitlog.Logger) {
+		require.Nil(t, l.AddTombstone(2))
+		require.Nil(t, l.AddNode(3, 0))
+	})
+
+	// cut off the last commit
+	info, err := os.Stat(second)
+	require.Nil(t, err)
+	require.Nil(t, os.Truncate(second, info.Size()-3))
+
+	before, err := os.ReadDir(commitLogDirectory(rootPath, "main"))
+	require.Nil(t, err)
+
+	stats, err := ReadCommitLogStats(rootPath, "main", logger)
+	require.Nil(t, err)
+
+	assert.Empty(t, stats.Snapshot)
+	require.Len(t, stats.Files, 2)
+	assert.Nil(t, stats.Files[0].Err)
+	assert.Equal(t, stats.Files[0].Size, int64(stats.Files[0].ValidLength))
+	assert.Equal(t, second, stats.Files[1].Path)
+	assert.Equal(t, info.Size()-3, stats.Files[1].Size)
+	assert.Less(t, int64(stats.Files[1].ValidLength), stats.Files[1].Size)
+
+	assert.Equal(t, 3, stats.Nodes)
+	assert.Equal(t, 3, stats.Links)
+	assert.Equal(t, 1, stats.Tombstones)
+	assert.Equal(t, uint64(0), stats.Entrypoint)
+	assert.Equal(t, uint16(1), stats.MaxLevel)
+	assert.False(t, stats.Compressed)
+
+	after, err := os.ReadDir(commitLogDirectory(rootPath, "main"))
+	require.Nil(t, err)
+	assert.Equal(t, len(before), len(after), "no files are created or removed")
+}
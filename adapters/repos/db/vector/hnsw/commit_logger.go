@@ -41,6 +41,12 @@ func commitLogDirectory(rootPath, name string) string {
 	return fmt.Sprintf("%s/%s.hnsw.commitlog.d", rootPath, name)
 }
 
+// CommitLogDirectory returns the directory the commit log of the index with
+// the given name is written to
+func CommitLogDirectory(rootPath, name string) string {
+	return commitLogDirectory(rootPath, name)
+}
+
 func NewCommitLogger(rootPath, name string, logger logrus.FieldLogger,
 	maintenanceCallbacks cyclemanager.CycleCallbackGroup, opts ...CommitlogOption,
 ) (*hnswCommitLogger, error) {
@@ -254,6 +260,7 @@ type hnswCommitLogger struct {
 	logger            logrus.FieldLogger
 	maxSizeIndividual int64
 	maxSizeCombining  int64
+	snapshotInterval  time.Duration
 	commitLogger      *commitlog.Logger
 
 	switchLogsCallbackCtrl   cyclemanager.CycleCallbackCtrl
@@ -434,7 +441,16 @@ func (l *hnswCommitLogger) startCombineAndCondenseLogs(shouldAbort cyclemanager.
 			WithField("action", "hnsw_commit_log_condensing").
 			Error("hnsw commit log maintenance (condensing) failed")
 	}
-	return executed1 || executed2
+
+	// snapshots are created in the same cycle as combining, so the combiner
+	// never merges logs on both sides of a boundary that is being created
+	executed3, err := l.createSnapshot(shouldAbort)
+	if err != nil {
+		l.logger.WithError(err).
+			WithField("action", "hnsw_snapshot").
+			Error("hnsw commit log maintenance (snapshot) failed")
+	}
+	return executed1 || executed2 || executed3
 }
 
 func (l *hnswCommitLogger) SwitchCommitLogs(force bool) error {
@@ -572,6 +588,15 @@ func (l *hnswCommitLogger) Drop(ctx context.Context) error {
 			return errors.Wrap(err, "delete commit files directory")
 		}
 	}
+
+	// remove snapshot directory if exists
+	dir = snapshotDirectory(l.rootPath, l.id)
+	if _, err := os.Stat(dir); err == nil {
+		err := os.RemoveAll(dir)
+		if err != nil {
+			return errors.Wrap(err, "delete snapshot directory")
+		}
+	}
 	return nil
 }
 
@@ -11,7 +11,11 @@
 
 package hnsw
 
-import "github.com/weaviate/weaviate/usecases/memwatch"
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
 
 type CommitlogOption func(l *hnswCommitLogger) error
 
@@ -29,6 +33,16 @@ func WithCommitlogThresholdForCombining(size int64) CommitlogOption {
 	}
 }
 
+// WithSnapshotInterval enables periodic snapshots of the graph, a snapshot is
+// created at most once per interval. Snapshots are disabled if the interval
+// is not positive.
+func WithSnapshotInterval(interval time.Duration) CommitlogOption {
+	return func(l *hnswCommitLogger) error {
+		l.snapshotInterval = interval
+		return nil
+	}
+}
+
 func WithAllocChecker(mm memwatch.AllocChecker) CommitlogOption {
 	return func(l *hnswCommitLogger) error {
 		l.allocChecker = mm
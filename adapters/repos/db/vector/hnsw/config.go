@@ -15,6 +15,7 @@ import (
 	"context"
 
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
@@ -36,6 +37,9 @@ type Config struct {
 	DistanceProvider      distancer.Provider
 	PrometheusMetrics     *monitoring.PrometheusMetrics
 	AllocChecker          memwatch.AllocChecker
+	// VectorCacheBudget is shared by the vector caches of all shards of the
+	// node, nil if every cache is limited on its own
+	VectorCacheBudget *cache.Budget
 
 	// metadata for monitoring
 	ShardName string
@@ -35,15 +35,9 @@ func ValidateUserConfigUpdate(initial, updated config.VectorIndexConfig) error {
 		return errors.Errorf("updated is not UserConfig, but %T", updated)
 	}
 
+	// efConstruction and maxConnections can be changed, the shard rebuilds the
+	// graph in the background when they are
 	immutableFields := []immutableParameter{
-		{
-			name:     "efConstruction",
-			accessor: func(c ent.UserConfig) interface{} { return c.EFConstruction },
-		},
-		{
-			name:     "maxConnections",
-			accessor: func(c ent.UserConfig) interface{} { return c.MaxConnections },
-		},
 		{
 			// NOTE: There isn't a technical reason for this to be immutable, it
 			// simply hasn't been implemented yet. It would require to stop the
@@ -99,6 +93,7 @@ func (h *hnsw) UpdateUserConfig(updated config.VectorIndexConfig, callback func(
 	atomic.StoreInt64(&h.efMax, int64(parsed.DynamicEFMax))
 	atomic.StoreInt64(&h.efFactor, int64(parsed.DynamicEFFactor))
 	atomic.StoreInt64(&h.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
+	h.acornSearch.Store(parsed.FilterStrategy == ent.FilterStrategyAcorn)
 
 	if !parsed.PQ.Enabled && !parsed.BQ.Enabled {
 		callback()
@@ -32,20 +32,16 @@ func TestUserConfigUpdates(t *testing.T) {
 
 		tests := []test{
 			{
-				name:    "attempting to change ef construction",
-				initial: ent.UserConfig{EFConstruction: 64},
-				update:  ent.UserConfig{EFConstruction: 128},
-				expectedError: errors.Errorf(
-					"efConstruction is immutable: " +
-						"attempted change from \"64\" to \"128\""),
+				name:          "changing ef construction",
+				initial:       ent.UserConfig{EFConstruction: 64},
+				update:        ent.UserConfig{EFConstruction: 128},
+				expectedError: nil,
 			},
 			{
-				name:    "attempting to change ef construction",
-				initial: ent.UserConfig{MaxConnections: 10},
-				update:  ent.UserConfig{MaxConnections: 15},
-				expectedError: errors.Errorf(
-					"maxConnections is immutable: " +
-						"attempted change from \"10\" to \"15\""),
+				name:          "changing max connections",
+				initial:       ent.UserConfig{MaxConnections: 10},
+				update:        ent.UserConfig{MaxConnections: 15},
+				expectedError: nil,
 			},
 			{
 				name:    "attempting to change cleanup interval seconds",
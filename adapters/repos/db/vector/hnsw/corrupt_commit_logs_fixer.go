@@ -41,22 +41,12 @@ func NewCorruptedCommitLogFixer(logger logrus.FieldLogger) *CorruptCommitLogFixe
 // original file. We thus assume the file must be corrupted, and delete it, so
 // that the original will be used instead.
 func (fixer *CorruptCommitLogFixer) Do(fileNames []string) ([]string, error) {
-	out := make([]string, len(fileNames))
+	corrupt := fixer.Corrupt(fileNames)
+	out := make([]string, 0, len(fileNames)-len(corrupt))
 
-	i := 0
 	for _, fileName := range fileNames {
-		if !strings.HasSuffix(fileName, ".condensed") {
-			// has no suffix, so it can never be considered corrupt
-			out[i] = fileName
-			i++
-			continue
-		}
-
-		// this file has a suffix, check if one without the suffix exists as well
-		if !fixer.listContains(fileNames, strings.TrimSuffix(fileName, ".condensed")) {
-			// does not seem corrupt, proceed
-			out[i] = fileName
-			i++
+		if !fixer.listContains(corrupt, fileName) {
+			out = append(out, fileName)
 			continue
 		}
 
@@ -66,7 +56,25 @@ func (fixer *CorruptCommitLogFixer) Do(fileNames []string) ([]string, error) {
 		}
 	}
 
-	return out[:i], nil
+	return out, nil
+}
+
+// Corrupt returns the files which Do would delete, see Do
+func (fixer *CorruptCommitLogFixer) Corrupt(fileNames []string) []string {
+	var corrupt []string
+	for _, fileName := range fileNames {
+		if !strings.HasSuffix(fileName, ".condensed") {
+			// has no suffix, so it can never be considered corrupt
+			continue
+		}
+
+		// this file has a suffix, check if one without the suffix exists as well
+		if fixer.listContains(fileNames, strings.TrimSuffix(fileName, ".condensed")) {
+			corrupt = append(corrupt, fileName)
+		}
+	}
+
+	return corrupt
 }
 
 func (fixer *CorruptCommitLogFixer) listContains(haystack []string,
@@ -80,3 +88,37 @@ func (fixer *CorruptCommitLogFixer) listContains(haystack []string,
 
 	return false
 }
+
+// FindCorruptCommitLogs returns the commit logs of the index which would be
+// deleted when the index is loaded, without modifying any files
+func FindCorruptCommitLogs(rootPath, name string) ([]string, error) {
+	files, err := os.ReadDir(commitLogDirectory(rootPath, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "browse commit logger directory")
+	}
+
+	var fileNames []string
+	for _, file := range removeTmpScratchOrHiddenFiles(files) {
+		fileNames = append(fileNames, commitLogFileName(rootPath, name, file.Name()))
+	}
+	return NewCorruptedCommitLogFixer(nil).Corrupt(fileNames), nil
+}
+
+// FixCorruptCommitLogs deletes the corrupt commit logs of the index, like
+// loading the index does. It returns the deleted files.
+func FixCorruptCommitLogs(rootPath, name string, logger logrus.FieldLogger) ([]string, error) {
+	fileNames, err := getCommitFileNames(rootPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	fixer := NewCorruptedCommitLogFixer(logger)
+	corrupt := fixer.Corrupt(fileNames)
+	if _, err := fixer.Do(fileNames); err != nil {
+		return nil, err
+	}
+	return corrupt, nil
+}
@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"os"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCorruptCommitLogFixer(t *testing.T) {
+	rootPath := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	require.Nil(t, os.MkdirAll(commitLogDirectory(rootPath, "main"), 0o777))
+
+	for _, name := range []string{"1000.condensed", "1001", "1001.condensed", "1002"} {
+		require.Nil(t, os.WriteFile(commitLogFileName(rootPath, "main", name), []byte{}, 0o666))
+	}
+	corrupt := commitLogFileName(rootPath, "main", "1001.condensed")
+
+	found, err := FindCorruptCommitLogs(rootPath, "main")
+	require.Nil(t, err)
+	assert.Equal(t, []string{corrupt}, found)
+	assert.FileExists(t, corrupt, "finding corrupt logs does not delete them")
+
+	fixed, err := FixCorruptCommitLogs(rootPath, "main", logger)
+	require.Nil(t, err)
+	assert.Equal(t, []string{corrupt}, fixed)
+	assert.NoFileExists(t, corrupt)
+
+	found, err = FindCorruptCommitLogs(rootPath, "main")
+	require.Nil(t, err)
+	assert.Empty(t, found)
+
+	found, err = FindCorruptCommitLogs(rootPath, "missing")
+	require.Nil(t, err)
+	assert.Empty(t, found)
+}
@@ -638,6 +638,19 @@ func (d *Deserializer) readUint16(r io.Reader) (uint16, error) {
 	return value, nil
 }
 
+func (d *Deserializer) readUint32(r io.Reader) (uint32, error) {
+	var value uint32
+	d.resetResusableBuffer(4)
+	_, err := io.ReadFull(r, d.reusableBuffer)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to read uint32")
+	}
+
+	value = binary.LittleEndian.Uint32(d.reusableBuffer)
+
+	return value, nil
+}
+
 func (d *Deserializer) readByte(r io.Reader) (byte, error) {
 	d.resetResusableBuffer(1)
 	_, err := io.ReadFull(r, d.reusableBuffer)
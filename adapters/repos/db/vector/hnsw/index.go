@@ -94,6 +94,9 @@ type hnsw struct {
 	// on filtered searches with less than n elements, perform flat search
 	flatSearchCutoff int64
 
+	// on filtered searches, only explore nodes allowed by the filter
+	acornSearch atomic.Bool
+
 	levelNormalizer float64
 
 	nodes []*vertex
@@ -225,7 +228,8 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCallbacks, shardCompactionCallb
 	}
 
 	vectorCache := cache.NewShardedFloat32LockCache(cfg.VectorForIDThunk, uc.VectorCacheMaxObjects,
-		cfg.Logger, normalizeOnRead, cache.DefaultDeletionInterval, cfg.AllocChecker)
+		cfg.Logger, normalizeOnRead, cache.DefaultDeletionInterval, cfg.AllocChecker,
+		vectorCacheOptions(cfg)...)
 
 	resetCtx, resetCtxCancel := context.WithCancel(context.Background())
 	shutdownCtx, shutdownCtxCancel := context.WithCancel(context.Background())
@@ -278,6 +282,7 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCallbacks, shardCompactionCallb
 		store:                    store,
 		allocChecker:             cfg.AllocChecker,
 	}
+	index.acornSearch.Store(uc.FilterStrategy == ent.FilterStrategyAcorn)
 
 	if uc.BQ.Enabled {
 		var err error
@@ -693,6 +698,28 @@ func (h *hnsw) ContainsNode(id uint64) bool {
 	return len(h.nodes) > int(id) && h.nodes[id] != nil
 }
 
+// Iterate calls fn with the id of every node which isn't deleted, until fn
+// returns false. Nodes which are added while iterating may be skipped.
+func (h *hnsw) Iterate(fn func(id uint64) bool) {
+	h.RLock()
+	size := len(h.nodes)
+	h.RUnlock()
+
+	for i := 0; i < size; i++ {
+		id := uint64(i)
+		h.shardedNodeLocks.RLock(id)
+		exists := id < uint64(len(h.nodes)) && h.nodes[id] != nil
+		h.shardedNodeLocks.RUnlock(id)
+
+		if !exists || h.hasTombstone(id) {
+			continue
+		}
+		if !fn(id) {
+			return
+		}
+	}
+}
+
 func (h *hnsw) DistancerProvider() distancer.Provider {
 	return h.distancerProvider
 }
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 )
 
@@ -38,6 +39,31 @@ type Metrics struct {
 	tombstoneDeleteListSize       prometheus.Gauge
 }
 
+// vectorCacheOptions shares the node's vector cache budget with the cache of
+// the index and counts its hits and misses
+func vectorCacheOptions(cfg Config) []cache.Option {
+	var opts []cache.Option
+	if cfg.VectorCacheBudget != nil {
+		opts = append(opts, cache.WithBudget(cfg.VectorCacheBudget))
+	}
+
+	if prom := cfg.PrometheusMetrics; prom != nil {
+		className, shardName := cfg.ClassName, cfg.ShardName
+		if prom.Group {
+			className = "n/a"
+			shardName = "n/a"
+		}
+		labels := prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}
+		opts = append(opts, cache.WithHitMetrics(
+			prom.VectorIndexCacheHits.With(labels),
+			prom.VectorIndexCacheMisses.With(labels)))
+	}
+	return opts
+}
+
 func NewMetrics(prom *monitoring.PrometheusMetrics,
 	className, shardName string,
 ) *Metrics {
@@ -19,15 +19,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math/rand"
 	"runtime"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 func TestRecall(t *testing.T) {
@@ -68,11 +73,12 @@ func TestRecall(t *testing.T) {
 			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
 				return vectors[int(id)], nil
 			},
-		}, UserConfig{
+		}, ent.UserConfig{
 			MaxConnections: maxNeighbors,
 			EFConstruction: efConstruction,
 			EF:             ef,
-		}, testinghelpers.NewDummyStore(t))
+		}, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
 		require.Nil(t, err)
 		vectorIndex = index
 
@@ -139,6 +145,134 @@ func TestRecall(t *testing.T) {
 	})
 }
 
+// TestFilteredRecall compares the recall and latency of the filter strategies
+// for filters matching a small fraction of the index, where neither sweeping
+// through the graph nor a flat search are efficient.
+func TestFilteredRecall(t *testing.T) {
+	vectorsSize := 100_000
+	queriesSize := 100
+	dimensions := 64
+	k := 10
+	logger, _ := test.NewNullLogger()
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, queriesSize, dimensions)
+	provider := distancer.NewL2SquaredProvider()
+	distance := func(a, b []float32) float32 {
+		d, _, _ := provider.SingleDist(a, b)
+		return d
+	}
+	index := newFilteredRecallIndex(t, vectors, provider)
+
+	for _, selectivity := range []float32{0.01, 0.05, 0.1} {
+		allowList, allowedIDs := filteredRecallAllowList(vectors, selectivity)
+		allowedVectors := make([][]float32, len(allowedIDs))
+		for i, id := range allowedIDs {
+			allowedVectors[i] = vectors[id]
+		}
+		truths := make([][]uint64, len(queries))
+		for i, query := range queries {
+			positions, _ := testinghelpers.BruteForce(logger, allowedVectors, query, k, distance)
+			truths[i] = make([]uint64, len(positions))
+			for j, pos := range positions {
+				truths[i][j] = allowedIDs[pos]
+			}
+		}
+
+		for _, strategy := range []string{ent.FilterStrategySweeping, ent.FilterStrategyAcorn} {
+			t.Run(fmt.Sprintf("%s with selectivity %.2f", strategy, selectivity), func(t *testing.T) {
+				index.acornSearch.Store(strategy == ent.FilterStrategyAcorn)
+
+				var relevant int
+				var took time.Duration
+				for i, query := range queries {
+					before := time.Now()
+					results, _, err := index.SearchByVector(query, k, allowList)
+					took += time.Since(before)
+					require.Nil(t, err)
+					for _, id := range results {
+						require.True(t, allowList.Contains(id))
+					}
+					relevant += matchesInLists(truths[i], results)
+				}
+
+				recall := float32(relevant) / float32(k*len(queries))
+				fmt.Printf("strategy=%s selectivity=%.2f recall=%f latency=%s\n",
+					strategy, selectivity, recall, took/time.Duration(len(queries)))
+				assert.True(t, recall >= 0.9)
+			})
+		}
+	}
+}
+
+func BenchmarkFilteredSearch(b *testing.B) {
+	vectors, queries := testinghelpers.RandomVecs(100_000, 100, 64)
+	index := newFilteredRecallIndex(b, vectors, distancer.NewL2SquaredProvider())
+
+	for _, selectivity := range []float32{0.01, 0.05, 0.1} {
+		allowList, _ := filteredRecallAllowList(vectors, selectivity)
+		for _, strategy := range []string{ent.FilterStrategySweeping, ent.FilterStrategyAcorn} {
+			b.Run(fmt.Sprintf("%s with selectivity %.2f", strategy, selectivity), func(b *testing.B) {
+				index.acornSearch.Store(strategy == ent.FilterStrategyAcorn)
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					_, _, err := index.SearchByVector(queries[i%len(queries)], 10, allowList)
+					require.Nil(b, err)
+				}
+			})
+		}
+	}
+}
+
+func newFilteredRecallIndex(t testing.TB, vectors [][]float32, provider distancer.Provider) *hnsw {
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "filteredrecallbenchmark",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      provider,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, ent.UserConfig{
+		MaxConnections:        32,
+		EFConstruction:        128,
+		EF:                    128,
+		VectorCacheMaxObjects: len(vectors),
+	}, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	// compare the graph traversals, a flat search would be used otherwise
+	index.forbidFlat = true
+
+	workerCount := runtime.GOMAXPROCS(0)
+	wg := &sync.WaitGroup{}
+	for workerID := 0; workerID < workerCount; workerID++ {
+		wg.Add(1)
+		go func(workerID int) {
+			defer wg.Done()
+			for i := workerID; i < len(vectors); i += workerCount {
+				require.Nil(t, index.Add(uint64(i), vectors[i]))
+			}
+		}(workerID)
+	}
+	wg.Wait()
+
+	return index
+}
+
+// filteredRecallAllowList allows a random fraction of the vectors and returns
+// the allowed ids in addition to the allow list
+func filteredRecallAllowList(vectors [][]float32, selectivity float32,
+) (helpers.AllowList, []uint64) {
+	allowList := helpers.NewAllowList()
+	var allowed []uint64
+	for i := range vectors {
+		if rand.Float32() < selectivity {
+			allowList.Insert(uint64(i))
+			allowed = append(allowed, uint64(i))
+		}
+	}
+	return allowList, allowed
+}
+
 func matchesInLists(control []uint64, results []uint64) int {
 	desired := map[uint64]struct{}{}
 	for _, relevant := range control {
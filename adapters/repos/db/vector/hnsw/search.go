@@ -194,6 +194,16 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 	h.insertViableEntrypointsAsCandidatesAndResults(entrypoints, candidates,
 		results, level, visited, allowList)
 
+	useAcorn := h.useAcorn(level, allowList)
+	var acornConnections, acornFilteredOut []uint64
+	var acornAllowed acornAllowList
+	if useAcorn {
+		acornConnections = make([]uint64, 0, h.maximumConnectionsLayerZero)
+		acornFilteredOut = make([]uint64, 0, h.maximumConnectionsLayerZero)
+		acornAllowed = newAcornAllowList(allowList)
+		defer acornAllowed.release()
+	}
+
 	var worstResultDistance float32
 	var err error
 	if h.compressed.Load() {
@@ -258,7 +268,14 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 		copy(connectionsReusable, candidateNode.connections[level])
 		candidateNode.Unlock()
 
-		for _, neighborID := range connectionsReusable {
+		neighbors := connectionsReusable
+		if useAcorn {
+			acornConnections, acornFilteredOut = h.acornNeighbors(connectionsReusable,
+				visited, acornAllowed, acornConnections, acornFilteredOut)
+			neighbors = acornConnections
+		}
+
+		for _, neighborID := range neighbors {
 
 			if ok := visited.Visited(neighborID); ok {
 				// skip if we've already visited this neighbor
@@ -556,6 +573,11 @@ func (h *hnsw) knnSearchByVector(searchVec []float32, k int,
 
 	eps := priorityqueue.NewMin[any](10)
 	eps.Insert(entryPointID, entryPointDistance)
+	if h.useAcorn(0, allowList) {
+		if err := h.addAcornEntrypoints(eps, searchVec, allowList, entryPointID); err != nil {
+			return nil, nil, errors.Wrap(err, "knn search: add filtered entrypoints")
+		}
+	}
 	res, err := h.searchLayerByVectorWithDistancer(searchVec, eps, ef, 0, allowList, compressorDistancer)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "knn search: search layer at level %d", 0)
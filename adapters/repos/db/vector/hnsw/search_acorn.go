@@ -0,0 +1,170 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
+)
+
+// acornEntrypoints is the number of nodes allowed by the filter which are
+// added as entrypoints of a filtered search on the lowest layer. They make
+// sure the search finds allowed nodes, even if there are none in the
+// neighborhood of the entrypoint found on the upper layers.
+const acornEntrypoints = 16
+
+// useAcorn returns true if a search on the given level should only explore
+// nodes allowed by the filter, see acornNeighbors.
+func (h *hnsw) useAcorn(level int, allowList helpers.AllowList) bool {
+	return level == 0 && allowList != nil && h.acornSearch.Load()
+}
+
+// acornDenseFactor is how many words of a dense copy of an allow list may be
+// used per allowed node. Sparser allow lists are not copied.
+const acornDenseFactor = 4
+
+// acornBitsPool holds the dense copies of allow lists, see acornAllowList
+var acornBitsPool = sync.Pool{}
+
+// acornAllowList checks the membership in the allow list of a filtered
+// search. The two-hop exploration checks many more nodes against the filter
+// than it evaluates, so dense allow lists are copied to a bitmap taken from a
+// pool, which is much cheaper to check. For sparse allow lists the bitmap
+// would be large compared to the filter, their own membership check is used.
+type acornAllowList struct {
+	allowList helpers.AllowList
+	bits      *[]uint64
+}
+
+func newAcornAllowList(allowList helpers.AllowList) acornAllowList {
+	if allowList.IsEmpty() {
+		return acornAllowList{}
+	}
+
+	words := int(allowList.Max()/64 + 1)
+	if words > allowList.Len()*acornDenseFactor {
+		return acornAllowList{allowList: allowList}
+	}
+
+	bits, _ := acornBitsPool.Get().(*[]uint64)
+	if bits == nil || cap(*bits) < words {
+		b := make([]uint64, words)
+		bits = &b
+	}
+	*bits = (*bits)[:words]
+	it := allowList.Iterator()
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		(*bits)[id/64] |= 1 << (id % 64)
+	}
+	return acornAllowList{bits: bits}
+}
+
+func (a acornAllowList) contains(id uint64) bool {
+	if a.bits == nil {
+		return a.allowList != nil && a.allowList.Contains(id)
+	}
+	bits := *a.bits
+	i := id / 64
+	return i < uint64(len(bits)) && bits[i]&(1<<(id%64)) != 0
+}
+
+// release returns the dense copy to the pool, the allow list must not be used
+// afterwards
+func (a acornAllowList) release() {
+	if a.bits == nil {
+		return
+	}
+	clear(*a.bits)
+	acornBitsPool.Put(a.bits)
+}
+
+// acornNeighbors returns the neighbors of a candidate which should be
+// evaluated in a filtered search. Unlike the regular search, which evaluates
+// every neighbor and skips the ones not allowed by the filter when collecting
+// results, only allowed neighbors are returned. Neighbors which are not
+// allowed are not evaluated at all, instead their own neighbors are explored
+// (two-hop exploration). This keeps the search connected even if most nodes
+// are filtered out, while only calculating distances to allowed nodes.
+//
+// Nodes which are returned are not yet marked as visited, this is left to the
+// caller. Filtered-out nodes are marked as visited, so they are never expanded
+// twice.
+func (h *hnsw) acornNeighbors(connections []uint64, visitedList visited.ListSet,
+	allowList acornAllowList, out, filteredOut []uint64,
+) ([]uint64, []uint64) {
+	out, filteredOut = out[:0], filteredOut[:0]
+	for _, id := range connections {
+		if visitedList.Visited(id) {
+			continue
+		}
+		if allowList.contains(id) {
+			out = append(out, id)
+		} else {
+			filteredOut = append(filteredOut, id)
+		}
+	}
+
+	for _, id := range filteredOut {
+		if len(out) >= h.maximumConnectionsLayerZero {
+			break
+		}
+		visitedList.Visit(id)
+
+		node := h.nodeByID(id)
+		if node == nil {
+			continue
+		}
+
+		node.Lock()
+		if len(node.connections) > 0 {
+			for _, secondHop := range node.connections[0] {
+				if len(out) >= h.maximumConnectionsLayerZero {
+					break
+				}
+				if !visitedList.Visited(secondHop) && allowList.contains(secondHop) {
+					out = append(out, secondHop)
+				}
+			}
+		}
+		node.Unlock()
+	}
+
+	return out, filteredOut
+}
+
+// addAcornEntrypoints adds nodes allowed by the filter to the entrypoints of
+// a search on the lowest layer
+func (h *hnsw) addAcornEntrypoints(eps *priorityqueue.Queue[any],
+	searchVec []float32, allowList helpers.AllowList, entryPointID uint64,
+) error {
+	it := allowList.LimitedIterator(acornEntrypoints)
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		if id == entryPointID || h.nodeByID(id) == nil {
+			continue
+		}
+
+		dist, ok, err := h.distBetweenNodeAndVec(id, searchVec)
+		if err != nil {
+			return errors.Wrapf(err, "distance between entrypoint %d and query", id)
+		}
+		if !ok {
+			continue
+		}
+		eps.Insert(id, dist)
+	}
+
+	return nil
+}
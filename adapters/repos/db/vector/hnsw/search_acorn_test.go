@@ -0,0 +1,160 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestAcornAllowList(t *testing.T) {
+	t.Run("dense", func(t *testing.T) {
+		allowList := newAcornAllowList(helpers.NewAllowList(0, 63, 64, 1000))
+		defer allowList.release()
+		require.NotNil(t, allowList.bits)
+		for _, id := range []uint64{0, 63, 64, 1000} {
+			assert.True(t, allowList.contains(id))
+		}
+		for _, id := range []uint64{1, 62, 65, 999, 1001, 1 << 40} {
+			assert.False(t, allowList.contains(id))
+		}
+	})
+
+	t.Run("sparse", func(t *testing.T) {
+		allowList := newAcornAllowList(helpers.NewAllowList(3, 1<<30))
+		defer allowList.release()
+		assert.Nil(t, allowList.bits)
+		assert.True(t, allowList.contains(3))
+		assert.True(t, allowList.contains(1<<30))
+		assert.False(t, allowList.contains(4))
+	})
+
+	t.Run("pooled copies are cleared", func(t *testing.T) {
+		newAcornAllowList(helpers.NewAllowList(1, 2, 3)).release()
+		allowList := newAcornAllowList(helpers.NewAllowList(4, 5, 6))
+		defer allowList.release()
+		assert.False(t, allowList.contains(1))
+		assert.True(t, allowList.contains(4))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.False(t, newAcornAllowList(helpers.NewAllowList()).contains(0))
+	})
+}
+
+func BenchmarkAcornAllowList(b *testing.B) {
+	for _, bc := range []struct {
+		name string
+		ids  []uint64
+	}{
+		{name: "sparse with high ids", ids: []uint64{10, 1 << 20, 1 << 28}},
+		{name: "dense", ids: func() []uint64 {
+			ids := make([]uint64, 100_000)
+			for i := range ids {
+				ids[i] = uint64(i * 3)
+			}
+			return ids
+		}()},
+	} {
+		allowList := helpers.NewAllowList(bc.ids...)
+		b.Run(bc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				a := newAcornAllowList(allowList)
+				for id := uint64(0); id < 1000; id++ {
+					a.contains(id)
+				}
+				a.release()
+			}
+		})
+	}
+}
+
+func TestAcornFilteredSearch(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vectors, queries := testinghelpers.RandomVecs(5000, 20, 16)
+	provider := distancer.NewL2SquaredProvider()
+	k := 10
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "acorn-filtered-search",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      provider,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+	}, ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		EF:                    64,
+		VectorCacheMaxObjects: len(vectors),
+		FilterStrategy:        ent.FilterStrategyAcorn,
+	}, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	// make sure the graph is searched, rather than falling back to a flat search
+	index.forbidFlat = true
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(uint64(i), vec))
+	}
+	require.True(t, index.acornSearch.Load())
+
+	// allow every 20th vector
+	allowList := helpers.NewAllowList()
+	var allowedIDs []uint64
+	var allowedVectors [][]float32
+	for i := 0; i < len(vectors); i += 20 {
+		allowList.Insert(uint64(i))
+		allowedIDs = append(allowedIDs, uint64(i))
+		allowedVectors = append(allowedVectors, vectors[i])
+	}
+
+	var relevant int
+	for _, query := range queries {
+		results, _, err := index.SearchByVector(query, k, allowList)
+		require.Nil(t, err)
+		require.Len(t, results, k)
+		for _, id := range results {
+			require.True(t, allowList.Contains(id))
+		}
+
+		positions, _ := testinghelpers.BruteForce(logger, allowedVectors, query, k,
+			func(a, b []float32) float32 {
+				dist, _, _ := provider.SingleDist(a, b)
+				return dist
+			})
+		truth := make([]uint64, len(positions))
+		for i, pos := range positions {
+			truth[i] = allowedIDs[pos]
+		}
+		relevant += int(testinghelpers.MatchesInLists(truth, results))
+	}
+
+	recall := float32(relevant) / float32(k*len(queries))
+	assert.GreaterOrEqual(t, recall, float32(0.9))
+
+	t.Run("switch back to sweeping", func(t *testing.T) {
+		uc := ent.NewDefaultUserConfig()
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+		assert.False(t, index.acornSearch.Load())
+	})
+}
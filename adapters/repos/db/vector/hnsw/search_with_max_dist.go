@@ -53,6 +53,11 @@ func (h *hnsw) KnnSearchByVectorMaxDist(searchVec []float32, dist float32,
 
 	eps := priorityqueue.NewMin[any](1)
 	eps.Insert(entryPointID, entryPointDistance)
+	if h.useAcorn(0, allowList) {
+		if err := h.addAcornEntrypoints(eps, searchVec, allowList, entryPointID); err != nil {
+			return nil, errors.Wrap(err, "knn search: add filtered entrypoints")
+		}
+	}
 	res, err := h.searchLayerByVector(searchVec, eps, ef, 0, allowList)
 	if err != nil {
 		return nil, errors.Wrapf(err, "knn search: search layer at level %d", 0)
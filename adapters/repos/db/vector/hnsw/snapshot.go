@@ -0,0 +1,575 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+// A snapshot contains the state of the graph after replaying all commit logs
+// up to and including the log file it is named after (the boundary). On
+// startup the latest snapshot is loaded and only the commit logs written
+// after the boundary need to be replayed.
+//
+// The commit logs are never deleted because of a snapshot, so a corrupt
+// snapshot can always be discarded in favor of a full replay.
+//
+// Layout (little endian):
+//
+//	version         uint8
+//	entrypoint      uint64
+//	level           uint16
+//	compressed      uint8, followed by the PQ data if 1 (same layout as AddPQ)
+//	tombstones      uint64 count, followed by uint64 ids
+//	nodes           uint64 length of the nodes slice, uint64 count of
+//	                non-nil nodes, followed by every non-nil node:
+//	                id uint64, level uint16, levels uint16 and for every
+//	                level a uint32 count followed by uint64 connections
+//	checksum        uint32 CRC32 (Castagnoli) of everything above
+const (
+	snapshotVersion   = 1
+	snapshotSuffix    = ".snapshot"
+	snapshotTmpSuffix = ".snapshot.tmp"
+)
+
+var snapshotChecksumTable = crc32.MakeTable(crc32.Castagnoli)
+
+func snapshotDirectory(rootPath, name string) string {
+	return fmt.Sprintf("%s/%s.hnsw.snapshot.d", rootPath, name)
+}
+
+type snapshotInfo struct {
+	path string
+	// boundary is the timestamp of the last commit log contained in the
+	// snapshot
+	boundary  int64
+	createdAt time.Time
+}
+
+// latestSnapshot returns nil if no snapshot is present
+func latestSnapshot(rootPath, name string) (*snapshotInfo, error) {
+	dir := snapshotDirectory(rootPath, name)
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "browse snapshot directory")
+	}
+
+	var latest *snapshotInfo
+	for _, file := range files {
+		if !strings.HasSuffix(file.Name(), snapshotSuffix) {
+			continue
+		}
+
+		boundary, err := strconv.ParseInt(strings.TrimSuffix(file.Name(), snapshotSuffix), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse snapshot name %q", file.Name())
+		}
+
+		if latest != nil && latest.boundary >= boundary {
+			continue
+		}
+
+		info, err := file.Info()
+		if err != nil {
+			return nil, errors.Wrapf(err, "stat snapshot %q", file.Name())
+		}
+
+		latest = &snapshotInfo{
+			path:      filepath.Join(dir, file.Name()),
+			boundary:  boundary,
+			createdAt: info.ModTime(),
+		}
+	}
+
+	return latest, nil
+}
+
+// writeSnapshot atomically writes the state as the snapshot for the given
+// boundary and removes all other snapshots
+func writeSnapshot(rootPath, name string, boundary int64,
+	state *DeserializationResult,
+) (string, error) {
+	dir := snapshotDirectory(rootPath, name)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return "", errors.Wrap(err, "create snapshot directory")
+	}
+
+	finalName := filepath.Join(dir, fmt.Sprintf("%d%s", boundary, snapshotSuffix))
+	tmpName := filepath.Join(dir, fmt.Sprintf("%d%s", boundary, snapshotTmpSuffix))
+
+	if err := writeSnapshotFile(tmpName, state); err != nil {
+		os.Remove(tmpName)
+		return "", err
+	}
+
+	if err := os.Rename(tmpName, finalName); err != nil {
+		return "", errors.Wrapf(err, "rename tmp (%q) to final (%q)", tmpName, finalName)
+	}
+
+	if err := syncDir(dir); err != nil {
+		return "", err
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return "", errors.Wrap(err, "browse snapshot directory")
+	}
+	for _, file := range files {
+		path := filepath.Join(dir, file.Name())
+		if path == finalName {
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			return "", errors.Wrapf(err, "clean up old snapshot %q", path)
+		}
+	}
+
+	return finalName, nil
+}
+
+func writeSnapshotFile(fileName string, state *DeserializationResult) error {
+	fd, err := os.Create(fileName)
+	if err != nil {
+		return errors.Wrapf(err, "create snapshot file %q", fileName)
+	}
+	defer fd.Close()
+
+	checksum := crc32.New(snapshotChecksumTable)
+	w := bufio.NewWriterSize(io.MultiWriter(fd, checksum), 1024*1024)
+	if err := writeSnapshotBody(w, state); err != nil {
+		return errors.Wrapf(err, "write snapshot file %q", fileName)
+	}
+	if err := w.Flush(); err != nil {
+		return errors.Wrapf(err, "write snapshot file %q", fileName)
+	}
+
+	if err := binary.Write(fd, binary.LittleEndian, checksum.Sum32()); err != nil {
+		return errors.Wrapf(err, "write snapshot checksum %q", fileName)
+	}
+
+	if err := fd.Sync(); err != nil {
+		return errors.Wrapf(err, "fsync snapshot file %q", fileName)
+	}
+
+	return fd.Close()
+}
+
+func writeSnapshotBody(w io.Writer, state *DeserializationResult) error {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, snapshotVersion)
+	buf = binary.LittleEndian.AppendUint64(buf, state.Entrypoint)
+	buf = binary.LittleEndian.AppendUint16(buf, state.Level)
+
+	if state.Compressed {
+		pq := state.PQData
+		buf = append(buf, 1)
+		buf = binary.LittleEndian.AppendUint16(buf, pq.Dimensions)
+		buf = append(buf, byte(pq.EncoderType))
+		buf = binary.LittleEndian.AppendUint16(buf, pq.Ks)
+		buf = binary.LittleEndian.AppendUint16(buf, pq.M)
+		buf = append(buf, pq.EncoderDistribution)
+		if pq.UseBitsEncoding {
+			buf = append(buf, 1)
+		} else {
+			buf = append(buf, 0)
+		}
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+		for _, encoder := range pq.Encoders {
+			if _, err := w.Write(encoder.ExposeDataForRestore()); err != nil {
+				return err
+			}
+		}
+		buf = buf[:0]
+	} else {
+		buf = append(buf, 0)
+	}
+
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(len(state.Tombstones)))
+	if _, err := w.Write(buf); err != nil {
+		return err
+	}
+	for id := range state.Tombstones {
+		buf = binary.LittleEndian.AppendUint64(buf[:0], id)
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+
+	nonNil := 0
+	for _, node := range state.Nodes {
+		if node != nil {
+			nonNil++
+		}
+	}
+	buf = binary.LittleEndian.AppendUint64(buf[:0], uint64(len(state.Nodes)))
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(nonNil))
+	if _, err := w.Write(buf); err != nil {
+		return err
+	}
+
+	for _, node := range state.Nodes {
+		if node == nil {
+			continue
+		}
+
+		buf = binary.LittleEndian.AppendUint64(buf[:0], node.id)
+		buf = binary.LittleEndian.AppendUint16(buf, uint16(node.level))
+		buf = binary.LittleEndian.AppendUint16(buf, uint16(len(node.connections)))
+		for _, conns := range node.connections {
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(len(conns)))
+			for _, conn := range conns {
+				buf = binary.LittleEndian.AppendUint64(buf, conn)
+			}
+		}
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readSnapshot returns an error if the checksum of the snapshot does not
+// match or it cannot be read completely. The checksum is verified before
+// parsing, so a corrupt snapshot never leads to oversized allocations.
+func readSnapshot(fileName string, logger logrus.FieldLogger) (*DeserializationResult, error) {
+	fd, err := os.Open(fileName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open snapshot %q", fileName)
+	}
+	defer fd.Close()
+
+	if err := verifySnapshotChecksum(fd); err != nil {
+		return nil, errors.Wrapf(err, "snapshot %q", fileName)
+	}
+
+	if _, err := fd.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.Wrapf(err, "seek snapshot %q", fileName)
+	}
+
+	state, err := readSnapshotBody(bufio.NewReaderSize(fd, 1024*1024), logger)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read snapshot %q", fileName)
+	}
+
+	return state, nil
+}
+
+func verifySnapshotChecksum(fd *os.File) error {
+	stat, err := fd.Stat()
+	if err != nil {
+		return errors.Wrap(err, "stat")
+	}
+	if stat.Size() < 4 {
+		return errors.New("too short to contain a checksum")
+	}
+
+	r := bufio.NewReaderSize(fd, 1024*1024)
+	checksum := crc32.New(snapshotChecksumTable)
+	if _, err := io.CopyN(checksum, r, stat.Size()-4); err != nil {
+		return errors.Wrap(err, "read body")
+	}
+
+	var expected uint32
+	if err := binary.Read(r, binary.LittleEndian, &expected); err != nil {
+		return errors.Wrap(err, "read checksum")
+	}
+	if expected != checksum.Sum32() {
+		return errors.New("checksum mismatch")
+	}
+
+	return nil
+}
+
+func readSnapshotBody(r io.Reader, logger logrus.FieldLogger) (*DeserializationResult, error) {
+	d := NewDeserializer(logger)
+	state := &DeserializationResult{
+		Tombstones:        make(map[uint64]struct{}),
+		TombstonesDeleted: make(map[uint64]struct{}),
+		LinksReplaced:     make(map[uint64]map[uint16]struct{}),
+		EntrypointChanged: true,
+	}
+
+	version, err := d.readByte(r)
+	if err != nil {
+		return nil, err
+	}
+	if version != snapshotVersion {
+		return nil, errors.Errorf("unsupported snapshot version %d", version)
+	}
+
+	if state.Entrypoint, err = d.readUint64(r); err != nil {
+		return nil, err
+	}
+	if state.Level, err = d.readUint16(r); err != nil {
+		return nil, err
+	}
+
+	compressed, err := d.readByte(r)
+	if err != nil {
+		return nil, err
+	}
+	if compressed == 1 {
+		if err := d.ReadPQ(r, state); err != nil {
+			return nil, errors.Wrap(err, "read pq data")
+		}
+	}
+
+	tombstones, err := d.readUint64(r)
+	if err != nil {
+		return nil, err
+	}
+	for i := uint64(0); i < tombstones; i++ {
+		id, err := d.readUint64(r)
+		if err != nil {
+			return nil, err
+		}
+		state.Tombstones[id] = struct{}{}
+	}
+
+	size, err := d.readUint64(r)
+	if err != nil {
+		return nil, err
+	}
+	nonNil, err := d.readUint64(r)
+	if err != nil {
+		return nil, err
+	}
+	if nonNil > size {
+		return nil, errors.Errorf("%d nodes do not fit into %d slots", nonNil, size)
+	}
+
+	state.Nodes = make([]*vertex, size)
+	header := make([]byte, 12)
+	for i := uint64(0); i < nonNil; i++ {
+		if _, err := io.ReadFull(r, header); err != nil {
+			return nil, err
+		}
+		id := binary.LittleEndian.Uint64(header[0:8])
+		if id >= size {
+			return nil, errors.Errorf("node %d does not fit into %d slots", id, size)
+		}
+
+		node := &vertex{
+			id:          id,
+			level:       int(binary.LittleEndian.Uint16(header[8:10])),
+			connections: make([][]uint64, binary.LittleEndian.Uint16(header[10:12])),
+		}
+		for level := range node.connections {
+			count, err := d.readUint32(r)
+			if err != nil {
+				return nil, err
+			}
+			conns, err := d.readUint64Slice(r, int(count))
+			if err != nil {
+				return nil, err
+			}
+			node.connections[level] = make([]uint64, count)
+			copy(node.connections[level], conns)
+		}
+		state.Nodes[id] = node
+	}
+
+	return state, nil
+}
+
+// createSnapshot replays all completed commit logs which are not part of the
+// latest snapshot on top of it and writes the result as the new snapshot. The
+// current log is still being written to, so it is never included.
+func (l *hnswCommitLogger) createSnapshot(shouldAbort cyclemanager.ShouldAbortCallback) (bool, error) {
+	if l.snapshotInterval <= 0 {
+		return false, nil
+	}
+
+	snapshot, err := latestSnapshot(l.rootPath, l.id)
+	if err != nil {
+		return false, err
+	}
+	if snapshot != nil && time.Since(snapshot.createdAt) < l.snapshotInterval {
+		return false, nil
+	}
+
+	files, err := getCommitFileNames(l.rootPath, l.id)
+	if err != nil {
+		return false, err
+	}
+	if len(files) <= 1 {
+		return false, nil
+	}
+
+	// cut off last element, as that's never a candidate
+	candidates := files[:len(files)-1]
+	if snapshot != nil {
+		candidates, err = commitLogsAfter(candidates, snapshot.boundary)
+		if err != nil {
+			return false, err
+		}
+	}
+	if len(candidates) == 0 {
+		// nothing new since the last snapshot
+		return false, nil
+	}
+
+	inputs := candidates
+	if snapshot != nil {
+		inputs = append([]string{snapshot.path}, candidates...)
+	}
+	if ok, err := l.snapshotFitsMemory(inputs); !ok || err != nil {
+		return false, err
+	}
+
+	before := time.Now()
+	var state *DeserializationResult
+	if snapshot != nil {
+		state, err = readSnapshot(snapshot.path, l.logger)
+		if err != nil {
+			l.logger.WithField("action", "hnsw_snapshot").
+				WithField("id", l.id).
+				WithField("path", snapshot.path).
+				WithError(err).
+				Warn("previous snapshot is unusable, creating snapshot from all commit logs")
+
+			// replaying all commit logs needs more memory than was checked
+			candidates = files[:len(files)-1]
+			state = nil
+			if ok, err := l.snapshotFitsMemory(candidates); !ok || err != nil {
+				return false, err
+			}
+		}
+	}
+
+	for _, candidate := range candidates {
+		if shouldAbort() {
+			return false, nil
+		}
+
+		state, err = replayCommitLog(candidate, state, l.logger)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	boundary, err := asTimeStamp(filepath.Base(candidates[len(candidates)-1]))
+	if err != nil {
+		return false, err
+	}
+
+	path, err := writeSnapshot(l.rootPath, l.id, boundary, state)
+	if err != nil {
+		return false, err
+	}
+
+	l.logger.WithFields(logrus.Fields{
+		"action":   "hnsw_snapshot_created",
+		"id":       l.id,
+		"path":     path,
+		"replayed": len(candidates),
+		"took":     time.Since(before),
+	}).Info("created hnsw snapshot")
+
+	return true, nil
+}
+
+func replayCommitLog(fileName string, state *DeserializationResult,
+	logger logrus.FieldLogger,
+) (*DeserializationResult, error) {
+	fd, err := os.Open(fileName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open commit log %q for reading", fileName)
+	}
+	defer fd.Close()
+
+	state, _, err = NewDeserializer(logger).Do(bufio.NewReaderSize(fd, 256*1024), state, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "deserialize commit log %q", fileName)
+	}
+
+	return state, nil
+}
+
+// commitLogsAfter returns the commit logs which were written after the
+// given snapshot boundary
+func commitLogsAfter(fileNames []string, boundary int64) ([]string, error) {
+	for i, fileName := range fileNames {
+		ts, err := asTimeStamp(filepath.Base(fileName))
+		if err != nil {
+			return nil, err
+		}
+		if ts > boundary {
+			return fileNames[i:], nil
+		}
+	}
+	return nil, nil
+}
+
+func syncDir(dir string) error {
+	fd, err := os.Open(dir)
+	if err != nil {
+		return errors.Wrapf(err, "open directory %q", dir)
+	}
+	defer fd.Close()
+
+	if err := fd.Sync(); err != nil {
+		return errors.Wrapf(err, "fsync directory %q", dir)
+	}
+	return nil
+}
+
+// snapshotFitsMemory checks if the state read from the inputs of a snapshot
+// fits into memory, the snapshot is skipped otherwise
+func (l *hnswCommitLogger) snapshotFitsMemory(inputs []string) (bool, error) {
+	if l.allocChecker == nil {
+		// allocChecker is optional, so we can only check this if it's actually set
+		return true, nil
+	}
+
+	// Same estimate as for condensing, the state needs about 1B of memory
+	// for every byte read from disk.
+	var required int64
+	for _, input := range inputs {
+		stat, err := os.Stat(input)
+		if err != nil {
+			return false, fmt.Errorf("stat snapshot input %q: %w", input, err)
+		}
+		required += stat.Size()
+	}
+
+	if err := l.allocChecker.CheckAlloc(required); err != nil {
+		l.logger.WithFields(logrus.Fields{
+			"action": "hnsw_snapshot",
+			"event":  "snapshot_skipped_oom",
+			"id":     l.id,
+			"size":   required,
+		}).WithError(err).
+			Warnf("skipping hnsw snapshot due to memory pressure")
+		return false, nil
+	}
+	return true, nil
+}
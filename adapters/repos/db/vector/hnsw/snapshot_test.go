@@ -0,0 +1,290 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	rootPath := t.TempDir()
+	state := &DeserializationResult{
+		Nodes: []*vertex{
+			{id: 0, level: 1, connections: [][]uint64{{2}, {2}}},
+			nil,
+			{id: 2, level: 1, connections: [][]uint64{{0, 3}, {0}}},
+			{id: 3, connections: [][]uint64{{2}}},
+			nil,
+		},
+		Entrypoint: 2,
+		Level:      1,
+		Tombstones: map[uint64]struct{}{3: {}},
+	}
+
+	_, err := writeSnapshot(rootPath, "main", 1000, state)
+	require.Nil(t, err)
+	path, err := writeSnapshot(rootPath, "main", 1001, state)
+	require.Nil(t, err)
+
+	snapshot, err := latestSnapshot(rootPath, "main")
+	require.Nil(t, err)
+	require.NotNil(t, snapshot)
+	assert.Equal(t, path, snapshot.path)
+	assert.Equal(t, int64(1001), snapshot.boundary)
+
+	t.Run("older snapshots are removed", func(t *testing.T) {
+		files, err := os.ReadDir(snapshotDirectory(rootPath, "main"))
+		require.Nil(t, err)
+		require.Len(t, files, 1)
+		assert.Equal(t, "1001.snapshot", files[0].Name())
+	})
+
+	t.Run("read snapshot", func(t *testing.T) {
+		restored, err := readSnapshot(path, logger)
+		require.Nil(t, err)
+		assert.Equal(t, state.Entrypoint, restored.Entrypoint)
+		assert.Equal(t, state.Level, restored.Level)
+		assert.Equal(t, state.Tombstones, restored.Tombstones)
+		assert.False(t, restored.Compressed)
+		require.Len(t, restored.Nodes, len(state.Nodes))
+		for i, node := range state.Nodes {
+			if node == nil {
+				assert.Nil(t, restored.Nodes[i])
+				continue
+			}
+			assert.Equal(t, node.id, restored.Nodes[i].id)
+			assert.Equal(t, node.level, restored.Nodes[i].level)
+			assert.Equal(t, node.connections, restored.Nodes[i].connections)
+		}
+	})
+
+	t.Run("corrupt snapshot", func(t *testing.T) {
+		data, err := os.ReadFile(path)
+		require.Nil(t, err)
+		data[len(data)/2] ^= 0xff
+		require.Nil(t, os.WriteFile(path, data, 0o666))
+
+		_, err = readSnapshot(path, logger)
+		assert.ErrorContains(t, err, "checksum mismatch")
+	})
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	rootPath := t.TempDir()
+	vectors, _ := testinghelpers.RandomVecs(300, 0, 16)
+
+	newIndex := func() *hnsw {
+		index, err := New(Config{
+			RootPath: rootPath,
+			ID:       "snapshot",
+			Logger:   logger,
+			MakeCommitLoggerThunk: func() (CommitLogger, error) {
+				return NewCommitLogger(rootPath, "snapshot", logger,
+					cyclemanager.NewCallbackGroupNoop(), WithSnapshotInterval(time.Hour))
+			},
+			DistanceProvider: distancer.NewL2SquaredProvider(),
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+		}, ent.UserConfig{
+			MaxConnections: 16,
+			EFConstruction: 64,
+		}, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+		require.Nil(t, err)
+		return index
+	}
+
+	addVectors := func(index *hnsw, from, to int) {
+		for i := from; i < to; i++ {
+			require.Nil(t, index.Add(uint64(i), vectors[i]))
+		}
+		require.Nil(t, index.Flush())
+	}
+
+	// commit logs are named after the second they were created in
+	switchLogs := func(index *hnsw) {
+		time.Sleep(time.Second)
+		require.Nil(t, index.commitLog.SwitchCommitLogs(true))
+	}
+
+	noAbort := func() bool { return false }
+
+	index := newIndex()
+	cl := index.commitLog.(*hnswCommitLogger)
+	addVectors(index, 0, 100)
+	switchLogs(index)
+	addVectors(index, 100, 200)
+	require.Nil(t, index.Delete(10, 20, 30))
+	require.Nil(t, index.Flush())
+
+	t.Run("create snapshot", func(t *testing.T) {
+		created, err := cl.createSnapshot(noAbort)
+		require.Nil(t, err)
+		require.True(t, created)
+
+		// the interval has not passed yet
+		created, err = cl.createSnapshot(noAbort)
+		require.Nil(t, err)
+		assert.False(t, created)
+	})
+
+	switchLogs(index)
+	addVectors(index, 200, 300)
+
+	assertSameGraph := func(t *testing.T, expected, actual *hnsw) {
+		assert.Equal(t, expected.entryPointID, actual.entryPointID)
+		assert.Equal(t, expected.currentMaximumLayer, actual.currentMaximumLayer)
+		assert.Equal(t, expected.tombstones, actual.tombstones)
+		for i, node := range expected.nodes {
+			if node == nil {
+				continue
+			}
+			require.NotNil(t, actual.nodes[i])
+			assert.Equal(t, node.level, actual.nodes[i].level)
+			require.Len(t, actual.nodes[i].connections, len(node.connections))
+			for level, conns := range node.connections {
+				if len(conns) == 0 {
+					// replaying commit logs doesn't distinguish nil and empty links
+					assert.Empty(t, actual.nodes[i].connections[level])
+					continue
+				}
+				assert.Equal(t, conns, actual.nodes[i].connections[level])
+			}
+		}
+	}
+
+	loadedSnapshot := func() bool {
+		for _, entry := range hook.AllEntries() {
+			if entry.Data["action"] == "hnsw_load_snapshot" && entry.Level == logrus.InfoLevel {
+				return true
+			}
+		}
+		return false
+	}
+
+	t.Run("restore from snapshot and later commit logs", func(t *testing.T) {
+		hook.Reset()
+		restored := newIndex()
+		assert.True(t, loadedSnapshot())
+		assertSameGraph(t, index, restored)
+		require.Nil(t, restored.Shutdown(context.Background()))
+	})
+
+	t.Run("full replay after a corrupt snapshot checks the memory again", func(t *testing.T) {
+		switchLogs(index)
+		snapshot, err := latestSnapshot(rootPath, "snapshot")
+		require.Nil(t, err)
+		require.NotNil(t, snapshot)
+		original, err := os.ReadFile(snapshot.path)
+		require.Nil(t, err)
+		corrupt := append([]byte(nil), original...)
+		corrupt[len(corrupt)/2] ^= 0xff
+		require.Nil(t, os.WriteFile(snapshot.path, corrupt, 0o666))
+		defer func() {
+			require.Nil(t, os.WriteFile(snapshot.path, original, 0o666))
+		}()
+
+		// the snapshot and the logs after it fit, all logs do not
+		checker := &sequenceAllocChecker{errs: []error{nil, errors.New("oom")}}
+		cl.allocChecker = checker
+		cl.snapshotInterval = time.Nanosecond
+		defer func() {
+			cl.allocChecker = nil
+			cl.snapshotInterval = time.Hour
+		}()
+
+		created, err := cl.createSnapshot(noAbort)
+		require.Nil(t, err)
+		assert.False(t, created)
+		assert.Equal(t, 2, checker.calls)
+
+		latest, err := latestSnapshot(rootPath, "snapshot")
+		require.Nil(t, err)
+		assert.Equal(t, snapshot.path, latest.path)
+	})
+
+	t.Run("corrupt snapshot falls back to a full replay", func(t *testing.T) {
+		snapshot, err := latestSnapshot(rootPath, "snapshot")
+		require.Nil(t, err)
+		require.NotNil(t, snapshot)
+
+		data, err := os.ReadFile(snapshot.path)
+		require.Nil(t, err)
+		data[len(data)/2] ^= 0xff
+		require.Nil(t, os.WriteFile(snapshot.path, data, 0o666))
+
+		hook.Reset()
+		restored := newIndex()
+		assert.False(t, loadedSnapshot())
+		assertSameGraph(t, index, restored)
+		require.Nil(t, restored.Shutdown(context.Background()))
+
+		_, err = os.Stat(snapshot.path)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestCombinerRespectsSnapshotBoundary(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	rootPath := t.TempDir()
+	dir := commitLogDirectory(rootPath, "main")
+	require.Nil(t, os.MkdirAll(dir, os.ModePerm))
+	for _, name := range []string{"1000.condensed", "1001.condensed", "1002"} {
+		require.Nil(t, os.WriteFile(filepath.Join(dir, name), []byte{0}, 0o666))
+	}
+
+	_, err := writeSnapshot(rootPath, "main", 1000, &DeserializationResult{})
+	require.Nil(t, err)
+
+	combined, err := NewCommitLogCombiner(rootPath, "main", 1024, logger).Do()
+	require.Nil(t, err)
+	assert.False(t, combined)
+
+	_, err = writeSnapshot(rootPath, "main", 1001, &DeserializationResult{})
+	require.Nil(t, err)
+
+	combined, err = NewCommitLogCombiner(rootPath, "main", 1024, logger).Do()
+	require.Nil(t, err)
+	assert.True(t, combined)
+}
+
+// sequenceAllocChecker returns the errors in order, one per check
+type sequenceAllocChecker struct {
+	fakeAllocChecker
+	errs  []error
+	calls int
+}
+
+func (f *sequenceAllocChecker) CheckAlloc(sizeInBytes int64) error {
+	f.calls++
+	if f.calls > len(f.errs) {
+		return nil
+	}
+	return f.errs[f.calls-1]
+}
@@ -73,7 +73,11 @@ func (h *hnsw) restoreFromDisk() error {
 		return errors.Wrap(err, "corrupted commit log fixer")
 	}
 
-	var state *DeserializationResult
+	state, fileNames, err := h.restoreSnapshot(fileNames)
+	if err != nil {
+		return err
+	}
+
 	for i, fileName := range fileNames {
 		beforeIndividual := time.Now()
 
@@ -176,6 +180,53 @@ func (h *hnsw) restoreFromDisk() error {
 	return nil
 }
 
+// restoreSnapshot loads the latest snapshot, if there is one, and returns the
+// commit logs which still need to be replayed on top of it. A snapshot which
+// cannot be loaded is removed and all commit logs are replayed instead.
+func (h *hnsw) restoreSnapshot(fileNames []string) (*DeserializationResult, []string, error) {
+	snapshot, err := latestSnapshot(h.rootPath, h.id)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "find latest snapshot")
+	}
+	if snapshot == nil {
+		return nil, fileNames, nil
+	}
+
+	before := time.Now()
+	remaining, err := commitLogsAfter(fileNames, snapshot.boundary)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "find commit logs after snapshot")
+	}
+
+	var state *DeserializationResult
+	if len(remaining) == 0 {
+		// the current commit log is never part of a snapshot, the snapshot
+		// can't belong to these commit logs
+		err = errors.Errorf("no commit log after snapshot boundary %d", snapshot.boundary)
+	} else {
+		state, err = readSnapshot(snapshot.path, h.logger)
+	}
+	if err != nil {
+		h.logger.WithField("action", "hnsw_load_snapshot").
+			WithField("path", snapshot.path).
+			WithError(err).
+			Warn("snapshot is unusable, replaying all commit logs instead")
+
+		if err := os.Remove(snapshot.path); err != nil {
+			return nil, nil, errors.Wrapf(err, "remove unusable snapshot %q", snapshot.path)
+		}
+		return nil, fileNames, nil
+	}
+
+	h.logger.WithField("action", "hnsw_load_snapshot").
+		WithField("path", snapshot.path).
+		WithField("skipped_commit_logs", len(fileNames)-len(remaining)).
+		WithField("took", time.Since(before)).
+		Info("loaded snapshot")
+
+	return state, remaining, nil
+}
+
 func (h *hnsw) tombstoneCleanup(shouldAbort cyclemanager.ShouldAbortCallback) bool {
 	if h.allocChecker != nil {
 		// allocChecker is optional, we can only check if it was actually set
@@ -231,7 +282,7 @@ func (h *hnsw) prefillCache() {
 
 		var err error
 		if h.compressed.Load() {
-			h.compressor.PrefillCache()
+			err = h.compressor.PrefillCache()
 		} else {
 			err = newVectorCachePrefiller(h.cache, h, h.logger).Prefill(ctx, limit)
 		}
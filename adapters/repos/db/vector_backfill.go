@@ -0,0 +1,320 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorreindex"
+)
+
+// vectorBackfill vectorizes the objects of a shard which don't have a vector
+// for a target vector that was added to the class. Unlike a reindexing job it
+// doesn't build a new index, the vectors are written to the objects and to
+// the index of the target vector right away. Objects written after the
+// target vector was added are vectorized on write, so once all objects were
+// scanned the shard is done.
+type vectorBackfill struct {
+	job        vectorreindex.Job
+	class      *models.Class
+	vectorizer vectorreindex.Vectorizer
+	limiter    *rate.Limiter
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	total  int64
+
+	processed atomic.Int64
+	filled    atomic.Bool
+	completed atomic.Bool
+	// result is set when the backfill failed or was stopped
+	result atomic.Pointer[vectorreindex.ShardStatus]
+
+	jobPause
+}
+
+func (b *vectorBackfill) shardStatus() *vectorreindex.ShardStatus {
+	if result := b.result.Load(); result != nil {
+		status := *result
+		return &status
+	}
+
+	status := vectorreindex.StatusRunning
+	switch {
+	case b.completed.Load():
+		status = vectorreindex.StatusCompleted
+	case b.filled.Load():
+		status = vectorreindex.StatusReady
+	case b.isPaused():
+		status = vectorreindex.StatusPaused
+	}
+	return &vectorreindex.ShardStatus{
+		Status:           status,
+		ObjectsProcessed: b.processed.Load(),
+		ObjectsTotal:     b.total,
+	}
+}
+
+func (b *vectorBackfill) setResult(status, errMsg string) {
+	result := &vectorreindex.ShardStatus{
+		Status:           status,
+		ObjectsProcessed: b.processed.Load(),
+		ObjectsTotal:     b.total,
+		Error:            errMsg,
+	}
+	b.result.CompareAndSwap(nil, result)
+}
+
+// stop waits for the backfill to return. The result is kept if it failed
+// before.
+func (b *vectorBackfill) stop(status, errMsg string) {
+	b.cancel()
+	<-b.done
+	if !b.completed.Load() {
+		b.setResult(status, errMsg)
+	}
+}
+
+// reconcileVectorBackfill starts, pauses or stops the backfill of the target
+// vector of the job to match the job stored in the schema
+func (s *Shard) reconcileVectorBackfill(job vectorreindex.Job, class *models.Class,
+	vectorizer vectorreindex.Vectorizer, limiter *rate.Limiter,
+) {
+	s.vectorBackfillLock.Lock()
+	defer s.vectorBackfillLock.Unlock()
+
+	if s.vectorBackfillsClosed {
+		return
+	}
+
+	b := s.vectorBackfills[job.TargetVector]
+	if b != nil && b.job.Version != job.Version {
+		b.stop(vectorreindex.StatusCancelled, "")
+		b = nil
+	}
+
+	switch job.Status {
+	case vectorreindex.StatusRunning, vectorreindex.StatusPaused:
+		if b == nil {
+			b = s.startVectorBackfill(job, class, vectorizer, limiter)
+		}
+		b.setPaused(job.Status == vectorreindex.StatusPaused)
+	case vectorreindex.StatusCompleted:
+		if b == nil && slices.Contains(job.Deferred, s.name) {
+			// the tenant wasn't active when the backfill was committed
+			b = s.startVectorBackfill(job, class, vectorizer, limiter)
+		}
+		if b != nil && b.filled.Load() {
+			b.completed.Store(true)
+		}
+	default:
+		if b != nil {
+			b.stop(job.Status, job.Error)
+		}
+	}
+}
+
+// startVectorBackfill must be called with the vectorBackfillLock held
+func (s *Shard) startVectorBackfill(job vectorreindex.Job, class *models.Class,
+	vectorizer vectorreindex.Vectorizer, limiter *rate.Limiter,
+) *vectorBackfill {
+	if job.BatchSize <= 0 {
+		job.BatchSize = vectorreindex.DefaultBatchSize
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b := &vectorBackfill{
+		job:        job,
+		class:      class,
+		vectorizer: vectorizer,
+		limiter:    limiter,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+		total:      int64(s.store.Bucket(helpers.ObjectsBucketLSM).Count()),
+	}
+	if s.vectorBackfills == nil {
+		s.vectorBackfills = map[string]*vectorBackfill{}
+	}
+	s.vectorBackfills[job.TargetVector] = b
+
+	s.index.logger.WithFields(logrus.Fields{
+		"action":        "vector_backfill",
+		"shard":         s.name,
+		"target_vector": job.TargetVector,
+		"vectorizer":    job.Vectorizer,
+	}).Info("started vectorizing objects for added target vector")
+
+	enterrors.GoWrapper(func() { s.runVectorBackfill(ctx, b) }, s.index.logger)
+	return b
+}
+
+func (s *Shard) runVectorBackfill(ctx context.Context, b *vectorBackfill) {
+	defer close(b.done)
+
+	err := scanObjects(ctx, s.store, func(objs []*storobj.Object) error {
+		missing := make([]*storobj.Object, 0, len(objs))
+		for _, obj := range objs {
+			if _, ok := obj.Vectors[b.job.TargetVector]; !ok {
+				missing = append(missing, obj)
+			}
+		}
+
+		for start := 0; start < len(missing); start += b.job.BatchSize {
+			if err := b.waitIfPaused(ctx); err != nil {
+				return err
+			}
+			end := start + b.job.BatchSize
+			if end > len(missing) {
+				end = len(missing)
+			}
+			if err := s.backfillObjects(ctx, b, missing[start:end]); err != nil {
+				return err
+			}
+		}
+		b.processed.Add(int64(len(objs)))
+		return nil
+	})
+	if err == nil {
+		if vectorIndex := s.VectorIndexForName(b.job.TargetVector); vectorIndex != nil {
+			err = vectorIndex.Flush()
+		}
+	}
+	if err == nil {
+		b.filled.Store(true)
+		return
+	}
+	if ctx.Err() != nil {
+		// a stopped backfill records the status it was stopped with
+		return
+	}
+
+	s.index.logger.WithFields(logrus.Fields{
+		"action":        "vector_backfill",
+		"shard":         s.name,
+		"target_vector": b.job.TargetVector,
+	}).WithError(err).Error("vectorizing objects for added target vector failed")
+	b.setResult(vectorreindex.StatusFailed, err.Error())
+}
+
+func (s *Shard) backfillObjects(ctx context.Context, b *vectorBackfill, objs []*storobj.Object) error {
+	vectors, err := vectorizeForReindex(ctx, b.vectorizer, b.class, b.job.TargetVector, b.limiter, objs)
+	if err != nil {
+		return err
+	}
+	for i, obj := range objs {
+		if len(vectors[i]) == 0 {
+			continue
+		}
+		if err := s.writeBackfilledVector(obj, b.job.TargetVector, vectors[i]); err != nil {
+			return errors.Wrapf(err, "write vector of object %s", obj.ID())
+		}
+	}
+	return nil
+}
+
+// writeBackfilledVector adds the vector to the object and to the index of the
+// target vector, unless the object was replaced or got a vector for the
+// target vector in the meantime. The update time of the object is kept, as
+// every replica backfills its objects itself.
+func (s *Shard) writeBackfilledVector(obj *storobj.Object, targetVector string, vector []float32) error {
+	idBytes, err := uuid.MustParse(obj.ID().String()).MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	current, err := fetchObject(bucket, idBytes)
+	if err != nil {
+		return err
+	}
+	if current == nil || current.DocID != obj.DocID {
+		return nil
+	}
+	if _, ok := current.Vectors[targetVector]; ok {
+		return nil
+	}
+
+	vectorIndex := s.VectorIndexForName(targetVector)
+	if vectorIndex == nil {
+		return fmt.Errorf("vector index not found for target vector %s", targetVector)
+	}
+	if err := vectorIndex.ValidateBeforeInsert(vector); err != nil {
+		return err
+	}
+
+	vectors := make(map[string][]float32, len(current.Vectors)+1)
+	for name, vec := range current.Vectors {
+		vectors[name] = vec
+	}
+	vectors[targetVector] = vector
+	current.Vectors = vectors
+
+	data, err := current.MarshalBinary()
+	if err != nil {
+		return errors.Wrapf(err, "marshal object %s", current.ID())
+	}
+	if err := s.upsertObjectDataLSM(bucket, idBytes, data, current.DocID); err != nil {
+		return err
+	}
+	// the object is still locked, so a concurrent update which replaces it
+	// removes the vector from the index afterwards
+	return vectorIndex.Add(current.DocID, vector)
+}
+
+// vectorBackfillStatus returns nil if the shard didn't start the job
+func (s *Shard) vectorBackfillStatus(job vectorreindex.Job) *vectorreindex.ShardStatus {
+	s.vectorBackfillLock.Lock()
+	b := s.vectorBackfills[job.TargetVector]
+	s.vectorBackfillLock.Unlock()
+
+	if b == nil || b.job.Version != job.Version {
+		return nil
+	}
+	status := b.shardStatus()
+	status.Shard = s.name
+	return status
+}
+
+// stopVectorBackfills stops all backfills when the shard is shut down
+func (s *Shard) stopVectorBackfills() {
+	s.vectorBackfillLock.Lock()
+	defer s.vectorBackfillLock.Unlock()
+
+	s.vectorBackfillsClosed = true
+	var wg sync.WaitGroup
+	for _, b := range s.vectorBackfills {
+		b := b
+		wg.Add(1)
+		enterrors.GoWrapper(func() {
+			defer wg.Done()
+			b.stop(vectorreindex.StatusCancelled, "shard was shut down")
+		}, s.index.logger)
+	}
+	wg.Wait()
+}
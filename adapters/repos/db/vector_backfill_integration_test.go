@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorreindex"
+)
+
+func TestVectorBackfill(t *testing.T) {
+	ctx := context.Background()
+	className := "BackfillClass"
+	class := &models.Class{
+		Class: className,
+		Properties: []*models.Property{
+			{Name: "name", DataType: schema.DataTypeText.PropString()},
+		},
+	}
+	withTargetVectorA := func(idx *Index) {
+		idx.vectorIndexUserConfigs = map[string]schemaConfig.VectorIndexConfig{
+			"a": enthnsw.NewDefaultUserConfig(),
+		}
+	}
+
+	shd, idx := testShardWithSettings(t, ctx, class, nil, false, false, withTargetVectorA)
+	shard := loadedShard(t, shd)
+
+	r := getRandomSeed()
+	objs := make([]*storobj.Object, 120)
+	for i := range objs {
+		vector := make([]float32, 16)
+		for j := range vector {
+			vector[j] = r.Float32()
+		}
+		objs[i] = &storobj.Object{
+			MarshallerVersion: 1,
+			Object: models.Object{
+				ID:                 strfmt.UUID(uuid.NewString()),
+				Class:              className,
+				Properties:         map[string]interface{}{"name": fmt.Sprintf("object %d", i)},
+				LastUpdateTimeUnix: 1,
+			},
+			Vectors: map[string][]float32{"a": vector},
+		}
+	}
+	for _, err := range shard.PutObjectBatch(ctx, objs) {
+		require.Nil(t, err)
+	}
+
+	t.Run("adding a target vector creates an empty index", func(t *testing.T) {
+		require.Nil(t, idx.updateVectorIndexConfigs(ctx, map[string]schemaConfig.VectorIndexConfig{
+			"a": enthnsw.NewDefaultUserConfig(),
+			"b": enthnsw.NewDefaultUserConfig(),
+		}))
+		require.Eventually(t, func() bool {
+			return shard.GetStatus() == storagestate.StatusReady
+		}, 10*time.Second, 10*time.Millisecond)
+
+		require.NotNil(t, shard.VectorIndexForName("b"))
+		ids, _, err := shard.VectorIndexForName("b").SearchByVector(fakeReindexVector(objs[0].ID()), 10, nil)
+		require.Nil(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("client supplied vectors keep the docID of the object", func(t *testing.T) {
+		updated := *objs[0]
+		updated.Vectors = map[string][]float32{
+			"a": objs[0].Vectors["a"],
+			"b": fakeReindexVector(objs[0].ID()),
+		}
+		updated.Object.LastUpdateTimeUnix = 2
+		require.Nil(t, shard.PutObject(ctx, &updated))
+
+		found, err := shard.ObjectByID(ctx, objs[0].ID(), nil, additional.Properties{})
+		require.Nil(t, err)
+		assert.Equal(t, objs[0].DocID, found.DocID)
+
+		ids, _, err := shard.VectorIndexForName("b").SearchByVector(fakeReindexVector(objs[0].ID()), 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{objs[0].DocID}, ids)
+	})
+
+	vectorizer := &fakeReindexVectorizer{}
+	job := vectorreindex.Job{
+		TargetVector: "b",
+		Vectorizer:   "fake",
+		BatchSize:    50,
+		Backfill:     true,
+		Status:       vectorreindex.StatusRunning,
+		Version:      3,
+	}
+
+	t.Run("the backfill vectorizes objects without a vector", func(t *testing.T) {
+		shard.reconcileVectorReindex(job, class, vectorizer, nil)
+
+		require.Eventually(t, func() bool {
+			status := shard.vectorReindexStatus(job)
+			return status != nil && status.Status == vectorreindex.StatusReady
+		}, 30*time.Second, 10*time.Millisecond)
+
+		status := shard.vectorReindexStatus(job)
+		assert.Equal(t, int64(len(objs)), status.ObjectsProcessed)
+		assert.Equal(t, int64(len(objs)), status.ObjectsTotal)
+
+		for _, obj := range objs {
+			vector := fakeReindexVector(obj.ID())
+			ids, _, err := shard.VectorIndexForName("b").SearchByVector(vector, 1, nil)
+			require.Nil(t, err)
+			assert.Equal(t, []uint64{obj.DocID}, ids)
+
+			found, err := shard.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+			require.Nil(t, err)
+			assert.Equal(t, obj.DocID, found.DocID)
+			assert.Equal(t, vector, found.Vectors["b"])
+			assert.Equal(t, obj.Vectors["a"], found.Vectors["a"])
+		}
+
+		ids, _, err := shard.VectorIndexForName("a").SearchByVector(objs[1].Vectors["a"], 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{objs[1].DocID}, ids)
+	})
+
+	t.Run("committing the job completes the backfill", func(t *testing.T) {
+		job.Status = vectorreindex.StatusCompleted
+		shard.reconcileVectorReindex(job, class, vectorizer, nil)
+		assert.Equal(t, vectorreindex.StatusCompleted, shard.vectorReindexStatus(job).Status)
+	})
+
+	t.Run("deferred tenants are backfilled after the commit", func(t *testing.T) {
+		deferred := job
+		deferred.Version = 4
+		deferred.Deferred = []string{shard.Name()}
+		shard.reconcileVectorReindex(deferred, class, vectorizer, nil)
+
+		require.Eventually(t, func() bool {
+			status := shard.vectorReindexStatus(deferred)
+			return status != nil && status.Status == vectorreindex.StatusReady
+		}, 30*time.Second, 10*time.Millisecond)
+
+		shard.reconcileVectorReindex(deferred, class, vectorizer, nil)
+		assert.Equal(t, vectorreindex.StatusCompleted, shard.vectorReindexStatus(deferred).Status)
+	})
+}
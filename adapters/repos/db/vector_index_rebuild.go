@@ -0,0 +1,1021 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorreindex"
+)
+
+// rebuildScanBatchSize is the number of objects read with a single cursor.
+// The cursor is reopened for every batch, so memtables can be flushed while
+// the rebuild is running.
+const rebuildScanBatchSize = 1000
+
+// hnswBuildState is persisted next to the hnsw index of a shard. It records
+// the generation of the graph which is currently in use, and the parameters
+// it was built with.
+type hnswBuildState struct {
+	Generation     int `json:"generation"`
+	MaxConnections int `json:"maxConnections"`
+	EFConstruction int `json:"efConstruction"`
+	// Staged is set if the graph was built by a vector reindexing job, whose
+	// vectors weren't written to the objects yet
+	Staged bool `json:"staged,omitempty"`
+	// Reindexing is the version of the vector reindexing job which is
+	// building the next graph, so it can be resumed if the job was committed
+	// in the schema while the node was stopped
+	Reindexing uint64 `json:"reindexing,omitempty"`
+}
+
+func newHNSWBuildState(generation int, uc hnswent.UserConfig) hnswBuildState {
+	return hnswBuildState{
+		Generation:     generation,
+		MaxConnections: uc.MaxConnections,
+		EFConstruction: uc.EFConstruction,
+	}
+}
+
+func (st hnswBuildState) matches(uc hnswent.UserConfig) bool {
+	return st.MaxConnections == uc.MaxConnections && st.EFConstruction == uc.EFConstruction
+}
+
+// indexID is the id of the hnsw index of the given generation. The first
+// generation uses the plain id, so shards created before rebuilds were
+// possible keep their files.
+func (st hnswBuildState) indexID(vecIdxID string) string {
+	if st.Generation == 0 {
+		return vecIdxID
+	}
+	return fmt.Sprintf("%s.gen%d", vecIdxID, st.Generation)
+}
+
+func hnswBuildStatePath(shardPath, vecIdxID string) string {
+	return filepath.Join(shardPath, fmt.Sprintf("%s.hnsw-build.json", vecIdxID))
+}
+
+func readHNSWBuildState(path string) (hnswBuildState, bool, error) {
+	var st hnswBuildState
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return st, false, nil
+		}
+		return st, false, errors.Wrap(err, "read hnsw build state")
+	}
+	if err := json.Unmarshal(data, &st); err != nil {
+		return st, false, errors.Wrap(err, "unmarshal hnsw build state")
+	}
+	return st, true, nil
+}
+
+func writeHNSWBuildState(path string, st hnswBuildState) error {
+	data, err := json.Marshal(st)
+	if err != nil {
+		return errors.Wrap(err, "marshal hnsw build state")
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o666); err != nil {
+		return errors.Wrap(err, "write hnsw build state")
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return errors.Wrap(err, "rename hnsw build state")
+	}
+	return nil
+}
+
+// removeStaleHNSWGenerations removes the files of all generations except the
+// current one. These are left behind if the node stopped during a rebuild or
+// before the previous generation was dropped.
+func removeStaleHNSWGenerations(shardPath, vecIdxID string, current int) error {
+	entries, err := os.ReadDir(shardPath)
+	if err != nil {
+		return errors.Wrap(err, "read shard directory")
+	}
+
+	genPrefix := vecIdxID + ".gen"
+	plainPrefix := vecIdxID + ".hnsw."
+	for _, entry := range entries {
+		name := entry.Name()
+
+		stale := false
+		if strings.HasPrefix(name, genPrefix) {
+			genStr, _, ok := strings.Cut(strings.TrimPrefix(name, genPrefix), ".hnsw.")
+			if !ok {
+				continue
+			}
+			gen, err := strconv.Atoi(genStr)
+			stale = err == nil && gen != current
+		} else if strings.HasPrefix(name, plainPrefix) {
+			stale = current != 0
+		}
+
+		if stale {
+			if err := os.RemoveAll(filepath.Join(shardPath, name)); err != nil {
+				return errors.Wrapf(err, "remove %q", name)
+			}
+		}
+	}
+	return nil
+}
+
+// rebuildableVectorIndex wraps the hnsw index of a shard. When parameters
+// which determine the structure of the graph are changed, a new graph is
+// built in the background from the objects of the shard, while the current
+// one keeps serving queries. Writes are applied to both graphs until the new
+// one is complete and replaces the current one.
+type rebuildableVectorIndex struct {
+	// protects index, state, uc, rebuild, lastRebuild, reindex, lastReindex,
+	// staged and the finishing fields
+	sync.RWMutex
+	index       VectorIndex
+	state       hnswBuildState
+	uc          hnswent.UserConfig
+	rebuild     *vectorIndexRebuild
+	lastRebuild *models.VectorIndexRebuildStatus
+	reindex     *vectorReindex
+	lastReindex *lastVectorReindex
+
+	// staged are the vectors of the last vector reindexing job, which are
+	// written to the objects by finishReindex
+	staged       *stagedVectors
+	finishCancel context.CancelFunc
+	finishDone   chan struct{}
+
+	// serializes starting, stopping and replacing rebuilds
+	rebuildLock sync.Mutex
+	closed      bool
+
+	targetVector string
+	vecIdxID     string
+	statePath    string
+	store        *lsmkv.Store
+	shard        *Shard
+	logger       logrus.FieldLogger
+	newIndex     func(id string, uc hnswent.UserConfig, staged *stagedVectors) (VectorIndex, error)
+}
+
+type vectorIndexRebuild struct {
+	index   VectorIndex
+	uc      hnswent.UserConfig
+	cancel  context.CancelFunc
+	done    chan struct{}
+	started time.Time
+	total   int64
+
+	processed atomic.Int64
+	// errors while applying writes to the new index are not returned to the
+	// user, the rebuild fails instead
+	writeErr atomic.Pointer[error]
+
+	// ids are locked while added, so an id added by the rebuild and by a
+	// concurrent write is only inserted once
+	locks     *common.ShardedLocks
+	deletedMu sync.Mutex
+	deleted   map[uint64]struct{}
+}
+
+func (r *vectorIndexRebuild) add(id uint64, vector []float32) error {
+	r.locks.Lock(id)
+	defer r.locks.Unlock(id)
+
+	r.deletedMu.Lock()
+	_, deleted := r.deleted[id]
+	r.deletedMu.Unlock()
+	if deleted || r.index.ContainsNode(id) {
+		return nil
+	}
+	return r.index.Add(id, vector)
+}
+
+func (r *vectorIndexRebuild) delete(ids ...uint64) error {
+	// mark the ids as deleted first, so objects read by the rebuild before
+	// they were deleted are not added afterwards
+	for _, id := range ids {
+		r.locks.Lock(id)
+		r.deletedMu.Lock()
+		r.deleted[id] = struct{}{}
+		r.deletedMu.Unlock()
+		r.locks.Unlock(id)
+	}
+	return r.index.Delete(ids...)
+}
+
+func (r *vectorIndexRebuild) recordWriteErr(err error) {
+	r.writeErr.CompareAndSwap(nil, &err)
+}
+
+func (r *vectorIndexRebuild) status() *models.VectorIndexRebuildStatus {
+	return &models.VectorIndexRebuildStatus{
+		Status:            models.VectorIndexRebuildStatusStatusRUNNING,
+		ObjectsProcessed:  r.processed.Load(),
+		ObjectsTotal:      r.total,
+		StartedUnixMillis: r.started.UnixMilli(),
+	}
+}
+
+func sameBuildParams(a, b hnswent.UserConfig) bool {
+	return a.MaxConnections == b.MaxConnections && a.EFConstruction == b.EFConstruction
+}
+
+// initRebuildableHNSW opens the current generation of the hnsw index. A
+// rebuild is started on PostStartup if the graph was built with different
+// parameters than the configured ones, e.g. because the node was stopped
+// during a rebuild.
+func (s *Shard) initRebuildableHNSW(targetVector string, uc hnswent.UserConfig,
+	distProv distancer.Provider,
+) (*rebuildableVectorIndex, error) {
+	// a shard can actually have multiple vector indexes:
+	// - the main index, which is used for all normal object vectors
+	// - a geo property index for each geo prop in the schema
+	//
+	// here we label the main vector index as such.
+	vecIdxID := s.vectorIndexID(targetVector)
+	statePath := hnswBuildStatePath(s.path(), vecIdxID)
+
+	state, ok, err := readHNSWBuildState(statePath)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// the index was created before its build state was tracked, or is
+		// created right now. Either way it was built with the configured
+		// parameters, as they could not be changed before.
+		state = newHNSWBuildState(0, uc)
+		if err := writeHNSWBuildState(statePath, state); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := removeStaleHNSWGenerations(s.path(), vecIdxID, state.Generation); err != nil {
+		return nil, errors.Wrap(err, "remove stale hnsw generations")
+	}
+
+	newIndex := func(id string, uc hnswent.UserConfig, staged *stagedVectors) (VectorIndex, error) {
+		return s.newHNSWIndex(targetVector, id, uc, distProv, staged)
+	}
+
+	// vectors staged by a vector reindexing job which wasn't committed are
+	// not needed anymore, the job is started from scratch
+	reindexBucket := vectorReindexBucket(targetVector)
+	var staged *stagedVectors
+	if state.Staged {
+		if err := s.store.CreateOrLoadBucket(context.Background(), reindexBucket,
+			lsmkv.WithStrategy(lsmkv.StrategyReplace)); err != nil {
+			return nil, errors.Wrap(err, "load reindex bucket")
+		}
+		staged = newStagedVectors(s.store, reindexBucket)
+	} else if err := os.RemoveAll(filepath.Join(s.pathLSM(), reindexBucket)); err != nil {
+		return nil, errors.Wrap(err, "remove reindex bucket")
+	}
+
+	index, err := newIndex(state.indexID(vecIdxID), uc, staged)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rebuildableVectorIndex{
+		index:        index,
+		state:        state,
+		uc:           uc,
+		staged:       staged,
+		targetVector: targetVector,
+		vecIdxID:     vecIdxID,
+		statePath:    statePath,
+		store:        s.store,
+		shard:        s,
+		logger: s.index.logger.WithFields(logrus.Fields{
+			"shard":         s.name,
+			"target_vector": targetVector,
+		}),
+		newIndex: newIndex,
+	}, nil
+}
+
+// reconcileRebuild starts a rebuild if the graph doesn't match the configured
+// parameters, and stops a running rebuild if it was started for parameters
+// which are no longer configured
+func (w *rebuildableVectorIndex) reconcileRebuild() {
+	w.rebuildLock.Lock()
+	defer w.rebuildLock.Unlock()
+
+	if w.closed {
+		return
+	}
+
+	w.RLock()
+	uc := w.uc
+	upToDate := w.state.matches(uc)
+	running := w.rebuild
+	// a running vector reindexing job builds the new graph with the
+	// configured parameters already, and staged vectors can't be read
+	// by a rebuild
+	reindexing := w.reindex != nil || w.state.Staged || w.state.Reindexing != 0
+	w.RUnlock()
+
+	if reindexing {
+		return
+	}
+
+	if running != nil {
+		if !upToDate && sameBuildParams(running.uc, uc) {
+			return
+		}
+		w.stopRebuild()
+	}
+	if upToDate {
+		return
+	}
+
+	if err := w.startRebuild(uc); err != nil {
+		w.logger.WithField("action", "hnsw_rebuild").WithError(err).
+			Error("failed to start rebuilding vector index")
+		w.Lock()
+		w.lastRebuild = &models.VectorIndexRebuildStatus{
+			Status:             models.VectorIndexRebuildStatusStatusFAILED,
+			StartedUnixMillis:  time.Now().UnixMilli(),
+			FinishedUnixMillis: time.Now().UnixMilli(),
+			Error:              err.Error(),
+		}
+		w.Unlock()
+	}
+}
+
+// startRebuild must be called with the rebuildLock held
+func (w *rebuildableVectorIndex) startRebuild(uc hnswent.UserConfig) error {
+	w.RLock()
+	next := newHNSWBuildState(w.state.Generation+1, uc)
+	w.RUnlock()
+
+	index, err := w.newIndex(next.indexID(w.vecIdxID), uc, nil)
+	if err != nil {
+		return errors.Wrap(err, "create index")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &vectorIndexRebuild{
+		index:   index,
+		uc:      uc,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+		started: time.Now(),
+		total:   int64(w.store.Bucket(helpers.ObjectsBucketLSM).Count()),
+		locks:   common.NewDefaultShardedLocks(),
+		deleted: map[uint64]struct{}{},
+	}
+
+	// from now on all writes are applied to the new index as well, anything
+	// written before is found in the objects bucket
+	w.Lock()
+	w.rebuild = r
+	w.Unlock()
+
+	w.logger.WithFields(logrus.Fields{
+		"action":          "hnsw_rebuild",
+		"generation":      next.Generation,
+		"max_connections": uc.MaxConnections,
+		"ef_construction": uc.EFConstruction,
+	}).Info("started rebuilding vector index")
+
+	enterrors.GoWrapper(func() { w.runRebuild(ctx, r, next) }, w.logger)
+	return nil
+}
+
+// rebuildNow builds a new graph with the configured parameters, even if the
+// current one matches them, and waits until it replaced the current one
+func (w *rebuildableVectorIndex) rebuildNow(ctx context.Context) error {
+	w.rebuildLock.Lock()
+	if w.closed {
+		w.rebuildLock.Unlock()
+		return errors.New("vector index is shut down")
+	}
+
+	w.RLock()
+	uc := w.uc
+	reindexing := w.reindex != nil || w.state.Staged || w.state.Reindexing != 0
+	w.RUnlock()
+	if reindexing {
+		w.rebuildLock.Unlock()
+		return errors.New("a vector reindexing job is building the graph")
+	}
+
+	w.stopRebuild()
+	if err := w.startRebuild(uc); err != nil {
+		w.rebuildLock.Unlock()
+		return err
+	}
+	w.RLock()
+	r := w.rebuild
+	w.RUnlock()
+	w.rebuildLock.Unlock()
+
+	select {
+	case <-r.done:
+	case <-ctx.Done():
+		w.rebuildLock.Lock()
+		w.stopRebuild()
+		w.rebuildLock.Unlock()
+		return ctx.Err()
+	}
+
+	status := w.rebuildStatus()
+	if status == nil || status.Status != models.VectorIndexRebuildStatusStatusCOMPLETED {
+		if status != nil && status.Error != "" {
+			return errors.New(status.Error)
+		}
+		return errors.New("rebuild did not complete")
+	}
+	return nil
+}
+
+// stopRebuild must be called with the rebuildLock held
+func (w *rebuildableVectorIndex) stopRebuild() {
+	w.Lock()
+	r := w.rebuild
+	w.rebuild = nil
+	w.Unlock()
+
+	if r == nil {
+		return
+	}
+
+	r.cancel()
+	<-r.done
+	if err := r.index.Drop(context.Background()); err != nil {
+		w.logger.WithField("action", "hnsw_rebuild").WithError(err).
+			Error("failed to drop partially rebuilt vector index")
+	}
+}
+
+func (w *rebuildableVectorIndex) runRebuild(ctx context.Context, r *vectorIndexRebuild,
+	next hnswBuildState,
+) {
+	defer close(r.done)
+
+	err := w.fill(ctx, r)
+	if err == nil {
+		err = w.compress(ctx, r.index)
+	}
+	if err == nil {
+		if writeErr := r.writeErr.Load(); writeErr != nil {
+			err = errors.Wrap(*writeErr, "apply writes")
+		}
+	}
+	if err == nil {
+		err = w.swap(r, next)
+	}
+	if err == nil || ctx.Err() != nil {
+		// a stopped rebuild is cleaned up by whoever stopped it
+		return
+	}
+
+	w.logger.WithField("action", "hnsw_rebuild").WithError(err).
+		Error("rebuilding vector index failed")
+
+	w.Lock()
+	owned := w.rebuild == r
+	if owned {
+		w.rebuild = nil
+		status := r.status()
+		status.Status = models.VectorIndexRebuildStatusStatusFAILED
+		status.FinishedUnixMillis = time.Now().UnixMilli()
+		status.Error = err.Error()
+		w.lastRebuild = status
+	}
+	w.Unlock()
+
+	if owned {
+		if err := r.index.Drop(context.Background()); err != nil {
+			w.logger.WithField("action", "hnsw_rebuild").WithError(err).
+				Error("failed to drop partially rebuilt vector index")
+		}
+	}
+}
+
+// fill adds the vectors of all objects in the shard to the new index
+func (w *rebuildableVectorIndex) fill(ctx context.Context, r *vectorIndexRebuild) error {
+	type task struct {
+		id     uint64
+		vector []float32
+	}
+
+	eg, ctx := enterrors.NewErrorGroupWithContextWrapper(w.logger, ctx)
+	workerCount := runtime.GOMAXPROCS(0)
+	ch := make(chan task, workerCount)
+
+	for i := 0; i < workerCount; i++ {
+		eg.Go(func() error {
+			for t := range ch {
+				if err := r.add(t.id, t.vector); err != nil {
+					return errors.Wrapf(err, "add node %d", t.id)
+				}
+				r.processed.Add(1)
+			}
+			return nil
+		})
+	}
+
+	err := scanObjects(ctx, w.store, func(objs []*storobj.Object) error {
+		for _, obj := range objs {
+			vector := obj.Vector
+			if w.targetVector != "" {
+				vector = obj.Vectors[w.targetVector]
+			}
+			if len(vector) == 0 {
+				r.processed.Add(1)
+				continue
+			}
+
+			select {
+			case ch <- task{id: obj.DocID, vector: vector}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+	close(ch)
+
+	if waitErr := eg.Wait(); waitErr != nil {
+		return waitErr
+	}
+	return err
+}
+
+// scanObjects calls fn with batches of the objects in the shard, the cursor
+// is not held while fn runs
+func scanObjects(ctx context.Context, store *lsmkv.Store, fn func(objs []*storobj.Object) error) error {
+	bucket := store.Bucket(helpers.ObjectsBucketLSM)
+
+	var lastKey []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		cursor, err := bucket.Cursor()
+		if err != nil {
+			return err
+		}
+		var k, v []byte
+		if lastKey == nil {
+			k, v = cursor.First()
+		} else {
+			k, v = cursor.Seek(lastKey)
+			if k != nil && string(k) == string(lastKey) {
+				k, v = cursor.Next()
+			}
+		}
+
+		objs := make([]*storobj.Object, 0, rebuildScanBatchSize)
+		for ; k != nil && len(objs) < rebuildScanBatchSize; k, v = cursor.Next() {
+			obj, err := storobj.FromBinary(v)
+			if err != nil {
+				cursor.Close()
+				return errors.Wrapf(err, "unmarshal object %x", k)
+			}
+			objs = append(objs, obj)
+			lastKey = append(lastKey[:0], k...)
+		}
+		cursor.Close()
+
+		if len(objs) > 0 {
+			if err := fn(objs); err != nil {
+				return err
+			}
+		}
+
+		if len(objs) < rebuildScanBatchSize {
+			return nil
+		}
+	}
+}
+
+// compress makes sure the new index is compressed if the current one is, so
+// replacing it doesn't change the memory usage of the shard unexpectedly
+func (w *rebuildableVectorIndex) compress(ctx context.Context, index VectorIndex) error {
+	w.RLock()
+	compressed := w.index.Compressed()
+	w.RUnlock()
+
+	if !compressed || index.Compressed() {
+		return nil
+	}
+
+	upgradable, ok := index.(upgradableIndexer)
+	if !ok {
+		return nil
+	}
+
+	done := make(chan struct{})
+	if err := upgradable.Upgrade(func() { close(done) }); err != nil {
+		return errors.Wrap(err, "compress")
+	}
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if !index.Compressed() {
+		return errors.New("compress: index is not compressed after upgrade")
+	}
+	return nil
+}
+
+// swap replaces the current index with the rebuilt one. The build state is
+// written first, if the node stops before the previous generation is dropped,
+// its files are removed on the next startup.
+func (w *rebuildableVectorIndex) swap(r *vectorIndexRebuild, next hnswBuildState) error {
+	w.RLock()
+	uc := w.uc
+	w.RUnlock()
+
+	// apply the parameters which were changed during the rebuild
+	if err := r.index.UpdateUserConfig(uc, func() {}); err != nil {
+		return errors.Wrap(err, "update user config")
+	}
+	if err := r.index.Flush(); err != nil {
+		return errors.Wrap(err, "flush")
+	}
+
+	w.Lock()
+	if w.rebuild != r {
+		w.Unlock()
+		return nil
+	}
+	if err := writeHNSWBuildState(w.statePath, next); err != nil {
+		w.Unlock()
+		return err
+	}
+
+	previous := w.index
+	w.index = r.index
+	w.state = next
+	w.rebuild = nil
+	status := r.status()
+	status.Status = models.VectorIndexRebuildStatusStatusCOMPLETED
+	status.FinishedUnixMillis = time.Now().UnixMilli()
+	w.lastRebuild = status
+	w.Unlock()
+
+	w.logger.WithFields(logrus.Fields{
+		"action":     "hnsw_rebuild",
+		"generation": next.Generation,
+		"objects":    status.ObjectsProcessed,
+		"took":       time.Since(r.started),
+	}).Info("completed rebuilding vector index")
+
+	if err := previous.Drop(context.Background()); err != nil {
+		w.logger.WithField("action", "hnsw_rebuild").WithError(err).
+			Error("failed to drop previous vector index")
+	}
+	return nil
+}
+
+func (w *rebuildableVectorIndex) rebuildStatus() *models.VectorIndexRebuildStatus {
+	w.RLock()
+	defer w.RUnlock()
+
+	var status *models.VectorIndexRebuildStatus
+	if w.rebuild != nil {
+		status = w.rebuild.status()
+	} else if w.lastRebuild != nil {
+		copied := *w.lastRebuild
+		status = &copied
+	} else {
+		return nil
+	}
+	status.TargetVector = w.targetVector
+	return status
+}
+
+// vectorIndexRebuildStatus returns the progress of running rebuilds, and the
+// result of the last rebuild of every vector index since the shard was loaded
+func (s *Shard) vectorIndexRebuildStatus() []*models.VectorIndexRebuildStatus {
+	indexes := s.VectorIndexes()
+	if !s.hasTargetVectors() {
+		indexes = map[string]VectorIndex{"": s.VectorIndex()}
+	}
+
+	var status []*models.VectorIndexRebuildStatus
+	for _, index := range indexes {
+		if rebuildable, ok := index.(*rebuildableVectorIndex); ok {
+			if rebuild := rebuildable.rebuildStatus(); rebuild != nil {
+				status = append(status, rebuild)
+			}
+		}
+	}
+	sort.Slice(status, func(i, j int) bool {
+		return status[i].TargetVector < status[j].TargetVector
+	})
+	return status
+}
+
+func (w *rebuildableVectorIndex) Dump(labels ...string) {
+	w.RLock()
+	defer w.RUnlock()
+	w.index.Dump(labels...)
+}
+
+func (w *rebuildableVectorIndex) Add(id uint64, vector []float32) error {
+	w.RLock()
+	defer w.RUnlock()
+
+	if w.reindex != nil {
+		// the object is vectorized by the job, its vector is only added to the
+		// current index if it still fits, e.g. it was vectorized by a module
+		// with different dimensions after the job was committed in the schema
+		w.reindex.enqueue(id)
+		if w.index.ValidateBeforeInsert(vector) != nil {
+			return nil
+		}
+	}
+
+	if err := w.index.Add(id, vector); err != nil {
+		return err
+	}
+	if w.rebuild != nil {
+		if err := w.rebuild.add(id, vector); err != nil {
+			w.rebuild.recordWriteErr(err)
+		}
+	}
+	return nil
+}
+
+func (w *rebuildableVectorIndex) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	w.RLock()
+	defer w.RUnlock()
+
+	if w.reindex != nil {
+		w.reindex.enqueue(ids...)
+		if len(vectors) > 0 && w.index.ValidateBeforeInsert(vectors[0]) != nil {
+			return nil
+		}
+	}
+
+	if err := w.index.AddBatch(ctx, ids, vectors); err != nil {
+		return err
+	}
+	if w.rebuild != nil {
+		for i := range ids {
+			if err := w.rebuild.add(ids[i], vectors[i]); err != nil {
+				w.rebuild.recordWriteErr(err)
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (w *rebuildableVectorIndex) Delete(ids ...uint64) error {
+	w.RLock()
+	defer w.RUnlock()
+
+	if err := w.index.Delete(ids...); err != nil {
+		return err
+	}
+	if w.rebuild != nil {
+		if err := w.rebuild.delete(ids...); err != nil {
+			w.rebuild.recordWriteErr(err)
+		}
+	}
+	if w.reindex != nil {
+		return w.reindex.delete(ids...)
+	}
+	if w.staged != nil && w.state.Staged {
+		for _, id := range ids {
+			if err := w.staged.delete(id); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (w *rebuildableVectorIndex) SearchByVector(vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	w.RLock()
+	defer w.RUnlock()
+	return w.index.SearchByVector(vector, k, allow)
+}
+
+func (w *rebuildableVectorIndex) SearchByVectorDistance(vector []float32, dist float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	w.RLock()
+	defer w.RUnlock()
+	return w.index.SearchByVectorDistance(vector, dist, maxLimit, allow)
+}
+
+func (w *rebuildableVectorIndex) UpdateUserConfig(updated schemaConfig.VectorIndexConfig,
+	callback func(),
+) error {
+	parsed, ok := updated.(hnswent.UserConfig)
+	if !ok {
+		callback()
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	w.Lock()
+	w.uc = parsed
+	index := w.index
+	w.Unlock()
+
+	if err := index.UpdateUserConfig(updated, callback); err != nil {
+		return err
+	}
+
+	w.reconcileRebuild()
+	return nil
+}
+
+func (w *rebuildableVectorIndex) Drop(ctx context.Context) error {
+	w.close()
+
+	w.RLock()
+	defer w.RUnlock()
+	if err := w.index.Drop(ctx); err != nil {
+		return err
+	}
+	if err := os.Remove(w.statePath); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove hnsw build state")
+	}
+	return nil
+}
+
+func (w *rebuildableVectorIndex) Shutdown(ctx context.Context) error {
+	w.close()
+
+	w.RLock()
+	defer w.RUnlock()
+	return w.index.Shutdown(ctx)
+}
+
+// close stops a running rebuild or vector reindexing job, they are started
+// again on the next startup
+func (w *rebuildableVectorIndex) close() {
+	w.rebuildLock.Lock()
+	w.closed = true
+	w.stopRebuild()
+	w.stopReindex(vectorreindex.StatusCancelled, "shard was shut down")
+	w.rebuildLock.Unlock()
+
+	// the staged vectors are written to the objects on the next startup. It is
+	// waited for without the rebuildLock, as finishing reconciles the rebuild.
+	w.RLock()
+	cancel, done := w.finishCancel, w.finishDone
+	w.RUnlock()
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+func (w *rebuildableVectorIndex) Flush() error {
+	w.RLock()
+	defer w.RUnlock()
+
+	if err := w.index.Flush(); err != nil {
+		return err
+	}
+	if w.rebuild != nil {
+		if err := w.rebuild.index.Flush(); err != nil {
+			return err
+		}
+	}
+	if w.reindex != nil {
+		return w.reindex.index.Flush()
+	}
+	return nil
+}
+
+func (w *rebuildableVectorIndex) SwitchCommitLogs(ctx context.Context) error {
+	w.RLock()
+	defer w.RUnlock()
+	return w.index.SwitchCommitLogs(ctx)
+}
+
+// ListFiles includes the build state, so a restored shard opens the same
+// generation of the graph
+func (w *rebuildableVectorIndex) ListFiles(ctx context.Context, basePath string) ([]string, error) {
+	w.RLock()
+	defer w.RUnlock()
+
+	files, err := w.index.ListFiles(ctx, basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	statePath, err := filepath.Rel(basePath, w.statePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "hnsw build state path")
+	}
+	return append(files, statePath), nil
+}
+
+func (w *rebuildableVectorIndex) PostStartup() {
+	w.RLock()
+	w.index.PostStartup()
+	w.RUnlock()
+
+	w.reconcileRebuild()
+}
+
+func (w *rebuildableVectorIndex) Compressed() bool {
+	w.RLock()
+	defer w.RUnlock()
+	return w.index.Compressed()
+}
+
+func (w *rebuildableVectorIndex) ValidateBeforeInsert(vector []float32) error {
+	w.RLock()
+	defer w.RUnlock()
+	return w.index.ValidateBeforeInsert(vector)
+}
+
+func (w *rebuildableVectorIndex) DistanceBetweenVectors(x, y []float32) (float32, bool, error) {
+	w.RLock()
+	defer w.RUnlock()
+	return w.index.DistanceBetweenVectors(x, y)
+}
+
+func (w *rebuildableVectorIndex) ContainsNode(id uint64) bool {
+	w.RLock()
+	defer w.RUnlock()
+	return w.index.ContainsNode(id)
+}
+
+func (w *rebuildableVectorIndex) AlreadyIndexed() uint64 {
+	w.RLock()
+	defer w.RUnlock()
+	return w.index.AlreadyIndexed()
+}
+
+func (w *rebuildableVectorIndex) DistancerProvider() distancer.Provider {
+	w.RLock()
+	defer w.RUnlock()
+	return w.index.DistancerProvider()
+}
+
+func (w *rebuildableVectorIndex) Upgraded() bool {
+	w.RLock()
+	defer w.RUnlock()
+	if upgradable, ok := w.index.(upgradableIndexer); ok {
+		return upgradable.Upgraded()
+	}
+	return false
+}
+
+func (w *rebuildableVectorIndex) Upgrade(callback func()) error {
+	w.RLock()
+	defer w.RUnlock()
+	if upgradable, ok := w.index.(upgradableIndexer); ok {
+		return upgradable.Upgrade(callback)
+	}
+	callback()
+	return nil
+}
+
+func (w *rebuildableVectorIndex) ShouldUpgrade() (bool, int) {
+	w.RLock()
+	defer w.RUnlock()
+	if upgradable, ok := w.index.(upgradableIndexer); ok {
+		return upgradable.ShouldUpgrade()
+	}
+	return false, 0
+}
@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestVectorIndexRebuild(t *testing.T) {
+	ctx := context.Background()
+	className := "RebuildClass"
+
+	uc := enthnsw.NewDefaultUserConfig()
+	uc.MaxConnections = 8
+	uc.EFConstruction = 32
+	shd, _ := testShardWithSettings(t, ctx, &models.Class{Class: className}, uc, false, false)
+	shard := loadedShard(t, shd)
+
+	objs := createRandomObjects(getRandomSeed(), className, 500, 16)
+	for _, err := range shard.PutObjectBatch(ctx, objs) {
+		require.Nil(t, err)
+	}
+
+	t.Run("build state is written for a new index", func(t *testing.T) {
+		state, ok, err := readHNSWBuildState(hnswBuildStatePath(shard.path(), "main"))
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, newHNSWBuildState(0, uc), state)
+		assert.Nil(t, shard.vectorIndexRebuildStatus())
+	})
+
+	updated := uc
+	updated.MaxConnections = 32
+	updated.EFConstruction = 128
+
+	t.Run("changing the build parameters rebuilds the graph", func(t *testing.T) {
+		require.Nil(t, shard.UpdateVectorIndexConfig(ctx, updated))
+
+		require.Eventually(t, func() bool {
+			status := shard.vectorIndexRebuildStatus()
+			return len(status) == 1 &&
+				status[0].Status == models.VectorIndexRebuildStatusStatusCOMPLETED
+		}, 30*time.Second, 10*time.Millisecond)
+
+		status := shard.vectorIndexRebuildStatus()[0]
+		assert.Equal(t, int64(len(objs)), status.ObjectsProcessed)
+		assert.Equal(t, int64(len(objs)), status.ObjectsTotal)
+		assert.Empty(t, status.TargetVector)
+
+		state, _, err := readHNSWBuildState(hnswBuildStatePath(shard.path(), "main"))
+		require.Nil(t, err)
+		assert.Equal(t, newHNSWBuildState(1, updated), state)
+
+		assert.NoDirExists(t, filepath.Join(shard.path(), "main.hnsw.commitlog.d"))
+		assert.DirExists(t, filepath.Join(shard.path(), "main.gen1.hnsw.commitlog.d"))
+	})
+
+	t.Run("the rebuilt graph contains all objects", func(t *testing.T) {
+		assert.Equal(t, uint64(len(objs)), shard.VectorIndex().AlreadyIndexed())
+		for _, obj := range objs[:20] {
+			ids, _, err := shard.VectorIndex().SearchByVector(obj.Vector, 1, nil)
+			require.Nil(t, err)
+			require.Len(t, ids, 1)
+			assert.Equal(t, obj.DocID, ids[0])
+		}
+	})
+
+	t.Run("writes are applied to the rebuilt graph", func(t *testing.T) {
+		more := createRandomObjects(getRandomSeed(), className, 10, 16)
+		for _, err := range shard.PutObjectBatch(ctx, more) {
+			require.Nil(t, err)
+		}
+
+		ids, _, err := shard.VectorIndex().SearchByVector(more[0].Vector, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{more[0].DocID}, ids)
+	})
+
+	t.Run("the build state is part of the backup", func(t *testing.T) {
+		files, err := shard.VectorIndex().ListFiles(ctx, shard.index.Config.RootPath)
+		require.Nil(t, err)
+
+		statePath, err := filepath.Rel(shard.index.Config.RootPath,
+			hnswBuildStatePath(shard.path(), "main"))
+		require.Nil(t, err)
+		assert.Contains(t, files, statePath)
+	})
+}
+
+func TestVectorIndexRebuildWithWrites(t *testing.T) {
+	ctx := context.Background()
+	className := "RebuildWritesClass"
+
+	uc := enthnsw.NewDefaultUserConfig()
+	shd, _ := testShardWithSettings(t, ctx, &models.Class{Class: className}, uc, false, false)
+	shard := loadedShard(t, shd)
+
+	objs := createRandomObjects(getRandomSeed(), className, 2000, 16)
+	for _, err := range shard.PutObjectBatch(ctx, objs[:1000]) {
+		require.Nil(t, err)
+	}
+
+	updated := uc
+	updated.MaxConnections = 16
+	require.Nil(t, shard.UpdateVectorIndexConfig(ctx, updated))
+
+	// written and deleted while the graph is rebuilt
+	for _, err := range shard.PutObjectBatch(ctx, objs[1000:]) {
+		require.Nil(t, err)
+	}
+	for _, obj := range objs[:100] {
+		require.Nil(t, shard.DeleteObject(ctx, obj.ID(), nil))
+	}
+
+	require.Eventually(t, func() bool {
+		status := shard.vectorIndexRebuildStatus()
+		return len(status) == 1 &&
+			status[0].Status == models.VectorIndexRebuildStatusStatusCOMPLETED
+	}, 30*time.Second, 10*time.Millisecond)
+
+	index := shard.VectorIndex()
+	for _, obj := range objs[:100] {
+		ids, _, err := index.SearchByVector(obj.Vector, 1, nil)
+		require.Nil(t, err)
+		assert.NotContains(t, ids, obj.DocID)
+	}
+	for _, obj := range objs[100:] {
+		assert.True(t, index.ContainsNode(obj.DocID))
+	}
+}
+
+func loadedShard(t *testing.T, shd ShardLike) *Shard {
+	lazy, ok := shd.(*LazyLoadShard)
+	if !ok {
+		return shd.(*Shard)
+	}
+	require.Nil(t, lazy.Load(context.Background()))
+	return lazy.shard
+}
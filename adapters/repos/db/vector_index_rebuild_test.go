@@ -0,0 +1,51 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveStaleHNSWGenerations(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"main.hnsw.commitlog.d",
+		"main.hnsw.snapshot.d",
+		"main.gen1.hnsw.commitlog.d",
+		"main.gen2.hnsw.commitlog.d",
+		"vectors_main.hnsw.commitlog.d",
+		"lsm",
+	} {
+		require.Nil(t, os.Mkdir(filepath.Join(dir, name), 0o777))
+	}
+	require.Nil(t, writeHNSWBuildState(hnswBuildStatePath(dir, "main"), hnswBuildState{Generation: 1}))
+
+	require.Nil(t, removeStaleHNSWGenerations(dir, "main", 1))
+
+	entries, err := os.ReadDir(dir)
+	require.Nil(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.ElementsMatch(t, []string{
+		"main.gen1.hnsw.commitlog.d",
+		"main.hnsw-build.json",
+		"vectors_main.hnsw.commitlog.d",
+		"lsm",
+	}, names)
+}
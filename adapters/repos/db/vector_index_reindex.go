@@ -0,0 +1,1164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorreindex"
+)
+
+const (
+	// vectorReindexPollInterval is the interval in which a shard which is
+	// ready to be committed vectorizes the objects written in the meantime
+	vectorReindexPollInterval = 500 * time.Millisecond
+
+	// vectorReindexMaxAttempts is the number of times a batch is sent to the
+	// vectorizer before the job fails, e.g. because of rate limits of an API
+	vectorReindexMaxAttempts = 5
+)
+
+// vectorReindexBucket is the bucket which holds the vectors computed by a
+// vector reindexing job, until they are written to the objects
+func vectorReindexBucket(targetVector string) string {
+	if targetVector == "" {
+		return "reindex_vectors"
+	}
+	return "reindex_vectors_" + targetVector
+}
+
+// pendingVectorMarker is stored instead of a vector for objects which were
+// written right before the job was committed and have not been vectorized
+// yet
+var pendingVectorMarker = []byte{0}
+
+// stagedVectors are the vectors of an index built by a vector reindexing
+// job. The index reads them from the reindex bucket, as the objects still
+// contain the previous vectors which are used by the current index. Once the
+// vectors are written to the objects, the bucket is not read anymore.
+type stagedVectors struct {
+	enabled atomic.Bool
+	store   *lsmkv.Store
+	bucket  string
+}
+
+func newStagedVectors(store *lsmkv.Store, bucket string) *stagedVectors {
+	s := &stagedVectors{store: store, bucket: bucket}
+	s.enabled.Store(true)
+	return s
+}
+
+func docIDKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+func vectorToBytes(vector []float32) []byte {
+	out := make([]byte, 4*len(vector))
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(out[i*4:], math.Float32bits(v))
+	}
+	return out
+}
+
+func vectorFromBytes(data []byte, into []float32) []float32 {
+	n := len(data) / 4
+	if cap(into) < n {
+		into = make([]float32, n)
+	}
+	into = into[:n]
+	for i := range into {
+		into[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:]))
+	}
+	return into
+}
+
+// get returns nil if the vector is not staged, or was staged as pending
+func (s *stagedVectors) get(id uint64, into []float32) ([]float32, error) {
+	if !s.enabled.Load() {
+		return nil, nil
+	}
+	bucket := s.store.Bucket(s.bucket)
+	if bucket == nil {
+		return nil, nil
+	}
+	data, err := bucket.Get(docIDKey(id))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 4 {
+		return nil, nil
+	}
+	return vectorFromBytes(data, into), nil
+}
+
+func (s *stagedVectors) put(id uint64, vector []float32) error {
+	return s.store.Bucket(s.bucket).Put(docIDKey(id), vectorToBytes(vector))
+}
+
+func (s *stagedVectors) markPending(id uint64) error {
+	return s.store.Bucket(s.bucket).Put(docIDKey(id), pendingVectorMarker)
+}
+
+func (s *stagedVectors) delete(id uint64) error {
+	return s.store.Bucket(s.bucket).Delete(docIDKey(id))
+}
+
+// scan calls fn with the staged entries in batches, the cursor is not held
+// while fn runs
+func (s *stagedVectors) scan(ctx context.Context, fn func(ids []uint64, values [][]byte) error) error {
+	bucket := s.store.Bucket(s.bucket)
+
+	var lastKey []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		cursor, err := bucket.Cursor()
+		if err != nil {
+			return err
+		}
+		var k, v []byte
+		if lastKey == nil {
+			k, v = cursor.First()
+		} else {
+			k, v = cursor.Seek(lastKey)
+			if k != nil && string(k) == string(lastKey) {
+				k, v = cursor.Next()
+			}
+		}
+
+		ids := make([]uint64, 0, rebuildScanBatchSize)
+		values := make([][]byte, 0, rebuildScanBatchSize)
+		for ; k != nil && len(ids) < rebuildScanBatchSize; k, v = cursor.Next() {
+			ids = append(ids, binary.BigEndian.Uint64(k))
+			values = append(values, append([]byte(nil), v...))
+			lastKey = append(lastKey[:0], k...)
+		}
+		cursor.Close()
+
+		if len(ids) > 0 {
+			if err := fn(ids, values); err != nil {
+				return err
+			}
+		}
+		if len(ids) < rebuildScanBatchSize {
+			return nil
+		}
+	}
+}
+
+// clear removes all staged vectors, e.g. after a job was cancelled
+func (s *stagedVectors) clear(ctx context.Context) error {
+	return s.scan(ctx, func(ids []uint64, _ [][]byte) error {
+		for _, id := range ids {
+			if err := s.delete(id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// vectorReindex recomputes the vectors of a shard into a new index. Writes
+// to the shard are vectorized by the job as well, until it is committed and
+// the new index replaces the current one.
+type vectorReindex struct {
+	job        vectorreindex.Job
+	class      *models.Class
+	vectorizer vectorreindex.Vectorizer
+	limiter    *rate.Limiter
+
+	index   VectorIndex
+	staged  *stagedVectors
+	next    hnswBuildState
+	cancel  context.CancelFunc
+	done    chan struct{}
+	started time.Time
+	total   int64
+
+	processed atomic.Int64
+	ready     atomic.Bool
+	commit    chan struct{}
+	commitReq sync.Once
+
+	jobPause
+
+	// ids are locked while added, so an id added by the job and by a
+	// concurrent write is only inserted once
+	locks     *common.ShardedLocks
+	deletedMu sync.Mutex
+	deleted   map[uint64]struct{}
+
+	// pending are the objects written since the job was started, they are
+	// vectorized by the job with the vectorizer of the job
+	pendingMu sync.Mutex
+	pending   map[uint64]struct{}
+}
+
+// jobPause blocks a job between batches while it is paused
+type jobPause struct {
+	pauseLock sync.Mutex
+	paused    bool
+	resumed   chan struct{}
+}
+
+func (p *jobPause) setPaused(paused bool) {
+	p.pauseLock.Lock()
+	defer p.pauseLock.Unlock()
+
+	if paused == p.paused {
+		return
+	}
+	p.paused = paused
+	if paused {
+		p.resumed = make(chan struct{})
+	} else {
+		close(p.resumed)
+	}
+}
+
+func (p *jobPause) isPaused() bool {
+	p.pauseLock.Lock()
+	defer p.pauseLock.Unlock()
+	return p.paused
+}
+
+func (p *jobPause) waitIfPaused(ctx context.Context) error {
+	p.pauseLock.Lock()
+	resumed := p.resumed
+	paused := p.paused
+	p.pauseLock.Unlock()
+
+	if !paused {
+		return nil
+	}
+	select {
+	case <-resumed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *vectorReindex) requestCommit() {
+	r.commitReq.Do(func() { close(r.commit) })
+}
+
+func (r *vectorReindex) enqueue(ids ...uint64) {
+	r.pendingMu.Lock()
+	defer r.pendingMu.Unlock()
+	for _, id := range ids {
+		r.pending[id] = struct{}{}
+	}
+}
+
+func (r *vectorReindex) takePending() []uint64 {
+	r.pendingMu.Lock()
+	defer r.pendingMu.Unlock()
+
+	ids := make([]uint64, 0, len(r.pending))
+	for id := range r.pending {
+		ids = append(ids, id)
+	}
+	r.pending = map[uint64]struct{}{}
+	return ids
+}
+
+func (r *vectorReindex) add(id uint64, vector []float32) error {
+	r.locks.Lock(id)
+	defer r.locks.Unlock(id)
+
+	r.deletedMu.Lock()
+	_, deleted := r.deleted[id]
+	r.deletedMu.Unlock()
+	if deleted || r.index.ContainsNode(id) {
+		return nil
+	}
+	if err := r.staged.put(id, vector); err != nil {
+		return err
+	}
+	return r.index.Add(id, vector)
+}
+
+func (r *vectorReindex) delete(ids ...uint64) error {
+	// mark the ids as deleted first, so objects read by the job before they
+	// were deleted are not added afterwards
+	for _, id := range ids {
+		r.locks.Lock(id)
+		r.deletedMu.Lock()
+		r.deleted[id] = struct{}{}
+		r.deletedMu.Unlock()
+		r.locks.Unlock(id)
+	}
+
+	r.pendingMu.Lock()
+	for _, id := range ids {
+		delete(r.pending, id)
+	}
+	r.pendingMu.Unlock()
+
+	for _, id := range ids {
+		if err := r.staged.delete(id); err != nil {
+			return err
+		}
+	}
+	return r.index.Delete(ids...)
+}
+
+// vectorize sends the objects to the vectorizer of the job, respecting the
+// rate limit of the job. Failed batches are retried with a backoff.
+func (r *vectorReindex) vectorize(ctx context.Context, objs []*storobj.Object) ([][]float32, error) {
+	return vectorizeForReindex(ctx, r.vectorizer, r.class, r.job.TargetVector, r.limiter, objs)
+}
+
+func vectorizeForReindex(ctx context.Context, vectorizer vectorreindex.Vectorizer, class *models.Class,
+	targetVector string, limiter *rate.Limiter, objs []*storobj.Object,
+) ([][]float32, error) {
+	if limiter != nil {
+		if err := limiter.WaitN(ctx, len(objs)); err != nil {
+			return nil, err
+		}
+	}
+
+	backoff := time.Second
+	for attempt := 1; ; attempt++ {
+		in := make([]*models.Object, len(objs))
+		for i, obj := range objs {
+			in[i] = &models.Object{
+				Class:      obj.Class().String(),
+				ID:         obj.ID(),
+				Properties: obj.Properties(),
+				Tenant:     obj.Object.Tenant,
+			}
+		}
+
+		errs, err := vectorizer.ReindexVectors(ctx, class, targetVector, in)
+		if err == nil {
+			for i, vecErr := range errs {
+				err = errors.Wrapf(vecErr, "vectorize object %s", in[i].ID)
+				break
+			}
+		}
+		if err == nil {
+			vectors := make([][]float32, len(in))
+			for i, obj := range in {
+				if targetVector == "" {
+					vectors[i] = obj.Vector
+				} else {
+					vectors[i] = obj.Vectors[targetVector]
+				}
+			}
+			return vectors, nil
+		}
+
+		if attempt == vectorReindexMaxAttempts || ctx.Err() != nil {
+			return nil, err
+		}
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (r *vectorReindex) shardStatus() *vectorreindex.ShardStatus {
+	status := vectorreindex.StatusRunning
+	if r.isPaused() {
+		status = vectorreindex.StatusPaused
+	}
+	if r.ready.Load() {
+		status = vectorreindex.StatusReady
+	}
+
+	return &vectorreindex.ShardStatus{
+		Status:           status,
+		ObjectsProcessed: r.processed.Load(),
+		ObjectsTotal:     r.total,
+	}
+}
+
+// reconcileReindex starts, pauses, commits or stops the reindexing of the
+// index to match the job stored in the schema. class is the class as it is
+// stored in the schema.
+func (w *rebuildableVectorIndex) reconcileReindex(job vectorreindex.Job, class *models.Class,
+	vectorizer vectorreindex.Vectorizer, limiter *rate.Limiter,
+) {
+	w.rebuildLock.Lock()
+	defer w.rebuildLock.Unlock()
+
+	if w.closed {
+		return
+	}
+
+	w.RLock()
+	r := w.reindex
+	last := w.lastReindex
+	staged := w.state.Staged
+	reindexing := w.state.Reindexing
+	w.RUnlock()
+
+	if staged {
+		// the node was stopped before the vectors of the last job were written
+		// to the objects. The class uses the vectorizer of that job already.
+		w.startFinishing(class, vectorizer)
+	}
+
+	if r != nil && r.job.Version != job.Version {
+		w.stopReindex(vectorreindex.StatusCancelled, "")
+		r = nil
+	}
+
+	switch job.Status {
+	case vectorreindex.StatusRunning, vectorreindex.StatusPaused:
+		if r == nil {
+			if staged || (last != nil && last.version == job.Version) {
+				return
+			}
+			if r = w.tryStartReindex(job, job.ApplyTo(class), vectorizer, limiter); r == nil {
+				return
+			}
+		}
+		r.setPaused(job.Status == vectorreindex.StatusPaused)
+	case vectorreindex.StatusCompleted:
+		if r == nil && reindexing == job.Version && !staged {
+			// the node was stopped after the job was ready, but before it was
+			// committed. The class uses the new vectorizer already, so the
+			// vectors are computed again and committed right away.
+			r = w.tryStartReindex(job, class, vectorizer, limiter)
+		}
+		if r != nil {
+			// the index is replaced as soon as all objects are vectorized
+			r.requestCommit()
+		}
+	default:
+		if r != nil {
+			w.stopReindex(job.Status, job.Error)
+		}
+	}
+
+	w.RLock()
+	stopped := w.reindex == nil
+	w.RUnlock()
+	if stopped && reindexing != 0 {
+		// the job which was started before was stopped, or doesn't exist
+		// anymore
+		if err := w.setReindexing(0); err != nil {
+			w.logger.WithField("action", "vector_reindex").WithError(err).
+				Error("failed to reset vector reindexing state")
+		}
+	}
+}
+
+// tryStartReindex records the error as the result of the job if it fails to
+// start, and returns nil in this case
+func (w *rebuildableVectorIndex) tryStartReindex(job vectorreindex.Job, class *models.Class,
+	vectorizer vectorreindex.Vectorizer, limiter *rate.Limiter,
+) *vectorReindex {
+	if err := w.startReindex(job, class, vectorizer, limiter); err != nil {
+		w.logger.WithField("action", "vector_reindex").WithError(err).
+			Error("failed to start vector reindexing")
+		w.Lock()
+		w.lastReindex = &lastVectorReindex{
+			version: job.Version,
+			status: vectorreindex.ShardStatus{
+				Status: vectorreindex.StatusFailed,
+				Error:  err.Error(),
+			},
+		}
+		w.Unlock()
+		return nil
+	}
+
+	w.RLock()
+	defer w.RUnlock()
+	return w.reindex
+}
+
+// setReindexing persists the version of the job which builds the next graph
+func (w *rebuildableVectorIndex) setReindexing(version uint64) error {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.state.Reindexing == version {
+		return nil
+	}
+	state := w.state
+	state.Reindexing = version
+	if err := writeHNSWBuildState(w.statePath, state); err != nil {
+		return err
+	}
+	w.state = state
+	return nil
+}
+
+// lastVectorReindex is the result of the last job of the index
+type lastVectorReindex struct {
+	version uint64
+	status  vectorreindex.ShardStatus
+}
+
+// startReindex must be called with the rebuildLock held
+func (w *rebuildableVectorIndex) startReindex(job vectorreindex.Job, class *models.Class,
+	vectorizer vectorreindex.Vectorizer, limiter *rate.Limiter,
+) error {
+	// the new index is built with the current parameters, a running rebuild
+	// isn't needed anymore
+	w.stopRebuild()
+
+	bucket := vectorReindexBucket(w.targetVector)
+	if err := w.store.CreateOrLoadBucket(context.Background(), bucket,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace)); err != nil {
+		return errors.Wrap(err, "create reindex bucket")
+	}
+	staged := newStagedVectors(w.store, bucket)
+	if err := staged.clear(context.Background()); err != nil {
+		return errors.Wrap(err, "clear reindex bucket")
+	}
+
+	w.RLock()
+	uc := w.uc
+	next := newHNSWBuildState(w.state.Generation+1, uc)
+	w.RUnlock()
+	next.Staged = true
+
+	if err := w.setReindexing(job.Version); err != nil {
+		return err
+	}
+	index, err := w.newIndex(next.indexID(w.vecIdxID), uc, staged)
+	if err != nil {
+		return errors.Wrap(err, "create index")
+	}
+
+	batchSize := job.BatchSize
+	if batchSize <= 0 {
+		batchSize = vectorreindex.DefaultBatchSize
+	}
+	job.BatchSize = batchSize
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &vectorReindex{
+		job:        job,
+		class:      class,
+		vectorizer: vectorizer,
+		limiter:    limiter,
+		index:      index,
+		staged:     staged,
+		next:       next,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+		started:    time.Now(),
+		total:      int64(w.store.Bucket(helpers.ObjectsBucketLSM).Count()),
+		commit:     make(chan struct{}),
+		locks:      common.NewDefaultShardedLocks(),
+		deleted:    map[uint64]struct{}{},
+		pending:    map[uint64]struct{}{},
+	}
+
+	// from now on all writes are vectorized by the job as well, anything
+	// written before is found in the objects bucket
+	w.Lock()
+	w.reindex = r
+	w.lastReindex = nil
+	w.Unlock()
+
+	w.logger.WithFields(logrus.Fields{
+		"action":     "vector_reindex",
+		"generation": next.Generation,
+		"vectorizer": job.Vectorizer,
+	}).Info("started reindexing vectors")
+
+	enterrors.GoWrapper(func() { w.runReindex(ctx, r) }, w.logger)
+	return nil
+}
+
+// stopReindex must be called with the rebuildLock held
+func (w *rebuildableVectorIndex) stopReindex(status, errMsg string) {
+	w.Lock()
+	r := w.reindex
+	w.reindex = nil
+	if r != nil {
+		last := r.shardStatus()
+		last.Status = status
+		last.Error = errMsg
+		w.lastReindex = &lastVectorReindex{version: r.job.Version, status: *last}
+	}
+	w.Unlock()
+
+	if r == nil {
+		return
+	}
+
+	r.cancel()
+	<-r.done
+	w.dropReindex(r)
+}
+
+func (w *rebuildableVectorIndex) dropReindex(r *vectorReindex) {
+	if err := r.index.Drop(context.Background()); err != nil {
+		w.logger.WithField("action", "vector_reindex").WithError(err).
+			Error("failed to drop partially reindexed vector index")
+	}
+	if err := r.staged.clear(context.Background()); err != nil {
+		w.logger.WithField("action", "vector_reindex").WithError(err).
+			Error("failed to remove staged vectors")
+	}
+}
+
+func (w *rebuildableVectorIndex) runReindex(ctx context.Context, r *vectorReindex) {
+	defer close(r.done)
+
+	err := w.fillReindex(ctx, r)
+	if err == nil {
+		err = w.compress(ctx, r.index)
+	}
+	if err == nil {
+		r.ready.Store(true)
+		err = w.awaitReindexCommit(ctx, r)
+	}
+	if err == nil {
+		err = w.commitReindex(ctx, r)
+	}
+	if err == nil || ctx.Err() != nil {
+		// a stopped job is cleaned up by whoever stopped it
+		return
+	}
+
+	w.logger.WithField("action", "vector_reindex").WithError(err).
+		Error("reindexing vectors failed")
+
+	w.Lock()
+	owned := w.reindex == r
+	if owned {
+		w.reindex = nil
+		last := r.shardStatus()
+		last.Status = vectorreindex.StatusFailed
+		last.Error = err.Error()
+		w.lastReindex = &lastVectorReindex{version: r.job.Version, status: *last}
+	}
+	w.Unlock()
+
+	if owned {
+		w.dropReindex(r)
+	}
+}
+
+// fillReindex vectorizes all objects of the shard with the vectorizer of the
+// job and adds them to the new index
+func (w *rebuildableVectorIndex) fillReindex(ctx context.Context, r *vectorReindex) error {
+	return scanObjects(ctx, w.store, func(objs []*storobj.Object) error {
+		for start := 0; start < len(objs); start += r.job.BatchSize {
+			if err := r.waitIfPaused(ctx); err != nil {
+				return err
+			}
+			end := start + r.job.BatchSize
+			if end > len(objs) {
+				end = len(objs)
+			}
+			if err := w.reindexObjects(ctx, r, objs[start:end]); err != nil {
+				return err
+			}
+			r.processed.Add(int64(end - start))
+		}
+		return nil
+	})
+}
+
+func (w *rebuildableVectorIndex) reindexObjects(ctx context.Context, r *vectorReindex,
+	objs []*storobj.Object,
+) error {
+	vectors, err := r.vectorize(ctx, objs)
+	if err != nil {
+		return err
+	}
+	for i, obj := range objs {
+		if len(vectors[i]) == 0 {
+			continue
+		}
+		if err := r.add(obj.DocID, vectors[i]); err != nil {
+			return errors.Wrapf(err, "add node %d", obj.DocID)
+		}
+	}
+	return nil
+}
+
+// awaitReindexCommit vectorizes the objects written in the meantime until
+// the job is committed
+func (w *rebuildableVectorIndex) awaitReindexCommit(ctx context.Context, r *vectorReindex) error {
+	ticker := time.NewTicker(vectorReindexPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.reindexPending(ctx, r); err != nil {
+			return err
+		}
+
+		select {
+		case <-r.commit:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *rebuildableVectorIndex) reindexPending(ctx context.Context, r *vectorReindex) error {
+	ids := r.takePending()
+	for start := 0; start < len(ids); start += r.job.BatchSize {
+		end := start + r.job.BatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		objs, err := w.objectsByDocID(ctx, ids[start:end])
+		if err != nil {
+			return err
+		}
+		if len(objs) == 0 {
+			continue
+		}
+		if err := w.reindexObjects(ctx, r, objs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// objectsByDocID skips objects which were deleted in the meantime
+func (w *rebuildableVectorIndex) objectsByDocID(ctx context.Context, ids []uint64) ([]*storobj.Object, error) {
+	objs := make([]*storobj.Object, 0, len(ids))
+	for _, id := range ids {
+		obj, err := w.shard.objectByIndexID(ctx, id, false)
+		if err != nil {
+			if errors.As(err, &storobj.ErrNotFound{}) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "get object %d", id)
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+// commitReindex replaces the current index with the new one. Objects which
+// were written right before and are not vectorized yet are stored as pending
+// and vectorized afterwards, together with writing the vectors to the
+// objects.
+func (w *rebuildableVectorIndex) commitReindex(ctx context.Context, r *vectorReindex) error {
+	// keep the time the index is locked short
+	if err := w.reindexPending(ctx, r); err != nil {
+		return err
+	}
+
+	w.RLock()
+	uc := w.uc
+	w.RUnlock()
+
+	if err := r.index.UpdateUserConfig(uc, func() {}); err != nil {
+		return errors.Wrap(err, "update user config")
+	}
+	if err := r.index.Flush(); err != nil {
+		return errors.Wrap(err, "flush")
+	}
+
+	w.Lock()
+	if w.reindex != r {
+		w.Unlock()
+		return nil
+	}
+	for _, id := range r.takePending() {
+		if err := r.staged.markPending(id); err != nil {
+			w.Unlock()
+			return errors.Wrap(err, "mark pending object")
+		}
+	}
+	if err := writeHNSWBuildState(w.statePath, r.next); err != nil {
+		w.Unlock()
+		return err
+	}
+
+	previous := w.index
+	w.index = r.index
+	w.state = r.next
+	w.staged = r.staged
+	w.reindex = nil
+	last := r.shardStatus()
+	last.Status = vectorreindex.StatusCompleted
+	w.lastReindex = &lastVectorReindex{version: r.job.Version, status: *last}
+	w.Unlock()
+
+	w.logger.WithFields(logrus.Fields{
+		"action":     "vector_reindex",
+		"generation": r.next.Generation,
+		"objects":    last.ObjectsProcessed,
+		"took":       time.Since(r.started),
+	}).Info("replaced vector index with reindexed one")
+
+	if err := previous.Drop(context.Background()); err != nil {
+		w.logger.WithField("action", "vector_reindex").WithError(err).
+			Error("failed to drop previous vector index")
+	}
+
+	w.startFinishing(r.class, r.vectorizer)
+	return nil
+}
+
+// startFinishing writes the staged vectors to the objects in the background,
+// unless this is running already
+func (w *rebuildableVectorIndex) startFinishing(class *models.Class, vectorizer vectorreindex.Vectorizer) {
+	w.Lock()
+	defer w.Unlock()
+
+	if w.finishDone != nil || !w.state.Staged {
+		return
+	}
+	if w.staged == nil {
+		w.staged = newStagedVectors(w.store, vectorReindexBucket(w.targetVector))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	w.finishCancel = cancel
+	w.finishDone = done
+	staged := w.staged
+
+	enterrors.GoWrapper(func() {
+		defer close(done)
+		err := w.finishReindex(ctx, staged, class, vectorizer)
+
+		w.Lock()
+		w.finishCancel = nil
+		w.finishDone = nil
+		w.Unlock()
+
+		if err != nil {
+			if ctx.Err() == nil {
+				w.logger.WithField("action", "vector_reindex").WithError(err).
+					Error("failed to write reindexed vectors to objects")
+			}
+			return
+		}
+		w.reconcileRebuild()
+	}, w.logger)
+}
+
+// finishReindex vectorizes the pending objects and writes the staged vectors
+// to the objects. Afterwards the index reads the vectors from the objects.
+func (w *rebuildableVectorIndex) finishReindex(ctx context.Context, staged *stagedVectors,
+	class *models.Class, vectorizer vectorreindex.Vectorizer,
+) error {
+	err := staged.scan(ctx, func(ids []uint64, values [][]byte) error {
+		var pending []uint64
+		for i, value := range values {
+			if len(value) < 4 {
+				pending = append(pending, ids[i])
+			}
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		objs, err := w.objectsByDocID(ctx, pending)
+		if err != nil {
+			return err
+		}
+		if len(objs) == 0 {
+			return nil
+		}
+		vectors, err := vectorizeForReindex(ctx, vectorizer, class, w.targetVector, nil, objs)
+		if err != nil {
+			return err
+		}
+
+		w.RLock()
+		index := w.index
+		w.RUnlock()
+		for i, obj := range objs {
+			if len(vectors[i]) == 0 {
+				continue
+			}
+			if err := staged.put(obj.DocID, vectors[i]); err != nil {
+				return err
+			}
+			if !index.ContainsNode(obj.DocID) {
+				if err := index.Add(obj.DocID, vectors[i]); err != nil {
+					return errors.Wrapf(err, "add node %d", obj.DocID)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "vectorize pending objects")
+	}
+
+	err = staged.scan(ctx, func(ids []uint64, values [][]byte) error {
+		for i, id := range ids {
+			if len(values[i]) >= 4 {
+				vector := vectorFromBytes(values[i], nil)
+				if err := w.shard.writeReindexedVector(ctx, id, w.targetVector, vector); err != nil {
+					return errors.Wrapf(err, "write vector of object %d", id)
+				}
+			}
+			if err := staged.delete(id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "write vectors to objects")
+	}
+
+	staged.enabled.Store(false)
+
+	w.Lock()
+	defer w.Unlock()
+	state := w.state
+	state.Staged = false
+	if err := writeHNSWBuildState(w.statePath, state); err != nil {
+		return err
+	}
+	w.state = state
+
+	w.logger.WithField("action", "vector_reindex").
+		Info("wrote reindexed vectors to objects")
+	return nil
+}
+
+// writeReindexedVector replaces the vector of an object in place, without
+// changing its doc id. Objects which were updated in the meantime already
+// contain a vector of the new vectorizer and are skipped.
+func (s *Shard) writeReindexedVector(ctx context.Context, docID uint64, targetVector string,
+	vector []float32,
+) error {
+	obj, err := s.objectByIndexID(ctx, docID, false)
+	if err != nil {
+		if errors.As(err, &storobj.ErrNotFound{}) {
+			return nil
+		}
+		return err
+	}
+
+	id, err := uuid.Parse(obj.ID().String())
+	if err != nil {
+		return err
+	}
+	idBytes, err := id.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	current, err := fetchObject(bucket, idBytes)
+	if err != nil {
+		return err
+	}
+	if current == nil || current.DocID != docID {
+		return nil
+	}
+
+	if targetVector == "" {
+		current.Vector = vector
+		current.VectorLen = len(vector)
+	} else {
+		if current.Vectors == nil {
+			current.Vectors = map[string][]float32{}
+		}
+		current.Vectors[targetVector] = vector
+	}
+
+	data, err := current.MarshalBinary()
+	if err != nil {
+		return errors.Wrapf(err, "marshal object %s", current.ID())
+	}
+	return s.upsertObjectDataLSM(bucket, idBytes, data, docID)
+}
+
+func (w *rebuildableVectorIndex) reindexStatus(version uint64) *vectorreindex.ShardStatus {
+	w.RLock()
+	defer w.RUnlock()
+
+	if w.reindex != nil && w.reindex.job.Version == version {
+		return w.reindex.shardStatus()
+	}
+	if w.lastReindex != nil && w.lastReindex.version == version {
+		status := w.lastReindex.status
+		return &status
+	}
+	return nil
+}
+
+// reconcileVectorReindex applies the vector reindexing job of the schema to
+// the vector index of its target vector
+func (s *Shard) reconcileVectorReindex(job vectorreindex.Job, class *models.Class,
+	vectorizer vectorreindex.Vectorizer, limiter *rate.Limiter,
+) {
+	if job.Backfill {
+		s.reconcileVectorBackfill(job, class, vectorizer, limiter)
+		return
+	}
+	if w, ok := s.reindexableVectorIndex(job.TargetVector); ok {
+		w.reconcileReindex(job, class, vectorizer, limiter)
+	}
+}
+
+// vectorReindexStatus returns nil if the shard didn't start the job yet
+func (s *Shard) vectorReindexStatus(job vectorreindex.Job) *vectorreindex.ShardStatus {
+	if job.Backfill {
+		return s.vectorBackfillStatus(job)
+	}
+
+	w, ok := s.reindexableVectorIndex(job.TargetVector)
+	if !ok {
+		return &vectorreindex.ShardStatus{
+			Shard:  s.name,
+			Status: vectorreindex.StatusFailed,
+			Error:  "vectors can only be reindexed for hnsw indexes",
+		}
+	}
+
+	status := w.reindexStatus(job.Version)
+	if status != nil {
+		status.Shard = s.name
+	}
+	return status
+}
+
+func (s *Shard) reindexableVectorIndex(targetVector string) (*rebuildableVectorIndex, bool) {
+	index := s.VectorIndex()
+	if targetVector != "" {
+		index = s.VectorIndexForName(targetVector)
+	}
+	w, ok := index.(*rebuildableVectorIndex)
+	return w, ok
+}
+
+// ReconcileVectorReindexes applies the vector reindexing jobs stored in the
+// schema to the local shards. jobs are keyed by class name.
+func (db *DB) ReconcileVectorReindexes(jobs map[string][]vectorreindex.Job, vectorizer vectorreindex.Vectorizer) {
+	active := map[string]struct{}{}
+	for className, classJobs := range jobs {
+		idx := db.GetIndex(schema.ClassName(className))
+		if idx == nil {
+			continue
+		}
+		class := db.schemaGetter.ReadOnlyClass(className)
+		if class == nil {
+			continue
+		}
+
+		for _, job := range classJobs {
+			var limiter *rate.Limiter
+			if !job.Finished() {
+				key := vectorReindexLimiterKey(className, job)
+				active[key] = struct{}{}
+				limiter = db.vectorReindexLimiter(key, job)
+			}
+
+			job := job
+			idx.ForEachShard(func(_ string, shard ShardLike) error {
+				shard.reconcileVectorReindex(job, class, vectorizer, limiter)
+				return nil
+			})
+		}
+	}
+
+	db.vectorReindexLock.Lock()
+	defer db.vectorReindexLock.Unlock()
+	for key := range db.vectorReindexLimiters {
+		if _, ok := active[key]; !ok {
+			delete(db.vectorReindexLimiters, key)
+		}
+	}
+}
+
+func vectorReindexLimiterKey(className string, job vectorreindex.Job) string {
+	return fmt.Sprintf("%s/%s/%d", className, job.TargetVector, job.Version)
+}
+
+func (db *DB) vectorReindexLimiter(key string, job vectorreindex.Job) *rate.Limiter {
+	if job.MaxObjectsPerSecond <= 0 {
+		return nil
+	}
+
+	db.vectorReindexLock.Lock()
+	defer db.vectorReindexLock.Unlock()
+
+	if db.vectorReindexLimiters == nil {
+		db.vectorReindexLimiters = map[string]*rate.Limiter{}
+	}
+	limiter, ok := db.vectorReindexLimiters[key]
+	if !ok {
+		// a whole batch has to fit into the bucket, or waiting for it fails
+		burst := job.BatchSize
+		if burst <= 0 {
+			burst = 100
+		}
+		limiter = rate.NewLimiter(rate.Limit(job.MaxObjectsPerSecond), burst)
+		db.vectorReindexLimiters[key] = limiter
+	}
+	return limiter
+}
+
+// VectorReindexStatus returns the progress of the job on the local shards.
+// Shards which didn't start the job yet are omitted.
+func (db *DB) VectorReindexStatus(className string, job vectorreindex.Job) *vectorreindex.NodeStatus {
+	status := &vectorreindex.NodeStatus{
+		Node:    db.schemaGetter.NodeName(),
+		Version: job.Version,
+		Shards:  []vectorreindex.ShardStatus{},
+	}
+
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return status
+	}
+
+	idx.ForEachShard(func(_ string, shard ShardLike) error {
+		if shardStatus := shard.vectorReindexStatus(job); shardStatus != nil {
+			status.Shards = append(status.Shards, *shardStatus)
+		}
+		return nil
+	})
+	sort.Slice(status.Shards, func(i, j int) bool {
+		return status.Shards[i].Shard < status.Shards[j].Shard
+	})
+	return status
+}
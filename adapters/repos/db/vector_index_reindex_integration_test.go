@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorreindex"
+)
+
+type fakeReindexVectorizer struct {
+	calls atomic.Int64
+}
+
+// fakeReindexVector derives a vector with 8 dimensions from the id, which
+// differs from the 16 dimensions of the random vectors of the test objects
+func fakeReindexVector(id strfmt.UUID) []float32 {
+	vector := make([]float32, 8)
+	for i, c := range id.String()[:8] {
+		vector[i] = float32(c)
+	}
+	return vector
+}
+
+func (f *fakeReindexVectorizer) ReindexVectors(ctx context.Context, class *models.Class,
+	targetVector string, objects []*models.Object,
+) (map[int]error, error) {
+	f.calls.Add(1)
+	for _, obj := range objects {
+		if targetVector == "" {
+			obj.Vector = fakeReindexVector(obj.ID)
+		} else {
+			obj.Vectors = models.Vectors{targetVector: fakeReindexVector(obj.ID)}
+		}
+	}
+	return nil, nil
+}
+
+func TestVectorReindex(t *testing.T) {
+	ctx := context.Background()
+	className := "ReindexClass"
+	class := &models.Class{Class: className, Vectorizer: "none"}
+
+	shd, _ := testShardWithSettings(t, ctx, class, enthnsw.NewDefaultUserConfig(), false, false)
+	shard := loadedShard(t, shd)
+
+	objs := createRandomObjects(getRandomSeed(), className, 300, 16)
+	for _, err := range shard.PutObjectBatch(ctx, objs) {
+		require.Nil(t, err)
+	}
+
+	vectorizer := &fakeReindexVectorizer{}
+	job := vectorreindex.Job{
+		Vectorizer: "fake",
+		BatchSize:  50,
+		Status:     vectorreindex.StatusRunning,
+		Version:    5,
+	}
+
+	t.Run("the job vectorizes all objects in batches", func(t *testing.T) {
+		shard.reconcileVectorReindex(job, class, vectorizer, nil)
+
+		require.Eventually(t, func() bool {
+			status := shard.vectorReindexStatus(job)
+			return status != nil && status.Status == vectorreindex.StatusReady
+		}, 30*time.Second, 10*time.Millisecond)
+
+		status := shard.vectorReindexStatus(job)
+		assert.Equal(t, int64(len(objs)), status.ObjectsProcessed)
+		assert.Equal(t, int64(len(objs)), status.ObjectsTotal)
+		assert.Equal(t, int64(len(objs)/50), vectorizer.calls.Load())
+	})
+
+	t.Run("the previous vectors are used until the job is committed", func(t *testing.T) {
+		ids, _, err := shard.VectorIndex().SearchByVector(objs[0].Vector, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{objs[0].DocID}, ids)
+	})
+
+	more := createRandomObjects(getRandomSeed(), className, 10, 16)
+	t.Run("objects written in the meantime are vectorized by the job", func(t *testing.T) {
+		for _, err := range shard.PutObjectBatch(ctx, more) {
+			require.Nil(t, err)
+		}
+		require.Nil(t, shard.DeleteObject(ctx, objs[1].ID(), nil))
+	})
+
+	t.Run("committing the job replaces the index and the vectors", func(t *testing.T) {
+		job.Status = vectorreindex.StatusCompleted
+		shard.reconcileVectorReindex(job, job.ApplyTo(class), vectorizer, nil)
+
+		require.Eventually(t, func() bool {
+			state, _, err := readHNSWBuildState(hnswBuildStatePath(shard.path(), "main"))
+			return err == nil && state.Generation == 1 && !state.Staged
+		}, 30*time.Second, 10*time.Millisecond)
+
+		assert.Equal(t, vectorreindex.StatusCompleted, shard.vectorReindexStatus(job).Status)
+
+		for _, obj := range append([]*storobj.Object{objs[0], objs[2]}, more...) {
+			vector := fakeReindexVector(obj.ID())
+			ids, _, err := shard.VectorIndex().SearchByVector(vector, 1, nil)
+			require.Nil(t, err)
+			assert.Equal(t, []uint64{obj.DocID}, ids)
+
+			found, err := shard.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+			require.Nil(t, err)
+			assert.Equal(t, vector, found.Vector)
+		}
+
+		ids, _, err := shard.VectorIndex().SearchByVector(fakeReindexVector(objs[1].ID()), 1, nil)
+		require.Nil(t, err)
+		assert.NotContains(t, ids, objs[1].DocID)
+	})
+}
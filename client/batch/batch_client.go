@@ -45,6 +45,8 @@ type ClientService interface {
 
 	BatchObjectsDelete(params *BatchObjectsDeleteParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchObjectsDeleteOK, error)
 
+	BatchObjectsUpdate(params *BatchObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchObjectsUpdateOK, error)
+
 	BatchReferencesCreate(params *BatchReferencesCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchReferencesCreateOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -132,6 +134,47 @@ func (a *Client) BatchObjectsDelete(params *BatchObjectsDeleteParams, authInfo r
 	panic(msg)
 }
 
+/*
+BatchObjectsUpdate updates objects based on a match filter as a batch
+
+Partially update Objects in bulk that match a certain filter. Properties which are not mentioned keep their values.
+*/
+func (a *Client) BatchObjectsUpdate(params *BatchObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*BatchObjectsUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBatchObjectsUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "batch.objects.update",
+		Method:             "PATCH",
+		PathPattern:        "/batch/objects",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &BatchObjectsUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*BatchObjectsUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for batch.objects.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 BatchReferencesCreate creates new cross references between arbitrary classes in bulk
 
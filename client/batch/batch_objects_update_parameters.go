@@ -0,0 +1,229 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batch
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewBatchObjectsUpdateParams creates a new BatchObjectsUpdateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewBatchObjectsUpdateParams() *BatchObjectsUpdateParams {
+	return &BatchObjectsUpdateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewBatchObjectsUpdateParamsWithTimeout creates a new BatchObjectsUpdateParams object
+// with the ability to set a timeout on a request.
+func NewBatchObjectsUpdateParamsWithTimeout(timeout time.Duration) *BatchObjectsUpdateParams {
+	return &BatchObjectsUpdateParams{
+		timeout: timeout,
+	}
+}
+
+// NewBatchObjectsUpdateParamsWithContext creates a new BatchObjectsUpdateParams object
+// with the ability to set a context for a request.
+func NewBatchObjectsUpdateParamsWithContext(ctx context.Context) *BatchObjectsUpdateParams {
+	return &BatchObjectsUpdateParams{
+		Context: ctx,
+	}
+}
+
+// NewBatchObjectsUpdateParamsWithHTTPClient creates a new BatchObjectsUpdateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewBatchObjectsUpdateParamsWithHTTPClient(client *http.Client) *BatchObjectsUpdateParams {
+	return &BatchObjectsUpdateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+BatchObjectsUpdateParams contains all the parameters to send to the API endpoint
+
+	for the batch objects update operation.
+
+	Typically these are written to a http.Request.
+*/
+type BatchObjectsUpdateParams struct {
+
+	// Body.
+	Body *models.BatchUpdate
+
+	/* ConsistencyLevel.
+
+	   Determines how many replicas must acknowledge a request before it is considered successful
+	*/
+	ConsistencyLevel *string
+
+	/* Tenant.
+
+	   Specifies the tenant in a request targeting a multi-tenant class
+	*/
+	Tenant *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the batch objects update params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *BatchObjectsUpdateParams) WithDefaults() *BatchObjectsUpdateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the batch objects update params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *BatchObjectsUpdateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the batch objects update params
+func (o *BatchObjectsUpdateParams) WithTimeout(timeout time.Duration) *BatchObjectsUpdateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the batch objects update params
+func (o *BatchObjectsUpdateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the batch objects update params
+func (o *BatchObjectsUpdateParams) WithContext(ctx context.Context) *BatchObjectsUpdateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the batch objects update params
+func (o *BatchObjectsUpdateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the batch objects update params
+func (o *BatchObjectsUpdateParams) WithHTTPClient(client *http.Client) *BatchObjectsUpdateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the batch objects update params
+func (o *BatchObjectsUpdateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the batch objects update params
+func (o *BatchObjectsUpdateParams) WithBody(body *models.BatchUpdate) *BatchObjectsUpdateParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the batch objects update params
+func (o *BatchObjectsUpdateParams) SetBody(body *models.BatchUpdate) {
+	o.Body = body
+}
+
+// WithConsistencyLevel adds the consistencyLevel to the batch objects update params
+func (o *BatchObjectsUpdateParams) WithConsistencyLevel(consistencyLevel *string) *BatchObjectsUpdateParams {
+	o.SetConsistencyLevel(consistencyLevel)
+	return o
+}
+
+// SetConsistencyLevel adds the consistencyLevel to the batch objects update params
+func (o *BatchObjectsUpdateParams) SetConsistencyLevel(consistencyLevel *string) {
+	o.ConsistencyLevel = consistencyLevel
+}
+
+// WithTenant adds the tenant to the batch objects update params
+func (o *BatchObjectsUpdateParams) WithTenant(tenant *string) *BatchObjectsUpdateParams {
+	o.SetTenant(tenant)
+	return o
+}
+
+// SetTenant adds the tenant to the batch objects update params
+func (o *BatchObjectsUpdateParams) SetTenant(tenant *string) {
+	o.Tenant = tenant
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *BatchObjectsUpdateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if o.ConsistencyLevel != nil {
+
+		// query param consistency_level
+		var qrConsistencyLevel string
+
+		if o.ConsistencyLevel != nil {
+			qrConsistencyLevel = *o.ConsistencyLevel
+		}
+		qConsistencyLevel := qrConsistencyLevel
+		if qConsistencyLevel != "" {
+
+			if err := r.SetQueryParam("consistency_level", qConsistencyLevel); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Tenant != nil {
+
+		// query param tenant
+		var qrTenant string
+
+		if o.Tenant != nil {
+			qrTenant = *o.Tenant
+		}
+		qTenant := qrTenant
+		if qTenant != "" {
+
+			if err := r.SetQueryParam("tenant", qTenant); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package batch
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BatchObjectsUpdateReader is a Reader for the BatchObjectsUpdate structure.
+type BatchObjectsUpdateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BatchObjectsUpdateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewBatchObjectsUpdateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewBatchObjectsUpdateBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewBatchObjectsUpdateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewBatchObjectsUpdateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewBatchObjectsUpdateUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewBatchObjectsUpdateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewBatchObjectsUpdateOK creates a BatchObjectsUpdateOK with default headers values
+func NewBatchObjectsUpdateOK() *BatchObjectsUpdateOK {
+	return &BatchObjectsUpdateOK{}
+}
+
+/*
+BatchObjectsUpdateOK describes a response with status code 200, with default header values.
+
+Request succeeded, see response body to get detailed information about each batched item.
+*/
+type BatchObjectsUpdateOK struct {
+	Payload *models.BatchUpdateResponse
+}
+
+// IsSuccess returns true when this batch objects update o k response has a 2xx status code
+func (o *BatchObjectsUpdateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this batch objects update o k response has a 3xx status code
+func (o *BatchObjectsUpdateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this batch objects update o k response has a 4xx status code
+func (o *BatchObjectsUpdateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this batch objects update o k response has a 5xx status code
+func (o *BatchObjectsUpdateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this batch objects update o k response a status code equal to that given
+func (o *BatchObjectsUpdateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the batch objects update o k response
+func (o *BatchObjectsUpdateOK) Code() int {
+	return 200
+}
+
+func (o *BatchObjectsUpdateOK) Error() string {
+	return fmt.Sprintf("[PATCH /batch/objects][%d] batchObjectsUpdateOK  %+v", 200, o.Payload)
+}
+
+func (o *BatchObjectsUpdateOK) String() string {
+	return fmt.Sprintf("[PATCH /batch/objects][%d] batchObjectsUpdateOK  %+v", 200, o.Payload)
+}
+
+func (o *BatchObjectsUpdateOK) GetPayload() *models.BatchUpdateResponse {
+	return o.Payload
+}
+
+func (o *BatchObjectsUpdateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.BatchUpdateResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchObjectsUpdateBadRequest creates a BatchObjectsUpdateBadRequest with default headers values
+func NewBatchObjectsUpdateBadRequest() *BatchObjectsUpdateBadRequest {
+	return &BatchObjectsUpdateBadRequest{}
+}
+
+/*
+BatchObjectsUpdateBadRequest describes a response with status code 400, with default header values.
+
+Malformed request.
+*/
+type BatchObjectsUpdateBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this batch objects update bad request response has a 2xx status code
+func (o *BatchObjectsUpdateBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this batch objects update bad request response has a 3xx status code
+func (o *BatchObjectsUpdateBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this batch objects update bad request response has a 4xx status code
+func (o *BatchObjectsUpdateBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this batch objects update bad request response has a 5xx status code
+func (o *BatchObjectsUpdateBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this batch objects update bad request response a status code equal to that given
+func (o *BatchObjectsUpdateBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the batch objects update bad request response
+func (o *BatchObjectsUpdateBadRequest) Code() int {
+	return 400
+}
+
+func (o *BatchObjectsUpdateBadRequest) Error() string {
+	return fmt.Sprintf("[PATCH /batch/objects][%d] batchObjectsUpdateBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *BatchObjectsUpdateBadRequest) String() string {
+	return fmt.Sprintf("[PATCH /batch/objects][%d] batchObjectsUpdateBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *BatchObjectsUpdateBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchObjectsUpdateBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchObjectsUpdateUnauthorized creates a BatchObjectsUpdateUnauthorized with default headers values
+func NewBatchObjectsUpdateUnauthorized() *BatchObjectsUpdateUnauthorized {
+	return &BatchObjectsUpdateUnauthorized{}
+}
+
+/*
+BatchObjectsUpdateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type BatchObjectsUpdateUnauthorized struct {
+}
+
+// IsSuccess returns true when this batch objects update unauthorized response has a 2xx status code
+func (o *BatchObjectsUpdateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this batch objects update unauthorized response has a 3xx status code
+func (o *BatchObjectsUpdateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this batch objects update unauthorized response has a 4xx status code
+func (o *BatchObjectsUpdateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this batch objects update unauthorized response has a 5xx status code
+func (o *BatchObjectsUpdateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this batch objects update unauthorized response a status code equal to that given
+func (o *BatchObjectsUpdateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the batch objects update unauthorized response
+func (o *BatchObjectsUpdateUnauthorized) Code() int {
+	return 401
+}
+
+func (o *BatchObjectsUpdateUnauthorized) Error() string {
+	return fmt.Sprintf("[PATCH /batch/objects][%d] batchObjectsUpdateUnauthorized ", 401)
+}
+
+func (o *BatchObjectsUpdateUnauthorized) String() string {
+	return fmt.Sprintf("[PATCH /batch/objects][%d] batchObjectsUpdateUnauthorized ", 401)
+}
+
+func (o *BatchObjectsUpdateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBatchObjectsUpdateForbidden creates a BatchObjectsUpdateForbidden with default headers values
+func NewBatchObjectsUpdateForbidden() *BatchObjectsUpdateForbidden {
+	return &BatchObjectsUpdateForbidden{}
+}
+
+/*
+BatchObjectsUpdateForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type BatchObjectsUpdateForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this batch objects update forbidden response has a 2xx status code
+func (o *BatchObjectsUpdateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this batch objects update forbidden response has a 3xx status code
+func (o *BatchObjectsUpdateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this batch objects update forbidden response has a 4xx status code
+func (o *BatchObjectsUpdateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this batch objects update forbidden response has a 5xx status code
+func (o *BatchObjectsUpdateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this batch objects update forbidden response a status code equal to that given
+func (o *BatchObjectsUpdateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the batch objects update forbidden response
+func (o *BatchObjectsUpdateForbidden) Code() int {
+	return 403
+}
+
+func (o *BatchObjectsUpdateForbidden) Error() string {
+	return fmt.Sprintf("[PATCH /batch/objects][%d] batchObjectsUpdateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BatchObjectsUpdateForbidden) String() string {
+	return fmt.Sprintf("[PATCH /batch/objects][%d] batchObjectsUpdateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BatchObjectsUpdateForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchObjectsUpdateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchObjectsUpdateUnprocessableEntity creates a BatchObjectsUpdateUnprocessableEntity with default headers values
+func NewBatchObjectsUpdateUnprocessableEntity() *BatchObjectsUpdateUnprocessableEntity {
+	return &BatchObjectsUpdateUnprocessableEntity{}
+}
+
+/*
+BatchObjectsUpdateUnprocessableEntity describes a response with status code 422, with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?
+*/
+type BatchObjectsUpdateUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this batch objects update unprocessable entity response has a 2xx status code
+func (o *BatchObjectsUpdateUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this batch objects update unprocessable entity response has a 3xx status code
+func (o *BatchObjectsUpdateUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this batch objects update unprocessable entity response has a 4xx status code
+func (o *BatchObjectsUpdateUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this batch objects update unprocessable entity response has a 5xx status code
+func (o *BatchObjectsUpdateUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this batch objects update unprocessable entity response a status code equal to that given
+func (o *BatchObjectsUpdateUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the batch objects update unprocessable entity response
+func (o *BatchObjectsUpdateUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *BatchObjectsUpdateUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PATCH /batch/objects][%d] batchObjectsUpdateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *BatchObjectsUpdateUnprocessableEntity) String() string {
+	return fmt.Sprintf("[PATCH /batch/objects][%d] batchObjectsUpdateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *BatchObjectsUpdateUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchObjectsUpdateUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBatchObjectsUpdateInternalServerError creates a BatchObjectsUpdateInternalServerError with default headers values
+func NewBatchObjectsUpdateInternalServerError() *BatchObjectsUpdateInternalServerError {
+	return &BatchObjectsUpdateInternalServerError{}
+}
+
+/*
+BatchObjectsUpdateInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type BatchObjectsUpdateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this batch objects update internal server error response has a 2xx status code
+func (o *BatchObjectsUpdateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this batch objects update internal server error response has a 3xx status code
+func (o *BatchObjectsUpdateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this batch objects update internal server error response has a 4xx status code
+func (o *BatchObjectsUpdateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this batch objects update internal server error response has a 5xx status code
+func (o *BatchObjectsUpdateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this batch objects update internal server error response a status code equal to that given
+func (o *BatchObjectsUpdateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the batch objects update internal server error response
+func (o *BatchObjectsUpdateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *BatchObjectsUpdateInternalServerError) Error() string {
+	return fmt.Sprintf("[PATCH /batch/objects][%d] batchObjectsUpdateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BatchObjectsUpdateInternalServerError) String() string {
+	return fmt.Sprintf("[PATCH /batch/objects][%d] batchObjectsUpdateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BatchObjectsUpdateInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BatchObjectsUpdateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
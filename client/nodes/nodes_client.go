@@ -41,6 +41,8 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	NodesDynamicIndexUpdate(params *NodesDynamicIndexUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesDynamicIndexUpdateAccepted, error)
+
 	NodesGet(params *NodesGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesGetOK, error)
 
 	NodesGetClass(params *NodesGetClassParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesGetClassOK, error)
@@ -48,6 +50,45 @@ type ClientService interface {
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+NodesDynamicIndexUpdate Forces or defers the upgrade of the dynamic vector indexes of a class from flat to hnsw, for a single tenant or all shards. The policy is persisted with each index; a forced upgrade starts in the background.
+*/
+func (a *Client) NodesDynamicIndexUpdate(params *NodesDynamicIndexUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesDynamicIndexUpdateAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesDynamicIndexUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.dynamic.index.update",
+		Method:             "PUT",
+		PathPattern:        "/nodes/{className}/dynamic-index",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesDynamicIndexUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesDynamicIndexUpdateAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.dynamic.index.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 NodesGet Returns status of Weaviate DB.
 */
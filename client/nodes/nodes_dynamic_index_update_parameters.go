@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewNodesDynamicIndexUpdateParams creates a new NodesDynamicIndexUpdateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewNodesDynamicIndexUpdateParams() *NodesDynamicIndexUpdateParams {
+	return &NodesDynamicIndexUpdateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewNodesDynamicIndexUpdateParamsWithTimeout creates a new NodesDynamicIndexUpdateParams object
+// with the ability to set a timeout on a request.
+func NewNodesDynamicIndexUpdateParamsWithTimeout(timeout time.Duration) *NodesDynamicIndexUpdateParams {
+	return &NodesDynamicIndexUpdateParams{
+		timeout: timeout,
+	}
+}
+
+// NewNodesDynamicIndexUpdateParamsWithContext creates a new NodesDynamicIndexUpdateParams object
+// with the ability to set a context for a request.
+func NewNodesDynamicIndexUpdateParamsWithContext(ctx context.Context) *NodesDynamicIndexUpdateParams {
+	return &NodesDynamicIndexUpdateParams{
+		Context: ctx,
+	}
+}
+
+// NewNodesDynamicIndexUpdateParamsWithHTTPClient creates a new NodesDynamicIndexUpdateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewNodesDynamicIndexUpdateParamsWithHTTPClient(client *http.Client) *NodesDynamicIndexUpdateParams {
+	return &NodesDynamicIndexUpdateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+NodesDynamicIndexUpdateParams contains all the parameters to send to the API endpoint
+
+	for the nodes dynamic index update operation.
+
+	Typically these are written to a http.Request.
+*/
+type NodesDynamicIndexUpdateParams struct {
+
+	// Body.
+	Body *models.DynamicVectorIndexPolicyRequest
+
+	// ClassName.
+	ClassName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the nodes dynamic index update params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *NodesDynamicIndexUpdateParams) WithDefaults() *NodesDynamicIndexUpdateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the nodes dynamic index update params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *NodesDynamicIndexUpdateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the nodes dynamic index update params
+func (o *NodesDynamicIndexUpdateParams) WithTimeout(timeout time.Duration) *NodesDynamicIndexUpdateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the nodes dynamic index update params
+func (o *NodesDynamicIndexUpdateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the nodes dynamic index update params
+func (o *NodesDynamicIndexUpdateParams) WithContext(ctx context.Context) *NodesDynamicIndexUpdateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the nodes dynamic index update params
+func (o *NodesDynamicIndexUpdateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the nodes dynamic index update params
+func (o *NodesDynamicIndexUpdateParams) WithHTTPClient(client *http.Client) *NodesDynamicIndexUpdateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the nodes dynamic index update params
+func (o *NodesDynamicIndexUpdateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the nodes dynamic index update params
+func (o *NodesDynamicIndexUpdateParams) WithBody(body *models.DynamicVectorIndexPolicyRequest) *NodesDynamicIndexUpdateParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the nodes dynamic index update params
+func (o *NodesDynamicIndexUpdateParams) SetBody(body *models.DynamicVectorIndexPolicyRequest) {
+	o.Body = body
+}
+
+// WithClassName adds the className to the nodes dynamic index update params
+func (o *NodesDynamicIndexUpdateParams) WithClassName(className string) *NodesDynamicIndexUpdateParams {
+	o.SetClassName(className)
+	return o
+}
+
+// SetClassName adds the className to the nodes dynamic index update params
+func (o *NodesDynamicIndexUpdateParams) SetClassName(className string) {
+	o.ClassName = className
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *NodesDynamicIndexUpdateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param className
+	if err := r.SetPathParam("className", o.ClassName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
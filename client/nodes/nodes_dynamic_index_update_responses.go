@@ -0,0 +1,460 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package nodes
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NodesDynamicIndexUpdateReader is a Reader for the NodesDynamicIndexUpdate structure.
+type NodesDynamicIndexUpdateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *NodesDynamicIndexUpdateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewNodesDynamicIndexUpdateAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewNodesDynamicIndexUpdateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewNodesDynamicIndexUpdateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewNodesDynamicIndexUpdateNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewNodesDynamicIndexUpdateUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewNodesDynamicIndexUpdateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewNodesDynamicIndexUpdateAccepted creates a NodesDynamicIndexUpdateAccepted with default headers values
+func NewNodesDynamicIndexUpdateAccepted() *NodesDynamicIndexUpdateAccepted {
+	return &NodesDynamicIndexUpdateAccepted{}
+}
+
+/*
+NodesDynamicIndexUpdateAccepted describes a response with status code 202, with default header values.
+
+Upgrade policy successfully updated
+*/
+type NodesDynamicIndexUpdateAccepted struct {
+}
+
+// IsSuccess returns true when this nodes dynamic index update accepted response has a 2xx status code
+func (o *NodesDynamicIndexUpdateAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this nodes dynamic index update accepted response has a 3xx status code
+func (o *NodesDynamicIndexUpdateAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes dynamic index update accepted response has a 4xx status code
+func (o *NodesDynamicIndexUpdateAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this nodes dynamic index update accepted response has a 5xx status code
+func (o *NodesDynamicIndexUpdateAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes dynamic index update accepted response a status code equal to that given
+func (o *NodesDynamicIndexUpdateAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+// Code gets the status code for the nodes dynamic index update accepted response
+func (o *NodesDynamicIndexUpdateAccepted) Code() int {
+	return 202
+}
+
+func (o *NodesDynamicIndexUpdateAccepted) Error() string {
+	return fmt.Sprintf("[PUT /nodes/{className}/dynamic-index][%d] nodesDynamicIndexUpdateAccepted ", 202)
+}
+
+func (o *NodesDynamicIndexUpdateAccepted) String() string {
+	return fmt.Sprintf("[PUT /nodes/{className}/dynamic-index][%d] nodesDynamicIndexUpdateAccepted ", 202)
+}
+
+func (o *NodesDynamicIndexUpdateAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewNodesDynamicIndexUpdateUnauthorized creates a NodesDynamicIndexUpdateUnauthorized with default headers values
+func NewNodesDynamicIndexUpdateUnauthorized() *NodesDynamicIndexUpdateUnauthorized {
+	return &NodesDynamicIndexUpdateUnauthorized{}
+}
+
+/*
+NodesDynamicIndexUpdateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type NodesDynamicIndexUpdateUnauthorized struct {
+}
+
+// IsSuccess returns true when this nodes dynamic index update unauthorized response has a 2xx status code
+func (o *NodesDynamicIndexUpdateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes dynamic index update unauthorized response has a 3xx status code
+func (o *NodesDynamicIndexUpdateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes dynamic index update unauthorized response has a 4xx status code
+func (o *NodesDynamicIndexUpdateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes dynamic index update unauthorized response has a 5xx status code
+func (o *NodesDynamicIndexUpdateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes dynamic index update unauthorized response a status code equal to that given
+func (o *NodesDynamicIndexUpdateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the nodes dynamic index update unauthorized response
+func (o *NodesDynamicIndexUpdateUnauthorized) Code() int {
+	return 401
+}
+
+func (o *NodesDynamicIndexUpdateUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /nodes/{className}/dynamic-index][%d] nodesDynamicIndexUpdateUnauthorized ", 401)
+}
+
+func (o *NodesDynamicIndexUpdateUnauthorized) String() string {
+	return fmt.Sprintf("[PUT /nodes/{className}/dynamic-index][%d] nodesDynamicIndexUpdateUnauthorized ", 401)
+}
+
+func (o *NodesDynamicIndexUpdateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewNodesDynamicIndexUpdateForbidden creates a NodesDynamicIndexUpdateForbidden with default headers values
+func NewNodesDynamicIndexUpdateForbidden() *NodesDynamicIndexUpdateForbidden {
+	return &NodesDynamicIndexUpdateForbidden{}
+}
+
+/*
+NodesDynamicIndexUpdateForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type NodesDynamicIndexUpdateForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes dynamic index update forbidden response has a 2xx status code
+func (o *NodesDynamicIndexUpdateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes dynamic index update forbidden response has a 3xx status code
+func (o *NodesDynamicIndexUpdateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes dynamic index update forbidden response has a 4xx status code
+func (o *NodesDynamicIndexUpdateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes dynamic index update forbidden response has a 5xx status code
+func (o *NodesDynamicIndexUpdateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes dynamic index update forbidden response a status code equal to that given
+func (o *NodesDynamicIndexUpdateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the nodes dynamic index update forbidden response
+func (o *NodesDynamicIndexUpdateForbidden) Code() int {
+	return 403
+}
+
+func (o *NodesDynamicIndexUpdateForbidden) Error() string {
+	return fmt.Sprintf("[PUT /nodes/{className}/dynamic-index][%d] nodesDynamicIndexUpdateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *NodesDynamicIndexUpdateForbidden) String() string {
+	return fmt.Sprintf("[PUT /nodes/{className}/dynamic-index][%d] nodesDynamicIndexUpdateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *NodesDynamicIndexUpdateForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesDynamicIndexUpdateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesDynamicIndexUpdateNotFound creates a NodesDynamicIndexUpdateNotFound with default headers values
+func NewNodesDynamicIndexUpdateNotFound() *NodesDynamicIndexUpdateNotFound {
+	return &NodesDynamicIndexUpdateNotFound{}
+}
+
+/*
+NodesDynamicIndexUpdateNotFound describes a response with status code 404, with default header values.
+
+Not Found - Class or tenant does not exist
+*/
+type NodesDynamicIndexUpdateNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes dynamic index update not found response has a 2xx status code
+func (o *NodesDynamicIndexUpdateNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes dynamic index update not found response has a 3xx status code
+func (o *NodesDynamicIndexUpdateNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes dynamic index update not found response has a 4xx status code
+func (o *NodesDynamicIndexUpdateNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes dynamic index update not found response has a 5xx status code
+func (o *NodesDynamicIndexUpdateNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes dynamic index update not found response a status code equal to that given
+func (o *NodesDynamicIndexUpdateNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the nodes dynamic index update not found response
+func (o *NodesDynamicIndexUpdateNotFound) Code() int {
+	return 404
+}
+
+func (o *NodesDynamicIndexUpdateNotFound) Error() string {
+	return fmt.Sprintf("[PUT /nodes/{className}/dynamic-index][%d] nodesDynamicIndexUpdateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *NodesDynamicIndexUpdateNotFound) String() string {
+	return fmt.Sprintf("[PUT /nodes/{className}/dynamic-index][%d] nodesDynamicIndexUpdateNotFound  %+v", 404, o.Payload)
+}
+
+func (o *NodesDynamicIndexUpdateNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesDynamicIndexUpdateNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesDynamicIndexUpdateUnprocessableEntity creates a NodesDynamicIndexUpdateUnprocessableEntity with default headers values
+func NewNodesDynamicIndexUpdateUnprocessableEntity() *NodesDynamicIndexUpdateUnprocessableEntity {
+	return &NodesDynamicIndexUpdateUnprocessableEntity{}
+}
+
+/*
+NodesDynamicIndexUpdateUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid request, e.g. the target vector does not use a dynamic index or the tenant is not active.
+*/
+type NodesDynamicIndexUpdateUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes dynamic index update unprocessable entity response has a 2xx status code
+func (o *NodesDynamicIndexUpdateUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes dynamic index update unprocessable entity response has a 3xx status code
+func (o *NodesDynamicIndexUpdateUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes dynamic index update unprocessable entity response has a 4xx status code
+func (o *NodesDynamicIndexUpdateUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this nodes dynamic index update unprocessable entity response has a 5xx status code
+func (o *NodesDynamicIndexUpdateUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this nodes dynamic index update unprocessable entity response a status code equal to that given
+func (o *NodesDynamicIndexUpdateUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the nodes dynamic index update unprocessable entity response
+func (o *NodesDynamicIndexUpdateUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *NodesDynamicIndexUpdateUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PUT /nodes/{className}/dynamic-index][%d] nodesDynamicIndexUpdateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *NodesDynamicIndexUpdateUnprocessableEntity) String() string {
+	return fmt.Sprintf("[PUT /nodes/{className}/dynamic-index][%d] nodesDynamicIndexUpdateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *NodesDynamicIndexUpdateUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesDynamicIndexUpdateUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewNodesDynamicIndexUpdateInternalServerError creates a NodesDynamicIndexUpdateInternalServerError with default headers values
+func NewNodesDynamicIndexUpdateInternalServerError() *NodesDynamicIndexUpdateInternalServerError {
+	return &NodesDynamicIndexUpdateInternalServerError{}
+}
+
+/*
+NodesDynamicIndexUpdateInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type NodesDynamicIndexUpdateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this nodes dynamic index update internal server error response has a 2xx status code
+func (o *NodesDynamicIndexUpdateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this nodes dynamic index update internal server error response has a 3xx status code
+func (o *NodesDynamicIndexUpdateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this nodes dynamic index update internal server error response has a 4xx status code
+func (o *NodesDynamicIndexUpdateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this nodes dynamic index update internal server error response has a 5xx status code
+func (o *NodesDynamicIndexUpdateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this nodes dynamic index update internal server error response a status code equal to that given
+func (o *NodesDynamicIndexUpdateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the nodes dynamic index update internal server error response
+func (o *NodesDynamicIndexUpdateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *NodesDynamicIndexUpdateInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /nodes/{className}/dynamic-index][%d] nodesDynamicIndexUpdateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *NodesDynamicIndexUpdateInternalServerError) String() string {
+	return fmt.Sprintf("[PUT /nodes/{className}/dynamic-index][%d] nodesDynamicIndexUpdateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *NodesDynamicIndexUpdateInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *NodesDynamicIndexUpdateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -72,6 +72,12 @@ ObjectsClassDeleteParams contains all the parameters to send to the API endpoint
 */
 type ObjectsClassDeleteParams struct {
 
+	/* IfMatch.
+
+	   Only apply the write if the stored object has this version, its lastUpdateTimeUnix as returned in the ETag header. A version of 0 requires that the object does not exist. A write within the same millisecond as the write of the stored version would not change the version and is refused, it needs to be retried.
+	*/
+	IfMatch *string
+
 	// ClassName.
 	ClassName string
 
@@ -148,6 +154,17 @@ func (o *ObjectsClassDeleteParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfMatch adds the ifMatch to the objects class delete params
+func (o *ObjectsClassDeleteParams) WithIfMatch(ifMatch *string) *ObjectsClassDeleteParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the objects class delete params
+func (o *ObjectsClassDeleteParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithClassName adds the className to the objects class delete params
 func (o *ObjectsClassDeleteParams) WithClassName(className string) *ObjectsClassDeleteParams {
 	o.SetClassName(className)
@@ -200,6 +217,14 @@ func (o *ObjectsClassDeleteParams) WriteToRequest(r runtime.ClientRequest, reg s
 	}
 	var res []error
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
 	// path param className
 	if err := r.SetPathParam("className", o.ClassName); err != nil {
 		return err
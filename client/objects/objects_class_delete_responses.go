@@ -64,6 +64,12 @@ func (o *ObjectsClassDeleteReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewObjectsClassDeletePreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 422:
 		result := NewObjectsClassDeleteUnprocessableEntity()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -385,6 +391,74 @@ func (o *ObjectsClassDeleteNotFound) readResponse(response runtime.ClientRespons
 	return nil
 }
 
+// NewObjectsClassDeletePreconditionFailed creates a ObjectsClassDeletePreconditionFailed with default headers values
+func NewObjectsClassDeletePreconditionFailed() *ObjectsClassDeletePreconditionFailed {
+	return &ObjectsClassDeletePreconditionFailed{}
+}
+
+/*
+ObjectsClassDeletePreconditionFailed describes a response with status code 412, with default header values.
+
+The stored object does not have the version given in the If-Match header, or the write would not change the version and needs to be retried.
+*/
+type ObjectsClassDeletePreconditionFailed struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this objects class delete precondition failed response has a 2xx status code
+func (o *ObjectsClassDeletePreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this objects class delete precondition failed response has a 3xx status code
+func (o *ObjectsClassDeletePreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this objects class delete precondition failed response has a 4xx status code
+func (o *ObjectsClassDeletePreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this objects class delete precondition failed response has a 5xx status code
+func (o *ObjectsClassDeletePreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this objects class delete precondition failed response a status code equal to that given
+func (o *ObjectsClassDeletePreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the objects class delete precondition failed response
+func (o *ObjectsClassDeletePreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *ObjectsClassDeletePreconditionFailed) Error() string {
+	return fmt.Sprintf("[DELETE /objects/{className}/{id}][%d] objectsClassDeletePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsClassDeletePreconditionFailed) String() string {
+	return fmt.Sprintf("[DELETE /objects/{className}/{id}][%d] objectsClassDeletePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ObjectsClassDeletePreconditionFailed) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ObjectsClassDeletePreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewObjectsClassDeleteUnprocessableEntity creates a ObjectsClassDeleteUnprocessableEntity with default headers values
 func NewObjectsClassDeleteUnprocessableEntity() *ObjectsClassDeleteUnprocessableEntity {
 	return &ObjectsClassDeleteUnprocessableEntity{}
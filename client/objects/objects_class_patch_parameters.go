@@ -74,6 +74,12 @@ ObjectsClassPatchParams contains all the parameters to send to the API endpoint
 */
 type ObjectsClassPatchParams struct {
 
+	/* IfMatch.
+
+	   Only apply the write if the stored object has this version, its lastUpdateTimeUnix as returned in the ETag header. A version of 0 requires that the object does not exist. A write within the same millisecond as the write of the stored version would not change the version and is refused, it needs to be retried.
+	*/
+	IfMatch *string
+
 	/* Body.
 
 	   RFC 7396-style patch, the body contains the object to merge into the existing object.
@@ -153,6 +159,17 @@ func (o *ObjectsClassPatchParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfMatch adds the ifMatch to the objects class patch params
+func (o *ObjectsClassPatchParams) WithIfMatch(ifMatch *string) *ObjectsClassPatchParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the objects class patch params
+func (o *ObjectsClassPatchParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithBody adds the body to the objects class patch params
 func (o *ObjectsClassPatchParams) WithBody(body *models.Object) *ObjectsClassPatchParams {
 	o.SetBody(body)
@@ -204,6 +221,14 @@ func (o *ObjectsClassPatchParams) WriteToRequest(r runtime.ClientRequest, reg st
 		return err
 	}
 	var res []error
+
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
 	if o.Body != nil {
 		if err := r.SetBodyParam(o.Body); err != nil {
 			return err
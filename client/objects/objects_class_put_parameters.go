@@ -74,6 +74,12 @@ ObjectsClassPutParams contains all the parameters to send to the API endpoint
 */
 type ObjectsClassPutParams struct {
 
+	/* IfMatch.
+
+	   Only apply the write if the stored object has this version, its lastUpdateTimeUnix as returned in the ETag header. A version of 0 requires that the object does not exist. A write within the same millisecond as the write of the stored version would not change the version and is refused, it needs to be retried.
+	*/
+	IfMatch *string
+
 	// Body.
 	Body *models.Object
 
@@ -147,6 +153,17 @@ func (o *ObjectsClassPutParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfMatch adds the ifMatch to the objects class put params
+func (o *ObjectsClassPutParams) WithIfMatch(ifMatch *string) *ObjectsClassPutParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the objects class put params
+func (o *ObjectsClassPutParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithBody adds the body to the objects class put params
 func (o *ObjectsClassPutParams) WithBody(body *models.Object) *ObjectsClassPutParams {
 	o.SetBody(body)
@@ -198,6 +215,14 @@ func (o *ObjectsClassPutParams) WriteToRequest(r runtime.ClientRequest, reg strf
 		return err
 	}
 	var res []error
+
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
 	if o.Body != nil {
 		if err := r.SetBodyParam(o.Body); err != nil {
 			return err
@@ -0,0 +1,378 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+)
+
+// New creates a new replication API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) ClientService {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for replication API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+// ClientOption is the option for Client methods
+type ClientOption func(*runtime.ClientOperation)
+
+// ClientService is the interface for Client methods
+type ClientService interface {
+	ReplicationCompare(params *ReplicationCompareParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationCompareAccepted, error)
+
+	ReplicationRebalancerGet(params *ReplicationRebalancerGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationRebalancerGetOK, error)
+
+	ReplicationRebalancerUpdate(params *ReplicationRebalancerUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationRebalancerUpdateOK, error)
+
+	ReplicationReshard(params *ReplicationReshardParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationReshardAccepted, error)
+
+	ReplicationReshardStatus(params *ReplicationReshardStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationReshardStatusOK, error)
+
+	ReplicationStatus(params *ReplicationStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationStatusOK, error)
+
+	ReplicationTransfer(params *ReplicationTransferParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationTransferAccepted, error)
+
+	ReplicationTransferList(params *ReplicationTransferListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationTransferListOK, error)
+
+	SetTransport(transport runtime.ClientTransport)
+}
+
+/*
+ReplicationCompare Triggers an immediate hashtree comparison of every shard of the class with its replicas.
+*/
+func (a *Client) ReplicationCompare(params *ReplicationCompareParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationCompareAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReplicationCompareParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "replication.compare",
+		Method:             "POST",
+		PathPattern:        "/replication/compare/{className}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReplicationCompareReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReplicationCompareAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for replication.compare: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReplicationRebalancerGet Returns the state of the automatic shard rebalancer, including the load of the nodes and the recent shard moves.
+*/
+func (a *Client) ReplicationRebalancerGet(params *ReplicationRebalancerGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationRebalancerGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReplicationRebalancerGetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "replication.rebalancer.get",
+		Method:             "GET",
+		PathPattern:        "/replication/rebalancer",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReplicationRebalancerGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReplicationRebalancerGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for replication.rebalancer.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReplicationRebalancerUpdate Pauses or resumes the automatic shard rebalancer of the cluster. Moves in progress are finished when the rebalancer is paused.
+*/
+func (a *Client) ReplicationRebalancerUpdate(params *ReplicationRebalancerUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationRebalancerUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReplicationRebalancerUpdateParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "replication.rebalancer.update",
+		Method:             "PUT",
+		PathPattern:        "/replication/rebalancer",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReplicationRebalancerUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReplicationRebalancerUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for replication.rebalancer.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReplicationReshard Splits the physical shards of a class into more shards, or merges them into fewer, while the class keeps serving traffic. The virtual shards are redistributed among the new physical shards and the existing objects are copied to them in the background, while writes are applied to both layouts. Once all objects are copied, the new layout replaces the current one atomically. The resharding is stored in the cluster schema and continues after restarts and leader changes, its progress is returned by GET /replication/reshard/{className}. Classes with multi-tenancy enabled cannot be resharded.
+*/
+func (a *Client) ReplicationReshard(params *ReplicationReshardParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationReshardAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReplicationReshardParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "replication.reshard",
+		Method:             "POST",
+		PathPattern:        "/replication/reshard",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReplicationReshardReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReplicationReshardAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for replication.reshard: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReplicationReshardStatus Returns the progress of the running resharding of a class, or the outcome of the last one.
+*/
+func (a *Client) ReplicationReshardStatus(params *ReplicationReshardStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationReshardStatusOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReplicationReshardStatusParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "replication.reshard.status",
+		Method:             "GET",
+		PathPattern:        "/replication/reshard/{className}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReplicationReshardStatusReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReplicationReshardStatusOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for replication.reshard.status: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReplicationStatus Returns the async replication status of every shard in the cluster.
+*/
+func (a *Client) ReplicationStatus(params *ReplicationStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationStatusOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReplicationStatusParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "replication.status",
+		Method:             "GET",
+		PathPattern:        "/replication/status",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReplicationStatusReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReplicationStatusOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for replication.status: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReplicationTransfer Starts to copy or move a single shard (or tenant) between nodes while it keeps serving traffic. A snapshot of the shard is transferred to the target node, the writes and deletions received in the meantime are synced through the replication layer and the shard ownership is then updated in the cluster schema. The transfer runs in the background, its state is returned by GET /replication/transfer on the node which accepted it.
+*/
+func (a *Client) ReplicationTransfer(params *ReplicationTransferParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationTransferAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReplicationTransferParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "replication.transfer",
+		Method:             "POST",
+		PathPattern:        "/replication/transfer",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReplicationTransferReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReplicationTransferAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for replication.transfer: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReplicationTransferList Returns the shard transfers started through this node, running ones and the most recent finished ones.
+*/
+func (a *Client) ReplicationTransferList(params *ReplicationTransferListParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationTransferListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReplicationTransferListParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "replication.transfer.list",
+		Method:             "GET",
+		PathPattern:        "/replication/transfer",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReplicationTransferListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReplicationTransferListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for replication.transfer.list: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReplicationCompareParams creates a new ReplicationCompareParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReplicationCompareParams() *ReplicationCompareParams {
+	return &ReplicationCompareParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReplicationCompareParamsWithTimeout creates a new ReplicationCompareParams object
+// with the ability to set a timeout on a request.
+func NewReplicationCompareParamsWithTimeout(timeout time.Duration) *ReplicationCompareParams {
+	return &ReplicationCompareParams{
+		timeout: timeout,
+	}
+}
+
+// NewReplicationCompareParamsWithContext creates a new ReplicationCompareParams object
+// with the ability to set a context for a request.
+func NewReplicationCompareParamsWithContext(ctx context.Context) *ReplicationCompareParams {
+	return &ReplicationCompareParams{
+		Context: ctx,
+	}
+}
+
+// NewReplicationCompareParamsWithHTTPClient creates a new ReplicationCompareParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReplicationCompareParamsWithHTTPClient(client *http.Client) *ReplicationCompareParams {
+	return &ReplicationCompareParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReplicationCompareParams contains all the parameters to send to the API endpoint
+
+	for the replication compare operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReplicationCompareParams struct {
+
+	// ClassName.
+	ClassName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the replication compare params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationCompareParams) WithDefaults() *ReplicationCompareParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the replication compare params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationCompareParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the replication compare params
+func (o *ReplicationCompareParams) WithTimeout(timeout time.Duration) *ReplicationCompareParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the replication compare params
+func (o *ReplicationCompareParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the replication compare params
+func (o *ReplicationCompareParams) WithContext(ctx context.Context) *ReplicationCompareParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the replication compare params
+func (o *ReplicationCompareParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the replication compare params
+func (o *ReplicationCompareParams) WithHTTPClient(client *http.Client) *ReplicationCompareParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the replication compare params
+func (o *ReplicationCompareParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClassName adds the className to the replication compare params
+func (o *ReplicationCompareParams) WithClassName(className string) *ReplicationCompareParams {
+	o.SetClassName(className)
+	return o
+}
+
+// SetClassName adds the className to the replication compare params
+func (o *ReplicationCompareParams) SetClassName(className string) {
+	o.ClassName = className
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReplicationCompareParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param className
+	if err := r.SetPathParam("className", o.ClassName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
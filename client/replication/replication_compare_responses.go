@@ -0,0 +1,460 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationCompareReader is a Reader for the ReplicationCompare structure.
+type ReplicationCompareReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReplicationCompareReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewReplicationCompareAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewReplicationCompareUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReplicationCompareForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewReplicationCompareNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewReplicationCompareUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReplicationCompareInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewReplicationCompareAccepted creates a ReplicationCompareAccepted with default headers values
+func NewReplicationCompareAccepted() *ReplicationCompareAccepted {
+	return &ReplicationCompareAccepted{}
+}
+
+/*
+ReplicationCompareAccepted describes a response with status code 202, with default header values.
+
+Comparison successfully triggered
+*/
+type ReplicationCompareAccepted struct {
+}
+
+// IsSuccess returns true when this replication compare accepted response has a 2xx status code
+func (o *ReplicationCompareAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this replication compare accepted response has a 3xx status code
+func (o *ReplicationCompareAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication compare accepted response has a 4xx status code
+func (o *ReplicationCompareAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication compare accepted response has a 5xx status code
+func (o *ReplicationCompareAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication compare accepted response a status code equal to that given
+func (o *ReplicationCompareAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+// Code gets the status code for the replication compare accepted response
+func (o *ReplicationCompareAccepted) Code() int {
+	return 202
+}
+
+func (o *ReplicationCompareAccepted) Error() string {
+	return fmt.Sprintf("[POST /replication/compare/{className}][%d] replicationCompareAccepted ", 202)
+}
+
+func (o *ReplicationCompareAccepted) String() string {
+	return fmt.Sprintf("[POST /replication/compare/{className}][%d] replicationCompareAccepted ", 202)
+}
+
+func (o *ReplicationCompareAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationCompareUnauthorized creates a ReplicationCompareUnauthorized with default headers values
+func NewReplicationCompareUnauthorized() *ReplicationCompareUnauthorized {
+	return &ReplicationCompareUnauthorized{}
+}
+
+/*
+ReplicationCompareUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ReplicationCompareUnauthorized struct {
+}
+
+// IsSuccess returns true when this replication compare unauthorized response has a 2xx status code
+func (o *ReplicationCompareUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication compare unauthorized response has a 3xx status code
+func (o *ReplicationCompareUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication compare unauthorized response has a 4xx status code
+func (o *ReplicationCompareUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication compare unauthorized response has a 5xx status code
+func (o *ReplicationCompareUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication compare unauthorized response a status code equal to that given
+func (o *ReplicationCompareUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the replication compare unauthorized response
+func (o *ReplicationCompareUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ReplicationCompareUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /replication/compare/{className}][%d] replicationCompareUnauthorized ", 401)
+}
+
+func (o *ReplicationCompareUnauthorized) String() string {
+	return fmt.Sprintf("[POST /replication/compare/{className}][%d] replicationCompareUnauthorized ", 401)
+}
+
+func (o *ReplicationCompareUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationCompareForbidden creates a ReplicationCompareForbidden with default headers values
+func NewReplicationCompareForbidden() *ReplicationCompareForbidden {
+	return &ReplicationCompareForbidden{}
+}
+
+/*
+ReplicationCompareForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReplicationCompareForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication compare forbidden response has a 2xx status code
+func (o *ReplicationCompareForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication compare forbidden response has a 3xx status code
+func (o *ReplicationCompareForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication compare forbidden response has a 4xx status code
+func (o *ReplicationCompareForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication compare forbidden response has a 5xx status code
+func (o *ReplicationCompareForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication compare forbidden response a status code equal to that given
+func (o *ReplicationCompareForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the replication compare forbidden response
+func (o *ReplicationCompareForbidden) Code() int {
+	return 403
+}
+
+func (o *ReplicationCompareForbidden) Error() string {
+	return fmt.Sprintf("[POST /replication/compare/{className}][%d] replicationCompareForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationCompareForbidden) String() string {
+	return fmt.Sprintf("[POST /replication/compare/{className}][%d] replicationCompareForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationCompareForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationCompareForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationCompareNotFound creates a ReplicationCompareNotFound with default headers values
+func NewReplicationCompareNotFound() *ReplicationCompareNotFound {
+	return &ReplicationCompareNotFound{}
+}
+
+/*
+ReplicationCompareNotFound describes a response with status code 404, with default header values.
+
+Not Found - Class does not exist
+*/
+type ReplicationCompareNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication compare not found response has a 2xx status code
+func (o *ReplicationCompareNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication compare not found response has a 3xx status code
+func (o *ReplicationCompareNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication compare not found response has a 4xx status code
+func (o *ReplicationCompareNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication compare not found response has a 5xx status code
+func (o *ReplicationCompareNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication compare not found response a status code equal to that given
+func (o *ReplicationCompareNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the replication compare not found response
+func (o *ReplicationCompareNotFound) Code() int {
+	return 404
+}
+
+func (o *ReplicationCompareNotFound) Error() string {
+	return fmt.Sprintf("[POST /replication/compare/{className}][%d] replicationCompareNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ReplicationCompareNotFound) String() string {
+	return fmt.Sprintf("[POST /replication/compare/{className}][%d] replicationCompareNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ReplicationCompareNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationCompareNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationCompareUnprocessableEntity creates a ReplicationCompareUnprocessableEntity with default headers values
+func NewReplicationCompareUnprocessableEntity() *ReplicationCompareUnprocessableEntity {
+	return &ReplicationCompareUnprocessableEntity{}
+}
+
+/*
+ReplicationCompareUnprocessableEntity describes a response with status code 422, with default header values.
+
+Async replication is not enabled for the class.
+*/
+type ReplicationCompareUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication compare unprocessable entity response has a 2xx status code
+func (o *ReplicationCompareUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication compare unprocessable entity response has a 3xx status code
+func (o *ReplicationCompareUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication compare unprocessable entity response has a 4xx status code
+func (o *ReplicationCompareUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication compare unprocessable entity response has a 5xx status code
+func (o *ReplicationCompareUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication compare unprocessable entity response a status code equal to that given
+func (o *ReplicationCompareUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the replication compare unprocessable entity response
+func (o *ReplicationCompareUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ReplicationCompareUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /replication/compare/{className}][%d] replicationCompareUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationCompareUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /replication/compare/{className}][%d] replicationCompareUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationCompareUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationCompareUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationCompareInternalServerError creates a ReplicationCompareInternalServerError with default headers values
+func NewReplicationCompareInternalServerError() *ReplicationCompareInternalServerError {
+	return &ReplicationCompareInternalServerError{}
+}
+
+/*
+ReplicationCompareInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ReplicationCompareInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication compare internal server error response has a 2xx status code
+func (o *ReplicationCompareInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication compare internal server error response has a 3xx status code
+func (o *ReplicationCompareInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication compare internal server error response has a 4xx status code
+func (o *ReplicationCompareInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication compare internal server error response has a 5xx status code
+func (o *ReplicationCompareInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this replication compare internal server error response a status code equal to that given
+func (o *ReplicationCompareInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the replication compare internal server error response
+func (o *ReplicationCompareInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReplicationCompareInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /replication/compare/{className}][%d] replicationCompareInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationCompareInternalServerError) String() string {
+	return fmt.Sprintf("[POST /replication/compare/{className}][%d] replicationCompareInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationCompareInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationCompareInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReplicationRebalancerGetParams creates a new ReplicationRebalancerGetParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReplicationRebalancerGetParams() *ReplicationRebalancerGetParams {
+	return &ReplicationRebalancerGetParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReplicationRebalancerGetParamsWithTimeout creates a new ReplicationRebalancerGetParams object
+// with the ability to set a timeout on a request.
+func NewReplicationRebalancerGetParamsWithTimeout(timeout time.Duration) *ReplicationRebalancerGetParams {
+	return &ReplicationRebalancerGetParams{
+		timeout: timeout,
+	}
+}
+
+// NewReplicationRebalancerGetParamsWithContext creates a new ReplicationRebalancerGetParams object
+// with the ability to set a context for a request.
+func NewReplicationRebalancerGetParamsWithContext(ctx context.Context) *ReplicationRebalancerGetParams {
+	return &ReplicationRebalancerGetParams{
+		Context: ctx,
+	}
+}
+
+// NewReplicationRebalancerGetParamsWithHTTPClient creates a new ReplicationRebalancerGetParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReplicationRebalancerGetParamsWithHTTPClient(client *http.Client) *ReplicationRebalancerGetParams {
+	return &ReplicationRebalancerGetParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReplicationRebalancerGetParams contains all the parameters to send to the API endpoint
+
+	for the replication rebalancer get operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReplicationRebalancerGetParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the replication rebalancer get params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationRebalancerGetParams) WithDefaults() *ReplicationRebalancerGetParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the replication rebalancer get params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationRebalancerGetParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the replication rebalancer get params
+func (o *ReplicationRebalancerGetParams) WithTimeout(timeout time.Duration) *ReplicationRebalancerGetParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the replication rebalancer get params
+func (o *ReplicationRebalancerGetParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the replication rebalancer get params
+func (o *ReplicationRebalancerGetParams) WithContext(ctx context.Context) *ReplicationRebalancerGetParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the replication rebalancer get params
+func (o *ReplicationRebalancerGetParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the replication rebalancer get params
+func (o *ReplicationRebalancerGetParams) WithHTTPClient(client *http.Client) *ReplicationRebalancerGetParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the replication rebalancer get params
+func (o *ReplicationRebalancerGetParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReplicationRebalancerGetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
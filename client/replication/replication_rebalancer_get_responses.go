@@ -0,0 +1,324 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalancerGetReader is a Reader for the ReplicationRebalancerGet structure.
+type ReplicationRebalancerGetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReplicationRebalancerGetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReplicationRebalancerGetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewReplicationRebalancerGetUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReplicationRebalancerGetForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReplicationRebalancerGetInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewReplicationRebalancerGetOK creates a ReplicationRebalancerGetOK with default headers values
+func NewReplicationRebalancerGetOK() *ReplicationRebalancerGetOK {
+	return &ReplicationRebalancerGetOK{}
+}
+
+/*
+ReplicationRebalancerGetOK describes a response with status code 200, with default header values.
+
+The state of the rebalancer
+*/
+type ReplicationRebalancerGetOK struct {
+	Payload *models.RebalancerStatus
+}
+
+// IsSuccess returns true when this replication rebalancer get o k response has a 2xx status code
+func (o *ReplicationRebalancerGetOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this replication rebalancer get o k response has a 3xx status code
+func (o *ReplicationRebalancerGetOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalancer get o k response has a 4xx status code
+func (o *ReplicationRebalancerGetOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication rebalancer get o k response has a 5xx status code
+func (o *ReplicationRebalancerGetOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalancer get o k response a status code equal to that given
+func (o *ReplicationRebalancerGetOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the replication rebalancer get o k response
+func (o *ReplicationRebalancerGetOK) Code() int {
+	return 200
+}
+
+func (o *ReplicationRebalancerGetOK) Error() string {
+	return fmt.Sprintf("[GET /replication/rebalancer][%d] replicationRebalancerGetOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationRebalancerGetOK) String() string {
+	return fmt.Sprintf("[GET /replication/rebalancer][%d] replicationRebalancerGetOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationRebalancerGetOK) GetPayload() *models.RebalancerStatus {
+	return o.Payload
+}
+
+func (o *ReplicationRebalancerGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RebalancerStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalancerGetUnauthorized creates a ReplicationRebalancerGetUnauthorized with default headers values
+func NewReplicationRebalancerGetUnauthorized() *ReplicationRebalancerGetUnauthorized {
+	return &ReplicationRebalancerGetUnauthorized{}
+}
+
+/*
+ReplicationRebalancerGetUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ReplicationRebalancerGetUnauthorized struct {
+}
+
+// IsSuccess returns true when this replication rebalancer get unauthorized response has a 2xx status code
+func (o *ReplicationRebalancerGetUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalancer get unauthorized response has a 3xx status code
+func (o *ReplicationRebalancerGetUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalancer get unauthorized response has a 4xx status code
+func (o *ReplicationRebalancerGetUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalancer get unauthorized response has a 5xx status code
+func (o *ReplicationRebalancerGetUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalancer get unauthorized response a status code equal to that given
+func (o *ReplicationRebalancerGetUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the replication rebalancer get unauthorized response
+func (o *ReplicationRebalancerGetUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ReplicationRebalancerGetUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /replication/rebalancer][%d] replicationRebalancerGetUnauthorized ", 401)
+}
+
+func (o *ReplicationRebalancerGetUnauthorized) String() string {
+	return fmt.Sprintf("[GET /replication/rebalancer][%d] replicationRebalancerGetUnauthorized ", 401)
+}
+
+func (o *ReplicationRebalancerGetUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationRebalancerGetForbidden creates a ReplicationRebalancerGetForbidden with default headers values
+func NewReplicationRebalancerGetForbidden() *ReplicationRebalancerGetForbidden {
+	return &ReplicationRebalancerGetForbidden{}
+}
+
+/*
+ReplicationRebalancerGetForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReplicationRebalancerGetForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalancer get forbidden response has a 2xx status code
+func (o *ReplicationRebalancerGetForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalancer get forbidden response has a 3xx status code
+func (o *ReplicationRebalancerGetForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalancer get forbidden response has a 4xx status code
+func (o *ReplicationRebalancerGetForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalancer get forbidden response has a 5xx status code
+func (o *ReplicationRebalancerGetForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalancer get forbidden response a status code equal to that given
+func (o *ReplicationRebalancerGetForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the replication rebalancer get forbidden response
+func (o *ReplicationRebalancerGetForbidden) Code() int {
+	return 403
+}
+
+func (o *ReplicationRebalancerGetForbidden) Error() string {
+	return fmt.Sprintf("[GET /replication/rebalancer][%d] replicationRebalancerGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationRebalancerGetForbidden) String() string {
+	return fmt.Sprintf("[GET /replication/rebalancer][%d] replicationRebalancerGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationRebalancerGetForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalancerGetForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalancerGetInternalServerError creates a ReplicationRebalancerGetInternalServerError with default headers values
+func NewReplicationRebalancerGetInternalServerError() *ReplicationRebalancerGetInternalServerError {
+	return &ReplicationRebalancerGetInternalServerError{}
+}
+
+/*
+ReplicationRebalancerGetInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ReplicationRebalancerGetInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalancer get internal server error response has a 2xx status code
+func (o *ReplicationRebalancerGetInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalancer get internal server error response has a 3xx status code
+func (o *ReplicationRebalancerGetInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalancer get internal server error response has a 4xx status code
+func (o *ReplicationRebalancerGetInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication rebalancer get internal server error response has a 5xx status code
+func (o *ReplicationRebalancerGetInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this replication rebalancer get internal server error response a status code equal to that given
+func (o *ReplicationRebalancerGetInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the replication rebalancer get internal server error response
+func (o *ReplicationRebalancerGetInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReplicationRebalancerGetInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /replication/rebalancer][%d] replicationRebalancerGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationRebalancerGetInternalServerError) String() string {
+	return fmt.Sprintf("[GET /replication/rebalancer][%d] replicationRebalancerGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationRebalancerGetInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalancerGetInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewReplicationRebalancerUpdateParams creates a new ReplicationRebalancerUpdateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReplicationRebalancerUpdateParams() *ReplicationRebalancerUpdateParams {
+	return &ReplicationRebalancerUpdateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReplicationRebalancerUpdateParamsWithTimeout creates a new ReplicationRebalancerUpdateParams object
+// with the ability to set a timeout on a request.
+func NewReplicationRebalancerUpdateParamsWithTimeout(timeout time.Duration) *ReplicationRebalancerUpdateParams {
+	return &ReplicationRebalancerUpdateParams{
+		timeout: timeout,
+	}
+}
+
+// NewReplicationRebalancerUpdateParamsWithContext creates a new ReplicationRebalancerUpdateParams object
+// with the ability to set a context for a request.
+func NewReplicationRebalancerUpdateParamsWithContext(ctx context.Context) *ReplicationRebalancerUpdateParams {
+	return &ReplicationRebalancerUpdateParams{
+		Context: ctx,
+	}
+}
+
+// NewReplicationRebalancerUpdateParamsWithHTTPClient creates a new ReplicationRebalancerUpdateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReplicationRebalancerUpdateParamsWithHTTPClient(client *http.Client) *ReplicationRebalancerUpdateParams {
+	return &ReplicationRebalancerUpdateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReplicationRebalancerUpdateParams contains all the parameters to send to the API endpoint
+
+	for the replication rebalancer update operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReplicationRebalancerUpdateParams struct {
+
+	// Body.
+	Body *models.RebalancerUpdateRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the replication rebalancer update params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationRebalancerUpdateParams) WithDefaults() *ReplicationRebalancerUpdateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the replication rebalancer update params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationRebalancerUpdateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the replication rebalancer update params
+func (o *ReplicationRebalancerUpdateParams) WithTimeout(timeout time.Duration) *ReplicationRebalancerUpdateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the replication rebalancer update params
+func (o *ReplicationRebalancerUpdateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the replication rebalancer update params
+func (o *ReplicationRebalancerUpdateParams) WithContext(ctx context.Context) *ReplicationRebalancerUpdateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the replication rebalancer update params
+func (o *ReplicationRebalancerUpdateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the replication rebalancer update params
+func (o *ReplicationRebalancerUpdateParams) WithHTTPClient(client *http.Client) *ReplicationRebalancerUpdateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the replication rebalancer update params
+func (o *ReplicationRebalancerUpdateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the replication rebalancer update params
+func (o *ReplicationRebalancerUpdateParams) WithBody(body *models.RebalancerUpdateRequest) *ReplicationRebalancerUpdateParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the replication rebalancer update params
+func (o *ReplicationRebalancerUpdateParams) SetBody(body *models.RebalancerUpdateRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReplicationRebalancerUpdateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
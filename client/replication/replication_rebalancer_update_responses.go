@@ -0,0 +1,398 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalancerUpdateReader is a Reader for the ReplicationRebalancerUpdate structure.
+type ReplicationRebalancerUpdateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReplicationRebalancerUpdateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReplicationRebalancerUpdateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewReplicationRebalancerUpdateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReplicationRebalancerUpdateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewReplicationRebalancerUpdateUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReplicationRebalancerUpdateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewReplicationRebalancerUpdateOK creates a ReplicationRebalancerUpdateOK with default headers values
+func NewReplicationRebalancerUpdateOK() *ReplicationRebalancerUpdateOK {
+	return &ReplicationRebalancerUpdateOK{}
+}
+
+/*
+ReplicationRebalancerUpdateOK describes a response with status code 200, with default header values.
+
+The state of the rebalancer after the update
+*/
+type ReplicationRebalancerUpdateOK struct {
+	Payload *models.RebalancerStatus
+}
+
+// IsSuccess returns true when this replication rebalancer update o k response has a 2xx status code
+func (o *ReplicationRebalancerUpdateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this replication rebalancer update o k response has a 3xx status code
+func (o *ReplicationRebalancerUpdateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalancer update o k response has a 4xx status code
+func (o *ReplicationRebalancerUpdateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication rebalancer update o k response has a 5xx status code
+func (o *ReplicationRebalancerUpdateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalancer update o k response a status code equal to that given
+func (o *ReplicationRebalancerUpdateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the replication rebalancer update o k response
+func (o *ReplicationRebalancerUpdateOK) Code() int {
+	return 200
+}
+
+func (o *ReplicationRebalancerUpdateOK) Error() string {
+	return fmt.Sprintf("[PUT /replication/rebalancer][%d] replicationRebalancerUpdateOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationRebalancerUpdateOK) String() string {
+	return fmt.Sprintf("[PUT /replication/rebalancer][%d] replicationRebalancerUpdateOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationRebalancerUpdateOK) GetPayload() *models.RebalancerStatus {
+	return o.Payload
+}
+
+func (o *ReplicationRebalancerUpdateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RebalancerStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalancerUpdateUnauthorized creates a ReplicationRebalancerUpdateUnauthorized with default headers values
+func NewReplicationRebalancerUpdateUnauthorized() *ReplicationRebalancerUpdateUnauthorized {
+	return &ReplicationRebalancerUpdateUnauthorized{}
+}
+
+/*
+ReplicationRebalancerUpdateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ReplicationRebalancerUpdateUnauthorized struct {
+}
+
+// IsSuccess returns true when this replication rebalancer update unauthorized response has a 2xx status code
+func (o *ReplicationRebalancerUpdateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalancer update unauthorized response has a 3xx status code
+func (o *ReplicationRebalancerUpdateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalancer update unauthorized response has a 4xx status code
+func (o *ReplicationRebalancerUpdateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalancer update unauthorized response has a 5xx status code
+func (o *ReplicationRebalancerUpdateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalancer update unauthorized response a status code equal to that given
+func (o *ReplicationRebalancerUpdateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the replication rebalancer update unauthorized response
+func (o *ReplicationRebalancerUpdateUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ReplicationRebalancerUpdateUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /replication/rebalancer][%d] replicationRebalancerUpdateUnauthorized ", 401)
+}
+
+func (o *ReplicationRebalancerUpdateUnauthorized) String() string {
+	return fmt.Sprintf("[PUT /replication/rebalancer][%d] replicationRebalancerUpdateUnauthorized ", 401)
+}
+
+func (o *ReplicationRebalancerUpdateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationRebalancerUpdateForbidden creates a ReplicationRebalancerUpdateForbidden with default headers values
+func NewReplicationRebalancerUpdateForbidden() *ReplicationRebalancerUpdateForbidden {
+	return &ReplicationRebalancerUpdateForbidden{}
+}
+
+/*
+ReplicationRebalancerUpdateForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReplicationRebalancerUpdateForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalancer update forbidden response has a 2xx status code
+func (o *ReplicationRebalancerUpdateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalancer update forbidden response has a 3xx status code
+func (o *ReplicationRebalancerUpdateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalancer update forbidden response has a 4xx status code
+func (o *ReplicationRebalancerUpdateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalancer update forbidden response has a 5xx status code
+func (o *ReplicationRebalancerUpdateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalancer update forbidden response a status code equal to that given
+func (o *ReplicationRebalancerUpdateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the replication rebalancer update forbidden response
+func (o *ReplicationRebalancerUpdateForbidden) Code() int {
+	return 403
+}
+
+func (o *ReplicationRebalancerUpdateForbidden) Error() string {
+	return fmt.Sprintf("[PUT /replication/rebalancer][%d] replicationRebalancerUpdateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationRebalancerUpdateForbidden) String() string {
+	return fmt.Sprintf("[PUT /replication/rebalancer][%d] replicationRebalancerUpdateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationRebalancerUpdateForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalancerUpdateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalancerUpdateUnprocessableEntity creates a ReplicationRebalancerUpdateUnprocessableEntity with default headers values
+func NewReplicationRebalancerUpdateUnprocessableEntity() *ReplicationRebalancerUpdateUnprocessableEntity {
+	return &ReplicationRebalancerUpdateUnprocessableEntity{}
+}
+
+/*
+ReplicationRebalancerUpdateUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid update request
+*/
+type ReplicationRebalancerUpdateUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalancer update unprocessable entity response has a 2xx status code
+func (o *ReplicationRebalancerUpdateUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalancer update unprocessable entity response has a 3xx status code
+func (o *ReplicationRebalancerUpdateUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalancer update unprocessable entity response has a 4xx status code
+func (o *ReplicationRebalancerUpdateUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalancer update unprocessable entity response has a 5xx status code
+func (o *ReplicationRebalancerUpdateUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalancer update unprocessable entity response a status code equal to that given
+func (o *ReplicationRebalancerUpdateUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the replication rebalancer update unprocessable entity response
+func (o *ReplicationRebalancerUpdateUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ReplicationRebalancerUpdateUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[PUT /replication/rebalancer][%d] replicationRebalancerUpdateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationRebalancerUpdateUnprocessableEntity) String() string {
+	return fmt.Sprintf("[PUT /replication/rebalancer][%d] replicationRebalancerUpdateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationRebalancerUpdateUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalancerUpdateUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalancerUpdateInternalServerError creates a ReplicationRebalancerUpdateInternalServerError with default headers values
+func NewReplicationRebalancerUpdateInternalServerError() *ReplicationRebalancerUpdateInternalServerError {
+	return &ReplicationRebalancerUpdateInternalServerError{}
+}
+
+/*
+ReplicationRebalancerUpdateInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ReplicationRebalancerUpdateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalancer update internal server error response has a 2xx status code
+func (o *ReplicationRebalancerUpdateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalancer update internal server error response has a 3xx status code
+func (o *ReplicationRebalancerUpdateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalancer update internal server error response has a 4xx status code
+func (o *ReplicationRebalancerUpdateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication rebalancer update internal server error response has a 5xx status code
+func (o *ReplicationRebalancerUpdateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this replication rebalancer update internal server error response a status code equal to that given
+func (o *ReplicationRebalancerUpdateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the replication rebalancer update internal server error response
+func (o *ReplicationRebalancerUpdateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReplicationRebalancerUpdateInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /replication/rebalancer][%d] replicationRebalancerUpdateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationRebalancerUpdateInternalServerError) String() string {
+	return fmt.Sprintf("[PUT /replication/rebalancer][%d] replicationRebalancerUpdateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationRebalancerUpdateInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalancerUpdateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationReshardReader is a Reader for the ReplicationReshard structure.
+type ReplicationReshardReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReplicationReshardReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewReplicationReshardAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewReplicationReshardUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReplicationReshardForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewReplicationReshardNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewReplicationReshardUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReplicationReshardInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewReplicationReshardAccepted creates a ReplicationReshardAccepted with default headers values
+func NewReplicationReshardAccepted() *ReplicationReshardAccepted {
+	return &ReplicationReshardAccepted{}
+}
+
+/*
+ReplicationReshardAccepted describes a response with status code 202, with default header values.
+
+Resharding started
+*/
+type ReplicationReshardAccepted struct {
+	Payload *models.ReshardingStatus
+}
+
+// IsSuccess returns true when this replication reshard accepted response has a 2xx status code
+func (o *ReplicationReshardAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this replication reshard accepted response has a 3xx status code
+func (o *ReplicationReshardAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication reshard accepted response has a 4xx status code
+func (o *ReplicationReshardAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication reshard accepted response has a 5xx status code
+func (o *ReplicationReshardAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication reshard accepted response a status code equal to that given
+func (o *ReplicationReshardAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+// Code gets the status code for the replication reshard accepted response
+func (o *ReplicationReshardAccepted) Code() int {
+	return 202
+}
+
+func (o *ReplicationReshardAccepted) Error() string {
+	return fmt.Sprintf("[POST /replication/reshard][%d] replicationReshardAccepted  %+v", 202, o.Payload)
+}
+
+func (o *ReplicationReshardAccepted) String() string {
+	return fmt.Sprintf("[POST /replication/reshard][%d] replicationReshardAccepted  %+v", 202, o.Payload)
+}
+
+func (o *ReplicationReshardAccepted) GetPayload() *models.ReshardingStatus {
+	return o.Payload
+}
+
+func (o *ReplicationReshardAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ReshardingStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationReshardUnauthorized creates a ReplicationReshardUnauthorized with default headers values
+func NewReplicationReshardUnauthorized() *ReplicationReshardUnauthorized {
+	return &ReplicationReshardUnauthorized{}
+}
+
+/*
+ReplicationReshardUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ReplicationReshardUnauthorized struct {
+}
+
+// IsSuccess returns true when this replication reshard unauthorized response has a 2xx status code
+func (o *ReplicationReshardUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication reshard unauthorized response has a 3xx status code
+func (o *ReplicationReshardUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication reshard unauthorized response has a 4xx status code
+func (o *ReplicationReshardUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication reshard unauthorized response has a 5xx status code
+func (o *ReplicationReshardUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication reshard unauthorized response a status code equal to that given
+func (o *ReplicationReshardUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the replication reshard unauthorized response
+func (o *ReplicationReshardUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ReplicationReshardUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /replication/reshard][%d] replicationReshardUnauthorized ", 401)
+}
+
+func (o *ReplicationReshardUnauthorized) String() string {
+	return fmt.Sprintf("[POST /replication/reshard][%d] replicationReshardUnauthorized ", 401)
+}
+
+func (o *ReplicationReshardUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationReshardForbidden creates a ReplicationReshardForbidden with default headers values
+func NewReplicationReshardForbidden() *ReplicationReshardForbidden {
+	return &ReplicationReshardForbidden{}
+}
+
+/*
+ReplicationReshardForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReplicationReshardForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication reshard forbidden response has a 2xx status code
+func (o *ReplicationReshardForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication reshard forbidden response has a 3xx status code
+func (o *ReplicationReshardForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication reshard forbidden response has a 4xx status code
+func (o *ReplicationReshardForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication reshard forbidden response has a 5xx status code
+func (o *ReplicationReshardForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication reshard forbidden response a status code equal to that given
+func (o *ReplicationReshardForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the replication reshard forbidden response
+func (o *ReplicationReshardForbidden) Code() int {
+	return 403
+}
+
+func (o *ReplicationReshardForbidden) Error() string {
+	return fmt.Sprintf("[POST /replication/reshard][%d] replicationReshardForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationReshardForbidden) String() string {
+	return fmt.Sprintf("[POST /replication/reshard][%d] replicationReshardForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationReshardForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationReshardForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationReshardNotFound creates a ReplicationReshardNotFound with default headers values
+func NewReplicationReshardNotFound() *ReplicationReshardNotFound {
+	return &ReplicationReshardNotFound{}
+}
+
+/*
+ReplicationReshardNotFound describes a response with status code 404, with default header values.
+
+Not Found - Class does not exist
+*/
+type ReplicationReshardNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication reshard not found response has a 2xx status code
+func (o *ReplicationReshardNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication reshard not found response has a 3xx status code
+func (o *ReplicationReshardNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication reshard not found response has a 4xx status code
+func (o *ReplicationReshardNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication reshard not found response has a 5xx status code
+func (o *ReplicationReshardNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication reshard not found response a status code equal to that given
+func (o *ReplicationReshardNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the replication reshard not found response
+func (o *ReplicationReshardNotFound) Code() int {
+	return 404
+}
+
+func (o *ReplicationReshardNotFound) Error() string {
+	return fmt.Sprintf("[POST /replication/reshard][%d] replicationReshardNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ReplicationReshardNotFound) String() string {
+	return fmt.Sprintf("[POST /replication/reshard][%d] replicationReshardNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ReplicationReshardNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationReshardNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationReshardUnprocessableEntity creates a ReplicationReshardUnprocessableEntity with default headers values
+func NewReplicationReshardUnprocessableEntity() *ReplicationReshardUnprocessableEntity {
+	return &ReplicationReshardUnprocessableEntity{}
+}
+
+/*
+ReplicationReshardUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid reshard request, e.g. the class has multi-tenancy enabled, is already being resharded or there are not enough nodes for the shard count.
+*/
+type ReplicationReshardUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication reshard unprocessable entity response has a 2xx status code
+func (o *ReplicationReshardUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication reshard unprocessable entity response has a 3xx status code
+func (o *ReplicationReshardUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication reshard unprocessable entity response has a 4xx status code
+func (o *ReplicationReshardUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication reshard unprocessable entity response has a 5xx status code
+func (o *ReplicationReshardUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication reshard unprocessable entity response a status code equal to that given
+func (o *ReplicationReshardUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the replication reshard unprocessable entity response
+func (o *ReplicationReshardUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ReplicationReshardUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /replication/reshard][%d] replicationReshardUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationReshardUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /replication/reshard][%d] replicationReshardUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationReshardUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationReshardUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationReshardInternalServerError creates a ReplicationReshardInternalServerError with default headers values
+func NewReplicationReshardInternalServerError() *ReplicationReshardInternalServerError {
+	return &ReplicationReshardInternalServerError{}
+}
+
+/*
+ReplicationReshardInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ReplicationReshardInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication reshard internal server error response has a 2xx status code
+func (o *ReplicationReshardInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication reshard internal server error response has a 3xx status code
+func (o *ReplicationReshardInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication reshard internal server error response has a 4xx status code
+func (o *ReplicationReshardInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication reshard internal server error response has a 5xx status code
+func (o *ReplicationReshardInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this replication reshard internal server error response a status code equal to that given
+func (o *ReplicationReshardInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the replication reshard internal server error response
+func (o *ReplicationReshardInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReplicationReshardInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /replication/reshard][%d] replicationReshardInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationReshardInternalServerError) String() string {
+	return fmt.Sprintf("[POST /replication/reshard][%d] replicationReshardInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationReshardInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationReshardInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReplicationReshardStatusParams creates a new ReplicationReshardStatusParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReplicationReshardStatusParams() *ReplicationReshardStatusParams {
+	return &ReplicationReshardStatusParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReplicationReshardStatusParamsWithTimeout creates a new ReplicationReshardStatusParams object
+// with the ability to set a timeout on a request.
+func NewReplicationReshardStatusParamsWithTimeout(timeout time.Duration) *ReplicationReshardStatusParams {
+	return &ReplicationReshardStatusParams{
+		timeout: timeout,
+	}
+}
+
+// NewReplicationReshardStatusParamsWithContext creates a new ReplicationReshardStatusParams object
+// with the ability to set a context for a request.
+func NewReplicationReshardStatusParamsWithContext(ctx context.Context) *ReplicationReshardStatusParams {
+	return &ReplicationReshardStatusParams{
+		Context: ctx,
+	}
+}
+
+// NewReplicationReshardStatusParamsWithHTTPClient creates a new ReplicationReshardStatusParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReplicationReshardStatusParamsWithHTTPClient(client *http.Client) *ReplicationReshardStatusParams {
+	return &ReplicationReshardStatusParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReplicationReshardStatusParams contains all the parameters to send to the API endpoint
+
+	for the replication reshard status operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReplicationReshardStatusParams struct {
+
+	// ClassName.
+	ClassName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the replication reshard status params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationReshardStatusParams) WithDefaults() *ReplicationReshardStatusParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the replication reshard status params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationReshardStatusParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the replication reshard status params
+func (o *ReplicationReshardStatusParams) WithTimeout(timeout time.Duration) *ReplicationReshardStatusParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the replication reshard status params
+func (o *ReplicationReshardStatusParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the replication reshard status params
+func (o *ReplicationReshardStatusParams) WithContext(ctx context.Context) *ReplicationReshardStatusParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the replication reshard status params
+func (o *ReplicationReshardStatusParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the replication reshard status params
+func (o *ReplicationReshardStatusParams) WithHTTPClient(client *http.Client) *ReplicationReshardStatusParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the replication reshard status params
+func (o *ReplicationReshardStatusParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClassName adds the className to the replication reshard status params
+func (o *ReplicationReshardStatusParams) WithClassName(className string) *ReplicationReshardStatusParams {
+	o.SetClassName(className)
+	return o
+}
+
+// SetClassName adds the className to the replication reshard status params
+func (o *ReplicationReshardStatusParams) SetClassName(className string) {
+	o.ClassName = className
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReplicationReshardStatusParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param className
+	if err := r.SetPathParam("className", o.ClassName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,398 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationReshardStatusReader is a Reader for the ReplicationReshardStatus structure.
+type ReplicationReshardStatusReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReplicationReshardStatusReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReplicationReshardStatusOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewReplicationReshardStatusUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReplicationReshardStatusForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewReplicationReshardStatusNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReplicationReshardStatusInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewReplicationReshardStatusOK creates a ReplicationReshardStatusOK with default headers values
+func NewReplicationReshardStatusOK() *ReplicationReshardStatusOK {
+	return &ReplicationReshardStatusOK{}
+}
+
+/*
+ReplicationReshardStatusOK describes a response with status code 200, with default header values.
+
+The state of the resharding
+*/
+type ReplicationReshardStatusOK struct {
+	Payload *models.ReshardingStatus
+}
+
+// IsSuccess returns true when this replication reshard status o k response has a 2xx status code
+func (o *ReplicationReshardStatusOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this replication reshard status o k response has a 3xx status code
+func (o *ReplicationReshardStatusOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication reshard status o k response has a 4xx status code
+func (o *ReplicationReshardStatusOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication reshard status o k response has a 5xx status code
+func (o *ReplicationReshardStatusOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication reshard status o k response a status code equal to that given
+func (o *ReplicationReshardStatusOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the replication reshard status o k response
+func (o *ReplicationReshardStatusOK) Code() int {
+	return 200
+}
+
+func (o *ReplicationReshardStatusOK) Error() string {
+	return fmt.Sprintf("[GET /replication/reshard/{className}][%d] replicationReshardStatusOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationReshardStatusOK) String() string {
+	return fmt.Sprintf("[GET /replication/reshard/{className}][%d] replicationReshardStatusOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationReshardStatusOK) GetPayload() *models.ReshardingStatus {
+	return o.Payload
+}
+
+func (o *ReplicationReshardStatusOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ReshardingStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationReshardStatusUnauthorized creates a ReplicationReshardStatusUnauthorized with default headers values
+func NewReplicationReshardStatusUnauthorized() *ReplicationReshardStatusUnauthorized {
+	return &ReplicationReshardStatusUnauthorized{}
+}
+
+/*
+ReplicationReshardStatusUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ReplicationReshardStatusUnauthorized struct {
+}
+
+// IsSuccess returns true when this replication reshard status unauthorized response has a 2xx status code
+func (o *ReplicationReshardStatusUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication reshard status unauthorized response has a 3xx status code
+func (o *ReplicationReshardStatusUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication reshard status unauthorized response has a 4xx status code
+func (o *ReplicationReshardStatusUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication reshard status unauthorized response has a 5xx status code
+func (o *ReplicationReshardStatusUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication reshard status unauthorized response a status code equal to that given
+func (o *ReplicationReshardStatusUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the replication reshard status unauthorized response
+func (o *ReplicationReshardStatusUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ReplicationReshardStatusUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /replication/reshard/{className}][%d] replicationReshardStatusUnauthorized ", 401)
+}
+
+func (o *ReplicationReshardStatusUnauthorized) String() string {
+	return fmt.Sprintf("[GET /replication/reshard/{className}][%d] replicationReshardStatusUnauthorized ", 401)
+}
+
+func (o *ReplicationReshardStatusUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationReshardStatusForbidden creates a ReplicationReshardStatusForbidden with default headers values
+func NewReplicationReshardStatusForbidden() *ReplicationReshardStatusForbidden {
+	return &ReplicationReshardStatusForbidden{}
+}
+
+/*
+ReplicationReshardStatusForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReplicationReshardStatusForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication reshard status forbidden response has a 2xx status code
+func (o *ReplicationReshardStatusForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication reshard status forbidden response has a 3xx status code
+func (o *ReplicationReshardStatusForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication reshard status forbidden response has a 4xx status code
+func (o *ReplicationReshardStatusForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication reshard status forbidden response has a 5xx status code
+func (o *ReplicationReshardStatusForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication reshard status forbidden response a status code equal to that given
+func (o *ReplicationReshardStatusForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the replication reshard status forbidden response
+func (o *ReplicationReshardStatusForbidden) Code() int {
+	return 403
+}
+
+func (o *ReplicationReshardStatusForbidden) Error() string {
+	return fmt.Sprintf("[GET /replication/reshard/{className}][%d] replicationReshardStatusForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationReshardStatusForbidden) String() string {
+	return fmt.Sprintf("[GET /replication/reshard/{className}][%d] replicationReshardStatusForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationReshardStatusForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationReshardStatusForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationReshardStatusNotFound creates a ReplicationReshardStatusNotFound with default headers values
+func NewReplicationReshardStatusNotFound() *ReplicationReshardStatusNotFound {
+	return &ReplicationReshardStatusNotFound{}
+}
+
+/*
+ReplicationReshardStatusNotFound describes a response with status code 404, with default header values.
+
+Not Found - Class does not exist or was never resharded
+*/
+type ReplicationReshardStatusNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication reshard status not found response has a 2xx status code
+func (o *ReplicationReshardStatusNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication reshard status not found response has a 3xx status code
+func (o *ReplicationReshardStatusNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication reshard status not found response has a 4xx status code
+func (o *ReplicationReshardStatusNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication reshard status not found response has a 5xx status code
+func (o *ReplicationReshardStatusNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication reshard status not found response a status code equal to that given
+func (o *ReplicationReshardStatusNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the replication reshard status not found response
+func (o *ReplicationReshardStatusNotFound) Code() int {
+	return 404
+}
+
+func (o *ReplicationReshardStatusNotFound) Error() string {
+	return fmt.Sprintf("[GET /replication/reshard/{className}][%d] replicationReshardStatusNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ReplicationReshardStatusNotFound) String() string {
+	return fmt.Sprintf("[GET /replication/reshard/{className}][%d] replicationReshardStatusNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ReplicationReshardStatusNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationReshardStatusNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationReshardStatusInternalServerError creates a ReplicationReshardStatusInternalServerError with default headers values
+func NewReplicationReshardStatusInternalServerError() *ReplicationReshardStatusInternalServerError {
+	return &ReplicationReshardStatusInternalServerError{}
+}
+
+/*
+ReplicationReshardStatusInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ReplicationReshardStatusInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication reshard status internal server error response has a 2xx status code
+func (o *ReplicationReshardStatusInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication reshard status internal server error response has a 3xx status code
+func (o *ReplicationReshardStatusInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication reshard status internal server error response has a 4xx status code
+func (o *ReplicationReshardStatusInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication reshard status internal server error response has a 5xx status code
+func (o *ReplicationReshardStatusInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this replication reshard status internal server error response a status code equal to that given
+func (o *ReplicationReshardStatusInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the replication reshard status internal server error response
+func (o *ReplicationReshardStatusInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReplicationReshardStatusInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /replication/reshard/{className}][%d] replicationReshardStatusInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationReshardStatusInternalServerError) String() string {
+	return fmt.Sprintf("[GET /replication/reshard/{className}][%d] replicationReshardStatusInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationReshardStatusInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationReshardStatusInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
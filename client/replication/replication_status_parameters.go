@@ -0,0 +1,174 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReplicationStatusParams creates a new ReplicationStatusParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReplicationStatusParams() *ReplicationStatusParams {
+	return &ReplicationStatusParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReplicationStatusParamsWithTimeout creates a new ReplicationStatusParams object
+// with the ability to set a timeout on a request.
+func NewReplicationStatusParamsWithTimeout(timeout time.Duration) *ReplicationStatusParams {
+	return &ReplicationStatusParams{
+		timeout: timeout,
+	}
+}
+
+// NewReplicationStatusParamsWithContext creates a new ReplicationStatusParams object
+// with the ability to set a context for a request.
+func NewReplicationStatusParamsWithContext(ctx context.Context) *ReplicationStatusParams {
+	return &ReplicationStatusParams{
+		Context: ctx,
+	}
+}
+
+// NewReplicationStatusParamsWithHTTPClient creates a new ReplicationStatusParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReplicationStatusParamsWithHTTPClient(client *http.Client) *ReplicationStatusParams {
+	return &ReplicationStatusParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReplicationStatusParams contains all the parameters to send to the API endpoint
+
+	for the replication status operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReplicationStatusParams struct {
+
+	/* Class.
+
+	   Only return the status of the shards of this class.
+	*/
+	Class *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the replication status params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationStatusParams) WithDefaults() *ReplicationStatusParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the replication status params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationStatusParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the replication status params
+func (o *ReplicationStatusParams) WithTimeout(timeout time.Duration) *ReplicationStatusParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the replication status params
+func (o *ReplicationStatusParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the replication status params
+func (o *ReplicationStatusParams) WithContext(ctx context.Context) *ReplicationStatusParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the replication status params
+func (o *ReplicationStatusParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the replication status params
+func (o *ReplicationStatusParams) WithHTTPClient(client *http.Client) *ReplicationStatusParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the replication status params
+func (o *ReplicationStatusParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClass adds the class to the replication status params
+func (o *ReplicationStatusParams) WithClass(class *string) *ReplicationStatusParams {
+	o.SetClass(class)
+	return o
+}
+
+// SetClass adds the class to the replication status params
+func (o *ReplicationStatusParams) SetClass(class *string) {
+	o.Class = class
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReplicationStatusParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Class != nil {
+
+		// query param class
+		var qrClass string
+
+		if o.Class != nil {
+			qrClass = *o.Class
+		}
+		qClass := qrClass
+		if qClass != "" {
+
+			if err := r.SetQueryParam("class", qClass); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
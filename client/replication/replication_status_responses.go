@@ -0,0 +1,398 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationStatusReader is a Reader for the ReplicationStatus structure.
+type ReplicationStatusReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReplicationStatusReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReplicationStatusOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewReplicationStatusUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReplicationStatusForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewReplicationStatusNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReplicationStatusInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewReplicationStatusOK creates a ReplicationStatusOK with default headers values
+func NewReplicationStatusOK() *ReplicationStatusOK {
+	return &ReplicationStatusOK{}
+}
+
+/*
+ReplicationStatusOK describes a response with status code 200, with default header values.
+
+Replication status successfully returned
+*/
+type ReplicationStatusOK struct {
+	Payload *models.ReplicationStatusResponse
+}
+
+// IsSuccess returns true when this replication status o k response has a 2xx status code
+func (o *ReplicationStatusOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this replication status o k response has a 3xx status code
+func (o *ReplicationStatusOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication status o k response has a 4xx status code
+func (o *ReplicationStatusOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication status o k response has a 5xx status code
+func (o *ReplicationStatusOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication status o k response a status code equal to that given
+func (o *ReplicationStatusOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the replication status o k response
+func (o *ReplicationStatusOK) Code() int {
+	return 200
+}
+
+func (o *ReplicationStatusOK) Error() string {
+	return fmt.Sprintf("[GET /replication/status][%d] replicationStatusOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationStatusOK) String() string {
+	return fmt.Sprintf("[GET /replication/status][%d] replicationStatusOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationStatusOK) GetPayload() *models.ReplicationStatusResponse {
+	return o.Payload
+}
+
+func (o *ReplicationStatusOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ReplicationStatusResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationStatusUnauthorized creates a ReplicationStatusUnauthorized with default headers values
+func NewReplicationStatusUnauthorized() *ReplicationStatusUnauthorized {
+	return &ReplicationStatusUnauthorized{}
+}
+
+/*
+ReplicationStatusUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ReplicationStatusUnauthorized struct {
+}
+
+// IsSuccess returns true when this replication status unauthorized response has a 2xx status code
+func (o *ReplicationStatusUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication status unauthorized response has a 3xx status code
+func (o *ReplicationStatusUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication status unauthorized response has a 4xx status code
+func (o *ReplicationStatusUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication status unauthorized response has a 5xx status code
+func (o *ReplicationStatusUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication status unauthorized response a status code equal to that given
+func (o *ReplicationStatusUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the replication status unauthorized response
+func (o *ReplicationStatusUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ReplicationStatusUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /replication/status][%d] replicationStatusUnauthorized ", 401)
+}
+
+func (o *ReplicationStatusUnauthorized) String() string {
+	return fmt.Sprintf("[GET /replication/status][%d] replicationStatusUnauthorized ", 401)
+}
+
+func (o *ReplicationStatusUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationStatusForbidden creates a ReplicationStatusForbidden with default headers values
+func NewReplicationStatusForbidden() *ReplicationStatusForbidden {
+	return &ReplicationStatusForbidden{}
+}
+
+/*
+ReplicationStatusForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReplicationStatusForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication status forbidden response has a 2xx status code
+func (o *ReplicationStatusForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication status forbidden response has a 3xx status code
+func (o *ReplicationStatusForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication status forbidden response has a 4xx status code
+func (o *ReplicationStatusForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication status forbidden response has a 5xx status code
+func (o *ReplicationStatusForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication status forbidden response a status code equal to that given
+func (o *ReplicationStatusForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the replication status forbidden response
+func (o *ReplicationStatusForbidden) Code() int {
+	return 403
+}
+
+func (o *ReplicationStatusForbidden) Error() string {
+	return fmt.Sprintf("[GET /replication/status][%d] replicationStatusForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationStatusForbidden) String() string {
+	return fmt.Sprintf("[GET /replication/status][%d] replicationStatusForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationStatusForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationStatusForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationStatusNotFound creates a ReplicationStatusNotFound with default headers values
+func NewReplicationStatusNotFound() *ReplicationStatusNotFound {
+	return &ReplicationStatusNotFound{}
+}
+
+/*
+ReplicationStatusNotFound describes a response with status code 404, with default header values.
+
+Not Found - Class does not exist
+*/
+type ReplicationStatusNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication status not found response has a 2xx status code
+func (o *ReplicationStatusNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication status not found response has a 3xx status code
+func (o *ReplicationStatusNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication status not found response has a 4xx status code
+func (o *ReplicationStatusNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication status not found response has a 5xx status code
+func (o *ReplicationStatusNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication status not found response a status code equal to that given
+func (o *ReplicationStatusNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the replication status not found response
+func (o *ReplicationStatusNotFound) Code() int {
+	return 404
+}
+
+func (o *ReplicationStatusNotFound) Error() string {
+	return fmt.Sprintf("[GET /replication/status][%d] replicationStatusNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ReplicationStatusNotFound) String() string {
+	return fmt.Sprintf("[GET /replication/status][%d] replicationStatusNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ReplicationStatusNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationStatusNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationStatusInternalServerError creates a ReplicationStatusInternalServerError with default headers values
+func NewReplicationStatusInternalServerError() *ReplicationStatusInternalServerError {
+	return &ReplicationStatusInternalServerError{}
+}
+
+/*
+ReplicationStatusInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ReplicationStatusInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication status internal server error response has a 2xx status code
+func (o *ReplicationStatusInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication status internal server error response has a 3xx status code
+func (o *ReplicationStatusInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication status internal server error response has a 4xx status code
+func (o *ReplicationStatusInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication status internal server error response has a 5xx status code
+func (o *ReplicationStatusInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this replication status internal server error response a status code equal to that given
+func (o *ReplicationStatusInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the replication status internal server error response
+func (o *ReplicationStatusInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReplicationStatusInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /replication/status][%d] replicationStatusInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationStatusInternalServerError) String() string {
+	return fmt.Sprintf("[GET /replication/status][%d] replicationStatusInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationStatusInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationStatusInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReplicationTransferListParams creates a new ReplicationTransferListParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReplicationTransferListParams() *ReplicationTransferListParams {
+	return &ReplicationTransferListParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReplicationTransferListParamsWithTimeout creates a new ReplicationTransferListParams object
+// with the ability to set a timeout on a request.
+func NewReplicationTransferListParamsWithTimeout(timeout time.Duration) *ReplicationTransferListParams {
+	return &ReplicationTransferListParams{
+		timeout: timeout,
+	}
+}
+
+// NewReplicationTransferListParamsWithContext creates a new ReplicationTransferListParams object
+// with the ability to set a context for a request.
+func NewReplicationTransferListParamsWithContext(ctx context.Context) *ReplicationTransferListParams {
+	return &ReplicationTransferListParams{
+		Context: ctx,
+	}
+}
+
+// NewReplicationTransferListParamsWithHTTPClient creates a new ReplicationTransferListParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReplicationTransferListParamsWithHTTPClient(client *http.Client) *ReplicationTransferListParams {
+	return &ReplicationTransferListParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReplicationTransferListParams contains all the parameters to send to the API endpoint
+
+	for the replication transfer list operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReplicationTransferListParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the replication transfer list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationTransferListParams) WithDefaults() *ReplicationTransferListParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the replication transfer list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationTransferListParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the replication transfer list params
+func (o *ReplicationTransferListParams) WithTimeout(timeout time.Duration) *ReplicationTransferListParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the replication transfer list params
+func (o *ReplicationTransferListParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the replication transfer list params
+func (o *ReplicationTransferListParams) WithContext(ctx context.Context) *ReplicationTransferListParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the replication transfer list params
+func (o *ReplicationTransferListParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the replication transfer list params
+func (o *ReplicationTransferListParams) WithHTTPClient(client *http.Client) *ReplicationTransferListParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the replication transfer list params
+func (o *ReplicationTransferListParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReplicationTransferListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
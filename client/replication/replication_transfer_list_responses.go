@@ -0,0 +1,322 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationTransferListReader is a Reader for the ReplicationTransferList structure.
+type ReplicationTransferListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReplicationTransferListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReplicationTransferListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewReplicationTransferListUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReplicationTransferListForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReplicationTransferListInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewReplicationTransferListOK creates a ReplicationTransferListOK with default headers values
+func NewReplicationTransferListOK() *ReplicationTransferListOK {
+	return &ReplicationTransferListOK{}
+}
+
+/*
+ReplicationTransferListOK describes a response with status code 200, with default header values.
+
+The shard transfers, most recent last
+*/
+type ReplicationTransferListOK struct {
+	Payload []*models.ShardTransferStatus
+}
+
+// IsSuccess returns true when this replication transfer list o k response has a 2xx status code
+func (o *ReplicationTransferListOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this replication transfer list o k response has a 3xx status code
+func (o *ReplicationTransferListOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication transfer list o k response has a 4xx status code
+func (o *ReplicationTransferListOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication transfer list o k response has a 5xx status code
+func (o *ReplicationTransferListOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication transfer list o k response a status code equal to that given
+func (o *ReplicationTransferListOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the replication transfer list o k response
+func (o *ReplicationTransferListOK) Code() int {
+	return 200
+}
+
+func (o *ReplicationTransferListOK) Error() string {
+	return fmt.Sprintf("[GET /replication/transfer][%d] replicationTransferListOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationTransferListOK) String() string {
+	return fmt.Sprintf("[GET /replication/transfer][%d] replicationTransferListOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationTransferListOK) GetPayload() []*models.ShardTransferStatus {
+	return o.Payload
+}
+
+func (o *ReplicationTransferListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationTransferListUnauthorized creates a ReplicationTransferListUnauthorized with default headers values
+func NewReplicationTransferListUnauthorized() *ReplicationTransferListUnauthorized {
+	return &ReplicationTransferListUnauthorized{}
+}
+
+/*
+ReplicationTransferListUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ReplicationTransferListUnauthorized struct {
+}
+
+// IsSuccess returns true when this replication transfer list unauthorized response has a 2xx status code
+func (o *ReplicationTransferListUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication transfer list unauthorized response has a 3xx status code
+func (o *ReplicationTransferListUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication transfer list unauthorized response has a 4xx status code
+func (o *ReplicationTransferListUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication transfer list unauthorized response has a 5xx status code
+func (o *ReplicationTransferListUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication transfer list unauthorized response a status code equal to that given
+func (o *ReplicationTransferListUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the replication transfer list unauthorized response
+func (o *ReplicationTransferListUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ReplicationTransferListUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /replication/transfer][%d] replicationTransferListUnauthorized ", 401)
+}
+
+func (o *ReplicationTransferListUnauthorized) String() string {
+	return fmt.Sprintf("[GET /replication/transfer][%d] replicationTransferListUnauthorized ", 401)
+}
+
+func (o *ReplicationTransferListUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationTransferListForbidden creates a ReplicationTransferListForbidden with default headers values
+func NewReplicationTransferListForbidden() *ReplicationTransferListForbidden {
+	return &ReplicationTransferListForbidden{}
+}
+
+/*
+ReplicationTransferListForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReplicationTransferListForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication transfer list forbidden response has a 2xx status code
+func (o *ReplicationTransferListForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication transfer list forbidden response has a 3xx status code
+func (o *ReplicationTransferListForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication transfer list forbidden response has a 4xx status code
+func (o *ReplicationTransferListForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication transfer list forbidden response has a 5xx status code
+func (o *ReplicationTransferListForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication transfer list forbidden response a status code equal to that given
+func (o *ReplicationTransferListForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the replication transfer list forbidden response
+func (o *ReplicationTransferListForbidden) Code() int {
+	return 403
+}
+
+func (o *ReplicationTransferListForbidden) Error() string {
+	return fmt.Sprintf("[GET /replication/transfer][%d] replicationTransferListForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationTransferListForbidden) String() string {
+	return fmt.Sprintf("[GET /replication/transfer][%d] replicationTransferListForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationTransferListForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationTransferListForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationTransferListInternalServerError creates a ReplicationTransferListInternalServerError with default headers values
+func NewReplicationTransferListInternalServerError() *ReplicationTransferListInternalServerError {
+	return &ReplicationTransferListInternalServerError{}
+}
+
+/*
+ReplicationTransferListInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ReplicationTransferListInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication transfer list internal server error response has a 2xx status code
+func (o *ReplicationTransferListInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication transfer list internal server error response has a 3xx status code
+func (o *ReplicationTransferListInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication transfer list internal server error response has a 4xx status code
+func (o *ReplicationTransferListInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication transfer list internal server error response has a 5xx status code
+func (o *ReplicationTransferListInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this replication transfer list internal server error response a status code equal to that given
+func (o *ReplicationTransferListInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the replication transfer list internal server error response
+func (o *ReplicationTransferListInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReplicationTransferListInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /replication/transfer][%d] replicationTransferListInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationTransferListInternalServerError) String() string {
+	return fmt.Sprintf("[GET /replication/transfer][%d] replicationTransferListInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationTransferListInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationTransferListInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
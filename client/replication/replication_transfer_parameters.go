@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewReplicationTransferParams creates a new ReplicationTransferParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReplicationTransferParams() *ReplicationTransferParams {
+	return &ReplicationTransferParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReplicationTransferParamsWithTimeout creates a new ReplicationTransferParams object
+// with the ability to set a timeout on a request.
+func NewReplicationTransferParamsWithTimeout(timeout time.Duration) *ReplicationTransferParams {
+	return &ReplicationTransferParams{
+		timeout: timeout,
+	}
+}
+
+// NewReplicationTransferParamsWithContext creates a new ReplicationTransferParams object
+// with the ability to set a context for a request.
+func NewReplicationTransferParamsWithContext(ctx context.Context) *ReplicationTransferParams {
+	return &ReplicationTransferParams{
+		Context: ctx,
+	}
+}
+
+// NewReplicationTransferParamsWithHTTPClient creates a new ReplicationTransferParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReplicationTransferParamsWithHTTPClient(client *http.Client) *ReplicationTransferParams {
+	return &ReplicationTransferParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReplicationTransferParams contains all the parameters to send to the API endpoint
+
+	for the replication transfer operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReplicationTransferParams struct {
+
+	// Body.
+	Body *models.ShardTransferRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the replication transfer params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationTransferParams) WithDefaults() *ReplicationTransferParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the replication transfer params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationTransferParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the replication transfer params
+func (o *ReplicationTransferParams) WithTimeout(timeout time.Duration) *ReplicationTransferParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the replication transfer params
+func (o *ReplicationTransferParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the replication transfer params
+func (o *ReplicationTransferParams) WithContext(ctx context.Context) *ReplicationTransferParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the replication transfer params
+func (o *ReplicationTransferParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the replication transfer params
+func (o *ReplicationTransferParams) WithHTTPClient(client *http.Client) *ReplicationTransferParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the replication transfer params
+func (o *ReplicationTransferParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the replication transfer params
+func (o *ReplicationTransferParams) WithBody(body *models.ShardTransferRequest) *ReplicationTransferParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the replication transfer params
+func (o *ReplicationTransferParams) SetBody(body *models.ShardTransferRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReplicationTransferParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationTransferReader is a Reader for the ReplicationTransfer structure.
+type ReplicationTransferReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReplicationTransferReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 202:
+		result := NewReplicationTransferAccepted()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewReplicationTransferUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReplicationTransferForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewReplicationTransferNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewReplicationTransferUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReplicationTransferInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewReplicationTransferAccepted creates a ReplicationTransferAccepted with default headers values
+func NewReplicationTransferAccepted() *ReplicationTransferAccepted {
+	return &ReplicationTransferAccepted{}
+}
+
+/*
+ReplicationTransferAccepted describes a response with status code 202, with default header values.
+
+Shard transfer started
+*/
+type ReplicationTransferAccepted struct {
+	Payload *models.ShardTransferStatus
+}
+
+// IsSuccess returns true when this replication transfer accepted response has a 2xx status code
+func (o *ReplicationTransferAccepted) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this replication transfer accepted response has a 3xx status code
+func (o *ReplicationTransferAccepted) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication transfer accepted response has a 4xx status code
+func (o *ReplicationTransferAccepted) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication transfer accepted response has a 5xx status code
+func (o *ReplicationTransferAccepted) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication transfer accepted response a status code equal to that given
+func (o *ReplicationTransferAccepted) IsCode(code int) bool {
+	return code == 202
+}
+
+// Code gets the status code for the replication transfer accepted response
+func (o *ReplicationTransferAccepted) Code() int {
+	return 202
+}
+
+func (o *ReplicationTransferAccepted) Error() string {
+	return fmt.Sprintf("[POST /replication/transfer][%d] replicationTransferAccepted  %+v", 202, o.Payload)
+}
+
+func (o *ReplicationTransferAccepted) String() string {
+	return fmt.Sprintf("[POST /replication/transfer][%d] replicationTransferAccepted  %+v", 202, o.Payload)
+}
+
+func (o *ReplicationTransferAccepted) GetPayload() *models.ShardTransferStatus {
+	return o.Payload
+}
+
+func (o *ReplicationTransferAccepted) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ShardTransferStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationTransferUnauthorized creates a ReplicationTransferUnauthorized with default headers values
+func NewReplicationTransferUnauthorized() *ReplicationTransferUnauthorized {
+	return &ReplicationTransferUnauthorized{}
+}
+
+/*
+ReplicationTransferUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ReplicationTransferUnauthorized struct {
+}
+
+// IsSuccess returns true when this replication transfer unauthorized response has a 2xx status code
+func (o *ReplicationTransferUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication transfer unauthorized response has a 3xx status code
+func (o *ReplicationTransferUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication transfer unauthorized response has a 4xx status code
+func (o *ReplicationTransferUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication transfer unauthorized response has a 5xx status code
+func (o *ReplicationTransferUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication transfer unauthorized response a status code equal to that given
+func (o *ReplicationTransferUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the replication transfer unauthorized response
+func (o *ReplicationTransferUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ReplicationTransferUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /replication/transfer][%d] replicationTransferUnauthorized ", 401)
+}
+
+func (o *ReplicationTransferUnauthorized) String() string {
+	return fmt.Sprintf("[POST /replication/transfer][%d] replicationTransferUnauthorized ", 401)
+}
+
+func (o *ReplicationTransferUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationTransferForbidden creates a ReplicationTransferForbidden with default headers values
+func NewReplicationTransferForbidden() *ReplicationTransferForbidden {
+	return &ReplicationTransferForbidden{}
+}
+
+/*
+ReplicationTransferForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReplicationTransferForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication transfer forbidden response has a 2xx status code
+func (o *ReplicationTransferForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication transfer forbidden response has a 3xx status code
+func (o *ReplicationTransferForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication transfer forbidden response has a 4xx status code
+func (o *ReplicationTransferForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication transfer forbidden response has a 5xx status code
+func (o *ReplicationTransferForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication transfer forbidden response a status code equal to that given
+func (o *ReplicationTransferForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the replication transfer forbidden response
+func (o *ReplicationTransferForbidden) Code() int {
+	return 403
+}
+
+func (o *ReplicationTransferForbidden) Error() string {
+	return fmt.Sprintf("[POST /replication/transfer][%d] replicationTransferForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationTransferForbidden) String() string {
+	return fmt.Sprintf("[POST /replication/transfer][%d] replicationTransferForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationTransferForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationTransferForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationTransferNotFound creates a ReplicationTransferNotFound with default headers values
+func NewReplicationTransferNotFound() *ReplicationTransferNotFound {
+	return &ReplicationTransferNotFound{}
+}
+
+/*
+ReplicationTransferNotFound describes a response with status code 404, with default header values.
+
+Not Found - Class or shard does not exist
+*/
+type ReplicationTransferNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication transfer not found response has a 2xx status code
+func (o *ReplicationTransferNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication transfer not found response has a 3xx status code
+func (o *ReplicationTransferNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication transfer not found response has a 4xx status code
+func (o *ReplicationTransferNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication transfer not found response has a 5xx status code
+func (o *ReplicationTransferNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication transfer not found response a status code equal to that given
+func (o *ReplicationTransferNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the replication transfer not found response
+func (o *ReplicationTransferNotFound) Code() int {
+	return 404
+}
+
+func (o *ReplicationTransferNotFound) Error() string {
+	return fmt.Sprintf("[POST /replication/transfer][%d] replicationTransferNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ReplicationTransferNotFound) String() string {
+	return fmt.Sprintf("[POST /replication/transfer][%d] replicationTransferNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ReplicationTransferNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationTransferNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationTransferUnprocessableEntity creates a ReplicationTransferUnprocessableEntity with default headers values
+func NewReplicationTransferUnprocessableEntity() *ReplicationTransferUnprocessableEntity {
+	return &ReplicationTransferUnprocessableEntity{}
+}
+
+/*
+ReplicationTransferUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid transfer request, e.g. the source node does not hold the shard or the target node already does.
+*/
+type ReplicationTransferUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication transfer unprocessable entity response has a 2xx status code
+func (o *ReplicationTransferUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication transfer unprocessable entity response has a 3xx status code
+func (o *ReplicationTransferUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication transfer unprocessable entity response has a 4xx status code
+func (o *ReplicationTransferUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication transfer unprocessable entity response has a 5xx status code
+func (o *ReplicationTransferUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication transfer unprocessable entity response a status code equal to that given
+func (o *ReplicationTransferUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the replication transfer unprocessable entity response
+func (o *ReplicationTransferUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ReplicationTransferUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /replication/transfer][%d] replicationTransferUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationTransferUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /replication/transfer][%d] replicationTransferUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationTransferUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationTransferUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationTransferInternalServerError creates a ReplicationTransferInternalServerError with default headers values
+func NewReplicationTransferInternalServerError() *ReplicationTransferInternalServerError {
+	return &ReplicationTransferInternalServerError{}
+}
+
+/*
+ReplicationTransferInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ReplicationTransferInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication transfer internal server error response has a 2xx status code
+func (o *ReplicationTransferInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication transfer internal server error response has a 3xx status code
+func (o *ReplicationTransferInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication transfer internal server error response has a 4xx status code
+func (o *ReplicationTransferInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication transfer internal server error response has a 5xx status code
+func (o *ReplicationTransferInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this replication transfer internal server error response a status code equal to that given
+func (o *ReplicationTransferInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the replication transfer internal server error response
+func (o *ReplicationTransferInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReplicationTransferInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /replication/transfer][%d] replicationTransferInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationTransferInternalServerError) String() string {
+	return fmt.Sprintf("[POST /replication/transfer][%d] replicationTransferInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationTransferInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationTransferInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewSchemaObjectsVectorRecallParams creates a new SchemaObjectsVectorRecallParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSchemaObjectsVectorRecallParams() *SchemaObjectsVectorRecallParams {
+	return &SchemaObjectsVectorRecallParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSchemaObjectsVectorRecallParamsWithTimeout creates a new SchemaObjectsVectorRecallParams object
+// with the ability to set a timeout on a request.
+func NewSchemaObjectsVectorRecallParamsWithTimeout(timeout time.Duration) *SchemaObjectsVectorRecallParams {
+	return &SchemaObjectsVectorRecallParams{
+		timeout: timeout,
+	}
+}
+
+// NewSchemaObjectsVectorRecallParamsWithContext creates a new SchemaObjectsVectorRecallParams object
+// with the ability to set a context for a request.
+func NewSchemaObjectsVectorRecallParamsWithContext(ctx context.Context) *SchemaObjectsVectorRecallParams {
+	return &SchemaObjectsVectorRecallParams{
+		Context: ctx,
+	}
+}
+
+// NewSchemaObjectsVectorRecallParamsWithHTTPClient creates a new SchemaObjectsVectorRecallParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSchemaObjectsVectorRecallParamsWithHTTPClient(client *http.Client) *SchemaObjectsVectorRecallParams {
+	return &SchemaObjectsVectorRecallParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SchemaObjectsVectorRecallParams contains all the parameters to send to the API endpoint
+
+	for the schema objects vector recall operation.
+
+	Typically these are written to a http.Request.
+*/
+type SchemaObjectsVectorRecallParams struct {
+
+	// Body.
+	Body *models.VectorRecallRequest
+
+	// ClassName.
+	ClassName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the schema objects vector recall params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaObjectsVectorRecallParams) WithDefaults() *SchemaObjectsVectorRecallParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the schema objects vector recall params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaObjectsVectorRecallParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the schema objects vector recall params
+func (o *SchemaObjectsVectorRecallParams) WithTimeout(timeout time.Duration) *SchemaObjectsVectorRecallParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the schema objects vector recall params
+func (o *SchemaObjectsVectorRecallParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the schema objects vector recall params
+func (o *SchemaObjectsVectorRecallParams) WithContext(ctx context.Context) *SchemaObjectsVectorRecallParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the schema objects vector recall params
+func (o *SchemaObjectsVectorRecallParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the schema objects vector recall params
+func (o *SchemaObjectsVectorRecallParams) WithHTTPClient(client *http.Client) *SchemaObjectsVectorRecallParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the schema objects vector recall params
+func (o *SchemaObjectsVectorRecallParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the schema objects vector recall params
+func (o *SchemaObjectsVectorRecallParams) WithBody(body *models.VectorRecallRequest) *SchemaObjectsVectorRecallParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the schema objects vector recall params
+func (o *SchemaObjectsVectorRecallParams) SetBody(body *models.VectorRecallRequest) {
+	o.Body = body
+}
+
+// WithClassName adds the className to the schema objects vector recall params
+func (o *SchemaObjectsVectorRecallParams) WithClassName(className string) *SchemaObjectsVectorRecallParams {
+	o.SetClassName(className)
+	return o
+}
+
+// SetClassName adds the className to the schema objects vector recall params
+func (o *SchemaObjectsVectorRecallParams) SetClassName(className string) {
+	o.ClassName = className
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SchemaObjectsVectorRecallParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param className
+	if err := r.SetPathParam("className", o.ClassName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorRecallReader is a Reader for the SchemaObjectsVectorRecall structure.
+type SchemaObjectsVectorRecallReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsVectorRecallReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsVectorRecallOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsVectorRecallUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsVectorRecallForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsVectorRecallNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaObjectsVectorRecallUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsVectorRecallInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsVectorRecallOK creates a SchemaObjectsVectorRecallOK with default headers values
+func NewSchemaObjectsVectorRecallOK() *SchemaObjectsVectorRecallOK {
+	return &SchemaObjectsVectorRecallOK{}
+}
+
+/*
+SchemaObjectsVectorRecallOK describes a response with status code 200, with default header values.
+
+The recall was measured
+*/
+type SchemaObjectsVectorRecallOK struct {
+	Payload *models.VectorRecallReport
+}
+
+// IsSuccess returns true when this schema objects vector recall o k response has a 2xx status code
+func (o *SchemaObjectsVectorRecallOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects vector recall o k response has a 3xx status code
+func (o *SchemaObjectsVectorRecallOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector recall o k response has a 4xx status code
+func (o *SchemaObjectsVectorRecallOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vector recall o k response has a 5xx status code
+func (o *SchemaObjectsVectorRecallOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector recall o k response a status code equal to that given
+func (o *SchemaObjectsVectorRecallOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects vector recall o k response
+func (o *SchemaObjectsVectorRecallOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsVectorRecallOK) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-recall][%d] schemaObjectsVectorRecallOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsVectorRecallOK) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-recall][%d] schemaObjectsVectorRecallOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsVectorRecallOK) GetPayload() *models.VectorRecallReport {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorRecallOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.VectorRecallReport)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorRecallUnauthorized creates a SchemaObjectsVectorRecallUnauthorized with default headers values
+func NewSchemaObjectsVectorRecallUnauthorized() *SchemaObjectsVectorRecallUnauthorized {
+	return &SchemaObjectsVectorRecallUnauthorized{}
+}
+
+/*
+SchemaObjectsVectorRecallUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsVectorRecallUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects vector recall unauthorized response has a 2xx status code
+func (o *SchemaObjectsVectorRecallUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector recall unauthorized response has a 3xx status code
+func (o *SchemaObjectsVectorRecallUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector recall unauthorized response has a 4xx status code
+func (o *SchemaObjectsVectorRecallUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector recall unauthorized response has a 5xx status code
+func (o *SchemaObjectsVectorRecallUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector recall unauthorized response a status code equal to that given
+func (o *SchemaObjectsVectorRecallUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects vector recall unauthorized response
+func (o *SchemaObjectsVectorRecallUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsVectorRecallUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-recall][%d] schemaObjectsVectorRecallUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorRecallUnauthorized) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-recall][%d] schemaObjectsVectorRecallUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorRecallUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsVectorRecallForbidden creates a SchemaObjectsVectorRecallForbidden with default headers values
+func NewSchemaObjectsVectorRecallForbidden() *SchemaObjectsVectorRecallForbidden {
+	return &SchemaObjectsVectorRecallForbidden{}
+}
+
+/*
+SchemaObjectsVectorRecallForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsVectorRecallForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector recall forbidden response has a 2xx status code
+func (o *SchemaObjectsVectorRecallForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector recall forbidden response has a 3xx status code
+func (o *SchemaObjectsVectorRecallForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector recall forbidden response has a 4xx status code
+func (o *SchemaObjectsVectorRecallForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector recall forbidden response has a 5xx status code
+func (o *SchemaObjectsVectorRecallForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector recall forbidden response a status code equal to that given
+func (o *SchemaObjectsVectorRecallForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects vector recall forbidden response
+func (o *SchemaObjectsVectorRecallForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsVectorRecallForbidden) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-recall][%d] schemaObjectsVectorRecallForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorRecallForbidden) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-recall][%d] schemaObjectsVectorRecallForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorRecallForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorRecallForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorRecallNotFound creates a SchemaObjectsVectorRecallNotFound with default headers values
+func NewSchemaObjectsVectorRecallNotFound() *SchemaObjectsVectorRecallNotFound {
+	return &SchemaObjectsVectorRecallNotFound{}
+}
+
+/*
+SchemaObjectsVectorRecallNotFound describes a response with status code 404, with default header values.
+
+This class does not exist
+*/
+type SchemaObjectsVectorRecallNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector recall not found response has a 2xx status code
+func (o *SchemaObjectsVectorRecallNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector recall not found response has a 3xx status code
+func (o *SchemaObjectsVectorRecallNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector recall not found response has a 4xx status code
+func (o *SchemaObjectsVectorRecallNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector recall not found response has a 5xx status code
+func (o *SchemaObjectsVectorRecallNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector recall not found response a status code equal to that given
+func (o *SchemaObjectsVectorRecallNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects vector recall not found response
+func (o *SchemaObjectsVectorRecallNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsVectorRecallNotFound) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-recall][%d] schemaObjectsVectorRecallNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsVectorRecallNotFound) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-recall][%d] schemaObjectsVectorRecallNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsVectorRecallNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorRecallNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorRecallUnprocessableEntity creates a SchemaObjectsVectorRecallUnprocessableEntity with default headers values
+func NewSchemaObjectsVectorRecallUnprocessableEntity() *SchemaObjectsVectorRecallUnprocessableEntity {
+	return &SchemaObjectsVectorRecallUnprocessableEntity{}
+}
+
+/*
+SchemaObjectsVectorRecallUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid target vector, tenant or parameters
+*/
+type SchemaObjectsVectorRecallUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector recall unprocessable entity response has a 2xx status code
+func (o *SchemaObjectsVectorRecallUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector recall unprocessable entity response has a 3xx status code
+func (o *SchemaObjectsVectorRecallUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector recall unprocessable entity response has a 4xx status code
+func (o *SchemaObjectsVectorRecallUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector recall unprocessable entity response has a 5xx status code
+func (o *SchemaObjectsVectorRecallUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector recall unprocessable entity response a status code equal to that given
+func (o *SchemaObjectsVectorRecallUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the schema objects vector recall unprocessable entity response
+func (o *SchemaObjectsVectorRecallUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SchemaObjectsVectorRecallUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-recall][%d] schemaObjectsVectorRecallUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsVectorRecallUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-recall][%d] schemaObjectsVectorRecallUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsVectorRecallUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorRecallUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorRecallInternalServerError creates a SchemaObjectsVectorRecallInternalServerError with default headers values
+func NewSchemaObjectsVectorRecallInternalServerError() *SchemaObjectsVectorRecallInternalServerError {
+	return &SchemaObjectsVectorRecallInternalServerError{}
+}
+
+/*
+SchemaObjectsVectorRecallInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsVectorRecallInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector recall internal server error response has a 2xx status code
+func (o *SchemaObjectsVectorRecallInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector recall internal server error response has a 3xx status code
+func (o *SchemaObjectsVectorRecallInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector recall internal server error response has a 4xx status code
+func (o *SchemaObjectsVectorRecallInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vector recall internal server error response has a 5xx status code
+func (o *SchemaObjectsVectorRecallInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects vector recall internal server error response a status code equal to that given
+func (o *SchemaObjectsVectorRecallInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects vector recall internal server error response
+func (o *SchemaObjectsVectorRecallInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsVectorRecallInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-recall][%d] schemaObjectsVectorRecallInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorRecallInternalServerError) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-recall][%d] schemaObjectsVectorRecallInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorRecallInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorRecallInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorReindexCancelReader is a Reader for the SchemaObjectsVectorReindexCancel structure.
+type SchemaObjectsVectorReindexCancelReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsVectorReindexCancelReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsVectorReindexCancelOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsVectorReindexCancelUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsVectorReindexCancelForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsVectorReindexCancelNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaObjectsVectorReindexCancelUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsVectorReindexCancelInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsVectorReindexCancelOK creates a SchemaObjectsVectorReindexCancelOK with default headers values
+func NewSchemaObjectsVectorReindexCancelOK() *SchemaObjectsVectorReindexCancelOK {
+	return &SchemaObjectsVectorReindexCancelOK{}
+}
+
+/*
+SchemaObjectsVectorReindexCancelOK describes a response with status code 200, with default header values.
+
+The job was cancelled
+*/
+type SchemaObjectsVectorReindexCancelOK struct {
+	Payload *models.VectorReindexStatus
+}
+
+// IsSuccess returns true when this schema objects vector reindex cancel o k response has a 2xx status code
+func (o *SchemaObjectsVectorReindexCancelOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects vector reindex cancel o k response has a 3xx status code
+func (o *SchemaObjectsVectorReindexCancelOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex cancel o k response has a 4xx status code
+func (o *SchemaObjectsVectorReindexCancelOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vector reindex cancel o k response has a 5xx status code
+func (o *SchemaObjectsVectorReindexCancelOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex cancel o k response a status code equal to that given
+func (o *SchemaObjectsVectorReindexCancelOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects vector reindex cancel o k response
+func (o *SchemaObjectsVectorReindexCancelOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsVectorReindexCancelOK) Error() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexCancelOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexCancelOK) String() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexCancelOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexCancelOK) GetPayload() *models.VectorReindexStatus {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexCancelOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.VectorReindexStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexCancelUnauthorized creates a SchemaObjectsVectorReindexCancelUnauthorized with default headers values
+func NewSchemaObjectsVectorReindexCancelUnauthorized() *SchemaObjectsVectorReindexCancelUnauthorized {
+	return &SchemaObjectsVectorReindexCancelUnauthorized{}
+}
+
+/*
+SchemaObjectsVectorReindexCancelUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsVectorReindexCancelUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects vector reindex cancel unauthorized response has a 2xx status code
+func (o *SchemaObjectsVectorReindexCancelUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex cancel unauthorized response has a 3xx status code
+func (o *SchemaObjectsVectorReindexCancelUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex cancel unauthorized response has a 4xx status code
+func (o *SchemaObjectsVectorReindexCancelUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex cancel unauthorized response has a 5xx status code
+func (o *SchemaObjectsVectorReindexCancelUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex cancel unauthorized response a status code equal to that given
+func (o *SchemaObjectsVectorReindexCancelUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects vector reindex cancel unauthorized response
+func (o *SchemaObjectsVectorReindexCancelUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsVectorReindexCancelUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexCancelUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorReindexCancelUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexCancelUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorReindexCancelUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexCancelForbidden creates a SchemaObjectsVectorReindexCancelForbidden with default headers values
+func NewSchemaObjectsVectorReindexCancelForbidden() *SchemaObjectsVectorReindexCancelForbidden {
+	return &SchemaObjectsVectorReindexCancelForbidden{}
+}
+
+/*
+SchemaObjectsVectorReindexCancelForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsVectorReindexCancelForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex cancel forbidden response has a 2xx status code
+func (o *SchemaObjectsVectorReindexCancelForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex cancel forbidden response has a 3xx status code
+func (o *SchemaObjectsVectorReindexCancelForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex cancel forbidden response has a 4xx status code
+func (o *SchemaObjectsVectorReindexCancelForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex cancel forbidden response has a 5xx status code
+func (o *SchemaObjectsVectorReindexCancelForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex cancel forbidden response a status code equal to that given
+func (o *SchemaObjectsVectorReindexCancelForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects vector reindex cancel forbidden response
+func (o *SchemaObjectsVectorReindexCancelForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsVectorReindexCancelForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexCancelForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexCancelForbidden) String() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexCancelForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexCancelForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexCancelForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexCancelNotFound creates a SchemaObjectsVectorReindexCancelNotFound with default headers values
+func NewSchemaObjectsVectorReindexCancelNotFound() *SchemaObjectsVectorReindexCancelNotFound {
+	return &SchemaObjectsVectorReindexCancelNotFound{}
+}
+
+/*
+SchemaObjectsVectorReindexCancelNotFound describes a response with status code 404, with default header values.
+
+There is no job in progress for the target vector
+*/
+type SchemaObjectsVectorReindexCancelNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex cancel not found response has a 2xx status code
+func (o *SchemaObjectsVectorReindexCancelNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex cancel not found response has a 3xx status code
+func (o *SchemaObjectsVectorReindexCancelNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex cancel not found response has a 4xx status code
+func (o *SchemaObjectsVectorReindexCancelNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex cancel not found response has a 5xx status code
+func (o *SchemaObjectsVectorReindexCancelNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex cancel not found response a status code equal to that given
+func (o *SchemaObjectsVectorReindexCancelNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects vector reindex cancel not found response
+func (o *SchemaObjectsVectorReindexCancelNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsVectorReindexCancelNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexCancelNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexCancelNotFound) String() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexCancelNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexCancelNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexCancelNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexCancelUnprocessableEntity creates a SchemaObjectsVectorReindexCancelUnprocessableEntity with default headers values
+func NewSchemaObjectsVectorReindexCancelUnprocessableEntity() *SchemaObjectsVectorReindexCancelUnprocessableEntity {
+	return &SchemaObjectsVectorReindexCancelUnprocessableEntity{}
+}
+
+/*
+SchemaObjectsVectorReindexCancelUnprocessableEntity describes a response with status code 422, with default header values.
+
+The job can not be cancelled in its current status
+*/
+type SchemaObjectsVectorReindexCancelUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex cancel unprocessable entity response has a 2xx status code
+func (o *SchemaObjectsVectorReindexCancelUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex cancel unprocessable entity response has a 3xx status code
+func (o *SchemaObjectsVectorReindexCancelUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex cancel unprocessable entity response has a 4xx status code
+func (o *SchemaObjectsVectorReindexCancelUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex cancel unprocessable entity response has a 5xx status code
+func (o *SchemaObjectsVectorReindexCancelUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex cancel unprocessable entity response a status code equal to that given
+func (o *SchemaObjectsVectorReindexCancelUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the schema objects vector reindex cancel unprocessable entity response
+func (o *SchemaObjectsVectorReindexCancelUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SchemaObjectsVectorReindexCancelUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexCancelUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexCancelUnprocessableEntity) String() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexCancelUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexCancelUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexCancelUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexCancelInternalServerError creates a SchemaObjectsVectorReindexCancelInternalServerError with default headers values
+func NewSchemaObjectsVectorReindexCancelInternalServerError() *SchemaObjectsVectorReindexCancelInternalServerError {
+	return &SchemaObjectsVectorReindexCancelInternalServerError{}
+}
+
+/*
+SchemaObjectsVectorReindexCancelInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsVectorReindexCancelInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex cancel internal server error response has a 2xx status code
+func (o *SchemaObjectsVectorReindexCancelInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex cancel internal server error response has a 3xx status code
+func (o *SchemaObjectsVectorReindexCancelInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex cancel internal server error response has a 4xx status code
+func (o *SchemaObjectsVectorReindexCancelInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vector reindex cancel internal server error response has a 5xx status code
+func (o *SchemaObjectsVectorReindexCancelInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects vector reindex cancel internal server error response a status code equal to that given
+func (o *SchemaObjectsVectorReindexCancelInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects vector reindex cancel internal server error response
+func (o *SchemaObjectsVectorReindexCancelInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsVectorReindexCancelInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexCancelInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexCancelInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexCancelInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexCancelInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexCancelInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewSchemaObjectsVectorReindexGetParams creates a new SchemaObjectsVectorReindexGetParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSchemaObjectsVectorReindexGetParams() *SchemaObjectsVectorReindexGetParams {
+	return &SchemaObjectsVectorReindexGetParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSchemaObjectsVectorReindexGetParamsWithTimeout creates a new SchemaObjectsVectorReindexGetParams object
+// with the ability to set a timeout on a request.
+func NewSchemaObjectsVectorReindexGetParamsWithTimeout(timeout time.Duration) *SchemaObjectsVectorReindexGetParams {
+	return &SchemaObjectsVectorReindexGetParams{
+		timeout: timeout,
+	}
+}
+
+// NewSchemaObjectsVectorReindexGetParamsWithContext creates a new SchemaObjectsVectorReindexGetParams object
+// with the ability to set a context for a request.
+func NewSchemaObjectsVectorReindexGetParamsWithContext(ctx context.Context) *SchemaObjectsVectorReindexGetParams {
+	return &SchemaObjectsVectorReindexGetParams{
+		Context: ctx,
+	}
+}
+
+// NewSchemaObjectsVectorReindexGetParamsWithHTTPClient creates a new SchemaObjectsVectorReindexGetParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSchemaObjectsVectorReindexGetParamsWithHTTPClient(client *http.Client) *SchemaObjectsVectorReindexGetParams {
+	return &SchemaObjectsVectorReindexGetParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SchemaObjectsVectorReindexGetParams contains all the parameters to send to the API endpoint
+
+	for the schema objects vector reindex get operation.
+
+	Typically these are written to a http.Request.
+*/
+type SchemaObjectsVectorReindexGetParams struct {
+
+	// ClassName.
+	ClassName string
+
+	/* TargetVector.
+
+	   The target vector of the job, empty for classes without named vectors
+	*/
+	TargetVector *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the schema objects vector reindex get params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaObjectsVectorReindexGetParams) WithDefaults() *SchemaObjectsVectorReindexGetParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the schema objects vector reindex get params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaObjectsVectorReindexGetParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the schema objects vector reindex get params
+func (o *SchemaObjectsVectorReindexGetParams) WithTimeout(timeout time.Duration) *SchemaObjectsVectorReindexGetParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the schema objects vector reindex get params
+func (o *SchemaObjectsVectorReindexGetParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the schema objects vector reindex get params
+func (o *SchemaObjectsVectorReindexGetParams) WithContext(ctx context.Context) *SchemaObjectsVectorReindexGetParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the schema objects vector reindex get params
+func (o *SchemaObjectsVectorReindexGetParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the schema objects vector reindex get params
+func (o *SchemaObjectsVectorReindexGetParams) WithHTTPClient(client *http.Client) *SchemaObjectsVectorReindexGetParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the schema objects vector reindex get params
+func (o *SchemaObjectsVectorReindexGetParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClassName adds the className to the schema objects vector reindex get params
+func (o *SchemaObjectsVectorReindexGetParams) WithClassName(className string) *SchemaObjectsVectorReindexGetParams {
+	o.SetClassName(className)
+	return o
+}
+
+// SetClassName adds the className to the schema objects vector reindex get params
+func (o *SchemaObjectsVectorReindexGetParams) SetClassName(className string) {
+	o.ClassName = className
+}
+
+// WithTargetVector adds the targetVector to the schema objects vector reindex get params
+func (o *SchemaObjectsVectorReindexGetParams) WithTargetVector(targetVector *string) *SchemaObjectsVectorReindexGetParams {
+	o.SetTargetVector(targetVector)
+	return o
+}
+
+// SetTargetVector adds the targetVector to the schema objects vector reindex get params
+func (o *SchemaObjectsVectorReindexGetParams) SetTargetVector(targetVector *string) {
+	o.TargetVector = targetVector
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SchemaObjectsVectorReindexGetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param className
+	if err := r.SetPathParam("className", o.ClassName); err != nil {
+		return err
+	}
+
+	if o.TargetVector != nil {
+
+		// query param targetVector
+		var qrTargetVector string
+
+		if o.TargetVector != nil {
+			qrTargetVector = *o.TargetVector
+		}
+		qTargetVector := qrTargetVector
+		if qTargetVector != "" {
+
+			if err := r.SetQueryParam("targetVector", qTargetVector); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,398 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorReindexGetReader is a Reader for the SchemaObjectsVectorReindexGet structure.
+type SchemaObjectsVectorReindexGetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsVectorReindexGetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsVectorReindexGetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsVectorReindexGetUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsVectorReindexGetForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsVectorReindexGetNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsVectorReindexGetInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsVectorReindexGetOK creates a SchemaObjectsVectorReindexGetOK with default headers values
+func NewSchemaObjectsVectorReindexGetOK() *SchemaObjectsVectorReindexGetOK {
+	return &SchemaObjectsVectorReindexGetOK{}
+}
+
+/*
+SchemaObjectsVectorReindexGetOK describes a response with status code 200, with default header values.
+
+Found the job, returned as body
+*/
+type SchemaObjectsVectorReindexGetOK struct {
+	Payload *models.VectorReindexStatus
+}
+
+// IsSuccess returns true when this schema objects vector reindex get o k response has a 2xx status code
+func (o *SchemaObjectsVectorReindexGetOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects vector reindex get o k response has a 3xx status code
+func (o *SchemaObjectsVectorReindexGetOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex get o k response has a 4xx status code
+func (o *SchemaObjectsVectorReindexGetOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vector reindex get o k response has a 5xx status code
+func (o *SchemaObjectsVectorReindexGetOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex get o k response a status code equal to that given
+func (o *SchemaObjectsVectorReindexGetOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects vector reindex get o k response
+func (o *SchemaObjectsVectorReindexGetOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsVectorReindexGetOK) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexGetOK) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexGetOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexGetOK) GetPayload() *models.VectorReindexStatus {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexGetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.VectorReindexStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexGetUnauthorized creates a SchemaObjectsVectorReindexGetUnauthorized with default headers values
+func NewSchemaObjectsVectorReindexGetUnauthorized() *SchemaObjectsVectorReindexGetUnauthorized {
+	return &SchemaObjectsVectorReindexGetUnauthorized{}
+}
+
+/*
+SchemaObjectsVectorReindexGetUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsVectorReindexGetUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects vector reindex get unauthorized response has a 2xx status code
+func (o *SchemaObjectsVectorReindexGetUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex get unauthorized response has a 3xx status code
+func (o *SchemaObjectsVectorReindexGetUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex get unauthorized response has a 4xx status code
+func (o *SchemaObjectsVectorReindexGetUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex get unauthorized response has a 5xx status code
+func (o *SchemaObjectsVectorReindexGetUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex get unauthorized response a status code equal to that given
+func (o *SchemaObjectsVectorReindexGetUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects vector reindex get unauthorized response
+func (o *SchemaObjectsVectorReindexGetUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsVectorReindexGetUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexGetUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorReindexGetUnauthorized) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexGetUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorReindexGetUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexGetForbidden creates a SchemaObjectsVectorReindexGetForbidden with default headers values
+func NewSchemaObjectsVectorReindexGetForbidden() *SchemaObjectsVectorReindexGetForbidden {
+	return &SchemaObjectsVectorReindexGetForbidden{}
+}
+
+/*
+SchemaObjectsVectorReindexGetForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsVectorReindexGetForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex get forbidden response has a 2xx status code
+func (o *SchemaObjectsVectorReindexGetForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex get forbidden response has a 3xx status code
+func (o *SchemaObjectsVectorReindexGetForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex get forbidden response has a 4xx status code
+func (o *SchemaObjectsVectorReindexGetForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex get forbidden response has a 5xx status code
+func (o *SchemaObjectsVectorReindexGetForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex get forbidden response a status code equal to that given
+func (o *SchemaObjectsVectorReindexGetForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects vector reindex get forbidden response
+func (o *SchemaObjectsVectorReindexGetForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsVectorReindexGetForbidden) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexGetForbidden) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexGetForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexGetForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexGetForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexGetNotFound creates a SchemaObjectsVectorReindexGetNotFound with default headers values
+func NewSchemaObjectsVectorReindexGetNotFound() *SchemaObjectsVectorReindexGetNotFound {
+	return &SchemaObjectsVectorReindexGetNotFound{}
+}
+
+/*
+SchemaObjectsVectorReindexGetNotFound describes a response with status code 404, with default header values.
+
+This class does not exist, or its target vector was never reindexed
+*/
+type SchemaObjectsVectorReindexGetNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex get not found response has a 2xx status code
+func (o *SchemaObjectsVectorReindexGetNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex get not found response has a 3xx status code
+func (o *SchemaObjectsVectorReindexGetNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex get not found response has a 4xx status code
+func (o *SchemaObjectsVectorReindexGetNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex get not found response has a 5xx status code
+func (o *SchemaObjectsVectorReindexGetNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex get not found response a status code equal to that given
+func (o *SchemaObjectsVectorReindexGetNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects vector reindex get not found response
+func (o *SchemaObjectsVectorReindexGetNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsVectorReindexGetNotFound) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexGetNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexGetNotFound) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexGetNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexGetNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexGetNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexGetInternalServerError creates a SchemaObjectsVectorReindexGetInternalServerError with default headers values
+func NewSchemaObjectsVectorReindexGetInternalServerError() *SchemaObjectsVectorReindexGetInternalServerError {
+	return &SchemaObjectsVectorReindexGetInternalServerError{}
+}
+
+/*
+SchemaObjectsVectorReindexGetInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsVectorReindexGetInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex get internal server error response has a 2xx status code
+func (o *SchemaObjectsVectorReindexGetInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex get internal server error response has a 3xx status code
+func (o *SchemaObjectsVectorReindexGetInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex get internal server error response has a 4xx status code
+func (o *SchemaObjectsVectorReindexGetInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vector reindex get internal server error response has a 5xx status code
+func (o *SchemaObjectsVectorReindexGetInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects vector reindex get internal server error response a status code equal to that given
+func (o *SchemaObjectsVectorReindexGetInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects vector reindex get internal server error response
+func (o *SchemaObjectsVectorReindexGetInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsVectorReindexGetInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexGetInternalServerError) String() string {
+	return fmt.Sprintf("[GET /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexGetInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexGetInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexGetInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
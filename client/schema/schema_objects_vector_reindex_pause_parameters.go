@@ -0,0 +1,193 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewSchemaObjectsVectorReindexPauseParams creates a new SchemaObjectsVectorReindexPauseParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSchemaObjectsVectorReindexPauseParams() *SchemaObjectsVectorReindexPauseParams {
+	return &SchemaObjectsVectorReindexPauseParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSchemaObjectsVectorReindexPauseParamsWithTimeout creates a new SchemaObjectsVectorReindexPauseParams object
+// with the ability to set a timeout on a request.
+func NewSchemaObjectsVectorReindexPauseParamsWithTimeout(timeout time.Duration) *SchemaObjectsVectorReindexPauseParams {
+	return &SchemaObjectsVectorReindexPauseParams{
+		timeout: timeout,
+	}
+}
+
+// NewSchemaObjectsVectorReindexPauseParamsWithContext creates a new SchemaObjectsVectorReindexPauseParams object
+// with the ability to set a context for a request.
+func NewSchemaObjectsVectorReindexPauseParamsWithContext(ctx context.Context) *SchemaObjectsVectorReindexPauseParams {
+	return &SchemaObjectsVectorReindexPauseParams{
+		Context: ctx,
+	}
+}
+
+// NewSchemaObjectsVectorReindexPauseParamsWithHTTPClient creates a new SchemaObjectsVectorReindexPauseParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSchemaObjectsVectorReindexPauseParamsWithHTTPClient(client *http.Client) *SchemaObjectsVectorReindexPauseParams {
+	return &SchemaObjectsVectorReindexPauseParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SchemaObjectsVectorReindexPauseParams contains all the parameters to send to the API endpoint
+
+	for the schema objects vector reindex pause operation.
+
+	Typically these are written to a http.Request.
+*/
+type SchemaObjectsVectorReindexPauseParams struct {
+
+	// ClassName.
+	ClassName string
+
+	/* TargetVector.
+
+	   The target vector of the job, empty for classes without named vectors
+	*/
+	TargetVector *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the schema objects vector reindex pause params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaObjectsVectorReindexPauseParams) WithDefaults() *SchemaObjectsVectorReindexPauseParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the schema objects vector reindex pause params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaObjectsVectorReindexPauseParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the schema objects vector reindex pause params
+func (o *SchemaObjectsVectorReindexPauseParams) WithTimeout(timeout time.Duration) *SchemaObjectsVectorReindexPauseParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the schema objects vector reindex pause params
+func (o *SchemaObjectsVectorReindexPauseParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the schema objects vector reindex pause params
+func (o *SchemaObjectsVectorReindexPauseParams) WithContext(ctx context.Context) *SchemaObjectsVectorReindexPauseParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the schema objects vector reindex pause params
+func (o *SchemaObjectsVectorReindexPauseParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the schema objects vector reindex pause params
+func (o *SchemaObjectsVectorReindexPauseParams) WithHTTPClient(client *http.Client) *SchemaObjectsVectorReindexPauseParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the schema objects vector reindex pause params
+func (o *SchemaObjectsVectorReindexPauseParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClassName adds the className to the schema objects vector reindex pause params
+func (o *SchemaObjectsVectorReindexPauseParams) WithClassName(className string) *SchemaObjectsVectorReindexPauseParams {
+	o.SetClassName(className)
+	return o
+}
+
+// SetClassName adds the className to the schema objects vector reindex pause params
+func (o *SchemaObjectsVectorReindexPauseParams) SetClassName(className string) {
+	o.ClassName = className
+}
+
+// WithTargetVector adds the targetVector to the schema objects vector reindex pause params
+func (o *SchemaObjectsVectorReindexPauseParams) WithTargetVector(targetVector *string) *SchemaObjectsVectorReindexPauseParams {
+	o.SetTargetVector(targetVector)
+	return o
+}
+
+// SetTargetVector adds the targetVector to the schema objects vector reindex pause params
+func (o *SchemaObjectsVectorReindexPauseParams) SetTargetVector(targetVector *string) {
+	o.TargetVector = targetVector
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SchemaObjectsVectorReindexPauseParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param className
+	if err := r.SetPathParam("className", o.ClassName); err != nil {
+		return err
+	}
+
+	if o.TargetVector != nil {
+
+		// query param targetVector
+		var qrTargetVector string
+
+		if o.TargetVector != nil {
+			qrTargetVector = *o.TargetVector
+		}
+		qTargetVector := qrTargetVector
+		if qTargetVector != "" {
+
+			if err := r.SetQueryParam("targetVector", qTargetVector); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
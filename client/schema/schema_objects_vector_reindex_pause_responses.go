@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorReindexPauseReader is a Reader for the SchemaObjectsVectorReindexPause structure.
+type SchemaObjectsVectorReindexPauseReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsVectorReindexPauseReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsVectorReindexPauseOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsVectorReindexPauseUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsVectorReindexPauseForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsVectorReindexPauseNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaObjectsVectorReindexPauseUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsVectorReindexPauseInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsVectorReindexPauseOK creates a SchemaObjectsVectorReindexPauseOK with default headers values
+func NewSchemaObjectsVectorReindexPauseOK() *SchemaObjectsVectorReindexPauseOK {
+	return &SchemaObjectsVectorReindexPauseOK{}
+}
+
+/*
+SchemaObjectsVectorReindexPauseOK describes a response with status code 200, with default header values.
+
+The job was paused
+*/
+type SchemaObjectsVectorReindexPauseOK struct {
+	Payload *models.VectorReindexStatus
+}
+
+// IsSuccess returns true when this schema objects vector reindex pause o k response has a 2xx status code
+func (o *SchemaObjectsVectorReindexPauseOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects vector reindex pause o k response has a 3xx status code
+func (o *SchemaObjectsVectorReindexPauseOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex pause o k response has a 4xx status code
+func (o *SchemaObjectsVectorReindexPauseOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vector reindex pause o k response has a 5xx status code
+func (o *SchemaObjectsVectorReindexPauseOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex pause o k response a status code equal to that given
+func (o *SchemaObjectsVectorReindexPauseOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects vector reindex pause o k response
+func (o *SchemaObjectsVectorReindexPauseOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsVectorReindexPauseOK) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/pause][%d] schemaObjectsVectorReindexPauseOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexPauseOK) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/pause][%d] schemaObjectsVectorReindexPauseOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexPauseOK) GetPayload() *models.VectorReindexStatus {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexPauseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.VectorReindexStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexPauseUnauthorized creates a SchemaObjectsVectorReindexPauseUnauthorized with default headers values
+func NewSchemaObjectsVectorReindexPauseUnauthorized() *SchemaObjectsVectorReindexPauseUnauthorized {
+	return &SchemaObjectsVectorReindexPauseUnauthorized{}
+}
+
+/*
+SchemaObjectsVectorReindexPauseUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsVectorReindexPauseUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects vector reindex pause unauthorized response has a 2xx status code
+func (o *SchemaObjectsVectorReindexPauseUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex pause unauthorized response has a 3xx status code
+func (o *SchemaObjectsVectorReindexPauseUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex pause unauthorized response has a 4xx status code
+func (o *SchemaObjectsVectorReindexPauseUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex pause unauthorized response has a 5xx status code
+func (o *SchemaObjectsVectorReindexPauseUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex pause unauthorized response a status code equal to that given
+func (o *SchemaObjectsVectorReindexPauseUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects vector reindex pause unauthorized response
+func (o *SchemaObjectsVectorReindexPauseUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsVectorReindexPauseUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/pause][%d] schemaObjectsVectorReindexPauseUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorReindexPauseUnauthorized) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/pause][%d] schemaObjectsVectorReindexPauseUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorReindexPauseUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexPauseForbidden creates a SchemaObjectsVectorReindexPauseForbidden with default headers values
+func NewSchemaObjectsVectorReindexPauseForbidden() *SchemaObjectsVectorReindexPauseForbidden {
+	return &SchemaObjectsVectorReindexPauseForbidden{}
+}
+
+/*
+SchemaObjectsVectorReindexPauseForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsVectorReindexPauseForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex pause forbidden response has a 2xx status code
+func (o *SchemaObjectsVectorReindexPauseForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex pause forbidden response has a 3xx status code
+func (o *SchemaObjectsVectorReindexPauseForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex pause forbidden response has a 4xx status code
+func (o *SchemaObjectsVectorReindexPauseForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex pause forbidden response has a 5xx status code
+func (o *SchemaObjectsVectorReindexPauseForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex pause forbidden response a status code equal to that given
+func (o *SchemaObjectsVectorReindexPauseForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects vector reindex pause forbidden response
+func (o *SchemaObjectsVectorReindexPauseForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsVectorReindexPauseForbidden) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/pause][%d] schemaObjectsVectorReindexPauseForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexPauseForbidden) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/pause][%d] schemaObjectsVectorReindexPauseForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexPauseForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexPauseForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexPauseNotFound creates a SchemaObjectsVectorReindexPauseNotFound with default headers values
+func NewSchemaObjectsVectorReindexPauseNotFound() *SchemaObjectsVectorReindexPauseNotFound {
+	return &SchemaObjectsVectorReindexPauseNotFound{}
+}
+
+/*
+SchemaObjectsVectorReindexPauseNotFound describes a response with status code 404, with default header values.
+
+There is no job in progress for the target vector
+*/
+type SchemaObjectsVectorReindexPauseNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex pause not found response has a 2xx status code
+func (o *SchemaObjectsVectorReindexPauseNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex pause not found response has a 3xx status code
+func (o *SchemaObjectsVectorReindexPauseNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex pause not found response has a 4xx status code
+func (o *SchemaObjectsVectorReindexPauseNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex pause not found response has a 5xx status code
+func (o *SchemaObjectsVectorReindexPauseNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex pause not found response a status code equal to that given
+func (o *SchemaObjectsVectorReindexPauseNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects vector reindex pause not found response
+func (o *SchemaObjectsVectorReindexPauseNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsVectorReindexPauseNotFound) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/pause][%d] schemaObjectsVectorReindexPauseNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexPauseNotFound) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/pause][%d] schemaObjectsVectorReindexPauseNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexPauseNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexPauseNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexPauseUnprocessableEntity creates a SchemaObjectsVectorReindexPauseUnprocessableEntity with default headers values
+func NewSchemaObjectsVectorReindexPauseUnprocessableEntity() *SchemaObjectsVectorReindexPauseUnprocessableEntity {
+	return &SchemaObjectsVectorReindexPauseUnprocessableEntity{}
+}
+
+/*
+SchemaObjectsVectorReindexPauseUnprocessableEntity describes a response with status code 422, with default header values.
+
+The job is not running
+*/
+type SchemaObjectsVectorReindexPauseUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex pause unprocessable entity response has a 2xx status code
+func (o *SchemaObjectsVectorReindexPauseUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex pause unprocessable entity response has a 3xx status code
+func (o *SchemaObjectsVectorReindexPauseUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex pause unprocessable entity response has a 4xx status code
+func (o *SchemaObjectsVectorReindexPauseUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex pause unprocessable entity response has a 5xx status code
+func (o *SchemaObjectsVectorReindexPauseUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex pause unprocessable entity response a status code equal to that given
+func (o *SchemaObjectsVectorReindexPauseUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the schema objects vector reindex pause unprocessable entity response
+func (o *SchemaObjectsVectorReindexPauseUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SchemaObjectsVectorReindexPauseUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/pause][%d] schemaObjectsVectorReindexPauseUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexPauseUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/pause][%d] schemaObjectsVectorReindexPauseUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexPauseUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexPauseUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexPauseInternalServerError creates a SchemaObjectsVectorReindexPauseInternalServerError with default headers values
+func NewSchemaObjectsVectorReindexPauseInternalServerError() *SchemaObjectsVectorReindexPauseInternalServerError {
+	return &SchemaObjectsVectorReindexPauseInternalServerError{}
+}
+
+/*
+SchemaObjectsVectorReindexPauseInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsVectorReindexPauseInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex pause internal server error response has a 2xx status code
+func (o *SchemaObjectsVectorReindexPauseInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex pause internal server error response has a 3xx status code
+func (o *SchemaObjectsVectorReindexPauseInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex pause internal server error response has a 4xx status code
+func (o *SchemaObjectsVectorReindexPauseInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vector reindex pause internal server error response has a 5xx status code
+func (o *SchemaObjectsVectorReindexPauseInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects vector reindex pause internal server error response a status code equal to that given
+func (o *SchemaObjectsVectorReindexPauseInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects vector reindex pause internal server error response
+func (o *SchemaObjectsVectorReindexPauseInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsVectorReindexPauseInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/pause][%d] schemaObjectsVectorReindexPauseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexPauseInternalServerError) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/pause][%d] schemaObjectsVectorReindexPauseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexPauseInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexPauseInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
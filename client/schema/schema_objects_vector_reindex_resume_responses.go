@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorReindexResumeReader is a Reader for the SchemaObjectsVectorReindexResume structure.
+type SchemaObjectsVectorReindexResumeReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsVectorReindexResumeReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsVectorReindexResumeOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsVectorReindexResumeUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsVectorReindexResumeForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsVectorReindexResumeNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaObjectsVectorReindexResumeUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsVectorReindexResumeInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsVectorReindexResumeOK creates a SchemaObjectsVectorReindexResumeOK with default headers values
+func NewSchemaObjectsVectorReindexResumeOK() *SchemaObjectsVectorReindexResumeOK {
+	return &SchemaObjectsVectorReindexResumeOK{}
+}
+
+/*
+SchemaObjectsVectorReindexResumeOK describes a response with status code 200, with default header values.
+
+The job was resumed
+*/
+type SchemaObjectsVectorReindexResumeOK struct {
+	Payload *models.VectorReindexStatus
+}
+
+// IsSuccess returns true when this schema objects vector reindex resume o k response has a 2xx status code
+func (o *SchemaObjectsVectorReindexResumeOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects vector reindex resume o k response has a 3xx status code
+func (o *SchemaObjectsVectorReindexResumeOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex resume o k response has a 4xx status code
+func (o *SchemaObjectsVectorReindexResumeOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vector reindex resume o k response has a 5xx status code
+func (o *SchemaObjectsVectorReindexResumeOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex resume o k response a status code equal to that given
+func (o *SchemaObjectsVectorReindexResumeOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects vector reindex resume o k response
+func (o *SchemaObjectsVectorReindexResumeOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsVectorReindexResumeOK) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/resume][%d] schemaObjectsVectorReindexResumeOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexResumeOK) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/resume][%d] schemaObjectsVectorReindexResumeOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexResumeOK) GetPayload() *models.VectorReindexStatus {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexResumeOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.VectorReindexStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexResumeUnauthorized creates a SchemaObjectsVectorReindexResumeUnauthorized with default headers values
+func NewSchemaObjectsVectorReindexResumeUnauthorized() *SchemaObjectsVectorReindexResumeUnauthorized {
+	return &SchemaObjectsVectorReindexResumeUnauthorized{}
+}
+
+/*
+SchemaObjectsVectorReindexResumeUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsVectorReindexResumeUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects vector reindex resume unauthorized response has a 2xx status code
+func (o *SchemaObjectsVectorReindexResumeUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex resume unauthorized response has a 3xx status code
+func (o *SchemaObjectsVectorReindexResumeUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex resume unauthorized response has a 4xx status code
+func (o *SchemaObjectsVectorReindexResumeUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex resume unauthorized response has a 5xx status code
+func (o *SchemaObjectsVectorReindexResumeUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex resume unauthorized response a status code equal to that given
+func (o *SchemaObjectsVectorReindexResumeUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects vector reindex resume unauthorized response
+func (o *SchemaObjectsVectorReindexResumeUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsVectorReindexResumeUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/resume][%d] schemaObjectsVectorReindexResumeUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorReindexResumeUnauthorized) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/resume][%d] schemaObjectsVectorReindexResumeUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorReindexResumeUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexResumeForbidden creates a SchemaObjectsVectorReindexResumeForbidden with default headers values
+func NewSchemaObjectsVectorReindexResumeForbidden() *SchemaObjectsVectorReindexResumeForbidden {
+	return &SchemaObjectsVectorReindexResumeForbidden{}
+}
+
+/*
+SchemaObjectsVectorReindexResumeForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsVectorReindexResumeForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex resume forbidden response has a 2xx status code
+func (o *SchemaObjectsVectorReindexResumeForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex resume forbidden response has a 3xx status code
+func (o *SchemaObjectsVectorReindexResumeForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex resume forbidden response has a 4xx status code
+func (o *SchemaObjectsVectorReindexResumeForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex resume forbidden response has a 5xx status code
+func (o *SchemaObjectsVectorReindexResumeForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex resume forbidden response a status code equal to that given
+func (o *SchemaObjectsVectorReindexResumeForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects vector reindex resume forbidden response
+func (o *SchemaObjectsVectorReindexResumeForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsVectorReindexResumeForbidden) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/resume][%d] schemaObjectsVectorReindexResumeForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexResumeForbidden) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/resume][%d] schemaObjectsVectorReindexResumeForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexResumeForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexResumeForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexResumeNotFound creates a SchemaObjectsVectorReindexResumeNotFound with default headers values
+func NewSchemaObjectsVectorReindexResumeNotFound() *SchemaObjectsVectorReindexResumeNotFound {
+	return &SchemaObjectsVectorReindexResumeNotFound{}
+}
+
+/*
+SchemaObjectsVectorReindexResumeNotFound describes a response with status code 404, with default header values.
+
+There is no job in progress for the target vector
+*/
+type SchemaObjectsVectorReindexResumeNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex resume not found response has a 2xx status code
+func (o *SchemaObjectsVectorReindexResumeNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex resume not found response has a 3xx status code
+func (o *SchemaObjectsVectorReindexResumeNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex resume not found response has a 4xx status code
+func (o *SchemaObjectsVectorReindexResumeNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex resume not found response has a 5xx status code
+func (o *SchemaObjectsVectorReindexResumeNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex resume not found response a status code equal to that given
+func (o *SchemaObjectsVectorReindexResumeNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects vector reindex resume not found response
+func (o *SchemaObjectsVectorReindexResumeNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsVectorReindexResumeNotFound) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/resume][%d] schemaObjectsVectorReindexResumeNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexResumeNotFound) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/resume][%d] schemaObjectsVectorReindexResumeNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexResumeNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexResumeNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexResumeUnprocessableEntity creates a SchemaObjectsVectorReindexResumeUnprocessableEntity with default headers values
+func NewSchemaObjectsVectorReindexResumeUnprocessableEntity() *SchemaObjectsVectorReindexResumeUnprocessableEntity {
+	return &SchemaObjectsVectorReindexResumeUnprocessableEntity{}
+}
+
+/*
+SchemaObjectsVectorReindexResumeUnprocessableEntity describes a response with status code 422, with default header values.
+
+The job is not paused
+*/
+type SchemaObjectsVectorReindexResumeUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex resume unprocessable entity response has a 2xx status code
+func (o *SchemaObjectsVectorReindexResumeUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex resume unprocessable entity response has a 3xx status code
+func (o *SchemaObjectsVectorReindexResumeUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex resume unprocessable entity response has a 4xx status code
+func (o *SchemaObjectsVectorReindexResumeUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex resume unprocessable entity response has a 5xx status code
+func (o *SchemaObjectsVectorReindexResumeUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex resume unprocessable entity response a status code equal to that given
+func (o *SchemaObjectsVectorReindexResumeUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the schema objects vector reindex resume unprocessable entity response
+func (o *SchemaObjectsVectorReindexResumeUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SchemaObjectsVectorReindexResumeUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/resume][%d] schemaObjectsVectorReindexResumeUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexResumeUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/resume][%d] schemaObjectsVectorReindexResumeUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexResumeUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexResumeUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexResumeInternalServerError creates a SchemaObjectsVectorReindexResumeInternalServerError with default headers values
+func NewSchemaObjectsVectorReindexResumeInternalServerError() *SchemaObjectsVectorReindexResumeInternalServerError {
+	return &SchemaObjectsVectorReindexResumeInternalServerError{}
+}
+
+/*
+SchemaObjectsVectorReindexResumeInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsVectorReindexResumeInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex resume internal server error response has a 2xx status code
+func (o *SchemaObjectsVectorReindexResumeInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex resume internal server error response has a 3xx status code
+func (o *SchemaObjectsVectorReindexResumeInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex resume internal server error response has a 4xx status code
+func (o *SchemaObjectsVectorReindexResumeInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vector reindex resume internal server error response has a 5xx status code
+func (o *SchemaObjectsVectorReindexResumeInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects vector reindex resume internal server error response a status code equal to that given
+func (o *SchemaObjectsVectorReindexResumeInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects vector reindex resume internal server error response
+func (o *SchemaObjectsVectorReindexResumeInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsVectorReindexResumeInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/resume][%d] schemaObjectsVectorReindexResumeInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexResumeInternalServerError) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex/resume][%d] schemaObjectsVectorReindexResumeInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexResumeInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexResumeInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
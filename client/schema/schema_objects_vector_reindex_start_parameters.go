@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewSchemaObjectsVectorReindexStartParams creates a new SchemaObjectsVectorReindexStartParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSchemaObjectsVectorReindexStartParams() *SchemaObjectsVectorReindexStartParams {
+	return &SchemaObjectsVectorReindexStartParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSchemaObjectsVectorReindexStartParamsWithTimeout creates a new SchemaObjectsVectorReindexStartParams object
+// with the ability to set a timeout on a request.
+func NewSchemaObjectsVectorReindexStartParamsWithTimeout(timeout time.Duration) *SchemaObjectsVectorReindexStartParams {
+	return &SchemaObjectsVectorReindexStartParams{
+		timeout: timeout,
+	}
+}
+
+// NewSchemaObjectsVectorReindexStartParamsWithContext creates a new SchemaObjectsVectorReindexStartParams object
+// with the ability to set a context for a request.
+func NewSchemaObjectsVectorReindexStartParamsWithContext(ctx context.Context) *SchemaObjectsVectorReindexStartParams {
+	return &SchemaObjectsVectorReindexStartParams{
+		Context: ctx,
+	}
+}
+
+// NewSchemaObjectsVectorReindexStartParamsWithHTTPClient creates a new SchemaObjectsVectorReindexStartParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSchemaObjectsVectorReindexStartParamsWithHTTPClient(client *http.Client) *SchemaObjectsVectorReindexStartParams {
+	return &SchemaObjectsVectorReindexStartParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+SchemaObjectsVectorReindexStartParams contains all the parameters to send to the API endpoint
+
+	for the schema objects vector reindex start operation.
+
+	Typically these are written to a http.Request.
+*/
+type SchemaObjectsVectorReindexStartParams struct {
+
+	// Body.
+	Body *models.VectorReindexRequest
+
+	// ClassName.
+	ClassName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the schema objects vector reindex start params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaObjectsVectorReindexStartParams) WithDefaults() *SchemaObjectsVectorReindexStartParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the schema objects vector reindex start params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SchemaObjectsVectorReindexStartParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the schema objects vector reindex start params
+func (o *SchemaObjectsVectorReindexStartParams) WithTimeout(timeout time.Duration) *SchemaObjectsVectorReindexStartParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the schema objects vector reindex start params
+func (o *SchemaObjectsVectorReindexStartParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the schema objects vector reindex start params
+func (o *SchemaObjectsVectorReindexStartParams) WithContext(ctx context.Context) *SchemaObjectsVectorReindexStartParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the schema objects vector reindex start params
+func (o *SchemaObjectsVectorReindexStartParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the schema objects vector reindex start params
+func (o *SchemaObjectsVectorReindexStartParams) WithHTTPClient(client *http.Client) *SchemaObjectsVectorReindexStartParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the schema objects vector reindex start params
+func (o *SchemaObjectsVectorReindexStartParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the schema objects vector reindex start params
+func (o *SchemaObjectsVectorReindexStartParams) WithBody(body *models.VectorReindexRequest) *SchemaObjectsVectorReindexStartParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the schema objects vector reindex start params
+func (o *SchemaObjectsVectorReindexStartParams) SetBody(body *models.VectorReindexRequest) {
+	o.Body = body
+}
+
+// WithClassName adds the className to the schema objects vector reindex start params
+func (o *SchemaObjectsVectorReindexStartParams) WithClassName(className string) *SchemaObjectsVectorReindexStartParams {
+	o.SetClassName(className)
+	return o
+}
+
+// SetClassName adds the className to the schema objects vector reindex start params
+func (o *SchemaObjectsVectorReindexStartParams) SetClassName(className string) {
+	o.ClassName = className
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SchemaObjectsVectorReindexStartParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param className
+	if err := r.SetPathParam("className", o.ClassName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package schema
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaObjectsVectorReindexStartReader is a Reader for the SchemaObjectsVectorReindexStart structure.
+type SchemaObjectsVectorReindexStartReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaObjectsVectorReindexStartReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSchemaObjectsVectorReindexStartOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSchemaObjectsVectorReindexStartUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSchemaObjectsVectorReindexStartForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewSchemaObjectsVectorReindexStartNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewSchemaObjectsVectorReindexStartUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewSchemaObjectsVectorReindexStartInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewSchemaObjectsVectorReindexStartOK creates a SchemaObjectsVectorReindexStartOK with default headers values
+func NewSchemaObjectsVectorReindexStartOK() *SchemaObjectsVectorReindexStartOK {
+	return &SchemaObjectsVectorReindexStartOK{}
+}
+
+/*
+SchemaObjectsVectorReindexStartOK describes a response with status code 200, with default header values.
+
+The job was started
+*/
+type SchemaObjectsVectorReindexStartOK struct {
+	Payload *models.VectorReindexStatus
+}
+
+// IsSuccess returns true when this schema objects vector reindex start o k response has a 2xx status code
+func (o *SchemaObjectsVectorReindexStartOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this schema objects vector reindex start o k response has a 3xx status code
+func (o *SchemaObjectsVectorReindexStartOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex start o k response has a 4xx status code
+func (o *SchemaObjectsVectorReindexStartOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vector reindex start o k response has a 5xx status code
+func (o *SchemaObjectsVectorReindexStartOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex start o k response a status code equal to that given
+func (o *SchemaObjectsVectorReindexStartOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the schema objects vector reindex start o k response
+func (o *SchemaObjectsVectorReindexStartOK) Code() int {
+	return 200
+}
+
+func (o *SchemaObjectsVectorReindexStartOK) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexStartOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexStartOK) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexStartOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexStartOK) GetPayload() *models.VectorReindexStatus {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexStartOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.VectorReindexStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexStartUnauthorized creates a SchemaObjectsVectorReindexStartUnauthorized with default headers values
+func NewSchemaObjectsVectorReindexStartUnauthorized() *SchemaObjectsVectorReindexStartUnauthorized {
+	return &SchemaObjectsVectorReindexStartUnauthorized{}
+}
+
+/*
+SchemaObjectsVectorReindexStartUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type SchemaObjectsVectorReindexStartUnauthorized struct {
+}
+
+// IsSuccess returns true when this schema objects vector reindex start unauthorized response has a 2xx status code
+func (o *SchemaObjectsVectorReindexStartUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex start unauthorized response has a 3xx status code
+func (o *SchemaObjectsVectorReindexStartUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex start unauthorized response has a 4xx status code
+func (o *SchemaObjectsVectorReindexStartUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex start unauthorized response has a 5xx status code
+func (o *SchemaObjectsVectorReindexStartUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex start unauthorized response a status code equal to that given
+func (o *SchemaObjectsVectorReindexStartUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the schema objects vector reindex start unauthorized response
+func (o *SchemaObjectsVectorReindexStartUnauthorized) Code() int {
+	return 401
+}
+
+func (o *SchemaObjectsVectorReindexStartUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexStartUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorReindexStartUnauthorized) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexStartUnauthorized ", 401)
+}
+
+func (o *SchemaObjectsVectorReindexStartUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexStartForbidden creates a SchemaObjectsVectorReindexStartForbidden with default headers values
+func NewSchemaObjectsVectorReindexStartForbidden() *SchemaObjectsVectorReindexStartForbidden {
+	return &SchemaObjectsVectorReindexStartForbidden{}
+}
+
+/*
+SchemaObjectsVectorReindexStartForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type SchemaObjectsVectorReindexStartForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex start forbidden response has a 2xx status code
+func (o *SchemaObjectsVectorReindexStartForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex start forbidden response has a 3xx status code
+func (o *SchemaObjectsVectorReindexStartForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex start forbidden response has a 4xx status code
+func (o *SchemaObjectsVectorReindexStartForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex start forbidden response has a 5xx status code
+func (o *SchemaObjectsVectorReindexStartForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex start forbidden response a status code equal to that given
+func (o *SchemaObjectsVectorReindexStartForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the schema objects vector reindex start forbidden response
+func (o *SchemaObjectsVectorReindexStartForbidden) Code() int {
+	return 403
+}
+
+func (o *SchemaObjectsVectorReindexStartForbidden) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexStartForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexStartForbidden) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexStartForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexStartForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexStartForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexStartNotFound creates a SchemaObjectsVectorReindexStartNotFound with default headers values
+func NewSchemaObjectsVectorReindexStartNotFound() *SchemaObjectsVectorReindexStartNotFound {
+	return &SchemaObjectsVectorReindexStartNotFound{}
+}
+
+/*
+SchemaObjectsVectorReindexStartNotFound describes a response with status code 404, with default header values.
+
+This class does not exist
+*/
+type SchemaObjectsVectorReindexStartNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex start not found response has a 2xx status code
+func (o *SchemaObjectsVectorReindexStartNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex start not found response has a 3xx status code
+func (o *SchemaObjectsVectorReindexStartNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex start not found response has a 4xx status code
+func (o *SchemaObjectsVectorReindexStartNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex start not found response has a 5xx status code
+func (o *SchemaObjectsVectorReindexStartNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex start not found response a status code equal to that given
+func (o *SchemaObjectsVectorReindexStartNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the schema objects vector reindex start not found response
+func (o *SchemaObjectsVectorReindexStartNotFound) Code() int {
+	return 404
+}
+
+func (o *SchemaObjectsVectorReindexStartNotFound) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexStartNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexStartNotFound) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexStartNotFound  %+v", 404, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexStartNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexStartNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexStartUnprocessableEntity creates a SchemaObjectsVectorReindexStartUnprocessableEntity with default headers values
+func NewSchemaObjectsVectorReindexStartUnprocessableEntity() *SchemaObjectsVectorReindexStartUnprocessableEntity {
+	return &SchemaObjectsVectorReindexStartUnprocessableEntity{}
+}
+
+/*
+SchemaObjectsVectorReindexStartUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid vectorizer or module config, or a job for the target vector is in progress already
+*/
+type SchemaObjectsVectorReindexStartUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex start unprocessable entity response has a 2xx status code
+func (o *SchemaObjectsVectorReindexStartUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex start unprocessable entity response has a 3xx status code
+func (o *SchemaObjectsVectorReindexStartUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex start unprocessable entity response has a 4xx status code
+func (o *SchemaObjectsVectorReindexStartUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this schema objects vector reindex start unprocessable entity response has a 5xx status code
+func (o *SchemaObjectsVectorReindexStartUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this schema objects vector reindex start unprocessable entity response a status code equal to that given
+func (o *SchemaObjectsVectorReindexStartUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the schema objects vector reindex start unprocessable entity response
+func (o *SchemaObjectsVectorReindexStartUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *SchemaObjectsVectorReindexStartUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexStartUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexStartUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexStartUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexStartUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexStartUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSchemaObjectsVectorReindexStartInternalServerError creates a SchemaObjectsVectorReindexStartInternalServerError with default headers values
+func NewSchemaObjectsVectorReindexStartInternalServerError() *SchemaObjectsVectorReindexStartInternalServerError {
+	return &SchemaObjectsVectorReindexStartInternalServerError{}
+}
+
+/*
+SchemaObjectsVectorReindexStartInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type SchemaObjectsVectorReindexStartInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this schema objects vector reindex start internal server error response has a 2xx status code
+func (o *SchemaObjectsVectorReindexStartInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this schema objects vector reindex start internal server error response has a 3xx status code
+func (o *SchemaObjectsVectorReindexStartInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this schema objects vector reindex start internal server error response has a 4xx status code
+func (o *SchemaObjectsVectorReindexStartInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this schema objects vector reindex start internal server error response has a 5xx status code
+func (o *SchemaObjectsVectorReindexStartInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this schema objects vector reindex start internal server error response a status code equal to that given
+func (o *SchemaObjectsVectorReindexStartInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the schema objects vector reindex start internal server error response
+func (o *SchemaObjectsVectorReindexStartInternalServerError) Code() int {
+	return 500
+}
+
+func (o *SchemaObjectsVectorReindexStartInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexStartInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexStartInternalServerError) String() string {
+	return fmt.Sprintf("[POST /schema/{className}/vector-reindex][%d] schemaObjectsVectorReindexStartInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *SchemaObjectsVectorReindexStartInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SchemaObjectsVectorReindexStartInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/weaviate/weaviate/client/nodes"
 	"github.com/weaviate/weaviate/client/objects"
 	"github.com/weaviate/weaviate/client/operations"
+	"github.com/weaviate/weaviate/client/replication"
 	"github.com/weaviate/weaviate/client/schema"
 	"github.com/weaviate/weaviate/client/well_known"
 )
@@ -85,6 +86,7 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Weaviate {
 	cli.Nodes = nodes.New(transport, formats)
 	cli.Objects = objects.New(transport, formats)
 	cli.Operations = operations.New(transport, formats)
+	cli.Replication = replication.New(transport, formats)
 	cli.Schema = schema.New(transport, formats)
 	cli.WellKnown = well_known.New(transport, formats)
 	return cli
@@ -149,6 +151,8 @@ type Weaviate struct {
 
 	Operations operations.ClientService
 
+	Replication replication.ClientService
+
 	Schema schema.ClientService
 
 	WellKnown well_known.ClientService
@@ -168,6 +172,7 @@ func (c *Weaviate) SetTransport(transport runtime.ClientTransport) {
 	c.Nodes.SetTransport(transport)
 	c.Objects.SetTransport(transport)
 	c.Operations.SetTransport(transport)
+	c.Replication.SetTransport(transport)
 	c.Schema.SetTransport(transport)
 	c.WellKnown.SetTransport(transport)
 }
@@ -12,7 +12,10 @@
 package api
 
 import (
+	"time"
+
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorreindex"
 	"github.com/weaviate/weaviate/entities/versioned"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
@@ -25,6 +28,9 @@ type AddClassRequest struct {
 type UpdateClassRequest struct {
 	Class *models.Class
 	State *sharding.State
+	// Time is the time of the update. It is the start time of the jobs
+	// vectorizing the existing objects for added target vectors.
+	Time time.Time
 }
 
 type AddPropertyRequest struct {
@@ -40,6 +46,56 @@ type UpdateShardStatusRequest struct {
 	SchemaVersion        uint64
 }
 
+// UpdateShardOwnersRequest replaces the list of nodes a physical shard
+// (or tenant) belongs to. The first node is the primary owner.
+type UpdateShardOwnersRequest struct {
+	Class, Shard string
+	Nodes        []string
+}
+
+// SetRebalancerPausedRequest pauses or resumes the automatic shard rebalancer
+// of the cluster.
+type SetRebalancerPausedRequest struct {
+	Paused bool
+}
+
+// StartReshardingRequest starts resharding a class to the given layout
+type StartReshardingRequest struct {
+	Resharding *sharding.Resharding
+}
+
+// FinishReshardingRequest commits or aborts the resharding of a class
+type FinishReshardingRequest struct {
+	// Version is the version the resharding was started with, a resharding
+	// started later is not finished by the request
+	Version uint64
+	// Error is the reason the resharding is aborted
+	Error string
+	// Time is the time of the change, it is set by the caller so that all
+	// nodes store the same time
+	Time time.Time
+}
+
+// StartVectorReindexRequest starts recomputing the vectors of a target
+// vector of a class
+type StartVectorReindexRequest struct {
+	Job *vectorreindex.Job
+}
+
+// UpdateVectorReindexRequest changes the status of the unfinished vector
+// reindexing job of a target vector
+type UpdateVectorReindexRequest struct {
+	TargetVector string
+	Status       string
+	Error        string
+	// Deferred replaces the deferred tenants of a backfill, see
+	// vectorreindex.Job
+	Deferred []string
+	// Time is the time of the change, it is set by the caller so that all
+	// nodes store the same time
+	Time time.Time
+}
+
 type QueryReadOnlyClassesRequest struct {
 	Classes []string
 }
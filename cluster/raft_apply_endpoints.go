@@ -14,12 +14,14 @@ package cluster
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	cmd "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/cluster/types"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorreindex"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"google.golang.org/protobuf/proto"
@@ -47,7 +49,7 @@ func (s *Raft) UpdateClass(cls *models.Class, ss *sharding.State) (uint64, error
 	if cls == nil || cls.Class == "" {
 		return 0, fmt.Errorf("nil class or empty class name : %w", schema.ErrBadRequest)
 	}
-	req := cmd.UpdateClassRequest{Class: cls, State: ss}
+	req := cmd.UpdateClassRequest{Class: cls, State: ss, Time: time.Now()}
 	subCommand, err := json.Marshal(&req)
 	if err != nil {
 		return 0, fmt.Errorf("marshal request: %w", err)
@@ -121,6 +123,114 @@ func (s *Raft) UpdateShardStatus(class, shard, status string) (uint64, error) {
 	return s.Execute(command)
 }
 
+func (s *Raft) UpdateShardOwners(class, shard string, nodes []string) (uint64, error) {
+	if class == "" || shard == "" || len(nodes) == 0 {
+		return 0, fmt.Errorf("empty class, shard or owners : %w", schema.ErrBadRequest)
+	}
+	req := cmd.UpdateShardOwnersRequest{Class: class, Shard: shard, Nodes: nodes}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_UPDATE_SHARD_OWNERS,
+		Class:      req.Class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(command)
+}
+
+func (s *Raft) SetRebalancerPaused(paused bool) (uint64, error) {
+	req := cmd.SetRebalancerPausedRequest{Paused: paused}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_SET_REBALANCER_PAUSED,
+		SubCommand: subCommand,
+	}
+	return s.Execute(command)
+}
+
+func (s *Raft) StartResharding(class string, resharding *sharding.Resharding) (uint64, error) {
+	if class == "" || resharding == nil {
+		return 0, fmt.Errorf("empty class name or nil resharding : %w", schema.ErrBadRequest)
+	}
+	req := cmd.StartReshardingRequest{Resharding: resharding}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_START_RESHARDING,
+		Class:      class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(command)
+}
+
+// CommitResharding commits the resharding of class started with version
+func (s *Raft) CommitResharding(class string, version uint64) (uint64, error) {
+	return s.finishResharding(cmd.ApplyRequest_TYPE_COMMIT_RESHARDING, class, version, "")
+}
+
+// AbortResharding aborts the resharding of class started with version
+func (s *Raft) AbortResharding(class string, version uint64, errMsg string) (uint64, error) {
+	return s.finishResharding(cmd.ApplyRequest_TYPE_ABORT_RESHARDING, class, version, errMsg)
+}
+
+func (s *Raft) finishResharding(typ cmd.ApplyRequest_Type, class string, version uint64, errMsg string,
+) (uint64, error) {
+	if class == "" {
+		return 0, fmt.Errorf("empty class name : %w", schema.ErrBadRequest)
+	}
+	req := cmd.FinishReshardingRequest{Version: version, Error: errMsg, Time: time.Now()}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       typ,
+		Class:      class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(command)
+}
+
+func (s *Raft) StartVectorReindex(class string, job *vectorreindex.Job) (uint64, error) {
+	if class == "" || job == nil {
+		return 0, fmt.Errorf("empty class name or nil job : %w", schema.ErrBadRequest)
+	}
+	req := cmd.StartVectorReindexRequest{Job: job}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_START_VECTOR_REINDEX,
+		Class:      class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(command)
+}
+
+func (s *Raft) UpdateVectorReindex(class string, req *cmd.UpdateVectorReindexRequest) (uint64, error) {
+	if class == "" || req == nil {
+		return 0, fmt.Errorf("empty class name or nil request : %w", schema.ErrBadRequest)
+	}
+	subCommand, err := json.Marshal(req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_UPDATE_VECTOR_REINDEX,
+		Class:      class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(command)
+}
+
 func (s *Raft) AddTenants(class string, req *cmd.AddTenantsRequest) (uint64, error) {
 	if class == "" || req == nil {
 		return 0, fmt.Errorf("empty class name or nil request : %w", schema.ErrBadRequest)
@@ -25,6 +25,11 @@ func (s *Raft) LeaderWithID() (string, string) {
 	return string(addr), string(id)
 }
 
+// IsLeader returns whether this node is the current leader of the cluster
+func (s *Raft) IsLeader() bool {
+	return s.store.IsLeader()
+}
+
 func (s *Raft) Join(ctx context.Context, id, addr string, voter bool) error {
 	s.log.WithFields(logrus.Fields{
 		"id":      id,
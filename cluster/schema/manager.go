@@ -22,6 +22,8 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/cluster/proto/api"
 	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorreindex"
 	gproto "google.golang.org/protobuf/proto"
 )
 
@@ -197,16 +199,24 @@ func (s *SchemaManager) UpdateClass(cmd *command.ApplyRequest, nodeID string, sc
 		if err != nil {
 			return fmt.Errorf("%w :parse class update: %w", ErrBadRequest, err)
 		}
+		added := map[string]models.VectorConfig{}
+		for name, cfg := range u.VectorConfig {
+			if _, ok := meta.Class.VectorConfig[name]; !ok {
+				added[name] = cfg
+			}
+		}
 		meta.Class.VectorIndexConfig = u.VectorIndexConfig
 		meta.Class.InvertedIndexConfig = u.InvertedIndexConfig
 		meta.Class.VectorConfig = u.VectorConfig
 		meta.Class.ReplicationConfig = u.ReplicationConfig
 		meta.Class.MultiTenancyConfig = u.MultiTenancyConfig
+		meta.Class.StorageConfig = u.StorageConfig
 		meta.Class.Description = u.Description
 		meta.ClassVersion = cmd.Version
 		if req.State != nil {
 			meta.Sharding = *req.State
 		}
+		meta.startVectorBackfills(added, req.Time, cmd.Version)
 		return nil
 	}
 
@@ -269,6 +279,144 @@ func (s *SchemaManager) UpdateShardStatus(cmd *command.ApplyRequest, schemaOnly
 	)
 }
 
+func (s *SchemaManager) UpdateShardOwners(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.UpdateShardOwnersRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if len(req.Nodes) == 0 {
+		return fmt.Errorf("%w: empty shard owners", ErrBadRequest)
+	}
+
+	return s.apply(
+		applyOp{
+			op:           cmd.GetType().String(),
+			updateSchema: func() error { return s.schema.updateShardOwners(cmd.Class, cmd.Version, &req) },
+			updateStore:  func() error { return s.db.UpdateShardOwners(&req) },
+			schemaOnly:   schemaOnly,
+		},
+	)
+}
+
+func (s *SchemaManager) SetRebalancerPaused(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.SetRebalancerPausedRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return s.apply(
+		applyOp{
+			op:           cmd.GetType().String(),
+			updateSchema: func() error { s.schema.setRebalancerPaused(req.Paused); return nil },
+			updateStore:  func() error { return nil },
+			schemaOnly:   schemaOnly,
+		},
+	)
+}
+
+func (s *SchemaManager) StartResharding(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.StartReshardingRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if req.Resharding == nil || len(req.Resharding.Physical) == 0 {
+		return fmt.Errorf("%w: empty resharding layout", ErrBadRequest)
+	}
+
+	return s.apply(
+		applyOp{
+			op:           cmd.GetType().String(),
+			updateSchema: func() error { return s.schema.startResharding(cmd.Class, cmd.Version, req.Resharding) },
+			updateStore:  func() error { return s.db.StartResharding(cmd.Class) },
+			schemaOnly:   schemaOnly,
+		},
+	)
+}
+
+func (s *SchemaManager) CommitResharding(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req, err := finishReshardingRequest(cmd)
+	if err != nil {
+		return err
+	}
+
+	return s.apply(
+		applyOp{
+			op:                    cmd.GetType().String(),
+			updateSchema:          func() error { return s.schema.commitResharding(cmd.Class, cmd.Version, req) },
+			updateStore:           func() error { return s.db.FinishResharding(cmd.Class) },
+			schemaOnly:            schemaOnly,
+			triggerSchemaCallback: true,
+		},
+	)
+}
+
+func (s *SchemaManager) AbortResharding(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req, err := finishReshardingRequest(cmd)
+	if err != nil {
+		return err
+	}
+
+	return s.apply(
+		applyOp{
+			op:           cmd.GetType().String(),
+			updateSchema: func() error { return s.schema.abortResharding(cmd.Class, cmd.Version, req) },
+			updateStore:  func() error { return s.db.FinishResharding(cmd.Class) },
+			schemaOnly:   schemaOnly,
+		},
+	)
+}
+
+// finishReshardingRequest decodes the request of a commit or abort. Commands
+// of older versions don't have one.
+func finishReshardingRequest(cmd *command.ApplyRequest) (*command.FinishReshardingRequest, error) {
+	req := &command.FinishReshardingRequest{}
+	if len(cmd.SubCommand) == 0 {
+		return req, nil
+	}
+	if err := json.Unmarshal(cmd.SubCommand, req); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	return req, nil
+}
+
+func (s *SchemaManager) StartVectorReindex(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.StartVectorReindexRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if req.Job == nil {
+		return fmt.Errorf("%w: nil vector reindexing job", ErrBadRequest)
+	}
+
+	// the shards are reindexed by the vector reindexing manager of each node,
+	// which picks up the job from the schema
+	return s.apply(
+		applyOp{
+			op:           cmd.GetType().String(),
+			updateSchema: func() error { return s.schema.startVectorReindex(cmd.Class, cmd.Version, req.Job) },
+			updateStore:  func() error { return nil },
+			schemaOnly:   schemaOnly,
+		},
+	)
+}
+
+func (s *SchemaManager) UpdateVectorReindex(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.UpdateVectorReindexRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return s.apply(
+		applyOp{
+			op:                    cmd.GetType().String(),
+			updateSchema:          func() error { return s.schema.updateVectorReindex(cmd.Class, cmd.Version, &req) },
+			updateStore:           func() error { return nil },
+			schemaOnly:            schemaOnly,
+			triggerSchemaCallback: req.Status == vectorreindex.StatusCompleted,
+		},
+	)
+}
+
 func (s *SchemaManager) AddTenants(cmd *command.ApplyRequest, schemaOnly bool) error {
 	req := &command.AddTenantsRequest{}
 	if err := gproto.Unmarshal(cmd.SubCommand, req); err != nil {
@@ -18,12 +18,16 @@ import (
 	"fmt"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorreindex"
 	"github.com/weaviate/weaviate/usecases/fakes"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingcfg "github.com/weaviate/weaviate/usecases/sharding/config"
 )
 
 var errAny = errors.New("any error")
@@ -279,6 +283,7 @@ func TestSchemaSnapshot(t *testing.T) {
 	)
 	ss.SetLocalName(node)
 	assert.Nil(t, sc.addClass(cls, ss, 1))
+	sc.setRebalancerPaused(true)
 	parser.On("ParseClass", mock.Anything).Return(nil)
 
 	// Create Snapshot
@@ -289,6 +294,7 @@ func TestSchemaSnapshot(t *testing.T) {
 	sc2 := NewSchema("N1", fakes.NewMockSchemaExecutor())
 	assert.Nil(t, sc2.Restore(sink, parser))
 	assert.Equal(t, sc.Classes, sc2.Classes)
+	assert.True(t, sc2.RebalancerPaused())
 
 	// Encoding error
 	sink2 := &MockSnapshotSink{wErr: errAny, rErr: errAny}
@@ -340,3 +346,228 @@ func (m *MockSnapshotSink) Read(p []byte) (n int, err error) {
 	}
 	return m.buf.Read(p)
 }
+
+func TestSchemaUpdateShardOwners(t *testing.T) {
+	sc := &schema{
+		Classes:     make(map[string]*metaClass),
+		shardReader: &MockShardReader{},
+	}
+	req := &command.UpdateShardOwnersRequest{Class: "C", Shard: "S1", Nodes: []string{"N2", "N1"}}
+
+	err := sc.updateShardOwners("C", 2, req)
+	assert.ErrorIs(t, err, ErrClassNotFound)
+
+	ss := &sharding.State{Physical: map[string]sharding.Physical{
+		"S1": {Name: "S1", BelongsToNodes: []string{"N1"}},
+	}}
+	assert.Nil(t, sc.addClass(&models.Class{Class: "C"}, ss, 1))
+
+	err = sc.updateShardOwners("C", 2, &command.UpdateShardOwnersRequest{Class: "C", Shard: "S2", Nodes: []string{"N2"}})
+	assert.ErrorIs(t, err, ErrShardNotFound)
+
+	assert.Nil(t, sc.updateShardOwners("C", 2, req))
+	replicas, _, err := sc.ShardReplicas("C", "S1")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"N2", "N1"}, replicas)
+	owner, _, err := sc.ShardOwner("C", "S1")
+	assert.Nil(t, err)
+	assert.Equal(t, "N2", owner)
+	assert.Equal(t, uint64(2), sc.ClassInfo("C").ShardVersion)
+}
+
+func TestSchemaResharding(t *testing.T) {
+	sc := &schema{
+		Classes:     make(map[string]*metaClass),
+		shardReader: &MockShardReader{},
+	}
+	cfg, err := shardingcfg.ParseConfig(map[string]interface{}{"desiredCount": float64(1)}, 1)
+	assert.Nil(t, err)
+	ss, err := sharding.InitState("C", cfg, fakes.NewFakeClusterState("N1"), 1, false)
+	assert.Nil(t, err)
+	old := ss.AllPhysicalShards()[0]
+	assert.Nil(t, sc.addClass(&models.Class{Class: "C", ShardingConfig: cfg}, ss, 1))
+
+	plan, err := ss.PlanResharding(2, []string{"N1", "N2"}, 1)
+	assert.Nil(t, err)
+	assert.ErrorIs(t, sc.startResharding("D", 2, plan), ErrClassNotFound)
+	assert.Nil(t, sc.startResharding("C", 2, plan))
+	assert.NotNil(t, sc.startResharding("C", 3, plan), "resharding already in progress")
+
+	// the shards of both layouts can be resolved while resharding
+	for name, p := range plan.Physical {
+		owner, _, err := sc.ShardOwner("C", name)
+		assert.Nil(t, err)
+		assert.Equal(t, p.BelongsToNodes[0], owner)
+	}
+	_, _, err = sc.ShardOwner("C", old)
+	assert.Nil(t, err)
+
+	now := time.Now()
+	state, _ := sc.CopyShardingState("C")
+	assert.Equal(t, sharding.ReshardingRunning, state.Resharding.Status)
+	assert.Equal(t, uint64(2), state.Resharding.Version)
+	assert.NotNil(t, sc.abortResharding("C", 4, &command.FinishReshardingRequest{Version: 1}),
+		"resharding of another version")
+	assert.Nil(t, sc.abortResharding("C", 4, &command.FinishReshardingRequest{Version: 2, Error: "boom", Time: now}))
+	assert.NotNil(t, sc.abortResharding("C", 5, &command.FinishReshardingRequest{}))
+	state, _ = sc.CopyShardingState("C")
+	assert.Equal(t, sharding.ReshardingFailed, state.LastResharding.Status)
+	assert.Equal(t, "boom", state.LastResharding.Error)
+
+	assert.Nil(t, sc.startResharding("C", 6, plan))
+	assert.Nil(t, sc.commitResharding("C", 7, &command.FinishReshardingRequest{Version: 6, Time: now}))
+
+	state, _ = sc.CopyShardingState("C")
+	assert.Nil(t, state.Resharding)
+	assert.Equal(t, sharding.ReshardingCompleted, state.LastResharding.Status)
+	assert.Len(t, state.Physical, 2)
+	assert.Equal(t, 2, state.Config.DesiredCount)
+	class, _ := sc.ReadOnlyClass("C")
+	assert.Equal(t, 2, class.ShardingConfig.(shardingcfg.Config).DesiredCount)
+	assert.Equal(t, uint64(7), sc.ClassInfo("C").ShardVersion)
+	_, _, err = sc.ShardOwner("C", old)
+	assert.NotNil(t, err)
+}
+
+func TestSchemaVectorReindex(t *testing.T) {
+	sc := &schema{
+		Classes:     make(map[string]*metaClass),
+		shardReader: &MockShardReader{},
+	}
+	cfg, err := shardingcfg.ParseConfig(map[string]interface{}{"desiredCount": float64(1)}, 1)
+	assert.Nil(t, err)
+	ss, err := sharding.InitState("C", cfg, fakes.NewFakeClusterState("N1"), 1, false)
+	assert.Nil(t, err)
+	assert.Nil(t, sc.addClass(&models.Class{
+		Class: "C",
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {Vectorizer: map[string]interface{}{"text2vec-old": map[string]interface{}{}}},
+		},
+	}, ss, 1))
+
+	job := &vectorreindex.Job{
+		TargetVector: "title",
+		Vectorizer:   "text2vec-new",
+		ModuleConfig: map[string]interface{}{"model": "v2"},
+	}
+	assert.ErrorIs(t, sc.startVectorReindex("D", 2, job), ErrClassNotFound)
+	assert.NotNil(t, sc.startVectorReindex("C", 2, &vectorreindex.Job{TargetVector: "body"}))
+	assert.Nil(t, sc.startVectorReindex("C", 2, job))
+	assert.NotNil(t, sc.startVectorReindex("C", 3, job), "reindexing already in progress")
+
+	update := func(status string, v uint64) error {
+		return sc.updateVectorReindex("C", v, &command.UpdateVectorReindexRequest{
+			TargetVector: "title", Status: status,
+		})
+	}
+	assert.Nil(t, update(vectorreindex.StatusPaused, 4))
+	assert.NotNil(t, update(vectorreindex.StatusCompleted, 5), "paused jobs can't be committed")
+	assert.Nil(t, update(vectorreindex.StatusCancelled, 6))
+	assert.NotNil(t, update(vectorreindex.StatusRunning, 7), "cancelled jobs can't be resumed")
+
+	class, _ := sc.ReadOnlyClass("C")
+	assert.Equal(t, map[string]interface{}{"text2vec-old": map[string]interface{}{}},
+		class.VectorConfig["title"].Vectorizer)
+
+	assert.Nil(t, sc.startVectorReindex("C", 8, job))
+	jobs := sc.VectorReindexJobs()["C"]
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, vectorreindex.StatusRunning, jobs[0].Status)
+	assert.Equal(t, uint64(8), jobs[0].Version)
+
+	assert.Nil(t, update(vectorreindex.StatusCompleted, 9))
+	class, v := sc.ReadOnlyClass("C")
+	assert.Equal(t, uint64(9), v)
+	assert.Equal(t, map[string]interface{}{"text2vec-new": map[string]interface{}{"model": "v2"}},
+		class.VectorConfig["title"].Vectorizer)
+	assert.Equal(t, vectorreindex.StatusCompleted, sc.VectorReindexJobs()["C"][0].Status)
+}
+
+func TestSchemaVectorBackfill(t *testing.T) {
+	sc := &schema{
+		Classes:     make(map[string]*metaClass),
+		shardReader: &MockShardReader{},
+	}
+	cfg, err := shardingcfg.ParseConfig(map[string]interface{}{"desiredCount": float64(1)}, 1)
+	assert.Nil(t, err)
+	ss, err := sharding.InitState("C", cfg, fakes.NewFakeClusterState("N1"), 1, false)
+	assert.Nil(t, err)
+	assert.Nil(t, sc.addClass(&models.Class{
+		Class: "C",
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {Vectorizer: map[string]interface{}{"text2vec-old": map[string]interface{}{}}},
+		},
+	}, ss, 1))
+
+	start := time.Now()
+	added := map[string]models.VectorConfig{
+		"body":  {Vectorizer: map[string]interface{}{"text2vec-new": map[string]interface{}{"model": "v2"}}},
+		"image": {Vectorizer: map[string]interface{}{"none": map[string]interface{}{}}},
+	}
+	assert.Nil(t, sc.updateClass("C", func(meta *metaClass) error {
+		for name, vectorCfg := range added {
+			meta.Class.VectorConfig[name] = vectorCfg
+		}
+		meta.startVectorBackfills(added, start, 2)
+		return nil
+	}))
+
+	jobs := sc.VectorReindexJobs()["C"]
+	assert.Len(t, jobs, 1, "target vectors without vectorizer aren't backfilled")
+	assert.Equal(t, "body", jobs[0].TargetVector)
+	assert.Equal(t, "text2vec-new", jobs[0].Vectorizer)
+	assert.Equal(t, map[string]interface{}{"model": "v2"}, jobs[0].ModuleConfig)
+	assert.True(t, jobs[0].Backfill)
+	assert.Equal(t, vectorreindex.StatusRunning, jobs[0].Status)
+	assert.Equal(t, start, jobs[0].StartTime)
+	assert.Equal(t, uint64(2), jobs[0].Version)
+
+	assert.Nil(t, sc.updateVectorReindex("C", 3, &command.UpdateVectorReindexRequest{
+		TargetVector: "body", Status: vectorreindex.StatusCompleted,
+	}))
+	class, v := sc.ReadOnlyClass("C")
+	assert.Equal(t, uint64(1), v, "completed backfills don't change the class")
+	assert.Equal(t, added["body"].Vectorizer, class.VectorConfig["body"].Vectorizer)
+	assert.Equal(t, vectorreindex.StatusCompleted, sc.VectorReindexJobs()["C"][0].Status)
+}
+
+func TestSchemaVectorBackfillMultiTenant(t *testing.T) {
+	sc := &schema{
+		Classes:     make(map[string]*metaClass),
+		shardReader: &MockShardReader{},
+	}
+	ss, err := sharding.InitState("C", shardingcfg.Config{}, fakes.NewFakeClusterState("N1"), 1, true)
+	assert.Nil(t, err)
+	assert.Nil(t, sc.addClass(&models.Class{
+		Class:              "C",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		VectorConfig:       map[string]models.VectorConfig{},
+	}, ss, 1))
+
+	added := map[string]models.VectorConfig{
+		"body": {Vectorizer: map[string]interface{}{"text2vec-new": map[string]interface{}{}}},
+	}
+	assert.Nil(t, sc.updateClass("C", func(meta *metaClass) error {
+		meta.Class.VectorConfig["body"] = added["body"]
+		meta.startVectorBackfills(added, time.Now(), 2)
+		return nil
+	}))
+	jobs := sc.VectorReindexJobs()["C"]
+	assert.Len(t, jobs, 1, "multi-tenant classes are backfilled")
+
+	assert.Nil(t, sc.updateVectorReindex("C", 3, &command.UpdateVectorReindexRequest{
+		TargetVector: "body", Status: vectorreindex.StatusCompleted, Deferred: []string{"T1", "T2"},
+	}))
+	assert.Equal(t, []string{"T1", "T2"}, sc.VectorReindexJobs()["C"][0].Deferred)
+
+	assert.Nil(t, sc.updateVectorReindex("C", 4, &command.UpdateVectorReindexRequest{
+		TargetVector: "body", Status: vectorreindex.StatusCompleted, Deferred: []string{"T2"},
+	}))
+	job := sc.VectorReindexJobs()["C"][0]
+	assert.Equal(t, vectorreindex.StatusCompleted, job.Status)
+	assert.Equal(t, []string{"T2"}, job.Deferred)
+
+	assert.NotNil(t, sc.updateVectorReindex("C", 5, &command.UpdateVectorReindexRequest{
+		TargetVector: "body", Status: vectorreindex.StatusCancelled,
+	}), "committed backfills can't be cancelled")
+}
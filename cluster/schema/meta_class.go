@@ -15,11 +15,15 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/models"
 	entSchema "github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorreindex"
+	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingcfg "github.com/weaviate/weaviate/usecases/sharding/config"
 	"golang.org/x/exp/slices"
 )
 
@@ -29,6 +33,8 @@ type metaClass struct {
 	ClassVersion uint64
 	Sharding     sharding.State
 	ShardVersion uint64
+	// VectorReindexes are the last vector reindexing jobs by target vector
+	VectorReindexes map[string]*vectorreindex.Job `json:"vectorReindexes,omitempty"`
 }
 
 func (m *metaClass) ClassInfo() (ci ClassInfo) {
@@ -86,7 +92,7 @@ func (m *metaClass) CloneClass() *models.Class {
 func (m *metaClass) ShardOwner(shard string) (string, uint64, error) {
 	m.RLock()
 	defer m.RUnlock()
-	x, ok := m.Sharding.Physical[shard]
+	x, ok := m.physical(shard)
 
 	if !ok {
 		return "", 0, ErrShardNotFound
@@ -108,13 +114,26 @@ func (m *metaClass) ShardFromUUID(uuid []byte) (string, uint64) {
 func (m *metaClass) ShardReplicas(shard string) ([]string, uint64, error) {
 	m.RLock()
 	defer m.RUnlock()
-	x, ok := m.Sharding.Physical[shard]
+	x, ok := m.physical(shard)
 	if !ok {
 		return nil, 0, ErrShardNotFound
 	}
 	return slices.Clone(x.BelongsToNodes), m.version(), nil
 }
 
+// physical returns a physical shard of the class, including the shards the
+// class is being resharded to, so that they can receive writes
+func (m *metaClass) physical(shard string) (sharding.Physical, bool) {
+	if x, ok := m.Sharding.Physical[shard]; ok {
+		return x, true
+	}
+	if m.Sharding.Resharding == nil {
+		return sharding.Physical{}, false
+	}
+	x, ok := m.Sharding.Resharding.Physical[shard]
+	return x, ok
+}
+
 // TenantsShards returns shard name for the provided tenant and its activity status
 func (m *metaClass) TenantsShards(class string, tenants ...string) (map[string]string, uint64) {
 	m.RLock()
@@ -187,6 +206,213 @@ func MergeProps(old, new []*models.Property) []*models.Property {
 	return mergedProps
 }
 
+// UpdateShardOwners replaces the nodes a physical shard belongs to
+func (m *metaClass) UpdateShardOwners(req *command.UpdateShardOwnersRequest, v uint64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	p, ok := m.Sharding.Physical[req.Shard]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrShardNotFound, req.Shard)
+	}
+	copy := p.DeepCopy()
+	copy.BelongsToNodes = slices.Clone(req.Nodes)
+	m.Sharding.Physical[req.Shard] = copy
+	m.ShardVersion = v
+	return nil
+}
+
+// StartResharding stores the layout the physical shards are resharded to
+func (m *metaClass) StartResharding(resharding *sharding.Resharding, v uint64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.Sharding.PartitioningEnabled {
+		return fmt.Errorf("resharding is not supported for multi-tenant classes")
+	}
+	if m.Sharding.Resharding != nil {
+		return fmt.Errorf("resharding already in progress")
+	}
+	m.Sharding.Resharding = resharding.DeepCopy()
+	m.Sharding.Resharding.Status = sharding.ReshardingRunning
+	m.Sharding.Resharding.Version = v
+	m.ShardVersion = v
+	return nil
+}
+
+// CommitResharding replaces the physical shards by the ones the class was
+// resharded to
+func (m *metaClass) CommitResharding(req *command.FinishReshardingRequest, v uint64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if err := m.checkReshardingVersion(req.Version); err != nil {
+		return err
+	}
+	if err := m.Sharding.CommitResharding(req.Time); err != nil {
+		return err
+	}
+	if cfg, ok := m.Class.ShardingConfig.(shardingcfg.Config); ok {
+		cfg.DesiredCount = m.Sharding.Config.DesiredCount
+		cfg.ActualCount = m.Sharding.Config.ActualCount
+		m.Class.ShardingConfig = cfg
+	}
+	m.ClassVersion = v
+	m.ShardVersion = v
+	return nil
+}
+
+// AbortResharding discards the layout the class is resharded to
+func (m *metaClass) AbortResharding(req *command.FinishReshardingRequest, v uint64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if err := m.checkReshardingVersion(req.Version); err != nil {
+		return err
+	}
+	if err := m.Sharding.AbortResharding(req.Error, req.Time); err != nil {
+		return err
+	}
+	m.ShardVersion = v
+	return nil
+}
+
+// checkReshardingVersion returns an error if the resharding in progress is
+// not the one started with version. Version 0 matches any resharding.
+func (m *metaClass) checkReshardingVersion(version uint64) error {
+	if m.Sharding.Resharding == nil {
+		return fmt.Errorf("no resharding in progress")
+	}
+	if version != 0 && m.Sharding.Resharding.Version != version {
+		return fmt.Errorf("resharding %d is in progress, not %d", m.Sharding.Resharding.Version, version)
+	}
+	return nil
+}
+
+// StartVectorReindex stores a new vector reindexing job. It replaces the
+// previous job of the target vector, if that one is finished.
+func (m *metaClass) StartVectorReindex(job *vectorreindex.Job, v uint64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.Sharding.PartitioningEnabled {
+		return fmt.Errorf("vector reindexing is not supported for multi-tenant classes")
+	}
+	if job.TargetVector == "" {
+		if len(m.Class.VectorConfig) > 0 {
+			return fmt.Errorf("class %q has named vectors, a target vector is required", m.Class.Class)
+		}
+	} else if _, ok := m.Class.VectorConfig[job.TargetVector]; !ok {
+		return fmt.Errorf("target vector %q not found", job.TargetVector)
+	}
+	if prev := m.VectorReindexes[job.TargetVector]; prev != nil && !prev.Finished() {
+		return fmt.Errorf("vector reindexing of target vector %q already in progress", job.TargetVector)
+	}
+
+	copied := *job
+	copied.Status = vectorreindex.StatusRunning
+	copied.Error = ""
+	copied.Version = v
+	if m.VectorReindexes == nil {
+		m.VectorReindexes = map[string]*vectorreindex.Job{}
+	}
+	m.VectorReindexes[job.TargetVector] = &copied
+	return nil
+}
+
+// startVectorBackfills stores jobs which vectorize the existing objects for
+// target vectors added to the class. Target vectors without a vectorizer are
+// filled by the clients. Tenants of multi-tenant classes which are not active
+// are deferred until they are activated, see vectorreindex.Job. The lock must
+// be held.
+func (m *metaClass) startVectorBackfills(added map[string]models.VectorConfig, start time.Time, v uint64) {
+	for name, cfg := range added {
+		vectorizers, ok := cfg.Vectorizer.(map[string]interface{})
+		if !ok || len(vectorizers) != 1 {
+			continue
+		}
+		for vectorizer, moduleConfig := range vectorizers {
+			if vectorizer == config.VectorizerModuleNone {
+				continue
+			}
+			job := &vectorreindex.Job{
+				TargetVector: name,
+				Vectorizer:   vectorizer,
+				BatchSize:    vectorreindex.DefaultBatchSize,
+				Backfill:     true,
+				Status:       vectorreindex.StatusRunning,
+				StartTime:    start,
+				Version:      v,
+			}
+			job.ModuleConfig, _ = moduleConfig.(map[string]interface{})
+			if m.VectorReindexes == nil {
+				m.VectorReindexes = map[string]*vectorreindex.Job{}
+			}
+			m.VectorReindexes[name] = job
+		}
+	}
+}
+
+// UpdateVectorReindex pauses, resumes or finishes the vector reindexing job
+// of a target vector. Completing a job makes the class use its vectorizer.
+func (m *metaClass) UpdateVectorReindex(req *command.UpdateVectorReindexRequest, v uint64) error {
+	m.Lock()
+	defer m.Unlock()
+
+	job := m.VectorReindexes[req.TargetVector]
+	if job != nil && job.Backfill && job.Status == vectorreindex.StatusCompleted &&
+		req.Status == vectorreindex.StatusCompleted {
+		// deferred tenants of a committed backfill were done
+		copied := *job
+		copied.Deferred = req.Deferred
+		m.VectorReindexes[req.TargetVector] = &copied
+		return nil
+	}
+	if job == nil || job.Finished() {
+		return fmt.Errorf("no vector reindexing of target vector %q in progress", req.TargetVector)
+	}
+
+	switch req.Status {
+	case vectorreindex.StatusRunning, vectorreindex.StatusPaused,
+		vectorreindex.StatusCancelled, vectorreindex.StatusFailed:
+	case vectorreindex.StatusCompleted:
+		if job.Status != vectorreindex.StatusRunning {
+			return fmt.Errorf("vector reindexing of target vector %q is %s", req.TargetVector, job.Status)
+		}
+		// backfills use the vectorizer the class is configured with already
+		if !job.Backfill {
+			m.Class = *job.ApplyTo(&m.Class)
+			m.ClassVersion = v
+		}
+	default:
+		return fmt.Errorf("invalid vector reindexing status %q", req.Status)
+	}
+
+	copied := *job
+	copied.Status = req.Status
+	copied.Error = req.Error
+	if req.Status == vectorreindex.StatusCompleted && job.Backfill {
+		copied.Deferred = req.Deferred
+	}
+	if copied.Finished() {
+		copied.FinishTime = req.Time
+	}
+	m.VectorReindexes[req.TargetVector] = &copied
+	return nil
+}
+
+// VectorReindexJobs returns copies of the vector reindexing jobs of the class
+func (m *metaClass) VectorReindexJobs() []vectorreindex.Job {
+	m.RLock()
+	defer m.RUnlock()
+
+	jobs := make([]vectorreindex.Job, 0, len(m.VectorReindexes))
+	for _, job := range m.VectorReindexes {
+		jobs = append(jobs, *job)
+	}
+	return jobs
+}
+
 func (m *metaClass) AddTenants(nodeID string, req *command.AddTenantsRequest, replFactor int64, v uint64) error {
 	req.Tenants = removeNilTenants(req.Tenants)
 	m.Lock()
@@ -19,6 +19,7 @@ import (
 	"github.com/weaviate/weaviate/cluster/types"
 	"github.com/weaviate/weaviate/cluster/utils"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorreindex"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
@@ -158,6 +159,14 @@ func (rs SchemaReader) GetShardsStatus(class, tenant string) (models.ShardStatus
 
 func (rs SchemaReader) Len() int { return rs.schema.len() }
 
+// RebalancerPaused returns whether the automatic shard rebalancer is paused
+func (rs SchemaReader) RebalancerPaused() bool { return rs.schema.RebalancerPaused() }
+
+// VectorReindexJobs returns the vector reindexing jobs by class
+func (rs SchemaReader) VectorReindexJobs() map[string][]vectorreindex.Job {
+	return rs.schema.VectorReindexJobs()
+}
+
 func (rs SchemaReader) retry(f func(*schema) error) error {
 	return backoff.Retry(func() error {
 		return f(rs.schema)
@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/cluster/types"
 	"github.com/weaviate/weaviate/entities/models"
 	entSchema "github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorreindex"
 	"github.com/weaviate/weaviate/entities/versioned"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
@@ -50,6 +51,9 @@ type schema struct {
 	shardReader shardReader
 	sync.RWMutex
 	Classes map[string]*metaClass
+
+	// rebalancerPaused stops the automatic shard rebalancer of the cluster
+	rebalancerPaused bool
 }
 
 func (s *schema) ClassInfo(class string) ClassInfo {
@@ -202,6 +206,26 @@ func (s *schema) CopyShardingState(class string) (*sharding.State, uint64) {
 	return meta.CopyShardingState()
 }
 
+func (s *schema) RebalancerPaused() bool {
+	s.RLock()
+	defer s.RUnlock()
+	return s.rebalancerPaused
+}
+
+// VectorReindexJobs returns the vector reindexing jobs by class
+func (s *schema) VectorReindexJobs() map[string][]vectorreindex.Job {
+	s.RLock()
+	defer s.RUnlock()
+
+	jobs := make(map[string][]vectorreindex.Job)
+	for name, meta := range s.Classes {
+		if classJobs := meta.VectorReindexJobs(); len(classJobs) > 0 {
+			jobs[name] = classJobs
+		}
+	}
+	return jobs
+}
+
 func (s *schema) GetShardsStatus(class, tenant string) (models.ShardStatusList, error) {
 	return s.shardReader.GetShardsStatus(class, tenant)
 }
@@ -279,6 +303,78 @@ func (s *schema) addProperty(class string, v uint64, props ...*models.Property)
 	return meta.AddProperty(v, props...)
 }
 
+func (s *schema) updateShardOwners(class string, v uint64, req *command.UpdateShardOwnersRequest) error {
+	s.Lock()
+	defer s.Unlock()
+
+	meta := s.Classes[class]
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	return meta.UpdateShardOwners(req, v)
+}
+
+func (s *schema) setRebalancerPaused(paused bool) {
+	s.Lock()
+	defer s.Unlock()
+	s.rebalancerPaused = paused
+}
+
+func (s *schema) startResharding(class string, v uint64, resharding *sharding.Resharding) error {
+	s.Lock()
+	defer s.Unlock()
+
+	meta := s.Classes[class]
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	return meta.StartResharding(resharding, v)
+}
+
+func (s *schema) commitResharding(class string, v uint64, req *command.FinishReshardingRequest) error {
+	s.Lock()
+	defer s.Unlock()
+
+	meta := s.Classes[class]
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	return meta.CommitResharding(req, v)
+}
+
+func (s *schema) abortResharding(class string, v uint64, req *command.FinishReshardingRequest) error {
+	s.Lock()
+	defer s.Unlock()
+
+	meta := s.Classes[class]
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	return meta.AbortResharding(req, v)
+}
+
+func (s *schema) startVectorReindex(class string, v uint64, job *vectorreindex.Job) error {
+	s.Lock()
+	defer s.Unlock()
+
+	meta := s.Classes[class]
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	return meta.StartVectorReindex(job, v)
+}
+
+func (s *schema) updateVectorReindex(class string, v uint64, req *command.UpdateVectorReindexRequest) error {
+	s.Lock()
+	defer s.Unlock()
+
+	meta := s.Classes[class]
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	return meta.UpdateVectorReindex(req, v)
+}
+
 func (s *schema) addTenants(class string, v uint64, req *command.AddTenantsRequest) error {
 	req.Tenants = removeNilTenants(req.Tenants)
 
@@ -24,6 +24,8 @@ type snapshot struct {
 	NodeID     string                `json:"node_id"`
 	SnapshotID string                `json:"snapshot_id"`
 	Classes    map[string]*metaClass `json:"classes"`
+
+	RebalancerPaused bool `json:"rebalancer_paused,omitempty"`
 }
 
 func (s *schema) Restore(r io.Reader, parser Parser) error {
@@ -41,6 +43,7 @@ func (s *schema) Restore(r io.Reader, parser Parser) error {
 	s.Lock()
 	defer s.Unlock()
 	s.Classes = snap.Classes
+	s.rebalancerPaused = snap.RebalancerPaused
 
 	return nil
 }
@@ -56,6 +59,8 @@ func (s *schema) Persist(sink raft.SnapshotSink) (err error) {
 		NodeID:     s.nodeID,
 		SnapshotID: sink.ID(),
 		Classes:    s.Classes,
+
+		RebalancerPaused: s.rebalancerPaused,
 	}
 	if err := json.NewEncoder(sink).Encode(&snap); err != nil {
 		return fmt.Errorf("encode: %w", err)
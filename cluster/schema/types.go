@@ -37,6 +37,12 @@ type Indexer interface {
 	UpdateTenants(class string, req *api.UpdateTenantsRequest) error
 	DeleteTenants(class string, req *api.DeleteTenantsRequest) error
 	UpdateShardStatus(*api.UpdateShardStatusRequest) error
+	UpdateShardOwners(*api.UpdateShardOwnersRequest) error
+	// StartResharding creates the local shards a class is resharded to
+	StartResharding(class string) error
+	// FinishResharding drops the local shards which are not part of the
+	// layout of a class anymore, after resharding was committed or aborted
+	FinishResharding(class string) error
 	GetShardsStatus(class, tenant string) (models.ShardStatusList, error)
 	UpdateIndex(api.UpdateClassRequest) error
 
@@ -148,6 +148,27 @@ func (st *Store) Apply(l *raft.Log) interface{} {
 	case api.ApplyRequest_TYPE_UPDATE_SHARD_STATUS:
 		ret.Error = st.schemaManager.UpdateShardStatus(&cmd, schemaOnly)
 
+	case api.ApplyRequest_TYPE_UPDATE_SHARD_OWNERS:
+		ret.Error = st.schemaManager.UpdateShardOwners(&cmd, schemaOnly)
+
+	case api.ApplyRequest_TYPE_SET_REBALANCER_PAUSED:
+		ret.Error = st.schemaManager.SetRebalancerPaused(&cmd, schemaOnly)
+
+	case api.ApplyRequest_TYPE_START_RESHARDING:
+		ret.Error = st.schemaManager.StartResharding(&cmd, schemaOnly)
+
+	case api.ApplyRequest_TYPE_COMMIT_RESHARDING:
+		ret.Error = st.schemaManager.CommitResharding(&cmd, schemaOnly)
+
+	case api.ApplyRequest_TYPE_ABORT_RESHARDING:
+		ret.Error = st.schemaManager.AbortResharding(&cmd, schemaOnly)
+
+	case api.ApplyRequest_TYPE_START_VECTOR_REINDEX:
+		ret.Error = st.schemaManager.StartVectorReindex(&cmd, schemaOnly)
+
+	case api.ApplyRequest_TYPE_UPDATE_VECTOR_REINDEX:
+		ret.Error = st.schemaManager.UpdateVectorReindex(&cmd, schemaOnly)
+
 	case api.ApplyRequest_TYPE_ADD_TENANT:
 		ret.Error = st.schemaManager.AddTenants(&cmd, schemaOnly)
 
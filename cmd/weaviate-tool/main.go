@@ -0,0 +1,417 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// weaviate-tool inspects the files of a shard without starting Weaviate.
+// Nothing in the shard directory is modified, so it can be pointed at the
+// data of a running node or at a restored backup.
+//
+// The repair command is the exception, it runs migrations and repairs on the
+// data path of a node which is shut down. It refuses to run while the server
+// holds the lock of the data path.
+//
+// usage: weaviate-tool <command> [flags] <shard dir | data path>
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+const hnswCommitLogSuffix = ".hnsw.commitlog.d"
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string, out io.Writer) error
+}
+
+var commands = []command{
+	{"buckets", "list the buckets of the shard", runBuckets},
+	{"segments", "list the segments of every bucket, or of -bucket", runSegments},
+	{"dump", "print the keys and values of -bucket", runDump},
+	{"verify", "verify checksums, bloom filters and net count files", runVerify},
+	{"hnsw", "print statistics of the hnsw commit logs", runHNSW},
+	{"repair", "run migrations and repairs on the shards of a data path", runRepair},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == os.Args[1] {
+			if err := cmd.run(os.Args[2:], os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", cmd.name, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: %s <command> [flags] <shard dir | data path>\n\ncommands:\n", filepath.Base(os.Args[0]))
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", cmd.name, cmd.usage)
+	}
+}
+
+// parseFlags parses the flags of a command followed by the shard directory,
+// or the data path for repair
+func parseFlags(fs *flag.FlagSet, args []string) (string, error) {
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+	if fs.NArg() != 1 {
+		return "", fmt.Errorf("expected exactly one directory, got %d arguments", fs.NArg())
+	}
+	return fs.Arg(0), nil
+}
+
+func newLogger() logrus.FieldLogger {
+	logger := logrus.New()
+	logger.SetOutput(os.Stderr)
+	logger.SetLevel(logrus.WarnLevel)
+	return logger
+}
+
+// openStore opens the lsm store of the shard read-only, the caller has to
+// shut it down
+func openStore(shardDir string) (*lsmkv.Store, error) {
+	dir := filepath.Join(shardDir, "lsm")
+	if _, err := os.Stat(dir); err != nil {
+		return nil, fmt.Errorf("open lsm store: %w", err)
+	}
+	return lsmkv.NewReadOnly(context.Background(), dir, newLogger(), nil)
+}
+
+func sortedBuckets(store *lsmkv.Store) ([]string, map[string]*lsmkv.Bucket) {
+	buckets := store.GetBucketsByName()
+	names := make([]string, 0, len(buckets))
+	for name := range buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, buckets
+}
+
+func runBuckets(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("buckets", flag.ContinueOnError)
+	shardDir, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	store, err := openStore(shardDir)
+	if err != nil {
+		return err
+	}
+	defer store.Shutdown(context.Background())
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "BUCKET\tSTRATEGY\tSEGMENTS\tSIZE")
+	names, buckets := sortedBuckets(store)
+	for _, name := range names {
+		segments := buckets[name].Segments()
+		var size int64
+		for _, seg := range segments {
+			size += seg.Size
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\n", name, buckets[name].Strategy(), len(segments), size)
+	}
+	return w.Flush()
+}
+
+func runSegments(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("segments", flag.ContinueOnError)
+	bucketName := fs.String("bucket", "", "only list the segments of this bucket")
+	shardDir, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	store, err := openStore(shardDir)
+	if err != nil {
+		return err
+	}
+	defer store.Shutdown(context.Background())
+
+	names, buckets := sortedBuckets(store)
+	if *bucketName != "" {
+		if buckets[*bucketName] == nil {
+			return fmt.Errorf("bucket %q not found", *bucketName)
+		}
+		names = []string{*bucketName}
+	}
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "BUCKET\tSEGMENT\tSTRATEGY\tVERSION\tLEVEL\tSIZE\tPAYLOAD\tNET ADDITIONS")
+	for _, name := range names {
+		for _, seg := range buckets[name].Segments() {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%d\t%d\n", name, filepath.Base(seg.Path),
+				seg.Strategy, seg.Version, seg.Level, seg.Size, seg.PayloadSize, seg.CountNetAdditions)
+		}
+	}
+	return w.Flush()
+}
+
+func runDump(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("dump", flag.ContinueOnError)
+	bucketName := fs.String("bucket", helpers.ObjectsBucketLSM, "bucket to dump")
+	limit := fs.Int("limit", 0, "stop after this many keys, 0 dumps all keys")
+	raw := fs.Bool("raw", false, "print objects as hex instead of decoding them")
+	shardDir, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	store, err := openStore(shardDir)
+	if err != nil {
+		return err
+	}
+	defer store.Shutdown(context.Background())
+
+	bucket := store.Bucket(*bucketName)
+	if bucket == nil {
+		return fmt.Errorf("bucket %q not found", *bucketName)
+	}
+
+	d := &dumper{out: out, limit: *limit, decodeObjects: !*raw && *bucketName == helpers.ObjectsBucketLSM}
+	switch bucket.Strategy() {
+	case lsmkv.StrategyReplace:
+		return d.replace(bucket)
+	case lsmkv.StrategySetCollection:
+		return d.set(bucket)
+	case lsmkv.StrategyMapCollection:
+		return d.mapCollection(bucket)
+	case lsmkv.StrategyRoaringSet:
+		return d.roaringSet(bucket)
+	default:
+		return fmt.Errorf("unsupported strategy %q", bucket.Strategy())
+	}
+}
+
+type dumper struct {
+	out           io.Writer
+	limit         int
+	decodeObjects bool
+	count         int
+}
+
+// next counts the key about to be printed and reports whether the limit
+// allows it
+func (d *dumper) next() bool {
+	if d.limit > 0 && d.count >= d.limit {
+		return false
+	}
+	d.count++
+	return true
+}
+
+func (d *dumper) replace(bucket *lsmkv.Bucket) error {
+	c, err := bucket.Cursor()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	for k, v := c.First(); k != nil && d.next(); k, v = c.Next() {
+		if !d.decodeObjects {
+			fmt.Fprintf(d.out, "%x: %x\n", k, v)
+			continue
+		}
+
+		obj, err := storobj.FromBinary(v)
+		if err != nil {
+			return fmt.Errorf("decode object %x: %w", k, err)
+		}
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return fmt.Errorf("encode object %x: %w", k, err)
+		}
+		fmt.Fprintf(d.out, "%s\n", data)
+	}
+	return nil
+}
+
+func (d *dumper) set(bucket *lsmkv.Bucket) error {
+	c, err := bucket.SetCursor()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	for k, values := c.First(); k != nil && d.next(); k, values = c.Next() {
+		encoded := make([]string, len(values))
+		for i, v := range values {
+			encoded[i] = hex.EncodeToString(v)
+		}
+		fmt.Fprintf(d.out, "%x: [%s]\n", k, strings.Join(encoded, " "))
+	}
+	return nil
+}
+
+func (d *dumper) mapCollection(bucket *lsmkv.Bucket) error {
+	ctx := context.Background()
+	c, err := bucket.MapCursor()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	for k, pairs := c.First(ctx); k != nil && d.next(); k, pairs = c.Next(ctx) {
+		fmt.Fprintf(d.out, "%x:\n", k)
+		for _, pair := range pairs {
+			if pair.Tombstone {
+				fmt.Fprintf(d.out, "  %x: <tombstone>\n", pair.Key)
+				continue
+			}
+			fmt.Fprintf(d.out, "  %x: %x\n", pair.Key, pair.Value)
+		}
+	}
+	return nil
+}
+
+func (d *dumper) roaringSet(bucket *lsmkv.Bucket) error {
+	c, err := bucket.CursorRoaringSet()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	for k, bm := c.First(); k != nil && d.next(); k, bm = c.Next() {
+		fmt.Fprintf(d.out, "%x: %v\n", k, bm.ToArray())
+	}
+	return nil
+}
+
+func runVerify(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("verify", flag.ContinueOnError)
+	shardDir, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	store, err := openStore(shardDir)
+	if err != nil {
+		return err
+	}
+	defer store.Shutdown(context.Background())
+
+	failed := 0
+	report := func(path, check string, err error) {
+		if err == nil {
+			return
+		}
+		failed++
+		fmt.Fprintf(out, "%s: %s: %v\n", path, check, err)
+	}
+
+	// segments with invalid checksums are not loaded at all
+	for _, corrupt := range store.CorruptSegments() {
+		report(corrupt.Path, "checksum", errors.New(corrupt.Error))
+	}
+
+	names, buckets := sortedBuckets(store)
+	segments := 0
+	for _, name := range names {
+		for _, v := range buckets[name].VerifySegments() {
+			segments++
+			report(v.Path, "checksum", v.Checksums)
+			report(v.Path, "bloom filter", v.BloomFilters)
+			report(v.Path, "net additions", v.CountNetAdditions)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d problems found", failed)
+	}
+	fmt.Fprintf(out, "verified %d segments in %d buckets\n", segments, len(names))
+	return nil
+}
+
+func runHNSW(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("hnsw", flag.ContinueOnError)
+	index := fs.String("index", "", "vector index id, e.g. main or vectors_<name>, all indexes if empty")
+	shardDir, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	var indexes []string
+	if *index != "" {
+		indexes = []string{*index}
+	} else {
+		entries, err := os.ReadDir(shardDir)
+		if err != nil {
+			return fmt.Errorf("read shard directory: %w", err)
+		}
+		for _, entry := range entries {
+			if entry.IsDir() && strings.HasSuffix(entry.Name(), hnswCommitLogSuffix) {
+				indexes = append(indexes, strings.TrimSuffix(entry.Name(), hnswCommitLogSuffix))
+			}
+		}
+	}
+
+	logger := newLogger()
+	for _, id := range indexes {
+		stats, err := hnsw.ReadCommitLogStats(shardDir, id, logger)
+		if err != nil {
+			return fmt.Errorf("index %q: %w", id, err)
+		}
+
+		fmt.Fprintf(out, "index %s\n", id)
+		if stats.Snapshot != "" {
+			fmt.Fprintf(out, "  snapshot:    %s\n", filepath.Base(stats.Snapshot))
+		}
+		fmt.Fprintf(out, "  nodes:       %d\n", stats.Nodes)
+		fmt.Fprintf(out, "  links:       %d\n", stats.Links)
+		fmt.Fprintf(out, "  tombstones:  %d\n", stats.Tombstones)
+		fmt.Fprintf(out, "  entrypoint:  %d\n", stats.Entrypoint)
+		fmt.Fprintf(out, "  max level:   %d\n", stats.MaxLevel)
+		fmt.Fprintf(out, "  compressed:  %t\n", stats.Compressed)
+
+		w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "  FILE\tSIZE\tVALID\tERROR")
+		for _, file := range stats.Files {
+			errMsg := ""
+			if file.Err != nil {
+				errMsg = file.Err.Error()
+			}
+			fmt.Fprintf(w, "  %s\t%d\t%d\t%s\n", filepath.Base(file.Path), file.Size, file.ValidLength, errMsg)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,376 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/replication"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+// offlineNode owns all shards found in the data path
+const offlineNode = "offline"
+
+func runRepair(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("repair", flag.ContinueOnError)
+	schemaFile := fs.String("schema", "", "schema of the node as returned by GET /v1/schema (required)")
+	taskNames := fs.String("tasks", "", "comma separated tasks to run, the migrations if empty. "+
+		"Rebuilding property-lengths, vector-dimensions and hnsw-rebuild must be listed.")
+	className := fs.String("class", "", "only repair the shards of this class")
+	shardNames := fs.String("shard", "", "comma separated shards or tenants to repair, requires -class")
+	dryRun := fs.Bool("dry-run", false, "only print what would be repaired, without modifying files")
+	dataPath, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if *schemaFile == "" {
+		return fmt.Errorf("-schema is required")
+	}
+	if *shardNames != "" && *className == "" {
+		return fmt.Errorf("-shard requires -class")
+	}
+
+	tasks, err := repairTasks(*taskNames, *shardNames != "")
+	if err != nil {
+		return err
+	}
+
+	sg, err := loadOfflineSchema(*schemaFile, dataPath)
+	if err != nil {
+		return err
+	}
+	if err := sg.selectShards(*className, splitList(*shardNames)); err != nil {
+		return err
+	}
+
+	logger := newLogger()
+	var actions []db.RepairAction
+	if *dryRun {
+		actions, err = db.PlanRepair(context.Background(), dataPath, sg, tasks, logger)
+	} else {
+		cfg, cfgErr := repairConfig(dataPath)
+		if cfgErr != nil {
+			return cfgErr
+		}
+		actions, err = db.Repair(context.Background(), cfg, sg, tasks, logger)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(actions) == 0 {
+		fmt.Fprintln(out, "nothing to repair")
+		return nil
+	}
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "TASK\tCLASS\tSHARD\tACTION")
+	for _, action := range actions {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", action.Task, action.Class, action.Shard, action.Detail)
+	}
+	return w.Flush()
+}
+
+// repairTasks parses the task list. Without a list the migrations run, except
+// for the ones which can only run on whole classes if single shards are
+// repaired.
+func repairTasks(names string, singleShards bool) ([]db.RepairTask, error) {
+	if names == "" {
+		var tasks []db.RepairTask
+		for _, task := range db.RepairMigrations {
+			if singleShards && task == db.RepairMissingTextFilterable {
+				continue
+			}
+			tasks = append(tasks, task)
+		}
+		return tasks, nil
+	}
+
+	var tasks []db.RepairTask
+	for _, name := range splitList(names) {
+		task, err := db.ParseRepairTask(name)
+		if err != nil {
+			return nil, err
+		}
+		if singleShards && task == db.RepairMissingTextFilterable {
+			return nil, fmt.Errorf("task %q updates the migration state of the whole class "+
+				"and can't be run on single shards", task)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func splitList(in string) []string {
+	var out []string
+	for _, s := range strings.Split(in, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// repairConfig uses the same environment variables as the server to
+// configure the stores of the repaired shards
+func repairConfig(dataPath string) (db.Config, error) {
+	cfg := config.Config{}
+	if err := config.FromEnv(&cfg); err != nil {
+		return db.Config{}, fmt.Errorf("read config from environment: %w", err)
+	}
+
+	return db.Config{
+		RootPath:                  dataPath,
+		MemtablesFlushDirtyAfter:  cfg.Persistence.MemtablesFlushDirtyAfter,
+		MemtablesInitialSizeMB:    10,
+		MemtablesMaxSizeMB:        cfg.Persistence.MemtablesMaxSizeMB,
+		MemtablesMinActiveSeconds: cfg.Persistence.MemtablesMinActiveDurationSeconds,
+		MemtablesMaxActiveSeconds: cfg.Persistence.MemtablesMaxActiveDurationSeconds,
+		MaxSegmentSize:            cfg.Persistence.LSMMaxSegmentSize,
+		HNSWMaxLogSize:            cfg.Persistence.HNSWMaxLogSize,
+		LSMCompactionPolicy:       cfg.Persistence.LSMCompactionPolicy,
+		LSMCompactionFanOut:       cfg.Persistence.LSMCompactionFanOut,
+		QueryMaximumResults:       cfg.QueryMaximumResults,
+		MaxImportGoroutinesFactor: cfg.MaxImportGoroutinesFactor,
+		AvoidMMap:                 cfg.AvoidMmap,
+		Replication:               replication.GlobalConfig{MinimumFactor: 1},
+	}, nil
+}
+
+// offlineSchema serves a schema file to the db. The shards of the classes
+// are the shard directories found in the data path, all owned by this node.
+type offlineSchema struct {
+	schema schema.Schema
+	states map[string]*sharding.State
+}
+
+func loadOfflineSchema(schemaFile, dataPath string) (*offlineSchema, error) {
+	data, err := os.ReadFile(schemaFile)
+	if err != nil {
+		return nil, fmt.Errorf("read schema: %w", err)
+	}
+	sch := &models.Schema{}
+	if err := json.Unmarshal(data, sch); err != nil {
+		return nil, fmt.Errorf("decode schema: %w", err)
+	}
+
+	s := &offlineSchema{
+		schema: schema.Schema{Objects: sch},
+		states: map[string]*sharding.State{},
+	}
+	for _, class := range sch.Classes {
+		if err := parseOfflineClass(class); err != nil {
+			return nil, fmt.Errorf("class %q: %w", class.Class, err)
+		}
+		state, err := shardsOnDisk(class, dataPath)
+		if err != nil {
+			return nil, fmt.Errorf("class %q: %w", class.Class, err)
+		}
+		s.states[class.Class] = state
+	}
+	return s, nil
+}
+
+// parseOfflineClass sets the concrete config types, like the schema manager
+// does when it loads a class
+func parseOfflineClass(class *models.Class) error {
+	if class.ReplicationConfig == nil {
+		class.ReplicationConfig = &models.ReplicationConfig{Factor: 1}
+	}
+
+	if !schema.MultiTenancyEnabled(class) {
+		cfg, err := shardingConfig.ParseConfig(class.ShardingConfig, 1)
+		if err != nil {
+			return fmt.Errorf("parse sharding config: %w", err)
+		}
+		class.ShardingConfig = cfg
+	} else {
+		class.ShardingConfig = shardingConfig.Config{}
+	}
+
+	parse := func(cfg interface{}, indexType string) (interface{}, error) {
+		if indexType == "" {
+			indexType = vectorindex.DefaultVectorIndexType
+		}
+		return vectorindex.ParseAndValidateConfig(cfg, indexType)
+	}
+	if len(class.VectorConfig) == 0 {
+		cfg, err := parse(class.VectorIndexConfig, class.VectorIndexType)
+		if err != nil {
+			return fmt.Errorf("parse vector index config: %w", err)
+		}
+		class.VectorIndexConfig = cfg
+		return nil
+	}
+	for name, vectorConfig := range class.VectorConfig {
+		cfg, err := parse(vectorConfig.VectorIndexConfig, vectorConfig.VectorIndexType)
+		if err != nil {
+			return fmt.Errorf("parse vector index config of %q: %w", name, err)
+		}
+		vectorConfig.VectorIndexConfig = cfg
+		class.VectorConfig[name] = vectorConfig
+	}
+	return nil
+}
+
+func shardsOnDisk(class *models.Class, dataPath string) (*sharding.State, error) {
+	state := &sharding.State{
+		IndexID:             class.Class,
+		Physical:            map[string]sharding.Physical{},
+		PartitioningEnabled: schema.MultiTenancyEnabled(class),
+	}
+	if cfg, ok := class.ShardingConfig.(shardingConfig.Config); ok {
+		state.Config = cfg
+	}
+	state.SetLocalName(offlineNode)
+
+	entries, err := os.ReadDir(filepath.Join(dataPath, strings.ToLower(class.Class)))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("read index directory: %w", err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		state.Physical[entry.Name()] = sharding.Physical{
+			Name:           entry.Name(),
+			BelongsToNodes: []string{offlineNode},
+			Status:         models.TenantActivityStatusHOT,
+		}
+	}
+	return state, nil
+}
+
+// selectShards limits the schema to the class and its shards, if given
+func (s *offlineSchema) selectShards(className string, shards []string) error {
+	if className == "" {
+		return nil
+	}
+	class := s.schema.FindClassByName(schema.ClassName(className))
+	if class == nil {
+		return fmt.Errorf("class %q not found in schema", className)
+	}
+	s.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+
+	if len(shards) == 0 {
+		return nil
+	}
+	state := s.states[class.Class]
+	selected := map[string]sharding.Physical{}
+	for _, name := range shards {
+		physical, ok := state.Physical[name]
+		if !ok {
+			return fmt.Errorf("shard %q of class %q not found in data path", name, class.Class)
+		}
+		selected[name] = physical
+	}
+	state.Physical = selected
+	return nil
+}
+
+func (s *offlineSchema) GetSchemaSkipAuth() schema.Schema {
+	return s.schema
+}
+
+func (s *offlineSchema) ReadOnlyClass(class string) *models.Class {
+	return s.schema.GetClass(class)
+}
+
+func (s *offlineSchema) Nodes() []string {
+	return []string{offlineNode}
+}
+
+func (s *offlineSchema) NodeName() string {
+	return offlineNode
+}
+
+func (s *offlineSchema) ClusterHealthScore() int {
+	return 0
+}
+
+func (s *offlineSchema) ResolveParentNodes(string, string) (map[string]string, error) {
+	return nil, nil
+}
+
+func (s *offlineSchema) Statistics() map[string]any {
+	return nil
+}
+
+func (s *offlineSchema) CopyShardingState(class string) *sharding.State {
+	state, ok := s.states[class]
+	if !ok {
+		return nil
+	}
+	copied := *state
+	copied.Physical = make(map[string]sharding.Physical, len(state.Physical))
+	for name, physical := range state.Physical {
+		copied.Physical[name] = physical
+	}
+	return &copied
+}
+
+func (s *offlineSchema) ShardOwner(class, shard string) (string, error) {
+	if _, ok := s.physical(class, shard); !ok {
+		return "", fmt.Errorf("shard %q of class %q not found", shard, class)
+	}
+	return offlineNode, nil
+}
+
+func (s *offlineSchema) ShardReplicas(class, shard string) ([]string, error) {
+	if _, ok := s.physical(class, shard); !ok {
+		return nil, fmt.Errorf("shard %q of class %q not found", shard, class)
+	}
+	return []string{offlineNode}, nil
+}
+
+func (s *offlineSchema) TenantsShards(class string, tenants ...string) (map[string]string, error) {
+	status := map[string]string{}
+	for _, tenant := range tenants {
+		if physical, ok := s.physical(class, tenant); ok {
+			status[tenant] = physical.Status
+		}
+	}
+	return status, nil
+}
+
+func (s *offlineSchema) OptimisticTenantStatus(class string, tenant string) (map[string]string, error) {
+	return s.TenantsShards(class, tenant)
+}
+
+func (s *offlineSchema) physical(class, shard string) (sharding.Physical, bool) {
+	state, ok := s.states[class]
+	if !ok {
+		return sharding.Physical{}, false
+	}
+	physical, ok := state.Physical[shard]
+	return physical, ok
+}
+
+// ShardFromUUID isn't supported, the virtual shards of the classes are
+// unknown offline
+func (s *offlineSchema) ShardFromUUID(class string, uuid []byte) string {
+	return ""
+}
@@ -41,6 +41,11 @@ type Properties struct {
 	// operation that isn't required.
 	NoProps bool `json:"noProps"`
 
+	// ExactSearch computes the distances to all vectors of the searched
+	// shards instead of using the vector index, so vector searches return the
+	// exact nearest neighbors regardless of the index type and compression.
+	ExactSearch bool `json:"exactSearch"`
+
 	// ReferenceQuery is used to indicate that a search
 	// is being conducted on behalf of a referenced
 	// property. for example: this is relevant when a
@@ -35,6 +35,9 @@ type Params struct {
 	NearVector       *searchparams.NearVector   `json:"nearVector"`
 	NearObject       *searchparams.NearObject   `json:"nearObject"`
 	Hybrid           *searchparams.HybridSearch `json:"hybrid"`
+	// ExactSearch computes the exact distances to all vectors instead of
+	// using the vector index for near searches
+	ExactSearch bool `json:"exactSearch"`
 }
 
 type ParamProperty struct {
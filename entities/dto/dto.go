@@ -24,23 +24,47 @@ type GroupParams struct {
 }
 
 type GetParams struct {
-	Filters               *filters.LocalFilter
-	ClassName             string
-	Pagination            *filters.Pagination
-	Cursor                *filters.Cursor
-	Sort                  []filters.Sort
-	Properties            search.SelectProperties
-	NearVector            *searchparams.NearVector
-	NearObject            *searchparams.NearObject
-	KeywordRanking        *searchparams.KeywordRanking
-	HybridSearch          *searchparams.HybridSearch
-	GroupBy               *searchparams.GroupBy
-	SearchVector          []float32
-	TargetVector          string
-	Group                 *GroupParams
-	ModuleParams          map[string]interface{}
-	AdditionalProperties  additional.Properties
-	ReplicationProperties *additional.ReplicationProperties
-	Tenant                string
-	IsRefOrigin           bool // is created by ref filter
+	Filters                 *filters.LocalFilter
+	ClassName               string
+	Pagination              *filters.Pagination
+	Cursor                  *filters.Cursor
+	Sort                    []filters.Sort
+	Properties              search.SelectProperties
+	NearVector              *searchparams.NearVector
+	NearObject              *searchparams.NearObject
+	KeywordRanking          *searchparams.KeywordRanking
+	HybridSearch            *searchparams.HybridSearch
+	GroupBy                 *searchparams.GroupBy
+	SearchVector            []float32
+	TargetVector            string
+	SearchVectors           [][]float32
+	TargetVectors           []string
+	TargetVectorCombination *TargetCombination
+	Group                   *GroupParams
+	ModuleParams            map[string]interface{}
+	AdditionalProperties    additional.Properties
+	ReplicationProperties   *additional.ReplicationProperties
+	Tenant                  string
+	IsRefOrigin             bool // is created by ref filter
+}
+
+type TargetCombinationType int
+
+const (
+	Minimum TargetCombinationType = iota
+	Sum
+	Average
+	ManualWeights
+	RelativeScore
+)
+
+// TargetCombination defines how the distances of an object to several
+// target vectors are combined into a single distance. It is used when
+// searching several named vectors at once, in which case SearchVectors and
+// TargetVectors of GetParams are set instead of SearchVector and TargetVector.
+type TargetCombination struct {
+	Type TargetCombinationType
+	// Weights by target vector, used by ManualWeights and RelativeScore. Targets
+	// without weight have a weight of 1 for RelativeScore.
+	Weights map[string]float32
 }
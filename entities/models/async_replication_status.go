@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// AsyncReplicationStatus The status of the async replication of a shard with one of its replicas
+//
+// swagger:model AsyncReplicationStatus
+type AsyncReplicationStatus struct {
+
+	// The number of hashtree leaves found to differ in the last comparison.
+	DifferingLeaves int64 `json:"differingLeaves"`
+
+	// How long the replica has been known to be out of sync with the shard (in ms), 0 if it was in sync at the last comparison.
+	LagMillis int64 `json:"lagMillis"`
+
+	// The time of the last hashtree comparison with the replica (in ms since epoch).
+	LastComparisonUnixMillis int64 `json:"lastComparisonUnixMillis"`
+
+	// The number of objects propagated to the replica since the shard was loaded.
+	ObjectsPropagated int64 `json:"objectsPropagated"`
+
+	// The name of the node holding the replica the shard was compared with.
+	TargetNode string `json:"targetNode"`
+}
+
+// Validate validates this async replication status
+func (m *AsyncReplicationStatus) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this async replication status based on context it is used
+func (m *AsyncReplicationStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AsyncReplicationStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AsyncReplicationStatus) UnmarshalBinary(b []byte) error {
+	var res AsyncReplicationStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,560 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BatchUpdateResponse Update Objects response.
+//
+// swagger:model BatchUpdateResponse
+type BatchUpdateResponse struct {
+
+	// If true, objects will not be updated yet, but merely listed. Defaults to false.
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// match
+	Match *BatchUpdateResponseMatch `json:"match,omitempty"`
+
+	// Controls the verbosity of the output, possible values are: "minimal", "verbose". Defaults to "minimal".
+	Output *string `json:"output,omitempty"`
+
+	// results
+	Results *BatchUpdateResponseResults `json:"results,omitempty"`
+}
+
+// Validate validates this batch update response
+func (m *BatchUpdateResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMatch(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResults(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchUpdateResponse) validateMatch(formats strfmt.Registry) error {
+	if swag.IsZero(m.Match) { // not required
+		return nil
+	}
+
+	if m.Match != nil {
+		if err := m.Match.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("match")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("match")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BatchUpdateResponse) validateResults(formats strfmt.Registry) error {
+	if swag.IsZero(m.Results) { // not required
+		return nil
+	}
+
+	if m.Results != nil {
+		if err := m.Results.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("results")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("results")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this batch update response based on the context it is used
+func (m *BatchUpdateResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMatch(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateResults(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchUpdateResponse) contextValidateMatch(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Match != nil {
+		if err := m.Match.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("match")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("match")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BatchUpdateResponse) contextValidateResults(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Results != nil {
+		if err := m.Results.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("results")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("results")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BatchUpdateResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BatchUpdateResponse) UnmarshalBinary(b []byte) error {
+	var res BatchUpdateResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// BatchUpdateResponseMatch Outlines how to find the objects to be updated.
+//
+// swagger:model BatchUpdateResponseMatch
+type BatchUpdateResponseMatch struct {
+
+	// Class (name) which objects will be updated.
+	// Example: City
+	Class string `json:"class,omitempty"`
+
+	// Filter to limit the objects to be updated.
+	Where *WhereFilter `json:"where,omitempty"`
+}
+
+// Validate validates this batch update response match
+func (m *BatchUpdateResponseMatch) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateWhere(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchUpdateResponseMatch) validateWhere(formats strfmt.Registry) error {
+	if swag.IsZero(m.Where) { // not required
+		return nil
+	}
+
+	if m.Where != nil {
+		if err := m.Where.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("match" + "." + "where")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("match" + "." + "where")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this batch update response match based on the context it is used
+func (m *BatchUpdateResponseMatch) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateWhere(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchUpdateResponseMatch) contextValidateWhere(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Where != nil {
+		if err := m.Where.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("match" + "." + "where")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("match" + "." + "where")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BatchUpdateResponseMatch) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BatchUpdateResponseMatch) UnmarshalBinary(b []byte) error {
+	var res BatchUpdateResponseMatch
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// BatchUpdateResponseResults batch update response results
+//
+// swagger:model BatchUpdateResponseResults
+type BatchUpdateResponseResults struct {
+
+	// How many objects should have been updated but could not be updated.
+	Failed int64 `json:"failed"`
+
+	// The most amount of objects that can be updated in a single query, equals QUERY_MAXIMUM_RESULTS. The objects are updated in the order of their ids.
+	Limit int64 `json:"limit"`
+
+	// How many objects were matched by the filter.
+	Matches int64 `json:"matches"`
+
+	// Set if more objects match the filter than the limit allows to update. Pass it as after to continue the update.
+	// Format: uuid
+	Next strfmt.UUID `json:"next,omitempty"`
+
+	// With output set to "minimal" only objects with error occurred will the be described. Successfully updated objects would be omitted. Output set to "verbose" will list all of the objets with their respective statuses.
+	Objects []*BatchUpdateResponseResultsObjectsItems0 `json:"objects"`
+
+	// How many objects were successfully updated in this round.
+	Updated int64 `json:"updated"`
+}
+
+// Validate validates this batch update response results
+func (m *BatchUpdateResponseResults) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateNext(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateObjects(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchUpdateResponseResults) validateNext(formats strfmt.Registry) error {
+	if swag.IsZero(m.Next) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("results"+"."+"next", "body", "uuid", m.Next.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BatchUpdateResponseResults) validateObjects(formats strfmt.Registry) error {
+	if swag.IsZero(m.Objects) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Objects); i++ {
+		if swag.IsZero(m.Objects[i]) { // not required
+			continue
+		}
+
+		if m.Objects[i] != nil {
+			if err := m.Objects[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("results" + "." + "objects" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("results" + "." + "objects" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this batch update response results based on the context it is used
+func (m *BatchUpdateResponseResults) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateObjects(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchUpdateResponseResults) contextValidateObjects(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Objects); i++ {
+
+		if m.Objects[i] != nil {
+			if err := m.Objects[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("results" + "." + "objects" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("results" + "." + "objects" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BatchUpdateResponseResults) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BatchUpdateResponseResults) UnmarshalBinary(b []byte) error {
+	var res BatchUpdateResponseResults
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// BatchUpdateResponseResultsObjectsItems0 Results for this specific Object.
+//
+// swagger:model BatchUpdateResponseResultsObjectsItems0
+type BatchUpdateResponseResultsObjectsItems0 struct {
+
+	// errors
+	Errors *ErrorResponse `json:"errors,omitempty"`
+
+	// ID of the Object.
+	// Format: uuid
+	ID strfmt.UUID `json:"id,omitempty"`
+
+	// status
+	// Enum: [SUCCESS DRYRUN FAILED]
+	Status *string `json:"status,omitempty"`
+}
+
+// Validate validates this batch update response results objects items0
+func (m *BatchUpdateResponseResultsObjectsItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateErrors(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchUpdateResponseResultsObjectsItems0) validateErrors(formats strfmt.Registry) error {
+	if swag.IsZero(m.Errors) { // not required
+		return nil
+	}
+
+	if m.Errors != nil {
+		if err := m.Errors.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("errors")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("errors")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *BatchUpdateResponseResultsObjectsItems0) validateID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var batchUpdateResponseResultsObjectsItems0TypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["SUCCESS","DRYRUN","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		batchUpdateResponseResultsObjectsItems0TypeStatusPropEnum = append(batchUpdateResponseResultsObjectsItems0TypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// BatchUpdateResponseResultsObjectsItems0StatusSUCCESS captures enum value "SUCCESS"
+	BatchUpdateResponseResultsObjectsItems0StatusSUCCESS string = "SUCCESS"
+
+	// BatchUpdateResponseResultsObjectsItems0StatusDRYRUN captures enum value "DRYRUN"
+	BatchUpdateResponseResultsObjectsItems0StatusDRYRUN string = "DRYRUN"
+
+	// BatchUpdateResponseResultsObjectsItems0StatusFAILED captures enum value "FAILED"
+	BatchUpdateResponseResultsObjectsItems0StatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *BatchUpdateResponseResultsObjectsItems0) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, batchUpdateResponseResultsObjectsItems0TypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BatchUpdateResponseResultsObjectsItems0) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this batch update response results objects items0 based on the context it is used
+func (m *BatchUpdateResponseResultsObjectsItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateErrors(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BatchUpdateResponseResultsObjectsItems0) contextValidateErrors(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Errors != nil {
+		if err := m.Errors.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("errors")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("errors")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BatchUpdateResponseResultsObjectsItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BatchUpdateResponseResultsObjectsItems0) UnmarshalBinary(b []byte) error {
+	var res BatchUpdateResponseResultsObjectsItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
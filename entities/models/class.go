@@ -55,6 +55,9 @@ type Class struct {
 	// Manage how the index should be sharded and distributed in the cluster
 	ShardingConfig interface{} `json:"shardingConfig,omitempty"`
 
+	// storage config
+	StorageConfig *StorageConfig `json:"storageConfig,omitempty"`
+
 	// vector config
 	VectorConfig map[string]VectorConfig `json:"vectorConfig,omitempty"`
 
@@ -88,6 +91,10 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateStorageConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateVectorConfig(formats); err != nil {
 		res = append(res, err)
 	}
@@ -181,6 +188,25 @@ func (m *Class) validateReplicationConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateStorageConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.StorageConfig) { // not required
+		return nil
+	}
+
+	if m.StorageConfig != nil {
+		if err := m.StorageConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("storageConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("storageConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateVectorConfig(formats strfmt.Registry) error {
 	if swag.IsZero(m.VectorConfig) { // not required
 		return nil
@@ -227,6 +253,10 @@ func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) er
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateStorageConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateVectorConfig(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -305,6 +335,22 @@ func (m *Class) contextValidateReplicationConfig(ctx context.Context, formats st
 	return nil
 }
 
+func (m *Class) contextValidateStorageConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.StorageConfig != nil {
+		if err := m.StorageConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("storageConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("storageConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) contextValidateVectorConfig(ctx context.Context, formats strfmt.Registry) error {
 
 	for k := range m.VectorConfig {
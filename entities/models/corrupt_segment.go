@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// CorruptSegment An LSM segment which failed checksum verification
+//
+// swagger:model CorruptSegment
+type CorruptSegment struct {
+
+	// The name of the bucket the segment belongs to.
+	Bucket string `json:"bucket"`
+
+	// The time the corruption was detected (in ms since epoch).
+	DetectedUnixMillis int64 `json:"detectedUnixMillis"`
+
+	// The checksum failure.
+	Error string `json:"error"`
+
+	// The path of the segment file.
+	Path string `json:"path"`
+}
+
+// Validate validates this corrupt segment
+func (m *CorruptSegment) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this corrupt segment based on context it is used
+func (m *CorruptSegment) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CorruptSegment) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CorruptSegment) UnmarshalBinary(b []byte) error {
+	var res CorruptSegment
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
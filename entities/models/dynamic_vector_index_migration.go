@@ -0,0 +1,187 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DynamicVectorIndexMigration The running migration of a dynamic vector index between flat and hnsw, or the last one since the shard was loaded
+//
+// swagger:model DynamicVectorIndexMigration
+type DynamicVectorIndexMigration struct {
+
+	// Whether the index is upgraded to hnsw or downgraded to flat.
+	// Enum: [UPGRADE DOWNGRADE]
+	Direction string `json:"direction"`
+
+	// The reason the migration failed.
+	Error string `json:"error,omitempty"`
+
+	// The estimated time the running migration completes (in ms since epoch), 0 if it can't be estimated yet.
+	EstimatedFinishUnixMillis int64 `json:"estimatedFinishUnixMillis"`
+
+	// The time the migration completed or failed (in ms since epoch), 0 while it is running.
+	FinishedUnixMillis int64 `json:"finishedUnixMillis"`
+
+	// The number of vectors added to the new index so far.
+	ObjectsProcessed int64 `json:"objectsProcessed"`
+
+	// The number of vectors in the index when the migration started.
+	ObjectsTotal int64 `json:"objectsTotal"`
+
+	// The time the migration was started (in ms since epoch).
+	StartedUnixMillis int64 `json:"startedUnixMillis"`
+
+	// The state of the migration.
+	// Enum: [RUNNING COMPLETED FAILED]
+	Status string `json:"status"`
+}
+
+// Validate validates this dynamic vector index migration
+func (m *DynamicVectorIndexMigration) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDirection(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var dynamicVectorIndexMigrationTypeDirectionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["UPGRADE","DOWNGRADE"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		dynamicVectorIndexMigrationTypeDirectionPropEnum = append(dynamicVectorIndexMigrationTypeDirectionPropEnum, v)
+	}
+}
+
+const (
+
+	// DynamicVectorIndexMigrationDirectionUPGRADE captures enum value "UPGRADE"
+	DynamicVectorIndexMigrationDirectionUPGRADE string = "UPGRADE"
+
+	// DynamicVectorIndexMigrationDirectionDOWNGRADE captures enum value "DOWNGRADE"
+	DynamicVectorIndexMigrationDirectionDOWNGRADE string = "DOWNGRADE"
+)
+
+// prop value enum
+func (m *DynamicVectorIndexMigration) validateDirectionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, dynamicVectorIndexMigrationTypeDirectionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DynamicVectorIndexMigration) validateDirection(formats strfmt.Registry) error {
+	if swag.IsZero(m.Direction) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateDirectionEnum("direction", "body", m.Direction); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var dynamicVectorIndexMigrationTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["RUNNING","COMPLETED","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		dynamicVectorIndexMigrationTypeStatusPropEnum = append(dynamicVectorIndexMigrationTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// DynamicVectorIndexMigrationStatusRUNNING captures enum value "RUNNING"
+	DynamicVectorIndexMigrationStatusRUNNING string = "RUNNING"
+
+	// DynamicVectorIndexMigrationStatusCOMPLETED captures enum value "COMPLETED"
+	DynamicVectorIndexMigrationStatusCOMPLETED string = "COMPLETED"
+
+	// DynamicVectorIndexMigrationStatusFAILED captures enum value "FAILED"
+	DynamicVectorIndexMigrationStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *DynamicVectorIndexMigration) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, dynamicVectorIndexMigrationTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DynamicVectorIndexMigration) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this dynamic vector index migration based on context it is used
+func (m *DynamicVectorIndexMigration) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DynamicVectorIndexMigration) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DynamicVectorIndexMigration) UnmarshalBinary(b []byte) error {
+	var res DynamicVectorIndexMigration
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
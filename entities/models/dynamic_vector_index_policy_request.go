@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DynamicVectorIndexPolicyRequest Sets whether the dynamic vector indexes of a class are upgraded from flat to hnsw automatically, deferred or forced
+//
+// swagger:model DynamicVectorIndexPolicyRequest
+type DynamicVectorIndexPolicyRequest struct {
+
+	// The name of the target vector, empty for the legacy vector.
+	TargetVector string `json:"targetVector,omitempty"`
+
+	// The tenant whose index is updated. If empty, the indexes of all shards of the class are updated.
+	Tenant string `json:"tenant,omitempty"`
+
+	// AUTO upgrades the index once it reaches the threshold and downgrades it below the downgrade threshold, DEFER keeps it flat and FORCE upgrades it right away and never downgrades it.
+	// Required: true
+	// Enum: [AUTO DEFER FORCE]
+	UpgradePolicy *string `json:"upgradePolicy"`
+}
+
+// Validate validates this dynamic vector index policy request
+func (m *DynamicVectorIndexPolicyRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateUpgradePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var dynamicVectorIndexPolicyRequestTypeUpgradePolicyPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AUTO","DEFER","FORCE"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		dynamicVectorIndexPolicyRequestTypeUpgradePolicyPropEnum = append(dynamicVectorIndexPolicyRequestTypeUpgradePolicyPropEnum, v)
+	}
+}
+
+const (
+
+	// DynamicVectorIndexPolicyRequestUpgradePolicyAUTO captures enum value "AUTO"
+	DynamicVectorIndexPolicyRequestUpgradePolicyAUTO string = "AUTO"
+
+	// DynamicVectorIndexPolicyRequestUpgradePolicyDEFER captures enum value "DEFER"
+	DynamicVectorIndexPolicyRequestUpgradePolicyDEFER string = "DEFER"
+
+	// DynamicVectorIndexPolicyRequestUpgradePolicyFORCE captures enum value "FORCE"
+	DynamicVectorIndexPolicyRequestUpgradePolicyFORCE string = "FORCE"
+)
+
+// prop value enum
+func (m *DynamicVectorIndexPolicyRequest) validateUpgradePolicyEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, dynamicVectorIndexPolicyRequestTypeUpgradePolicyPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DynamicVectorIndexPolicyRequest) validateUpgradePolicy(formats strfmt.Registry) error {
+
+	if err := validate.Required("upgradePolicy", "body", m.UpgradePolicy); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateUpgradePolicyEnum("upgradePolicy", "body", *m.UpgradePolicy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this dynamic vector index policy request based on context it is used
+func (m *DynamicVectorIndexPolicyRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DynamicVectorIndexPolicyRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DynamicVectorIndexPolicyRequest) UnmarshalBinary(b []byte) error {
+	var res DynamicVectorIndexPolicyRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,229 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DynamicVectorIndexStatus The state of a dynamic vector index of a shard
+//
+// swagger:model DynamicVectorIndexStatus
+type DynamicVectorIndexStatus struct {
+
+	// The index currently serving the vectors.
+	// Enum: [flat hnsw]
+	Backend string `json:"backend"`
+
+	// The number of vectors below which an upgraded index is downgraded to flat, 0 if downgrades are disabled.
+	DowngradeThreshold int64 `json:"downgradeThreshold"`
+
+	// migration
+	Migration *DynamicVectorIndexMigration `json:"migration,omitempty"`
+
+	// The name of the target vector, empty for the legacy vector.
+	TargetVector string `json:"targetVector"`
+
+	// The number of vectors beyond which the index is upgraded to hnsw.
+	Threshold int64 `json:"threshold"`
+
+	// Whether the index is upgraded once it reaches the threshold (AUTO), never (DEFER) or right away (FORCE).
+	// Enum: [AUTO DEFER FORCE]
+	UpgradePolicy string `json:"upgradePolicy"`
+}
+
+// Validate validates this dynamic vector index status
+func (m *DynamicVectorIndexStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBackend(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMigration(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpgradePolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var dynamicVectorIndexStatusTypeBackendPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["flat","hnsw"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		dynamicVectorIndexStatusTypeBackendPropEnum = append(dynamicVectorIndexStatusTypeBackendPropEnum, v)
+	}
+}
+
+const (
+
+	// DynamicVectorIndexStatusBackendFlat captures enum value "flat"
+	DynamicVectorIndexStatusBackendFlat string = "flat"
+
+	// DynamicVectorIndexStatusBackendHnsw captures enum value "hnsw"
+	DynamicVectorIndexStatusBackendHnsw string = "hnsw"
+)
+
+// prop value enum
+func (m *DynamicVectorIndexStatus) validateBackendEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, dynamicVectorIndexStatusTypeBackendPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DynamicVectorIndexStatus) validateBackend(formats strfmt.Registry) error {
+	if swag.IsZero(m.Backend) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateBackendEnum("backend", "body", m.Backend); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DynamicVectorIndexStatus) validateMigration(formats strfmt.Registry) error {
+	if swag.IsZero(m.Migration) { // not required
+		return nil
+	}
+
+	if m.Migration != nil {
+		if err := m.Migration.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("migration")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("migration")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var dynamicVectorIndexStatusTypeUpgradePolicyPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AUTO","DEFER","FORCE"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		dynamicVectorIndexStatusTypeUpgradePolicyPropEnum = append(dynamicVectorIndexStatusTypeUpgradePolicyPropEnum, v)
+	}
+}
+
+const (
+
+	// DynamicVectorIndexStatusUpgradePolicyAUTO captures enum value "AUTO"
+	DynamicVectorIndexStatusUpgradePolicyAUTO string = "AUTO"
+
+	// DynamicVectorIndexStatusUpgradePolicyDEFER captures enum value "DEFER"
+	DynamicVectorIndexStatusUpgradePolicyDEFER string = "DEFER"
+
+	// DynamicVectorIndexStatusUpgradePolicyFORCE captures enum value "FORCE"
+	DynamicVectorIndexStatusUpgradePolicyFORCE string = "FORCE"
+)
+
+// prop value enum
+func (m *DynamicVectorIndexStatus) validateUpgradePolicyEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, dynamicVectorIndexStatusTypeUpgradePolicyPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DynamicVectorIndexStatus) validateUpgradePolicy(formats strfmt.Registry) error {
+	if swag.IsZero(m.UpgradePolicy) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateUpgradePolicyEnum("upgradePolicy", "body", m.UpgradePolicy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this dynamic vector index status based on the context it is used
+func (m *DynamicVectorIndexStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMigration(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DynamicVectorIndexStatus) contextValidateMigration(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Migration != nil {
+		if err := m.Migration.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("migration")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("migration")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DynamicVectorIndexStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DynamicVectorIndexStatus) UnmarshalBinary(b []byte) error {
+	var res DynamicVectorIndexStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
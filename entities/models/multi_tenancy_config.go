@@ -34,6 +34,12 @@ type MultiTenancyConfig struct {
 	// Nonexistent tenants should (not) be created implicitly
 	AutoTenantCreation bool `json:"autoTenantCreation"`
 
+	// HOT tenants should (not) be turned COLD implicitly when they were not accessed for autoTenantDeactivationTimeout seconds
+	AutoTenantDeactivation bool `json:"autoTenantDeactivation"`
+
+	// Seconds after which a HOT tenant which was not accessed is turned COLD, if autoTenantDeactivation is enabled. Defaults to 3600.
+	AutoTenantDeactivationTimeout int64 `json:"autoTenantDeactivationTimeout,omitempty"`
+
 	// Whether or not multi-tenancy is enabled for this class
 	Enabled bool `json:"enabled"`
 }
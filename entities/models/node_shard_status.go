@@ -18,7 +18,9 @@ package models
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -28,12 +30,18 @@ import (
 // swagger:model NodeShardStatus
 type NodeShardStatus struct {
 
+	// The status of the async replication of the shard with each of its replicas.
+	AsyncReplicationStatus []*AsyncReplicationStatus `json:"asyncReplicationStatus"`
+
 	// The name of shard's class.
 	Class string `json:"class"`
 
 	// The status of vector compression/quantization.
 	Compressed bool `json:"compressed"`
 
+	// The backend of each of the shard's dynamic vector indexes and the progress of their migration between flat and hnsw.
+	DynamicVectorIndexes []*DynamicVectorIndexStatus `json:"dynamicVectorIndexes"`
+
 	// The load status of the shard.
 	Loaded bool `json:"loaded"`
 
@@ -43,6 +51,12 @@ type NodeShardStatus struct {
 	// The number of objects in shard.
 	ObjectCount int64 `json:"objectCount"`
 
+	// segment integrity
+	SegmentIntegrity *SegmentIntegrityStatus `json:"segmentIntegrity,omitempty"`
+
+	// The background rebuilds of the shard's vector indexes, started by changing parameters which determine the structure of the graph.
+	VectorIndexRebuilds []*VectorIndexRebuildStatus `json:"vectorIndexRebuilds"`
+
 	// The status of the vector indexing process.
 	VectorIndexingStatus string `json:"vectorIndexingStatus"`
 
@@ -52,11 +66,226 @@ type NodeShardStatus struct {
 
 // Validate validates this node shard status
 func (m *NodeShardStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAsyncReplicationStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDynamicVectorIndexes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSegmentIntegrity(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVectorIndexRebuilds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodeShardStatus) validateAsyncReplicationStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.AsyncReplicationStatus) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.AsyncReplicationStatus); i++ {
+		if swag.IsZero(m.AsyncReplicationStatus[i]) { // not required
+			continue
+		}
+
+		if m.AsyncReplicationStatus[i] != nil {
+			if err := m.AsyncReplicationStatus[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("asyncReplicationStatus" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("asyncReplicationStatus" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NodeShardStatus) validateDynamicVectorIndexes(formats strfmt.Registry) error {
+	if swag.IsZero(m.DynamicVectorIndexes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.DynamicVectorIndexes); i++ {
+		if swag.IsZero(m.DynamicVectorIndexes[i]) { // not required
+			continue
+		}
+
+		if m.DynamicVectorIndexes[i] != nil {
+			if err := m.DynamicVectorIndexes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("dynamicVectorIndexes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("dynamicVectorIndexes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NodeShardStatus) validateSegmentIntegrity(formats strfmt.Registry) error {
+	if swag.IsZero(m.SegmentIntegrity) { // not required
+		return nil
+	}
+
+	if m.SegmentIntegrity != nil {
+		if err := m.SegmentIntegrity.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("segmentIntegrity")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("segmentIntegrity")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *NodeShardStatus) validateVectorIndexRebuilds(formats strfmt.Registry) error {
+	if swag.IsZero(m.VectorIndexRebuilds) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.VectorIndexRebuilds); i++ {
+		if swag.IsZero(m.VectorIndexRebuilds[i]) { // not required
+			continue
+		}
+
+		if m.VectorIndexRebuilds[i] != nil {
+			if err := m.VectorIndexRebuilds[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vectorIndexRebuilds" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vectorIndexRebuilds" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
-// ContextValidate validates this node shard status based on context it is used
+// ContextValidate validate this node shard status based on the context it is used
 func (m *NodeShardStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAsyncReplicationStatus(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDynamicVectorIndexes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSegmentIntegrity(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVectorIndexRebuilds(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodeShardStatus) contextValidateAsyncReplicationStatus(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.AsyncReplicationStatus); i++ {
+
+		if m.AsyncReplicationStatus[i] != nil {
+			if err := m.AsyncReplicationStatus[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("asyncReplicationStatus" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("asyncReplicationStatus" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NodeShardStatus) contextValidateDynamicVectorIndexes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.DynamicVectorIndexes); i++ {
+
+		if m.DynamicVectorIndexes[i] != nil {
+			if err := m.DynamicVectorIndexes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("dynamicVectorIndexes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("dynamicVectorIndexes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NodeShardStatus) contextValidateSegmentIntegrity(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.SegmentIntegrity != nil {
+		if err := m.SegmentIntegrity.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("segmentIntegrity")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("segmentIntegrity")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *NodeShardStatus) contextValidateVectorIndexRebuilds(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.VectorIndexRebuilds); i++ {
+
+		if m.VectorIndexRebuilds[i] != nil {
+			if err := m.VectorIndexRebuilds[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vectorIndexRebuilds" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vectorIndexRebuilds" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
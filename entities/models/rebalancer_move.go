@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// RebalancerMove A shard move started by the rebalancer
+//
+// swagger:model RebalancerMove
+type RebalancerMove struct {
+
+	// The name of the class the shard belongs to
+	Class string `json:"class,omitempty"`
+
+	// The reason of a failed move
+	Error string `json:"error,omitempty"`
+
+	// Finish time of the move in milliseconds since epoch UTC
+	FinishTimeUnix int64 `json:"finishTimeUnix,omitempty"`
+
+	// The name of the shard. For multi-tenant classes, the name of the tenant
+	Shard string `json:"shard,omitempty"`
+
+	// The node the shard is moved from
+	SourceNode string `json:"sourceNode,omitempty"`
+
+	// Start time of the move in milliseconds since epoch UTC
+	StartTimeUnix int64 `json:"startTimeUnix,omitempty"`
+
+	// The status of the move
+	// Enum: [RUNNING SUCCESS FAILED]
+	Status string `json:"status,omitempty"`
+
+	// The node the shard is moved to
+	TargetNode string `json:"targetNode,omitempty"`
+}
+
+// Validate validates this rebalancer move
+func (m *RebalancerMove) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var rebalancerMoveTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["RUNNING","SUCCESS","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		rebalancerMoveTypeStatusPropEnum = append(rebalancerMoveTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// RebalancerMoveStatusRUNNING captures enum value "RUNNING"
+	RebalancerMoveStatusRUNNING string = "RUNNING"
+
+	// RebalancerMoveStatusSUCCESS captures enum value "SUCCESS"
+	RebalancerMoveStatusSUCCESS string = "SUCCESS"
+
+	// RebalancerMoveStatusFAILED captures enum value "FAILED"
+	RebalancerMoveStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *RebalancerMove) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, rebalancerMoveTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *RebalancerMove) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this rebalancer move based on context it is used
+func (m *RebalancerMove) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RebalancerMove) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RebalancerMove) UnmarshalBinary(b []byte) error {
+	var res RebalancerMove
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
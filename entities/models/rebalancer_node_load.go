@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// RebalancerNodeLoad The load of a node as seen by the rebalancer
+//
+// swagger:model RebalancerNodeLoad
+type RebalancerNodeLoad struct {
+
+	// The percentage of the disk of the node which is in use
+	DiskUsedPercent float64 `json:"diskUsedPercent,omitempty"`
+
+	// The name of the node
+	Name string `json:"name,omitempty"`
+
+	// The number of shards (and tenants) the node holds
+	Shards int64 `json:"shards,omitempty"`
+}
+
+// Validate validates this rebalancer node load
+func (m *RebalancerNodeLoad) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this rebalancer node load based on context it is used
+func (m *RebalancerNodeLoad) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RebalancerNodeLoad) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RebalancerNodeLoad) UnmarshalBinary(b []byte) error {
+	var res RebalancerNodeLoad
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
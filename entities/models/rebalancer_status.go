@@ -0,0 +1,196 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// RebalancerStatus The state of the automatic shard rebalancer of the cluster
+//
+// swagger:model RebalancerStatus
+type RebalancerStatus struct {
+
+	// Whether the rebalancer is enabled in the configuration of the cluster
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Time of the last rebalancing round in milliseconds since epoch UTC
+	LastRunTimeUnix int64 `json:"lastRunTimeUnix,omitempty"`
+
+	// The node running the rebalancer, which is the current leader of the cluster
+	Leader string `json:"leader,omitempty"`
+
+	// The shard moves started by the rebalancer, most recent last
+	Moves []*RebalancerMove `json:"moves"`
+
+	// The current load of the nodes of the cluster
+	Nodes []*RebalancerNodeLoad `json:"nodes"`
+
+	// Whether the rebalancer is paused. A paused rebalancer finishes the moves in progress, but does not start new ones
+	Paused bool `json:"paused,omitempty"`
+}
+
+// Validate validates this rebalancer status
+func (m *RebalancerStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMoves(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNodes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RebalancerStatus) validateMoves(formats strfmt.Registry) error {
+	if swag.IsZero(m.Moves) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Moves); i++ {
+		if swag.IsZero(m.Moves[i]) { // not required
+			continue
+		}
+
+		if m.Moves[i] != nil {
+			if err := m.Moves[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("moves" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("moves" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *RebalancerStatus) validateNodes(formats strfmt.Registry) error {
+	if swag.IsZero(m.Nodes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Nodes); i++ {
+		if swag.IsZero(m.Nodes[i]) { // not required
+			continue
+		}
+
+		if m.Nodes[i] != nil {
+			if err := m.Nodes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nodes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nodes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this rebalancer status based on the context it is used
+func (m *RebalancerStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMoves(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateNodes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RebalancerStatus) contextValidateMoves(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Moves); i++ {
+
+		if m.Moves[i] != nil {
+			if err := m.Moves[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("moves" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("moves" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *RebalancerStatus) contextValidateNodes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Nodes); i++ {
+
+		if m.Nodes[i] != nil {
+			if err := m.Nodes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nodes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nodes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RebalancerStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RebalancerStatus) UnmarshalBinary(b []byte) error {
+	var res RebalancerStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
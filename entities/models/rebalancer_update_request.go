@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// RebalancerUpdateRequest Request to pause or resume the automatic shard rebalancer
+//
+// swagger:model RebalancerUpdateRequest
+type RebalancerUpdateRequest struct {
+
+	// Pause the rebalancer if true, resume it otherwise
+	// Required: true
+	Paused *bool `json:"paused"`
+}
+
+// Validate validates this rebalancer update request
+func (m *RebalancerUpdateRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePaused(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RebalancerUpdateRequest) validatePaused(formats strfmt.Registry) error {
+
+	if err := validate.Required("paused", "body", m.Paused); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this rebalancer update request based on context it is used
+func (m *RebalancerUpdateRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RebalancerUpdateRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RebalancerUpdateRequest) UnmarshalBinary(b []byte) error {
+	var res RebalancerUpdateRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
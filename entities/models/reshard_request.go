@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ReshardRequest Request to change the number of physical shards of a class which does not have multi-tenancy enabled
+//
+// swagger:model ReshardRequest
+type ReshardRequest struct {
+
+	// The name of the class to reshard
+	// Required: true
+	Class *string `json:"class"`
+
+	// The number of physical shards the class is split or merged into
+	// Required: true
+	// Minimum: 1
+	ShardCount *int64 `json:"shardCount"`
+}
+
+// Validate validates this reshard request
+func (m *ReshardRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClass(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateShardCount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReshardRequest) validateClass(formats strfmt.Registry) error {
+
+	if err := validate.Required("class", "body", m.Class); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ReshardRequest) validateShardCount(formats strfmt.Registry) error {
+
+	if err := validate.Required("shardCount", "body", m.ShardCount); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("shardCount", "body", *m.ShardCount, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this reshard request based on context it is used
+func (m *ReshardRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReshardRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReshardRequest) UnmarshalBinary(b []byte) error {
+	var res ReshardRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ReshardingNodeStatus The progress of a node filling its shards of the new layout
+//
+// swagger:model ReshardingNodeStatus
+type ReshardingNodeStatus struct {
+
+	// Set if the progress of the node could not be retrieved
+	Error string `json:"error,omitempty"`
+
+	// The name of the node
+	Node string `json:"node,omitempty"`
+
+	// shards
+	Shards []*ReshardingShardStatus `json:"shards"`
+}
+
+// Validate validates this resharding node status
+func (m *ReshardingNodeStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateShards(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReshardingNodeStatus) validateShards(formats strfmt.Registry) error {
+	if swag.IsZero(m.Shards) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Shards); i++ {
+		if swag.IsZero(m.Shards[i]) { // not required
+			continue
+		}
+
+		if m.Shards[i] != nil {
+			if err := m.Shards[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("shards" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("shards" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this resharding node status based on the context it is used
+func (m *ReshardingNodeStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateShards(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReshardingNodeStatus) contextValidateShards(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Shards); i++ {
+
+		if m.Shards[i] != nil {
+			if err := m.Shards[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("shards" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("shards" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReshardingNodeStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReshardingNodeStatus) UnmarshalBinary(b []byte) error {
+	var res ReshardingNodeStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
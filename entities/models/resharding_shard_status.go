@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ReshardingShardStatus The progress of a shard of the new layout on a node
+//
+// swagger:model ReshardingShardStatus
+type ReshardingShardStatus struct {
+
+	// The reason of a failed copy
+	Error string `json:"error,omitempty"`
+
+	// The name of the shard
+	Shard string `json:"shard,omitempty"`
+
+	// RUNNING while the objects are copied, READY once they are
+	// Enum: [RUNNING READY FAILED]
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this resharding shard status
+func (m *ReshardingShardStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var reshardingShardStatusTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["RUNNING","READY","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		reshardingShardStatusTypeStatusPropEnum = append(reshardingShardStatusTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ReshardingShardStatusStatusRUNNING captures enum value "RUNNING"
+	ReshardingShardStatusStatusRUNNING string = "RUNNING"
+
+	// ReshardingShardStatusStatusREADY captures enum value "READY"
+	ReshardingShardStatusStatusREADY string = "READY"
+
+	// ReshardingShardStatusStatusFAILED captures enum value "FAILED"
+	ReshardingShardStatusStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *ReshardingShardStatus) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, reshardingShardStatusTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ReshardingShardStatus) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this resharding shard status based on context it is used
+func (m *ReshardingShardStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReshardingShardStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReshardingShardStatus) UnmarshalBinary(b []byte) error {
+	var res ReshardingShardStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
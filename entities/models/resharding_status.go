@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ReshardingStatus The state of the resharding of a class
+//
+// swagger:model ReshardingStatus
+type ReshardingStatus struct {
+
+	// The name of the class
+	Class string `json:"class,omitempty"`
+
+	// The reason of a failed resharding
+	Error string `json:"error,omitempty"`
+
+	// Finish time of the resharding in milliseconds since epoch UTC
+	FinishTimeUnix int64 `json:"finishTimeUnix,omitempty"`
+
+	// The progress of the nodes owning the new shards, only set while the resharding is running
+	Nodes []*ReshardingNodeStatus `json:"nodes"`
+
+	// The number of physical shards the class is resharded to
+	ShardCount int64 `json:"shardCount,omitempty"`
+
+	// Start time of the resharding in milliseconds since epoch UTC
+	StartTimeUnix int64 `json:"startTimeUnix,omitempty"`
+
+	// The status of the resharding
+	// Enum: [RUNNING COMPLETED FAILED]
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this resharding status
+func (m *ReshardingStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateNodes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReshardingStatus) validateNodes(formats strfmt.Registry) error {
+	if swag.IsZero(m.Nodes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Nodes); i++ {
+		if swag.IsZero(m.Nodes[i]) { // not required
+			continue
+		}
+
+		if m.Nodes[i] != nil {
+			if err := m.Nodes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nodes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nodes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var reshardingStatusTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["RUNNING","COMPLETED","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		reshardingStatusTypeStatusPropEnum = append(reshardingStatusTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ReshardingStatusStatusRUNNING captures enum value "RUNNING"
+	ReshardingStatusStatusRUNNING string = "RUNNING"
+
+	// ReshardingStatusStatusCOMPLETED captures enum value "COMPLETED"
+	ReshardingStatusStatusCOMPLETED string = "COMPLETED"
+
+	// ReshardingStatusStatusFAILED captures enum value "FAILED"
+	ReshardingStatusStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *ReshardingStatus) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, reshardingStatusTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ReshardingStatus) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this resharding status based on the context it is used
+func (m *ReshardingStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateNodes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReshardingStatus) contextValidateNodes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Nodes); i++ {
+
+		if m.Nodes[i] != nil {
+			if err := m.Nodes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nodes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nodes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReshardingStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReshardingStatus) UnmarshalBinary(b []byte) error {
+	var res ReshardingStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,173 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// SegmentIntegrityStatus The LSM segments of a shard which failed checksum verification and the repair of the shard from its replicas
+//
+// swagger:model SegmentIntegrityStatus
+type SegmentIntegrityStatus struct {
+
+	// The segments which failed checksum verification and were not yet removed by a repair.
+	CorruptSegments []*CorruptSegment `json:"corruptSegments"`
+
+	// repair
+	Repair *SegmentRepairStatus `json:"repair,omitempty"`
+}
+
+// Validate validates this segment integrity status
+func (m *SegmentIntegrityStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCorruptSegments(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRepair(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SegmentIntegrityStatus) validateCorruptSegments(formats strfmt.Registry) error {
+	if swag.IsZero(m.CorruptSegments) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.CorruptSegments); i++ {
+		if swag.IsZero(m.CorruptSegments[i]) { // not required
+			continue
+		}
+
+		if m.CorruptSegments[i] != nil {
+			if err := m.CorruptSegments[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("corruptSegments" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("corruptSegments" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *SegmentIntegrityStatus) validateRepair(formats strfmt.Registry) error {
+	if swag.IsZero(m.Repair) { // not required
+		return nil
+	}
+
+	if m.Repair != nil {
+		if err := m.Repair.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("repair")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("repair")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this segment integrity status based on the context it is used
+func (m *SegmentIntegrityStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCorruptSegments(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRepair(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SegmentIntegrityStatus) contextValidateCorruptSegments(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.CorruptSegments); i++ {
+
+		if m.CorruptSegments[i] != nil {
+			if err := m.CorruptSegments[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("corruptSegments" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("corruptSegments" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *SegmentIntegrityStatus) contextValidateRepair(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Repair != nil {
+		if err := m.Repair.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("repair")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("repair")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SegmentIntegrityStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SegmentIntegrityStatus) UnmarshalBinary(b []byte) error {
+	var res SegmentIntegrityStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SegmentRepairStatus The running repair of a shard with corrupted segments, or the last one since the shard was loaded
+//
+// swagger:model SegmentRepairStatus
+type SegmentRepairStatus struct {
+
+	// The reason the repair failed.
+	Error string `json:"error,omitempty"`
+
+	// The time the repair completed or failed (in ms since epoch), 0 while it is running.
+	FinishedUnixMillis int64 `json:"finishedUnixMillis"`
+
+	// The number of objects restored from replicas so far.
+	ObjectsCopied int64 `json:"objectsCopied"`
+
+	// The paths of the corrupted segments which were removed from the shard, they are kept with a .corrupt extension.
+	QuarantinedSegments []string `json:"quarantinedSegments"`
+
+	// The time the repair was started (in ms since epoch).
+	StartedUnixMillis int64 `json:"startedUnixMillis"`
+
+	// The state of the repair.
+	// Enum: [RUNNING COMPLETED FAILED]
+	Status string `json:"status"`
+}
+
+// Validate validates this segment repair status
+func (m *SegmentRepairStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var segmentRepairStatusTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["RUNNING","COMPLETED","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		segmentRepairStatusTypeStatusPropEnum = append(segmentRepairStatusTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// SegmentRepairStatusStatusRUNNING captures enum value "RUNNING"
+	SegmentRepairStatusStatusRUNNING string = "RUNNING"
+
+	// SegmentRepairStatusStatusCOMPLETED captures enum value "COMPLETED"
+	SegmentRepairStatusStatusCOMPLETED string = "COMPLETED"
+
+	// SegmentRepairStatusStatusFAILED captures enum value "FAILED"
+	SegmentRepairStatusStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *SegmentRepairStatus) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, segmentRepairStatusTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SegmentRepairStatus) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this segment repair status based on context it is used
+func (m *SegmentRepairStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SegmentRepairStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SegmentRepairStatus) UnmarshalBinary(b []byte) error {
+	var res SegmentRepairStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
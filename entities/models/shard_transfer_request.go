@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ShardTransferRequest Request to copy or move a physical shard (or tenant) of a class from one node to another
+//
+// swagger:model ShardTransferRequest
+type ShardTransferRequest struct {
+
+	// The name of the class the shard belongs to
+	// Required: true
+	Class *string `json:"class"`
+
+	// The name of the shard. For multi-tenant classes, the name of the tenant
+	// Required: true
+	Shard *string `json:"shard"`
+
+	// The node currently holding the shard
+	// Required: true
+	SourceNode *string `json:"sourceNode"`
+
+	// The node the shard is transferred to
+	// Required: true
+	TargetNode *string `json:"targetNode"`
+
+	// COPY adds the target node as a new replica of the shard, MOVE additionally removes the shard from the source node
+	// Enum: [COPY MOVE]
+	TransferType *string `json:"transferType,omitempty"`
+}
+
+// Validate validates this shard transfer request
+func (m *ShardTransferRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClass(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateShard(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSourceNode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTargetNode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTransferType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ShardTransferRequest) validateClass(formats strfmt.Registry) error {
+
+	if err := validate.Required("class", "body", m.Class); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ShardTransferRequest) validateShard(formats strfmt.Registry) error {
+
+	if err := validate.Required("shard", "body", m.Shard); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ShardTransferRequest) validateSourceNode(formats strfmt.Registry) error {
+
+	if err := validate.Required("sourceNode", "body", m.SourceNode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ShardTransferRequest) validateTargetNode(formats strfmt.Registry) error {
+
+	if err := validate.Required("targetNode", "body", m.TargetNode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var shardTransferRequestTypeTransferTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["COPY","MOVE"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		shardTransferRequestTypeTransferTypePropEnum = append(shardTransferRequestTypeTransferTypePropEnum, v)
+	}
+}
+
+const (
+
+	// ShardTransferRequestTransferTypeCOPY captures enum value "COPY"
+	ShardTransferRequestTransferTypeCOPY string = "COPY"
+
+	// ShardTransferRequestTransferTypeMOVE captures enum value "MOVE"
+	ShardTransferRequestTransferTypeMOVE string = "MOVE"
+)
+
+// prop value enum
+func (m *ShardTransferRequest) validateTransferTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, shardTransferRequestTypeTransferTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ShardTransferRequest) validateTransferType(formats strfmt.Registry) error {
+	if swag.IsZero(m.TransferType) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTransferTypeEnum("transferType", "body", *m.TransferType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this shard transfer request based on context it is used
+func (m *ShardTransferRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ShardTransferRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ShardTransferRequest) UnmarshalBinary(b []byte) error {
+	var res ShardTransferRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,193 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ShardTransferStatus The state of a shard transfer
+//
+// swagger:model ShardTransferStatus
+type ShardTransferStatus struct {
+
+	// The name of the class the shard belongs to
+	Class string `json:"class,omitempty"`
+
+	// The reason of a failed transfer
+	Error string `json:"error,omitempty"`
+
+	// Finish time of the transfer in milliseconds since epoch UTC
+	FinishTimeUnix int64 `json:"finishTimeUnix,omitempty"`
+
+	// The id of the transfer
+	ID string `json:"id,omitempty"`
+
+	// The name of the shard. For multi-tenant classes, the name of the tenant
+	Shard string `json:"shard,omitempty"`
+
+	// The node the shard is transferred from
+	SourceNode string `json:"sourceNode,omitempty"`
+
+	// Start time of the transfer in milliseconds since epoch UTC
+	StartTimeUnix int64 `json:"startTimeUnix,omitempty"`
+
+	// The status of the transfer
+	// Enum: [RUNNING SUCCESS FAILED]
+	Status string `json:"status,omitempty"`
+
+	// The node the shard is transferred to
+	TargetNode string `json:"targetNode,omitempty"`
+
+	// Whether the shard is copied or moved
+	// Enum: [COPY MOVE]
+	TransferType string `json:"transferType,omitempty"`
+}
+
+// Validate validates this shard transfer status
+func (m *ShardTransferStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTransferType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var shardTransferStatusTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["RUNNING","SUCCESS","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		shardTransferStatusTypeStatusPropEnum = append(shardTransferStatusTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ShardTransferStatusStatusRUNNING captures enum value "RUNNING"
+	ShardTransferStatusStatusRUNNING string = "RUNNING"
+
+	// ShardTransferStatusStatusSUCCESS captures enum value "SUCCESS"
+	ShardTransferStatusStatusSUCCESS string = "SUCCESS"
+
+	// ShardTransferStatusStatusFAILED captures enum value "FAILED"
+	ShardTransferStatusStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *ShardTransferStatus) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, shardTransferStatusTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ShardTransferStatus) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var shardTransferStatusTypeTransferTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["COPY","MOVE"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		shardTransferStatusTypeTransferTypePropEnum = append(shardTransferStatusTypeTransferTypePropEnum, v)
+	}
+}
+
+const (
+
+	// ShardTransferStatusTransferTypeCOPY captures enum value "COPY"
+	ShardTransferStatusTransferTypeCOPY string = "COPY"
+
+	// ShardTransferStatusTransferTypeMOVE captures enum value "MOVE"
+	ShardTransferStatusTransferTypeMOVE string = "MOVE"
+)
+
+// prop value enum
+func (m *ShardTransferStatus) validateTransferTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, shardTransferStatusTypeTransferTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ShardTransferStatus) validateTransferType(formats strfmt.Registry) error {
+	if swag.IsZero(m.TransferType) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTransferTypeEnum("transferType", "body", m.TransferType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this shard transfer status based on context it is used
+func (m *ShardTransferStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ShardTransferStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ShardTransferStatus) UnmarshalBinary(b []byte) error {
+	var res ShardTransferStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// VectorIndexRebuildStatus The progress of rebuilding a vector index of a shard
+//
+// swagger:model VectorIndexRebuildStatus
+type VectorIndexRebuildStatus struct {
+
+	// The reason the rebuild failed.
+	Error string `json:"error,omitempty"`
+
+	// The time the rebuild completed or failed (in ms since epoch), 0 while it is running.
+	FinishedUnixMillis int64 `json:"finishedUnixMillis"`
+
+	// The number of objects of the shard added to the new index so far.
+	ObjectsProcessed int64 `json:"objectsProcessed"`
+
+	// The number of objects in the shard when the rebuild started.
+	ObjectsTotal int64 `json:"objectsTotal"`
+
+	// The time the rebuild was started (in ms since epoch).
+	StartedUnixMillis int64 `json:"startedUnixMillis"`
+
+	// The state of the rebuild.
+	// Enum: [RUNNING COMPLETED FAILED]
+	Status string `json:"status"`
+
+	// The name of the target vector, empty for the legacy vector.
+	TargetVector string `json:"targetVector"`
+}
+
+// Validate validates this vector index rebuild status
+func (m *VectorIndexRebuildStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var vectorIndexRebuildStatusTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["RUNNING","COMPLETED","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		vectorIndexRebuildStatusTypeStatusPropEnum = append(vectorIndexRebuildStatusTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// VectorIndexRebuildStatusStatusRUNNING captures enum value "RUNNING"
+	VectorIndexRebuildStatusStatusRUNNING string = "RUNNING"
+
+	// VectorIndexRebuildStatusStatusCOMPLETED captures enum value "COMPLETED"
+	VectorIndexRebuildStatusStatusCOMPLETED string = "COMPLETED"
+
+	// VectorIndexRebuildStatusStatusFAILED captures enum value "FAILED"
+	VectorIndexRebuildStatusStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *VectorIndexRebuildStatus) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, vectorIndexRebuildStatusTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *VectorIndexRebuildStatus) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this vector index rebuild status based on context it is used
+func (m *VectorIndexRebuildStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *VectorIndexRebuildStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VectorIndexRebuildStatus) UnmarshalBinary(b []byte) error {
+	var res VectorIndexRebuildStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
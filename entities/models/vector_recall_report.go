@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// VectorRecallReport The measured recall@k of the vector index of a target vector. Each sampled query is run as a regular vector search and as an exact search over all vectors
+//
+// swagger:model VectorRecallReport
+type VectorRecallReport struct {
+
+	// The name of the class
+	ClassName string `json:"className,omitempty"`
+
+	// The mean duration of the exact searches in milliseconds
+	ExactTookMs float64 `json:"exactTookMs,omitempty"`
+
+	// The mean duration of the vector index searches in milliseconds
+	IndexTookMs float64 `json:"indexTookMs,omitempty"`
+
+	// The number of nearest neighbors of each query
+	K int64 `json:"k,omitempty"`
+
+	// The lowest recall of a single query
+	// Required: true
+	MinRecall *float64 `json:"minRecall"`
+
+	// The mean fraction of the exact nearest neighbors which the vector index returned
+	// Required: true
+	Recall *float64 `json:"recall"`
+
+	// The number of queries which were run. Lower than requested if the class has fewer objects with a vector
+	Samples int64 `json:"samples,omitempty"`
+
+	// The measured target vector, empty for classes without named vectors
+	TargetVector string `json:"targetVector,omitempty"`
+
+	// The measured tenant
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// Validate validates this vector recall report
+func (m *VectorRecallReport) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMinRecall(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRecall(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *VectorRecallReport) validateMinRecall(formats strfmt.Registry) error {
+
+	if err := validate.Required("minRecall", "body", m.MinRecall); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *VectorRecallReport) validateRecall(formats strfmt.Registry) error {
+
+	if err := validate.Required("recall", "body", m.Recall); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this vector recall report based on context it is used
+func (m *VectorRecallReport) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *VectorRecallReport) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VectorRecallReport) UnmarshalBinary(b []byte) error {
+	var res VectorRecallReport
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
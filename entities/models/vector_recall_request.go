@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// VectorRecallRequest Request to measure the recall of the vector index of a target vector
+//
+// swagger:model VectorRecallRequest
+type VectorRecallRequest struct {
+
+	// The number of nearest neighbors of each query
+	// Maximum: 1000
+	K int64 `json:"k,omitempty"`
+
+	// The number of queries. Each query uses the vector of a randomly sampled object
+	// Maximum: 10000
+	Samples int64 `json:"samples,omitempty"`
+
+	// The target vector to measure. Required for classes with named vectors, must be empty otherwise
+	TargetVector string `json:"targetVector,omitempty"`
+
+	// The tenant to measure, required for multi-tenant classes
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// Validate validates this vector recall request
+func (m *VectorRecallRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateK(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSamples(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *VectorRecallRequest) validateK(formats strfmt.Registry) error {
+	if swag.IsZero(m.K) { // not required
+		return nil
+	}
+
+	if err := validate.MaximumInt("k", "body", m.K, 1000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *VectorRecallRequest) validateSamples(formats strfmt.Registry) error {
+	if swag.IsZero(m.Samples) { // not required
+		return nil
+	}
+
+	if err := validate.MaximumInt("samples", "body", m.Samples, 10000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this vector recall request based on context it is used
+func (m *VectorRecallRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *VectorRecallRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VectorRecallRequest) UnmarshalBinary(b []byte) error {
+	var res VectorRecallRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// VectorReindexNodeStatus The progress of a vector reindexing job on a node
+//
+// swagger:model VectorReindexNodeStatus
+type VectorReindexNodeStatus struct {
+
+	// The reason why the progress of the node is unknown
+	Error string `json:"error,omitempty"`
+
+	// The name of the node
+	Name string `json:"name,omitempty"`
+
+	// The progress on the shards of the node, shards which did not start the job yet are omitted
+	Shards []*VectorReindexShardStatus `json:"shards"`
+}
+
+// Validate validates this vector reindex node status
+func (m *VectorReindexNodeStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateShards(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *VectorReindexNodeStatus) validateShards(formats strfmt.Registry) error {
+	if swag.IsZero(m.Shards) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Shards); i++ {
+		if swag.IsZero(m.Shards[i]) { // not required
+			continue
+		}
+
+		if m.Shards[i] != nil {
+			if err := m.Shards[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("shards" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("shards" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this vector reindex node status based on the context it is used
+func (m *VectorReindexNodeStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateShards(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *VectorReindexNodeStatus) contextValidateShards(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Shards); i++ {
+
+		if m.Shards[i] != nil {
+			if err := m.Shards[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("shards" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("shards" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *VectorReindexNodeStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VectorReindexNodeStatus) UnmarshalBinary(b []byte) error {
+	var res VectorReindexNodeStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// VectorReindexRequest Request to recompute the vectors of a target vector of a class with a vectorizer module
+//
+// swagger:model VectorReindexRequest
+type VectorReindexRequest struct {
+
+	// The number of objects sent to the vectorizer at once
+	BatchSize *int64 `json:"batchSize,omitempty"`
+
+	// The number of objects each node vectorizes per second at most. 0 disables the limit
+	MaxObjectsPerSecond float64 `json:"maxObjectsPerSecond,omitempty"`
+
+	// The configuration of the vectorizer module, as in the module config of a class
+	ModuleConfig interface{} `json:"moduleConfig,omitempty"`
+
+	// The target vector to reindex. Required for classes with named vectors, must be empty otherwise
+	TargetVector string `json:"targetVector,omitempty"`
+
+	// The vectorizer module which computes the new vectors. The class uses it once the job completed
+	// Required: true
+	Vectorizer *string `json:"vectorizer"`
+}
+
+// Validate validates this vector reindex request
+func (m *VectorReindexRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateVectorizer(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *VectorReindexRequest) validateVectorizer(formats strfmt.Registry) error {
+
+	if err := validate.Required("vectorizer", "body", m.Vectorizer); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this vector reindex request based on context it is used
+func (m *VectorReindexRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *VectorReindexRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VectorReindexRequest) UnmarshalBinary(b []byte) error {
+	var res VectorReindexRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
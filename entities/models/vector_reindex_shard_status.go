@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// VectorReindexShardStatus The progress of a vector reindexing job on a shard
+//
+// swagger:model VectorReindexShardStatus
+type VectorReindexShardStatus struct {
+
+	// The reason of a failed shard
+	Error string `json:"error,omitempty"`
+
+	// The name of the shard
+	Name string `json:"name,omitempty"`
+
+	// The number of objects vectorized
+	ObjectsProcessed int64 `json:"objectsProcessed,omitempty"`
+
+	// The number of objects in the shard when it started reindexing
+	ObjectsTotal int64 `json:"objectsTotal,omitempty"`
+
+	// The status of the shard. READY shards vectorized all objects and wait for the job to be committed
+	// Enum: [RUNNING PAUSED READY COMPLETED CANCELLED FAILED]
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this vector reindex shard status
+func (m *VectorReindexShardStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var vectorReindexShardStatusTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["RUNNING","PAUSED","READY","COMPLETED","CANCELLED","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		vectorReindexShardStatusTypeStatusPropEnum = append(vectorReindexShardStatusTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// VectorReindexShardStatusStatusRUNNING captures enum value "RUNNING"
+	VectorReindexShardStatusStatusRUNNING string = "RUNNING"
+
+	// VectorReindexShardStatusStatusPAUSED captures enum value "PAUSED"
+	VectorReindexShardStatusStatusPAUSED string = "PAUSED"
+
+	// VectorReindexShardStatusStatusREADY captures enum value "READY"
+	VectorReindexShardStatusStatusREADY string = "READY"
+
+	// VectorReindexShardStatusStatusCOMPLETED captures enum value "COMPLETED"
+	VectorReindexShardStatusStatusCOMPLETED string = "COMPLETED"
+
+	// VectorReindexShardStatusStatusCANCELLED captures enum value "CANCELLED"
+	VectorReindexShardStatusStatusCANCELLED string = "CANCELLED"
+
+	// VectorReindexShardStatusStatusFAILED captures enum value "FAILED"
+	VectorReindexShardStatusStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *VectorReindexShardStatus) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, vectorReindexShardStatusTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *VectorReindexShardStatus) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this vector reindex shard status based on context it is used
+func (m *VectorReindexShardStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *VectorReindexShardStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VectorReindexShardStatus) UnmarshalBinary(b []byte) error {
+	var res VectorReindexShardStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// VectorReindexStatus The progress of the vector reindexing job of a target vector. The previous vectors are used for queries until the job completed
+//
+// swagger:model VectorReindexStatus
+type VectorReindexStatus struct {
+
+	// Whether the job only vectorizes the objects without a vector, for a target vector which was added to the class
+	Backfill bool `json:"backfill,omitempty"`
+
+	// The number of objects sent to the vectorizer at once
+	BatchSize int64 `json:"batchSize,omitempty"`
+
+	// The name of the class
+	ClassName string `json:"className,omitempty"`
+
+	// The tenants of a completed backfill which were not active when it completed. They are backfilled once they are activated
+	DeferredTenants []string `json:"deferredTenants"`
+
+	// The reason of a failed job
+	Error string `json:"error,omitempty"`
+
+	// Finish time of the job in milliseconds since epoch UTC
+	FinishTimeUnix int64 `json:"finishTimeUnix,omitempty"`
+
+	// The number of objects each node vectorizes per second at most
+	MaxObjectsPerSecond float64 `json:"maxObjectsPerSecond,omitempty"`
+
+	// The configuration of the vectorizer module, including its defaults
+	ModuleConfig interface{} `json:"moduleConfig,omitempty"`
+
+	// The progress on the nodes which hold shards of the class. Only present while the job is in progress
+	Nodes []*VectorReindexNodeStatus `json:"nodes"`
+
+	// The number of objects vectorized on all shards, replicas are counted separately
+	ObjectsProcessed int64 `json:"objectsProcessed,omitempty"`
+
+	// The number of objects on all shards when they started reindexing, replicas are counted separately
+	ObjectsTotal int64 `json:"objectsTotal,omitempty"`
+
+	// Start time of the job in milliseconds since epoch UTC
+	StartTimeUnix int64 `json:"startTimeUnix,omitempty"`
+
+	// The status of the job
+	// Enum: [RUNNING PAUSED COMPLETED CANCELLED FAILED]
+	Status string `json:"status,omitempty"`
+
+	// The target vector which is reindexed, empty for classes without named vectors
+	TargetVector string `json:"targetVector,omitempty"`
+
+	// The vectorizer module which computes the new vectors
+	Vectorizer string `json:"vectorizer,omitempty"`
+}
+
+// Validate validates this vector reindex status
+func (m *VectorReindexStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateNodes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *VectorReindexStatus) validateNodes(formats strfmt.Registry) error {
+	if swag.IsZero(m.Nodes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Nodes); i++ {
+		if swag.IsZero(m.Nodes[i]) { // not required
+			continue
+		}
+
+		if m.Nodes[i] != nil {
+			if err := m.Nodes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nodes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nodes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var vectorReindexStatusTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["RUNNING","PAUSED","COMPLETED","CANCELLED","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		vectorReindexStatusTypeStatusPropEnum = append(vectorReindexStatusTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// VectorReindexStatusStatusRUNNING captures enum value "RUNNING"
+	VectorReindexStatusStatusRUNNING string = "RUNNING"
+
+	// VectorReindexStatusStatusPAUSED captures enum value "PAUSED"
+	VectorReindexStatusStatusPAUSED string = "PAUSED"
+
+	// VectorReindexStatusStatusCOMPLETED captures enum value "COMPLETED"
+	VectorReindexStatusStatusCOMPLETED string = "COMPLETED"
+
+	// VectorReindexStatusStatusCANCELLED captures enum value "CANCELLED"
+	VectorReindexStatusStatusCANCELLED string = "CANCELLED"
+
+	// VectorReindexStatusStatusFAILED captures enum value "FAILED"
+	VectorReindexStatusStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *VectorReindexStatus) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, vectorReindexStatusTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *VectorReindexStatus) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this vector reindex status based on the context it is used
+func (m *VectorReindexStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateNodes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *VectorReindexStatus) contextValidateNodes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Nodes); i++ {
+
+		if m.Nodes[i] != nil {
+			if err := m.Nodes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nodes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nodes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *VectorReindexStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VectorReindexStatus) UnmarshalBinary(b []byte) error {
+	var res VectorReindexStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -45,3 +45,10 @@ type BackupBackend interface {
 	Write(ctx context.Context, backupID, key string, r io.ReadCloser) (int64, error)
 	Read(ctx context.Context, backupID, key string, w io.WriteCloser) (int64, error)
 }
+
+// BackupBackendDeleter is implemented by backends which can delete objects.
+// Deleting an object which does not exist is not an error.
+type BackupBackendDeleter interface {
+	// Delete removes the object with key `key`
+	Delete(ctx context.Context, backupID, key string) error
+}
@@ -11,7 +11,15 @@
 
 package schema
 
-import "github.com/weaviate/weaviate/entities/models"
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// DefaultAutoTenantDeactivationTimeout is used if autoTenantDeactivation is
+// enabled without a timeout
+const DefaultAutoTenantDeactivationTimeout = time.Hour
 
 func MultiTenancyEnabled(class *models.Class) bool {
 	if class == nil {
@@ -46,6 +54,27 @@ func AutoTenantActivationEnabled(class *models.Class) bool {
 	return false
 }
 
+func AutoTenantDeactivationEnabled(class *models.Class) bool {
+	if class == nil {
+		return false
+	}
+
+	if class.MultiTenancyConfig != nil {
+		return class.MultiTenancyConfig.AutoTenantDeactivation
+	}
+	return false
+}
+
+// AutoTenantDeactivationTimeout is the time after which a HOT tenant which
+// was not accessed is turned COLD
+func AutoTenantDeactivationTimeout(class *models.Class) time.Duration {
+	if class == nil || class.MultiTenancyConfig == nil ||
+		class.MultiTenancyConfig.AutoTenantDeactivationTimeout <= 0 {
+		return DefaultAutoTenantDeactivationTimeout
+	}
+	return time.Duration(class.MultiTenancyConfig.AutoTenantDeactivationTimeout) * time.Second
+}
+
 func ActivityStatus(status string) string {
 	if status == "" {
 		return models.TenantActivityStatusHOT
@@ -25,6 +25,9 @@ type NearVector struct {
 	Distance      float64   `json:"distance"`
 	WithDistance  bool      `json:"-"`
 	TargetVectors []string  `json:"targetVectors"`
+	// VectorPerTarget holds a separate query vector for each target vector.
+	// Targets without an entry are searched with Vector.
+	VectorPerTarget map[string][]float32 `json:"vectorPerTarget"`
 }
 
 type KeywordRanking struct {
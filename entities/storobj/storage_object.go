@@ -914,6 +914,10 @@ func unmarshalTargetVectors(rw *byteops.ReadWriter) (map[string][]float32, error
 	return nil, nil
 }
 
+// ErrVectorNotFound is returned by VectorFromBinary if the object has no
+// vector for the target vector
+var ErrVectorNotFound = errors.New("vector not found for target vector")
+
 func VectorFromBinary(in []byte, buffer []float32, targetVector string) ([]float32, error) {
 	if len(in) == 0 {
 		return nil, nil
@@ -949,7 +953,7 @@ func VectorFromBinary(in []byte, buffer []float32, targetVector string) ([]float
 		}
 		vector, ok := targetVectors[targetVector]
 		if !ok {
-			return nil, errors.Errorf("vector not found for target vector: %s", targetVector)
+			return nil, fmt.Errorf("%w: %s", ErrVectorNotFound, targetVector)
 		}
 		return vector, nil
 	}
@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"fmt"
+
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	DefaultMaxDegree           = 64
+	DefaultSearchListSize      = 100
+	DefaultBuildSearchListSize = 128
+	DefaultAlpha               = 1.2
+	DefaultBeamWidth           = 4
+	// DefaultPQSegments of 0 picks the number of segments based on the
+	// dimensions of the first vector
+	DefaultPQSegments      = 0
+	DefaultPQTrainingLimit = 100_000
+
+	// MinPQTrainingLimit is the number of centroids per segment, the codebook
+	// cannot be trained on fewer vectors
+	MinPQTrainingLimit = 256
+	MaxMaxDegree       = 512
+	MaxBeamWidth       = 64
+)
+
+// UserConfig configures a disk-resident graph index (Vamana, as used by
+// DiskANN). Full vectors and adjacency lists are kept on disk, only the PQ
+// codes of the vectors are held in memory.
+type UserConfig struct {
+	Distance            string  `json:"distance"`
+	MaxDegree           int     `json:"maxDegree"`
+	SearchListSize      int     `json:"searchListSize"`
+	BuildSearchListSize int     `json:"buildSearchListSize"`
+	Alpha               float64 `json:"alpha"`
+	BeamWidth           int     `json:"beamWidth"`
+	PQSegments          int     `json:"pqSegments"`
+	PQTrainingLimit     int     `json:"pqTrainingLimit"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "diskann"
+}
+
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = common.DefaultDistanceMetric
+	u.MaxDegree = DefaultMaxDegree
+	u.SearchListSize = DefaultSearchListSize
+	u.BuildSearchListSize = DefaultBuildSearchListSize
+	u.Alpha = DefaultAlpha
+	u.BeamWidth = DefaultBeamWidth
+	u.PQSegments = DefaultPQSegments
+	u.PQTrainingLimit = DefaultPQTrainingLimit
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if err := common.OptionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "maxDegree", func(v int) {
+		uc.MaxDegree = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "searchListSize", func(v int) {
+		uc.SearchListSize = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "buildSearchListSize", func(v int) {
+		uc.BuildSearchListSize = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalFloatFromMap(asMap, "alpha", func(v float64) {
+		uc.Alpha = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "beamWidth", func(v int) {
+		uc.BeamWidth = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "pqSegments", func(v int) {
+		uc.PQSegments = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "pqTrainingLimit", func(v int) {
+		uc.PQTrainingLimit = v
+	}); err != nil {
+		return uc, err
+	}
+
+	return uc, uc.validate()
+}
+
+func (u *UserConfig) validate() error {
+	if u.MaxDegree < 1 || u.MaxDegree > MaxMaxDegree {
+		return fmt.Errorf("maxDegree must be between 1 and %d", MaxMaxDegree)
+	}
+
+	if u.SearchListSize < 1 {
+		return fmt.Errorf("searchListSize must be a positive value")
+	}
+
+	if u.BuildSearchListSize < 1 {
+		return fmt.Errorf("buildSearchListSize must be a positive value")
+	}
+
+	if u.Alpha < 1 {
+		return fmt.Errorf("alpha must be at least 1")
+	}
+
+	if u.BeamWidth < 1 || u.BeamWidth > MaxBeamWidth {
+		return fmt.Errorf("beamWidth must be between 1 and %d", MaxBeamWidth)
+	}
+
+	if u.PQSegments < 0 {
+		return fmt.Errorf("pqSegments must not be negative")
+	}
+
+	if u.PQTrainingLimit < MinPQTrainingLimit {
+		return fmt.Errorf("pqTrainingLimit must be at least %d", MinPQTrainingLimit)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func Test_DiskANNUserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:     "nothing specified, all defaults",
+			input:    nil,
+			expected: NewDefaultUserConfig(),
+		},
+		{
+			name: "all fields specified",
+			input: map[string]interface{}{
+				"distance":            "l2-squared",
+				"maxDegree":           float64(32),
+				"searchListSize":      float64(200),
+				"buildSearchListSize": float64(64),
+				"alpha":               float64(1.5),
+				"beamWidth":           float64(8),
+				"pqSegments":          float64(16),
+				"pqTrainingLimit":     float64(5000),
+			},
+			expected: UserConfig{
+				Distance:            common.DistanceL2Squared,
+				MaxDegree:           32,
+				SearchListSize:      200,
+				BuildSearchListSize: 64,
+				Alpha:               1.5,
+				BeamWidth:           8,
+				PQSegments:          16,
+				PQTrainingLimit:     5000,
+			},
+		},
+		{
+			name: "json numbers",
+			input: map[string]interface{}{
+				"maxDegree": json.Number("48"),
+				"alpha":     json.Number("1.1"),
+			},
+			expected: UserConfig{
+				Distance:            common.DefaultDistanceMetric,
+				MaxDegree:           48,
+				SearchListSize:      DefaultSearchListSize,
+				BuildSearchListSize: DefaultBuildSearchListSize,
+				Alpha:               1.1,
+				BeamWidth:           DefaultBeamWidth,
+				PQSegments:          DefaultPQSegments,
+				PQTrainingLimit:     DefaultPQTrainingLimit,
+			},
+		},
+		{
+			name:         "max degree too high",
+			input:        map[string]interface{}{"maxDegree": float64(1000)},
+			expectErr:    true,
+			expectErrMsg: "maxDegree must be between 1 and 512",
+		},
+		{
+			name:         "alpha too low",
+			input:        map[string]interface{}{"alpha": float64(0.9)},
+			expectErr:    true,
+			expectErrMsg: "alpha must be at least 1",
+		},
+		{
+			name:         "beam width too low",
+			input:        map[string]interface{}{"beamWidth": float64(0)},
+			expectErr:    true,
+			expectErrMsg: "beamWidth must be between 1 and 64",
+		},
+		{
+			name:         "training limit too low",
+			input:        map[string]interface{}{"pqTrainingLimit": float64(100)},
+			expectErr:    true,
+			expectErrMsg: "pqTrainingLimit must be at least 256",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
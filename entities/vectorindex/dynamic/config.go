@@ -25,10 +25,13 @@ const (
 )
 
 type UserConfig struct {
-	Distance  string          `json:"distance"`
-	Threshold uint64          `json:"threshold"`
-	HnswUC    hnsw.UserConfig `json:"hnsw"`
-	FlatUC    flat.UserConfig `json:"flat"`
+	Distance  string `json:"distance"`
+	Threshold uint64 `json:"threshold"`
+	// DowngradeThreshold is the number of vectors below which an index which
+	// was upgraded to hnsw is switched back to flat. 0 disables downgrades.
+	DowngradeThreshold uint64          `json:"downgradeThreshold"`
+	HnswUC             hnsw.UserConfig `json:"hnsw"`
+	FlatUC             flat.UserConfig `json:"flat"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -82,6 +85,16 @@ func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig,
 		return uc, err
 	}
 
+	if err := common.OptionalIntFromMap(asMap, "downgradeThreshold", func(v int) {
+		uc.DowngradeThreshold = uint64(v)
+	}); err != nil {
+		return uc, err
+	}
+	if uc.DowngradeThreshold > 0 && uc.DowngradeThreshold >= uc.Threshold {
+		return uc, fmt.Errorf("downgradeThreshold must be lower than threshold, got %d and %d",
+			uc.DowngradeThreshold, uc.Threshold)
+	}
+
 	hnswConfig, ok := asMap["hnsw"]
 	if ok && hnswConfig != nil {
 		hnswUC, err := hnsw.ParseAndValidateConfig(hnswConfig)
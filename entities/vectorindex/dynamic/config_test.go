@@ -45,6 +45,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 					EF:                     hnsw.DefaultEF,
 					Skip:                   hnsw.DefaultSkip,
 					FlatSearchCutoff:       hnsw.DefaultFlatSearchCutoff,
+					FilterStrategy:         hnsw.DefaultFilterStrategy,
 					DynamicEFMin:           hnsw.DefaultDynamicEFMin,
 					DynamicEFMax:           hnsw.DefaultDynamicEFMax,
 					DynamicEFFactor:        hnsw.DefaultDynamicEFFactor,
@@ -79,11 +80,13 @@ func Test_DynamicUserConfig(t *testing.T) {
 		{
 			name: "threshold is properly set",
 			input: map[string]interface{}{
-				"threshold": float64(100),
+				"threshold":          float64(100),
+				"downgradeThreshold": float64(50),
 			},
 			expected: UserConfig{
-				Distance:  common.DefaultDistanceMetric,
-				Threshold: 100,
+				Distance:           common.DefaultDistanceMetric,
+				Threshold:          100,
+				DowngradeThreshold: 50,
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: hnsw.DefaultCleanupIntervalSeconds,
 					MaxConnections:         hnsw.DefaultMaxConnections,
@@ -92,6 +95,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 					EF:                     hnsw.DefaultEF,
 					Skip:                   hnsw.DefaultSkip,
 					FlatSearchCutoff:       hnsw.DefaultFlatSearchCutoff,
+					FilterStrategy:         hnsw.DefaultFilterStrategy,
 					DynamicEFMin:           hnsw.DefaultDynamicEFMin,
 					DynamicEFMax:           hnsw.DefaultDynamicEFMax,
 					DynamicEFFactor:        hnsw.DefaultDynamicEFFactor,
@@ -158,6 +162,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 					VectorCacheMaxObjects:  14,
 					EF:                     15,
 					FlatSearchCutoff:       16,
+					FilterStrategy:         hnsw.DefaultFilterStrategy,
 					DynamicEFMin:           17,
 					DynamicEFMax:           18,
 					DynamicEFFactor:        19,
@@ -213,6 +218,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 					EF:                     hnsw.DefaultEF,
 					Skip:                   hnsw.DefaultSkip,
 					FlatSearchCutoff:       hnsw.DefaultFlatSearchCutoff,
+					FilterStrategy:         hnsw.DefaultFilterStrategy,
 					DynamicEFMin:           hnsw.DefaultDynamicEFMin,
 					DynamicEFMax:           hnsw.DefaultDynamicEFMax,
 					DynamicEFFactor:        hnsw.DefaultDynamicEFFactor,
@@ -260,6 +266,15 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expectErr:    true,
 			expectErrMsg: "PQ is not currently supported for flat indices",
 		},
+		{
+			name: "downgrade threshold not below threshold returns error",
+			input: map[string]interface{}{
+				"threshold":          float64(100),
+				"downgradeThreshold": float64(100),
+			},
+			expectErr:    true,
+			expectErrMsg: "downgradeThreshold must be lower than threshold",
+		},
 	}
 
 	for _, test := range tests {
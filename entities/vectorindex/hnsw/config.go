@@ -30,6 +30,15 @@ const (
 	DefaultDynamicEFFactor        = 8
 	DefaultSkip                   = false
 	DefaultFlatSearchCutoff       = 40000
+	DefaultFilterStrategy         = FilterStrategySweeping
+
+	// FilterStrategySweeping walks the graph as for an unfiltered search and
+	// skips nodes which are not allowed by the filter
+	FilterStrategySweeping = "sweeping"
+	// FilterStrategyAcorn only evaluates nodes allowed by the filter and
+	// reaches them through the neighbors of filtered-out nodes (two-hop
+	// exploration). This is faster for restrictive filters.
+	FilterStrategyAcorn = "acorn"
 
 	// Fail validation if those criteria are not met
 	MinmumMaxConnections = 4
@@ -48,6 +57,7 @@ type UserConfig struct {
 	DynamicEFFactor        int      `json:"dynamicEfFactor"`
 	VectorCacheMaxObjects  int      `json:"vectorCacheMaxObjects"`
 	FlatSearchCutoff       int      `json:"flatSearchCutoff"`
+	FilterStrategy         string   `json:"filterStrategy"`
 	Distance               string   `json:"distance"`
 	PQ                     PQConfig `json:"pq"`
 	BQ                     BQConfig `json:"bq"`
@@ -75,6 +85,7 @@ func (u *UserConfig) SetDefaults() {
 	u.DynamicEFMin = DefaultDynamicEFMin
 	u.Skip = DefaultSkip
 	u.FlatSearchCutoff = DefaultFlatSearchCutoff
+	u.FilterStrategy = DefaultFilterStrategy
 	u.Distance = vectorIndexCommon.DefaultDistanceMetric
 	u.PQ = PQConfig{
 		Enabled:        DefaultPQEnabled,
@@ -161,6 +172,12 @@ func ParseAndValidateConfig(input interface{}) (config.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := vectorIndexCommon.OptionalStringFromMap(asMap, "filterStrategy", func(v string) {
+		uc.FilterStrategy = v
+	}); err != nil {
+		return uc, err
+	}
+
 	if err := vectorIndexCommon.OptionalBoolFromMap(asMap, "skip", func(v bool) {
 		uc.Skip = v
 	}); err != nil {
@@ -200,6 +217,13 @@ func (u *UserConfig) validate() error {
 		))
 	}
 
+	if u.FilterStrategy != FilterStrategySweeping && u.FilterStrategy != FilterStrategyAcorn {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"filterStrategy must be either %q or %q",
+			FilterStrategySweeping, FilterStrategyAcorn,
+		))
+	}
+
 	if len(errMsgs) > 0 {
 		return fmt.Errorf("invalid hnsw config: %s",
 			strings.Join(errMsgs, ", "))
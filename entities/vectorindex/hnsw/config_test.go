@@ -42,6 +42,7 @@ func Test_UserConfig(t *testing.T) {
 				EF:                     DefaultEF,
 				Skip:                   DefaultSkip,
 				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           DefaultDynamicEFMin,
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
@@ -72,6 +73,7 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
 				EF:                     DefaultEF,
 				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           DefaultDynamicEFMin,
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
@@ -112,6 +114,7 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -153,6 +156,7 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -194,6 +198,7 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -234,6 +239,7 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -283,6 +289,7 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -330,6 +337,7 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -396,6 +404,7 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  math.MaxInt64,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -413,6 +422,44 @@ func Test_UserConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with acorn filter strategy",
+			input: map[string]interface{}{
+				"filterStrategy": "acorn",
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         FilterStrategyAcorn,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+			},
+		},
+		{
+			name: "invalid filter strategy",
+			input: map[string]interface{}{
+				"filterStrategy": "sideways",
+			},
+			expectErr:    true,
+			expectErrMsg: `filterStrategy must be either "sweeping" or "acorn"`,
+		},
 		{
 			name: "invalid max connections (json)",
 			input: map[string]interface{}{
@@ -472,6 +519,7 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package vectorreindex contains the jobs which recompute the vectors of a
+// target vector of a class, e.g. with a different vectorizer module.
+package vectorreindex
+
+import (
+	"context"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	// StatusRunning is used for jobs and shards which are computing vectors
+	StatusRunning = "RUNNING"
+	// StatusPaused is used for jobs and shards which were paused by the user
+	StatusPaused = "PAUSED"
+	// StatusReady is only used for shards, which computed the vectors of all
+	// objects and keep up with writes until the job is committed
+	StatusReady = "READY"
+	// StatusCompleted is used for jobs which were committed and for shards
+	// which replaced their vector index
+	StatusCompleted = "COMPLETED"
+	// StatusCancelled is only used for jobs which were cancelled by the user
+	StatusCancelled = "CANCELLED"
+	// StatusFailed is used for jobs and shards which failed
+	StatusFailed = "FAILED"
+
+	// DefaultBatchSize is the number of objects sent to the vectorizer at
+	// once, if the job doesn't specify it
+	DefaultBatchSize = 100
+)
+
+// Job recomputes the vectors of a single target vector of a class. It is
+// stored in the cluster schema, the shards are reindexed by the nodes which
+// own them.
+type Job struct {
+	TargetVector string                 `json:"targetVector"`
+	Vectorizer   string                 `json:"vectorizer"`
+	ModuleConfig map[string]interface{} `json:"moduleConfig,omitempty"`
+	// BatchSize is the number of objects sent to the vectorizer at once
+	BatchSize int `json:"batchSize"`
+	// MaxObjectsPerSecond limits the objects vectorized per second on every
+	// node, 0 disables the limit
+	MaxObjectsPerSecond float64 `json:"maxObjectsPerSecond,omitempty"`
+	// Backfill jobs only vectorize objects which don't have a vector for
+	// the target vector yet, and write the vectors to the objects and the
+	// existing vector index. They are started for target vectors added to
+	// existing classes.
+	Backfill bool `json:"backfill,omitempty"`
+	// Deferred are the tenants of a backfill which were not active when the
+	// job was committed. They are backfilled once they are activated, and
+	// removed when all of their replicas are done.
+	Deferred []string `json:"deferred,omitempty"`
+
+	Status     string    `json:"status"`
+	Error      string    `json:"error,omitempty"`
+	StartTime  time.Time `json:"startTime"`
+	FinishTime time.Time `json:"finishTime,omitempty"`
+	// Version is the schema version the job was started with. It tells jobs
+	// for the same target vector apart.
+	Version uint64 `json:"version"`
+}
+
+// Finished returns whether the job was committed, cancelled or failed
+func (j *Job) Finished() bool {
+	switch j.Status {
+	case StatusCompleted, StatusCancelled, StatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// ApplyTo returns a copy of class which uses the vectorizer of the job for
+// its target vector. The class itself is not modified.
+func (j *Job) ApplyTo(class *models.Class) *models.Class {
+	moduleConfig := make(map[string]interface{}, len(j.ModuleConfig))
+	for k, v := range j.ModuleConfig {
+		moduleConfig[k] = v
+	}
+
+	copied := *class
+	if j.TargetVector != "" {
+		copied.VectorConfig = make(map[string]models.VectorConfig, len(class.VectorConfig))
+		for name, cfg := range class.VectorConfig {
+			if name == j.TargetVector {
+				cfg.Vectorizer = map[string]interface{}{j.Vectorizer: moduleConfig}
+			}
+			copied.VectorConfig[name] = cfg
+		}
+		return &copied
+	}
+
+	classModuleConfig := map[string]interface{}{}
+	if existing, ok := class.ModuleConfig.(map[string]interface{}); ok {
+		for k, v := range existing {
+			classModuleConfig[k] = v
+		}
+	}
+	// the vectorizer of a legacy class is found by its module config, so the
+	// config of the previous one has to be removed
+	delete(classModuleConfig, class.Vectorizer)
+	classModuleConfig[j.Vectorizer] = moduleConfig
+	copied.Vectorizer = j.Vectorizer
+	copied.ModuleConfig = classModuleConfig
+	return &copied
+}
+
+// ShardStatus is the progress of a job on a single shard
+type ShardStatus struct {
+	Shard            string `json:"shard"`
+	Status           string `json:"status"`
+	ObjectsProcessed int64  `json:"objectsProcessed"`
+	ObjectsTotal     int64  `json:"objectsTotal"`
+	Error            string `json:"error,omitempty"`
+}
+
+// NodeStatus is the progress of a job on the shards of a node
+type NodeStatus struct {
+	Node string `json:"node"`
+	// Version is the version of the job the shards were reindexed for
+	Version uint64        `json:"version"`
+	Shards  []ShardStatus `json:"shards"`
+	// Error is set if the status of the node could not be retrieved
+	Error string `json:"error,omitempty"`
+}
+
+// Vectorizer computes the vectors of the objects for the target vector of
+// the class. The vectors are set on the objects, errors are returned by the
+// position of the object.
+type Vectorizer interface {
+	ReindexVectors(ctx context.Context, class *models.Class, targetVector string,
+		objects []*models.Object) (map[int]error, error)
+}
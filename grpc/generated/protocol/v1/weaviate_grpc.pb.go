@@ -18,6 +18,7 @@ const (
 	Weaviate_Search_FullMethodName       = "/weaviate.v1.Weaviate/Search"
 	Weaviate_BatchObjects_FullMethodName = "/weaviate.v1.Weaviate/BatchObjects"
 	Weaviate_BatchDelete_FullMethodName  = "/weaviate.v1.Weaviate/BatchDelete"
+	Weaviate_BatchUpdate_FullMethodName  = "/weaviate.v1.Weaviate/BatchUpdate"
 	Weaviate_TenantsGet_FullMethodName   = "/weaviate.v1.Weaviate/TenantsGet"
 )
 
@@ -28,6 +29,7 @@ type WeaviateClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
 	BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error)
 	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error)
+	BatchUpdate(ctx context.Context, in *BatchUpdateRequest, opts ...grpc.CallOption) (*BatchUpdateReply, error)
 	TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsGetReply, error)
 }
 
@@ -66,6 +68,15 @@ func (c *weaviateClient) BatchDelete(ctx context.Context, in *BatchDeleteRequest
 	return out, nil
 }
 
+func (c *weaviateClient) BatchUpdate(ctx context.Context, in *BatchUpdateRequest, opts ...grpc.CallOption) (*BatchUpdateReply, error) {
+	out := new(BatchUpdateReply)
+	err := c.cc.Invoke(ctx, Weaviate_BatchUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *weaviateClient) TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsGetReply, error) {
 	out := new(TenantsGetReply)
 	err := c.cc.Invoke(ctx, Weaviate_TenantsGet_FullMethodName, in, out, opts...)
@@ -82,6 +93,7 @@ type WeaviateServer interface {
 	Search(context.Context, *SearchRequest) (*SearchReply, error)
 	BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error)
 	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error)
+	BatchUpdate(context.Context, *BatchUpdateRequest) (*BatchUpdateReply, error)
 	TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error)
 	mustEmbedUnimplementedWeaviateServer()
 }
@@ -99,6 +111,9 @@ func (UnimplementedWeaviateServer) BatchObjects(context.Context, *BatchObjectsRe
 func (UnimplementedWeaviateServer) BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
 }
+func (UnimplementedWeaviateServer) BatchUpdate(context.Context, *BatchUpdateRequest) (*BatchUpdateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdate not implemented")
+}
 func (UnimplementedWeaviateServer) TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TenantsGet not implemented")
 }
@@ -169,6 +184,24 @@ func _Weaviate_BatchDelete_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_BatchUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).BatchUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Weaviate_BatchUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).BatchUpdate(ctx, req.(*BatchUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Weaviate_TenantsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TenantsGetRequest)
 	if err := dec(in); err != nil {
@@ -206,6 +239,10 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchDelete",
 			Handler:    _Weaviate_BatchDelete_Handler,
 		},
+		{
+			MethodName: "BatchUpdate",
+			Handler:    _Weaviate_BatchUpdate_Handler,
+		},
 		{
 			MethodName: "TenantsGet",
 			Handler:    _Weaviate_TenantsGet_Handler,
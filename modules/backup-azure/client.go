@@ -191,6 +191,16 @@ func (a *azureClient) Initialize(ctx context.Context, backupID string) error {
 	return nil
 }
 
+// Delete removes an object, a missing object is not an error
+func (a *azureClient) Delete(ctx context.Context, backupID, key string) error {
+	objectName := a.makeObjectName(backupID, key)
+	if _, err := a.client.DeleteBlob(ctx, a.config.Container, objectName, nil); err != nil &&
+		!bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete object %s", objectName))
+	}
+	return nil
+}
+
 func (a *azureClient) WriteToFile(ctx context.Context, backupID, key, destPath string) error {
 	dir := path.Dir(destPath)
 	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
@@ -152,6 +152,18 @@ func (m *Module) WriteToFile(ctx context.Context, backupID, key, destPath string
 	return nil
 }
 
+// Delete removes an object, a missing object is not an error
+func (m *Module) Delete(ctx context.Context, backupID, key string) error {
+	backupPath := path.Join(m.makeBackupDirPath(backupID), key)
+	if err := ctx.Err(); err != nil {
+		return backup.NewErrContextExpired(errors.Wrapf(err, "delete object '%s'", backupPath))
+	}
+	if err := os.Remove(backupPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete object '%s'", backupPath))
+	}
+	return nil
+}
+
 func (m *Module) Write(ctx context.Context, backupID, key string, r io.ReadCloser) (int64, error) {
 	defer r.Close()
 	backupPath := path.Join(m.makeBackupDirPath(backupID), key)
@@ -236,6 +236,20 @@ func (g *gcsClient) Initialize(ctx context.Context, backupID string) error {
 	return nil
 }
 
+// Delete removes an object, a missing object is not an error
+func (g *gcsClient) Delete(ctx context.Context, backupID, key string) error {
+	bucket, err := g.findBucket(ctx)
+	if err != nil {
+		return errors.Wrap(err, "find bucket")
+	}
+
+	objectName := g.makeObjectName(backupID, key)
+	if err := bucket.Object(objectName).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete object %s", objectName))
+	}
+	return nil
+}
+
 // WriteToFile downloads an object and store its content in destPath
 // The file destPath will be created if it doesn't exit
 func (g *gcsClient) WriteToFile(ctx context.Context, backupID, key, destPath string) (err error) {
@@ -162,6 +162,15 @@ func (s *s3Client) Initialize(ctx context.Context, backupID string) error {
 	return nil
 }
 
+// Delete removes an object, a missing object is not an error
+func (s *s3Client) Delete(ctx context.Context, backupID, key string) error {
+	objectName := s.makeObjectName(backupID, key)
+	if err := s.client.RemoveObject(ctx, s.config.Bucket, objectName, minio.RemoveObjectOptions{}); err != nil {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete object %s", objectName))
+	}
+	return nil
+}
+
 // WriteFile downloads contents of an object to a local file destPath
 func (s *s3Client) WriteToFile(ctx context.Context, backupID, key, destPath string) error {
 	object := s.makeObjectName(backupID, key)
@@ -108,6 +108,9 @@ type Config struct {
 	GRPC                                GRPC                     `json:"grpc" yaml:"grpc"`
 	Profiling                           Profiling                `json:"profiling" yaml:"profiling"`
 	ResourceUsage                       ResourceUsage            `json:"resource_usage" yaml:"resource_usage"`
+	Rebalancer                          Rebalancer               `json:"rebalancer" yaml:"rebalancer"`
+	VectorCacheBudget                   VectorCacheBudget        `json:"vector_cache_budget" yaml:"vector_cache_budget"`
+	AutoTenantDeactivation              AutoTenantDeactivation   `json:"auto_tenant_deactivation" yaml:"auto_tenant_deactivation"`
 	MaxImportGoroutinesFactor           float64                  `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
 	MaximumConcurrentGetRequests        int                      `json:"maximum_concurrent_get_requests" yaml:"maximum_concurrent_get_requests"`
 	TrackVectorDimensions               bool                     `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
@@ -219,6 +222,15 @@ type Persistence struct {
 	MemtablesMaxActiveDurationSeconds int    `json:"memtablesMaxActiveDurationSeconds" yaml:"memtablesMaxActiveDurationSeconds"`
 	LSMMaxSegmentSize                 int64  `json:"lsmMaxSegmentSize" yaml:"lsmMaxSegmentSize"`
 	HNSWMaxLogSize                    int64  `json:"hnswMaxLogSize" yaml:"hnswMaxLogSize"`
+	HNSWSnapshotIntervalSeconds       int    `json:"hnswSnapshotIntervalSeconds" yaml:"hnswSnapshotIntervalSeconds"`
+	LSMScrubIntervalSeconds           int    `json:"lsmScrubIntervalSeconds" yaml:"lsmScrubIntervalSeconds"`
+	LSMScrubRepair                    bool   `json:"lsmScrubRepair" yaml:"lsmScrubRepair"`
+	LSMCompactionPolicy               string `json:"lsmCompactionPolicy" yaml:"lsmCompactionPolicy"`
+	LSMCompactionFanOut               int    `json:"lsmCompactionFanOut" yaml:"lsmCompactionFanOut"`
+	LSMTierBackend                    string `json:"lsmTierBackend" yaml:"lsmTierBackend"`
+	LSMTierMinLevel                   int    `json:"lsmTierMinLevel" yaml:"lsmTierMinLevel"`
+	LSMTierMinAgeSeconds              int    `json:"lsmTierMinAgeSeconds" yaml:"lsmTierMinAgeSeconds"`
+	LSMTierCacheSizeMB                int    `json:"lsmTierCacheSizeMB" yaml:"lsmTierCacheSizeMB"`
 }
 
 // DefaultPersistenceDataPath is the default location for data directory when no location is provided
@@ -231,6 +243,17 @@ const DefaultPersistenceLSMMaxSegmentSize = math.MaxInt64
 
 const DefaultPersistenceHNSWMaxLogSize = 500 * 1024 * 1024 // 500MB for backward compatibility
 
+// DefaultPersistenceLSMCompactionPolicy compacts pairs of segments like all
+// versions before the tiered and leveled policies were introduced
+const (
+	DefaultPersistenceLSMCompactionPolicy = "pairs"
+	DefaultPersistenceLSMCompactionFanOut = 4
+)
+
+// DefaultPersistenceLSMTierCacheSizeMB is the local disk space for segments
+// fetched from the remote tier
+const DefaultPersistenceLSMTierCacheSizeMB = 1024
+
 func (p Persistence) Validate() error {
 	if p.DataPath == "" {
 		return fmt.Errorf("persistence.dataPath must be set")
@@ -302,6 +325,44 @@ func (r ResourceUsage) Validate() error {
 	return nil
 }
 
+// Rebalancer configures the automatic rebalancing of shards between the nodes
+// of the cluster
+type Rebalancer struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// Interval between two rebalancing rounds
+	Interval time.Duration `json:"interval" yaml:"interval"`
+	// MaxConcurrentMoves is the number of shards moved at the same time
+	MaxConcurrentMoves int `json:"max_concurrent_moves" yaml:"max_concurrent_moves"`
+	// DiskUseThresholdPercentage is the difference of disk usage between the
+	// fullest and the emptiest node which is tolerated without moving shards
+	DiskUseThresholdPercentage uint64 `json:"disk_use_threshold_percentage" yaml:"disk_use_threshold_percentage"`
+	// MaxTransferMBPerSecond limits the bandwidth used by this node to push
+	// shard files to other nodes, 0 means unlimited
+	MaxTransferMBPerSecond uint64 `json:"max_transfer_mb_per_second" yaml:"max_transfer_mb_per_second"`
+}
+
+// VectorCacheBudget limits the memory used by the vector caches of all shards
+// of the node together
+type VectorCacheBudget struct {
+	// SizeMB is the memory shared by the caches, 0 disables the budget and
+	// every cache is only limited by its vectorCacheMaxObjects
+	SizeMB uint64 `json:"size_mb" yaml:"size_mb"`
+	// IdleTimeout is the time after which the cache of a shard which wasn't
+	// queried gives its memory back
+	IdleTimeout time.Duration `json:"idle_timeout" yaml:"idle_timeout"`
+}
+
+// AutoTenantDeactivation configures how the tenants of classes with
+// autoTenantDeactivation enabled are turned COLD by the node owning them
+type AutoTenantDeactivation struct {
+	// Interval between two checks for idle tenants
+	Interval time.Duration `json:"interval" yaml:"interval"`
+	// MaxHotTenantsPerNode is the number of HOT tenants of these classes a
+	// node holds, the least recently used are turned COLD before their
+	// timeout passes if there are more. 0 means unlimited.
+	MaxHotTenantsPerNode int `json:"max_hot_tenants_per_node" yaml:"max_hot_tenants_per_node"`
+}
+
 type Raft struct {
 	Port                   int
 	InternalRPCPort        int
@@ -203,6 +203,61 @@ func FromEnv(config *Config) error {
 		config.Persistence.HNSWMaxLogSize = DefaultPersistenceHNSWMaxLogSize
 	}
 
+	// snapshots of hnsw indexes are disabled unless an interval is set
+	if v := os.Getenv("PERSISTENCE_HNSW_SNAPSHOT_INTERVAL_SECONDS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse PERSISTENCE_HNSW_SNAPSHOT_INTERVAL_SECONDS as int: %w", err)
+		} else if asInt < 0 {
+			return fmt.Errorf("PERSISTENCE_HNSW_SNAPSHOT_INTERVAL_SECONDS must not be negative")
+		}
+
+		config.Persistence.HNSWSnapshotIntervalSeconds = asInt
+	}
+
+	// the background verification of segment checksums is disabled unless an
+	// interval is set, checksums are always verified on read
+	if v := os.Getenv("PERSISTENCE_LSM_SCRUB_INTERVAL_SECONDS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse PERSISTENCE_LSM_SCRUB_INTERVAL_SECONDS as int: %w", err)
+		} else if asInt < 0 {
+			return fmt.Errorf("PERSISTENCE_LSM_SCRUB_INTERVAL_SECONDS must not be negative")
+		}
+
+		config.Persistence.LSMScrubIntervalSeconds = asInt
+	}
+
+	if configbase.Enabled(os.Getenv("PERSISTENCE_LSM_SCRUB_REPAIR")) {
+		config.Persistence.LSMScrubRepair = true
+	}
+
+	config.Persistence.LSMCompactionPolicy = DefaultPersistenceLSMCompactionPolicy
+	if v := os.Getenv("PERSISTENCE_LSM_COMPACTION_POLICY"); v != "" {
+		switch v {
+		case "pairs", "tiered", "leveled":
+			config.Persistence.LSMCompactionPolicy = v
+		default:
+			return fmt.Errorf("PERSISTENCE_LSM_COMPACTION_POLICY must be one of pairs, tiered or leveled, got %q", v)
+		}
+	}
+
+	config.Persistence.LSMCompactionFanOut = DefaultPersistenceLSMCompactionFanOut
+	if v := os.Getenv("PERSISTENCE_LSM_COMPACTION_FAN_OUT"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse PERSISTENCE_LSM_COMPACTION_FAN_OUT as int: %w", err)
+		} else if asInt < 2 {
+			return fmt.Errorf("PERSISTENCE_LSM_COMPACTION_FAN_OUT must be at least 2")
+		}
+
+		config.Persistence.LSMCompactionFanOut = asInt
+	}
+
+	if err := config.parseLSMTierConfig(); err != nil {
+		return err
+	}
+
 	clusterCfg, err := parseClusterConfig()
 	if err != nil {
 		return err
@@ -395,6 +450,18 @@ func FromEnv(config *Config) error {
 		return err
 	}
 
+	if config.Rebalancer, err = parseRebalancerConfig(); err != nil {
+		return fmt.Errorf("parse rebalancer config: %w", err)
+	}
+
+	if config.VectorCacheBudget, err = parseVectorCacheBudgetConfig(); err != nil {
+		return fmt.Errorf("parse vector cache budget config: %w", err)
+	}
+
+	if config.AutoTenantDeactivation, err = parseAutoTenantDeactivationConfig(); err != nil {
+		return fmt.Errorf("parse auto tenant deactivation config: %w", err)
+	}
+
 	config.DisableTelemetry = false
 	if configbase.Enabled(os.Getenv("DISABLE_TELEMETRY")) {
 		config.DisableTelemetry = true
@@ -499,6 +566,92 @@ func parseRAFTConfig(hostname string) (Raft, error) {
 	return cfg, nil
 }
 
+func parseRebalancerConfig() (Rebalancer, error) {
+	cfg := Rebalancer{
+		Enabled: configbase.Enabled(os.Getenv("REBALANCER_ENABLED")),
+	}
+
+	if err := parsePositiveInt(
+		"REBALANCER_INTERVAL_SECONDS",
+		func(val int) { cfg.Interval = time.Second * time.Duration(val) },
+		DefaultRebalancerInterval,
+	); err != nil {
+		return cfg, err
+	}
+
+	if err := parsePositiveInt(
+		"REBALANCER_MAX_CONCURRENT_MOVES",
+		func(val int) { cfg.MaxConcurrentMoves = val },
+		DefaultRebalancerMaxConcurrentMoves,
+	); err != nil {
+		return cfg, err
+	}
+
+	if v := os.Getenv("REBALANCER_DISK_USE_THRESHOLD_PERCENTAGE"); v != "" {
+		asUint, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("parse REBALANCER_DISK_USE_THRESHOLD_PERCENTAGE as uint: %w", err)
+		}
+		cfg.DiskUseThresholdPercentage = asUint
+	} else {
+		cfg.DiskUseThresholdPercentage = DefaultRebalancerDiskUseThresholdPercentage
+	}
+
+	if v := os.Getenv("REBALANCER_MAX_TRANSFER_MB_PER_SECOND"); v != "" {
+		asUint, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("parse REBALANCER_MAX_TRANSFER_MB_PER_SECOND as uint: %w", err)
+		}
+		cfg.MaxTransferMBPerSecond = asUint
+	}
+
+	return cfg, nil
+}
+
+func parseVectorCacheBudgetConfig() (VectorCacheBudget, error) {
+	cfg := VectorCacheBudget{}
+
+	if v := os.Getenv("VECTOR_CACHE_BUDGET_MB"); v != "" {
+		asUint, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return cfg, fmt.Errorf("parse VECTOR_CACHE_BUDGET_MB as uint: %w", err)
+		}
+		cfg.SizeMB = asUint
+	}
+
+	if err := parsePositiveInt(
+		"VECTOR_CACHE_BUDGET_IDLE_TIMEOUT_SECONDS",
+		func(val int) { cfg.IdleTimeout = time.Second * time.Duration(val) },
+		DefaultVectorCacheBudgetIdleTimeout,
+	); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
+
+func parseAutoTenantDeactivationConfig() (AutoTenantDeactivation, error) {
+	cfg := AutoTenantDeactivation{}
+
+	if err := parsePositiveInt(
+		"AUTO_TENANT_DEACTIVATION_INTERVAL_SECONDS",
+		func(val int) { cfg.Interval = time.Second * time.Duration(val) },
+		DefaultAutoTenantDeactivationInterval,
+	); err != nil {
+		return cfg, err
+	}
+
+	if v := os.Getenv("AUTO_TENANT_DEACTIVATION_MAX_HOT_TENANTS_PER_NODE"); v != "" {
+		asUint, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return cfg, fmt.Errorf("parse AUTO_TENANT_DEACTIVATION_MAX_HOT_TENANTS_PER_NODE as uint: %w", err)
+		}
+		cfg.MaxHotTenantsPerNode = int(asUint)
+	}
+
+	return cfg, nil
+}
+
 func (c *Config) parseCORSConfig() error {
 	if v := os.Getenv("CORS_ALLOW_ORIGIN"); v != "" {
 		c.CORS.AllowOrigin = v
@@ -574,6 +727,45 @@ func (c *Config) parseMemtableConfig() error {
 	return nil
 }
 
+// parseLSMTierConfig parses the remote tier of LSM segments, which is
+// disabled unless a backend is set
+func (c *Config) parseLSMTierConfig() error {
+	c.Persistence.LSMTierBackend = os.Getenv("PERSISTENCE_LSM_TIER_BACKEND")
+	if c.Persistence.LSMTierBackend == "" {
+		return nil
+	}
+
+	if err := parsePositiveInt(
+		"PERSISTENCE_LSM_TIER_MIN_LEVEL",
+		func(val int) { c.Persistence.LSMTierMinLevel = val },
+		0,
+	); err != nil {
+		return err
+	}
+	if c.Persistence.LSMTierMinLevel > math.MaxUint16 {
+		return fmt.Errorf("PERSISTENCE_LSM_TIER_MIN_LEVEL must be at most %d", math.MaxUint16)
+	}
+
+	if err := parsePositiveInt(
+		"PERSISTENCE_LSM_TIER_MIN_AGE_SECONDS",
+		func(val int) { c.Persistence.LSMTierMinAgeSeconds = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	if c.Persistence.LSMTierMinLevel == 0 && c.Persistence.LSMTierMinAgeSeconds == 0 {
+		return fmt.Errorf("PERSISTENCE_LSM_TIER_BACKEND requires PERSISTENCE_LSM_TIER_MIN_LEVEL " +
+			"or PERSISTENCE_LSM_TIER_MIN_AGE_SECONDS to be set")
+	}
+
+	return parsePositiveInt(
+		"PERSISTENCE_LSM_TIER_CACHE_SIZE_MB",
+		func(val int) { c.Persistence.LSMTierCacheSizeMB = val },
+		DefaultPersistenceLSMTierCacheSizeMB,
+	)
+}
+
 func parsePositiveInt(varName string, cb func(val int), defaultValue int) error {
 	if v := os.Getenv(varName); v != "" {
 		asInt, err := strconv.Atoi(v)
@@ -604,6 +796,14 @@ const (
 	DefaultMaxConcurrentGetRequests            = 0
 	DefaultGRPCPort                            = 50051
 	DefaultMinimumReplicationFactor            = 1
+
+	DefaultRebalancerInterval                   = 300
+	DefaultRebalancerMaxConcurrentMoves         = 1
+	DefaultRebalancerDiskUseThresholdPercentage = 10
+
+	DefaultVectorCacheBudgetIdleTimeout = 300
+
+	DefaultAutoTenantDeactivationInterval = 60
 )
 
 const VectorizerModuleNone = "none"
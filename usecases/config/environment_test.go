@@ -15,6 +15,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -634,6 +635,98 @@ func TestEnvironmentMinimumReplicationFactor(t *testing.T) {
 	}
 }
 
+func TestEnvironmentRebalancer(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, Rebalancer{
+			Enabled:                    false,
+			Interval:                   DefaultRebalancerInterval * time.Second,
+			MaxConcurrentMoves:         DefaultRebalancerMaxConcurrentMoves,
+			DiskUseThresholdPercentage: DefaultRebalancerDiskUseThresholdPercentage,
+		}, conf.Rebalancer)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		t.Setenv("REBALANCER_ENABLED", "true")
+		t.Setenv("REBALANCER_INTERVAL_SECONDS", "60")
+		t.Setenv("REBALANCER_MAX_CONCURRENT_MOVES", "4")
+		t.Setenv("REBALANCER_DISK_USE_THRESHOLD_PERCENTAGE", "25")
+		t.Setenv("REBALANCER_MAX_TRANSFER_MB_PER_SECOND", "100")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, Rebalancer{
+			Enabled:                    true,
+			Interval:                   time.Minute,
+			MaxConcurrentMoves:         4,
+			DiskUseThresholdPercentage: 25,
+			MaxTransferMBPerSecond:     100,
+		}, conf.Rebalancer)
+	})
+
+	t.Run("invalid concurrency", func(t *testing.T) {
+		t.Setenv("REBALANCER_MAX_CONCURRENT_MOVES", "0")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentVectorCacheBudget(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, VectorCacheBudget{
+			SizeMB:      0,
+			IdleTimeout: DefaultVectorCacheBudgetIdleTimeout * time.Second,
+		}, conf.VectorCacheBudget)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		t.Setenv("VECTOR_CACHE_BUDGET_MB", "4096")
+		t.Setenv("VECTOR_CACHE_BUDGET_IDLE_TIMEOUT_SECONDS", "60")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, VectorCacheBudget{
+			SizeMB:      4096,
+			IdleTimeout: time.Minute,
+		}, conf.VectorCacheBudget)
+	})
+
+	t.Run("invalid size", func(t *testing.T) {
+		t.Setenv("VECTOR_CACHE_BUDGET_MB", "-1")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
+func TestEnvironmentAutoTenantDeactivation(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, AutoTenantDeactivation{
+			Interval:             DefaultAutoTenantDeactivationInterval * time.Second,
+			MaxHotTenantsPerNode: 0,
+		}, conf.AutoTenantDeactivation)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		t.Setenv("AUTO_TENANT_DEACTIVATION_INTERVAL_SECONDS", "10")
+		t.Setenv("AUTO_TENANT_DEACTIVATION_MAX_HOT_TENANTS_PER_NODE", "1000")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, AutoTenantDeactivation{
+			Interval:             10 * time.Second,
+			MaxHotTenantsPerNode: 1000,
+		}, conf.AutoTenantDeactivation)
+	})
+
+	t.Run("invalid max hot tenants", func(t *testing.T) {
+		t.Setenv("AUTO_TENANT_DEACTIVATION_MAX_HOT_TENANTS_PER_NODE", "-1")
+		conf := Config{}
+		require.NotNil(t, FromEnv(&conf))
+	})
+}
+
 func TestEnvironmentQueryDefaults_Limit(t *testing.T) {
 	factors := []struct {
 		name     string
@@ -774,3 +867,172 @@ func TestEnvironmentHNSWMaxLogSize(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentHNSWSnapshotInterval(t *testing.T) {
+	factors := []struct {
+		name        string
+		value       []string
+		expected    int
+		expectedErr bool
+	}{
+		{"Valid", []string{"3600"}, 3600, false},
+		{"disabled", []string{"0"}, 0, false},
+		{"not given", []string{}, 0, false},
+		{"negative", []string{"-1"}, -1, true},
+		{"not parsable", []string{"I'm not a number"}, -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("PERSISTENCE_HNSW_SNAPSHOT_INTERVAL_SECONDS", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, conf.Persistence.HNSWSnapshotIntervalSeconds)
+			}
+		})
+	}
+}
+
+func TestEnvironmentLSMScrubInterval(t *testing.T) {
+	factors := []struct {
+		name        string
+		value       []string
+		expected    int
+		expectedErr bool
+	}{
+		{"Valid", []string{"86400"}, 86400, false},
+		{"disabled", []string{"0"}, 0, false},
+		{"not given", []string{}, 0, false},
+		{"negative", []string{"-1"}, -1, true},
+		{"not parsable", []string{"I'm not a number"}, -1, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("PERSISTENCE_LSM_SCRUB_INTERVAL_SECONDS", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expected, conf.Persistence.LSMScrubIntervalSeconds)
+			}
+		})
+	}
+}
+
+func TestEnvironmentLSMScrubRepair(t *testing.T) {
+	factors := []struct {
+		name     string
+		value    []string
+		expected bool
+	}{
+		{"enabled", []string{"true"}, true},
+		{"disabled", []string{"false"}, false},
+		{"not given", []string{}, false},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("PERSISTENCE_LSM_SCRUB_REPAIR", tt.value[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+			require.Nil(t, err)
+			require.Equal(t, tt.expected, conf.Persistence.LSMScrubRepair)
+		})
+	}
+}
+
+func TestEnvironmentLSMCompactionPolicy(t *testing.T) {
+	factors := []struct {
+		name           string
+		policy         []string
+		fanOut         []string
+		expectedPolicy string
+		expectedFanOut int
+		expectedErr    bool
+	}{
+		{"not given", []string{}, []string{}, "pairs", 4, false},
+		{"tiered", []string{"tiered"}, []string{}, "tiered", 4, false},
+		{"leveled with fan-out", []string{"leveled"}, []string{"10"}, "leveled", 10, false},
+		{"unknown policy", []string{"universal"}, []string{}, "", 0, true},
+		{"fan-out too small", []string{"tiered"}, []string{"1"}, "", 0, true},
+		{"fan-out not parsable", []string{}, []string{"I'm not a number"}, "", 0, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.policy) == 1 {
+				t.Setenv("PERSISTENCE_LSM_COMPACTION_POLICY", tt.policy[0])
+			}
+			if len(tt.fanOut) == 1 {
+				t.Setenv("PERSISTENCE_LSM_COMPACTION_FAN_OUT", tt.fanOut[0])
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expectedPolicy, conf.Persistence.LSMCompactionPolicy)
+				require.Equal(t, tt.expectedFanOut, conf.Persistence.LSMCompactionFanOut)
+			}
+		})
+	}
+}
+
+func TestEnvironmentLSMTier(t *testing.T) {
+	factors := []struct {
+		name          string
+		env           map[string]string
+		expectedLevel int
+		expectedAge   int
+		expectedCache int
+		expectedErr   bool
+	}{
+		{"not given", map[string]string{}, 0, 0, 0, false},
+		{"thresholds without backend", map[string]string{"PERSISTENCE_LSM_TIER_MIN_LEVEL": "3"}, 0, 0, 0, false},
+		{"min level", map[string]string{
+			"PERSISTENCE_LSM_TIER_BACKEND":   "backup-s3",
+			"PERSISTENCE_LSM_TIER_MIN_LEVEL": "3",
+		}, 3, 0, 1024, false},
+		{"min age and cache size", map[string]string{
+			"PERSISTENCE_LSM_TIER_BACKEND":         "backup-s3",
+			"PERSISTENCE_LSM_TIER_MIN_AGE_SECONDS": "86400",
+			"PERSISTENCE_LSM_TIER_CACHE_SIZE_MB":   "100",
+		}, 0, 86400, 100, false},
+		{"no threshold", map[string]string{"PERSISTENCE_LSM_TIER_BACKEND": "backup-s3"}, 0, 0, 0, true},
+		{"negative level", map[string]string{
+			"PERSISTENCE_LSM_TIER_BACKEND":   "backup-s3",
+			"PERSISTENCE_LSM_TIER_MIN_LEVEL": "-1",
+		}, 0, 0, 0, true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			conf := Config{}
+			err := FromEnv(&conf)
+
+			if tt.expectedErr {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.Equal(t, tt.expectedLevel, conf.Persistence.LSMTierMinLevel)
+				require.Equal(t, tt.expectedAge, conf.Persistence.LSMTierMinAgeSeconds)
+				require.Equal(t, tt.expectedCache, conf.Persistence.LSMTierCacheSizeMB)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package fakes
+
+import "github.com/weaviate/weaviate/entities/models"
+
+// FakeAuthorizer allows everything, unless Err is set
+type FakeAuthorizer struct {
+	Err error
+}
+
+func (a *FakeAuthorizer) Authorize(*models.Principal, string, string) error {
+	return a.Err
+}
@@ -0,0 +1,34 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package fakes
+
+import "sync/atomic"
+
+// FakeLeader tells whether the local node is the RAFT leader. The leadership
+// may change while a test runs, e.g. in the middle of a job.
+type FakeLeader struct {
+	leader atomic.Bool
+}
+
+func NewFakeLeader(leader bool) *FakeLeader {
+	l := &FakeLeader{}
+	l.leader.Store(leader)
+	return l
+}
+
+func (l *FakeLeader) IsLeader() bool {
+	return l.leader.Load()
+}
+
+func (l *FakeLeader) SetLeader(leader bool) {
+	l.leader.Store(leader)
+}
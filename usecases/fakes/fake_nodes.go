@@ -0,0 +1,43 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package fakes
+
+import "github.com/weaviate/weaviate/usecases/cluster"
+
+// FakeNodes is a cluster of the nodes Names, where Local is the local node.
+// Every node is reachable at "<name>:8300".
+type FakeNodes struct {
+	Local string
+	Names []string
+	Infos map[string]cluster.NodeInfo
+}
+
+func NewFakeNodes(local string, names ...string) *FakeNodes {
+	return &FakeNodes{Local: local, Names: names, Infos: map[string]cluster.NodeInfo{}}
+}
+
+func (n *FakeNodes) LocalName() string {
+	return n.Local
+}
+
+func (n *FakeNodes) AllNames() []string {
+	return n.Names
+}
+
+func (n *FakeNodes) NodeHostname(name string) (string, bool) {
+	return name + ":8300", true
+}
+
+func (n *FakeNodes) NodeInfo(name string) (cluster.NodeInfo, bool) {
+	info, ok := n.Infos[name]
+	return info, ok
+}
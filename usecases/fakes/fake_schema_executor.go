@@ -82,6 +82,21 @@ func (m *MockSchemaExecutor) UpdateShardStatus(req *cmd.UpdateShardStatusRequest
 	return args.Error(0)
 }
 
+func (m *MockSchemaExecutor) UpdateShardOwners(req *cmd.UpdateShardOwnersRequest) error {
+	args := m.Called(req)
+	return args.Error(0)
+}
+
+func (m *MockSchemaExecutor) StartResharding(class string) error {
+	args := m.Called(class)
+	return args.Error(0)
+}
+
+func (m *MockSchemaExecutor) FinishResharding(class string) error {
+	args := m.Called(class)
+	return args.Error(0)
+}
+
 func (m *MockSchemaExecutor) GetShardsStatus(class, tenant string) (models.ShardStatusList, error) {
 	args := m.Called(class, tenant)
 	return models.ShardStatusList{}, args.Error(1)
@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package fakes
+
+import (
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// FakeSchemaReader holds classes and their sharding states. Classes with a
+// sharding state but without a definition are read as bare classes.
+type FakeSchemaReader struct {
+	Classes map[string]*models.Class
+	States  map[string]*sharding.State
+}
+
+func NewFakeSchemaReader() *FakeSchemaReader {
+	return &FakeSchemaReader{
+		Classes: map[string]*models.Class{},
+		States:  map[string]*sharding.State{},
+	}
+}
+
+// ReadOnlySchema returns the classes ordered by name
+func (s *FakeSchemaReader) ReadOnlySchema() models.Schema {
+	var classes []*models.Class
+	for name, class := range s.Classes {
+		if class == nil {
+			class = &models.Class{Class: name}
+		}
+		classes = append(classes, class)
+	}
+	for name := range s.States {
+		if _, ok := s.Classes[name]; !ok {
+			classes = append(classes, &models.Class{Class: name})
+		}
+	}
+	sort.Slice(classes, func(i, j int) bool {
+		return classes[i].Class < classes[j].Class
+	})
+	return models.Schema{Classes: classes}
+}
+
+func (s *FakeSchemaReader) ReadOnlyClass(class string) *models.Class {
+	return s.Classes[class]
+}
+
+// CopyShardingState returns a copy, so that changes of the caller are not
+// visible to the test
+func (s *FakeSchemaReader) CopyShardingState(class string) *sharding.State {
+	state, ok := s.States[class]
+	if !ok {
+		return nil
+	}
+	copied := state.DeepCopy()
+	return &copied
+}
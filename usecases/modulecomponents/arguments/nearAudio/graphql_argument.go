@@ -61,5 +61,9 @@ func nearAudioFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: "Target vectors",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"exact": &graphql.InputObjectFieldConfig{
+			Description: descriptions.ExactSearch,
+			Type:        graphql.Boolean,
+		},
 	}
 }
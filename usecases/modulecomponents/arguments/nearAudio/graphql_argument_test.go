@@ -32,13 +32,14 @@ func TestNearAudioGraphQLArgument(t *testing.T) {
 		//   audio: "base64;encoded,audio",
 		//   distance: 0.9
 		//   targetVectors: ["targetVector"]
+		//   exact: true
 		// }
 		assert.NotNil(t, nearAudio)
 		assert.Equal(t, "Multi2VecBindPrefixClassNearAudioInpObj", nearAudio.Type.Name())
 		nearAudioFields, ok := nearAudio.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, nearAudioFields)
-		assert.Equal(t, 4, len(nearAudioFields.Fields()))
+		assert.Equal(t, 5, len(nearAudioFields.Fields()))
 		fields := nearAudioFields.Fields()
 		audio := fields["audio"]
 		audioNonNull, audioNonNullOK := audio.Type.(*graphql.NonNull)
@@ -52,5 +53,6 @@ func TestNearAudioGraphQLArgument(t *testing.T) {
 		assert.True(t, targetVectorsListOK)
 		assert.Equal(t, "String", targetVectorsList.OfType.Name())
 		assert.NotNil(t, targetVectors)
+		assert.Equal(t, "Boolean", fields["exact"].Type.Name())
 	})
 }
@@ -32,13 +32,14 @@ func TestNearDepthGraphQLArgument(t *testing.T) {
 		//   depth: "base64;encoded,depth_depth",
 		//   distance: 0.9
 		//   targetVectors: ["targetVector"]
+		//   exact: true
 		// }
 		assert.NotNil(t, nearDepth)
 		assert.Equal(t, "Multi2VecBindPrefixClassNearDepthInpObj", nearDepth.Type.Name())
 		answerFields, ok := nearDepth.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, answerFields)
-		assert.Equal(t, 4, len(answerFields.Fields()))
+		assert.Equal(t, 5, len(answerFields.Fields()))
 		fields := answerFields.Fields()
 		depth := fields["depth"]
 		depthNonNull, depthNonNullOK := depth.Type.(*graphql.NonNull)
@@ -52,5 +53,6 @@ func TestNearDepthGraphQLArgument(t *testing.T) {
 		assert.True(t, targetVectorsListOK)
 		assert.Equal(t, "String", targetVectorsList.OfType.Name())
 		assert.NotNil(t, targetVectors)
+		assert.Equal(t, "Boolean", fields["exact"].Type.Name())
 	})
 }
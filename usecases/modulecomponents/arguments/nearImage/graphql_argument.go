@@ -61,5 +61,9 @@ func nearImageFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: "Target vectors",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"exact": &graphql.InputObjectFieldConfig{
+			Description: descriptions.ExactSearch,
+			Type:        graphql.Boolean,
+		},
 	}
 }
@@ -32,13 +32,14 @@ func TestNearImageGraphQLArgument(t *testing.T) {
 		//   image: "base64;encoded,image",
 		//   distance: 0.4,
 		//   targetVectors: ["targetVector"]
+		//   exact: true
 		// }
 		assert.NotNil(t, nearImage)
 		assert.Equal(t, "Img2VecImagePrefixClassNearImageInpObj", nearImage.Type.Name())
 		answerFields, ok := nearImage.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, answerFields)
-		assert.Equal(t, 4, len(answerFields.Fields()))
+		assert.Equal(t, 5, len(answerFields.Fields()))
 		fields := answerFields.Fields()
 		image := fields["image"]
 		imageNonNull, imageNonNullOK := image.Type.(*graphql.NonNull)
@@ -52,5 +53,6 @@ func TestNearImageGraphQLArgument(t *testing.T) {
 		assert.True(t, targetVectorsListOK)
 		assert.Equal(t, "String", targetVectorsList.OfType.Name())
 		assert.NotNil(t, targetVectors)
+		assert.Equal(t, "Boolean", fields["exact"].Type.Name())
 	})
 }
@@ -32,13 +32,14 @@ func TestNearIMUGraphQLArgument(t *testing.T) {
 		//   imu: "base64;encoded,imu_data",
 		//   distance: 0.9
 		//   targetVectors: ["targetVector"]
+		//   exact: true
 		// }
 		assert.NotNil(t, nearIMU)
 		assert.Equal(t, "Multi2VecBindPrefixClassNearIMUInpObj", nearIMU.Type.Name())
 		answerFields, ok := nearIMU.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, answerFields)
-		assert.Equal(t, 4, len(answerFields.Fields()))
+		assert.Equal(t, 5, len(answerFields.Fields()))
 		fields := answerFields.Fields()
 		imu := fields["imu"]
 		imuNonNull, imuNonNullOK := imu.Type.(*graphql.NonNull)
@@ -52,5 +53,6 @@ func TestNearIMUGraphQLArgument(t *testing.T) {
 		assert.True(t, targetVectorsListOK)
 		assert.Equal(t, "String", targetVectorsList.OfType.Name())
 		assert.NotNil(t, targetVectors)
+		assert.Equal(t, "Boolean", fields["exact"].Type.Name())
 	})
 }
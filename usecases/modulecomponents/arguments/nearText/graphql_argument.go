@@ -77,6 +77,10 @@ func (g *GraphQLArgumentsProvider) nearTextFields(prefix string) graphql.InputOb
 			Description: "Target vectors",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"exact": &graphql.InputObjectFieldConfig{
+			Description: descriptions.ExactSearch,
+			Type:        graphql.Boolean,
+		},
 	}
 	if g.nearTextTransformer != nil {
 		nearTextFields["autocorrect"] = &graphql.InputObjectFieldConfig{
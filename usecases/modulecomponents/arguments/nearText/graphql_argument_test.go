@@ -48,13 +48,14 @@ func TestNearTextGraphQLArgument(t *testing.T) {
 		//          force: 0.8
 		//   },
 		//   targetVectors: ["targetVector"]
+		//   exact: true
 		// }
 		assert.NotNil(t, nearText)
 		assert.Equal(t, "PrefixClassNearTextInpObj", nearText.Type.Name())
 		nearTextFields, ok := nearText.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, nearTextFields)
-		assert.Equal(t, 6, len(nearTextFields.Fields()))
+		assert.Equal(t, 7, len(nearTextFields.Fields()))
 		fields := nearTextFields.Fields()
 		concepts := fields["concepts"]
 		conceptsNonNull, conceptsNonNullOK := concepts.Type.(*graphql.NonNull)
@@ -108,6 +109,7 @@ func TestNearTextGraphQLArgument(t *testing.T) {
 		assert.True(t, targetVectorsListOK)
 		assert.Equal(t, "String", targetVectorsList.OfType.Name())
 		assert.NotNil(t, targetVectors)
+		assert.Equal(t, "Boolean", fields["exact"].Type.Name())
 	})
 }
 
@@ -142,13 +144,14 @@ func TestNearTextGraphQLArgumentWithAutocorrect(t *testing.T) {
 		//          force: 0.8
 		//   },
 		//   targetVectors: ["targetVector"],
+		//   exact: true
 		// }
 		assert.NotNil(t, nearText)
 		assert.Equal(t, "PrefixClassNearTextInpObj", nearText.Type.Name())
 		nearTextFields, ok := nearText.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, nearTextFields)
-		assert.Equal(t, 7, len(nearTextFields.Fields()))
+		assert.Equal(t, 8, len(nearTextFields.Fields()))
 		fields := nearTextFields.Fields()
 		concepts := fields["concepts"]
 		conceptsNonNull, conceptsNonNullOK := concepts.Type.(*graphql.NonNull)
@@ -204,5 +207,6 @@ func TestNearTextGraphQLArgumentWithAutocorrect(t *testing.T) {
 		assert.NotNil(t, targetVectorsList)
 		assert.Equal(t, "String", targetVectorsList.OfType.Name())
 		assert.NotNil(t, targetVectors)
+		assert.Equal(t, "Boolean", fields["exact"].Type.Name())
 	})
 }
@@ -32,13 +32,14 @@ func TestNearThermalGraphQLArgument(t *testing.T) {
 		//   thermal: "base64;encoded,thermal_image",
 		//   distance: 0.9
 		//   targetVectors: ["targetVector"]
+		//   exact: true
 		// }
 		assert.NotNil(t, nearThermal)
 		assert.Equal(t, "Multi2VecBindPrefixClassNearThermalInpObj", nearThermal.Type.Name())
 		answerFields, ok := nearThermal.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, answerFields)
-		assert.Equal(t, 4, len(answerFields.Fields()))
+		assert.Equal(t, 5, len(answerFields.Fields()))
 		fields := answerFields.Fields()
 		thermal := fields["thermal"]
 		thermalNonNull, thermalNonNullOK := thermal.Type.(*graphql.NonNull)
@@ -52,5 +53,6 @@ func TestNearThermalGraphQLArgument(t *testing.T) {
 		assert.True(t, targetVectorsListOK)
 		assert.Equal(t, "String", targetVectorsList.OfType.Name())
 		assert.NotNil(t, targetVectors)
+		assert.Equal(t, "Boolean", fields["exact"].Type.Name())
 	})
 }
@@ -32,13 +32,14 @@ func TestNearVideoGraphQLArgument(t *testing.T) {
 		//   video: "base64;encoded,video_file",
 		//   distance: 0.9
 		//   targetVectors: ["targetVector"]
+		//   exact: true
 		// }
 		assert.NotNil(t, nearVideo)
 		assert.Equal(t, "Multi2VecBindPrefixClassNearVideoInpObj", nearVideo.Type.Name())
 		answerFields, ok := nearVideo.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, answerFields)
-		assert.Equal(t, 4, len(answerFields.Fields()))
+		assert.Equal(t, 5, len(answerFields.Fields()))
 		fields := answerFields.Fields()
 		video := fields["video"]
 		videoNonNull, videoNonNullOK := video.Type.(*graphql.NonNull)
@@ -52,5 +53,6 @@ func TestNearVideoGraphQLArgument(t *testing.T) {
 		assert.True(t, targetVectorsListOK)
 		assert.Equal(t, "String", targetVectorsList.OfType.Name())
 		assert.NotNil(t, targetVectors)
+		assert.Equal(t, "Boolean", fields["exact"].Type.Name())
 	})
 }
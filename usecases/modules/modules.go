@@ -693,6 +693,30 @@ func (p *Provider) VectorFromSearchParam(ctx context.Context,
 	if err != nil {
 		return nil, "", err
 	}
+	vector, err := p.vectorFromSearchParam(ctx, class, targetVector, param, params, findVectorFn, tenant)
+	if err != nil {
+		return nil, "", err
+	}
+	return vector, targetVector, nil
+}
+
+// VectorFromSearchParamForTarget gets a vector for a given argument and
+// target vector. This is used when searching several target vectors at once.
+func (p *Provider) VectorFromSearchParamForTarget(ctx context.Context,
+	className, targetVector, param string, params interface{},
+	findVectorFn modulecapabilities.FindVectorFn, tenant string,
+) ([]float32, error) {
+	class, err := p.getClass(className)
+	if err != nil {
+		return nil, err
+	}
+	return p.vectorFromSearchParam(ctx, class, targetVector, param, params, findVectorFn, tenant)
+}
+
+func (p *Provider) vectorFromSearchParam(ctx context.Context,
+	class *models.Class, targetVector, param string, params interface{},
+	findVectorFn modulecapabilities.FindVectorFn, tenant string,
+) ([]float32, error) {
 	targetModule := p.getModuleNameForTargetVector(class, targetVector)
 
 	for _, mod := range p.GetAll() {
@@ -715,9 +739,9 @@ func (p *Provider) VectorFromSearchParam(ctx context.Context,
 					cfg := NewClassBasedModuleConfig(class, moduleName, tenant, targetVector)
 					vector, err := searchVectorFn(ctx, params, class.Class, findVectorFn, cfg)
 					if err != nil {
-						return nil, "", errors.Errorf("vectorize params: %v", err)
+						return nil, errors.Errorf("vectorize params: %v", err)
 					}
-					return vector, targetVector, nil
+					return vector, nil
 				}
 			}
 		}
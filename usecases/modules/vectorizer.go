@@ -65,6 +65,19 @@ func (p *Provider) ValidateVectorizer(moduleName string) error {
 	return nil
 }
 
+// ValidateReindexVectorizer checks that the module can recompute the vectors
+// of existing objects. Reference vectorizers are not supported, as their
+// vectors depend on the vectors of other objects.
+func (p *Provider) ValidateReindexVectorizer(moduleName string) error {
+	if err := p.ValidateVectorizer(moduleName); err != nil {
+		return err
+	}
+	if _, ok := p.GetByName(moduleName).(modulecapabilities.Vectorizer); !ok {
+		return fmt.Errorf("vectors of module %q can't be reindexed", moduleName)
+	}
+	return nil
+}
+
 func (p *Provider) UsingRef2Vec(className string) bool {
 	class, err := p.getClass(className)
 	if err != nil {
@@ -160,6 +173,57 @@ func (p *Provider) BatchUpdateVector(ctx context.Context, class *models.Class, o
 	}
 }
 
+// ReindexVectors computes the vectors of a single target vector of the
+// objects with the vectorizer configured in class. In contrast to
+// BatchUpdateVector, vectors the objects already have are replaced.
+func (p *Provider) ReindexVectors(ctx context.Context, class *models.Class, targetVector string,
+	objects []*models.Object,
+) (map[int]error, error) {
+	modConfigs, err := p.getModuleConfigs(class)
+	if err != nil {
+		return nil, err
+	}
+	modConfig, ok := modConfigs[targetVector]
+	if !ok {
+		return nil, fmt.Errorf("no vectorizer for target vector %q of class %q", targetVector, class.Class)
+	}
+	found := p.getModule(class, modConfig)
+	if found == nil {
+		return nil, fmt.Errorf("no vectorizer found for class %q", class.Class)
+	}
+	vectorizer, ok := found.(modulecapabilities.Vectorizer)
+	if !ok {
+		return nil, fmt.Errorf("vectors of module %q can't be reindexed", found.Name())
+	}
+	cfg := NewClassBasedModuleConfig(class, found.Name(), "", targetVector)
+
+	p.lockGuard(func() {
+		for _, obj := range objects {
+			if targetVector == "" {
+				obj.Vector = nil
+			} else {
+				delete(obj.Vectors, targetVector)
+			}
+		}
+	})
+
+	vectors, addProps, vecErrors := vectorizer.VectorizeBatch(ctx, objects, make([]bool, len(objects)), cfg)
+	for i := range objects {
+		if _, ok := vecErrors[i]; ok {
+			continue
+		}
+
+		var addProp models.AdditionalProperties
+		if addProps != nil {
+			addProp = addProps[i]
+		}
+		p.lockGuard(func() {
+			p.addVectorToObject(objects[i], vectors[i], addProp, cfg)
+		})
+	}
+	return vecErrors, nil
+}
+
 func (p *Provider) shouldVectorizeClass(class *models.Class, targetVector string, logger logrus.FieldLogger) (bool, error) {
 	hnswConfig, err := p.getVectorIndexConfig(class, targetVector)
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -257,6 +258,52 @@ func TestProvider_UpdateVector(t *testing.T) {
 	})
 }
 
+func TestProvider_ReindexVectors(t *testing.T) {
+	ctx := context.Background()
+	class := &models.Class{
+		Class: "SomeClass",
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {
+				Vectorizer:        map[string]interface{}{"some-vzr": map[string]interface{}{}},
+				VectorIndexConfig: hnsw.UserConfig{},
+			},
+			"body": {
+				Vectorizer:        map[string]interface{}{"some-vzr": map[string]interface{}{}},
+				VectorIndexConfig: hnsw.UserConfig{},
+			},
+		},
+	}
+
+	p := NewProvider()
+	p.Register(newDummyModule("some-vzr", modulecapabilities.Text2Vec))
+	p.Register(newDummyModule("some-ref-vzr", modulecapabilities.Ref2Vec))
+
+	t.Run("existing vectors of the target vector are replaced", func(t *testing.T) {
+		objs := []*models.Object{
+			{Class: "SomeClass", ID: newUUID(), Vectors: models.Vectors{"title": {9, 9}, "body": {8, 8}}},
+			{Class: "SomeClass", ID: newUUID()},
+		}
+		errs, err := p.ReindexVectors(ctx, class, "title", objs)
+		require.Nil(t, err)
+		assert.Empty(t, errs)
+		for _, obj := range objs {
+			assert.Equal(t, models.Vector{1, 2, 3}, obj.Vectors["title"])
+		}
+		assert.Equal(t, models.Vector{8, 8}, objs[0].Vectors["body"])
+	})
+
+	t.Run("unknown target vector", func(t *testing.T) {
+		_, err := p.ReindexVectors(ctx, class, "summary", []*models.Object{{Class: "SomeClass"}})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("reference vectorizers are not supported", func(t *testing.T) {
+		assert.Nil(t, p.ValidateReindexVectorizer("some-vzr"))
+		assert.NotNil(t, p.ValidateReindexVectorizer("some-ref-vzr"))
+		assert.NotNil(t, p.ValidateReindexVectorizer("missing"))
+	})
+}
+
 func newUUID() strfmt.UUID {
 	return strfmt.UUID(uuid.NewString())
 }
@@ -32,6 +32,10 @@ type PrometheusMetrics struct {
 	LSMBloomFilters                   *prometheus.SummaryVec
 	AsyncOperations                   *prometheus.GaugeVec
 	LSMSegmentCount                   *prometheus.GaugeVec
+	LSMSegmentChecksumFailures        *prometheus.CounterVec
+	LSMSegmentScrubbedBytes           *prometheus.CounterVec
+	LSMCompactionWrittenBytes         *prometheus.CounterVec
+	LSMFlushedBytes                   *prometheus.CounterVec
 	LSMSegmentCountByLevel            *prometheus.GaugeVec
 	LSMSegmentObjects                 *prometheus.GaugeVec
 	LSMSegmentSize                    *prometheus.GaugeVec
@@ -66,6 +70,17 @@ type PrometheusMetrics struct {
 	VectorDimensionsSumByVector        *prometheus.GaugeVec
 	VectorSegmentsSumByVector          *prometheus.GaugeVec
 
+	VectorIndexDynamicUpgraded           *prometheus.GaugeVec
+	VectorIndexDynamicMigrationProgress  *prometheus.GaugeVec
+	VectorIndexDynamicMigrationRemaining *prometheus.GaugeVec
+	VectorIndexDynamicMigrations         *prometheus.CounterVec
+
+	VectorIndexCacheHits       *prometheus.CounterVec
+	VectorIndexCacheMisses     *prometheus.CounterVec
+	VectorCacheBudgetUsed      prometheus.Gauge
+	VectorCacheBudgetLimit     prometheus.Gauge
+	VectorCacheBudgetEvictions *prometheus.CounterVec
+
 	StartupProgress  *prometheus.GaugeVec
 	StartupDurations *prometheus.SummaryVec
 	StartupDiskIO    *prometheus.SummaryVec
@@ -126,6 +141,10 @@ func (pm *PrometheusMetrics) DeleteShard(className, shardName string) error {
 	pm.LSMSegmentCount.DeletePartialMatch(labels)
 	pm.LSMSegmentSize.DeletePartialMatch(labels)
 	pm.LSMSegmentCountByLevel.DeletePartialMatch(labels)
+	pm.LSMSegmentChecksumFailures.DeletePartialMatch(labels)
+	pm.LSMSegmentScrubbedBytes.DeletePartialMatch(labels)
+	pm.LSMCompactionWrittenBytes.DeletePartialMatch(labels)
+	pm.LSMFlushedBytes.DeletePartialMatch(labels)
 	pm.VectorIndexTombstones.DeletePartialMatch(labels)
 	pm.VectorIndexTombstoneCleanupThreads.DeletePartialMatch(labels)
 	pm.VectorIndexTombstoneCleanedCount.DeletePartialMatch(labels)
@@ -133,6 +152,12 @@ func (pm *PrometheusMetrics) DeleteShard(className, shardName string) error {
 	pm.VectorIndexMaintenanceDurations.DeletePartialMatch(labels)
 	pm.VectorIndexDurations.DeletePartialMatch(labels)
 	pm.VectorIndexSize.DeletePartialMatch(labels)
+	pm.VectorIndexDynamicUpgraded.DeletePartialMatch(labels)
+	pm.VectorIndexDynamicMigrationProgress.DeletePartialMatch(labels)
+	pm.VectorIndexDynamicMigrationRemaining.DeletePartialMatch(labels)
+	pm.VectorIndexDynamicMigrations.DeletePartialMatch(labels)
+	pm.VectorIndexCacheHits.DeletePartialMatch(labels)
+	pm.VectorIndexCacheMisses.DeletePartialMatch(labels)
 	pm.StartupProgress.DeletePartialMatch(labels)
 	pm.StartupDurations.DeletePartialMatch(labels)
 	pm.StartupDiskIO.DeletePartialMatch(labels)
@@ -238,6 +263,22 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "lsm_active_segments",
 			Help: "Number of currently present segments per shard",
 		}, []string{"strategy", "class_name", "shard_name", "path"}),
+		LSMSegmentChecksumFailures: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_segment_checksum_failures",
+			Help: "Number of segments which failed checksum verification",
+		}, []string{"strategy", "class_name", "shard_name", "path"}),
+		LSMSegmentScrubbedBytes: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_segment_scrubbed_bytes",
+			Help: "Number of bytes verified by the segment scrubber",
+		}, []string{"strategy", "class_name", "shard_name", "path"}),
+		LSMCompactionWrittenBytes: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_compaction_written_bytes",
+			Help: "Number of bytes written to segments by compactions, divided by lsm_flushed_bytes this is the write amplification",
+		}, []string{"strategy", "class_name", "shard_name", "path"}),
+		LSMFlushedBytes: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_flushed_bytes",
+			Help: "Number of bytes written to segments by memtable flushes",
+		}, []string{"strategy", "class_name", "shard_name", "path"}),
 		LSMBloomFilters: promauto.NewSummaryVec(prometheus.SummaryOpts{
 			Name: "lsm_bloom_filters_duration_ms",
 			Help: "Duration of bloom filter operations",
@@ -292,6 +333,42 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "vector_index_durations_ms",
 			Help: "Duration of typical vector index operations (insert, delete)",
 		}, []string{"operation", "step", "class_name", "shard_name"}),
+		VectorIndexDynamicUpgraded: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_dynamic_upgraded",
+			Help: "Whether a dynamic vector index uses hnsw (1) or flat (0)",
+		}, []string{"class_name", "shard_name", "target_vector"}),
+		VectorIndexDynamicMigrationProgress: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_dynamic_migration_progress",
+			Help: "Fraction of vectors added to the new index of a running upgrade or downgrade of a dynamic vector index",
+		}, []string{"class_name", "shard_name", "target_vector"}),
+		VectorIndexDynamicMigrationRemaining: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_dynamic_migration_remaining_seconds",
+			Help: "Estimated time until a running upgrade or downgrade of a dynamic vector index completes",
+		}, []string{"class_name", "shard_name", "target_vector"}),
+		VectorIndexDynamicMigrations: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "vector_index_dynamic_migrations",
+			Help: "Total number of upgrades and downgrades of dynamic vector indexes",
+		}, []string{"class_name", "shard_name", "target_vector", "direction", "result"}),
+		VectorIndexCacheHits: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "vector_index_cache_hits",
+			Help: "Total number of vectors read from the vector cache of a shard",
+		}, []string{"class_name", "shard_name"}),
+		VectorIndexCacheMisses: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "vector_index_cache_misses",
+			Help: "Total number of vectors which were not in the vector cache of a shard and read from disk",
+		}, []string{"class_name", "shard_name"}),
+		VectorCacheBudgetUsed: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "vector_cache_budget_used_bytes",
+			Help: "Memory used by the vector caches of all shards which share the vector cache budget",
+		}),
+		VectorCacheBudgetLimit: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "vector_cache_budget_limit_bytes",
+			Help: "Memory the vector caches of all shards may use together",
+		}),
+		VectorCacheBudgetEvictions: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "vector_cache_budget_evictions",
+			Help: "Total number of vectors evicted from the vector caches to stay within the budget",
+		}, []string{"reason"}),
 		VectorDimensionsSum: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "vector_dimensions_sum",
 			Help: "Total dimensions in a shard",
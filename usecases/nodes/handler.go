@@ -17,6 +17,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/verbosity"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 )
 
@@ -29,6 +30,8 @@ type authorizer interface {
 type db interface {
 	GetNodeStatus(ctx context.Context, className, verbosity string) ([]*models.NodeStatus, error)
 	GetNodeStatistics(ctx context.Context) ([]*models.Statistics, error)
+	RequestAsyncReplicationComparison(ctx context.Context, className string) error
+	SetDynamicIndexUpgradePolicy(ctx context.Context, className, tenant, targetVector, policy string) error
 }
 
 type Manager struct {
@@ -69,3 +72,62 @@ func (m *Manager) GetNodeStatistics(ctx context.Context,
 	}
 	return m.db.GetNodeStatistics(ctxWithTimeout)
 }
+
+// GetReplicationStatus returns the async replication status of all shards in
+// the cluster, optionally restricted to the shards of a single class
+func (m *Manager) GetReplicationStatus(ctx context.Context,
+	principal *models.Principal, className string,
+) ([]*models.ShardReplicationStatus, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, GetNodeStatusTimeout)
+	defer cancel()
+
+	if err := m.authorizer.Authorize(principal, "list", "nodes"); err != nil {
+		return nil, err
+	}
+
+	nodeStatuses, err := m.db.GetNodeStatus(ctxWithTimeout, className, verbosity.OutputVerbose)
+	if err != nil {
+		return nil, err
+	}
+
+	shards := []*models.ShardReplicationStatus{}
+	for _, node := range nodeStatuses {
+		for _, shard := range node.Shards {
+			shards = append(shards, &models.ShardReplicationStatus{
+				Class:                  shard.Class,
+				Shard:                  shard.Name,
+				Node:                   node.Name,
+				AsyncReplicationStatus: shard.AsyncReplicationStatus,
+			})
+		}
+	}
+	return shards, nil
+}
+
+// RequestAsyncReplicationComparison triggers an immediate comparison of all
+// shards of the class with their replicas
+func (m *Manager) RequestAsyncReplicationComparison(ctx context.Context,
+	principal *models.Principal, className string,
+) error {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, GetNodeStatusTimeout)
+	defer cancel()
+
+	if err := m.authorizer.Authorize(principal, "update", "nodes"); err != nil {
+		return err
+	}
+	return m.db.RequestAsyncReplicationComparison(ctxWithTimeout, className)
+}
+
+// SetDynamicIndexUpgradePolicy forces or defers the upgrade of the dynamic
+// vector indexes of a class, for a single tenant or all shards
+func (m *Manager) SetDynamicIndexUpgradePolicy(ctx context.Context,
+	principal *models.Principal, className, tenant, targetVector, policy string,
+) error {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, GetNodeStatusTimeout)
+	defer cancel()
+
+	if err := m.authorizer.Authorize(principal, "update", "nodes"); err != nil {
+		return err
+	}
+	return m.db.SetDynamicIndexUpgradePolicy(ctxWithTimeout, className, tenant, targetVector, policy)
+}
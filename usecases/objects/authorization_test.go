@@ -87,6 +87,7 @@ func Test_Kinds_Authorization(t *testing.T) {
 			additionalArgs: []interface{}{
 				&models.Object{Class: "class", ID: "foo"},
 				(*additional.ReplicationProperties)(nil),
+				(*int64)(nil),
 			},
 			expectedVerb:     "update",
 			expectedResource: "objects/class/foo",
@@ -216,6 +217,26 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			expectedVerb:     "create",
 			expectedResource: "batch/objects",
 		},
+		{
+			methodName: "AddObjectsConditional",
+			additionalArgs: []interface{}{
+				[]*models.Object{},
+				[]*int64{},
+				&additional.ReplicationProperties{},
+			},
+			expectedVerb:     "create",
+			expectedResource: "batch/objects",
+		},
+		{
+			methodName: "AddObjectsTransactional",
+			additionalArgs: []interface{}{
+				[]*models.Object{},
+				[]*int64{},
+				&additional.ReplicationProperties{},
+			},
+			expectedVerb:     "create",
+			expectedResource: "batch/objects",
+		},
 
 		{
 			methodName: "AddReferences",
@@ -249,6 +270,37 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			expectedVerb:     "delete",
 			expectedResource: "batch/objects",
 		},
+		{
+			methodName: "UpdateObjects",
+			additionalArgs: []interface{}{
+				"",
+				BatchUpdateObjects{},
+				&additional.ReplicationProperties{},
+				"",
+			},
+			expectedVerb:     "update",
+			expectedResource: "batch/objects",
+		},
+		{
+			methodName: "UpdateObjectsByFilter",
+			additionalArgs: []interface{}{
+				BatchUpdateParams{},
+				&additional.ReplicationProperties{},
+				"",
+			},
+			expectedVerb:     "update",
+			expectedResource: "batch/objects",
+		},
+		{
+			methodName: "UpdateObjectsByMatch",
+			additionalArgs: []interface{}{
+				&models.BatchUpdate{},
+				&additional.ReplicationProperties{},
+				"",
+			},
+			expectedVerb:     "update",
+			expectedResource: "batch/objects",
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
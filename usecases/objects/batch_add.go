@@ -28,6 +28,50 @@ var errEmptyObjects = NewErrInvalidUserInput("invalid param 'objects': cannot be
 // AddObjects Class Instances in batch to the connected DB
 func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Principal,
 	objects []*models.Object, fields []*string, repl *additional.ReplicationProperties,
+) (BatchObjects, error) {
+	return b.addObjects(ctx, principal, objects, nil, false, repl)
+}
+
+// AddObjectsConditional adds objects like AddObjects, but each object with an
+// expected version is only written if the stored object has that
+// lastUpdateTimeUnix, 0 expects the object not to exist and nil skips the
+// check. Objects failing the check carry an ErrPreconditionFailed.
+func (b *BatchManager) AddObjectsConditional(ctx context.Context, principal *models.Principal,
+	objects []*models.Object, expectedVersions []*int64, repl *additional.ReplicationProperties,
+) (BatchObjects, error) {
+	if len(expectedVersions) > len(objects) {
+		return nil, NewErrInvalidUserInput("invalid param 'expectedVersions': got %d versions for %d objects",
+			len(expectedVersions), len(objects))
+	}
+
+	return b.addObjects(ctx, principal, objects, expectedVersions, false, repl)
+}
+
+// AddObjectsTransactional adds all objects or none of them. All objects must
+// belong to the same class and shard or tenant. expectedVersions holds the
+// lastUpdateTimeUnix per object the stored object must have, 0 expects the
+// object not to exist and nil skips the check. If any object fails, all
+// objects carry an error.
+func (b *BatchManager) AddObjectsTransactional(ctx context.Context, principal *models.Principal,
+	objects []*models.Object, expectedVersions []*int64, repl *additional.ReplicationProperties,
+) (BatchObjects, error) {
+	if len(expectedVersions) > len(objects) {
+		return nil, NewErrInvalidUserInput("invalid param 'expectedVersions': got %d versions for %d objects",
+			len(expectedVersions), len(objects))
+	}
+	for _, obj := range objects {
+		if obj.Class != objects[0].Class {
+			return nil, NewErrInvalidUserInput("transaction spans classes %q and %q, all objects must belong to the same class",
+				objects[0].Class, obj.Class)
+		}
+	}
+
+	return b.addObjects(ctx, principal, objects, expectedVersions, true, repl)
+}
+
+func (b *BatchManager) addObjects(ctx context.Context, principal *models.Principal,
+	objects []*models.Object, expectedVersions []*int64, transactional bool,
+	repl *additional.ReplicationProperties,
 ) (BatchObjects, error) {
 	err := b.authorizer.Authorize(principal, "create", "batch/objects")
 	if err != nil {
@@ -73,6 +117,19 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 	if err := b.schemaManager.WaitForUpdate(ctx, maxSchemaVersion); err != nil {
 		return nil, fmt.Errorf("error waiting for local schema to catch up to version %d: %w", maxSchemaVersion, err)
 	}
+	for i := range expectedVersions {
+		batchObjects[i].ExpectedVersion = expectedVersions[i]
+	}
+	if transactional {
+		if abortTransaction(batchObjects) {
+			return batchObjects, nil
+		}
+		if res, err = b.vectorRepo.BatchPutObjectsTransactional(ctx, batchObjects, repl, maxSchemaVersion); err != nil {
+			return nil, NewErrInternal("batch objects: %#v", err)
+		}
+		return res, nil
+	}
+
 	if res, err = b.vectorRepo.BatchPutObjects(ctx, batchObjects, repl, maxSchemaVersion); err != nil {
 		return nil, NewErrInternal("batch objects: %#v", err)
 	}
@@ -80,6 +137,29 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 	return res, nil
 }
 
+// abortTransaction fails all objects of a transaction if one of them failed
+// validation, nothing is written then
+func abortTransaction(batchObjects BatchObjects) bool {
+	pos := -1
+	for i := range batchObjects {
+		if batchObjects[i].Err != nil {
+			pos = i
+			break
+		}
+	}
+	if pos < 0 {
+		return false
+	}
+
+	for i := range batchObjects {
+		if batchObjects[i].Err == nil {
+			batchObjects[i].Err = fmt.Errorf("transaction aborted, object %d failed: %w",
+				pos, batchObjects[pos].Err)
+		}
+	}
+	return true
+}
+
 func (b *BatchManager) validateAndGetVector(ctx context.Context, principal *models.Principal,
 	objects []*models.Object, repl *additional.ReplicationProperties,
 ) (BatchObjects, uint64) {
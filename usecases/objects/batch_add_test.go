@@ -470,3 +470,86 @@ func Test_BatchManager_AddObjectsEmptyProperties(t *testing.T) {
 	require.NotNil(t, addedObjects[0].Object.Properties)
 	require.NotNil(t, addedObjects[1].Object.Properties)
 }
+
+func Test_BatchManager_AddObjectsTransactional(t *testing.T) {
+	var (
+		vectorRepo      *fakeVectorRepo
+		modulesProvider *fakeModulesProvider
+		manager         *BatchManager
+	)
+	schema := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Vectorizer:        config.VectorizerModuleNone,
+					Class:             "Order",
+					VectorIndexConfig: hnsw.UserConfig{},
+				},
+				{
+					Vectorizer:        config.VectorizerModuleNone,
+					Class:             "Item",
+					VectorIndexConfig: hnsw.UserConfig{},
+				},
+			},
+		},
+	}
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		config := &config.WeaviateConfig{}
+		locks := &fakeLocks{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema,
+		}
+		logger, _ := test.NewNullLogger()
+		authorizer := &fakeAuthorizer{}
+		modulesProvider = getFakeModulesProvider()
+		modulesProvider.On("BatchUpdateVector").Return(nil, nil)
+		manager = NewBatchManager(vectorRepo, modulesProvider, locks,
+			schemaManager, config, logger, authorizer, nil)
+	}
+	ctx := context.Background()
+	version := int64(1234)
+
+	t.Run("with valid objects", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchPutObjectsTransactional", mock.Anything).Return(nil).Once()
+		objects := []*models.Object{
+			{ID: "cf918366-3d3b-4b90-9bc6-bc5ea8762ff6", Class: "Order"},
+			{ID: "cf918366-3d3b-4b90-9bc6-bc5ea8762ff3", Class: "Order"},
+		}
+
+		res, err := manager.AddObjectsTransactional(ctx, nil, objects, []*int64{nil, &version}, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Nil(t, res[0].ExpectedVersion)
+		assert.Equal(t, &version, res[1].ExpectedVersion)
+		vectorRepo.AssertExpectations(t)
+	})
+
+	t.Run("with an invalid object", func(t *testing.T) {
+		reset()
+		objects := []*models.Object{
+			{ID: "cf918366-3d3b-4b90-9bc6-bc5ea8762ff6", Class: "Order"},
+			{ID: "invalid", Class: "Order"},
+		}
+
+		res, err := manager.AddObjectsTransactional(ctx, nil, objects, nil, nil)
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.ErrorContains(t, res[0].Err, "transaction aborted, object 1 failed")
+		assert.NotNil(t, res[1].Err)
+		vectorRepo.AssertNotCalled(t, "BatchPutObjectsTransactional", mock.Anything)
+	})
+
+	t.Run("with objects of different classes", func(t *testing.T) {
+		reset()
+		objects := []*models.Object{
+			{ID: "cf918366-3d3b-4b90-9bc6-bc5ea8762ff6", Class: "Order"},
+			{ID: "cf918366-3d3b-4b90-9bc6-bc5ea8762ff3", Class: "Item"},
+		}
+
+		_, err := manager.AddObjectsTransactional(ctx, nil, objects, nil, nil)
+		assert.ErrorAs(t, err, &ErrInvalidUserInput{})
+		vectorRepo.AssertNotCalled(t, "BatchPutObjectsTransactional", mock.Anything)
+	})
+}
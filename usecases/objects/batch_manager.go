@@ -42,10 +42,16 @@ type BatchVectorRepo interface {
 type batchRepoNew interface {
 	BatchPutObjects(ctx context.Context, objects BatchObjects,
 		repl *additional.ReplicationProperties, schemaVersion uint64) (BatchObjects, error)
+	BatchPutObjectsTransactional(ctx context.Context, objects BatchObjects,
+		repl *additional.ReplicationProperties, schemaVersion uint64) (BatchObjects, error)
 	BatchDeleteObjects(ctx context.Context, params BatchDeleteParams,
 		repl *additional.ReplicationProperties, tenant string, schemaVersion uint64) (BatchDeleteResult, error)
 	AddBatchReferences(ctx context.Context, references BatchReferences,
 		repl *additional.ReplicationProperties, schemaVersion uint64) (BatchReferences, error)
+	BatchMergeObjects(ctx context.Context, docs []MergeDocument,
+		repl *additional.ReplicationProperties, tenant string, schemaVersion uint64) []error
+	BatchUpdateObjects(ctx context.Context, params BatchUpdateParams,
+		repl *additional.ReplicationProperties, tenant string, schemaVersion uint64) (BatchUpdateResult, error)
 }
 
 // NewBatchManager creates a new manager
@@ -33,6 +33,10 @@ type BatchObject struct {
 	Err           error
 	Object        *models.Object
 	UUID          strfmt.UUID
+	// ExpectedVersion is the lastUpdateTimeUnix the stored object must have
+	// when it is written in a transactional batch, 0 expects the object not
+	// to exist and nil skips the check
+	ExpectedVersion *int64
 }
 
 // BatchObjects groups many Object items together. The order matches the
@@ -69,6 +73,60 @@ type BatchSimpleObject struct {
 
 type BatchSimpleObjects []BatchSimpleObject
 
+// PropertyChanges is a partial update of an object, properties which are not
+// mentioned keep their values
+type PropertyChanges struct {
+	Set    map[string]interface{} // properties which are overwritten
+	Unset  []string               // properties which are removed
+	Append map[string]interface{} // values appended to array properties
+}
+
+// BatchUpdateObject is a partial update of one object in a batch, Err is set
+// if the update failed
+type BatchUpdateObject struct {
+	OriginalIndex int
+	UUID          strfmt.UUID
+	Changes       PropertyChanges
+	Err           error
+}
+
+// BatchUpdateObjects groups many partial updates together. The order matches
+// the order from the original request.
+type BatchUpdateObjects []BatchUpdateObject
+
+// BatchUpdateParams selects the objects which are updated with the same
+// changes by filter
+type BatchUpdateParams struct {
+	ClassName  schema.ClassName
+	Filters    *filters.LocalFilter
+	Changes    PropertyChanges
+	UpdateTime int64
+	DryRun     bool
+	Output     string
+	// After continues a previous update, only objects with a greater id are
+	// updated
+	After strfmt.UUID
+}
+
+// BatchUpdateResult describes an update of at most Limit objects, ordered by
+// id. Next is set if more objects match the filter, it is passed as After to
+// continue the update.
+type BatchUpdateResult struct {
+	Matches int64
+	Limit   int64
+	Updated int64
+	DryRun  bool
+	Objects BatchSimpleObjects
+	Next    strfmt.UUID
+}
+
+type BatchUpdateResponse struct {
+	Match  *models.BatchUpdateMatch
+	DryRun bool
+	Output string
+	Result BatchUpdateResult
+}
+
 type BatchDeleteParams struct {
 	ClassName schema.ClassName     `json:"className"`
 	Filters   *filters.LocalFilter `json:"filters"`
@@ -0,0 +1,535 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/classcache"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/verbosity"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
+)
+
+// UpdateObjects applies partial updates to existing objects of a class.
+// Properties which are not changed keep their values, objects are only
+// re-vectorized if a property used by the vectorizer changed. Updates of
+// objects which do not exist fail with ErrNotFound. Re-vectorized objects are
+// only updated if they did not change since they were read for vectorization,
+// otherwise the update fails with ErrPreconditionFailed and can be retried.
+func (b *BatchManager) UpdateObjects(ctx context.Context, principal *models.Principal,
+	className string, objects BatchUpdateObjects,
+	repl *additional.ReplicationProperties, tenant string,
+) (BatchUpdateObjects, error) {
+	err := b.authorizer.Authorize(principal, "update", "batch/objects")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = classcache.ContextWithClassCache(ctx)
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	b.metrics.BatchInc()
+	defer b.metrics.BatchDec()
+
+	if len(objects) == 0 {
+		return nil, errEmptyObjects
+	}
+
+	class, schemaVersion, err := b.updateClass(ctx, principal, className)
+	if err != nil {
+		return nil, err
+	}
+
+	validator := validation.New(b.vectorRepo.Exists, b.config, repl)
+	for i := range objects {
+		objects[i].OriginalIndex = i
+		if _, err := uuid.Parse(objects[i].UUID.String()); err != nil {
+			objects[i].Err = NewErrInvalidUserInput("invalid uuid %q: %v", objects[i].UUID, err)
+			continue
+		}
+		changes, err := validateChanges(ctx, validator, class, tenant, objects[i].Changes)
+		if err != nil {
+			objects[i].Err = NewErrInvalidUserInput("invalid changes: %v", err)
+			continue
+		}
+		objects[i].Changes = changes
+	}
+
+	return b.updateObjects(ctx, class, schemaVersion, objects, repl, tenant)
+}
+
+// UpdateObjectsByFilter applies the same partial update to the objects of the
+// class matching the filter, see UpdateObjects. A single call updates at most
+// QUERY_MAXIMUM_RESULTS objects, BatchUpdateResult.Next continues the update.
+// With DryRun set the matching objects are only listed.
+func (b *BatchManager) UpdateObjectsByFilter(ctx context.Context, principal *models.Principal,
+	params BatchUpdateParams, repl *additional.ReplicationProperties, tenant string,
+) (BatchUpdateResult, error) {
+	err := b.authorizer.Authorize(principal, "update", "batch/objects")
+	if err != nil {
+		return BatchUpdateResult{}, err
+	}
+
+	ctx = classcache.ContextWithClassCache(ctx)
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return BatchUpdateResult{}, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	b.metrics.BatchInc()
+	defer b.metrics.BatchDec()
+
+	return b.updateObjectsByFilter(ctx, principal, params, repl, tenant)
+}
+
+// UpdateObjectsByMatch applies the partial update to all objects matching
+// the match clause, see UpdateObjectsByFilter
+func (b *BatchManager) UpdateObjectsByMatch(ctx context.Context, principal *models.Principal,
+	update *models.BatchUpdate, repl *additional.ReplicationProperties, tenant string,
+) (*BatchUpdateResponse, error) {
+	err := b.authorizer.Authorize(principal, "update", "batch/objects")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = classcache.ContextWithClassCache(ctx)
+
+	unlock, err := b.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	b.metrics.BatchInc()
+	defer b.metrics.BatchDec()
+
+	params, err := validateBatchUpdate(update)
+	if err != nil {
+		return nil, NewErrInvalidUserInput("validate: %v", err)
+	}
+
+	result, err := b.updateObjectsByFilter(ctx, principal, *params, repl, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BatchUpdateResponse{
+		Match:  update.Match,
+		DryRun: result.DryRun,
+		Output: params.Output,
+		Result: result,
+	}, nil
+}
+
+func validateBatchUpdate(update *models.BatchUpdate) (*BatchUpdateParams, error) {
+	if update == nil || update.Match == nil {
+		return nil, errors.New("empty match clause")
+	}
+
+	if len(update.Match.Class) == 0 {
+		return nil, errors.New("empty match.class clause")
+	}
+
+	if update.Match.Where == nil {
+		return nil, errors.New("empty match.where clause")
+	}
+
+	filter, err := filterext.Parse(update.Match.Where, update.Match.Class)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse where filter: %s", err)
+	}
+
+	changes := PropertyChanges{Unset: update.Unset}
+	if update.Set != nil {
+		set, ok := update.Set.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("set must be an object, got %T", update.Set)
+		}
+		changes.Set = set
+	}
+	if update.Append != nil {
+		appended, ok := update.Append.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("append must be an object, got %T", update.Append)
+		}
+		changes.Append = appended
+	}
+
+	output, err := verbosity.ParseOutput(update.Output)
+	if err != nil {
+		return nil, err
+	}
+
+	dryRun := false
+	if update.DryRun != nil {
+		dryRun = *update.DryRun
+	}
+
+	return &BatchUpdateParams{
+		ClassName: schema.ClassName(update.Match.Class),
+		Filters:   filter,
+		Changes:   changes,
+		DryRun:    dryRun,
+		Output:    output,
+		After:     update.After,
+	}, nil
+}
+
+func (b *BatchManager) updateObjectsByFilter(ctx context.Context, principal *models.Principal,
+	params BatchUpdateParams, repl *additional.ReplicationProperties, tenant string,
+) (BatchUpdateResult, error) {
+	if params.Filters == nil {
+		return BatchUpdateResult{}, NewErrInvalidUserInput("empty filter")
+	}
+	if err := filters.ValidateFilters(b.schemaManager.ReadOnlyClass, params.Filters); err != nil {
+		return BatchUpdateResult{}, NewErrInvalidUserInput("invalid filter: %v", err)
+	}
+
+	class, schemaVersion, err := b.updateClass(ctx, principal, params.ClassName.String())
+	if err != nil {
+		return BatchUpdateResult{}, err
+	}
+
+	validator := validation.New(b.vectorRepo.Exists, b.config, repl)
+	if params.Changes, err = validateChanges(ctx, validator, class, tenant, params.Changes); err != nil {
+		return BatchUpdateResult{}, NewErrInvalidUserInput("invalid changes: %v", err)
+	}
+	params.UpdateTime = time.Now().UnixNano() / int64(time.Millisecond)
+
+	// objects of vectorized classes are re-vectorized one by one, the
+	// shards only list the matching objects then
+	vectorize := hasVectorizer(class) && !params.DryRun
+	if vectorize {
+		params.DryRun = true
+	}
+
+	// Ensure that the local schema has caught up to the version we used to validate
+	if err := b.schemaManager.WaitForUpdate(ctx, schemaVersion); err != nil {
+		return BatchUpdateResult{}, fmt.Errorf("error waiting for local schema to catch up to version %d: %w", schemaVersion, err)
+	}
+	result, err := b.vectorRepo.BatchUpdateObjects(ctx, params, repl, tenant, schemaVersion)
+	if err != nil {
+		return BatchUpdateResult{}, fmt.Errorf("batch update objects: %w", err)
+	}
+	if !vectorize {
+		return result, nil
+	}
+
+	objects := make(BatchUpdateObjects, len(result.Objects))
+	for i, obj := range result.Objects {
+		objects[i] = BatchUpdateObject{OriginalIndex: i, UUID: obj.UUID, Changes: params.Changes}
+	}
+	if objects, err = b.updateObjects(ctx, class, schemaVersion, objects, repl, tenant); err != nil {
+		return BatchUpdateResult{}, err
+	}
+	result.DryRun = false
+	for i := range objects {
+		result.Objects[i].Err = objects[i].Err
+		if objects[i].Err == nil {
+			result.Updated++
+		}
+	}
+	return result, nil
+}
+
+func (b *BatchManager) updateClass(ctx context.Context, principal *models.Principal,
+	className string,
+) (*models.Class, uint64, error) {
+	vclasses, err := b.schemaManager.GetCachedClass(ctx, principal, className)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(vclasses) == 0 || vclasses[className].Class == nil {
+		return nil, 0, NewErrInvalidUserInput("class '%v' not present in schema", className)
+	}
+	return vclasses[className].Class, vclasses[className].Version, nil
+}
+
+func (b *BatchManager) updateObjects(ctx context.Context, class *models.Class,
+	schemaVersion uint64, objects BatchUpdateObjects,
+	repl *additional.ReplicationProperties, tenant string,
+) (BatchUpdateObjects, error) {
+	var vectorized map[int]*models.Object
+	if hasVectorizer(class) {
+		vectorized = b.vectorizeUpdates(ctx, class, objects, repl, tenant)
+	}
+
+	var (
+		now     = time.Now().UnixNano() / int64(time.Millisecond)
+		docs    = make([]MergeDocument, 0, len(objects))
+		indices = make([]int, 0, len(objects))
+	)
+	for i, obj := range objects {
+		if obj.Err != nil {
+			continue
+		}
+		doc := MergeDocument{
+			Class:              class.Class,
+			ID:                 obj.UUID,
+			PrimitiveSchema:    obj.Changes.Set,
+			PropertiesToDelete: obj.Changes.Unset,
+			PropertiesToAppend: obj.Changes.Append,
+			UpdateTime:         now,
+			RequireExisting:    true,
+		}
+		// without new vectors the stored ones are kept
+		if vectorized[i] != nil {
+			doc.Vector = vectorized[i].Vector
+			doc.Vectors = vectorized[i].Vectors
+			// the vectors were derived from this version of the object, a
+			// concurrent write must not be merged with them
+			version := vectorized[i].LastUpdateTimeUnix
+			doc.ExpectedVersion = &version
+		}
+		docs = append(docs, doc)
+		indices = append(indices, i)
+	}
+	if len(docs) == 0 {
+		return objects, nil
+	}
+
+	// Ensure that the local schema has caught up to the version we used to validate
+	if err := b.schemaManager.WaitForUpdate(ctx, schemaVersion); err != nil {
+		return nil, fmt.Errorf("error waiting for local schema to catch up to version %d: %w", schemaVersion, err)
+	}
+	for i, err := range b.vectorRepo.BatchMergeObjects(ctx, docs, repl, tenant, schemaVersion) {
+		objects[indices[i]].Err = err
+	}
+
+	return objects, nil
+}
+
+// vectorizeUpdates applies the changes to the stored objects and vectorizes
+// the result. Vectors of objects whose vectorized properties did not change
+// are reused. The returned objects are keyed by their position in objects,
+// their LastUpdateTimeUnix is the version of the stored object they are based
+// on.
+func (b *BatchManager) vectorizeUpdates(ctx context.Context, class *models.Class,
+	objects BatchUpdateObjects, repl *additional.ReplicationProperties, tenant string,
+) map[int]*models.Object {
+	var (
+		out      = make(map[int]*models.Object, len(objects))
+		toUpdate = make([]*models.Object, 0, len(objects))
+		prevVecs = make([]models.Vectors, 0, len(objects))
+		versions = make([]int64, 0, len(objects))
+		indices  = make([]int, 0, len(objects))
+	)
+	for i := range objects {
+		if objects[i].Err != nil {
+			continue
+		}
+		res, err := b.vectorRepo.Object(ctx, class.Class, objects[i].UUID, nil,
+			additional.Properties{}, repl, tenant)
+		if err != nil {
+			objects[i].Err = err
+			continue
+		}
+		if res == nil {
+			objects[i].Err = NewErrNotFound("object %s does not exist", objects[i].UUID)
+			continue
+		}
+		prev := res.Object()
+		toUpdate = append(toUpdate, &models.Object{
+			Class:      class.Class,
+			ID:         objects[i].UUID,
+			Tenant:     tenant,
+			Properties: applyChanges(prev.Properties, objects[i].Changes),
+		})
+		versions = append(versions, prev.LastUpdateTimeUnix)
+		prevVecs = append(prevVecs, prev.Vectors)
+		indices = append(indices, i)
+	}
+	if len(toUpdate) == 0 {
+		return out
+	}
+
+	errs, err := b.modulesProvider.BatchUpdateVector(ctx, class, toUpdate, b.findObject, b.logger)
+	for i, obj := range toUpdate {
+		pos := indices[i]
+		if err != nil {
+			objects[pos].Err = err
+			continue
+		}
+		if errs[i] != nil {
+			objects[pos].Err = errs[i]
+			continue
+		}
+		// named vectors which are not produced by a vectorizer are kept,
+		// the merge replaces all named vectors at once
+		for name, vec := range prevVecs[i] {
+			if _, ok := obj.Vectors[name]; !ok && len(obj.Vectors) > 0 {
+				obj.Vectors[name] = vec
+			}
+		}
+		obj.LastUpdateTimeUnix = versions[i]
+		out[pos] = obj
+	}
+
+	return out
+}
+
+// validateChanges validates set and appended values against the class and
+// returns the changes with normalized property names
+func validateChanges(ctx context.Context, validator *validation.Validator,
+	class *models.Class, tenant string, changes PropertyChanges,
+) (PropertyChanges, error) {
+	if len(changes.Set) == 0 && len(changes.Unset) == 0 && len(changes.Append) == 0 {
+		return changes, fmt.Errorf("no properties to set, unset or append")
+	}
+
+	out := PropertyChanges{}
+	seen := map[string]struct{}{}
+	checkProp := func(name string) (*models.Property, error) {
+		name = schema.LowercaseFirstLetter(name)
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[name]; ok {
+			return nil, fmt.Errorf("property %q is changed more than once", name)
+		}
+		seen[name] = struct{}{}
+		if _, ok := schema.AsPrimitive(prop.DataType); !ok && !schema.IsNested(schema.DataType(prop.DataType[0])) {
+			return nil, fmt.Errorf("reference property %q cannot be updated partially", name)
+		}
+		return prop, nil
+	}
+
+	for _, name := range changes.Unset {
+		if _, err := checkProp(name); err != nil {
+			return out, err
+		}
+		out.Unset = append(out.Unset, schema.LowercaseFirstLetter(name))
+	}
+
+	for name := range changes.Set {
+		if _, err := checkProp(name); err != nil {
+			return out, err
+		}
+	}
+	if len(changes.Set) > 0 {
+		set, err := validateProperties(ctx, validator, class, tenant, changes.Set)
+		if err != nil {
+			return out, err
+		}
+		out.Set = set
+	}
+
+	for name := range changes.Append {
+		prop, err := checkProp(name)
+		if err != nil {
+			return out, err
+		}
+		if !schema.IsArrayDataType(prop.DataType) {
+			return out, fmt.Errorf("cannot append to property %q of type %v, it is not an array",
+				prop.Name, prop.DataType)
+		}
+	}
+	if len(changes.Append) > 0 {
+		appended, err := validateProperties(ctx, validator, class, tenant, changes.Append)
+		if err != nil {
+			return out, err
+		}
+		out.Append = appended
+	}
+
+	return out, nil
+}
+
+func validateProperties(ctx context.Context, validator *validation.Validator,
+	class *models.Class, tenant string, props map[string]interface{},
+) (map[string]interface{}, error) {
+	obj := &models.Object{Class: class.Class, Tenant: tenant, Properties: props}
+	if err := validator.Object(ctx, class, obj, nil); err != nil {
+		return nil, err
+	}
+	return obj.Properties.(map[string]interface{}), nil
+}
+
+// applyChanges returns a copy of props with the changes applied
+func applyChanges(props models.PropertySchema, changes PropertyChanges) map[string]interface{} {
+	out := map[string]interface{}{}
+	if prev, ok := props.(map[string]interface{}); ok {
+		for name, value := range prev {
+			out[name] = value
+		}
+	}
+	for _, name := range changes.Unset {
+		delete(out, name)
+	}
+	for name, value := range changes.Set {
+		out[name] = value
+	}
+	for name, values := range changes.Append {
+		out[name] = AppendValues(out[name], values)
+	}
+	return out
+}
+
+// AppendValues appends values to the array property prop. The result keeps
+// the slice type if both have the same type, otherwise it is untyped, which
+// happens e.g. for values which were sent to a remote replica as json.
+func AppendValues(prop, values interface{}) interface{} {
+	prev, next := reflect.ValueOf(prop), reflect.ValueOf(values)
+	if prop == nil || prev.Kind() != reflect.Slice || next.Kind() != reflect.Slice {
+		return values
+	}
+
+	if prev.Type() == next.Type() {
+		out := reflect.MakeSlice(prev.Type(), 0, prev.Len()+next.Len())
+		return reflect.AppendSlice(reflect.AppendSlice(out, prev), next).Interface()
+	}
+
+	out := make([]interface{}, 0, prev.Len()+next.Len())
+	for i := 0; i < prev.Len(); i++ {
+		out = append(out, prev.Index(i).Interface())
+	}
+	for i := 0; i < next.Len(); i++ {
+		out = append(out, next.Index(i).Interface())
+	}
+	return out
+}
+
+// hasVectorizer is true if a module creates vectors for the class
+func hasVectorizer(class *models.Class) bool {
+	if class.Vectorizer != "" && class.Vectorizer != config.VectorizerModuleNone {
+		return true
+	}
+	for _, vectorConfig := range class.VectorConfig {
+		vectorizer, ok := vectorConfig.Vectorizer.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := vectorizer[config.VectorizerModuleNone]; !ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_BatchManager_UpdateObjects(t *testing.T) {
+	var (
+		vectorRepo      *fakeVectorRepo
+		modulesProvider *fakeModulesProvider
+		manager         *BatchManager
+		ctx             = context.Background()
+		id1             = strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+		id2             = strfmt.UUID("6a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	)
+
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Vectorizer:        config.VectorizerModuleNone,
+					Class:             "Foo",
+					VectorIndexConfig: hnsw.UserConfig{},
+					Properties: []*models.Property{
+						{Name: "popularity", DataType: schema.DataTypeInt.PropString()},
+						{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+						{Name: "title", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+				{
+					Vectorizer:        "text2vec-contextionary",
+					Class:             "Vectorized",
+					VectorIndexConfig: hnsw.UserConfig{},
+					Properties: []*models.Property{
+						{Name: "title", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+			},
+		},
+	}
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		modulesProvider = getFakeModulesProvider()
+		logger, _ := test.NewNullLogger()
+		manager = NewBatchManager(vectorRepo, modulesProvider, &fakeLocks{},
+			&fakeSchemaManager{GetSchemaResponse: sch}, &config.WeaviateConfig{},
+			logger, &fakeAuthorizer{}, nil)
+	}
+
+	t.Run("without any objects", func(t *testing.T) {
+		reset()
+		_, err := manager.UpdateObjects(ctx, nil, "Foo", nil, nil, "")
+		assert.Equal(t, errEmptyObjects, err)
+	})
+
+	t.Run("merges the changes without vectors", func(t *testing.T) {
+		reset()
+		notFound := NewErrNotFound("object %s does not exist", id2)
+		vectorRepo.On("BatchMergeObjects", mock.Anything).Return([]error{nil, notFound}).Once()
+
+		res, err := manager.UpdateObjects(ctx, nil, "Foo", BatchUpdateObjects{
+			{UUID: id1, Changes: PropertyChanges{
+				Set:    map[string]interface{}{"popularity": int64(7)},
+				Unset:  []string{"Title"},
+				Append: map[string]interface{}{"tags": []interface{}{"new"}},
+			}},
+			{UUID: id2, Changes: PropertyChanges{Set: map[string]interface{}{"popularity": int64(3)}}},
+		}, nil, "")
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Nil(t, res[0].Err)
+		assert.Equal(t, notFound, res[1].Err)
+
+		docs := vectorRepo.Calls[0].Arguments[0].([]MergeDocument)
+		require.Len(t, docs, 2)
+		assert.Equal(t, id1, docs[0].ID)
+		assert.True(t, docs[0].RequireExisting)
+		assert.Equal(t, []string{"title"}, docs[0].PropertiesToDelete)
+		assert.Equal(t, map[string]interface{}{"popularity": float64(7)}, docs[0].PrimitiveSchema)
+		assert.Equal(t, map[string]interface{}{"tags": []string{"new"}}, docs[0].PropertiesToAppend)
+		assert.Nil(t, docs[0].Vector)
+		assert.Nil(t, docs[0].Vectors)
+	})
+
+	t.Run("re-vectorized objects expect the version they were read in", func(t *testing.T) {
+		reset()
+		vectorRepo.On("Object", "Vectorized", id1, mock.Anything, mock.Anything, "").
+			Return(&search.Result{
+				ID: id1, ClassName: "Vectorized", Updated: 100,
+				Schema: map[string]interface{}{"title": "old"},
+			}, nil).Once()
+		modulesProvider.On("BatchUpdateVector").Return([]float32{1, 2, 3}, nil).Once()
+		changed := NewErrPreconditionFailed("object %s has version 101, expected version 100", id1)
+		vectorRepo.On("BatchMergeObjects", mock.Anything).Return([]error{changed}).Once()
+
+		res, err := manager.UpdateObjects(ctx, nil, "Vectorized", BatchUpdateObjects{
+			{UUID: id1, Changes: PropertyChanges{Set: map[string]interface{}{"title": "new"}}},
+		}, nil, "")
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.ErrorAs(t, res[0].Err, &ErrPreconditionFailed{})
+
+		docs := vectorRepo.Calls[1].Arguments[0].([]MergeDocument)
+		require.Len(t, docs, 1)
+		assert.Equal(t, []float32{1, 2, 3}, docs[0].Vector)
+		require.NotNil(t, docs[0].ExpectedVersion)
+		assert.Equal(t, int64(100), *docs[0].ExpectedVersion)
+	})
+
+	t.Run("with invalid changes", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchMergeObjects", mock.Anything).Return([]error{nil}).Once()
+
+		res, err := manager.UpdateObjects(ctx, nil, "Foo", BatchUpdateObjects{
+			{UUID: id1, Changes: PropertyChanges{Append: map[string]interface{}{"title": []interface{}{"a"}}}},
+			{UUID: id1, Changes: PropertyChanges{Set: map[string]interface{}{"unknown": "a"}}},
+			{UUID: id1, Changes: PropertyChanges{
+				Set:   map[string]interface{}{"title": "a"},
+				Unset: []string{"title"},
+			}},
+			{UUID: id1},
+			{UUID: "invalid", Changes: PropertyChanges{Unset: []string{"title"}}},
+			{UUID: id2, Changes: PropertyChanges{Unset: []string{"title"}}},
+		}, nil, "")
+		require.Nil(t, err)
+		for i := 0; i < 5; i++ {
+			assert.ErrorAs(t, res[i].Err, &ErrInvalidUserInput{}, "object %d", i)
+		}
+		assert.Nil(t, res[5].Err)
+
+		docs := vectorRepo.Calls[0].Arguments[0].([]MergeDocument)
+		require.Len(t, docs, 1)
+		assert.Equal(t, id2, docs[0].ID)
+	})
+
+	t.Run("by filter", func(t *testing.T) {
+		reset()
+		filter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: "Foo", Property: "title"},
+			Value:    &filters.Value{Value: "a", Type: schema.DataTypeText},
+		}}
+		expected := BatchUpdateResult{Matches: 2, Updated: 2, Objects: BatchSimpleObjects{{UUID: id1}, {UUID: id2}}}
+		vectorRepo.On("BatchUpdateObjects", mock.Anything).Return(expected, nil).Once()
+
+		res, err := manager.UpdateObjectsByFilter(ctx, nil, BatchUpdateParams{
+			ClassName: "Foo",
+			Filters:   filter,
+			Changes:   PropertyChanges{Append: map[string]interface{}{"Tags": []interface{}{"x"}}},
+		}, nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, expected, res)
+
+		params := vectorRepo.Calls[0].Arguments[0].(BatchUpdateParams)
+		assert.Equal(t, filter, params.Filters)
+		assert.False(t, params.DryRun)
+		assert.NotZero(t, params.UpdateTime)
+		assert.Equal(t, map[string]interface{}{"tags": []string{"x"}}, params.Changes.Append)
+	})
+
+	t.Run("by filter fails", func(t *testing.T) {
+		reset()
+		filter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: "Foo", Property: "title"},
+			Value:    &filters.Value{Value: "a", Type: schema.DataTypeText},
+		}}
+		vectorRepo.On("BatchUpdateObjects", mock.Anything).Return(BatchUpdateResult{}, errors.New("shard down")).Once()
+
+		_, err := manager.UpdateObjectsByFilter(ctx, nil, BatchUpdateParams{
+			ClassName: "Foo",
+			Filters:   filter,
+			Changes:   PropertyChanges{Unset: []string{"tags"}},
+		}, nil, "")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("by filter without filter", func(t *testing.T) {
+		reset()
+		_, err := manager.UpdateObjectsByFilter(ctx, nil, BatchUpdateParams{
+			ClassName: "Foo",
+			Changes:   PropertyChanges{Unset: []string{"tags"}},
+		}, nil, "")
+		assert.ErrorAs(t, err, &ErrInvalidUserInput{})
+	})
+
+	t.Run("by match", func(t *testing.T) {
+		reset()
+		vectorRepo.On("BatchUpdateObjects", mock.Anything).
+			Return(BatchUpdateResult{Matches: 1, DryRun: true, Objects: BatchSimpleObjects{{UUID: id1}}}, nil).Once()
+
+		dryRun := true
+		output := "verbose"
+		match := &models.BatchUpdateMatch{
+			Class: "Foo",
+			Where: &models.WhereFilter{
+				Operator:  "Equal",
+				Path:      []string{"title"},
+				ValueText: ptString("a"),
+			},
+		}
+		res, err := manager.UpdateObjectsByMatch(ctx, nil, &models.BatchUpdate{
+			Match:  match,
+			Set:    map[string]interface{}{"popularity": float64(1)},
+			Unset:  []string{"title"},
+			DryRun: &dryRun,
+			Output: &output,
+		}, nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, match, res.Match)
+		assert.True(t, res.DryRun)
+		assert.Equal(t, output, res.Output)
+		assert.Equal(t, int64(1), res.Result.Matches)
+
+		params := vectorRepo.Calls[0].Arguments[0].(BatchUpdateParams)
+		assert.Equal(t, schema.ClassName("Foo"), params.ClassName)
+		assert.True(t, params.DryRun)
+		assert.Equal(t, []string{"title"}, params.Changes.Unset)
+	})
+
+	t.Run("by match with invalid request", func(t *testing.T) {
+		reset()
+		where := &models.WhereFilter{Operator: "Equal", Path: []string{"title"}, ValueText: ptString("a")}
+		for _, update := range []*models.BatchUpdate{
+			nil,
+			{Unset: []string{"title"}},
+			{Match: &models.BatchUpdateMatch{Where: where}, Unset: []string{"title"}},
+			{Match: &models.BatchUpdateMatch{Class: "Foo"}, Unset: []string{"title"}},
+			{Match: &models.BatchUpdateMatch{Class: "Foo", Where: where}, Set: "title"},
+			{Match: &models.BatchUpdateMatch{Class: "Foo", Where: where}},
+		} {
+			_, err := manager.UpdateObjectsByMatch(ctx, nil, update, nil, "")
+			assert.ErrorAs(t, err, &ErrInvalidUserInput{})
+		}
+		vectorRepo.AssertNotCalled(t, "BatchUpdateObjects", mock.Anything)
+	})
+}
+
+func Test_AppendValues(t *testing.T) {
+	assert.Equal(t, []string{"a"}, AppendValues(nil, []string{"a"}))
+	assert.Equal(t, []string{"a", "b"}, AppendValues([]string{"a"}, []string{"b"}))
+	assert.Equal(t, []interface{}{"a", "b"}, AppendValues([]string{"a"}, []interface{}{"b"}))
+	assert.Equal(t, []interface{}{1.5, 2.5}, AppendValues([]interface{}{1.5}, []float64{2.5}))
+
+	prev := make([]float64, 1, 10)
+	next := AppendValues(prev, []float64{1})
+	assert.Equal(t, []float64{0, 1}, next)
+	assert.Equal(t, 1, len(prev), "previous values are not modified")
+}
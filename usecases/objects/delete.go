@@ -13,6 +13,7 @@ package objects
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-openapi/strfmt"
@@ -26,9 +27,12 @@ import (
 //
 // if class == "" it will delete all object with same id regardless of the class name.
 // This is due to backward compatibility reasons and should be removed in the future
+//
+// If expectedVersion is set, the object is only deleted if its
+// lastUpdateTimeUnix still matches, otherwise ErrPreconditionFailed is returned.
 func (m *Manager) DeleteObject(ctx context.Context,
 	principal *models.Principal, class string, id strfmt.UUID,
-	repl *additional.ReplicationProperties, tenant string,
+	repl *additional.ReplicationProperties, tenant string, expectedVersion *int64,
 ) error {
 	path := fmt.Sprintf("objects/%s/%s", class, id)
 	if class == "" {
@@ -56,6 +60,9 @@ func (m *Manager) DeleteObject(ctx context.Context,
 	defer m.metrics.DeleteObjectDec()
 
 	if class == "" { // deprecated
+		if expectedVersion != nil {
+			return NewErrInvalidUserInput("version preconditions require a class name")
+		}
 		return m.deleteObjectFromRepo(ctx, id)
 	}
 
@@ -74,6 +81,9 @@ func (m *Manager) DeleteObject(ctx context.Context,
 		}
 	}
 	if !ok {
+		if expectedVersion != nil && *expectedVersion != 0 {
+			return NewErrPreconditionFailed("object %s does not exist, expected version %d", id, *expectedVersion)
+		}
 		return NewErrNotFound("object %v could not be found", path)
 	}
 
@@ -81,7 +91,10 @@ func (m *Manager) DeleteObject(ctx context.Context,
 	if err := m.schemaManager.WaitForUpdate(ctx, vclasses[class].Version); err != nil {
 		return fmt.Errorf("error waiting for local schema to catch up to version %d: %w", vclasses[class].Version, err)
 	}
-	if err = m.vectorRepo.DeleteObject(ctx, class, id, repl, tenant, vclasses[class].Version); err != nil {
+	if err = m.vectorRepo.DeleteObject(ctx, class, id, expectedVersion, repl, tenant, vclasses[class].Version); err != nil {
+		if errors.As(err, &ErrPreconditionFailed{}) {
+			return err
+		}
 		return NewErrInternal("could not delete object from vector repo: %v", err)
 	}
 	return nil
@@ -110,7 +123,7 @@ func (m *Manager) deleteObjectFromRepo(ctx context.Context, id strfmt.UUID) erro
 		}
 
 		object := objectRes.Object()
-		err = m.vectorRepo.DeleteObject(ctx, object.Class, id, nil, "", 0)
+		err = m.vectorRepo.DeleteObject(ctx, object.Class, id, nil, nil, "", 0)
 		if err != nil {
 			return NewErrInternal("could not delete object from vector repo: %v", err)
 		}
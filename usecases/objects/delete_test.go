@@ -37,7 +37,7 @@ func Test_DeleteObjectsWithSameId(t *testing.T) {
 	vectorRepo.On("ObjectByID", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
 	vectorRepo.On("DeleteObject", cls, id).Return(nil).Once()
 
-	err := manager.DeleteObject(context.Background(), nil, "", id, nil, "")
+	err := manager.DeleteObject(context.Background(), nil, "", id, nil, "", nil)
 	assert.Nil(t, err)
 	vectorRepo.AssertExpectations(t)
 }
@@ -53,13 +53,13 @@ func Test_DeleteObject(t *testing.T) {
 	repo.On("DeleteObject", cls, id).Return(nil).Once()
 	repo.On("Exists", cls, id).Return(true, nil).Once()
 
-	err := manager.DeleteObject(context.Background(), nil, cls, id, nil, "")
+	err := manager.DeleteObject(context.Background(), nil, cls, id, nil, "", nil)
 	assert.Nil(t, err)
 	repo.AssertExpectations(t)
 
 	// delete non existing object
 	repo.On("Exists", cls, id).Return(false, nil).Once()
-	err = manager.DeleteObject(context.Background(), nil, cls, id, nil, "")
+	err = manager.DeleteObject(context.Background(), nil, cls, id, nil, "", nil)
 	if _, ok := err.(ErrNotFound); !ok {
 		t.Errorf("error type got: %T want: ErrNotFound", err)
 	}
@@ -67,7 +67,7 @@ func Test_DeleteObject(t *testing.T) {
 
 	// return internal error if exists() fails
 	repo.On("Exists", cls, id).Return(false, errNotFound).Once()
-	err = manager.DeleteObject(context.Background(), nil, cls, id, nil, "")
+	err = manager.DeleteObject(context.Background(), nil, cls, id, nil, "", nil)
 	if _, ok := err.(ErrInternal); !ok {
 		t.Errorf("error type got: %T want: ErrInternal", err)
 	}
@@ -76,13 +76,40 @@ func Test_DeleteObject(t *testing.T) {
 	// return internal error if deleteObject() fails
 	repo.On("DeleteObject", cls, id).Return(errNotFound).Once()
 	repo.On("Exists", cls, id).Return(true, nil).Once()
-	err = manager.DeleteObject(context.Background(), nil, cls, id, nil, "")
+	err = manager.DeleteObject(context.Background(), nil, cls, id, nil, "", nil)
 	if _, ok := err.(ErrInternal); !ok {
 		t.Errorf("error type got: %T want: ErrInternal", err)
 	}
 	repo.AssertExpectations(t)
 }
 
+func Test_DeleteObjectWithExpectedVersion(t *testing.T) {
+	var (
+		cls     = "MyClass"
+		id      = strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+		version = int64(100)
+	)
+
+	manager, repo := newDeleteDependency()
+
+	// the stored object has another version
+	repo.On("Exists", cls, id).Return(true, nil).Once()
+	repo.On("DeleteObject", cls, id).Return(NewErrPreconditionFailed("version mismatch")).Once()
+	err := manager.DeleteObject(context.Background(), nil, cls, id, nil, "", &version)
+	if _, ok := err.(ErrPreconditionFailed); !ok {
+		t.Errorf("error type got: %T want: ErrPreconditionFailed", err)
+	}
+	repo.AssertExpectations(t)
+
+	// the object does not exist anymore
+	repo.On("Exists", cls, id).Return(false, nil).Once()
+	err = manager.DeleteObject(context.Background(), nil, cls, id, nil, "", &version)
+	if _, ok := err.(ErrPreconditionFailed); !ok {
+		t.Errorf("error type got: %T want: ErrPreconditionFailed", err)
+	}
+	repo.AssertExpectations(t)
+}
+
 func newDeleteDependency() (*Manager, *fakeVectorRepo) {
 	vectorRepo := new(fakeVectorRepo)
 	logger, _ := test.NewNullLogger()
@@ -20,6 +20,7 @@ const (
 	StatusForbidden           = 403
 	StatusBadRequest          = 400
 	StatusNotFound            = 404
+	StatusPreconditionFailed  = 412
 	StatusUnprocessableEntity = 422
 	StatusInternalServerError = 500
 )
@@ -56,6 +57,10 @@ func (e *Error) UnprocessableEntity() bool {
 	return e.Code == StatusUnprocessableEntity
 }
 
+func (e *Error) PreconditionFailed() bool {
+	return e.Code == StatusPreconditionFailed
+}
+
 // ErrInvalidUserInput indicates a client-side error
 type ErrInvalidUserInput struct {
 	msg string
@@ -98,6 +103,21 @@ func NewErrNotFound(format string, args ...interface{}) ErrNotFound {
 	return ErrNotFound{msg: fmt.Sprintf(format, args...)}
 }
 
+// ErrPreconditionFailed indicates that the stored object does not have the
+// version the write expected
+type ErrPreconditionFailed struct {
+	msg string
+}
+
+func (e ErrPreconditionFailed) Error() string {
+	return e.msg
+}
+
+// NewErrPreconditionFailed with Errorf signature
+func NewErrPreconditionFailed(format string, args ...interface{}) ErrPreconditionFailed {
+	return ErrPreconditionFailed{msg: fmt.Sprintf(format, args...)}
+}
+
 type ErrMultiTenancy struct {
 	err error
 }
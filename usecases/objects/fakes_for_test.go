@@ -261,6 +261,13 @@ func (f *fakeVectorRepo) BatchPutObjects(ctx context.Context, batch BatchObjects
 	return batch, args.Error(0)
 }
 
+func (f *fakeVectorRepo) BatchPutObjectsTransactional(ctx context.Context, batch BatchObjects,
+	repl *additional.ReplicationProperties, schemaVersion uint64,
+) (BatchObjects, error) {
+	args := f.Called(batch)
+	return batch, args.Error(0)
+}
+
 func (f *fakeVectorRepo) AddBatchReferences(ctx context.Context, batch BatchReferences,
 	repl *additional.ReplicationProperties, schemaVersion uint64,
 ) (BatchReferences, error) {
@@ -275,13 +282,27 @@ func (f *fakeVectorRepo) BatchDeleteObjects(ctx context.Context, params BatchDel
 	return args.Get(0).(BatchDeleteResult), args.Error(1)
 }
 
+func (f *fakeVectorRepo) BatchMergeObjects(ctx context.Context, docs []MergeDocument,
+	repl *additional.ReplicationProperties, tenant string, schemaVersion uint64,
+) []error {
+	args := f.Called(docs)
+	return args.Get(0).([]error)
+}
+
+func (f *fakeVectorRepo) BatchUpdateObjects(ctx context.Context, params BatchUpdateParams,
+	repl *additional.ReplicationProperties, tenant string, schemaVersion uint64,
+) (BatchUpdateResult, error) {
+	args := f.Called(params)
+	return args.Get(0).(BatchUpdateResult), args.Error(1)
+}
+
 func (f *fakeVectorRepo) Merge(ctx context.Context, merge MergeDocument, repl *additional.ReplicationProperties, tenant string, schemaVersion uint64) error {
 	args := f.Called(merge)
 	return args.Error(0)
 }
 
 func (f *fakeVectorRepo) DeleteObject(ctx context.Context, className string,
-	id strfmt.UUID, repl *additional.ReplicationProperties, tenant string, schemaVersion uint64,
+	id strfmt.UUID, expectedVersion *int64, repl *additional.ReplicationProperties, tenant string, schemaVersion uint64,
 ) error {
 	args := f.Called(className, id)
 	return args.Error(0)
@@ -126,8 +126,11 @@ type authorizer interface {
 type VectorRepo interface {
 	PutObject(ctx context.Context, concept *models.Object, vector []float32, vectors models.Vectors,
 		repl *additional.ReplicationProperties, schemaVersion uint64) error
-	DeleteObject(ctx context.Context, className string, id strfmt.UUID,
+	DeleteObject(ctx context.Context, className string, id strfmt.UUID, expectedVersion *int64,
 		repl *additional.ReplicationProperties, tenant string, schemaVersion uint64) error
+	// BatchPutObjectsTransactional puts all objects or none of them
+	BatchPutObjectsTransactional(ctx context.Context, objects BatchObjects,
+		repl *additional.ReplicationProperties, schemaVersion uint64) (BatchObjects, error)
 	// Object returns object of the specified class giving by its id
 	Object(ctx context.Context, class string, id strfmt.UUID, props search.SelectProperties,
 		additional additional.Properties, repl *additional.ReplicationProperties,
@@ -13,6 +13,7 @@ package objects
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-openapi/strfmt"
@@ -35,10 +36,19 @@ type MergeDocument struct {
 	UpdateTime           int64                       `json:"updateTime"`
 	AdditionalProperties models.AdditionalProperties `json:"additionalProperties"`
 	PropertiesToDelete   []string                    `json:"propertiesToDelete"`
+	// ExpectedVersion is the lastUpdateTimeUnix the stored object must have,
+	// nil skips the check
+	ExpectedVersion *int64 `json:"expectedVersion,omitempty"`
+	// PropertiesToAppend holds values which are appended to array properties
+	// of the stored object
+	PropertiesToAppend map[string]interface{} `json:"propertiesToAppend,omitempty"`
+	// RequireExisting fails the merge with ErrNotFound instead of creating
+	// the object if it does not exist
+	RequireExisting bool `json:"requireExisting,omitempty"`
 }
 
 func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
-	updates *models.Object, repl *additional.ReplicationProperties,
+	updates *models.Object, repl *additional.ReplicationProperties, expectedVersion *int64,
 ) *Error {
 	if err := m.validateInputs(updates); err != nil {
 		return &Error{"bad request", StatusBadRequest, err}
@@ -68,6 +78,10 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 		}
 	}
 	if obj == nil {
+		if expectedVersion != nil && *expectedVersion != 0 {
+			err := NewErrPreconditionFailed("object %s does not exist, expected version %d", id, *expectedVersion)
+			return &Error{"precondition failed", StatusPreconditionFailed, err}
+		}
 		return &Error{"not found", StatusNotFound, err}
 	}
 
@@ -95,13 +109,14 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 		updates.Properties = map[string]interface{}{}
 	}
 
-	return m.patchObject(ctx, principal, prevObj, updates, repl, propertiesToDelete, updates.Tenant, schemaVersion)
+	return m.patchObject(ctx, principal, prevObj, updates, repl, propertiesToDelete, updates.Tenant,
+		expectedVersion, schemaVersion)
 }
 
 // patchObject patches an existing object obj with updates
 func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 	prevObj, updates *models.Object, repl *additional.ReplicationProperties,
-	propertiesToDelete []string, tenant string, schemaVersion uint64,
+	propertiesToDelete []string, tenant string, expectedVersion *int64, schemaVersion uint64,
 ) *Error {
 	cls, id := updates.Class, updates.ID
 	primitive, refs := m.splitPrimitiveAndRefs(updates.Properties.(map[string]interface{}), cls, id)
@@ -119,6 +134,7 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 		Vectors:            objWithVec.Vectors,
 		UpdateTime:         m.timeSource.Now(),
 		PropertiesToDelete: propertiesToDelete,
+		ExpectedVersion:    expectedVersion,
 	}
 
 	if objWithVec.Additional != nil {
@@ -134,6 +150,9 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 		}
 	}
 	if err := m.vectorRepo.Merge(ctx, mergeDoc, repl, tenant, schemaVersion); err != nil {
+		if errors.As(err, &ErrPreconditionFailed{}) {
+			return &Error{"precondition failed", StatusPreconditionFailed, err}
+		}
 		return &Error{"repo.merge", StatusInternalServerError, err}
 	}
 
@@ -33,6 +33,11 @@ type VObject struct {
 
 	// Version is the most recent incremental version number of the object
 	Version uint64 `json:"version"`
+
+	// ID and Deleted propagate the deletion of the object with StaleUpdateTime,
+	// LatestObject is not set in that case
+	ID      strfmt.UUID `json:"id,omitempty"`
+	Deleted bool        `json:"deleted,omitempty"`
 }
 
 // vobjectMarshaler is a helper for the methods implementing encoding.BinaryMarshaler
@@ -46,6 +51,8 @@ type vobjectMarshaler struct {
 	Vector          []float32
 	Vectors         models.Vectors
 	LatestObject    []byte
+	ID              strfmt.UUID `json:",omitempty"`
+	Deleted         bool        `json:",omitempty"`
 }
 
 func (vo *VObject) MarshalBinary() ([]byte, error) {
@@ -54,6 +61,8 @@ func (vo *VObject) MarshalBinary() ([]byte, error) {
 		Vector:          vo.Vector,
 		Vectors:         vo.Vectors,
 		Version:         vo.Version,
+		ID:              vo.ID,
+		Deleted:         vo.Deleted,
 	}
 	if vo.LatestObject != nil {
 		obj, err := vo.LatestObject.MarshalBinary()
@@ -77,6 +86,8 @@ func (vo *VObject) UnmarshalBinary(data []byte) error {
 	vo.Vector = b.Vector
 	vo.Vectors = b.Vectors
 	vo.Version = b.Version
+	vo.ID = b.ID
+	vo.Deleted = b.Deleted
 
 	if b.LatestObject != nil {
 		var obj models.Object
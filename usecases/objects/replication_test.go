@@ -119,6 +119,23 @@ func Test_VObject_MarshalBinary(t *testing.T) {
 			})
 		})
 	}
+
+	t.Run("deletion", func(t *testing.T) {
+		expected := VObject{
+			ID:              strfmt.UUID("c6f85bf5-c3b7-4c1d-bd51-e899f9605336"),
+			Deleted:         true,
+			StaleUpdateTime: now.UnixMilli(),
+		}
+
+		b, err := expected.MarshalBinary()
+		require.Nil(t, err)
+
+		var received VObject
+		err = received.UnmarshalBinary(b)
+		require.Nil(t, err)
+
+		assert.EqualValues(t, expected, received)
+	})
 }
 
 func Test_Replica_MarshalBinary(t *testing.T) {
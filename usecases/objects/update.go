@@ -13,6 +13,7 @@ package objects
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-openapi/strfmt"
@@ -25,9 +26,12 @@ import (
 // UpdateObject updates object of class.
 // If the class contains a network ref, it has a side-effect on the schema: The schema will be updated to
 // include this particular network ref class.
+//
+// If expectedVersion is set, the object is only replaced if its
+// lastUpdateTimeUnix still matches, otherwise ErrPreconditionFailed is returned.
 func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal,
 	class string, id strfmt.UUID, updates *models.Object,
-	repl *additional.ReplicationProperties,
+	repl *additional.ReplicationProperties, expectedVersion *int64,
 ) (*models.Object, error) {
 	path := fmt.Sprintf("objects/%s/%s", class, id)
 	if class == "" {
@@ -54,12 +58,12 @@ func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal,
 		return nil, fmt.Errorf("cannot process update object: %w", err)
 	}
 
-	return m.updateObjectToConnectorAndSchema(ctx, principal, class, id, updates, repl)
+	return m.updateObjectToConnectorAndSchema(ctx, principal, class, id, updates, repl, expectedVersion)
 }
 
 func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 	principal *models.Principal, className string, id strfmt.UUID, updates *models.Object,
-	repl *additional.ReplicationProperties,
+	repl *additional.ReplicationProperties, expectedVersion *int64,
 ) (*models.Object, error) {
 	if id != updates.ID {
 		return nil, NewErrInvalidUserInput("invalid update: field 'id' is immutable")
@@ -67,6 +71,9 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 
 	obj, err := m.getObjectFromRepo(ctx, className, id, additional.Properties{}, repl, updates.Tenant)
 	if err != nil {
+		if expectedVersion != nil && *expectedVersion != 0 && errors.As(err, &ErrNotFound{}) {
+			return nil, NewErrPreconditionFailed("object %s does not exist, expected version %d", id, *expectedVersion)
+		}
 		return nil, err
 	}
 
@@ -111,6 +118,20 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 		return nil, fmt.Errorf("error waiting for local schema to catch up to version %d: %w", schemaVersion, err)
 	}
 
+	if expectedVersion != nil {
+		// the version is checked atomically by the shard, which is only
+		// possible for transactional writes
+		batch := BatchObjects{{Object: updates, UUID: id, ExpectedVersion: expectedVersion}}
+		res, err := m.vectorRepo.BatchPutObjectsTransactional(ctx, batch, repl, schemaVersion)
+		if err != nil {
+			return nil, fmt.Errorf("put object: %w", err)
+		}
+		if err := res[0].Err; err != nil {
+			return nil, fmt.Errorf("put object: %w", err)
+		}
+		return updates, nil
+	}
+
 	err = m.vectorRepo.PutObject(ctx, updates, updates.Vector, updates.Vectors, repl, schemaVersion)
 	if err != nil {
 		return nil, fmt.Errorf("put object: %w", err)
@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rebalancer
+
+import (
+	"slices"
+	"sort"
+)
+
+// nodeLoad is the load of a node as reported through memberlist
+type nodeLoad struct {
+	name   string
+	total  uint64 // disk space in bytes
+	used   uint64 // used disk space in bytes
+	shards int
+}
+
+func (n *nodeLoad) usedPercent() float64 {
+	return percentOf(n.used, n.total)
+}
+
+func percentOf(used, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(used) * 100 / float64(total)
+}
+
+// avgShardSize is used to estimate the size of a shard, since the actual
+// size of remote shards is not known
+func (n *nodeLoad) avgShardSize() uint64 {
+	if n.shards == 0 {
+		return 0
+	}
+	return n.used / uint64(n.shards)
+}
+
+// shardPlacement is the current placement of a physical shard
+type shardPlacement struct {
+	class, shard string
+	owners       []string
+	// movable is false for shards which cannot be transferred, e.g. inactive tenants
+	movable bool
+}
+
+type move struct {
+	class, shard   string
+	source, target string
+}
+
+// plan computes up to maxMoves shard moves which bring the nodes closer to an
+// even placement.
+//
+// Disk usage takes precedence: as long as the difference between the fullest
+// and the emptiest node exceeds threshold percentage points, shards are moved
+// from the former to the latter. Once disk usage is balanced, shards are moved
+// from the node with the most shards to the node with the least, as long as
+// this does not unbalance disk usage again.
+//
+// The effect of every planned move is applied to the given loads, using the
+// average shard size of the source node as an estimate.
+func plan(loads []*nodeLoad, shards []shardPlacement, maxMoves int, threshold float64) []move {
+	if len(loads) < 2 {
+		return nil
+	}
+	shards = slices.Clone(shards)
+	sort.Slice(shards, func(i, j int) bool {
+		if shards[i].class != shards[j].class {
+			return shards[i].class < shards[j].class
+		}
+		return shards[i].shard < shards[j].shard
+	})
+
+	var (
+		moves   []move
+		planned = map[int]struct{}{}
+	)
+	for len(moves) < maxMoves {
+		source, target := pickNodes(loads, threshold)
+		if source == nil {
+			break
+		}
+
+		idx := pickShard(shards, planned, source.name, target.name)
+		if idx < 0 {
+			break
+		}
+		planned[idx] = struct{}{}
+		moves = append(moves, move{
+			class:  shards[idx].class,
+			shard:  shards[idx].shard,
+			source: source.name,
+			target: target.name,
+		})
+
+		size := source.avgShardSize()
+		source.used -= size
+		source.shards--
+		target.used += size
+		target.shards++
+	}
+
+	return moves
+}
+
+// pickNodes returns the source and target node of the next move, or nils if
+// the nodes are balanced
+func pickNodes(loads []*nodeLoad, threshold float64) (source, target *nodeLoad) {
+	byDisk := slices.Clone(loads)
+	sort.SliceStable(byDisk, func(i, j int) bool {
+		return byDisk[i].usedPercent() > byDisk[j].usedPercent()
+	})
+	fullest, emptiest := byDisk[0], byDisk[len(byDisk)-1]
+	if fullest.usedPercent()-emptiest.usedPercent() > threshold {
+		if fullest.shards == 0 {
+			return nil, nil
+		}
+		// do not overshoot, otherwise the shard would be moved back in the next round
+		size := fullest.avgShardSize()
+		if percentOf(emptiest.used+size, emptiest.total) > fullest.usedPercent() {
+			return nil, nil
+		}
+		return fullest, emptiest
+	}
+
+	byShards := slices.Clone(loads)
+	sort.SliceStable(byShards, func(i, j int) bool {
+		return byShards[i].shards > byShards[j].shards
+	})
+	most, least := byShards[0], byShards[len(byShards)-1]
+	if most.shards-least.shards <= 1 {
+		return nil, nil
+	}
+	size := most.avgShardSize()
+	if percentOf(least.used+size, least.total)-percentOf(most.used-size, most.total) > threshold {
+		return nil, nil
+	}
+	return most, least
+}
+
+// pickShard returns the index of the first movable shard which belongs to
+// source, but not to target, or -1 if there is none
+func pickShard(shards []shardPlacement, planned map[int]struct{},
+	source, target string,
+) int {
+	for i, s := range shards {
+		if _, ok := planned[i]; ok || !s.movable {
+			continue
+		}
+		if slices.Contains(s.owners, source) && !slices.Contains(s.owners, target) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rebalancer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlan(t *testing.T) {
+	shardsOf := func(node string, n int) []shardPlacement {
+		shards := make([]shardPlacement, n)
+		for i := range shards {
+			shards[i] = shardPlacement{
+				class:   "C",
+				shard:   fmt.Sprintf("%s-%02d", node, i),
+				owners:  []string{node},
+				movable: true,
+			}
+		}
+		return shards
+	}
+
+	t.Run("single node", func(t *testing.T) {
+		loads := []*nodeLoad{{name: "N1", total: 100, used: 90, shards: 9}}
+		assert.Empty(t, plan(loads, shardsOf("N1", 9), 5, 10))
+	})
+
+	t.Run("balanced nodes", func(t *testing.T) {
+		loads := []*nodeLoad{
+			{name: "N1", total: 100, used: 50, shards: 5},
+			{name: "N2", total: 100, used: 45, shards: 4},
+		}
+		shards := append(shardsOf("N1", 5), shardsOf("N2", 4)...)
+		assert.Empty(t, plan(loads, shards, 5, 10))
+	})
+
+	t.Run("moves from full to empty node", func(t *testing.T) {
+		loads := []*nodeLoad{
+			{name: "N1", total: 100, used: 90, shards: 9},
+			{name: "N2", total: 100, used: 20, shards: 2},
+		}
+		shards := append(shardsOf("N1", 9), shardsOf("N2", 2)...)
+		moves := plan(loads, shards, 10, 10)
+
+		// each shard is estimated at 10%, 90/20 -> 60/50
+		assert.Len(t, moves, 3)
+		for _, m := range moves {
+			assert.Equal(t, "N1", m.source)
+			assert.Equal(t, "N2", m.target)
+		}
+		assert.Equal(t, "N1-00", moves[0].shard)
+		assert.Equal(t, 6, loads[0].shards)
+		assert.Equal(t, 5, loads[1].shards)
+	})
+
+	t.Run("respects max moves", func(t *testing.T) {
+		loads := []*nodeLoad{
+			{name: "N1", total: 100, used: 90, shards: 9},
+			{name: "N2", total: 100, used: 20, shards: 2},
+		}
+		shards := append(shardsOf("N1", 9), shardsOf("N2", 2)...)
+		assert.Len(t, plan(loads, shards, 2, 10), 2)
+	})
+
+	t.Run("does not overshoot", func(t *testing.T) {
+		loads := []*nodeLoad{
+			{name: "N1", total: 100, used: 80, shards: 1},
+			{name: "N2", total: 100, used: 10, shards: 1},
+		}
+		shards := append(shardsOf("N1", 1), shardsOf("N2", 1)...)
+		assert.Empty(t, plan(loads, shards, 5, 10))
+	})
+
+	t.Run("balances shard counts once disk usage is balanced", func(t *testing.T) {
+		loads := []*nodeLoad{
+			{name: "N1", total: 1000, used: 60, shards: 6},
+			{name: "N2", total: 1000, used: 50, shards: 1},
+			{name: "N3", total: 1000, used: 50, shards: 2},
+		}
+		shards := append(shardsOf("N1", 6), shardsOf("N2", 1)...)
+		shards = append(shards, shardsOf("N3", 2)...)
+		moves := plan(loads, shards, 5, 10)
+
+		assert.Equal(t, []move{
+			{class: "C", shard: "N1-00", source: "N1", target: "N2"},
+			{class: "C", shard: "N1-01", source: "N1", target: "N3"},
+			{class: "C", shard: "N1-02", source: "N1", target: "N2"},
+		}, moves)
+		for _, l := range loads {
+			assert.Equal(t, 3, l.shards)
+		}
+	})
+
+	t.Run("skips shards already owned by target and inactive tenants", func(t *testing.T) {
+		loads := []*nodeLoad{
+			{name: "N1", total: 100, used: 90, shards: 3},
+			{name: "N2", total: 100, used: 20, shards: 1},
+		}
+		shards := []shardPlacement{
+			{class: "A", shard: "S1", owners: []string{"N1", "N2"}, movable: true},
+			{class: "B", shard: "cold", owners: []string{"N1"}, movable: false},
+			{class: "C", shard: "S1", owners: []string{"N1"}, movable: true},
+		}
+		moves := plan(loads, shards, 1, 10)
+		assert.Equal(t, []move{{class: "C", shard: "S1", source: "N1", target: "N2"}}, moves)
+	})
+}
@@ -0,0 +1,370 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package rebalancer moves shards from nodes with high disk usage or many
+// shards to nodes with low disk usage or few shards.
+//
+// The rebalancer runs on every node, but only the leader of the RAFT cluster
+// plans and executes moves. Every move is a regular shard transfer, the new
+// placement is therefore part of the cluster schema. The pause switch is
+// stored in the cluster schema as well, so that it survives leader changes.
+package rebalancer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+const (
+	MoveRunning = "RUNNING"
+	MoveSuccess = "SUCCESS"
+	MoveFailed  = "FAILED"
+
+	// maxMoveHistory is the number of moves kept for the status
+	maxMoveHistory = 100
+)
+
+// Move is a shard move started by the rebalancer
+type Move struct {
+	Class      string    `json:"class"`
+	Shard      string    `json:"shard"`
+	SourceNode string    `json:"sourceNode"`
+	TargetNode string    `json:"targetNode"`
+	Status     string    `json:"status"`
+	Error      string    `json:"error,omitempty"`
+	StartTime  time.Time `json:"startTime"`
+	FinishTime time.Time `json:"finishTime"`
+}
+
+// NodeLoad is the load of a node as seen by the rebalancer
+type NodeLoad struct {
+	Name            string  `json:"name"`
+	DiskUsedPercent float64 `json:"diskUsedPercent"`
+	Shards          int     `json:"shards"`
+}
+
+// Status is the state of the rebalancer
+type Status struct {
+	Enabled bool       `json:"enabled"`
+	Paused  bool       `json:"paused"`
+	Leader  string     `json:"leader"`
+	LastRun time.Time  `json:"lastRun"`
+	Nodes   []NodeLoad `json:"nodes"`
+	Moves   []Move     `json:"moves"`
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+// raft is used to find out which node runs the rebalancer and to pause it
+type raft interface {
+	IsLeader() bool
+	LeaderWithID() (string, string)
+	SetRebalancerPaused(paused bool) (uint64, error)
+}
+
+type schemaReader interface {
+	RebalancerPaused() bool
+	ReadOnlySchema() models.Schema
+	CopyShardingState(class string) *sharding.State
+}
+
+type nodes interface {
+	AllNames() []string
+	NodeHostname(name string) (string, bool)
+	NodeInfo(name string) (cluster.NodeInfo, bool)
+}
+
+type shardMover interface {
+	TransferShardSkipAuth(ctx context.Context,
+		class, shard, sourceNode, targetNode string, move bool) error
+}
+
+// client retrieves the status of the rebalancer running on the leader
+type client interface {
+	Status(ctx context.Context, host string) (*Status, error)
+}
+
+// Rebalancer periodically moves shards between nodes, see package doc
+type Rebalancer struct {
+	config     config.Rebalancer
+	authorizer authorizer
+	raft       raft
+	schema     schemaReader
+	nodes      nodes
+	mover      shardMover
+	client     client
+	logger     logrus.FieldLogger
+
+	sync.Mutex
+	lastRun time.Time
+	moves   []*Move
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func New(cfg config.Rebalancer, authorizer authorizer, raft raft,
+	schema schemaReader, nodes nodes, mover shardMover, client client,
+	logger logrus.FieldLogger,
+) *Rebalancer {
+	return &Rebalancer{
+		config:     cfg,
+		authorizer: authorizer,
+		raft:       raft,
+		schema:     schema,
+		nodes:      nodes,
+		mover:      mover,
+		client:     client,
+		logger:     logger.WithField("action", "rebalancer"),
+	}
+}
+
+// Start runs rebalancing rounds in the background if the rebalancer is enabled
+func (r *Rebalancer) Start() {
+	if !r.config.Enabled {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+	f := func() {
+		defer close(r.done)
+		t := time.NewTicker(r.config.Interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+				r.rebalance(ctx)
+			}
+		}
+	}
+	enterrors.GoWrapper(f, r.logger)
+
+	r.logger.WithField("interval", r.config.Interval).
+		WithField("max_concurrent_moves", r.config.MaxConcurrentMoves).
+		Info("rebalancer started")
+}
+
+// Stop cancels the moves in progress and waits for the background routine
+// to terminate
+func (r *Rebalancer) Stop(ctx context.Context) error {
+	if r.cancel == nil {
+		return nil
+	}
+	r.cancel()
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("stop rebalancer: %w", ctx.Err())
+	case <-r.done:
+		return nil
+	}
+}
+
+// rebalance runs a single rebalancing round
+func (r *Rebalancer) rebalance(ctx context.Context) {
+	if !r.raft.IsLeader() || r.schema.RebalancerPaused() {
+		return
+	}
+
+	loads, shards := r.currentPlacement()
+	threshold := float64(r.config.DiskUseThresholdPercentage)
+	moves := plan(loads, shards, r.config.MaxConcurrentMoves, threshold)
+
+	r.Lock()
+	r.lastRun = time.Now()
+	r.Unlock()
+	if len(moves) == 0 {
+		return
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(r.logger)
+	eg.SetLimit(r.config.MaxConcurrentMoves)
+	for _, m := range moves {
+		m := m
+		eg.Go(func() error {
+			// the rebalancer might have been paused in the meantime
+			if r.schema.RebalancerPaused() {
+				return nil
+			}
+			r.execute(ctx, m)
+			return nil
+		}, m.class, m.shard)
+	}
+	eg.Wait()
+}
+
+func (r *Rebalancer) execute(ctx context.Context, m move) {
+	record := &Move{
+		Class:      m.class,
+		Shard:      m.shard,
+		SourceNode: m.source,
+		TargetNode: m.target,
+		Status:     MoveRunning,
+		StartTime:  time.Now(),
+	}
+	r.Lock()
+	r.moves = append(r.moves, record)
+	if len(r.moves) > maxMoveHistory {
+		r.moves = r.moves[len(r.moves)-maxMoveHistory:]
+	}
+	r.Unlock()
+
+	logger := r.logger.WithField("class", m.class).
+		WithField("shard", m.shard).
+		WithField("source_node", m.source).
+		WithField("target_node", m.target)
+	logger.Info("moving shard")
+
+	err := r.mover.TransferShardSkipAuth(ctx, m.class, m.shard, m.source, m.target, true)
+
+	r.Lock()
+	defer r.Unlock()
+	record.FinishTime = time.Now()
+	if err != nil {
+		record.Status = MoveFailed
+		record.Error = err.Error()
+		logger.WithError(err).Error("move shard")
+		return
+	}
+	record.Status = MoveSuccess
+}
+
+// currentPlacement returns the load of the nodes, which report their disk
+// usage, and the placement of all physical shards
+func (r *Rebalancer) currentPlacement() ([]*nodeLoad, []shardPlacement) {
+	var (
+		names  = r.nodes.AllNames()
+		loads  = make([]*nodeLoad, 0, len(names))
+		byName = make(map[string]*nodeLoad, len(names))
+	)
+	for _, name := range names {
+		info, ok := r.nodes.NodeInfo(name)
+		if !ok || info.Total == 0 {
+			continue
+		}
+		load := &nodeLoad{
+			name:  name,
+			total: info.Total,
+			used:  info.Total - info.Available,
+		}
+		loads = append(loads, load)
+		byName[name] = load
+	}
+
+	var shards []shardPlacement
+	for _, class := range r.schema.ReadOnlySchema().Classes {
+		state := r.schema.CopyShardingState(class.Class)
+		if state == nil {
+			continue
+		}
+		for name, physical := range state.Physical {
+			for _, owner := range physical.BelongsToNodes {
+				if load, ok := byName[owner]; ok {
+					load.shards++
+				}
+			}
+			movable := !state.PartitioningEnabled ||
+				physical.ActivityStatus() == models.TenantActivityStatusHOT
+			shards = append(shards, shardPlacement{
+				class:   class.Class,
+				shard:   name,
+				owners:  physical.BelongsToNodes,
+				movable: movable,
+			})
+		}
+	}
+
+	sort.Slice(loads, func(i, j int) bool { return loads[i].name < loads[j].name })
+	return loads, shards
+}
+
+// LocalStatus returns the status of the rebalancer running on this node
+func (r *Rebalancer) LocalStatus() *Status {
+	loads, _ := r.currentPlacement()
+	_, leader := r.raft.LeaderWithID()
+
+	status := &Status{
+		Enabled: r.config.Enabled,
+		Paused:  r.schema.RebalancerPaused(),
+		Leader:  leader,
+		Nodes:   make([]NodeLoad, len(loads)),
+	}
+	for i, l := range loads {
+		status.Nodes[i] = NodeLoad{Name: l.name, DiskUsedPercent: l.usedPercent(), Shards: l.shards}
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	status.LastRun = r.lastRun
+	status.Moves = make([]Move, len(r.moves))
+	for i, m := range r.moves {
+		status.Moves[i] = *m
+	}
+	return status
+}
+
+// Status returns the status of the rebalancer running on the leader
+func (r *Rebalancer) Status(ctx context.Context, principal *models.Principal) (*Status, error) {
+	if err := r.authorizer.Authorize(principal, "get", "cluster/rebalancer"); err != nil {
+		return nil, err
+	}
+	return r.status(ctx)
+}
+
+// SetPaused pauses or resumes the rebalancer. Moves in progress are not
+// interrupted when pausing.
+func (r *Rebalancer) SetPaused(ctx context.Context, principal *models.Principal,
+	paused bool,
+) (*Status, error) {
+	if err := r.authorizer.Authorize(principal, "update", "cluster/rebalancer"); err != nil {
+		return nil, err
+	}
+	if _, err := r.raft.SetRebalancerPaused(paused); err != nil {
+		return nil, fmt.Errorf("set rebalancer paused: %w", err)
+	}
+	return r.status(ctx)
+}
+
+func (r *Rebalancer) status(ctx context.Context) (*Status, error) {
+	if r.raft.IsLeader() {
+		return r.LocalStatus(), nil
+	}
+
+	_, leader := r.raft.LeaderWithID()
+	if leader == "" {
+		return nil, fmt.Errorf("unknown leader")
+	}
+	host, ok := r.nodes.NodeHostname(leader)
+	if !ok {
+		return nil, fmt.Errorf("cannot resolve leader %q", leader)
+	}
+	status, err := r.client.Status(ctx, host)
+	if err != nil {
+		return nil, fmt.Errorf("get status from leader %q: %w", leader, err)
+	}
+	return status, nil
+}
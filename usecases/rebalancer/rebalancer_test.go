@@ -0,0 +1,208 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rebalancer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/fakes"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestRebalance(t *testing.T) {
+	t.Run("moves shards on the leader", func(t *testing.T) {
+		f := newFakes(true)
+		r := f.rebalancer()
+		r.rebalance(context.Background())
+
+		assert.Equal(t, []string{"C/S1:N1->N2"}, f.mover.moved)
+		status := r.LocalStatus()
+		require.Len(t, status.Moves, 1)
+		assert.Equal(t, MoveSuccess, status.Moves[0].Status)
+		assert.False(t, status.LastRun.IsZero())
+	})
+
+	t.Run("records failed moves", func(t *testing.T) {
+		f := newFakes(true)
+		f.mover.err = errors.New("boom")
+		r := f.rebalancer()
+		r.rebalance(context.Background())
+
+		status := r.LocalStatus()
+		require.Len(t, status.Moves, 1)
+		assert.Equal(t, MoveFailed, status.Moves[0].Status)
+		assert.Equal(t, "boom", status.Moves[0].Error)
+	})
+
+	t.Run("does nothing on followers", func(t *testing.T) {
+		f := newFakes(false)
+		f.rebalancer().rebalance(context.Background())
+		assert.Empty(t, f.mover.moved)
+	})
+
+	t.Run("stops moving shards once the leadership is lost", func(t *testing.T) {
+		f := newFakes(true)
+		r := f.rebalancer()
+		r.rebalance(context.Background())
+		assert.Equal(t, []string{"C/S1:N1->N2"}, f.mover.moved)
+
+		f.raft.SetLeader(false)
+		f.raft.leaderID = "N2"
+		f.mover.moved = nil
+		r.rebalance(context.Background())
+		assert.Empty(t, f.mover.moved)
+
+		// the status is served by the new leader
+		_, err := r.Status(context.Background(), nil)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"N2:8300"}, f.client.hosts)
+	})
+
+	t.Run("does nothing when paused", func(t *testing.T) {
+		f := newFakes(true)
+		f.schema.paused = true
+		f.rebalancer().rebalance(context.Background())
+		assert.Empty(t, f.mover.moved)
+	})
+}
+
+func TestRebalancerStatus(t *testing.T) {
+	t.Run("local status on the leader", func(t *testing.T) {
+		f := newFakes(true)
+		status, err := f.rebalancer().Status(context.Background(), nil)
+		require.Nil(t, err)
+		assert.True(t, status.Enabled)
+		assert.Equal(t, "N1", status.Leader)
+		assert.Equal(t, []NodeLoad{
+			{Name: "N1", DiskUsedPercent: 90, Shards: 2},
+			{Name: "N2", DiskUsedPercent: 20, Shards: 1},
+		}, status.Nodes)
+		assert.Empty(t, f.client.hosts)
+	})
+
+	t.Run("forwarded to the leader", func(t *testing.T) {
+		f := newFakes(false)
+		status, err := f.rebalancer().Status(context.Background(), nil)
+		require.Nil(t, err)
+		assert.Equal(t, "N1", status.Leader)
+		assert.Equal(t, []string{"N1:8300"}, f.client.hosts)
+	})
+
+	t.Run("pause", func(t *testing.T) {
+		f := newFakes(true)
+		status, err := f.rebalancer().SetPaused(context.Background(), nil, true)
+		require.Nil(t, err)
+		assert.True(t, status.Paused)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		f := newFakes(true)
+		f.authorizer.Err = errors.New("forbidden")
+		_, err := f.rebalancer().SetPaused(context.Background(), nil, true)
+		assert.NotNil(t, err)
+		assert.False(t, f.schema.paused)
+	})
+}
+
+type testFakes struct {
+	authorizer *fakes.FakeAuthorizer
+	raft       *fakeRaft
+	schema     *fakeSchema
+	nodes      *fakes.FakeNodes
+	mover      *fakeMover
+	client     *fakeClient
+}
+
+// newFakes returns a cluster of two nodes, where N1 is the leader and holds
+// two shards with 90% disk usage and N2 one with 20% disk usage
+func newFakes(leader bool) *testFakes {
+	schema := &fakeSchema{FakeSchemaReader: fakes.NewFakeSchemaReader()}
+	schema.States["C"] = &sharding.State{Physical: map[string]sharding.Physical{
+		"S1": {BelongsToNodes: []string{"N1"}},
+		"S2": {BelongsToNodes: []string{"N1"}},
+		"S3": {BelongsToNodes: []string{"N2"}},
+	}}
+	nodes := fakes.NewFakeNodes("N1", "N1", "N2")
+	nodes.Infos["N1"] = cluster.NodeInfo{DiskUsage: cluster.DiskUsage{Total: 100, Available: 10}}
+	nodes.Infos["N2"] = cluster.NodeInfo{DiskUsage: cluster.DiskUsage{Total: 100, Available: 80}}
+	return &testFakes{
+		authorizer: &fakes.FakeAuthorizer{},
+		raft:       &fakeRaft{FakeLeader: fakes.NewFakeLeader(leader), leaderID: "N1", schema: schema},
+		schema:     schema,
+		nodes:      nodes,
+		mover:      &fakeMover{},
+		client:     &fakeClient{status: &Status{Leader: "N1"}},
+	}
+}
+
+func (f *testFakes) rebalancer() *Rebalancer {
+	logger, _ := test.NewNullLogger()
+	cfg := config.Rebalancer{
+		Enabled:                    true,
+		MaxConcurrentMoves:         2,
+		DiskUseThresholdPercentage: 10,
+	}
+	return New(cfg, f.authorizer, f.raft, f.schema, f.nodes, f.mover, f.client, logger)
+}
+
+type fakeRaft struct {
+	*fakes.FakeLeader
+	leaderID string
+	schema   *fakeSchema
+}
+
+func (r *fakeRaft) LeaderWithID() (string, string) { return r.leaderID + ":8300", r.leaderID }
+
+func (r *fakeRaft) SetRebalancerPaused(paused bool) (uint64, error) {
+	r.schema.paused = paused
+	return 1, nil
+}
+
+type fakeSchema struct {
+	*fakes.FakeSchemaReader
+	paused bool
+}
+
+func (s *fakeSchema) RebalancerPaused() bool { return s.paused }
+
+type fakeMover struct {
+	sync.Mutex
+	moved []string
+	err   error
+}
+
+func (m *fakeMover) TransferShardSkipAuth(_ context.Context,
+	class, shard, sourceNode, targetNode string, move bool,
+) error {
+	m.Lock()
+	defer m.Unlock()
+	m.moved = append(m.moved, class+"/"+shard+":"+sourceNode+"->"+targetNode)
+	return m.err
+}
+
+type fakeClient struct {
+	hosts  []string
+	status *Status
+}
+
+func (c *fakeClient) Status(_ context.Context, host string) (*Status, error) {
+	c.hosts = append(c.hosts, host)
+	return c.status, nil
+}
@@ -0,0 +1,68 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// conditionalWrite collects the rejections of replicas which do not store
+// the version a conditional write expects. Replicas check the version when
+// the write is prepared and again when it is committed.
+type conditionalWrite struct {
+	sync.Mutex
+	rejected error
+}
+
+// observe remembers err if a replica rejected the write and returns it as is
+func (c *conditionalWrite) observe(err error) error {
+	if rejected := preconditionFailed(err); rejected != nil {
+		c.Lock()
+		if c.rejected == nil {
+			c.rejected = rejected
+		}
+		c.Unlock()
+	}
+	return err
+}
+
+// errors turns rejections into objects.ErrPreconditionFailed. If the
+// consistency level was not reached because replicas rejected the write while
+// it was prepared, the write was aborted on all replicas and the rejection is
+// reported instead.
+func (c *conditionalWrite) errors(errs []error) []error {
+	c.Lock()
+	defer c.Unlock()
+
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		if rejected := preconditionFailed(err); rejected != nil {
+			errs[i] = rejected
+		} else if c.rejected != nil && errors.Is(err, errReplicas) {
+			errs[i] = c.rejected
+		}
+	}
+	return errs
+}
+
+func preconditionFailed(err error) error {
+	var replicaErr *Error
+	if errors.As(err, &replicaErr) && replicaErr.Code == StatusPreconditionFailed {
+		return objects.NewErrPreconditionFailed("%s", replicaErr.Msg)
+	}
+	return nil
+}
@@ -233,8 +233,20 @@ type ShardDifferenceReader struct {
 	RangeReader hashtree.AggregatedHashTreeRangeReader
 }
 
+// ShardComparison is the outcome of comparing the hashtree of the local
+// shard with the hashtree of one of its replicas
+type ShardComparison struct {
+	Node       string // name of the node holding the replica
+	Host       string // hostname of the node holding the replica
+	DiffLeaves int    // number of hashtree leaves with different digests
+}
+
+// CollectShardDifferences compares the hashtree of the local shard with the
+// ones of its replicas until a replica with differences is found.
+// onCompared (optional) is called for every replica the hashtree was
+// successfully compared with, including replicas which are in sync
 func (f *Finder) CollectShardDifferences(ctx context.Context,
-	shardName string, ht hashtree.AggregatedHashTree,
+	shardName string, ht hashtree.AggregatedHashTree, onCompared func(ShardComparison),
 ) (replyCh <-chan _Result[*ShardDifferenceReader], hosts []string, err error) {
 	coord := newReadCoordinator[*ShardDifferenceReader](f, shardName)
 
@@ -243,6 +255,19 @@ func (f *Finder) CollectShardDifferences(ctx context.Context,
 		return nil, nil, fmt.Errorf("getting host %s", f.resolver.NodeName)
 	}
 
+	nodesByHost := make(map[string]string)
+	if replicas, err := f.resolver.Schema.ResolveParentNodes(f.class, shardName); err == nil {
+		for node, host := range replicas {
+			nodesByHost[host] = node
+		}
+	}
+
+	compared := func(host string, diffLeaves int) {
+		if onCompared != nil {
+			onCompared(ShardComparison{Node: nodesByHost[host], Host: host, DiffLeaves: diffLeaves})
+		}
+	}
+
 	op := func(ctx context.Context, host string, fullRead bool) (*ShardDifferenceReader, error) {
 		if host == sourceHost {
 			return nil, hashtree.ErrNoMoreRanges
@@ -255,6 +280,8 @@ func (f *Finder) CollectShardDifferences(ctx context.Context,
 
 		diff.Set(0) // init comparison at root level
 
+		var levelDiffCount int
+
 		for l := 0; l < ht.Height(); l++ {
 			_, err := ht.Level(l, diff, digests)
 			if err != nil {
@@ -266,8 +293,10 @@ func (f *Finder) CollectShardDifferences(ctx context.Context,
 				return nil, fmt.Errorf("%q: %w", host, err)
 			}
 
-			levelDiffCount := hashtree.LevelDiff(l, diff, digests, levelDigests)
+			levelDiffCount = hashtree.LevelDiff(l, diff, digests, levelDigests)
 			if levelDiffCount == 0 {
+				compared(host, 0)
+
 				// no difference was found
 				// an error is returned to ensure some existent difference is found if another
 				// consistency level than All is used
@@ -275,6 +304,9 @@ func (f *Finder) CollectShardDifferences(ctx context.Context,
 			}
 		}
 
+		// differences found at the last level correspond to hashtree leaves
+		compared(host, levelDiffCount)
+
 		return &ShardDifferenceReader{
 			Host:        host,
 			RangeReader: ht.NewRangeReader(diff),
@@ -295,6 +327,20 @@ func (f *Finder) DigestObjectsInTokenRange(ctx context.Context,
 	return f.client.DigestObjectsInTokenRange(ctx, host, f.class, shardName, initialToken, finalToken, limit)
 }
 
+// DigestObjects fetches the digests of objects of a shard from a specific host
+func (f *Finder) DigestObjects(ctx context.Context,
+	host, shard string, ids []strfmt.UUID,
+) ([]RepairResponse, error) {
+	return f.client.DigestReads(ctx, host, f.class, shard, ids)
+}
+
+// FetchObjects fetches the objects of a shard from a specific host
+func (f *Finder) FetchObjects(ctx context.Context,
+	host, shard string, ids []strfmt.UUID,
+) ([]objects.Replica, error) {
+	return f.client.FullReads(ctx, host, f.class, shard, ids)
+}
+
 // Overwrite specified object with most recent contents
 func (f *Finder) Overwrite(ctx context.Context,
 	host, index, shard string, xs []*objects.VObject,
@@ -83,7 +83,7 @@ func (f *fakeClient) PutObject(ctx context.Context, host, index, shard, requestI
 }
 
 func (f *fakeClient) DeleteObject(ctx context.Context, host, index, shard, requestID string,
-	id strfmt.UUID, schemaVersion uint64,
+	id strfmt.UUID, expectedVersion *int64, schemaVersion uint64,
 ) (SimpleResponse, error) {
 	args := f.Called(ctx, host, index, shard, requestID, id, schemaVersion)
 	return args.Get(0).(SimpleResponse), args.Error(1)
@@ -103,6 +103,13 @@ func (f *fakeClient) PutObjects(ctx context.Context, host, index, shard, request
 	return args.Get(0).(SimpleResponse), args.Error(1)
 }
 
+func (f *fakeClient) PutObjectsTransactional(ctx context.Context, host, index, shard, requestID string,
+	objs []*storobj.Object, expectedVersions []*int64, schemaVersion uint64,
+) (SimpleResponse, error) {
+	args := f.Called(ctx, host, index, shard, requestID, objs, expectedVersions, schemaVersion)
+	return args.Get(0).(SimpleResponse), args.Error(1)
+}
+
 func (f *fakeClient) DeleteObjects(ctx context.Context, host, index, shard, requestID string,
 	uuids []strfmt.UUID, dryRun bool, schemaVersion uint64,
 ) (SimpleResponse, error) {
@@ -35,8 +35,9 @@ type RemoteIndexIncomingRepo interface {
 	// Write endpoints
 	ReplicateObject(ctx context.Context, shardName, requestID string, object *storobj.Object) SimpleResponse
 	ReplicateObjects(ctx context.Context, shardName, requestID string, objects []*storobj.Object, schemaVersion uint64) SimpleResponse
+	ReplicateObjectsTransactional(ctx context.Context, shardName, requestID string, objects []*storobj.Object, expectedVersions []*int64, schemaVersion uint64) SimpleResponse
 	ReplicateUpdate(ctx context.Context, shardName, requestID string, mergeDoc *objects.MergeDocument) SimpleResponse
-	ReplicateDeletion(ctx context.Context, shardName, requestID string, uuid strfmt.UUID) SimpleResponse
+	ReplicateDeletion(ctx context.Context, shardName, requestID string, uuid strfmt.UUID, expectedVersion *int64) SimpleResponse
 	ReplicateDeletions(ctx context.Context, shardName, requestID string, uuids []strfmt.UUID, dryRun bool, schemaVersion uint64) SimpleResponse
 	ReplicateReferences(ctx context.Context, shardName, requestID string, refs []objects.BatchReference) SimpleResponse
 	CommitReplication(shardName, requestID string) interface{}
@@ -84,6 +85,16 @@ func (rri *RemoteReplicaIncoming) ReplicateObjects(ctx context.Context, indexNam
 	return index.ReplicateObjects(ctx, shardName, requestID, objects, schemaVersion)
 }
 
+func (rri *RemoteReplicaIncoming) ReplicateObjectsTransactional(ctx context.Context, indexName,
+	shardName, requestID string, objects []*storobj.Object, expectedVersions []*int64, schemaVersion uint64,
+) SimpleResponse {
+	index, simpleResp := rri.indexForIncomingWrite(ctx, indexName, schemaVersion)
+	if simpleResp != nil {
+		return *simpleResp
+	}
+	return index.ReplicateObjectsTransactional(ctx, shardName, requestID, objects, expectedVersions, schemaVersion)
+}
+
 func (rri *RemoteReplicaIncoming) ReplicateUpdate(ctx context.Context, indexName,
 	shardName, requestID string, mergeDoc *objects.MergeDocument, schemaVersion uint64,
 ) SimpleResponse {
@@ -95,13 +106,13 @@ func (rri *RemoteReplicaIncoming) ReplicateUpdate(ctx context.Context, indexName
 }
 
 func (rri *RemoteReplicaIncoming) ReplicateDeletion(ctx context.Context, indexName,
-	shardName, requestID string, uuid strfmt.UUID, schemaVersion uint64,
+	shardName, requestID string, uuid strfmt.UUID, expectedVersion *int64, schemaVersion uint64,
 ) SimpleResponse {
 	index, simpleResp := rri.indexForIncomingWrite(ctx, indexName, schemaVersion)
 	if simpleResp != nil {
 		return *simpleResp
 	}
-	return index.ReplicateDeletion(ctx, shardName, requestID, uuid)
+	return index.ReplicateDeletion(ctx, shardName, requestID, uuid, expectedVersion)
 }
 
 func (rri *RemoteReplicaIncoming) ReplicateDeletions(ctx context.Context, indexName,
@@ -34,6 +34,7 @@ const (
 	opPutObjects = iota + 97
 	opAddReferences
 	opDeleteObjects
+	opPutObjectsTransactional
 )
 
 type (
@@ -130,13 +131,14 @@ func (r *Replicator) MergeObject(ctx context.Context,
 	schemaVersion uint64,
 ) error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opMergeObject), r.log)
+	var cw conditionalWrite
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.MergeObject(ctx, host, r.class, shard, requestID, doc, schemaVersion)
 		if err == nil {
 			err = resp.FirstError()
 		}
 		if err != nil {
-			return fmt.Errorf("%q: %w", host, err)
+			return fmt.Errorf("%q: %w", host, cw.observe(err))
 		}
 		return nil
 	}
@@ -144,9 +146,9 @@ func (r *Replicator) MergeObject(ctx context.Context,
 	if err != nil {
 		r.log.WithField("op", "push.merge").WithField("class", r.class).
 			WithField("shard", shard).Error(err)
-		return fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas)
+		return cw.errors([]error{fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas)})[0]
 	}
-	err = r.stream.readErrors(1, level, replyCh)[0]
+	err = cw.errors(r.stream.readErrors(1, level, replyCh))[0]
 	if err != nil {
 		r.log.WithField("op", "put").WithField("class", r.class).
 			WithField("shard", shard).WithField("uuid", doc.ID).Error(err)
@@ -154,20 +156,24 @@ func (r *Replicator) MergeObject(ctx context.Context,
 	return err
 }
 
+// DeleteObject deletes an object on all replicas. If expectedVersion is set,
+// each replica only deletes the object if it has that version.
 func (r *Replicator) DeleteObject(ctx context.Context,
 	shard string,
 	id strfmt.UUID,
+	expectedVersion *int64,
 	l ConsistencyLevel,
 	schemaVersion uint64,
 ) error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opDeleteObject), r.log)
+	var cw conditionalWrite
 	op := func(ctx context.Context, host, requestID string) error {
-		resp, err := r.client.DeleteObject(ctx, host, r.class, shard, requestID, id, schemaVersion)
+		resp, err := r.client.DeleteObject(ctx, host, r.class, shard, requestID, id, expectedVersion, schemaVersion)
 		if err == nil {
 			err = resp.FirstError()
 		}
 		if err != nil {
-			return fmt.Errorf("%q: %w", host, err)
+			return fmt.Errorf("%q: %w", host, cw.observe(err))
 		}
 		return nil
 	}
@@ -175,9 +181,9 @@ func (r *Replicator) DeleteObject(ctx context.Context,
 	if err != nil {
 		r.log.WithField("op", "push.delete").WithField("class", r.class).
 			WithField("shard", shard).Error(err)
-		return fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas)
+		return cw.errors([]error{fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas)})[0]
 	}
-	err = r.stream.readErrors(1, level, replyCh)[0]
+	err = cw.errors(r.stream.readErrors(1, level, replyCh))[0]
 	if err != nil {
 		r.log.WithField("op", "put").WithField("class", r.class).
 			WithField("shard", shard).WithField("uuid", id).Error(err)
@@ -222,6 +228,48 @@ func (r *Replicator) PutObjects(ctx context.Context,
 	return errs
 }
 
+// PutObjectsTransactional puts all objects or none of them on every replica,
+// see PutObjects. The expected versions are checked by each replica.
+func (r *Replicator) PutObjectsTransactional(ctx context.Context,
+	shard string,
+	objs []*storobj.Object,
+	expectedVersions []*int64,
+	l ConsistencyLevel,
+	schemaVersion uint64,
+) []error {
+	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opPutObjectsTransactional), r.log)
+	var cw conditionalWrite
+	op := func(ctx context.Context, host, requestID string) error {
+		resp, err := r.client.PutObjectsTransactional(ctx, host, r.class, shard, requestID,
+			objs, expectedVersions, schemaVersion)
+		if err == nil {
+			err = resp.FirstError()
+		}
+		if err != nil {
+			return fmt.Errorf("%q: %w", host, cw.observe(err))
+		}
+		return nil
+	}
+
+	replyCh, level, err := coord.Push(ctx, l, op, r.simpleCommit(shard))
+	if err != nil {
+		r.log.WithField("op", "push.transaction").WithField("class", r.class).
+			WithField("shard", shard).Error(err)
+		err = fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas)
+		errs := make([]error, len(objs))
+		for i := 0; i < len(objs); i++ {
+			errs[i] = err
+		}
+		return cw.errors(errs)
+	}
+	errs := cw.errors(r.stream.readErrors(len(objs), level, replyCh))
+	if err := firstError(errs); err != nil {
+		r.log.WithField("op", "put.transaction").WithField("class", r.class).
+			WithField("shard", shard).Error(errs)
+	}
+	return errs
+}
+
 func (r *Replicator) DeleteObjects(ctx context.Context,
 	shard string,
 	uuids []strfmt.UUID,
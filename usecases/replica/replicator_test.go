@@ -53,7 +53,7 @@ func TestReplicatorReplicaNotFound(t *testing.T) {
 	t.Run("DeleteObject", func(t *testing.T) {
 		f := newFakeFactory("C1", "S", []string{})
 		rep := f.newReplicator()
-		err := rep.DeleteObject(ctx, "S", "id", All, 0)
+		err := rep.DeleteObject(ctx, "S", "id", nil, All, 0)
 		assert.ErrorIs(t, err, errReplicas)
 		f.assertLogErrorContains(t, errNoReplicaFound.Error())
 	})
@@ -274,7 +274,7 @@ func TestReplicatorDeleteObject(t *testing.T) {
 			client.On("Abort", mock.Anything, n, "C1", shard, anyVal).Return(resp, nil)
 		}
 
-		err := rep.DeleteObject(ctx, shard, uuid, All, 123)
+		err := rep.DeleteObject(ctx, shard, uuid, nil, All, 123)
 		assert.NotNil(t, err)
 		assert.ErrorIs(t, err, errReplicas)
 	})
@@ -288,9 +288,9 @@ func TestReplicatorDeleteObject(t *testing.T) {
 			client.On("DeleteObject", mock.Anything, n, cls, shard, anyVal, uuid, uint64(123)).Return(resp, nil)
 			client.On("Commit", ctx, n, "C1", shard, anyVal, anyVal).Return(nil)
 		}
-		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, All, 123))
-		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, Quorum, 123))
-		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, One, 123))
+		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, nil, All, 123))
+		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, nil, Quorum, 123))
+		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, nil, One, 123))
 	})
 	t.Run("SuccessWithConsistencyQuorum", func(t *testing.T) {
 		factory := newFakeFactory("C1", shard, nodes)
@@ -314,9 +314,9 @@ func TestReplicatorDeleteObject(t *testing.T) {
 			}
 		}
 
-		assert.NotNil(t, rep.DeleteObject(ctx, shard, uuid, All, 123))
-		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, Quorum, 123))
-		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, One, 123))
+		assert.NotNil(t, rep.DeleteObject(ctx, shard, uuid, nil, All, 123))
+		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, nil, Quorum, 123))
+		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, nil, One, 123))
 	})
 
 	t.Run("SuccessWithConsistencyQuorum", func(t *testing.T) {
@@ -341,9 +341,32 @@ func TestReplicatorDeleteObject(t *testing.T) {
 			}
 		}
 
-		assert.NotNil(t, rep.DeleteObject(ctx, shard, uuid, All, 123))
-		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, Quorum, 123))
-		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, One, 123))
+		assert.NotNil(t, rep.DeleteObject(ctx, shard, uuid, nil, All, 123))
+		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, nil, Quorum, 123))
+		assert.Nil(t, rep.DeleteObject(ctx, shard, uuid, nil, One, 123))
+	})
+
+	t.Run("PreconditionFailed", func(t *testing.T) {
+		factory := newFakeFactory("C1", shard, nodes)
+		client := factory.WClient
+		rep := factory.newReplicator()
+		version := int64(7)
+		resp := SimpleResponse{Errors: make([]Error, 1)}
+		rejected := SimpleResponse{Errors: []Error{{
+			Code: StatusPreconditionFailed, Msg: "object 1234 has version 8, expected version 7",
+		}}}
+		client.On("DeleteObject", mock.Anything, "A", cls, shard, anyVal, uuid, uint64(123)).Return(resp, nil)
+		for _, n := range nodes[1:] {
+			client.On("DeleteObject", mock.Anything, n, cls, shard, anyVal, uuid, uint64(123)).Return(rejected, nil)
+		}
+		for _, n := range nodes {
+			client.On("Abort", mock.Anything, n, "C1", shard, anyVal).Return(resp, nil)
+		}
+
+		err := rep.DeleteObject(ctx, shard, uuid, &version, Quorum, 123)
+		assert.ErrorAs(t, err, &objects.ErrPreconditionFailed{})
+		assert.ErrorContains(t, err, "has version 8")
+		client.AssertNotCalled(t, "Commit", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	})
 }
 
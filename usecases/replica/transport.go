@@ -27,6 +27,9 @@ const (
 	// RequestKey is used to marshalling request IDs
 	RequestKey       = "request_id"
 	SchemaVersionKey = "schema_version"
+	// ExpectedVersionKey is used to marshal the expected version of a
+	// conditional write
+	ExpectedVersionKey = "expected_version"
 )
 
 // Client is used to read and write objects on replicas
@@ -181,9 +184,11 @@ type wClient interface {
 	PutObject(ctx context.Context, host, index, shard, requestID string,
 		obj *storobj.Object, schemaVersion uint64) (SimpleResponse, error)
 	DeleteObject(ctx context.Context, host, index, shard, requestID string,
-		id strfmt.UUID, schemaVersion uint64) (SimpleResponse, error)
+		id strfmt.UUID, expectedVersion *int64, schemaVersion uint64) (SimpleResponse, error)
 	PutObjects(ctx context.Context, host, index, shard, requestID string,
 		objs []*storobj.Object, schemaVersion uint64) (SimpleResponse, error)
+	PutObjectsTransactional(ctx context.Context, host, index, shard, requestID string,
+		objs []*storobj.Object, expectedVersions []*int64, schemaVersion uint64) (SimpleResponse, error)
 	MergeObject(ctx context.Context, host, index, shard, requestID string,
 		mergeDoc *objects.MergeDocument, schemaVersion uint64) (SimpleResponse, error)
 	DeleteObjects(ctx context.Context, host, index, shard, requestID string,
@@ -0,0 +1,418 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package resharding fills the shards of the layouts classes are resharded
+// to and finishes the reshardings.
+//
+// A resharding is stored in the cluster schema. Every node copies the
+// objects of its shards of the new layout from the shards of the current
+// layout. Once all shards are filled, the leader commits the resharding,
+// which replaces the current layout. If a shard fails, the leader aborts the
+// resharding. Since the copies only depend on the schema, a resharding
+// continues after restarts and leader changes: a node which restarted copies
+// its shards again, which only transfers what is missing, and a new leader
+// picks up checking the nodes.
+package resharding
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// reconcileInterval is the interval in which the nodes start copying their
+// shards, and the leader checks whether reshardings can be finished
+const reconcileInterval = time.Second
+
+const (
+	ShardRunning = "RUNNING"
+	ShardReady   = "READY"
+	ShardFailed  = "FAILED"
+)
+
+// ShardStatus is the progress of a shard of the new layout on a node
+type ShardStatus struct {
+	Shard  string `json:"shard"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// NodeStatus is the progress of a node filling its shards of the new layout
+type NodeStatus struct {
+	Node string `json:"node"`
+	// Version is the version of the resharding the shards were filled for
+	Version uint64        `json:"version"`
+	Shards  []ShardStatus `json:"shards"`
+	// Error is set if the status of the node could not be retrieved
+	Error string `json:"error,omitempty"`
+}
+
+// Status is the state of the resharding of a class
+type Status struct {
+	Class      string
+	Resharding sharding.Resharding
+	Nodes      []NodeStatus
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type raft interface {
+	IsLeader() bool
+	CommitResharding(class string, version uint64) (uint64, error)
+	AbortResharding(class string, version uint64, errMsg string) (uint64, error)
+}
+
+type schemaReader interface {
+	ReadOnlySchema() models.Schema
+	CopyShardingState(class string) *sharding.State
+}
+
+// db fills the local shards of the new layouts
+type db interface {
+	ReshardShard(ctx context.Context, class, shard string) error
+}
+
+type nodes interface {
+	LocalName() string
+	NodeHostname(name string) (string, bool)
+}
+
+// client retrieves the progress of a resharding on other nodes
+type client interface {
+	Status(ctx context.Context, host, class string) (*NodeStatus, error)
+}
+
+type shardKey struct {
+	class string
+	shard string
+}
+
+// shardCopy fills a local shard of a new layout
+type shardCopy struct {
+	version uint64
+	status  string
+	err     string
+	cancel  context.CancelFunc
+}
+
+// Manager drives the reshardings, see package doc
+type Manager struct {
+	authorizer authorizer
+	raft       raft
+	schema     schemaReader
+	db         db
+	nodes      nodes
+	client     client
+	logger     logrus.FieldLogger
+
+	sync.Mutex
+	copies map[shardKey]*shardCopy
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func New(authorizer authorizer, raft raft, schema schemaReader, db db,
+	nodes nodes, client client, logger logrus.FieldLogger,
+) *Manager {
+	return &Manager{
+		authorizer: authorizer,
+		raft:       raft,
+		schema:     schema,
+		db:         db,
+		nodes:      nodes,
+		client:     client,
+		logger:     logger.WithField("action", "resharding"),
+		copies:     map[shardKey]*shardCopy{},
+	}
+}
+
+// Start drives the reshardings in the background
+func (m *Manager) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	m.done = make(chan struct{})
+	f := func() {
+		defer close(m.done)
+		t := time.NewTicker(reconcileInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				m.stopCopies(nil)
+				return
+			case <-t.C:
+				m.reconcile(ctx)
+			}
+		}
+	}
+	enterrors.GoWrapper(f, m.logger)
+}
+
+// Stop waits for the background routine to terminate. Running copies are
+// cancelled and started again once the node is back.
+func (m *Manager) Stop(ctx context.Context) error {
+	if m.cancel == nil {
+		return nil
+	}
+	m.cancel()
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("stop resharding: %w", ctx.Err())
+	case <-m.done:
+		return nil
+	}
+}
+
+func (m *Manager) reconcile(ctx context.Context) {
+	running := m.running()
+	m.reconcileCopies(ctx, running)
+	if !m.raft.IsLeader() {
+		return
+	}
+	for class, resharding := range running {
+		m.finishIfDone(ctx, class, resharding)
+	}
+}
+
+// running returns the reshardings in progress by class
+func (m *Manager) running() map[string]*sharding.Resharding {
+	running := map[string]*sharding.Resharding{}
+	for _, class := range m.schema.ReadOnlySchema().Classes {
+		state := m.schema.CopyShardingState(class.Class)
+		if state != nil && state.Resharding != nil {
+			running[class.Class] = state.Resharding
+		}
+	}
+	return running
+}
+
+// reconcileCopies starts copying the local shards of the running
+// reshardings, and stops the copies of finished ones
+func (m *Manager) reconcileCopies(ctx context.Context, running map[string]*sharding.Resharding) {
+	local := m.nodes.LocalName()
+
+	m.stopCopies(func(key shardKey, c *shardCopy) bool {
+		r, ok := running[key.class]
+		return ok && r.Version == c.version
+	})
+
+	for class, r := range running {
+		for name, physical := range r.Physical {
+			if !slices.Contains(physical.BelongsToNodes, local) {
+				continue
+			}
+			key := shardKey{class: class, shard: name}
+			m.Lock()
+			_, ok := m.copies[key]
+			m.Unlock()
+			if !ok {
+				m.startCopy(ctx, key, r.Version)
+			}
+		}
+	}
+}
+
+// stopCopies cancels and forgets the copies keep returns false for, or all
+// if keep is nil
+func (m *Manager) stopCopies(keep func(shardKey, *shardCopy) bool) {
+	m.Lock()
+	defer m.Unlock()
+
+	for key, c := range m.copies {
+		if keep != nil && keep(key, c) {
+			continue
+		}
+		c.cancel()
+		delete(m.copies, key)
+	}
+}
+
+func (m *Manager) startCopy(ctx context.Context, key shardKey, version uint64) {
+	ctx, cancel := context.WithCancel(ctx)
+	c := &shardCopy{version: version, status: ShardRunning, cancel: cancel}
+	m.Lock()
+	m.copies[key] = c
+	m.Unlock()
+
+	logger := m.logger.WithField("class", key.class).WithField("shard", key.shard)
+	logger.Info("copying objects to new shard")
+	enterrors.GoWrapper(func() {
+		err := m.db.ReshardShard(ctx, key.class, key.shard)
+
+		m.Lock()
+		defer m.Unlock()
+		if ctx.Err() != nil {
+			// stopped, the copy is started again if still needed
+			return
+		}
+		if err != nil {
+			logger.WithError(err).Error("copy objects to new shard")
+			c.status, c.err = ShardFailed, err.Error()
+			return
+		}
+		logger.Info("new shard filled")
+		c.status = ShardReady
+	}, m.logger)
+}
+
+// finishIfDone commits the resharding once every shard of the new layout is
+// filled on all of its owners, and aborts it if any shard failed
+func (m *Manager) finishIfDone(ctx context.Context, class string, r *sharding.Resharding) {
+	logger := m.logger.WithField("class", class)
+
+	done := true
+	for node, shards := range shardsByNode(r) {
+		status := m.nodeStatus(ctx, node, class)
+		if status.Error != "" || status.Version != r.Version {
+			done = false
+			continue
+		}
+
+		ready := 0
+		for _, shard := range status.Shards {
+			switch shard.Status {
+			case ShardFailed:
+				errMsg := fmt.Sprintf("node %q: shard %q: %s", node, shard.Shard, shard.Error)
+				logger.WithField("error", errMsg).Error("resharding failed, aborting")
+				if _, err := m.raft.AbortResharding(class, r.Version, errMsg); err != nil {
+					logger.WithError(err).Error("abort resharding")
+				}
+				return
+			case ShardReady:
+				ready++
+			}
+		}
+		if ready < shards {
+			done = false
+		}
+	}
+
+	if !done {
+		return
+	}
+	logger.Info("all new shards are filled, committing resharding")
+	if _, err := m.raft.CommitResharding(class, r.Version); err != nil {
+		logger.WithError(err).Error("commit resharding")
+	}
+}
+
+// shardsByNode returns the number of shards of the new layout each node owns
+func shardsByNode(r *sharding.Resharding) map[string]int {
+	shards := map[string]int{}
+	for _, physical := range r.Physical {
+		for _, node := range physical.BelongsToNodes {
+			shards[node]++
+		}
+	}
+	return shards
+}
+
+func (m *Manager) nodeStatus(ctx context.Context, node, class string) NodeStatus {
+	if node == m.nodes.LocalName() {
+		return *m.LocalStatus(class)
+	}
+
+	host, ok := m.nodes.NodeHostname(node)
+	if !ok {
+		return NodeStatus{Node: node, Error: "cannot resolve hostname"}
+	}
+	status, err := m.client.Status(ctx, host, class)
+	if err != nil {
+		return NodeStatus{Node: node, Error: err.Error()}
+	}
+	return *status
+}
+
+// LocalStatus returns the progress of the resharding of the class on the
+// shards of this node
+func (m *Manager) LocalStatus(class string) *NodeStatus {
+	status := &NodeStatus{Node: m.nodes.LocalName(), Shards: []ShardStatus{}}
+	state := m.schema.CopyShardingState(class)
+	if state == nil || state.Resharding == nil {
+		return status
+	}
+	status.Version = state.Resharding.Version
+
+	m.Lock()
+	defer m.Unlock()
+	for name, physical := range state.Resharding.Physical {
+		if !slices.Contains(physical.BelongsToNodes, status.Node) {
+			continue
+		}
+		shard := ShardStatus{Shard: name, Status: ShardRunning}
+		// copies of other versions are replaced by the next reconciliation
+		if c, ok := m.copies[shardKey{class: class, shard: name}]; ok && c.version == status.Version {
+			shard.Status, shard.Error = c.status, c.err
+		}
+		status.Shards = append(status.Shards, shard)
+	}
+	sort.Slice(status.Shards, func(i, j int) bool {
+		return status.Shards[i].Shard < status.Shards[j].Shard
+	})
+	return status
+}
+
+// Status returns the progress of the running resharding of the class, or the
+// outcome of the last one
+func (m *Manager) Status(ctx context.Context, principal *models.Principal, class string) (*Status, error) {
+	if err := m.authorizer.Authorize(principal, "list", fmt.Sprintf("schema/%s/shards", class)); err != nil {
+		return nil, err
+	}
+
+	state := m.schema.CopyShardingState(class)
+	if state == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", class))
+	}
+	if state.Resharding == nil {
+		if state.LastResharding == nil {
+			return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q was not resharded", class))
+		}
+		return &Status{Class: class, Resharding: *state.LastResharding}, nil
+	}
+
+	shards := shardsByNode(state.Resharding)
+	status := &Status{
+		Class:      class,
+		Resharding: *state.Resharding,
+		Nodes:      make([]NodeStatus, 0, len(shards)),
+	}
+	results := make(chan NodeStatus, len(shards))
+	eg := enterrors.NewErrorGroupWrapper(m.logger)
+	for node := range shards {
+		node := node
+		eg.Go(func() error {
+			results <- m.nodeStatus(ctx, node, class)
+			return nil
+		}, node)
+	}
+	eg.Wait()
+	close(results)
+
+	for nodeStatus := range results {
+		status.Nodes = append(status.Nodes, nodeStatus)
+	}
+	sort.Slice(status.Nodes, func(i, j int) bool {
+		return status.Nodes[i].Node < status.Nodes[j].Node
+	})
+	return status, nil
+}
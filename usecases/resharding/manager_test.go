@@ -0,0 +1,290 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package resharding
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/fakes"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+	ready := func(node string, version uint64, shards ...string) *NodeStatus {
+		status := &NodeStatus{Node: node, Version: version}
+		for _, shard := range shards {
+			status.Shards = append(status.Shards, ShardStatus{Shard: shard, Status: ShardReady})
+		}
+		return status
+	}
+
+	t.Run("copies the local shards and commits once all are filled", func(t *testing.T) {
+		f := newFakes(true)
+		m := f.manager()
+		f.client.status = ready("N2", 3, "S2")
+
+		m.reconcile(ctx)
+		waitForCopies(t, m)
+		assert.ElementsMatch(t, []string{"S1", "S2"}, f.db.copied())
+		assert.Equal(t, ShardReady, m.LocalStatus("C").Shards[0].Status)
+
+		m.reconcile(ctx)
+		assert.Equal(t, []uint64{3}, f.raft.committed)
+		assert.Empty(t, f.raft.aborted)
+	})
+
+	t.Run("waits for nodes which are not done", func(t *testing.T) {
+		f := newFakes(true)
+		m := f.manager()
+		f.client.status = &NodeStatus{Node: "N2", Version: 3, Shards: []ShardStatus{{Shard: "S2", Status: ShardRunning}}}
+
+		m.reconcile(ctx)
+		waitForCopies(t, m)
+		m.reconcile(ctx)
+		assert.Empty(t, f.raft.committed)
+
+		// a node which restarted reports another version until it copies again
+		f.client.status = ready("N2", 2, "S2")
+		m.reconcile(ctx)
+		assert.Empty(t, f.raft.committed)
+
+		f.client.err = errors.New("unreachable")
+		m.reconcile(ctx)
+		assert.Empty(t, f.raft.committed)
+	})
+
+	t.Run("aborts if a shard failed", func(t *testing.T) {
+		f := newFakes(true)
+		f.db.err = errors.New("source unavailable")
+		m := f.manager()
+		f.client.status = ready("N2", 3, "S2")
+
+		m.reconcile(ctx)
+		waitForCopies(t, m)
+		m.reconcile(ctx)
+		assert.Empty(t, f.raft.committed)
+		require.Len(t, f.raft.aborted, 1)
+		assert.Contains(t, f.raft.aborted[0], "source unavailable")
+	})
+
+	t.Run("a node which becomes the leader mid-job commits", func(t *testing.T) {
+		f := newFakes(false)
+		m := f.manager()
+		f.client.status = ready("N2", 3, "S2")
+
+		m.reconcile(ctx)
+		waitForCopies(t, m)
+		m.reconcile(ctx)
+		assert.Empty(t, f.raft.committed)
+
+		f.raft.SetLeader(true)
+		m.reconcile(ctx)
+		assert.Equal(t, []uint64{3}, f.raft.committed)
+		assert.Len(t, f.db.copied(), 2, "ready shards are not copied again")
+	})
+
+	t.Run("a leader which steps down mid-job does not commit", func(t *testing.T) {
+		f := newFakes(true)
+		m := f.manager()
+		f.client.status = &NodeStatus{Node: "N2", Version: 3, Shards: []ShardStatus{{Shard: "S2", Status: ShardRunning}}}
+
+		m.reconcile(ctx)
+		waitForCopies(t, m)
+		m.reconcile(ctx)
+		assert.Empty(t, f.raft.committed)
+
+		f.raft.SetLeader(false)
+		f.client.status = ready("N2", 3, "S2")
+		m.reconcile(ctx)
+		assert.Empty(t, f.raft.committed)
+		assert.Empty(t, f.raft.aborted)
+	})
+
+	t.Run("followers only copy their shards", func(t *testing.T) {
+		f := newFakes(false)
+		m := f.manager()
+		f.client.status = ready("N2", 3, "S2")
+
+		m.reconcile(ctx)
+		waitForCopies(t, m)
+		m.reconcile(ctx)
+		assert.Empty(t, f.raft.committed)
+		assert.Len(t, f.db.copied(), 2, "ready shards are not copied again")
+	})
+
+	t.Run("copies of finished reshardings are forgotten", func(t *testing.T) {
+		f := newFakes(false)
+		m := f.manager()
+
+		m.reconcile(ctx)
+		waitForCopies(t, m)
+		f.schema.States["C"].Resharding = nil
+		m.reconcile(ctx)
+		assert.Empty(t, m.copies)
+		assert.Empty(t, m.LocalStatus("C").Shards)
+	})
+}
+
+func TestStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("running", func(t *testing.T) {
+		f := newFakes(true)
+		f.client.status = &NodeStatus{Node: "N2", Version: 3, Shards: []ShardStatus{{Shard: "S2", Status: ShardReady}}}
+
+		status, err := f.manager().Status(ctx, nil, "C")
+		require.Nil(t, err)
+		assert.Equal(t, sharding.ReshardingRunning, status.Resharding.Status)
+		require.Len(t, status.Nodes, 2)
+		assert.Equal(t, "N1", status.Nodes[0].Node)
+		assert.Equal(t, []ShardStatus{{Shard: "S1", Status: ShardRunning}, {Shard: "S2", Status: ShardRunning}},
+			status.Nodes[0].Shards)
+		assert.Equal(t, *f.client.status, status.Nodes[1])
+	})
+
+	t.Run("finished", func(t *testing.T) {
+		f := newFakes(true)
+		require.Nil(t, f.schema.States["C"].AbortResharding("boom", time.Now()))
+
+		status, err := f.manager().Status(ctx, nil, "C")
+		require.Nil(t, err)
+		assert.Equal(t, sharding.ReshardingFailed, status.Resharding.Status)
+		assert.Equal(t, "boom", status.Resharding.Error)
+		assert.Empty(t, status.Nodes)
+	})
+
+	t.Run("never resharded", func(t *testing.T) {
+		f := newFakes(true)
+		f.schema.States["C"].Resharding = nil
+
+		_, err := f.manager().Status(ctx, nil, "C")
+		assert.IsType(t, enterrors.ErrNotFound{}, err)
+
+		_, err = f.manager().Status(ctx, nil, "Unknown")
+		assert.IsType(t, enterrors.ErrNotFound{}, err)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		f := newFakes(true)
+		f.authorizer.Err = errors.New("forbidden")
+		_, err := f.manager().Status(ctx, nil, "C")
+		assert.NotNil(t, err)
+	})
+}
+
+// waitForCopies waits until the copies of the local shards finished
+func waitForCopies(t *testing.T, m *Manager) {
+	require.Eventually(t, func() bool {
+		for _, shard := range m.LocalStatus("C").Shards {
+			if shard.Status == ShardRunning {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, time.Millisecond)
+}
+
+type testFakes struct {
+	authorizer *fakes.FakeAuthorizer
+	raft       *fakeRaft
+	schema     *fakes.FakeSchemaReader
+	db         *fakeDB
+	client     *fakeClient
+}
+
+// newFakes returns a class C which is resharded with version 3 to the
+// shards S1 and S2, where N1 owns both and N2 owns S2
+func newFakes(leader bool) *testFakes {
+	schema := fakes.NewFakeSchemaReader()
+	schema.States["C"] = &sharding.State{
+		Physical: map[string]sharding.Physical{"S0": {BelongsToNodes: []string{"N1"}}},
+		Resharding: &sharding.Resharding{
+			Physical: map[string]sharding.Physical{
+				"S1": {BelongsToNodes: []string{"N1"}},
+				"S2": {BelongsToNodes: []string{"N1", "N2"}},
+			},
+			Status:  sharding.ReshardingRunning,
+			Version: 3,
+		},
+	}
+	return &testFakes{
+		authorizer: &fakes.FakeAuthorizer{},
+		raft:       &fakeRaft{FakeLeader: fakes.NewFakeLeader(leader)},
+		schema:     schema,
+		db:         &fakeDB{},
+		client:     &fakeClient{},
+	}
+}
+
+func (f *testFakes) manager() *Manager {
+	logger, _ := test.NewNullLogger()
+	return New(f.authorizer, f.raft, f.schema, f.db, fakes.NewFakeNodes("N1", "N1", "N2"), f.client, logger)
+}
+
+type fakeRaft struct {
+	*fakes.FakeLeader
+	committed []uint64
+	aborted   []string
+}
+
+func (r *fakeRaft) CommitResharding(class string, version uint64) (uint64, error) {
+	r.committed = append(r.committed, version)
+	return 0, nil
+}
+
+func (r *fakeRaft) AbortResharding(class string, version uint64, errMsg string) (uint64, error) {
+	r.aborted = append(r.aborted, errMsg)
+	return 0, nil
+}
+
+type fakeDB struct {
+	sync.Mutex
+	shards []string
+	err    error
+}
+
+func (d *fakeDB) ReshardShard(ctx context.Context, class, shard string) error {
+	d.Lock()
+	defer d.Unlock()
+	d.shards = append(d.shards, shard)
+	return d.err
+}
+
+func (d *fakeDB) copied() []string {
+	d.Lock()
+	defer d.Unlock()
+	return append([]string{}, d.shards...)
+}
+
+type fakeClient struct {
+	status *NodeStatus
+	err    error
+}
+
+func (c *fakeClient) Status(ctx context.Context, host, class string) (*NodeStatus, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	if c.status == nil {
+		return &NodeStatus{Node: host}, nil
+	}
+	return c.status, nil
+}
@@ -160,6 +160,16 @@ func (s *fakeSource) ShardsBackup(
 	return args.Get(0).(backup.ClassDescriptor), args.Error(1)
 }
 
+func (s *fakeSource) SyncShardReplica(ctx context.Context, class, shard, targetNode string) error {
+	args := s.Called(ctx, class, shard, targetNode)
+	return args.Error(0)
+}
+
+func (s *fakeSource) TrackShardDeletions(ctx context.Context, class, shard string, track bool) error {
+	args := s.Called(ctx, class, shard, track)
+	return args.Error(0)
+}
+
 type fakeClient struct {
 	mock.Mock
 }
@@ -188,3 +198,17 @@ func (f *fakeClient) IncreaseReplicationFactor(ctx context.Context,
 	args := f.Called(ctx, host, class, dist)
 	return args.Error(0)
 }
+
+func (f *fakeClient) SyncShardReplica(ctx context.Context,
+	host, class, shard, targetNode string,
+) error {
+	args := f.Called(ctx, host, class, shard, targetNode)
+	return args.Error(0)
+}
+
+func (f *fakeClient) TrackShardDeletions(ctx context.Context,
+	host, class, shard string, track bool,
+) error {
+	args := f.Called(ctx, host, class, shard, track)
+	return args.Error(0)
+}
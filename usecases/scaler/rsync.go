@@ -22,6 +22,7 @@ import (
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 
 	"github.com/weaviate/weaviate/entities/backup"
+	"golang.org/x/time/rate"
 )
 
 // client the client interface is used to communicate with remote nodes
@@ -40,6 +41,14 @@ type client interface {
 	ReInitShard(ctx context.Context,
 		hostName, indexName, shardName string) error
 	IncreaseReplicationFactor(ctx context.Context, host, class string, dist ShardDist) error
+
+	// SyncShardReplica makes the node at host push the objects of its local
+	// shard which are missing or outdated on targetNode
+	SyncShardReplica(ctx context.Context, host, class, shard, targetNode string) error
+
+	// TrackShardDeletions makes the node at host start or stop recording the
+	// objects deleted from its local shard
+	TrackShardDeletions(ctx context.Context, host, class, shard string, track bool) error
 }
 
 // rsync synchronizes shards with remote nodes
@@ -47,10 +56,11 @@ type rsync struct {
 	client          client
 	cluster         cluster
 	persistenceRoot string
+	limiter         *rate.Limiter // optional bandwidth limit
 }
 
-func newRSync(c client, cl cluster, rootPath string) *rsync {
-	return &rsync{client: c, cluster: cl, persistenceRoot: rootPath}
+func newRSync(c client, cl cluster, rootPath string, limiter *rate.Limiter) *rsync {
+	return &rsync{client: c, cluster: cl, persistenceRoot: rootPath, limiter: limiter}
 }
 
 // Push pushes local shards of a class to remote nodes
@@ -124,5 +134,29 @@ func (r *rsync) PutFile(ctx context.Context, sourceFileName string,
 		return fmt.Errorf("open file %q for reading: %w", absPath, err)
 	}
 
-	return r.client.PutFile(ctx, hostname, className, shardName, sourceFileName, f)
+	var payload io.ReadSeekCloser = f
+	if r.limiter != nil {
+		payload = &throttledReader{ReadSeekCloser: f, ctx: ctx, limiter: r.limiter}
+	}
+	return r.client.PutFile(ctx, hostname, className, shardName, sourceFileName, payload)
+}
+
+// throttledReader limits the rate at which the underlying file is read
+type throttledReader struct {
+	io.ReadSeekCloser
+	ctx     context.Context
+	limiter *rate.Limiter
+}
+
+func (r *throttledReader) Read(p []byte) (int, error) {
+	if burst := r.limiter.Burst(); len(p) > burst {
+		p = p[:burst]
+	}
+	n, err := r.ReadSeekCloser.Read(p)
+	if n > 0 {
+		if werr := r.limiter.WaitN(r.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
 }
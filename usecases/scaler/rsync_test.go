@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+type nopReadSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopReadSeekCloser) Close() error { return nil }
+
+func TestThrottledReader(t *testing.T) {
+	data := bytes.Repeat([]byte{1}, 100)
+
+	t.Run("reads at most burst bytes at once", func(t *testing.T) {
+		r := &throttledReader{
+			ReadSeekCloser: nopReadSeekCloser{bytes.NewReader(data)},
+			ctx:            context.Background(),
+			limiter:        rate.NewLimiter(rate.Inf, 30),
+		}
+		buf := make([]byte, len(data))
+		n, err := r.Read(buf)
+		assert.Nil(t, err)
+		assert.Equal(t, 30, n)
+
+		got, err := io.ReadAll(r)
+		assert.Nil(t, err)
+		assert.Len(t, got, len(data)-30)
+	})
+
+	t.Run("stops when context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		r := &throttledReader{
+			ReadSeekCloser: nopReadSeekCloser{bytes.NewReader(data)},
+			ctx:            ctx,
+			limiter:        rate.NewLimiter(1, 10),
+		}
+		_, err := io.ReadAll(r)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
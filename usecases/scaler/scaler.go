@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"github.com/weaviate/weaviate/usecases/sharding/config"
+	"golang.org/x/time/rate"
 )
 
 // TODOs: Performance
@@ -47,14 +48,15 @@ var (
 type Scaler struct {
 	schema          SchemaManager
 	cluster         cluster
-	source          BackUpper // data source
-	client          client    // client for remote nodes
+	source          Source // data source
+	client          client // client for remote nodes
 	logger          logrus.FieldLogger
 	persistenceRoot string
+	transferLimiter *rate.Limiter // limits the bandwidth of file transfers
 }
 
 // New returns a new instance of Scaler
-func New(cl cluster, source BackUpper,
+func New(cl cluster, source Source,
 	c client, logger logrus.FieldLogger, persistenceRoot string,
 ) *Scaler {
 	return &Scaler{
@@ -74,6 +76,22 @@ type BackUpper interface {
 	ReleaseBackup(ctx context.Context, id, className string) error
 }
 
+// ReplicaSyncer is used to bring replicas of local shards up to date
+type ReplicaSyncer interface {
+	// SyncShardReplica pushes the objects of a local shard which are missing
+	// or outdated on the target node
+	SyncShardReplica(ctx context.Context, class, shard, targetNode string) error
+	// TrackShardDeletions starts or stops recording the objects deleted from
+	// a local shard, which are deleted on the target node by SyncShardReplica
+	TrackShardDeletions(ctx context.Context, class, shard string, track bool) error
+}
+
+// Source is the data source of the shards to be replicated
+type Source interface {
+	BackUpper
+	ReplicaSyncer
+}
+
 // cluster is used by the scaler to query cluster
 type cluster interface {
 	// Candidates returns list of existing nodes in the cluster
@@ -93,6 +111,16 @@ func (s *Scaler) SetSchemaManager(sm SchemaManager) {
 	s.schema = sm
 }
 
+// SetTransferRateLimit limits the number of bytes per second pushed by this
+// node to other nodes when copying shards. A non-positive limit disables it.
+func (s *Scaler) SetTransferRateLimit(bytesPerSecond int) {
+	if bytesPerSecond <= 0 {
+		s.transferLimiter = nil
+		return
+	}
+	s.transferLimiter = rate.NewLimiter(rate.Limit(bytesPerSecond), bytesPerSecond)
+}
+
 // Scale increase/decrease class replicas.
 //
 // It returns the updated sharding state if successful. The caller must then
@@ -211,7 +239,7 @@ func (s *Scaler) LocalScaleOut(ctx context.Context,
 			s.logger.WithField("scaler", "releaseBackup").WithField("class", className).Error(err)
 		}
 	}()
-	rsync := newRSync(s.client, s.cluster, s.persistenceRoot)
+	rsync := newRSync(s.client, s.cluster, s.persistenceRoot, s.transferLimiter)
 	return rsync.Push(ctx, bak.Shards, dist, className, s.logger)
 }
 
@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"fmt"
+)
+
+// CopyShard replicates a single shard from sourceNode to targetNode.
+//
+// The files of a snapshot of the shard are pushed to the target node by the
+// source node, exactly like scaling out does. Objects written to the shard
+// after the snapshot was taken are not part of the copy, see SyncShardReplica.
+func (s *Scaler) CopyShard(ctx context.Context,
+	className, shardName, sourceNode, targetNode string,
+) error {
+	dist := ShardDist{shardName: []string{targetNode}}
+	if sourceNode == s.cluster.LocalName() {
+		return s.LocalScaleOut(ctx, className, dist)
+	}
+
+	host, ok := s.cluster.NodeHostname(sourceNode)
+	if !ok {
+		return fmt.Errorf("%w, %q", ErrUnresolvedName, sourceNode)
+	}
+	if err := s.client.IncreaseReplicationFactor(ctx, host, className, dist); err != nil {
+		return fmt.Errorf("copy shard %q from node %q: %w", shardName, sourceNode, err)
+	}
+	return nil
+}
+
+// SyncShardReplica makes sourceNode push the objects of a shard which are
+// missing or outdated on targetNode.
+func (s *Scaler) SyncShardReplica(ctx context.Context,
+	className, shardName, sourceNode, targetNode string,
+) error {
+	if sourceNode == s.cluster.LocalName() {
+		return s.LocalSyncShardReplica(ctx, className, shardName, targetNode)
+	}
+
+	host, ok := s.cluster.NodeHostname(sourceNode)
+	if !ok {
+		return fmt.Errorf("%w, %q", ErrUnresolvedName, sourceNode)
+	}
+	if err := s.client.SyncShardReplica(ctx, host, className, shardName, targetNode); err != nil {
+		return fmt.Errorf("sync shard %q from node %q: %w", shardName, sourceNode, err)
+	}
+	return nil
+}
+
+// TrackShardDeletions makes node start or stop recording the objects deleted
+// from its shard, so that they are deleted on the target of a transfer by
+// SyncShardReplica. It must be started before the shard is copied.
+func (s *Scaler) TrackShardDeletions(ctx context.Context,
+	className, shardName, node string, track bool,
+) error {
+	if node == s.cluster.LocalName() {
+		return s.LocalTrackShardDeletions(ctx, className, shardName, track)
+	}
+
+	host, ok := s.cluster.NodeHostname(node)
+	if !ok {
+		return fmt.Errorf("%w, %q", ErrUnresolvedName, node)
+	}
+	if err := s.client.TrackShardDeletions(ctx, host, className, shardName, track); err != nil {
+		return fmt.Errorf("track deletions of shard %q on node %q: %w", shardName, node, err)
+	}
+	return nil
+}
+
+// LocalSyncShardReplica pushes the objects of a local shard which are missing
+// or outdated on targetNode.
+func (s *Scaler) LocalSyncShardReplica(ctx context.Context,
+	className, shardName, targetNode string,
+) error {
+	return s.source.SyncShardReplica(ctx, className, shardName, targetNode)
+}
+
+// LocalTrackShardDeletions starts or stops recording the objects deleted from
+// a local shard.
+func (s *Scaler) LocalTrackShardDeletions(ctx context.Context,
+	className, shardName string, track bool,
+) error {
+	return s.source.TrackShardDeletions(ctx, className, shardName, track)
+}
@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package scaler
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestScalerCopyShard(t *testing.T) {
+	var (
+		dataDir = t.TempDir()
+		ctx     = context.Background()
+		cls     = "C"
+		bak     = backup.ClassDescriptor{
+			Name: "C",
+			Shards: []*backup.ShardDescriptor{
+				{
+					Name: "S1", Files: []string{"f1"},
+					PropLengthTrackerPath: "f1",
+					ShardVersionPath:      "f1",
+					DocIDCounterPath:      "f1",
+				},
+			},
+		}
+	)
+	file, err := os.Create(path.Join(dataDir, "f1"))
+	assert.Nil(t, err)
+	file.Close()
+
+	t.Run("LocalSource", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Source.On("ShardsBackup", anyVal, anyVal, cls, []string{"S1"}).Return(bak, nil)
+		f.Client.On("CreateShard", anyVal, "H2", cls, "S1").Return(nil)
+		f.Client.On("PutFile", anyVal, "H2", cls, "S1", "f1", anyVal).Return(nil)
+		f.Client.On("ReInitShard", anyVal, "H2", cls, "S1").Return(nil)
+		f.Source.On("ReleaseBackup", anyVal, anyVal, cls).Return(nil)
+
+		err := f.Scaler(dataDir).CopyShard(ctx, cls, "S1", "N1", "N2")
+		assert.Nil(t, err)
+		f.Client.AssertNotCalled(t, "IncreaseReplicationFactor", anyVal, anyVal, anyVal, anyVal)
+	})
+
+	t.Run("RemoteSource", func(t *testing.T) {
+		f := newFakeFactory()
+		dist := ShardDist{"S3": []string{"N2"}}
+		f.Client.On("IncreaseReplicationFactor", anyVal, "H3", cls, dist).Return(nil)
+
+		err := f.Scaler(dataDir).CopyShard(ctx, cls, "S3", "N3", "N2")
+		assert.Nil(t, err)
+	})
+
+	t.Run("UnresolvedSource", func(t *testing.T) {
+		f := newFakeFactory()
+		delete(f.NodeHostMap, "N3")
+
+		err := f.Scaler(dataDir).CopyShard(ctx, cls, "S3", "N3", "N2")
+		assert.ErrorIs(t, err, ErrUnresolvedName)
+	})
+}
+
+func TestScalerSyncShardReplica(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("LocalSource", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Source.On("SyncShardReplica", anyVal, "C", "S1", "N2").Return(nil)
+
+		err := f.Scaler("").SyncShardReplica(ctx, "C", "S1", "N1", "N2")
+		assert.Nil(t, err)
+	})
+
+	t.Run("RemoteSource", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("SyncShardReplica", anyVal, "H3", "C", "S3", "N2").Return(errAny)
+
+		err := f.Scaler("").SyncShardReplica(ctx, "C", "S3", "N3", "N2")
+		assert.ErrorIs(t, err, errAny)
+	})
+}
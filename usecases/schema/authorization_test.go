@@ -100,6 +100,23 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:     "update",
 			expectedResource: "schema/className/shards/shardName",
 		},
+		{
+			methodName:       "TransferShard",
+			additionalArgs:   []interface{}{"className", "shardName", "sourceNode", "targetNode", true},
+			expectedVerb:     "update",
+			expectedResource: "schema/className/shards/shardName",
+		},
+		{
+			methodName:       "ShardTransfers",
+			expectedVerb:     "list",
+			expectedResource: "schema/*/shards",
+		},
+		{
+			methodName:       "ReshardClass",
+			additionalArgs:   []interface{}{"className", 2},
+			expectedVerb:     "update",
+			expectedResource: "schema/className/shards",
+		},
 		{
 			methodName:       "ShardsStatus",
 			additionalArgs:   []interface{}{"className", "tenant"},
@@ -156,7 +173,7 @@ func Test_Schema_Authorization(t *testing.T) {
 			switch method {
 			case "RegisterSchemaUpdateCallback",
 				// introduced by sync.Mutex in go 1.18
-				"UpdateMeta", "GetSchemaSkipAuth", "IndexedInverted", "RLock", "RUnlock", "Lock", "Unlock",
+				"UpdateMeta", "GetSchemaSkipAuth", "TransferShardSkipAuth", "IndexedInverted", "RLock", "RUnlock", "Lock", "Unlock",
 				"TryLock", "RLocker", "TryRLock", "CopyShardingState", "TxManager", "RestoreClass",
 				"ShardOwner", "TenantShard", "ShardFromUUID", "LockGuard", "RLockGuard", "ShardReplicas",
 				// internal methods to indicate readiness state
@@ -241,6 +241,17 @@ func (h *Handler) UpdateClass(ctx context.Context, principal *models.Principal,
 		if err := validateImmutableFields(initial, updated); err != nil {
 			return err
 		}
+
+		// target vectors added to the class need the same module validation
+		// as the ones of new classes
+		for name := range updated.VectorConfig {
+			if _, ok := initial.VectorConfig[name]; !ok {
+				if err := h.moduleConfig.ValidateClass(ctx, updated); err != nil {
+					return fmt.Errorf("target vector %q: %w", name, err)
+				}
+				break
+			}
+		}
 	}
 
 	_, err = h.metaWriter.UpdateClass(updated, shardingState)
@@ -678,7 +689,8 @@ func (h *Handler) validateVectorizer(vectorizer string) error {
 
 func (h *Handler) validateVectorIndexType(vectorIndexType string) error {
 	switch vectorIndexType {
-	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC:
+	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC,
+		vectorindex.VectorIndexTypeDISKANN:
 		return nil
 	default:
 		return errors.Errorf("unrecognized or unsupported vectorIndexType %q",
@@ -696,6 +708,14 @@ func validateMT(class *models.Class) error {
 		return fmt.Errorf("can't enable autoTenantActivation on a non-multi-tenant class")
 	}
 
+	if !enabled && schema.AutoTenantDeactivationEnabled(class) {
+		return fmt.Errorf("can't enable autoTenantDeactivation on a non-multi-tenant class")
+	}
+
+	if class.MultiTenancyConfig != nil && class.MultiTenancyConfig.AutoTenantDeactivationTimeout < 0 {
+		return fmt.Errorf("autoTenantDeactivationTimeout must not be negative")
+	}
+
 	return nil
 }
 
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -1328,6 +1329,60 @@ func Test_UpdateClass(t *testing.T) {
 				},
 				expectedError: nil,
 			},
+			{
+				name: "change auto tenant deactivation after creating the class",
+				initial: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "none",
+					MultiTenancyConfig: &models.MultiTenancyConfig{
+						Enabled: true,
+					},
+				},
+				update: &models.Class{
+					Class:      "InitialName",
+					Vectorizer: "none",
+					MultiTenancyConfig: &models.MultiTenancyConfig{
+						Enabled:                       true,
+						AutoTenantDeactivation:        true,
+						AutoTenantDeactivationTimeout: 600,
+					},
+				},
+				expectedError: nil,
+			},
+			{
+				name: "adding a named vector",
+				initial: &models.Class{
+					Class: "InitialName",
+					VectorConfig: map[string]models.VectorConfig{
+						"title": {VectorIndexType: "hnsw", Vectorizer: map[string]interface{}{"none": map[string]interface{}{}}},
+					},
+				},
+				update: &models.Class{
+					Class: "InitialName",
+					VectorConfig: map[string]models.VectorConfig{
+						"title": {VectorIndexType: "hnsw", Vectorizer: map[string]interface{}{"none": map[string]interface{}{}}},
+						"body":  {VectorIndexType: "hnsw", Vectorizer: map[string]interface{}{"none": map[string]interface{}{}}},
+					},
+				},
+				expectedError: nil,
+			},
+			{
+				name: "removing a named vector",
+				initial: &models.Class{
+					Class: "InitialName",
+					VectorConfig: map[string]models.VectorConfig{
+						"title": {VectorIndexType: "hnsw", Vectorizer: map[string]interface{}{"none": map[string]interface{}{}}},
+						"body":  {VectorIndexType: "hnsw", Vectorizer: map[string]interface{}{"none": map[string]interface{}{}}},
+					},
+				},
+				update: &models.Class{
+					Class: "InitialName",
+					VectorConfig: map[string]models.VectorConfig{
+						"title": {VectorIndexType: "hnsw", Vectorizer: map[string]interface{}{"none": map[string]interface{}{}}},
+					},
+				},
+				expectedError: fmt.Errorf("missing config for vector \"body\""),
+			},
 		}
 
 		for _, test := range tests {
@@ -1551,15 +1606,18 @@ func Test_AddClass_MultiTenancy(t *testing.T) {
 		require.Nil(t, err)
 		assert.False(t, schema.AutoTenantCreationEnabled(c))
 		assert.False(t, schema.AutoTenantActivationEnabled(c))
+		assert.False(t, schema.AutoTenantDeactivationEnabled(c))
 	})
 
 	t.Run("with MT enabled and all optional settings", func(t *testing.T) {
 		handler, fakeMetaHandler := newTestHandler(t, &fakeDB{})
 		class := models.Class{
 			MultiTenancyConfig: &models.MultiTenancyConfig{
-				Enabled:              true,
-				AutoTenantCreation:   true,
-				AutoTenantActivation: true,
+				Enabled:                       true,
+				AutoTenantCreation:            true,
+				AutoTenantActivation:          true,
+				AutoTenantDeactivation:        true,
+				AutoTenantDeactivationTimeout: 60,
 			},
 			Class:      "NewClass",
 			Vectorizer: "none",
@@ -1570,6 +1628,8 @@ func Test_AddClass_MultiTenancy(t *testing.T) {
 		require.Nil(t, err)
 		assert.True(t, schema.AutoTenantCreationEnabled(c))
 		assert.True(t, schema.AutoTenantActivationEnabled(c))
+		assert.True(t, schema.AutoTenantDeactivationEnabled(c))
+		assert.Equal(t, time.Minute, schema.AutoTenantDeactivationTimeout(c))
 	})
 
 	t.Run("with MT disabled, but auto tenant creation on", func(t *testing.T) {
@@ -1597,4 +1657,34 @@ func Test_AddClass_MultiTenancy(t *testing.T) {
 		_, _, err := handler.AddClass(ctx, nil, &class)
 		require.NotNil(t, err)
 	})
+
+	t.Run("with MT disabled, but auto tenant deactivation on", func(t *testing.T) {
+		handler, fakeMetaHandler := newTestHandler(t, &fakeDB{})
+		class := models.Class{
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: false, AutoTenantDeactivation: true},
+			Class:              "NewClass",
+			Vectorizer:         "none",
+		}
+
+		fakeMetaHandler.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+		_, _, err := handler.AddClass(ctx, nil, &class)
+		require.NotNil(t, err)
+	})
+
+	t.Run("with a negative auto tenant deactivation timeout", func(t *testing.T) {
+		handler, fakeMetaHandler := newTestHandler(t, &fakeDB{})
+		class := models.Class{
+			MultiTenancyConfig: &models.MultiTenancyConfig{
+				Enabled:                       true,
+				AutoTenantDeactivation:        true,
+				AutoTenantDeactivationTimeout: -1,
+			},
+			Class:      "NewClass",
+			Vectorizer: "none",
+		}
+
+		fakeMetaHandler.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+		_, _, err := handler.AddClass(ctx, nil, &class)
+		require.NotNil(t, err)
+	})
 }
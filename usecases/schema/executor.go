@@ -102,6 +102,10 @@ func (e *executor) UpdateClass(req api.UpdateClassRequest) error {
 		return errors.Wrap(err, "inverted index config")
 	}
 
+	if err := e.migrator.UpdateStorageConfig(ctx, className, req.Class.StorageConfig); err != nil {
+		return fmt.Errorf("storage config update: %w", err)
+	}
+
 	if err := e.migrator.UpdateReplicationFactor(ctx, className, req.Class.ReplicationConfig.Factor); err != nil {
 		return fmt.Errorf("replication index update: %w", err)
 	}
@@ -211,6 +215,43 @@ func (e *executor) UpdateShardStatus(req *api.UpdateShardStatusRequest) error {
 	return e.migrator.UpdateShardStatus(ctx, req.Class, req.Shard, req.Status, req.SchemaVersion)
 }
 
+func (e *executor) UpdateShardOwners(req *api.UpdateShardOwnersRequest) error {
+	ctx := context.Background()
+	if err := e.migrator.UpdateShardOwners(ctx, req.Class, req.Shard, req.Nodes); err != nil {
+		e.logger.WithFields(logrus.Fields{
+			"action": "update_shard_owners",
+			"class":  req.Class,
+			"shard":  req.Shard,
+		}).WithError(err).Error("error updating shard owners")
+	}
+
+	return nil
+}
+
+func (e *executor) StartResharding(class string) error {
+	ctx := context.Background()
+	if err := e.migrator.StartResharding(ctx, class); err != nil {
+		e.logger.WithFields(logrus.Fields{
+			"action": "start_resharding",
+			"class":  class,
+		}).WithError(err).Error("error starting resharding")
+	}
+
+	return nil
+}
+
+func (e *executor) FinishResharding(class string) error {
+	ctx := context.Background()
+	if err := e.migrator.FinishResharding(ctx, class); err != nil {
+		e.logger.WithFields(logrus.Fields{
+			"action": "finish_resharding",
+			"class":  class,
+		}).WithError(err).Error("error finishing resharding")
+	}
+
+	return nil
+}
+
 func (e *executor) GetShardsStatus(class, tenant string) (models.ShardStatusList, error) {
 	ctx := context.Background()
 	shardsStatus, err := e.migrator.GetShardsStatus(ctx, class, tenant)
@@ -60,6 +60,16 @@ func (f *fakeMetaHandler) UpdateShardStatus(class, shard, status string) (uint64
 	return 0, args.Error(0)
 }
 
+func (f *fakeMetaHandler) UpdateShardOwners(class, shard string, nodes []string) (uint64, error) {
+	args := f.Called(class, shard, nodes)
+	return 0, args.Error(0)
+}
+
+func (f *fakeMetaHandler) StartResharding(class string, resharding *sharding.Resharding) (uint64, error) {
+	args := f.Called(class, resharding)
+	return 0, args.Error(0)
+}
+
 func (f *fakeMetaHandler) AddTenants(class string, req *command.AddTenantsRequest) (uint64, error) {
 	args := f.Called(class, req)
 	return 0, args.Error(0)
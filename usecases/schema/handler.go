@@ -38,6 +38,8 @@ type metaWriter interface {
 	DeleteClass(name string) (uint64, error)
 	AddProperty(class string, p ...*models.Property) (uint64, error)
 	UpdateShardStatus(class, shard, status string) (uint64, error)
+	UpdateShardOwners(class, shard string, nodes []string) (uint64, error)
+	StartResharding(class string, resharding *sharding.Resharding) (uint64, error)
 	AddTenants(class string, req *command.AddTenantsRequest) (uint64, error)
 	UpdateTenants(class string, req *command.UpdateTenantsRequest) (uint64, error)
 	DeleteTenants(class string, req *command.DeleteTenantsRequest) (uint64, error)
@@ -114,6 +116,9 @@ type Handler struct {
 	invertedConfigValidator InvertedConfigValidator
 	scaleOut                scaleOut
 	parser                  Parser
+
+	// transfers are the shard transfers started through this node
+	transfers *shardTransfers
 }
 
 // NewHandler creates a new handler
@@ -141,6 +146,7 @@ func NewHandler(
 		moduleConfig:            moduleConfig,
 		clusterState:            clusterState,
 		scaleOut:                scaleoutManager,
+		transfers:               &shardTransfers{},
 	}
 
 	handler.scaleOut.SetSchemaManager(metaReader)
@@ -122,6 +122,18 @@ func (f *fakeDB) UpdateShardStatus(cmd *command.UpdateShardStatusRequest) error
 	return nil
 }
 
+func (f *fakeDB) UpdateShardOwners(cmd *command.UpdateShardOwnersRequest) error {
+	return nil
+}
+
+func (f *fakeDB) StartResharding(class string) error {
+	return nil
+}
+
+func (f *fakeDB) FinishResharding(class string) error {
+	return nil
+}
+
 func (f *fakeDB) GetShardsStatus(class, tenant string) (models.ShardStatusList, error) {
 	args := f.Called(class, tenant)
 	return args.Get(0).(models.ShardStatusList), nil
@@ -139,7 +151,10 @@ func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource s
 	return f.err
 }
 
-type fakeScaleOutManager struct{}
+type fakeScaleOutManager struct {
+	syncErr  error
+	tracking int
+}
 
 func (f *fakeScaleOutManager) Scale(ctx context.Context,
 	className string, updated shardingConfig.Config, _, _ int64,
@@ -150,6 +165,29 @@ func (f *fakeScaleOutManager) Scale(ctx context.Context,
 func (f *fakeScaleOutManager) SetSchemaManager(sm scaler.SchemaManager) {
 }
 
+func (f *fakeScaleOutManager) CopyShard(ctx context.Context,
+	className, shardName, sourceNode, targetNode string,
+) error {
+	return nil
+}
+
+func (f *fakeScaleOutManager) SyncShardReplica(ctx context.Context,
+	className, shardName, sourceNode, targetNode string,
+) error {
+	return f.syncErr
+}
+
+func (f *fakeScaleOutManager) TrackShardDeletions(ctx context.Context,
+	className, shardName, node string, track bool,
+) error {
+	if track {
+		f.tracking++
+	} else {
+		f.tracking--
+	}
+	return nil
+}
+
 type fakeValidator struct{}
 
 func (f *fakeValidator) ValidateVectorIndexConfigUpdate(
@@ -298,6 +336,21 @@ func (f *fakeMigrator) UpdateShardStatus(ctx context.Context, className, shardNa
 	return args.Error(0)
 }
 
+func (f *fakeMigrator) UpdateShardOwners(ctx context.Context, className, shardName string, nodes []string) error {
+	args := f.Called(ctx, className, shardName, nodes)
+	return args.Error(0)
+}
+
+func (f *fakeMigrator) StartResharding(ctx context.Context, className string) error {
+	args := f.Called(ctx, className)
+	return args.Error(0)
+}
+
+func (f *fakeMigrator) FinishResharding(ctx context.Context, className string) error {
+	args := f.Called(ctx, className)
+	return args.Error(0)
+}
+
 func (f *fakeMigrator) UpdateVectorIndexConfig(ctx context.Context, className string, updated schemaConfig.VectorIndexConfig) error {
 	args := f.Called(ctx, className, updated)
 	return args.Error(0)
@@ -323,6 +376,10 @@ func (f *fakeMigrator) UpdateInvertedIndexConfig(ctx context.Context, className
 	return args.Error(0)
 }
 
+func (f *fakeMigrator) UpdateStorageConfig(ctx context.Context, className string, updated *models.StorageConfig) error {
+	return nil
+}
+
 func (f *fakeMigrator) UpdateReplicationFactor(ctx context.Context, className string, factor int64) error {
 	return nil
 }
@@ -189,6 +189,9 @@ type scaleOut interface {
 	SetSchemaManager(sm scaler.SchemaManager)
 	Scale(ctx context.Context, className string,
 		updated shardingConfig.Config, prevReplFactor, newReplFactor int64) (*sharding.State, error)
+	CopyShard(ctx context.Context, className, shardName, sourceNode, targetNode string) error
+	SyncShardReplica(ctx context.Context, className, shardName, sourceNode, targetNode string) error
+	TrackShardDeletions(ctx context.Context, className, shardName, node string, track bool) error
 }
 
 // NewManager creates a new manager
@@ -51,6 +51,9 @@ type Migrator interface {
 
 	GetShardsStatus(ctx context.Context, className, tenant string) (map[string]string, error)
 	UpdateShardStatus(ctx context.Context, className, shardName, targetStatus string, schemaVersion uint64) error
+	UpdateShardOwners(ctx context.Context, className, shardName string, nodes []string) error
+	StartResharding(ctx context.Context, className string) error
+	FinishResharding(ctx context.Context, className string) error
 
 	UpdateVectorIndexConfig(ctx context.Context, className string, updated schemaConfig.VectorIndexConfig) error
 	ValidateVectorIndexConfigsUpdate(old, updated map[string]schemaConfig.VectorIndexConfig) error
@@ -59,6 +62,8 @@ type Migrator interface {
 	ValidateInvertedIndexConfigUpdate(old, updated *models.InvertedIndexConfig) error
 	UpdateInvertedIndexConfig(ctx context.Context, className string,
 		updated *models.InvertedIndexConfig) error
+	UpdateStorageConfig(ctx context.Context, className string,
+		updated *models.StorageConfig) error
 	UpdateReplicationFactor(ctx context.Context, className string, factor int64) error
 	UpdateAsyncReplication(ctx context.Context, className string, enabled bool) error
 	WaitForStartup(context.Context) error
@@ -109,7 +109,8 @@ func (m *Parser) parseTargetVectorsVectorIndexConfig(class *models.Class) error
 func (m *Parser) parseGivenVectorIndexConfig(vectorIndexType string,
 	vectorIndexConfig interface{},
 ) (schemaConfig.VectorIndexConfig, error) {
-	if vectorIndexType != vectorindex.VectorIndexTypeHNSW && vectorIndexType != vectorindex.VectorIndexTypeFLAT && vectorIndexType != vectorindex.VectorIndexTypeDYNAMIC {
+	if vectorIndexType != vectorindex.VectorIndexTypeHNSW && vectorIndexType != vectorindex.VectorIndexTypeFLAT && vectorIndexType != vectorindex.VectorIndexTypeDYNAMIC &&
+		vectorIndexType != vectorindex.VectorIndexTypeDISKANN {
 		return nil, errors.Errorf(
 			"parse vector index config: unsupported vector index type: %q",
 			vectorIndexType)
@@ -203,23 +204,14 @@ func validateVectorConfigsParityAndImmutables(initial, updated *models.Class) er
 		return fmt.Errorf("missing configs for vectors")
 	}
 
-	// matching cfgs on both sides
+	// existing cfgs can not be removed, additional cfgs add new target
+	// vectors to the class
 	for vecName := range initial.VectorConfig {
 		if _, ok := updated.VectorConfig[vecName]; !ok {
 			return fmt.Errorf("missing config for vector %q", vecName)
 		}
 	}
 
-	if initialVecCount != updatedVecCount {
-		for vecName := range updated.VectorConfig {
-			if _, ok := initial.VectorConfig[vecName]; !ok {
-				return fmt.Errorf("additional config for vector %q", vecName)
-			}
-		}
-		// fallback, error should be returned in loop
-		return fmt.Errorf("number of configs for vectors does not match")
-	}
-
 	// compare matching cfgs
 	for vecName, initialCfg := range initial.VectorConfig {
 		updatedCfg := updated.VectorConfig[vecName]
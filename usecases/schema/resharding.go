@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// ReshardClass starts to change the number of physical shards of a class,
+// which keeps serving traffic in the meantime:
+//
+//   - the virtual shards are distributed among the new physical shards, and
+//     the new layout is stored next to the current one through RAFT. From then
+//     on every write is applied to both layouts.
+//   - the owners of the new shards copy the existing objects of the token
+//     ranges they own from the shards of the current layout
+//   - once all new shards are filled, the leader replaces the current layout
+//     with the new one through RAFT, which drops the shards of the current
+//     layout. If copying fails, the leader discards the new layout instead.
+//
+// The resharding is stored in the schema, so it survives restarts and leader
+// changes. It is driven by the resharding manager, which also reports its
+// progress.
+func (h *Handler) ReshardClass(ctx context.Context, principal *models.Principal,
+	class string, shardCount int,
+) (*sharding.Resharding, error) {
+	err := h.Authorizer.Authorize(principal, "update", fmt.Sprintf("schema/%s/shards", class))
+	if err != nil {
+		return nil, err
+	}
+
+	info := h.metaReader.ClassInfo(class)
+	if !info.Exists {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", class))
+	}
+	if info.MultiTenancy.Enabled {
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("class %q has multi-tenancy enabled and cannot be resharded", class))
+	}
+	state := h.metaReader.CopyShardingState(class)
+	if state == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", class))
+	}
+	resharding, err := state.PlanResharding(shardCount, h.clusterState.Candidates(),
+		int64(info.ReplicationFactor))
+	if err != nil {
+		return nil, enterrors.NewErrUnprocessable(err)
+	}
+
+	resharding.Status = sharding.ReshardingRunning
+	resharding.StartTime = time.Now()
+	version, err := h.metaWriter.StartResharding(class, resharding)
+	if err != nil {
+		return nil, fmt.Errorf("start resharding: %w", err)
+	}
+	resharding.Version = version
+
+	h.logger.WithField("action", "reshard_class").
+		WithField("class", class).
+		WithField("shard_count", shardCount).
+		Info("resharding started")
+	return resharding, nil
+}
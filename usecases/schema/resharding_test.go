@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/fakes"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	"github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+func TestReshardClass(t *testing.T) {
+	ctx := context.Background()
+
+	newHandler := func(t *testing.T, mt bool) (*Handler, *fakeMetaHandler) {
+		cfg, err := config.ParseConfig(map[string]interface{}{"desiredCount": float64(1)}, 1)
+		require.Nil(t, err)
+		state, err := sharding.InitState("C", cfg, fakes.NewFakeClusterState("N1"), 1, false)
+		require.Nil(t, err)
+
+		handler, fakeMeta := newTestHandler(t, &fakeDB{})
+		handler.clusterState = fakes.NewFakeClusterState("N1", "N2", "N3")
+		fakeMeta.On("ClassInfo", "C").Return(clusterSchema.ClassInfo{
+			Exists: true, ReplicationFactor: 1,
+			MultiTenancy: models.MultiTenancyConfig{Enabled: mt},
+		})
+		fakeMeta.On("CopyShardingState", "C").Return(state)
+		return handler, fakeMeta
+	}
+
+	t.Run("starts the resharding", func(t *testing.T) {
+		handler, fakeMeta := newHandler(t, false)
+		fakeMeta.On("StartResharding", "C", mock.MatchedBy(func(r *sharding.Resharding) bool {
+			return len(r.Physical) == 3 && r.Status == sharding.ReshardingRunning && !r.StartTime.IsZero()
+		})).Return(nil).Once()
+
+		started, err := handler.ReshardClass(ctx, nil, "C", 3)
+		require.Nil(t, err)
+		assert.Len(t, started.Physical, 3)
+		fakeMeta.AssertExpectations(t)
+	})
+
+	t.Run("failed start", func(t *testing.T) {
+		handler, fakeMeta := newHandler(t, false)
+		fakeMeta.On("StartResharding", "C", mock.Anything).Return(errors.New("no leader")).Once()
+
+		_, err := handler.ReshardClass(ctx, nil, "C", 3)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		handler, fakeMeta := newHandler(t, false)
+		fakeMeta.On("ClassInfo", "D").Return(clusterSchema.ClassInfo{})
+		_, err := handler.ReshardClass(ctx, nil, "D", 3)
+		assert.IsType(t, enterrors.ErrNotFound{}, err)
+
+		_, err = handler.ReshardClass(ctx, nil, "C", 0)
+		assert.IsType(t, enterrors.ErrUnprocessable{}, err)
+
+		handler, _ = newHandler(t, true)
+		_, err = handler.ReshardClass(ctx, nil, "C", 3)
+		assert.IsType(t, enterrors.ErrUnprocessable{}, err)
+	})
+}
@@ -0,0 +1,299 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	TransferRunning = "RUNNING"
+	TransferSuccess = "SUCCESS"
+	TransferFailed  = "FAILED"
+
+	// maxTransferHistory is the number of finished transfers kept for the
+	// status
+	maxTransferHistory = 100
+)
+
+// ShardTransfer is a shard transfer started through the API
+type ShardTransfer struct {
+	ID         string
+	Class      string
+	Shard      string
+	SourceNode string
+	TargetNode string
+	Move       bool
+	Status     string
+	Error      string
+	StartTime  time.Time
+	FinishTime time.Time
+}
+
+// shardTransfers are the transfers started through this node, running ones
+// and the most recent finished ones
+type shardTransfers struct {
+	sync.Mutex
+	transfers []*ShardTransfer
+}
+
+// start registers a new transfer, unless the shard is already transferred
+func (t *shardTransfers) start(class, shard, sourceNode, targetNode string, move bool,
+) (*ShardTransfer, error) {
+	t.Lock()
+	defer t.Unlock()
+
+	finished := 0
+	for _, tr := range t.transfers {
+		if tr.Status != TransferRunning {
+			finished++
+			continue
+		}
+		if tr.Class == class && tr.Shard == shard {
+			return nil, enterrors.NewErrUnprocessable(
+				fmt.Errorf("shard %q is already transferred by %s", shard, tr.ID))
+		}
+	}
+	if finished >= maxTransferHistory {
+		// drop the oldest finished transfer
+		for i, tr := range t.transfers {
+			if tr.Status != TransferRunning {
+				t.transfers = slices.Delete(t.transfers, i, i+1)
+				break
+			}
+		}
+	}
+
+	tr := &ShardTransfer{
+		ID:         uuid.NewString(),
+		Class:      class,
+		Shard:      shard,
+		SourceNode: sourceNode,
+		TargetNode: targetNode,
+		Move:       move,
+		Status:     TransferRunning,
+		StartTime:  time.Now(),
+	}
+	t.transfers = append(t.transfers, tr)
+	return tr, nil
+}
+
+func (t *shardTransfers) finish(tr *ShardTransfer, err error) {
+	t.Lock()
+	defer t.Unlock()
+
+	tr.FinishTime = time.Now()
+	if err != nil {
+		tr.Status = TransferFailed
+		tr.Error = err.Error()
+		return
+	}
+	tr.Status = TransferSuccess
+}
+
+func (t *shardTransfers) list() []ShardTransfer {
+	t.Lock()
+	defer t.Unlock()
+
+	transfers := make([]ShardTransfer, len(t.transfers))
+	for i, tr := range t.transfers {
+		transfers[i] = *tr
+	}
+	return transfers
+}
+
+// TransferShard starts to copy a physical shard (or tenant) of a class from
+// sourceNode to targetNode, or to move it if move is set, in the background.
+// The shard keeps serving traffic during the transfer:
+//
+//   - the source node pushes a snapshot of the shard to the target node
+//   - the target node is added to the shard owners through RAFT
+//   - objects written or deleted in the meantime are synced from the source
+//     node
+//   - for a move, the target node becomes the primary owner, the shard is
+//     synced once more and finally the source node is removed from the owners,
+//     which drops its copy of the shard
+//
+// The state of the transfer is returned by ShardTransfers.
+func (h *Handler) TransferShard(ctx context.Context, principal *models.Principal,
+	class, shard, sourceNode, targetNode string, move bool,
+) (*ShardTransfer, error) {
+	err := h.Authorizer.Authorize(principal, "update",
+		fmt.Sprintf("schema/%s/shards/%s", class, shard))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.validateShardTransfer(class, shard, sourceNode, targetNode, move); err != nil {
+		return nil, err
+	}
+
+	tr, err := h.transfers.start(class, shard, sourceNode, targetNode, move)
+	if err != nil {
+		return nil, err
+	}
+	status := *tr
+	enterrors.GoWrapper(func() {
+		// the transfer outlives the request
+		err := h.TransferShardSkipAuth(context.Background(), class, shard, sourceNode, targetNode, move)
+		if err != nil {
+			h.logger.WithField("action", "transfer_shard").
+				WithField("transfer_id", tr.ID).
+				WithError(err).Error("transfer shard")
+		}
+		h.transfers.finish(tr, err)
+	}, h.logger)
+
+	return &status, nil
+}
+
+// ShardTransfers returns the shard transfers started through this node, most
+// recent last
+func (h *Handler) ShardTransfers(ctx context.Context, principal *models.Principal) ([]ShardTransfer, error) {
+	if err := h.Authorizer.Authorize(principal, "list", "schema/*/shards"); err != nil {
+		return nil, err
+	}
+	return h.transfers.list(), nil
+}
+
+// TransferShardSkipAuth transfers a shard like TransferShard, but without
+// authorization and blocking until the transfer is done. It's meant for
+// internal jobs like the rebalancer.
+//
+// If the transfer fails after the target node was added to the shard owners,
+// the owners are restored, which drops the copy of the target node.
+func (h *Handler) TransferShardSkipAuth(ctx context.Context,
+	class, shard, sourceNode, targetNode string, move bool,
+) (err error) {
+	current, err := h.validateShardTransfer(class, shard, sourceNode, targetNode, move)
+	if err != nil {
+		return err
+	}
+
+	logger := h.logger.WithField("action", "transfer_shard").
+		WithField("class", class).
+		WithField("shard", shard).
+		WithField("source_node", sourceNode).
+		WithField("target_node", targetNode).
+		WithField("move", move)
+
+	// deletions must be tracked from before the snapshot, otherwise objects
+	// deleted during the transfer would survive on the target node
+	if err := h.scaleOut.TrackShardDeletions(ctx, class, shard, sourceNode, true); err != nil {
+		return fmt.Errorf("track deletions on node %q: %w", sourceNode, err)
+	}
+	defer func() {
+		if err := h.scaleOut.TrackShardDeletions(context.Background(), class, shard, sourceNode, false); err != nil {
+			logger.WithError(err).Warn("stop tracking deletions")
+		}
+	}()
+
+	logger.Info("copying shard")
+	if err := h.scaleOut.CopyShard(ctx, class, shard, sourceNode, targetNode); err != nil {
+		return err
+	}
+
+	owners := append(slices.Clone(current), targetNode)
+	if _, err := h.metaWriter.UpdateShardOwners(class, shard, owners); err != nil {
+		return fmt.Errorf("add node %q to shard owners: %w", targetNode, err)
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		if _, rerr := h.metaWriter.UpdateShardOwners(class, shard, current); rerr != nil {
+			logger.WithError(rerr).Error("restore shard owners")
+			return
+		}
+		logger.Info("restored shard owners")
+	}()
+
+	if err := h.scaleOut.SyncShardReplica(ctx, class, shard, sourceNode, targetNode); err != nil {
+		return err
+	}
+	if !move {
+		logger.Info("shard copied")
+		return nil
+	}
+
+	// make the target node the primary owner, so that it receives the writes of
+	// non-replicated classes, before syncing objects written to the source node
+	// for the last time
+	owners = append([]string{targetNode}, current...)
+	if _, err := h.metaWriter.UpdateShardOwners(class, shard, owners); err != nil {
+		return fmt.Errorf("promote node %q to shard owner: %w", targetNode, err)
+	}
+	if err := h.scaleOut.SyncShardReplica(ctx, class, shard, sourceNode, targetNode); err != nil {
+		return err
+	}
+
+	owners = slices.DeleteFunc(slices.Clone(owners), func(n string) bool { return n == sourceNode })
+	if _, err := h.metaWriter.UpdateShardOwners(class, shard, owners); err != nil {
+		return fmt.Errorf("remove node %q from shard owners: %w", sourceNode, err)
+	}
+
+	logger.Info("shard moved")
+	return nil
+}
+
+// validateShardTransfer returns the current owners of the shard if it can be
+// transferred from sourceNode to targetNode
+func (h *Handler) validateShardTransfer(class, shard, sourceNode, targetNode string, move bool,
+) ([]string, error) {
+	if sourceNode == targetNode {
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("source and target node are the same: %q", sourceNode))
+	}
+	if !slices.Contains(h.clusterState.AllNames(), targetNode) {
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("target node %q is not part of the cluster", targetNode))
+	}
+
+	info := h.metaReader.ClassInfo(class)
+	if !info.Exists {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", class))
+	}
+	if !move && info.ReplicationFactor < 2 {
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("class %q is not replicated, shards can only be moved", class))
+	}
+
+	state := h.metaReader.CopyShardingState(class)
+	if state == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", class))
+	}
+	physical, ok := state.Physical[shard]
+	if !ok {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("shard %q not found", shard))
+	}
+	if info.MultiTenancy.Enabled && physical.ActivityStatus() != models.TenantActivityStatusHOT {
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("tenant %q is not active", shard))
+	}
+	if !slices.Contains(physical.BelongsToNodes, sourceNode) {
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("shard %q does not belong to source node %q", shard, sourceNode))
+	}
+	if slices.Contains(physical.BelongsToNodes, targetNode) {
+		return nil, enterrors.NewErrUnprocessable(
+			fmt.Errorf("shard %q already belongs to target node %q", shard, targetNode))
+	}
+
+	return physical.BelongsToNodes, nil
+}
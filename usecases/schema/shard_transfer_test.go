@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/fakes"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestTransferShard(t *testing.T) {
+	ctx := context.Background()
+
+	newHandler := func(t *testing.T, replicationFactor int) (*Handler, *fakeMetaHandler) {
+		handler, fakeMeta := newTestHandler(t, &fakeDB{})
+		handler.clusterState = fakes.NewFakeClusterState("N1", "N2", "N3")
+		fakeMeta.On("ClassInfo", "C").Return(clusterSchema.ClassInfo{
+			Exists: true, ReplicationFactor: replicationFactor,
+		})
+		fakeMeta.On("CopyShardingState", "C").Return(&sharding.State{
+			Physical: map[string]sharding.Physical{
+				"S1": {Name: "S1", BelongsToNodes: []string{"N1"}},
+			},
+		})
+		return handler, fakeMeta
+	}
+
+	t.Run("move", func(t *testing.T) {
+		handler, fakeMeta := newHandler(t, 1)
+		fakeMeta.On("UpdateShardOwners", "C", "S1", []string{"N1", "N2"}).Return(nil).Once()
+		fakeMeta.On("UpdateShardOwners", "C", "S1", []string{"N2", "N1"}).Return(nil).Once()
+		fakeMeta.On("UpdateShardOwners", "C", "S1", []string{"N2"}).Return(nil).Once()
+
+		err := handler.TransferShardSkipAuth(ctx, "C", "S1", "N1", "N2", true)
+		require.Nil(t, err)
+		fakeMeta.AssertExpectations(t)
+		assert.Equal(t, 0, handler.scaleOut.(*fakeScaleOutManager).tracking)
+	})
+
+	t.Run("copy", func(t *testing.T) {
+		handler, fakeMeta := newHandler(t, 2)
+		fakeMeta.On("UpdateShardOwners", "C", "S1", []string{"N1", "N2"}).Return(nil).Once()
+
+		err := handler.TransferShardSkipAuth(ctx, "C", "S1", "N1", "N2", false)
+		require.Nil(t, err)
+		fakeMeta.AssertExpectations(t)
+	})
+
+	t.Run("failed sync restores the owners", func(t *testing.T) {
+		handler, fakeMeta := newHandler(t, 1)
+		handler.scaleOut.(*fakeScaleOutManager).syncErr = errors.New("boom")
+		fakeMeta.On("UpdateShardOwners", "C", "S1", []string{"N1", "N2"}).Return(nil).Once()
+		fakeMeta.On("UpdateShardOwners", "C", "S1", []string{"N1"}).Return(nil).Once()
+
+		err := handler.TransferShardSkipAuth(ctx, "C", "S1", "N1", "N2", true)
+		require.NotNil(t, err)
+		fakeMeta.AssertExpectations(t)
+		assert.Equal(t, 0, handler.scaleOut.(*fakeScaleOutManager).tracking)
+	})
+
+	t.Run("runs in the background", func(t *testing.T) {
+		handler, fakeMeta := newHandler(t, 2)
+		fakeMeta.On("UpdateShardOwners", "C", "S1", []string{"N1", "N2"}).Return(nil).Once()
+
+		transfer, err := handler.TransferShard(ctx, nil, "C", "S1", "N1", "N2", false)
+		require.Nil(t, err)
+		assert.NotEmpty(t, transfer.ID)
+
+		require.Eventually(t, func() bool {
+			transfers, err := handler.ShardTransfers(ctx, nil)
+			require.Nil(t, err)
+			require.Len(t, transfers, 1)
+			return transfers[0].Status == TransferSuccess
+		}, 5*time.Second, 10*time.Millisecond)
+		fakeMeta.AssertExpectations(t)
+	})
+
+	t.Run("copy of non replicated class", func(t *testing.T) {
+		handler, _ := newHandler(t, 1)
+		_, err := handler.TransferShard(ctx, nil, "C", "S1", "N1", "N2", false)
+		assert.IsType(t, enterrors.ErrUnprocessable{}, err)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		handler, _ := newHandler(t, 1)
+
+		_, err := handler.TransferShard(ctx, nil, "C", "S2", "N1", "N2", true)
+		assert.IsType(t, enterrors.ErrNotFound{}, err)
+
+		_, err = handler.TransferShard(ctx, nil, "C", "S1", "N3", "N2", true)
+		assert.IsType(t, enterrors.ErrUnprocessable{}, err)
+
+		_, err = handler.TransferShard(ctx, nil, "C", "S1", "N1", "N1", true)
+		assert.IsType(t, enterrors.ErrUnprocessable{}, err)
+
+		_, err = handler.TransferShard(ctx, nil, "C", "S1", "N1", "N4", true)
+		assert.IsType(t, enterrors.ErrUnprocessable{}, err)
+	})
+}
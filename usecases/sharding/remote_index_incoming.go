@@ -53,7 +53,7 @@ type RemoteIndexIncomingRepo interface {
 	IncomingExists(ctx context.Context, shardName string,
 		id strfmt.UUID) (bool, error)
 	IncomingDeleteObject(ctx context.Context, shardName string,
-		id strfmt.UUID, schemaVersion uint64) error
+		id strfmt.UUID, expectedVersion *int64, schemaVersion uint64) error
 	IncomingMergeObject(ctx context.Context, shardName string,
 		mergeDoc objects.MergeDocument, schemaVersion uint64) error
 	IncomingMultiGetObjects(ctx context.Context, shardName string,
@@ -161,14 +161,14 @@ func (rii *RemoteIndexIncoming) Exists(ctx context.Context, indexName,
 }
 
 func (rii *RemoteIndexIncoming) DeleteObject(ctx context.Context, indexName,
-	shardName string, id strfmt.UUID, schemaVersion uint64,
+	shardName string, id strfmt.UUID, expectedVersion *int64, schemaVersion uint64,
 ) error {
 	index, err := rii.indexForIncomingWrite(ctx, indexName, schemaVersion)
 	if err != nil {
 		return err
 	}
 
-	return index.IncomingDeleteObject(ctx, shardName, id, schemaVersion)
+	return index.IncomingDeleteObject(ctx, shardName, id, expectedVersion, schemaVersion)
 }
 
 func (rii *RemoteIndexIncoming) MergeObject(ctx context.Context, indexName,
@@ -21,6 +21,8 @@ import (
 type RemoteNodeClient interface {
 	GetNodeStatus(ctx context.Context, hostName, className, output string) (*models.NodeStatus, error)
 	GetStatistics(ctx context.Context, hostName string) (*models.Statistics, error)
+	RequestAsyncReplicationComparison(ctx context.Context, hostName, className string) error
+	SetDynamicIndexUpgradePolicy(ctx context.Context, hostName, className, tenant, targetVector, policy string) error
 }
 
 type RemoteNode struct {
@@ -50,3 +52,21 @@ func (rn *RemoteNode) GetStatistics(ctx context.Context, nodeName string) (*mode
 	}
 	return rn.client.GetStatistics(ctx, host)
 }
+
+func (rn *RemoteNode) RequestAsyncReplicationComparison(ctx context.Context, nodeName, className string) error {
+	host, ok := rn.nodeResolver.NodeHostname(nodeName)
+	if !ok {
+		return fmt.Errorf("resolve node name %q to host", nodeName)
+	}
+	return rn.client.RequestAsyncReplicationComparison(ctx, host, className)
+}
+
+func (rn *RemoteNode) SetDynamicIndexUpgradePolicy(ctx context.Context,
+	nodeName, className, tenant, targetVector, policy string,
+) error {
+	host, ok := rn.nodeResolver.NodeHostname(nodeName)
+	if !ok {
+		return fmt.Errorf("resolve node name %q to host", nodeName)
+	}
+	return rn.client.SetDynamicIndexUpgradePolicy(ctx, host, className, tenant, targetVector, policy)
+}
@@ -20,6 +20,8 @@ import (
 type RemoteNodeIncomingRepo interface {
 	IncomingGetNodeStatus(ctx context.Context, className, output string) (*models.NodeStatus, error)
 	IncomingGetNodeStatistics() (*models.Statistics, error)
+	IncomingRequestAsyncReplicationComparison(ctx context.Context, className string) error
+	IncomingSetDynamicIndexUpgradePolicy(ctx context.Context, className, tenant, targetVector, policy string) error
 }
 
 type RemoteNodeIncoming struct {
@@ -39,3 +41,13 @@ func (rni *RemoteNodeIncoming) GetNodeStatus(ctx context.Context, className, out
 func (rni *RemoteNodeIncoming) GetStatistics(ctx context.Context) (*models.Statistics, error) {
 	return rni.repo.IncomingGetNodeStatistics()
 }
+
+func (rni *RemoteNodeIncoming) RequestAsyncReplicationComparison(ctx context.Context, className string) error {
+	return rni.repo.IncomingRequestAsyncReplicationComparison(ctx, className)
+}
+
+func (rni *RemoteNodeIncoming) SetDynamicIndexUpgradePolicy(ctx context.Context,
+	className, tenant, targetVector, policy string,
+) error {
+	return rni.repo.IncomingSetDynamicIndexUpgradePolicy(ctx, className, tenant, targetVector, policy)
+}
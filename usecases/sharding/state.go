@@ -16,6 +16,7 @@ import (
 	"math"
 	"math/rand"
 	"sort"
+	"time"
 
 	"github.com/spaolacci/murmur3"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -32,6 +33,12 @@ type State struct {
 	Virtual             []Virtual           `json:"virtual"`
 	PartitioningEnabled bool                `json:"partitioningEnabled"`
 
+	// Resharding is set while the physical shards are split or merged
+	Resharding *Resharding `json:"resharding,omitempty"`
+	// LastResharding is the last committed or aborted resharding, without
+	// its virtual shards
+	LastResharding *Resharding `json:"lastResharding,omitempty"`
+
 	// different for each node, not to be serialized
 	localNodeName string // TODO: localNodeName is static it is better to store just once
 }
@@ -51,6 +58,36 @@ func (s *State) MigrateFromOldFormat() {
 	}
 }
 
+// Resharding is the layout a class is resharded to. The virtual shards are
+// the same as the ones of the current layout, but assigned to a new set of
+// physical shards. Until the resharding is committed, objects are routed
+// according to the current layout and additionally written to the new one.
+type Resharding struct {
+	Physical map[string]Physical `json:"physical"`
+	Virtual  []Virtual           `json:"virtual"`
+
+	Status     string    `json:"status,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	StartTime  time.Time `json:"startTime"`
+	FinishTime time.Time `json:"finishTime,omitempty"`
+	// Version is the schema version the resharding was started with. It
+	// tells reshardings of the same class apart.
+	Version uint64 `json:"version"`
+}
+
+const (
+	ReshardingRunning   = "RUNNING"
+	ReshardingCompleted = "COMPLETED"
+	ReshardingFailed    = "FAILED"
+)
+
+// TokenRange is a range of object tokens, including From and To, which is
+// copied from the physical shard Source while resharding a class
+type TokenRange struct {
+	Source   string
+	From, To uint64
+}
+
 type Virtual struct {
 	Name               string  `json:"name"`
 	Upper              uint64  `json:"upper"`
@@ -173,13 +210,135 @@ func (s *State) PhysicalShard(in []byte) string {
 		panic("no virtual shards present")
 	}
 
-	h := murmur3.New64()
-	h.Write(in)
-	token := h.Sum64()
+	return virtualByToken(s.Virtual, tokenOf(in)).AssignedToPhysical
+}
+
+// ReshardingShard returns the physical shard of the new layout an object is
+// written to while the class is resharded, or an empty string otherwise
+func (s *State) ReshardingShard(in []byte) string {
+	if s.Resharding == nil || len(s.Resharding.Virtual) == 0 {
+		return ""
+	}
+	return virtualByToken(s.Resharding.Virtual, tokenOf(in)).AssignedToPhysical
+}
+
+// PlanResharding returns a new layout of count physical shards spread over
+// the given nodes. The existing virtual shards are redistributed among the
+// new physical shards, therefore count cannot exceed the number of virtual
+// shards. The state itself is not changed.
+func (s *State) PlanResharding(count int, nodes []string, replFactor int64) (*Resharding, error) {
+	if s.PartitioningEnabled {
+		return nil, fmt.Errorf("resharding is not supported for multi-tenant classes")
+	}
+	if s.Resharding != nil {
+		return nil, fmt.Errorf("resharding already in progress")
+	}
+	if count < 1 || count > len(s.Virtual) {
+		return nil, fmt.Errorf("shard count must be between 1 and %d: got %d", len(s.Virtual), count)
+	}
+	if f, n := replFactor, len(nodes); n == 0 || f > int64(n) {
+		return nil, fmt.Errorf("not enough storage replicas: found %d want %d", n, f)
+	}
 
-	virtual := s.virtualByToken(token)
+	target := State{Config: s.Config.DeepCopy()}
+	target.Config.DesiredCount = count
+	if err := target.initPhysical(nodes, replFactor); err != nil {
+		return nil, err
+	}
+	for name := range target.Physical {
+		if _, ok := s.Physical[name]; ok {
+			return nil, fmt.Errorf("generated shard name %q already exists", name)
+		}
+	}
+	target.Virtual = make([]Virtual, len(s.Virtual))
+	for i, v := range s.Virtual {
+		target.Virtual[i] = v.DeepCopy()
+		target.Virtual[i].AssignedToPhysical = ""
+	}
+	target.distributeVirtualAmongPhysical()
+
+	return &Resharding{Physical: target.Physical, Virtual: target.Virtual}, nil
+}
+
+// CommitResharding replaces the current layout with the one the class is
+// resharded to
+func (s *State) CommitResharding(finishTime time.Time) error {
+	if s.Resharding == nil {
+		return fmt.Errorf("no resharding in progress")
+	}
+	s.Physical = s.Resharding.Physical
+	s.Virtual = s.Resharding.Virtual
+	s.Config.DesiredCount = len(s.Physical)
+	s.Config.ActualCount = len(s.Physical)
+	s.finishResharding(ReshardingCompleted, "", finishTime)
+	return nil
+}
 
-	return virtual.AssignedToPhysical
+// AbortResharding discards the layout the class is resharded to
+func (s *State) AbortResharding(errMsg string, finishTime time.Time) error {
+	if s.Resharding == nil {
+		return fmt.Errorf("no resharding in progress")
+	}
+	s.finishResharding(ReshardingFailed, errMsg, finishTime)
+	return nil
+}
+
+func (s *State) finishResharding(status, errMsg string, finishTime time.Time) {
+	last := s.Resharding.DeepCopy()
+	last.Virtual = nil
+	last.Status = status
+	last.Error = errMsg
+	last.FinishTime = finishTime
+	s.LastResharding = last
+	s.Resharding = nil
+}
+
+// ReshardingSources returns the token ranges which have to be copied to the
+// physical shard target of the new layout, together with the physical shards
+// of the current layout they are copied from
+func (s *State) ReshardingSources(target string) []TokenRange {
+	if s.Resharding == nil {
+		return nil
+	}
+	current := make(map[string]string, len(s.Virtual))
+	for _, v := range s.Virtual {
+		current[v.Name] = v.AssignedToPhysical
+	}
+
+	virtual := s.Resharding.Virtual
+	var ranges []TokenRange
+	add := func(r TokenRange) {
+		if n := len(ranges); n > 0 && ranges[n-1].Source == r.Source &&
+			ranges[n-1].To < math.MaxUint64 && ranges[n-1].To+1 == r.From {
+			ranges[n-1].To = r.To
+			return
+		}
+		ranges = append(ranges, r)
+	}
+	for i, v := range virtual {
+		if v.AssignedToPhysical != target {
+			continue
+		}
+		source := current[v.Name]
+		if i == 0 {
+			add(TokenRange{Source: source, From: 0, To: v.Upper})
+			continue
+		}
+		add(TokenRange{Source: source, From: virtual[i-1].Upper + 1, To: v.Upper})
+	}
+	// the first virtual shard also owns the tokens after the last one
+	if first, last := virtual[0], virtual[len(virtual)-1]; first.AssignedToPhysical == target &&
+		last.Upper < math.MaxUint64 {
+		add(TokenRange{Source: current[first.Name], From: last.Upper + 1, To: math.MaxUint64})
+	}
+
+	return ranges
+}
+
+func tokenOf(in []byte) uint64 {
+	h := murmur3.New64()
+	h.Write(in)
+	return h.Sum64()
 }
 
 // CountPhysicalShards return a count of physical shards
@@ -447,16 +606,16 @@ func (s *State) VirtualByName(name string) *Virtual {
 	return nil
 }
 
-func (s *State) virtualByToken(token uint64) *Virtual {
-	for i := range s.Virtual {
-		if token > s.Virtual[i].Upper {
+func virtualByToken(virtual []Virtual, token uint64) *Virtual {
+	for i := range virtual {
+		if token > virtual[i].Upper {
 			continue
 		}
 
-		return &s.Virtual[i]
+		return &virtual[i]
 	}
 
-	return &s.Virtual[0]
+	return &virtual[0]
 }
 
 const shardNameChars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
@@ -492,7 +651,30 @@ func (s State) DeepCopy() State {
 		Physical:            physicalCopy,
 		Virtual:             virtualCopy,
 		PartitioningEnabled: s.PartitioningEnabled,
+		Resharding:          s.Resharding.DeepCopy(),
+		LastResharding:      s.LastResharding.DeepCopy(),
+	}
+}
+
+func (r *Resharding) DeepCopy() *Resharding {
+	if r == nil {
+		return nil
+	}
+	physicalCopy := make(map[string]Physical, len(r.Physical))
+	for name, shard := range r.Physical {
+		physicalCopy[name] = shard.DeepCopy()
+	}
+	var virtualCopy []Virtual
+	if r.Virtual != nil {
+		virtualCopy = make([]Virtual, len(r.Virtual))
+	}
+	for i, virtual := range r.Virtual {
+		virtualCopy[i] = virtual.DeepCopy()
 	}
+	copied := *r
+	copied.Physical = physicalCopy
+	copied.Virtual = virtualCopy
+	return &copied
 }
 
 func (p Physical) DeepCopy() Physical {
@@ -15,7 +15,10 @@ import (
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -562,3 +565,89 @@ func TestApplyNodeMapping(t *testing.T) {
 		})
 	}
 }
+
+func TestResharding(t *testing.T) {
+	cfg, err := config.ParseConfig(map[string]interface{}{"desiredCount": float64(1)}, 1)
+	require.Nil(t, err)
+	state, err := InitState("my-index", cfg, fakeNodes{[]string{"node1"}}, 1, false)
+	require.Nil(t, err)
+	oldShard := state.AllPhysicalShards()[0]
+
+	nodes := []string{"node1", "node2", "node3"}
+	t.Run("invalid shard count", func(t *testing.T) {
+		_, err := state.PlanResharding(0, nodes, 1)
+		assert.NotNil(t, err)
+		_, err = state.PlanResharding(len(state.Virtual)+1, nodes, 1)
+		assert.NotNil(t, err)
+		_, err = state.PlanResharding(3, nodes, 4)
+		assert.NotNil(t, err)
+	})
+
+	plan, err := state.PlanResharding(3, nodes, 1)
+	require.Nil(t, err)
+	require.Len(t, plan.Physical, 3)
+	require.Len(t, plan.Virtual, len(state.Virtual))
+	for _, p := range plan.Physical {
+		assert.Len(t, p.BelongsToNodes, 1)
+		assert.NotEmpty(t, p.OwnsVirtual)
+	}
+	assert.Equal(t, oldShard, state.Virtual[0].AssignedToPhysical, "plan must not change the state")
+
+	state.Resharding = plan
+	_, err = state.PlanResharding(3, nodes, 1)
+	assert.NotNil(t, err, "already in progress")
+
+	t.Run("routing", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			id := make([]byte, 16)
+			rand.Read(id)
+			assert.Equal(t, oldShard, state.PhysicalShard(id))
+			assert.Contains(t, plan.Physical, state.ReshardingShard(id))
+		}
+	})
+
+	t.Run("sources cover all tokens", func(t *testing.T) {
+		var ranges []TokenRange
+		for name := range plan.Physical {
+			for _, r := range state.ReshardingSources(name) {
+				assert.Equal(t, oldShard, r.Source)
+				assert.LessOrEqual(t, r.From, r.To)
+				ranges = append(ranges, r)
+			}
+		}
+		sort.Slice(ranges, func(i, j int) bool { return ranges[i].From < ranges[j].From })
+		assert.Equal(t, uint64(0), ranges[0].From)
+		assert.Equal(t, uint64(math.MaxUint64), ranges[len(ranges)-1].To)
+		for i := 1; i < len(ranges); i++ {
+			assert.Equal(t, ranges[i-1].To+1, ranges[i].From)
+		}
+	})
+
+	t.Run("deep copy", func(t *testing.T) {
+		cp := state.DeepCopy()
+		require.NotNil(t, cp.Resharding)
+		assert.Equal(t, state.Resharding, cp.Resharding)
+		cp.Resharding.Virtual[0].AssignedToPhysical = "changed"
+		assert.NotEqual(t, "changed", state.Resharding.Virtual[0].AssignedToPhysical)
+	})
+
+	t.Run("commit", func(t *testing.T) {
+		id := make([]byte, 16)
+		rand.Read(id)
+		target := state.ReshardingShard(id)
+
+		now := time.Now()
+		require.Nil(t, state.CommitResharding(now))
+		assert.Nil(t, state.Resharding)
+		require.NotNil(t, state.LastResharding)
+		assert.Equal(t, ReshardingCompleted, state.LastResharding.Status)
+		assert.Equal(t, now, state.LastResharding.FinishTime)
+		assert.Nil(t, state.LastResharding.Virtual)
+		assert.Equal(t, 3, state.Config.DesiredCount)
+		assert.Equal(t, 3, state.CountPhysicalShards())
+		assert.Equal(t, target, state.PhysicalShard(id))
+		assert.Equal(t, "", state.ReshardingShard(id))
+		assert.NotNil(t, state.CommitResharding(now))
+		assert.NotNil(t, state.AbortResharding("boom", now))
+	})
+}
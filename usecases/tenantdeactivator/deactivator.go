@@ -0,0 +1,324 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package tenantdeactivator turns HOT tenants of classes with
+// autoTenantDeactivation enabled COLD, once they were not accessed for the
+// timeout of their class or the node holds more HOT tenants than it may.
+//
+// The deactivator runs on every node. A tenant is only deactivated by the
+// first node of its replica set, so that the nodes of a cluster don't propose
+// the same change. It is idle once none of its replicas served an access
+// within the timeout. The change is a regular tenant update through RAFT,
+// with autoTenantActivation enabled the tenant is turned HOT again on its
+// next access.
+package tenantdeactivator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/tenantactivity"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+type schemaReader interface {
+	ReadOnlySchema() models.Schema
+	CopyShardingState(class string) *sharding.State
+}
+
+type tenantUpdater interface {
+	UpdateTenants(class string, req *api.UpdateTenantsRequest) (uint64, error)
+}
+
+// activitySource returns when the tenants held by this node were last used
+type activitySource interface {
+	LocalTenantActivity() tenantactivity.ByCollection
+}
+
+type nodes interface {
+	LocalName() string
+	NodeHostname(name string) (string, bool)
+}
+
+// client returns when the tenants held by another node were last used
+type client interface {
+	TenantActivity(ctx context.Context, host string) (tenantactivity.ByCollection, error)
+}
+
+// Deactivator periodically deactivates idle tenants, see package doc
+type Deactivator struct {
+	config   config.AutoTenantDeactivation
+	schema   schemaReader
+	updater  tenantUpdater
+	activity activitySource
+	nodes    nodes
+	client   client
+	logger   logrus.FieldLogger
+
+	// hotSince is when a tenant was first seen HOT by the deactivator. The
+	// activity of a tenant which was just activated may not be tracked yet, or
+	// still be the one from before it was deactivated.
+	hotSince map[tenantKey]time.Time
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type tenantKey struct {
+	class  string
+	tenant string
+}
+
+// candidate is a HOT tenant the node may deactivate
+type candidate struct {
+	tenantKey
+	lastUsed time.Time
+	timeout  time.Duration
+	// replicas are the other nodes holding the tenant
+	replicas []string
+}
+
+func New(cfg config.AutoTenantDeactivation, schema schemaReader,
+	updater tenantUpdater, activity activitySource, nodes nodes, client client,
+	logger logrus.FieldLogger,
+) *Deactivator {
+	return &Deactivator{
+		config:   cfg,
+		schema:   schema,
+		updater:  updater,
+		activity: activity,
+		nodes:    nodes,
+		client:   client,
+		logger:   logger.WithField("action", "auto_tenant_deactivation"),
+		hotSince: map[tenantKey]time.Time{},
+	}
+}
+
+// Start checks for idle tenants in the background
+func (d *Deactivator) Start() {
+	if d.config.Interval <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+	d.done = make(chan struct{})
+	f := func() {
+		defer close(d.done)
+		t := time.NewTicker(d.config.Interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+				d.deactivate(ctx, time.Now())
+			}
+		}
+	}
+	enterrors.GoWrapper(f, d.logger)
+}
+
+// Stop waits for the background routine to terminate
+func (d *Deactivator) Stop(ctx context.Context) error {
+	if d.cancel == nil {
+		return nil
+	}
+	d.cancel()
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("stop tenant deactivator: %w", ctx.Err())
+	case <-d.done:
+		return nil
+	}
+}
+
+// deactivate runs a single round, it must not be called concurrently
+func (d *Deactivator) deactivate(ctx context.Context, now time.Time) {
+	candidates, held := d.candidates(now)
+	if len(candidates) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.config.Interval)
+	defer cancel()
+	candidates = d.replicaActivity(ctx, candidates)
+
+	// least recently used first
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].lastUsed.Before(candidates[j].lastUsed)
+	})
+
+	// the budget covers all tenants held by the node, but only the ones it
+	// decides about can be deactivated. Idle tenants are deactivated first,
+	// as the timeout depends on the class they may be used more recently than
+	// other candidates, the least recently used ones then cover what is left
+	// of the excess.
+	excess := 0
+	if d.config.MaxHotTenantsPerNode > 0 {
+		excess = held - d.config.MaxHotTenantsPerNode
+	}
+	byClass := map[string][]string{}
+	active := make([]candidate, 0, len(candidates))
+	for _, c := range candidates {
+		if now.Sub(c.lastUsed) < c.timeout {
+			active = append(active, c)
+			continue
+		}
+		byClass[c.class] = append(byClass[c.class], c.tenant)
+		excess--
+	}
+	for _, c := range active {
+		if excess <= 0 {
+			break
+		}
+		byClass[c.class] = append(byClass[c.class], c.tenant)
+		excess--
+	}
+
+	for class, tenants := range byClass {
+		req := &api.UpdateTenantsRequest{
+			Tenants: make([]*api.Tenant, len(tenants)),
+		}
+		for i, tenant := range tenants {
+			req.Tenants[i] = &api.Tenant{Name: tenant, Status: models.TenantActivityStatusCOLD}
+		}
+
+		logger := d.logger.WithField("class", class).WithField("tenants", tenants)
+		if _, err := d.updater.UpdateTenants(class, req); err != nil {
+			logger.WithError(err).Error("deactivate idle tenants")
+			continue
+		}
+		for _, tenant := range tenants {
+			delete(d.hotSince, tenantKey{class: class, tenant: tenant})
+		}
+		logger.Info("deactivated idle tenants")
+	}
+}
+
+// candidates returns the HOT tenants of classes with autoTenantDeactivation
+// enabled, which are decided about by this node, and the number of HOT
+// tenants of these classes the node holds, including the ones led by others
+func (d *Deactivator) candidates(now time.Time) ([]candidate, int) {
+	var (
+		local      = d.nodes.LocalName()
+		activity   = d.activity.LocalTenantActivity()
+		candidates []candidate
+		hot        = map[tenantKey]struct{}{}
+		held       int
+	)
+
+	for _, class := range d.schema.ReadOnlySchema().Classes {
+		if !schema.MultiTenancyEnabled(class) || !schema.AutoTenantDeactivationEnabled(class) {
+			continue
+		}
+		state := d.schema.CopyShardingState(class.Class)
+		if state == nil {
+			continue
+		}
+		timeout := schema.AutoTenantDeactivationTimeout(class)
+
+		for name, physical := range state.Physical {
+			if physical.ActivityStatus() != models.TenantActivityStatusHOT ||
+				!slices.Contains(physical.BelongsToNodes, local) {
+				continue
+			}
+			held++
+			if physical.BelongsToNodes[0] != local {
+				continue
+			}
+
+			key := tenantKey{class: class.Class, tenant: name}
+			hot[key] = struct{}{}
+			lastUsed, ok := d.hotSince[key]
+			if !ok {
+				lastUsed = now
+				d.hotSince[key] = now
+			}
+			if used := activity[class.Class][name]; used.After(lastUsed) {
+				lastUsed = used
+			}
+			candidates = append(candidates, candidate{
+				tenantKey: key,
+				lastUsed:  lastUsed,
+				timeout:   timeout,
+				replicas:  physical.BelongsToNodes[1:],
+			})
+		}
+	}
+
+	// forget tenants which are not HOT anymore, so that they start over once
+	// they are activated again
+	for key := range d.hotSince {
+		if _, ok := hot[key]; !ok {
+			delete(d.hotSince, key)
+		}
+	}
+
+	return candidates, held
+}
+
+// replicaActivity updates when the candidates were last used with the
+// accesses served by their other replicas. Candidates with a replica whose
+// activity is unknown are not deactivated in this round.
+func (d *Deactivator) replicaActivity(ctx context.Context, candidates []candidate) []candidate {
+	activity := map[string]tenantactivity.ByCollection{}
+	for _, c := range candidates {
+		for _, node := range c.replicas {
+			if _, ok := activity[node]; ok {
+				continue
+			}
+			activity[node] = nil
+
+			host, ok := d.nodes.NodeHostname(node)
+			if !ok {
+				d.logger.WithField("node", node).Warn("tenant activity of unknown node")
+				continue
+			}
+			byCollection, err := d.client.TenantActivity(ctx, host)
+			if err != nil {
+				d.logger.WithField("node", node).WithError(err).Warn("get tenant activity")
+				continue
+			}
+			if byCollection == nil {
+				// the node holds no tenants which were used
+				byCollection = tenantactivity.ByCollection{}
+			}
+			activity[node] = byCollection
+		}
+	}
+
+	known := candidates[:0]
+outer:
+	for _, c := range candidates {
+		for _, node := range c.replicas {
+			byCollection := activity[node]
+			if byCollection == nil {
+				continue outer
+			}
+			if used := byCollection[c.class][c.tenant]; used.After(c.lastUsed) {
+				c.lastUsed = used
+			}
+		}
+		known = append(known, c)
+	}
+	return known
+}
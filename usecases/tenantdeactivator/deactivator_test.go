@@ -0,0 +1,250 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package tenantdeactivator
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/tenantactivity"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/fakes"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestDeactivate(t *testing.T) {
+	ctx := context.Background()
+	start := time.Now()
+
+	t.Run("deactivates tenants after the timeout", func(t *testing.T) {
+		f := newFakes()
+		d := f.deactivator()
+		f.activity.usage["C"] = tenantactivity.ByTenant{
+			"T1": start,
+			"T2": start.Add(30 * time.Minute),
+		}
+		d.deactivate(ctx, start)
+		assert.Empty(t, f.updater.updated)
+
+		d.deactivate(ctx, start.Add(time.Hour))
+		assert.Equal(t, map[string][]string{"C": {"T1", "T3"}}, f.updater.updated)
+	})
+
+	t.Run("only deactivates tenants of enabled classes owned first by the node", func(t *testing.T) {
+		f := newFakes()
+		d := f.deactivator()
+		d.deactivate(ctx, start)
+		d.deactivate(ctx, start.Add(24*time.Hour))
+		assert.Equal(t, map[string][]string{"C": {"T1", "T2", "T3"}}, f.updater.updated)
+	})
+
+	t.Run("uses the timeout of the class", func(t *testing.T) {
+		f := newFakes()
+		f.schema.Classes["C"].MultiTenancyConfig.AutoTenantDeactivationTimeout = 60
+		d := f.deactivator()
+		d.deactivate(ctx, start)
+		d.deactivate(ctx, start.Add(time.Minute))
+		assert.Equal(t, map[string][]string{"C": {"T1", "T2", "T3"}}, f.updater.updated)
+	})
+
+	t.Run("deactivates the least recently used tenants over the budget", func(t *testing.T) {
+		f := newFakes()
+		d := f.deactivator()
+		d.deactivate(ctx, start)
+		// T5 is held by the node as well, but deactivated by N2
+		d.config.MaxHotTenantsPerNode = 2
+		f.activity.usage["C"] = tenantactivity.ByTenant{
+			"T1": start.Add(2 * time.Second),
+			"T2": start.Add(time.Second),
+			"T3": start.Add(3 * time.Second),
+		}
+		d.deactivate(ctx, start.Add(time.Minute))
+		assert.Equal(t, map[string][]string{"C": {"T1", "T2"}}, f.updater.updated)
+	})
+
+	t.Run("idle tenants count against the excess of a node holding tenants it does not lead", func(t *testing.T) {
+		f := newFakes()
+		// E/T1 is idle after a minute, the tenants of C after an hour
+		f.schema.Classes["E"] = &models.Class{
+			Class: "E", MultiTenancyConfig: &models.MultiTenancyConfig{
+				Enabled: true, AutoTenantDeactivation: true, AutoTenantDeactivationTimeout: 60,
+			},
+		}
+		f.schema.States["E"] = &sharding.State{Physical: map[string]sharding.Physical{
+			"T1": {BelongsToNodes: []string{"N1"}},
+		}}
+		d := f.deactivator()
+		d.deactivate(ctx, start)
+		// the node holds five HOT tenants, including C/T5 led by N2
+		d.config.MaxHotTenantsPerNode = 4
+		f.activity.usage["C"] = tenantactivity.ByTenant{
+			"T1": start.Add(3 * time.Second),
+			"T2": start.Add(time.Second),
+			"T3": start.Add(4 * time.Second),
+		}
+		f.activity.usage["E"] = tenantactivity.ByTenant{"T1": start.Add(2 * time.Second)}
+		d.deactivate(ctx, start.Add(2*time.Second+time.Minute))
+		assert.Equal(t, map[string][]string{"E": {"T1"}}, f.updater.updated)
+	})
+
+	t.Run("a node over the budget which leads none of its tenants keeps them", func(t *testing.T) {
+		f := newFakes()
+		for name, physical := range f.schema.States["C"].Physical {
+			physical.BelongsToNodes = []string{"N2", "N1"}
+			f.schema.States["C"].Physical[name] = physical
+		}
+		d := f.deactivator()
+		d.config.MaxHotTenantsPerNode = 1
+		d.deactivate(ctx, start)
+		d.deactivate(ctx, start.Add(time.Minute))
+		assert.Empty(t, f.updater.updated)
+	})
+
+	t.Run("uses the activity of all replicas", func(t *testing.T) {
+		f := newFakes()
+		d := f.deactivator()
+		d.deactivate(ctx, start)
+		f.client.activity["N2:8300"] = tenantactivity.ByCollection{
+			"C": {"T1": start.Add(time.Hour)},
+		}
+		d.deactivate(ctx, start.Add(90*time.Minute))
+		assert.Equal(t, map[string][]string{"C": {"T2", "T3"}}, f.updater.updated)
+	})
+
+	t.Run("tenants of replicas with unknown activity are kept", func(t *testing.T) {
+		f := newFakes()
+		f.client.err = errors.New("boom")
+		d := f.deactivator()
+		d.deactivate(ctx, start)
+		d.deactivate(ctx, start.Add(24*time.Hour))
+		assert.Equal(t, map[string][]string{"C": {"T2", "T3"}}, f.updater.updated)
+	})
+
+	t.Run("a tenant activated again starts over", func(t *testing.T) {
+		f := newFakes()
+		d := f.deactivator()
+		d.deactivate(ctx, start)
+		d.deactivate(ctx, start.Add(time.Hour))
+		assert.Len(t, f.updater.updated["C"], 3)
+
+		// the activity from before the tenant was deactivated is still tracked
+		f.activity.usage["C"] = tenantactivity.ByTenant{"T1": start}
+		f.updater.updated = map[string][]string{}
+		d.deactivate(ctx, start.Add(2*time.Hour))
+		assert.Empty(t, f.updater.updated)
+	})
+
+	t.Run("failed updates are retried", func(t *testing.T) {
+		f := newFakes()
+		f.updater.err = errors.New("boom")
+		d := f.deactivator()
+		d.deactivate(ctx, start)
+		d.deactivate(ctx, start.Add(time.Hour))
+		assert.Empty(t, f.updater.updated)
+
+		f.updater.err = nil
+		d.deactivate(ctx, start.Add(time.Hour))
+		assert.Len(t, f.updater.updated["C"], 3)
+	})
+}
+
+type testFakes struct {
+	schema   *fakes.FakeSchemaReader
+	updater  *fakeUpdater
+	activity *fakeActivity
+	client   *fakeClient
+}
+
+// newFakes returns a class C with autoTenantDeactivation enabled and three
+// HOT tenants owned first by N1, a COLD tenant and a tenant owned first by
+// N2, as well as a class D with four HOT tenants but without
+// autoTenantDeactivation
+func newFakes() *testFakes {
+	schema := fakes.NewFakeSchemaReader()
+	schema.Classes["C"] = &models.Class{Class: "C", MultiTenancyConfig: &models.MultiTenancyConfig{
+		Enabled: true, AutoTenantDeactivation: true,
+	}}
+	schema.Classes["D"] = &models.Class{Class: "D", MultiTenancyConfig: &models.MultiTenancyConfig{
+		Enabled: true,
+	}}
+	schema.States["C"] = &sharding.State{Physical: map[string]sharding.Physical{
+		"T1": {BelongsToNodes: []string{"N1", "N2"}},
+		"T2": {BelongsToNodes: []string{"N1"}, Status: models.TenantActivityStatusHOT},
+		"T3": {BelongsToNodes: []string{"N1"}},
+		"T4": {BelongsToNodes: []string{"N1"}, Status: models.TenantActivityStatusCOLD},
+		"T5": {BelongsToNodes: []string{"N2", "N1"}},
+	}}
+	schema.States["D"] = &sharding.State{Physical: map[string]sharding.Physical{
+		"T1": {BelongsToNodes: []string{"N1"}},
+		"T2": {BelongsToNodes: []string{"N1"}},
+		"T3": {BelongsToNodes: []string{"N1"}},
+		"T4": {BelongsToNodes: []string{"N1"}},
+	}}
+	return &testFakes{
+		schema:   schema,
+		updater:  &fakeUpdater{updated: map[string][]string{}},
+		activity: &fakeActivity{usage: tenantactivity.ByCollection{}},
+		client:   &fakeClient{activity: map[string]tenantactivity.ByCollection{}},
+	}
+}
+
+func (f *testFakes) deactivator() *Deactivator {
+	logger, _ := test.NewNullLogger()
+	cfg := config.AutoTenantDeactivation{Interval: time.Minute}
+	return New(cfg, f.schema, f.updater, f.activity, fakes.NewFakeNodes("N1", "N1", "N2"), f.client, logger)
+}
+
+type fakeUpdater struct {
+	updated map[string][]string
+	err     error
+}
+
+func (u *fakeUpdater) UpdateTenants(class string, req *api.UpdateTenantsRequest) (uint64, error) {
+	if u.err != nil {
+		return 0, u.err
+	}
+	for _, tenant := range req.Tenants {
+		if tenant.Status != models.TenantActivityStatusCOLD {
+			return 0, errors.New("unexpected status " + tenant.Status)
+		}
+		u.updated[class] = append(u.updated[class], tenant.Name)
+	}
+	sort.Strings(u.updated[class])
+	return 1, nil
+}
+
+type fakeActivity struct {
+	usage tenantactivity.ByCollection
+}
+
+func (a *fakeActivity) LocalTenantActivity() tenantactivity.ByCollection {
+	return a.usage
+}
+
+type fakeClient struct {
+	activity map[string]tenantactivity.ByCollection
+	err      error
+}
+
+func (c *fakeClient) TenantActivity(ctx context.Context, host string) (tenantactivity.ByCollection, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.activity[host], nil
+}
@@ -62,6 +62,8 @@ type ModulesProvider interface {
 	CrossClassValidateSearchParam(name string, value interface{}) error
 	VectorFromSearchParam(ctx context.Context, className string, param string,
 		params interface{}, findVectorFn modulecapabilities.FindVectorFn, tenant string) ([]float32, string, error)
+	VectorFromSearchParamForTarget(ctx context.Context, className, targetVector, param string,
+		params interface{}, findVectorFn modulecapabilities.FindVectorFn, tenant string) ([]float32, error)
 	CrossClassVectorFromSearchParam(ctx context.Context, param string,
 		params interface{}, findVectorFn modulecapabilities.FindVectorFn) ([]float32, string, error)
 	GetExploreAdditionalExtend(ctx context.Context, in []search.Result,
@@ -208,18 +210,31 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 func (e *Explorer) getClassVectorSearch(ctx context.Context,
 	params dto.GetParams,
 ) ([]search.Result, []float32, error) {
-	searchVector, targetVector, err := e.vectorFromParams(ctx, params)
-	if err != nil {
-		return nil, nil, errors.Errorf("explorer: get class: vectorize params: %v", err)
-	}
+	var searchVector []float32
+	if targetVectors := e.targetParamHelper.GetTargetVectorsFromParams(params); len(targetVectors) > 1 {
+		searchVectors, err := e.nearParamsVector.vectorsFromParams(ctx, params.NearVector,
+			params.NearObject, params.ModuleParams, params.ClassName, params.Tenant, targetVectors)
+		if err != nil {
+			return nil, nil, errors.Errorf("explorer: get class: vectorize params: %v", err)
+		}
+		params.TargetVectors = targetVectors
+		params.SearchVectors = searchVectors
+		searchVector = searchVectors[0]
+	} else {
+		vector, targetVector, err := e.vectorFromParams(ctx, params)
+		if err != nil {
+			return nil, nil, errors.Errorf("explorer: get class: vectorize params: %v", err)
+		}
 
-	targetVector, err = e.targetParamHelper.GetTargetVectorOrDefault(e.schemaGetter.GetSchemaSkipAuth(),
-		params.ClassName, targetVector)
-	if err != nil {
-		return nil, nil, errors.Errorf("explorer: get class: validate target vector: %v", err)
+		targetVector, err = e.targetParamHelper.GetTargetVectorOrDefault(e.schemaGetter.GetSchemaSkipAuth(),
+			params.ClassName, targetVector)
+		if err != nil {
+			return nil, nil, errors.Errorf("explorer: get class: validate target vector: %v", err)
+		}
+		params.TargetVector = targetVector
+		params.SearchVector = vector
+		searchVector = vector
 	}
-	params.TargetVector = targetVector
-	params.SearchVector = searchVector
 
 	if len(params.AdditionalProperties.ModuleParams) > 0 || params.Group != nil {
 		// if a module-specific additional prop is set, assume it needs the vector
@@ -2770,6 +2770,14 @@ func (p *fakeModulesProvider) VectorFromSearchParam(ctx context.Context, classNa
 	return vec, targetVector, err
 }
 
+func (p *fakeModulesProvider) VectorFromSearchParamForTarget(ctx context.Context,
+	className, targetVector, param string, params interface{},
+	findVectorFn modulecapabilities.FindVectorFn, tenant string,
+) ([]float32, error) {
+	vec, _, err := p.VectorFromSearchParam(ctx, className, param, params, findVectorFn, tenant)
+	return vec, err
+}
+
 func (p *fakeModulesProvider) CrossClassVectorFromSearchParam(ctx context.Context,
 	param string, params interface{},
 	findVectorFn modulecapabilities.FindVectorFn,
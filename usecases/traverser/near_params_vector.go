@@ -63,6 +63,12 @@ func (v *nearParamsVector) vectorFromParams(ctx context.Context,
 		if len(nearVector.TargetVectors) == 1 {
 			targetVector = nearVector.TargetVectors[0]
 		}
+		if vector, ok := nearVector.VectorPerTarget[targetVector]; ok {
+			return vector, targetVector, nil
+		}
+		if len(nearVector.Vector) == 0 {
+			return nil, "", errors.Errorf("nearVector: no vector for target vector %s", targetVector)
+		}
 		return nearVector.Vector, targetVector, nil
 	}
 
@@ -80,6 +86,51 @@ func (v *nearParamsVector) vectorFromParams(ctx context.Context,
 	return []float32{}, "", errors.Errorf("vectorFromParams was called without any known params present")
 }
 
+// vectorsFromParams returns the search vector for each of the target vectors
+// of a search across several target vectors
+func (v *nearParamsVector) vectorsFromParams(ctx context.Context,
+	nearVector *searchparams.NearVector, nearObject *searchparams.NearObject,
+	moduleParams map[string]interface{}, className, tenant string, targetVectors []string,
+) ([][]float32, error) {
+	err := v.validateNearParams(nearVector, nearObject, moduleParams, className)
+	if err != nil {
+		return nil, err
+	}
+
+	vectors := make([][]float32, len(targetVectors))
+	for i, targetVector := range targetVectors {
+		switch {
+		case len(moduleParams) == 1:
+			if v.modulesProvider == nil {
+				return nil, errors.New("no modules defined")
+			}
+			for name, value := range moduleParams {
+				vectors[i], err = v.modulesProvider.VectorFromSearchParamForTarget(ctx,
+					className, targetVector, name, value, v.findVector, tenant)
+			}
+			if err != nil {
+				return nil, errors.Errorf("vectorize params for target vector %s: %v", targetVector, err)
+			}
+		case nearVector != nil:
+			vectors[i] = nearVector.Vector
+			if vec, ok := nearVector.VectorPerTarget[targetVector]; ok {
+				vectors[i] = vec
+			}
+			if len(vectors[i]) == 0 {
+				return nil, errors.Errorf("nearVector: no vector for target vector %s", targetVector)
+			}
+		case nearObject != nil:
+			vectors[i], err = v.vectorFromNearObjectParamsForTarget(ctx, className, nearObject, tenant, targetVector)
+			if err != nil {
+				return nil, errors.Errorf("nearObject params: %v", err)
+			}
+		default:
+			return nil, errors.Errorf("vectorsFromParams was called without any known params present")
+		}
+	}
+	return vectors, nil
+}
+
 func (v *nearParamsVector) validateNearParams(nearVector *searchparams.NearVector,
 	nearObject *searchparams.NearObject,
 	moduleParams map[string]interface{}, className ...string,
@@ -257,24 +308,9 @@ func (v *nearParamsVector) crossClassVectorFromNearObjectParams(ctx context.Cont
 func (v *nearParamsVector) vectorFromNearObjectParams(ctx context.Context,
 	className string, params *searchparams.NearObject, tenant string,
 ) ([]float32, string, error) {
-	if len(params.ID) == 0 && len(params.Beacon) == 0 {
-		return nil, "", errors.New("empty id and beacon")
-	}
-
-	var id strfmt.UUID
-	targetClassName := className
-
-	if len(params.ID) > 0 {
-		id = strfmt.UUID(params.ID)
-	} else {
-		ref, err := crossref.Parse(params.Beacon)
-		if err != nil {
-			return nil, "", err
-		}
-		id = ref.TargetID
-		if ref.Class != "" {
-			targetClassName = ref.Class
-		}
+	targetClassName, id, err := v.nearObjectID(className, params)
+	if err != nil {
+		return nil, "", err
 	}
 
 	targetVector := ""
@@ -285,6 +321,40 @@ func (v *nearParamsVector) vectorFromNearObjectParams(ctx context.Context,
 	return v.findVector(ctx, targetClassName, id, tenant, targetVector)
 }
 
+func (v *nearParamsVector) vectorFromNearObjectParamsForTarget(ctx context.Context,
+	className string, params *searchparams.NearObject, tenant, targetVector string,
+) ([]float32, error) {
+	targetClassName, id, err := v.nearObjectID(className, params)
+	if err != nil {
+		return nil, err
+	}
+
+	vector, _, err := v.findVector(ctx, targetClassName, id, tenant, targetVector)
+	return vector, err
+}
+
+// nearObjectID returns the class name and id of the object of a nearObject
+// search
+func (v *nearParamsVector) nearObjectID(className string, params *searchparams.NearObject,
+) (string, strfmt.UUID, error) {
+	if len(params.ID) == 0 && len(params.Beacon) == 0 {
+		return "", "", errors.New("empty id and beacon")
+	}
+
+	if len(params.ID) > 0 {
+		return className, strfmt.UUID(params.ID), nil
+	}
+
+	ref, err := crossref.Parse(params.Beacon)
+	if err != nil {
+		return "", "", err
+	}
+	if ref.Class != "" {
+		return ref.Class, ref.TargetID, nil
+	}
+	return className, ref.TargetID, nil
+}
+
 func (v *nearParamsVector) extractCertaintyFromParams(nearVector *searchparams.NearVector,
 	nearObject *searchparams.NearObject, moduleParams map[string]interface{}, hybrid *searchparams.HybridSearch,
 ) float64 {
@@ -18,6 +18,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
@@ -357,6 +358,67 @@ func Test_nearParamsVector_multiVectorFromParams(t *testing.T) {
 	}
 }
 
+func Test_nearParamsVector_vectorsFromParams(t *testing.T) {
+	tests := []struct {
+		name       string
+		nearVector *searchparams.NearVector
+		nearObject *searchparams.NearObject
+		want       [][]float32
+		wantErr    bool
+	}{
+		{
+			name: "Should get vectors from nearVector with a vector per target",
+			nearVector: &searchparams.NearVector{
+				VectorPerTarget: map[string][]float32{"A": {1, 2}, "B": {3}},
+				TargetVectors:   []string{"A", "B"},
+			},
+			want: [][]float32{{1, 2}, {3}},
+		},
+		{
+			name: "Should fall back to the vector of nearVector",
+			nearVector: &searchparams.NearVector{
+				Vector:          []float32{5, 6},
+				VectorPerTarget: map[string][]float32{"B": {3}},
+				TargetVectors:   []string{"A", "B"},
+			},
+			want: [][]float32{{5, 6}, {3}},
+		},
+		{
+			name: "Should fail for nearVector without a vector for a target",
+			nearVector: &searchparams.NearVector{
+				VectorPerTarget: map[string][]float32{"B": {3}},
+				TargetVectors:   []string{"A", "B"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should get vectors from nearObject",
+			nearObject: &searchparams.NearObject{
+				ID:            "uuid",
+				TargetVectors: []string{"A", "B"},
+			},
+			want: [][]float32{{3, 3, 3}, {4, 4, 4}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &nearParamsVector{
+				modulesProvider: &fakeModulesProvider{},
+				search:          &fakeNearParamsSearcher{},
+			}
+			targetVectors := []string{"A", "B"}
+			got, err := e.vectorsFromParams(context.Background(), tt.nearVector, tt.nearObject,
+				nil, "MultiMultiVector", "", targetVectors)
+			if tt.wantErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_nearParamsVector_extractCertaintyFromParams(t *testing.T) {
 	type args struct {
 		nearVector   *searchparams.NearVector
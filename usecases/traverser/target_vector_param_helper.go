@@ -42,6 +42,23 @@ func (t *TargetVectorParamHelper) GetTargetVectorOrDefault(sch schema.Schema, cl
 	return targetVector, nil
 }
 
+// GetTargetVectorsFromParams returns all target vectors of the near param of
+// a vector search. Hybrid searches are not taken into account.
+func (t *TargetVectorParamHelper) GetTargetVectorsFromParams(params dto.GetParams) []string {
+	if params.NearObject != nil {
+		return params.NearObject.TargetVectors
+	}
+	if params.NearVector != nil {
+		return params.NearVector.TargetVectors
+	}
+	for _, moduleParam := range params.ModuleParams {
+		if nearParam, ok := moduleParam.(modulecapabilities.NearParam); ok {
+			return nearParam.GetTargetVectors()
+		}
+	}
+	return nil
+}
+
 func (t *TargetVectorParamHelper) GetTargetVectorFromParams(params dto.GetParams) string {
 	if params.NearObject != nil && len(params.NearObject.TargetVectors) == 1 {
 		return params.NearObject.TargetVectors[0]
@@ -0,0 +1,248 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package vectorrecall measures the recall of the vector index of a target
+// vector. The vectors of sampled objects are used as queries, which are run
+// as regular vector searches and as exact searches over all vectors. The
+// searches go through the same code path as user queries, so all shards and
+// the compression of the index are taken into account.
+package vectorrecall
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+const (
+	DefaultK       = 10
+	DefaultSamples = 100
+	MaxK           = 1000
+	MaxSamples     = 10000
+)
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type schemaReader interface {
+	ReadOnlyClass(class string) *models.Class
+}
+
+// searcher runs the queries on all shards of the class
+type searcher interface {
+	Search(ctx context.Context, params dto.GetParams) ([]search.Result, error)
+	VectorSearch(ctx context.Context, params dto.GetParams) ([]search.Result, error)
+}
+
+// Request describes a recall measurement
+type Request struct {
+	ClassName    string
+	TargetVector string
+	Tenant       string
+	K            int
+	Samples      int
+}
+
+// Report is the result of a recall measurement. Samples is the number of
+// queries which were run, durations are the mean of all queries.
+type Report struct {
+	Request
+	Recall    float64
+	MinRecall float64
+	IndexTook time.Duration
+	ExactTook time.Duration
+}
+
+// Auditor measures the recall of vector indexes, see package doc
+type Auditor struct {
+	authorizer authorizer
+	schema     schemaReader
+	searcher   searcher
+}
+
+func New(authorizer authorizer, schema schemaReader, searcher searcher) *Auditor {
+	return &Auditor{authorizer: authorizer, schema: schema, searcher: searcher}
+}
+
+// Measure runs the sampled queries one after another, so it doesn't put more
+// load on the cluster than a single client
+func (a *Auditor) Measure(ctx context.Context, principal *models.Principal, req Request) (*Report, error) {
+	if err := a.authorizer.Authorize(principal, "get", "traversal/*"); err != nil {
+		return nil, err
+	}
+
+	class := a.schema.ReadOnlyClass(req.ClassName)
+	if class == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", req.ClassName))
+	}
+	if err := validate(class, &req); err != nil {
+		return nil, enterrors.NewErrUnprocessable(err)
+	}
+
+	queries, err := a.sample(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Request: req, MinRecall: 1}
+	done := 0
+	for _, query := range queries {
+		before := time.Now()
+		approximate, err := a.searcher.VectorSearch(ctx, a.searchParams(req, query, false))
+		if err != nil {
+			return nil, fmt.Errorf("vector index search: %w", err)
+		}
+		report.IndexTook += time.Since(before)
+
+		before = time.Now()
+		exact, err := a.searcher.VectorSearch(ctx, a.searchParams(req, query, true))
+		if err != nil {
+			return nil, fmt.Errorf("exact search: %w", err)
+		}
+		report.ExactTook += time.Since(before)
+
+		if len(exact) == 0 {
+			continue
+		}
+		r := recall(approximate, exact)
+		report.Recall += r
+		if r < report.MinRecall {
+			report.MinRecall = r
+		}
+		done++
+	}
+
+	report.Samples = done
+	if done == 0 {
+		report.MinRecall = 0
+		return report, nil
+	}
+	report.Recall /= float64(done)
+	report.IndexTook /= time.Duration(done)
+	report.ExactTook /= time.Duration(done)
+	return report, nil
+}
+
+func validate(class *models.Class, req *Request) error {
+	if req.K == 0 {
+		req.K = DefaultK
+	}
+	if req.Samples == 0 {
+		req.Samples = DefaultSamples
+	}
+	if req.K < 0 || req.K > MaxK {
+		return fmt.Errorf("k must be between 1 and %d", MaxK)
+	}
+	if req.Samples < 0 || req.Samples > MaxSamples {
+		return fmt.Errorf("samples must be between 1 and %d", MaxSamples)
+	}
+
+	if len(class.VectorConfig) == 0 {
+		if req.TargetVector != "" {
+			return fmt.Errorf("class %q has no named vectors", class.Class)
+		}
+		return nil
+	}
+	if req.TargetVector == "" {
+		return fmt.Errorf("class %q has named vectors, a target vector is required", class.Class)
+	}
+	if _, ok := class.VectorConfig[req.TargetVector]; !ok {
+		return fmt.Errorf("target vector %q not found in class %q", req.TargetVector, class.Class)
+	}
+	return nil
+}
+
+// sample returns the vectors of up to req.Samples objects. They are read
+// starting at a random id, so the sample differs between measurements.
+func (a *Auditor) sample(ctx context.Context, req Request) ([][]float32, error) {
+	addl := additional.Properties{NoProps: true}
+	if req.TargetVector == "" {
+		addl.Vector = true
+	} else {
+		addl.Vectors = []string{req.TargetVector}
+	}
+
+	var (
+		queries [][]float32
+		seen    = map[strfmt.UUID]struct{}{}
+	)
+	for _, after := range []string{uuid.NewString(), ""} {
+		res, err := a.searcher.Search(ctx, dto.GetParams{
+			ClassName:            req.ClassName,
+			Cursor:               &filters.Cursor{After: after, Limit: req.Samples},
+			Pagination:           &filters.Pagination{Limit: req.Samples},
+			AdditionalProperties: addl,
+			Tenant:               req.Tenant,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("sample objects: %w", err)
+		}
+		for _, obj := range res {
+			if _, ok := seen[obj.ID]; ok || len(queries) == req.Samples {
+				continue
+			}
+			seen[obj.ID] = struct{}{}
+
+			vector := obj.Vector
+			if req.TargetVector != "" {
+				vector = obj.Vectors[req.TargetVector]
+			}
+			if len(vector) > 0 {
+				queries = append(queries, vector)
+			}
+		}
+		if len(queries) == req.Samples {
+			break
+		}
+	}
+	return queries, nil
+}
+
+func (a *Auditor) searchParams(req Request, query []float32, exact bool) dto.GetParams {
+	params := dto.GetParams{
+		ClassName:            req.ClassName,
+		Pagination:           &filters.Pagination{Limit: req.K},
+		SearchVector:         query,
+		AdditionalProperties: additional.Properties{NoProps: true, ExactSearch: exact},
+		Tenant:               req.Tenant,
+	}
+	if req.TargetVector != "" {
+		params.TargetVector = req.TargetVector
+		params.TargetVectors = []string{req.TargetVector}
+	}
+	return params
+}
+
+// recall returns the fraction of the exact results which were found
+func recall(approximate, exact []search.Result) float64 {
+	found := make(map[strfmt.UUID]struct{}, len(approximate))
+	for _, res := range approximate {
+		found[res.ID] = struct{}{}
+	}
+	hits := 0
+	for _, res := range exact {
+		if _, ok := found[res.ID]; ok {
+			hits++
+		}
+	}
+	return float64(hits) / float64(len(exact))
+}
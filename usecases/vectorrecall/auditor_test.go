@@ -0,0 +1,204 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorrecall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestMeasure(t *testing.T) {
+	t.Run("computes mean and min recall", func(t *testing.T) {
+		s := newFakeSearcher(4)
+		// the index misses one of two results for the first query
+		s.approximate = func(query []float32) []search.Result {
+			if query[0] == 0 {
+				return results(0, 3)
+			}
+			return s.exact(query)
+		}
+		a := New(&fakeAuthorizer{}, fakeSchema{}, s)
+
+		report, err := a.Measure(context.Background(), nil, Request{ClassName: "Legacy", K: 2, Samples: 4})
+		require.Nil(t, err)
+		assert.Equal(t, 4, report.Samples)
+		assert.Equal(t, 2, report.K)
+		assert.InDelta(t, 0.875, report.Recall, 1e-9)
+		assert.InDelta(t, 0.5, report.MinRecall, 1e-9)
+		assert.Equal(t, 8, s.vectorSearches)
+		assert.Equal(t, 4, s.exactSearches)
+	})
+
+	t.Run("uses the defaults and the target vector", func(t *testing.T) {
+		s := newFakeSearcher(3)
+		a := New(&fakeAuthorizer{}, fakeSchema{}, s)
+
+		report, err := a.Measure(context.Background(), nil, Request{ClassName: "Named", TargetVector: "a"})
+		require.Nil(t, err)
+		assert.Equal(t, DefaultK, report.K)
+		// only three objects exist, so fewer queries than requested are run
+		assert.Equal(t, 3, report.Samples)
+		assert.Equal(t, 1.0, report.Recall)
+		assert.Equal(t, []string{"a"}, s.lastParams.TargetVectors)
+	})
+
+	t.Run("no objects", func(t *testing.T) {
+		a := New(&fakeAuthorizer{}, fakeSchema{}, newFakeSearcher(0))
+
+		report, err := a.Measure(context.Background(), nil, Request{ClassName: "Legacy"})
+		require.Nil(t, err)
+		assert.Equal(t, 0, report.Samples)
+		assert.Equal(t, 0.0, report.MinRecall)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		forbidden := errors.New("forbidden")
+		a := New(&fakeAuthorizer{err: forbidden}, fakeSchema{}, newFakeSearcher(1))
+
+		_, err := a.Measure(context.Background(), nil, Request{ClassName: "Legacy"})
+		assert.Equal(t, forbidden, err)
+	})
+
+	for _, tc := range []struct {
+		name string
+		req  Request
+		err  interface{}
+	}{
+		{name: "unknown class", req: Request{ClassName: "Unknown"}, err: enterrors.ErrNotFound{}},
+		{name: "k too large", req: Request{ClassName: "Legacy", K: MaxK + 1}, err: enterrors.ErrUnprocessable{}},
+		{name: "negative samples", req: Request{ClassName: "Legacy", Samples: -1}, err: enterrors.ErrUnprocessable{}},
+		{name: "target vector without named vectors", req: Request{ClassName: "Legacy", TargetVector: "a"}, err: enterrors.ErrUnprocessable{}},
+		{name: "missing target vector", req: Request{ClassName: "Named"}, err: enterrors.ErrUnprocessable{}},
+		{name: "unknown target vector", req: Request{ClassName: "Named", TargetVector: "b"}, err: enterrors.ErrUnprocessable{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := New(&fakeAuthorizer{}, fakeSchema{}, newFakeSearcher(1))
+			_, err := a.Measure(context.Background(), nil, tc.req)
+			assert.IsType(t, tc.err, err)
+		})
+	}
+}
+
+func TestSampleWrapsAround(t *testing.T) {
+	s := newFakeSearcher(5)
+	// the first page starts after the random id and only holds the last two
+	s.firstPage = 3
+	a := New(&fakeAuthorizer{}, fakeSchema{}, s)
+
+	queries, err := a.sample(context.Background(), Request{ClassName: "Legacy", Samples: 4})
+	require.Nil(t, err)
+	require.Len(t, queries, 4)
+	assert.Equal(t, []float32{3}, queries[0])
+	assert.Equal(t, []float32{4}, queries[1])
+	assert.Equal(t, []float32{0}, queries[2])
+	assert.Equal(t, []float32{1}, queries[3])
+}
+
+func TestRecall(t *testing.T) {
+	assert.Equal(t, 1.0, recall(results(0, 1), results(1, 0)))
+	assert.Equal(t, 0.5, recall(results(0, 2), results(0, 1)))
+	assert.Equal(t, 0.0, recall(nil, results(0)))
+}
+
+type fakeAuthorizer struct {
+	err error
+}
+
+func (a *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	return a.err
+}
+
+type fakeSchema struct{}
+
+func (fakeSchema) ReadOnlyClass(class string) *models.Class {
+	switch class {
+	case "Legacy":
+		return &models.Class{Class: class}
+	case "Named":
+		return &models.Class{Class: class, VectorConfig: map[string]models.VectorConfig{"a": {}}}
+	default:
+		return nil
+	}
+}
+
+// fakeSearcher holds objects whose vector is their index. Exact searches
+// return the query object and the next ones.
+type fakeSearcher struct {
+	objects        int
+	firstPage      int
+	approximate    func(query []float32) []search.Result
+	vectorSearches int
+	exactSearches  int
+	lastParams     dto.GetParams
+}
+
+func newFakeSearcher(objects int) *fakeSearcher {
+	s := &fakeSearcher{objects: objects}
+	s.approximate = s.exact
+	return s
+}
+
+func (s *fakeSearcher) Search(ctx context.Context, params dto.GetParams) ([]search.Result, error) {
+	start := 0
+	if params.Cursor.After != "" {
+		start = s.firstPage
+	}
+	var res []search.Result
+	for i := start; i < s.objects && len(res) < params.Cursor.Limit; i++ {
+		r := search.Result{ID: id(i)}
+		if params.AdditionalProperties.Vector {
+			r.Vector = []float32{float32(i)}
+		}
+		if len(params.AdditionalProperties.Vectors) > 0 {
+			r.Vectors = models.Vectors{params.AdditionalProperties.Vectors[0]: []float32{float32(i)}}
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+func (s *fakeSearcher) VectorSearch(ctx context.Context, params dto.GetParams) ([]search.Result, error) {
+	s.vectorSearches++
+	s.lastParams = params
+	if params.AdditionalProperties.ExactSearch {
+		s.exactSearches++
+		return s.exact(params.SearchVector), nil
+	}
+	return s.approximate(params.SearchVector), nil
+}
+
+func (s *fakeSearcher) exact(query []float32) []search.Result {
+	first := int(query[0])
+	return results(first, (first+1)%s.objects)
+}
+
+func results(ids ...int) []search.Result {
+	res := make([]search.Result, len(ids))
+	for i, n := range ids {
+		res[i] = search.Result{ID: id(n)}
+	}
+	return res
+}
+
+func id(i int) strfmt.UUID {
+	return strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-%012d", i))
+}
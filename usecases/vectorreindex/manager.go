@@ -0,0 +1,611 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package vectorreindex recomputes the vectors of a target vector of a class
+// in place, e.g. to switch to a different vectorizer module.
+//
+// A job is stored in the cluster schema. Every node vectorizes the objects of
+// its shards into a new vector index, while the current one keeps serving
+// queries. Once all shards are ready, the leader commits the job, which makes
+// the class use the new vectorizer and the shards replace their indexes.
+// Backfills of multi-tenant classes only wait for the active tenants, the
+// others are deferred and backfilled once they are activated.
+package vectorreindex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorreindex"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// reconcileInterval is the interval in which the nodes apply the jobs to
+// their shards, and the leader checks whether jobs can be committed
+const reconcileInterval = time.Second
+
+// Status is the progress of the job of a target vector on all nodes
+type Status struct {
+	Class string                     `json:"class"`
+	Job   vectorreindex.Job          `json:"job"`
+	Nodes []vectorreindex.NodeStatus `json:"nodes"`
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+type raft interface {
+	IsLeader() bool
+	StartVectorReindex(class string, job *vectorreindex.Job) (uint64, error)
+	UpdateVectorReindex(class string, req *cmd.UpdateVectorReindexRequest) (uint64, error)
+}
+
+type schemaReader interface {
+	ReadOnlyClass(class string) *models.Class
+	CopyShardingState(class string) *sharding.State
+	VectorReindexJobs() map[string][]vectorreindex.Job
+}
+
+type modules interface {
+	vectorreindex.Vectorizer
+	ValidateReindexVectorizer(moduleName string) error
+	SetClassDefaults(class *models.Class)
+	ValidateClass(ctx context.Context, class *models.Class) error
+}
+
+// db reindexes the shards of this node
+type db interface {
+	ReconcileVectorReindexes(jobs map[string][]vectorreindex.Job, vectorizer vectorreindex.Vectorizer)
+	VectorReindexStatus(className string, job vectorreindex.Job) *vectorreindex.NodeStatus
+}
+
+type nodes interface {
+	LocalName() string
+	NodeHostname(name string) (string, bool)
+}
+
+// client retrieves the progress of a job on other nodes
+type client interface {
+	Status(ctx context.Context, host, class, targetVector string) (*vectorreindex.NodeStatus, error)
+}
+
+// Manager starts and controls vector reindexing jobs, see package doc
+type Manager struct {
+	authorizer authorizer
+	raft       raft
+	schema     schemaReader
+	modules    modules
+	db         db
+	nodes      nodes
+	client     client
+	logger     logrus.FieldLogger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func New(authorizer authorizer, raft raft, schema schemaReader, modules modules,
+	db db, nodes nodes, client client, logger logrus.FieldLogger,
+) *Manager {
+	return &Manager{
+		authorizer: authorizer,
+		raft:       raft,
+		schema:     schema,
+		modules:    modules,
+		db:         db,
+		nodes:      nodes,
+		client:     client,
+		logger:     logger.WithField("action", "vector_reindex"),
+	}
+}
+
+// Start applies the jobs to the local shards in the background
+func (m *Manager) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	m.done = make(chan struct{})
+	f := func() {
+		defer close(m.done)
+		t := time.NewTicker(reconcileInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+				m.reconcile(ctx)
+			}
+		}
+	}
+	enterrors.GoWrapper(f, m.logger)
+}
+
+// Stop waits for the background routine to terminate. Running jobs are
+// stopped together with their shards.
+func (m *Manager) Stop(ctx context.Context) error {
+	if m.cancel == nil {
+		return nil
+	}
+	m.cancel()
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("stop vector reindexing: %w", ctx.Err())
+	case <-m.done:
+		return nil
+	}
+}
+
+func (m *Manager) reconcile(ctx context.Context) {
+	jobs := m.schema.VectorReindexJobs()
+	if len(jobs) == 0 {
+		return
+	}
+
+	m.db.ReconcileVectorReindexes(jobs, m.modules)
+	if !m.raft.IsLeader() {
+		return
+	}
+
+	for className, classJobs := range jobs {
+		for _, job := range classJobs {
+			switch {
+			case job.Status == vectorreindex.StatusRunning:
+				m.commitIfReady(ctx, className, job)
+			case job.Status == vectorreindex.StatusCompleted && len(job.Deferred) > 0:
+				m.completeDeferred(ctx, className, job)
+			}
+		}
+	}
+}
+
+// commitIfReady commits the job once every shard vectorized all of its
+// objects, and fails it if any shard failed
+func (m *Manager) commitIfReady(ctx context.Context, className string, job vectorreindex.Job) {
+	logger := m.logger.WithField("class", className).WithField("target_vector", job.TargetVector)
+
+	ready := true
+	for node, shards := range m.shardsByNode(className) {
+		status := m.nodeStatus(ctx, node, className, job.TargetVector)
+		if status.Error != "" || status.Version != job.Version {
+			ready = false
+			continue
+		}
+
+		readyShards := 0
+		for _, shard := range status.Shards {
+			switch shard.Status {
+			case vectorreindex.StatusFailed:
+				errMsg := fmt.Sprintf("node %q: shard %q: %s", node, shard.Shard, shard.Error)
+				logger.WithField("error", errMsg).Error("vector reindexing failed")
+				m.update(className, job.TargetVector, vectorreindex.StatusFailed, errMsg)
+				return
+			case vectorreindex.StatusReady:
+				readyShards++
+			}
+		}
+		if readyShards < shards {
+			ready = false
+		}
+	}
+
+	if !ready {
+		return
+	}
+	if job.Backfill {
+		// inactive tenants aren't loaded, they are backfilled once activated
+		deferred := m.inactiveShards(className)
+		logger.WithField("deferred", len(deferred)).
+			Info("all active shards are backfilled, committing vector backfill")
+		m.updateDeferred(className, job.TargetVector, deferred)
+		return
+	}
+	logger.Info("all shards are reindexed, committing vector reindexing")
+	m.update(className, job.TargetVector, vectorreindex.StatusCompleted, "")
+}
+
+// completeDeferred removes the deferred tenants of a committed backfill once
+// all of their replicas vectorized their objects
+func (m *Manager) completeDeferred(ctx context.Context, className string, job vectorreindex.Job) {
+	state := m.schema.CopyShardingState(className)
+	if state == nil {
+		return
+	}
+
+	statuses := map[string]map[string]vectorreindex.ShardStatus{}
+	shardStatus := func(node, shard string) (vectorreindex.ShardStatus, bool) {
+		if _, ok := statuses[node]; !ok {
+			statuses[node] = map[string]vectorreindex.ShardStatus{}
+			status := m.nodeStatus(ctx, node, className, job.TargetVector)
+			if status.Error == "" && status.Version == job.Version {
+				for _, s := range status.Shards {
+					statuses[node][s.Shard] = s
+				}
+			}
+		}
+		s, ok := statuses[node][shard]
+		return s, ok
+	}
+
+	remaining := make([]string, 0, len(job.Deferred))
+	for _, shard := range job.Deferred {
+		physical, ok := state.Physical[shard]
+		if !ok {
+			// the tenant was deleted
+			continue
+		}
+		if physical.ActivityStatus() != models.TenantActivityStatusHOT {
+			remaining = append(remaining, shard)
+			continue
+		}
+
+		done := true
+		for _, node := range physical.BelongsToNodes {
+			status, ok := shardStatus(node, shard)
+			if !ok {
+				done = false
+				continue
+			}
+			switch status.Status {
+			case vectorreindex.StatusReady, vectorreindex.StatusCompleted:
+			case vectorreindex.StatusFailed:
+				// the tenant is backfilled again when it is loaded next time
+				m.logger.WithField("class", className).WithField("target_vector", job.TargetVector).
+					WithField("node", node).WithField("shard", shard).WithField("error", status.Error).
+					Error("vector backfill of deferred tenant failed")
+				done = false
+			default:
+				done = false
+			}
+		}
+		if !done {
+			remaining = append(remaining, shard)
+		}
+	}
+
+	if len(remaining) < len(job.Deferred) {
+		m.updateDeferred(className, job.TargetVector, remaining)
+	}
+}
+
+// updateDeferred completes the backfill of the target vector, with the given
+// tenants still to be backfilled
+func (m *Manager) updateDeferred(className, targetVector string, deferred []string) error {
+	return m.updateRequest(className, &cmd.UpdateVectorReindexRequest{
+		TargetVector: targetVector,
+		Status:       vectorreindex.StatusCompleted,
+		Deferred:     deferred,
+		Time:         time.Now(),
+	})
+}
+
+func (m *Manager) update(className, targetVector, status, errMsg string) error {
+	return m.updateRequest(className, &cmd.UpdateVectorReindexRequest{
+		TargetVector: targetVector,
+		Status:       status,
+		Error:        errMsg,
+		Time:         time.Now(),
+	})
+}
+
+func (m *Manager) updateRequest(className string, req *cmd.UpdateVectorReindexRequest) error {
+	_, err := m.raft.UpdateVectorReindex(className, req)
+	if err != nil {
+		m.logger.WithField("class", className).WithField("target_vector", req.TargetVector).
+			WithError(err).Errorf("set vector reindexing status to %s", req.Status)
+		return fmt.Errorf("set vector reindexing status to %s: %w", req.Status, err)
+	}
+	return nil
+}
+
+// shardsByNode returns the number of active shards each node owns. Inactive
+// tenants aren't loaded, so they can't be vectorized.
+func (m *Manager) shardsByNode(className string) map[string]int {
+	shards := map[string]int{}
+	state := m.schema.CopyShardingState(className)
+	if state == nil {
+		return shards
+	}
+	for _, physical := range state.Physical {
+		if physical.ActivityStatus() != models.TenantActivityStatusHOT {
+			continue
+		}
+		for _, node := range physical.BelongsToNodes {
+			shards[node]++
+		}
+	}
+	return shards
+}
+
+// inactiveShards returns the tenants which aren't active, sorted by name
+func (m *Manager) inactiveShards(className string) []string {
+	var shards []string
+	state := m.schema.CopyShardingState(className)
+	if state == nil {
+		return shards
+	}
+	for name, physical := range state.Physical {
+		if physical.ActivityStatus() != models.TenantActivityStatusHOT {
+			shards = append(shards, name)
+		}
+	}
+	sort.Strings(shards)
+	return shards
+}
+
+func (m *Manager) nodeStatus(ctx context.Context, node, className, targetVector string) vectorreindex.NodeStatus {
+	if node == m.nodes.LocalName() {
+		return *m.LocalStatus(className, targetVector)
+	}
+
+	host, ok := m.nodes.NodeHostname(node)
+	if !ok {
+		return vectorreindex.NodeStatus{Node: node, Error: "cannot resolve hostname"}
+	}
+	status, err := m.client.Status(ctx, host, className, targetVector)
+	if err != nil {
+		return vectorreindex.NodeStatus{Node: node, Error: err.Error()}
+	}
+	return *status
+}
+
+// LocalStatus returns the progress of the job of the target vector on the
+// shards of this node
+func (m *Manager) LocalStatus(className, targetVector string) *vectorreindex.NodeStatus {
+	job := m.job(className, targetVector)
+	if job == nil {
+		return &vectorreindex.NodeStatus{Node: m.nodes.LocalName(), Shards: []vectorreindex.ShardStatus{}}
+	}
+	return m.db.VectorReindexStatus(className, *job)
+}
+
+func (m *Manager) job(className, targetVector string) *vectorreindex.Job {
+	for _, job := range m.schema.VectorReindexJobs()[className] {
+		if job.TargetVector == targetVector {
+			return &job
+		}
+	}
+	return nil
+}
+
+// StartJob validates the job and stores it in the schema. The shards start
+// reindexing within a few seconds.
+func (m *Manager) StartJob(ctx context.Context, principal *models.Principal,
+	className string, job vectorreindex.Job,
+) (*Status, error) {
+	if err := m.authorizer.Authorize(principal, "update", "schema/objects"); err != nil {
+		return nil, err
+	}
+
+	class := m.schema.ReadOnlyClass(className)
+	if class == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("class %q not found", className))
+	}
+	if err := m.validate(class, job); err != nil {
+		return nil, enterrors.NewErrUnprocessable(err)
+	}
+	if prev := m.job(className, job.TargetVector); prev != nil && !prev.Finished() {
+		return nil, enterrors.NewErrUnprocessable(fmt.Errorf(
+			"vector reindexing of target vector %q is %s", job.TargetVector, prev.Status))
+	}
+
+	moduleConfig, err := m.moduleConfig(ctx, class, job)
+	if err != nil {
+		return nil, enterrors.NewErrUnprocessable(err)
+	}
+
+	job.ModuleConfig = moduleConfig
+	if job.BatchSize == 0 {
+		job.BatchSize = vectorreindex.DefaultBatchSize
+	}
+	job.Status = vectorreindex.StatusRunning
+	job.StartTime = time.Now()
+	if _, err := m.raft.StartVectorReindex(className, &job); err != nil {
+		return nil, fmt.Errorf("start vector reindexing: %w", err)
+	}
+	return m.status(ctx, className, job.TargetVector)
+}
+
+func (m *Manager) validate(class *models.Class, job vectorreindex.Job) error {
+	if schema.MultiTenancyEnabled(class) {
+		return fmt.Errorf("vector reindexing is not supported for multi-tenant classes")
+	}
+
+	indexType, indexConfig := class.VectorIndexType, class.VectorIndexConfig
+	if job.TargetVector == "" {
+		if len(class.VectorConfig) > 0 {
+			return fmt.Errorf("class %q has named vectors, a target vector is required", class.Class)
+		}
+	} else {
+		cfg, ok := class.VectorConfig[job.TargetVector]
+		if !ok {
+			return fmt.Errorf("target vector %q not found", job.TargetVector)
+		}
+		indexType, indexConfig = cfg.VectorIndexType, cfg.VectorIndexConfig
+	}
+	if indexType != "" && indexType != "hnsw" {
+		return fmt.Errorf("vectors can only be reindexed for hnsw indexes, not %q", indexType)
+	}
+	if uc, ok := indexConfig.(hnswent.UserConfig); ok && uc.Skip {
+		return fmt.Errorf("vectors can't be reindexed if indexing is skipped")
+	}
+
+	if job.Vectorizer == "" {
+		return fmt.Errorf("vectorizer is required")
+	}
+	if err := m.modules.ValidateReindexVectorizer(job.Vectorizer); err != nil {
+		return err
+	}
+	if job.BatchSize < 0 {
+		return fmt.Errorf("batch size must not be negative")
+	}
+	if job.MaxObjectsPerSecond < 0 {
+		return fmt.Errorf("max objects per second must not be negative")
+	}
+	return nil
+}
+
+// moduleConfig validates the module config of the job and adds the defaults
+// of the module, as if the class was created with the vectorizer of the job
+func (m *Manager) moduleConfig(ctx context.Context, class *models.Class,
+	job vectorreindex.Job,
+) (map[string]interface{}, error) {
+	applied := job.ApplyTo(class)
+
+	// the module defaults of the properties are set as well, which must not
+	// change the class in the schema
+	props, err := copyProperties(class.Properties)
+	if err != nil {
+		return nil, err
+	}
+	applied.Properties = props
+
+	m.modules.SetClassDefaults(applied)
+	if err := m.modules.ValidateClass(ctx, applied); err != nil {
+		return nil, fmt.Errorf("module config: %w", err)
+	}
+
+	var moduleConfig interface{}
+	if job.TargetVector == "" {
+		if cfg, ok := applied.ModuleConfig.(map[string]interface{}); ok {
+			moduleConfig = cfg[job.Vectorizer]
+		}
+	} else if cfg, ok := applied.VectorConfig[job.TargetVector].Vectorizer.(map[string]interface{}); ok {
+		moduleConfig = cfg[job.Vectorizer]
+	}
+
+	if cfg, ok := moduleConfig.(map[string]interface{}); ok {
+		return cfg, nil
+	}
+	return map[string]interface{}{}, nil
+}
+
+func copyProperties(props []*models.Property) ([]*models.Property, error) {
+	b, err := json.Marshal(props)
+	if err != nil {
+		return nil, fmt.Errorf("marshal properties: %w", err)
+	}
+	var copied []*models.Property
+	if err := json.Unmarshal(b, &copied); err != nil {
+		return nil, fmt.Errorf("unmarshal properties: %w", err)
+	}
+	return copied, nil
+}
+
+// Status returns the progress of the last job of the target vector
+func (m *Manager) Status(ctx context.Context, principal *models.Principal,
+	className, targetVector string,
+) (*Status, error) {
+	if err := m.authorizer.Authorize(principal, "list", "schema/*"); err != nil {
+		return nil, err
+	}
+	return m.status(ctx, className, targetVector)
+}
+
+func (m *Manager) status(ctx context.Context, className, targetVector string) (*Status, error) {
+	job := m.job(className, targetVector)
+	if job == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf(
+			"no vector reindexing for target vector %q of class %q", targetVector, className))
+	}
+
+	status := &Status{Class: className, Job: *job}
+	if job.Finished() {
+		// shards don't report the progress of jobs which finished before they
+		// were loaded
+		return status, nil
+	}
+
+	shards := m.shardsByNode(className)
+	status.Nodes = make([]vectorreindex.NodeStatus, 0, len(shards))
+	results := make(chan vectorreindex.NodeStatus, len(shards))
+	eg := enterrors.NewErrorGroupWrapper(m.logger)
+	for node := range shards {
+		node := node
+		eg.Go(func() error {
+			results <- m.nodeStatus(ctx, node, className, targetVector)
+			return nil
+		}, node)
+	}
+	eg.Wait()
+	close(results)
+
+	for nodeStatus := range results {
+		status.Nodes = append(status.Nodes, nodeStatus)
+	}
+	sort.Slice(status.Nodes, func(i, j int) bool {
+		return status.Nodes[i].Node < status.Nodes[j].Node
+	})
+	return status, nil
+}
+
+// Pause stops vectorizing objects until the job is resumed
+func (m *Manager) Pause(ctx context.Context, principal *models.Principal,
+	className, targetVector string,
+) (*Status, error) {
+	return m.setStatus(ctx, principal, className, targetVector,
+		vectorreindex.StatusPaused, vectorreindex.StatusRunning)
+}
+
+// Resume continues a paused job
+func (m *Manager) Resume(ctx context.Context, principal *models.Principal,
+	className, targetVector string,
+) (*Status, error) {
+	return m.setStatus(ctx, principal, className, targetVector,
+		vectorreindex.StatusRunning, vectorreindex.StatusPaused)
+}
+
+// Cancel stops the job and removes the vectors computed so far. The class
+// keeps its current vectorizer.
+func (m *Manager) Cancel(ctx context.Context, principal *models.Principal,
+	className, targetVector string,
+) (*Status, error) {
+	return m.setStatus(ctx, principal, className, targetVector,
+		vectorreindex.StatusCancelled, vectorreindex.StatusRunning, vectorreindex.StatusPaused)
+}
+
+func (m *Manager) setStatus(ctx context.Context, principal *models.Principal,
+	className, targetVector, status string, from ...string,
+) (*Status, error) {
+	if err := m.authorizer.Authorize(principal, "update", "schema/objects"); err != nil {
+		return nil, err
+	}
+
+	job := m.job(className, targetVector)
+	if job == nil || job.Finished() {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf(
+			"no vector reindexing of target vector %q of class %q in progress", targetVector, className))
+	}
+
+	allowed := false
+	for _, s := range from {
+		allowed = allowed || job.Status == s
+	}
+	if !allowed {
+		return nil, enterrors.NewErrUnprocessable(fmt.Errorf(
+			"vector reindexing of target vector %q is %s", targetVector, job.Status))
+	}
+
+	if err := m.update(className, targetVector, status, ""); err != nil {
+		return nil, err
+	}
+	return m.status(ctx, className, targetVector)
+}
@@ -0,0 +1,460 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorreindex
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorreindex"
+	"github.com/weaviate/weaviate/usecases/fakes"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestStartJob(t *testing.T) {
+	t.Run("stores the job with the module defaults", func(t *testing.T) {
+		f := newFakes(true)
+		status, err := f.manager().StartJob(context.Background(), nil, "C",
+			vectorreindex.Job{Vectorizer: "text2vec-new", ModuleConfig: map[string]interface{}{"model": "large"}})
+		require.Nil(t, err)
+
+		job := f.schema.jobs["C"][""]
+		require.NotNil(t, job)
+		assert.Equal(t, vectorreindex.StatusRunning, job.Status)
+		assert.Equal(t, vectorreindex.DefaultBatchSize, job.BatchSize)
+		assert.Equal(t, map[string]interface{}{"model": "large", "dimensions": 8}, job.ModuleConfig)
+		assert.False(t, job.StartTime.IsZero())
+		assert.Equal(t, *job, status.Job)
+		assert.Len(t, status.Nodes, 2)
+
+		// the class in the schema is not changed before the job is committed
+		assert.Equal(t, "text2vec-old", f.schema.Classes["C"].Vectorizer)
+		assert.Nil(t, f.schema.Classes["C"].Properties[0].ModuleConfig)
+	})
+
+	for _, tc := range []struct {
+		name  string
+		class string
+		job   vectorreindex.Job
+		err   interface{}
+	}{
+		{
+			name:  "unknown class",
+			class: "Unknown",
+			job:   vectorreindex.Job{Vectorizer: "text2vec-new"},
+			err:   enterrors.ErrNotFound{},
+		},
+		{
+			name:  "multi-tenant class",
+			class: "MT",
+			job:   vectorreindex.Job{Vectorizer: "text2vec-new"},
+			err:   enterrors.ErrUnprocessable{},
+		},
+		{
+			name:  "missing target vector",
+			class: "Named",
+			job:   vectorreindex.Job{Vectorizer: "text2vec-new"},
+			err:   enterrors.ErrUnprocessable{},
+		},
+		{
+			name:  "unknown target vector",
+			class: "Named",
+			job:   vectorreindex.Job{TargetVector: "unknown", Vectorizer: "text2vec-new"},
+			err:   enterrors.ErrUnprocessable{},
+		},
+		{
+			name:  "flat index",
+			class: "Named",
+			job:   vectorreindex.Job{TargetVector: "flat", Vectorizer: "text2vec-new"},
+			err:   enterrors.ErrUnprocessable{},
+		},
+		{
+			name:  "module which can't vectorize",
+			class: "C",
+			job:   vectorreindex.Job{Vectorizer: "qna-openai"},
+			err:   enterrors.ErrUnprocessable{},
+		},
+		{
+			name:  "invalid module config",
+			class: "C",
+			job: vectorreindex.Job{
+				Vectorizer:   "text2vec-new",
+				ModuleConfig: map[string]interface{}{"model": "unknown"},
+			},
+			err: enterrors.ErrUnprocessable{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			f := newFakes(true)
+			_, err := f.manager().StartJob(context.Background(), nil, tc.class, tc.job)
+			require.NotNil(t, err)
+			assert.IsType(t, tc.err, err)
+			assert.Empty(t, f.schema.jobs)
+		})
+	}
+
+	t.Run("a job is in progress already", func(t *testing.T) {
+		f := newFakes(true)
+		f.schema.setJob("C", vectorreindex.Job{Status: vectorreindex.StatusPaused, Version: 3})
+
+		_, err := f.manager().StartJob(context.Background(), nil, "C",
+			vectorreindex.Job{Vectorizer: "text2vec-new"})
+		assert.IsType(t, enterrors.ErrUnprocessable{}, err)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		f := newFakes(true)
+		f.authorizer.Err = errors.New("forbidden")
+		_, err := f.manager().StartJob(context.Background(), nil, "C",
+			vectorreindex.Job{Vectorizer: "text2vec-new"})
+		assert.NotNil(t, err)
+		assert.Empty(t, f.schema.jobs)
+	})
+}
+
+func TestCommitIfReady(t *testing.T) {
+	running := vectorreindex.Job{Status: vectorreindex.StatusRunning, Version: 3}
+	ready := func(shards ...string) []vectorreindex.ShardStatus {
+		out := make([]vectorreindex.ShardStatus, len(shards))
+		for i, shard := range shards {
+			out[i] = vectorreindex.ShardStatus{Shard: shard, Status: vectorreindex.StatusReady}
+		}
+		return out
+	}
+
+	t.Run("commits once all shards are ready", func(t *testing.T) {
+		f := newFakes(true)
+		f.schema.setJob("C", running)
+		f.db.status = &vectorreindex.NodeStatus{Node: "N1", Version: 3, Shards: ready("S1", "S2")}
+		f.client.status = &vectorreindex.NodeStatus{Node: "N2", Version: 3, Shards: ready("S1")}
+
+		f.manager().reconcile(context.Background())
+		assert.Equal(t, 1, f.db.reconciled)
+		assert.Equal(t, vectorreindex.StatusCompleted, f.schema.jobs["C"][""].Status)
+	})
+
+	t.Run("a node which becomes the leader mid-job commits", func(t *testing.T) {
+		f := newFakes(false)
+		m := f.manager()
+		f.schema.setJob("C", running)
+		f.db.status = &vectorreindex.NodeStatus{Node: "N1", Version: 3, Shards: ready("S1", "S2")}
+		f.client.status = &vectorreindex.NodeStatus{Node: "N2", Version: 3, Shards: ready("S1")}
+
+		m.reconcile(context.Background())
+		assert.Equal(t, 1, f.db.reconciled)
+		assert.Equal(t, vectorreindex.StatusRunning, f.schema.jobs["C"][""].Status)
+
+		f.raft.SetLeader(true)
+		m.reconcile(context.Background())
+		assert.Equal(t, vectorreindex.StatusCompleted, f.schema.jobs["C"][""].Status)
+	})
+
+	t.Run("a leader which steps down mid-job does not commit", func(t *testing.T) {
+		f := newFakes(true)
+		m := f.manager()
+		f.schema.setJob("C", running)
+		f.db.status = &vectorreindex.NodeStatus{Node: "N1", Version: 3, Shards: ready("S1")}
+		f.client.status = &vectorreindex.NodeStatus{Node: "N2", Version: 3, Shards: ready("S1")}
+
+		m.reconcile(context.Background())
+		assert.Equal(t, vectorreindex.StatusRunning, f.schema.jobs["C"][""].Status)
+
+		f.raft.SetLeader(false)
+		f.db.status = &vectorreindex.NodeStatus{Node: "N1", Version: 3, Shards: ready("S1", "S2")}
+		m.reconcile(context.Background())
+		assert.Equal(t, 2, f.db.reconciled, "followers keep reindexing their shards")
+		assert.Equal(t, vectorreindex.StatusRunning, f.schema.jobs["C"][""].Status)
+	})
+
+	t.Run("waits for shards which aren't ready", func(t *testing.T) {
+		f := newFakes(true)
+		f.schema.setJob("C", running)
+		f.db.status = &vectorreindex.NodeStatus{Node: "N1", Version: 3, Shards: ready("S1")}
+		f.client.status = &vectorreindex.NodeStatus{Node: "N2", Version: 3, Shards: ready("S1")}
+
+		f.manager().reconcile(context.Background())
+		assert.Equal(t, vectorreindex.StatusRunning, f.schema.jobs["C"][""].Status)
+	})
+
+	t.Run("waits for nodes which didn't start the job", func(t *testing.T) {
+		f := newFakes(true)
+		f.schema.setJob("C", running)
+		f.db.status = &vectorreindex.NodeStatus{Node: "N1", Version: 3, Shards: ready("S1", "S2")}
+		f.client.status = &vectorreindex.NodeStatus{Node: "N2", Version: 2, Shards: ready("S1")}
+
+		f.manager().reconcile(context.Background())
+		assert.Equal(t, vectorreindex.StatusRunning, f.schema.jobs["C"][""].Status)
+	})
+
+	t.Run("fails if a shard failed", func(t *testing.T) {
+		f := newFakes(true)
+		f.schema.setJob("C", running)
+		f.db.status = &vectorreindex.NodeStatus{Node: "N1", Version: 3, Shards: ready("S1", "S2")}
+		f.client.status = &vectorreindex.NodeStatus{Node: "N2", Version: 3, Shards: []vectorreindex.ShardStatus{
+			{Shard: "S1", Status: vectorreindex.StatusFailed, Error: "rate limited"},
+		}}
+
+		f.manager().reconcile(context.Background())
+		job := f.schema.jobs["C"][""]
+		assert.Equal(t, vectorreindex.StatusFailed, job.Status)
+		assert.Contains(t, job.Error, "rate limited")
+	})
+
+	t.Run("backfills defer inactive tenants", func(t *testing.T) {
+		f := newFakes(true)
+		f.schema.States["MT"] = &sharding.State{Physical: map[string]sharding.Physical{
+			"T1": {BelongsToNodes: []string{"N1", "N2"}, Status: models.TenantActivityStatusHOT},
+			"T2": {BelongsToNodes: []string{"N1", "N2"}, Status: models.TenantActivityStatusCOLD},
+		}}
+		backfill := vectorreindex.Job{TargetVector: "b", Backfill: true, Status: vectorreindex.StatusRunning, Version: 3}
+		f.schema.setJob("MT", backfill)
+		f.db.status = &vectorreindex.NodeStatus{Node: "N1", Version: 3, Shards: ready("T1")}
+		f.client.status = &vectorreindex.NodeStatus{Node: "N2", Version: 3, Shards: ready("T1")}
+
+		m := f.manager()
+		m.reconcile(context.Background())
+		job := f.schema.jobs["MT"]["b"]
+		assert.Equal(t, vectorreindex.StatusCompleted, job.Status)
+		assert.Equal(t, []string{"T2"}, job.Deferred)
+
+		f.schema.States["MT"].Physical["T2"] = sharding.Physical{
+			BelongsToNodes: []string{"N1", "N2"}, Status: models.TenantActivityStatusHOT,
+		}
+		f.db.status = &vectorreindex.NodeStatus{Node: "N1", Version: 3, Shards: ready("T1", "T2")}
+		m.reconcile(context.Background())
+		assert.Equal(t, []string{"T2"}, job.Deferred, "waits for all replicas")
+
+		f.client.status = &vectorreindex.NodeStatus{Node: "N2", Version: 3, Shards: ready("T1", "T2")}
+		m.reconcile(context.Background())
+		assert.Empty(t, job.Deferred)
+	})
+
+	t.Run("followers only reconcile their shards", func(t *testing.T) {
+		f := newFakes(false)
+		f.schema.setJob("C", running)
+		f.db.status = &vectorreindex.NodeStatus{Node: "N1", Version: 3, Shards: ready("S1", "S2")}
+		f.client.status = &vectorreindex.NodeStatus{Node: "N2", Version: 3, Shards: ready("S1")}
+
+		f.manager().reconcile(context.Background())
+		assert.Equal(t, 1, f.db.reconciled)
+		assert.Equal(t, vectorreindex.StatusRunning, f.schema.jobs["C"][""].Status)
+	})
+}
+
+func TestSetStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pause, resume and cancel", func(t *testing.T) {
+		f := newFakes(true)
+		f.schema.setJob("C", vectorreindex.Job{Status: vectorreindex.StatusRunning, Version: 3})
+		m := f.manager()
+
+		status, err := m.Pause(ctx, nil, "C", "")
+		require.Nil(t, err)
+		assert.Equal(t, vectorreindex.StatusPaused, status.Job.Status)
+
+		_, err = m.Pause(ctx, nil, "C", "")
+		assert.IsType(t, enterrors.ErrUnprocessable{}, err)
+
+		status, err = m.Resume(ctx, nil, "C", "")
+		require.Nil(t, err)
+		assert.Equal(t, vectorreindex.StatusRunning, status.Job.Status)
+
+		status, err = m.Cancel(ctx, nil, "C", "")
+		require.Nil(t, err)
+		assert.Equal(t, vectorreindex.StatusCancelled, status.Job.Status)
+		assert.Empty(t, status.Nodes)
+
+		_, err = m.Cancel(ctx, nil, "C", "")
+		assert.IsType(t, enterrors.ErrNotFound{}, err)
+	})
+
+	t.Run("no job", func(t *testing.T) {
+		f := newFakes(true)
+		_, err := f.manager().Status(ctx, nil, "C", "")
+		assert.IsType(t, enterrors.ErrNotFound{}, err)
+		_, err = f.manager().Pause(ctx, nil, "C", "")
+		assert.IsType(t, enterrors.ErrNotFound{}, err)
+	})
+}
+
+type testFakes struct {
+	authorizer *fakes.FakeAuthorizer
+	raft       *fakeRaft
+	schema     *fakeSchema
+	modules    *fakeModules
+	db         *fakeDB
+	client     *fakeClient
+}
+
+// newFakes returns a cluster of two nodes, where N1 is the local node and
+// holds two shards of class C, and N2 one
+func newFakes(leader bool) *testFakes {
+	schema := &fakeSchema{
+		FakeSchemaReader: &fakes.FakeSchemaReader{
+			Classes: map[string]*models.Class{
+				"C": {
+					Class:      "C",
+					Vectorizer: "text2vec-old",
+					Properties: []*models.Property{{Name: "text", DataType: []string{"text"}}},
+				},
+				"MT": {
+					Class:              "MT",
+					MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+				},
+				"Named": {
+					Class: "Named",
+					VectorConfig: map[string]models.VectorConfig{
+						"flat": {VectorIndexType: "flat", VectorIndexConfig: flat.NewDefaultUserConfig()},
+					},
+				},
+			},
+			States: map[string]*sharding.State{
+				"C": {Physical: map[string]sharding.Physical{
+					"S1": {BelongsToNodes: []string{"N1", "N2"}},
+					"S2": {BelongsToNodes: []string{"N1"}},
+				}},
+			},
+		},
+		jobs: map[string]map[string]*vectorreindex.Job{},
+	}
+	return &testFakes{
+		authorizer: &fakes.FakeAuthorizer{},
+		raft:       &fakeRaft{FakeLeader: fakes.NewFakeLeader(leader), schema: schema},
+		schema:     schema,
+		modules:    &fakeModules{},
+		db:         &fakeDB{},
+		client:     &fakeClient{},
+	}
+}
+
+func (f *testFakes) manager() *Manager {
+	logger, _ := test.NewNullLogger()
+	return New(f.authorizer, f.raft, f.schema, f.modules, f.db,
+		fakes.NewFakeNodes("N1", "N1", "N2"), f.client, logger)
+}
+
+type fakeRaft struct {
+	*fakes.FakeLeader
+	schema *fakeSchema
+}
+
+func (r *fakeRaft) StartVectorReindex(class string, job *vectorreindex.Job) (uint64, error) {
+	copied := *job
+	copied.Version = 3
+	r.schema.setJob(class, copied)
+	return 3, nil
+}
+
+func (r *fakeRaft) UpdateVectorReindex(class string, req *cmd.UpdateVectorReindexRequest) (uint64, error) {
+	job := r.schema.jobs[class][req.TargetVector]
+	job.Status = req.Status
+	job.Error = req.Error
+	job.Deferred = req.Deferred
+	return 4, nil
+}
+
+type fakeSchema struct {
+	*fakes.FakeSchemaReader
+	jobs map[string]map[string]*vectorreindex.Job
+}
+
+func (s *fakeSchema) setJob(class string, job vectorreindex.Job) {
+	if s.jobs[class] == nil {
+		s.jobs[class] = map[string]*vectorreindex.Job{}
+	}
+	s.jobs[class][job.TargetVector] = &job
+}
+
+func (s *fakeSchema) VectorReindexJobs() map[string][]vectorreindex.Job {
+	out := map[string][]vectorreindex.Job{}
+	for class, jobs := range s.jobs {
+		for _, job := range jobs {
+			out[class] = append(out[class], *job)
+		}
+	}
+	return out
+}
+
+type fakeModules struct{}
+
+func (m *fakeModules) ReindexVectors(context.Context, *models.Class, string,
+	[]*models.Object,
+) (map[int]error, error) {
+	return nil, nil
+}
+
+func (m *fakeModules) ValidateReindexVectorizer(moduleName string) error {
+	if moduleName != "text2vec-new" {
+		return errors.New("not a vectorizer")
+	}
+	return nil
+}
+
+func (m *fakeModules) SetClassDefaults(class *models.Class) {
+	if cfg, ok := class.ModuleConfig.(map[string]interface{}); ok {
+		if modCfg, ok := cfg[class.Vectorizer].(map[string]interface{}); ok {
+			modCfg["dimensions"] = 8
+		}
+	}
+	for _, prop := range class.Properties {
+		prop.ModuleConfig = map[string]interface{}{class.Vectorizer: map[string]interface{}{"skip": false}}
+	}
+}
+
+func (m *fakeModules) ValidateClass(_ context.Context, class *models.Class) error {
+	if cfg, ok := class.ModuleConfig.(map[string]interface{}); ok {
+		if modCfg, ok := cfg[class.Vectorizer].(map[string]interface{}); ok && modCfg["model"] == "unknown" {
+			return errors.New("unknown model")
+		}
+	}
+	return nil
+}
+
+type fakeDB struct {
+	sync.Mutex
+	reconciled int
+	status     *vectorreindex.NodeStatus
+}
+
+func (d *fakeDB) ReconcileVectorReindexes(map[string][]vectorreindex.Job, vectorreindex.Vectorizer) {
+	d.Lock()
+	defer d.Unlock()
+	d.reconciled++
+}
+
+func (d *fakeDB) VectorReindexStatus(string, vectorreindex.Job) *vectorreindex.NodeStatus {
+	if d.status == nil {
+		return &vectorreindex.NodeStatus{Node: "N1"}
+	}
+	return d.status
+}
+
+type fakeClient struct {
+	status *vectorreindex.NodeStatus
+}
+
+func (c *fakeClient) Status(_ context.Context, host, class, targetVector string) (*vectorreindex.NodeStatus, error) {
+	if c.status == nil {
+		return &vectorreindex.NodeStatus{Node: "N2"}, nil
+	}
+	return c.status, nil
+}